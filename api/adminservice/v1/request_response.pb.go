@@ -44,6 +44,7 @@ import (
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	v1 "go.temporal.io/api/common/v1"
 	v16 "go.temporal.io/api/enums/v1"
+	v110 "go.temporal.io/api/taskqueue/v1"
 	v19 "go.temporal.io/api/version/v1"
 	v17 "go.temporal.io/api/workflow/v1"
 	v18 "go.temporal.io/server/api/cluster/v1"
@@ -889,6 +890,101 @@ func (m *RemoveTaskResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RemoveTaskResponse proto.InternalMessageInfo
 
+type DescribeHistoryQueueRequest struct {
+	ShardId int32 `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	// Describe all the queues of the shard if unspecified.
+	Category v13.TaskCategory `protobuf:"varint,2,opt,name=category,proto3,enum=temporal.server.api.enums.v1.TaskCategory" json:"category,omitempty"`
+}
+
+func (m *DescribeHistoryQueueRequest) Reset()      { *m = DescribeHistoryQueueRequest{} }
+func (*DescribeHistoryQueueRequest) ProtoMessage() {}
+func (*DescribeHistoryQueueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{15}
+}
+func (m *DescribeHistoryQueueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeHistoryQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeHistoryQueueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DescribeHistoryQueueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeHistoryQueueRequest.Merge(m, src)
+}
+func (m *DescribeHistoryQueueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeHistoryQueueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeHistoryQueueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeHistoryQueueRequest proto.InternalMessageInfo
+
+func (m *DescribeHistoryQueueRequest) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *DescribeHistoryQueueRequest) GetCategory() v13.TaskCategory {
+	if m != nil {
+		return m.Category
+	}
+	return v13.TASK_CATEGORY_UNSPECIFIED
+}
+
+type DescribeHistoryQueueResponse struct {
+	Queues []*v14.HistoryQueueInfo `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
+}
+
+func (m *DescribeHistoryQueueResponse) Reset()      { *m = DescribeHistoryQueueResponse{} }
+func (*DescribeHistoryQueueResponse) ProtoMessage() {}
+func (*DescribeHistoryQueueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{16}
+}
+func (m *DescribeHistoryQueueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeHistoryQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeHistoryQueueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DescribeHistoryQueueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeHistoryQueueResponse.Merge(m, src)
+}
+func (m *DescribeHistoryQueueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeHistoryQueueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeHistoryQueueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeHistoryQueueResponse proto.InternalMessageInfo
+
+func (m *DescribeHistoryQueueResponse) GetQueues() []*v14.HistoryQueueInfo {
+	if m != nil {
+		return m.Queues
+	}
+	return nil
+}
+
 // *
 // StartEventId defines the beginning of the event to fetch. The first event is exclusive.
 // EndEventId and EndEventVersion defines the end of the event to fetch. The end event is exclusive.
@@ -908,7 +1004,7 @@ func (m *GetWorkflowExecutionRawHistoryV2Request) Reset() {
 }
 func (*GetWorkflowExecutionRawHistoryV2Request) ProtoMessage() {}
 func (*GetWorkflowExecutionRawHistoryV2Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{15}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{17}
 }
 func (m *GetWorkflowExecutionRawHistoryV2Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1005,7 +1101,7 @@ func (m *GetWorkflowExecutionRawHistoryV2Response) Reset() {
 }
 func (*GetWorkflowExecutionRawHistoryV2Response) ProtoMessage() {}
 func (*GetWorkflowExecutionRawHistoryV2Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{16}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{18}
 }
 func (m *GetWorkflowExecutionRawHistoryV2Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1070,7 +1166,7 @@ type GetReplicationMessagesRequest struct {
 func (m *GetReplicationMessagesRequest) Reset()      { *m = GetReplicationMessagesRequest{} }
 func (*GetReplicationMessagesRequest) ProtoMessage() {}
 func (*GetReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{17}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{19}
 }
 func (m *GetReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1120,7 +1216,7 @@ type GetReplicationMessagesResponse struct {
 func (m *GetReplicationMessagesResponse) Reset()      { *m = GetReplicationMessagesResponse{} }
 func (*GetReplicationMessagesResponse) ProtoMessage() {}
 func (*GetReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{18}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{20}
 }
 func (m *GetReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1171,7 +1267,7 @@ func (m *GetNamespaceReplicationMessagesRequest) Reset() {
 }
 func (*GetNamespaceReplicationMessagesRequest) ProtoMessage() {}
 func (*GetNamespaceReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{19}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{21}
 }
 func (m *GetNamespaceReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1230,7 +1326,7 @@ func (m *GetNamespaceReplicationMessagesResponse) Reset() {
 }
 func (*GetNamespaceReplicationMessagesResponse) ProtoMessage() {}
 func (*GetNamespaceReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{20}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{22}
 }
 func (m *GetNamespaceReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1273,7 +1369,7 @@ type GetDLQReplicationMessagesRequest struct {
 func (m *GetDLQReplicationMessagesRequest) Reset()      { *m = GetDLQReplicationMessagesRequest{} }
 func (*GetDLQReplicationMessagesRequest) ProtoMessage() {}
 func (*GetDLQReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{21}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{23}
 }
 func (m *GetDLQReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1316,7 +1412,7 @@ type GetDLQReplicationMessagesResponse struct {
 func (m *GetDLQReplicationMessagesResponse) Reset()      { *m = GetDLQReplicationMessagesResponse{} }
 func (*GetDLQReplicationMessagesResponse) ProtoMessage() {}
 func (*GetDLQReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{22}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{24}
 }
 func (m *GetDLQReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1362,7 +1458,7 @@ type ReapplyEventsRequest struct {
 func (m *ReapplyEventsRequest) Reset()      { *m = ReapplyEventsRequest{} }
 func (*ReapplyEventsRequest) ProtoMessage() {}
 func (*ReapplyEventsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{23}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{25}
 }
 func (m *ReapplyEventsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1418,7 +1514,7 @@ type ReapplyEventsResponse struct {
 func (m *ReapplyEventsResponse) Reset()      { *m = ReapplyEventsResponse{} }
 func (*ReapplyEventsResponse) ProtoMessage() {}
 func (*ReapplyEventsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{24}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{26}
 }
 func (m *ReapplyEventsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1457,7 +1553,7 @@ type AddSearchAttributesRequest struct {
 func (m *AddSearchAttributesRequest) Reset()      { *m = AddSearchAttributesRequest{} }
 func (*AddSearchAttributesRequest) ProtoMessage() {}
 func (*AddSearchAttributesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{25}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{27}
 }
 func (m *AddSearchAttributesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1520,7 +1616,7 @@ type AddSearchAttributesResponse struct {
 func (m *AddSearchAttributesResponse) Reset()      { *m = AddSearchAttributesResponse{} }
 func (*AddSearchAttributesResponse) ProtoMessage() {}
 func (*AddSearchAttributesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{26}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{28}
 }
 func (m *AddSearchAttributesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1558,7 +1654,7 @@ type RemoveSearchAttributesRequest struct {
 func (m *RemoveSearchAttributesRequest) Reset()      { *m = RemoveSearchAttributesRequest{} }
 func (*RemoveSearchAttributesRequest) ProtoMessage() {}
 func (*RemoveSearchAttributesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{27}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{29}
 }
 func (m *RemoveSearchAttributesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1614,7 +1710,7 @@ type RemoveSearchAttributesResponse struct {
 func (m *RemoveSearchAttributesResponse) Reset()      { *m = RemoveSearchAttributesResponse{} }
 func (*RemoveSearchAttributesResponse) ProtoMessage() {}
 func (*RemoveSearchAttributesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{28}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{30}
 }
 func (m *RemoveSearchAttributesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1651,7 +1747,7 @@ type GetSearchAttributesRequest struct {
 func (m *GetSearchAttributesRequest) Reset()      { *m = GetSearchAttributesRequest{} }
 func (*GetSearchAttributesRequest) ProtoMessage() {}
 func (*GetSearchAttributesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{29}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{31}
 }
 func (m *GetSearchAttributesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1705,7 +1801,7 @@ type GetSearchAttributesResponse struct {
 func (m *GetSearchAttributesResponse) Reset()      { *m = GetSearchAttributesResponse{} }
 func (*GetSearchAttributesResponse) ProtoMessage() {}
 func (*GetSearchAttributesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{30}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{32}
 }
 func (m *GetSearchAttributesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1769,7 +1865,7 @@ type DescribeClusterRequest struct {
 func (m *DescribeClusterRequest) Reset()      { *m = DescribeClusterRequest{} }
 func (*DescribeClusterRequest) ProtoMessage() {}
 func (*DescribeClusterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{31}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{33}
 }
 func (m *DescribeClusterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1823,7 +1919,7 @@ type DescribeClusterResponse struct {
 func (m *DescribeClusterResponse) Reset()      { *m = DescribeClusterResponse{} }
 func (*DescribeClusterResponse) ProtoMessage() {}
 func (*DescribeClusterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{32}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{34}
 }
 func (m *DescribeClusterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1944,7 +2040,7 @@ type ListClustersRequest struct {
 func (m *ListClustersRequest) Reset()      { *m = ListClustersRequest{} }
 func (*ListClustersRequest) ProtoMessage() {}
 func (*ListClustersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{33}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{35}
 }
 func (m *ListClustersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1995,7 +2091,7 @@ type ListClustersResponse struct {
 func (m *ListClustersResponse) Reset()      { *m = ListClustersResponse{} }
 func (*ListClustersResponse) ProtoMessage() {}
 func (*ListClustersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{34}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{36}
 }
 func (m *ListClustersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2046,7 +2142,7 @@ type AddOrUpdateRemoteClusterRequest struct {
 func (m *AddOrUpdateRemoteClusterRequest) Reset()      { *m = AddOrUpdateRemoteClusterRequest{} }
 func (*AddOrUpdateRemoteClusterRequest) ProtoMessage() {}
 func (*AddOrUpdateRemoteClusterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{35}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{37}
 }
 func (m *AddOrUpdateRemoteClusterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2095,7 +2191,7 @@ type AddOrUpdateRemoteClusterResponse struct {
 func (m *AddOrUpdateRemoteClusterResponse) Reset()      { *m = AddOrUpdateRemoteClusterResponse{} }
 func (*AddOrUpdateRemoteClusterResponse) ProtoMessage() {}
 func (*AddOrUpdateRemoteClusterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{36}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{38}
 }
 func (m *AddOrUpdateRemoteClusterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2131,7 +2227,7 @@ type RemoveRemoteClusterRequest struct {
 func (m *RemoveRemoteClusterRequest) Reset()      { *m = RemoveRemoteClusterRequest{} }
 func (*RemoveRemoteClusterRequest) ProtoMessage() {}
 func (*RemoveRemoteClusterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{37}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{39}
 }
 func (m *RemoveRemoteClusterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2173,7 +2269,7 @@ type RemoveRemoteClusterResponse struct {
 func (m *RemoveRemoteClusterResponse) Reset()      { *m = RemoveRemoteClusterResponse{} }
 func (*RemoveRemoteClusterResponse) ProtoMessage() {}
 func (*RemoveRemoteClusterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{38}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{40}
 }
 func (m *RemoveRemoteClusterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2219,7 +2315,7 @@ type ListClusterMembersRequest struct {
 func (m *ListClusterMembersRequest) Reset()      { *m = ListClusterMembersRequest{} }
 func (*ListClusterMembersRequest) ProtoMessage() {}
 func (*ListClusterMembersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{39}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{41}
 }
 func (m *ListClusterMembersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2305,7 +2401,7 @@ type ListClusterMembersResponse struct {
 func (m *ListClusterMembersResponse) Reset()      { *m = ListClusterMembersResponse{} }
 func (*ListClusterMembersResponse) ProtoMessage() {}
 func (*ListClusterMembersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{40}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{42}
 }
 func (m *ListClusterMembersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2360,7 +2456,7 @@ type GetDLQMessagesRequest struct {
 func (m *GetDLQMessagesRequest) Reset()      { *m = GetDLQMessagesRequest{} }
 func (*GetDLQMessagesRequest) ProtoMessage() {}
 func (*GetDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{41}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{43}
 }
 func (m *GetDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2436,12 +2532,14 @@ type GetDLQMessagesResponse struct {
 	ReplicationTasks     []*v15.ReplicationTask     `protobuf:"bytes,2,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
 	NextPageToken        []byte                     `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	ReplicationTasksInfo []*v15.ReplicationTaskInfo `protobuf:"bytes,4,rep,name=replication_tasks_info,json=replicationTasksInfo,proto3" json:"replication_tasks_info,omitempty"`
+	// Set for DEAD_LETTER_QUEUE_TYPE_HISTORY_TASK.
+	HistoryTasks []*v11.HistoryTaskDLQMessage `protobuf:"bytes,5,rep,name=history_tasks,json=historyTasks,proto3" json:"history_tasks,omitempty"`
 }
 
 func (m *GetDLQMessagesResponse) Reset()      { *m = GetDLQMessagesResponse{} }
 func (*GetDLQMessagesResponse) ProtoMessage() {}
 func (*GetDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{42}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{44}
 }
 func (m *GetDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2498,6 +2596,13 @@ func (m *GetDLQMessagesResponse) GetReplicationTasksInfo() []*v15.ReplicationTas
 	return nil
 }
 
+func (m *GetDLQMessagesResponse) GetHistoryTasks() []*v11.HistoryTaskDLQMessage {
+	if m != nil {
+		return m.HistoryTasks
+	}
+	return nil
+}
+
 type PurgeDLQMessagesRequest struct {
 	Type                  v13.DeadLetterQueueType `protobuf:"varint,1,opt,name=type,proto3,enum=temporal.server.api.enums.v1.DeadLetterQueueType" json:"type,omitempty"`
 	ShardId               int32                   `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
@@ -2508,7 +2613,7 @@ type PurgeDLQMessagesRequest struct {
 func (m *PurgeDLQMessagesRequest) Reset()      { *m = PurgeDLQMessagesRequest{} }
 func (*PurgeDLQMessagesRequest) ProtoMessage() {}
 func (*PurgeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{43}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{45}
 }
 func (m *PurgeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2571,7 +2676,7 @@ type PurgeDLQMessagesResponse struct {
 func (m *PurgeDLQMessagesResponse) Reset()      { *m = PurgeDLQMessagesResponse{} }
 func (*PurgeDLQMessagesResponse) ProtoMessage() {}
 func (*PurgeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{44}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{46}
 }
 func (m *PurgeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2612,7 +2717,7 @@ type MergeDLQMessagesRequest struct {
 func (m *MergeDLQMessagesRequest) Reset()      { *m = MergeDLQMessagesRequest{} }
 func (*MergeDLQMessagesRequest) ProtoMessage() {}
 func (*MergeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{45}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{47}
 }
 func (m *MergeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2690,7 +2795,7 @@ type MergeDLQMessagesResponse struct {
 func (m *MergeDLQMessagesResponse) Reset()      { *m = MergeDLQMessagesResponse{} }
 func (*MergeDLQMessagesResponse) ProtoMessage() {}
 func (*MergeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{46}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{48}
 }
 func (m *MergeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2734,7 +2839,7 @@ type RefreshWorkflowTasksRequest struct {
 func (m *RefreshWorkflowTasksRequest) Reset()      { *m = RefreshWorkflowTasksRequest{} }
 func (*RefreshWorkflowTasksRequest) ProtoMessage() {}
 func (*RefreshWorkflowTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{47}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{49}
 }
 func (m *RefreshWorkflowTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2783,7 +2888,7 @@ type RefreshWorkflowTasksResponse struct {
 func (m *RefreshWorkflowTasksResponse) Reset()      { *m = RefreshWorkflowTasksResponse{} }
 func (*RefreshWorkflowTasksResponse) ProtoMessage() {}
 func (*RefreshWorkflowTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{48}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{50}
 }
 func (m *RefreshWorkflowTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2826,7 +2931,7 @@ type ResendReplicationTasksRequest struct {
 func (m *ResendReplicationTasksRequest) Reset()      { *m = ResendReplicationTasksRequest{} }
 func (*ResendReplicationTasksRequest) ProtoMessage() {}
 func (*ResendReplicationTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{49}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{51}
 }
 func (m *ResendReplicationTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2917,7 +3022,7 @@ type ResendReplicationTasksResponse struct {
 func (m *ResendReplicationTasksResponse) Reset()      { *m = ResendReplicationTasksResponse{} }
 func (*ResendReplicationTasksResponse) ProtoMessage() {}
 func (*ResendReplicationTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{50}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{52}
 }
 func (m *ResendReplicationTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2959,7 +3064,7 @@ type GetTaskQueueTasksRequest struct {
 func (m *GetTaskQueueTasksRequest) Reset()      { *m = GetTaskQueueTasksRequest{} }
 func (*GetTaskQueueTasksRequest) ProtoMessage() {}
 func (*GetTaskQueueTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{51}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{53}
 }
 func (m *GetTaskQueueTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3045,7 +3150,7 @@ type GetTaskQueueTasksResponse struct {
 func (m *GetTaskQueueTasksResponse) Reset()      { *m = GetTaskQueueTasksResponse{} }
 func (*GetTaskQueueTasksResponse) ProtoMessage() {}
 func (*GetTaskQueueTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{52}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{54}
 }
 func (m *GetTaskQueueTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3088,22 +3193,26 @@ func (m *GetTaskQueueTasksResponse) GetNextPageToken() []byte {
 	return nil
 }
 
-type DeleteWorkflowExecutionRequest struct {
-	Namespace string                `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	Execution *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+type ListWorkerBuildIdCompatibilityRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Maximum number of task queues to return in a page. Defaults to 100 if not set.
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken []byte `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Limits how many major version sets are returned for each task queue, as in GetWorkerBuildIdCompatibility.
+	MaxSets int32 `protobuf:"varint,4,opt,name=max_sets,json=maxSets,proto3" json:"max_sets,omitempty"`
 }
 
-func (m *DeleteWorkflowExecutionRequest) Reset()      { *m = DeleteWorkflowExecutionRequest{} }
-func (*DeleteWorkflowExecutionRequest) ProtoMessage() {}
-func (*DeleteWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{53}
+func (m *ListWorkerBuildIdCompatibilityRequest) Reset()      { *m = ListWorkerBuildIdCompatibilityRequest{} }
+func (*ListWorkerBuildIdCompatibilityRequest) ProtoMessage() {}
+func (*ListWorkerBuildIdCompatibilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{55}
 }
-func (m *DeleteWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+func (m *ListWorkerBuildIdCompatibilityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListWorkerBuildIdCompatibilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteWorkflowExecutionRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListWorkerBuildIdCompatibilityRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3113,47 +3222,66 @@ func (m *DeleteWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *DeleteWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteWorkflowExecutionRequest.Merge(m, src)
+func (m *ListWorkerBuildIdCompatibilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWorkerBuildIdCompatibilityRequest.Merge(m, src)
 }
-func (m *DeleteWorkflowExecutionRequest) XXX_Size() int {
+func (m *ListWorkerBuildIdCompatibilityRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeleteWorkflowExecutionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteWorkflowExecutionRequest.DiscardUnknown(m)
+func (m *ListWorkerBuildIdCompatibilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWorkerBuildIdCompatibilityRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteWorkflowExecutionRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListWorkerBuildIdCompatibilityRequest proto.InternalMessageInfo
 
-func (m *DeleteWorkflowExecutionRequest) GetNamespace() string {
+func (m *ListWorkerBuildIdCompatibilityRequest) GetNamespace() string {
 	if m != nil {
 		return m.Namespace
 	}
 	return ""
 }
 
-func (m *DeleteWorkflowExecutionRequest) GetExecution() *v1.WorkflowExecution {
+func (m *ListWorkerBuildIdCompatibilityRequest) GetPageSize() int32 {
 	if m != nil {
-		return m.Execution
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListWorkerBuildIdCompatibilityRequest) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
 	}
 	return nil
 }
 
-type DeleteWorkflowExecutionResponse struct {
-	Warnings []string `protobuf:"bytes,1,rep,name=warnings,proto3" json:"warnings,omitempty"`
+func (m *ListWorkerBuildIdCompatibilityRequest) GetMaxSets() int32 {
+	if m != nil {
+		return m.MaxSets
+	}
+	return 0
 }
 
-func (m *DeleteWorkflowExecutionResponse) Reset()      { *m = DeleteWorkflowExecutionResponse{} }
-func (*DeleteWorkflowExecutionResponse) ProtoMessage() {}
-func (*DeleteWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{54}
+type ListWorkerBuildIdCompatibilityResponse struct {
+	// Task queues of the page which have versioning data. A page may contain fewer entries than the page size, or
+	// none at all, while there are more pages to read.
+	TaskQueues    []*ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility `protobuf:"bytes,1,rep,name=task_queues,json=taskQueues,proto3" json:"task_queues,omitempty"`
+	NextPageToken []byte                                                                  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
-func (m *DeleteWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *ListWorkerBuildIdCompatibilityResponse) Reset() {
+	*m = ListWorkerBuildIdCompatibilityResponse{}
+}
+func (*ListWorkerBuildIdCompatibilityResponse) ProtoMessage() {}
+func (*ListWorkerBuildIdCompatibilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{56}
+}
+func (m *ListWorkerBuildIdCompatibilityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListWorkerBuildIdCompatibilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteWorkflowExecutionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListWorkerBuildIdCompatibilityResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3163,44 +3291,51 @@ func (m *DeleteWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *DeleteWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteWorkflowExecutionResponse.Merge(m, src)
+func (m *ListWorkerBuildIdCompatibilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWorkerBuildIdCompatibilityResponse.Merge(m, src)
 }
-func (m *DeleteWorkflowExecutionResponse) XXX_Size() int {
+func (m *ListWorkerBuildIdCompatibilityResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeleteWorkflowExecutionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteWorkflowExecutionResponse.DiscardUnknown(m)
+func (m *ListWorkerBuildIdCompatibilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWorkerBuildIdCompatibilityResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteWorkflowExecutionResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListWorkerBuildIdCompatibilityResponse proto.InternalMessageInfo
 
-func (m *DeleteWorkflowExecutionResponse) GetWarnings() []string {
+func (m *ListWorkerBuildIdCompatibilityResponse) GetTaskQueues() []*ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility {
 	if m != nil {
-		return m.Warnings
+		return m.TaskQueues
 	}
 	return nil
 }
 
-type StreamWorkflowReplicationMessagesRequest struct {
-	// Types that are valid to be assigned to Attributes:
-	//	*StreamWorkflowReplicationMessagesRequest_SyncReplicationState
-	Attributes isStreamWorkflowReplicationMessagesRequest_Attributes `protobuf_oneof:"attributes"`
+func (m *ListWorkerBuildIdCompatibilityResponse) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
 }
 
-func (m *StreamWorkflowReplicationMessagesRequest) Reset() {
-	*m = StreamWorkflowReplicationMessagesRequest{}
+type ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility struct {
+	TaskQueue string `protobuf:"bytes,1,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	// Same as in GetWorkerBuildIdCompatibility, ordered from oldest to newest with the default set last.
+	MajorVersionSets []*v110.CompatibleVersionSet `protobuf:"bytes,2,rep,name=major_version_sets,json=majorVersionSets,proto3" json:"major_version_sets,omitempty"`
 }
-func (*StreamWorkflowReplicationMessagesRequest) ProtoMessage() {}
-func (*StreamWorkflowReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{55}
+
+func (m *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) Reset() {
+	*m = ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility{}
 }
-func (m *StreamWorkflowReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
+func (*ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) ProtoMessage() {}
+func (*ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{56, 0}
+}
+func (m *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StreamWorkflowReplicationMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StreamWorkflowReplicationMessagesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3210,57 +3345,205 @@ func (m *StreamWorkflowReplicationMessagesRequest) XXX_Marshal(b []byte, determi
 		return b[:n], nil
 	}
 }
-func (m *StreamWorkflowReplicationMessagesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StreamWorkflowReplicationMessagesRequest.Merge(m, src)
+func (m *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility.Merge(m, src)
 }
-func (m *StreamWorkflowReplicationMessagesRequest) XXX_Size() int {
+func (m *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) XXX_Size() int {
 	return m.Size()
 }
-func (m *StreamWorkflowReplicationMessagesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StreamWorkflowReplicationMessagesRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_StreamWorkflowReplicationMessagesRequest proto.InternalMessageInfo
-
-type isStreamWorkflowReplicationMessagesRequest_Attributes interface {
-	isStreamWorkflowReplicationMessagesRequest_Attributes()
-	Equal(interface{}) bool
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type StreamWorkflowReplicationMessagesRequest_SyncReplicationState struct {
-	SyncReplicationState *v15.SyncReplicationState `protobuf:"bytes,1,opt,name=sync_replication_state,json=syncReplicationState,proto3,oneof" json:"sync_replication_state,omitempty"`
+func (m *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility.DiscardUnknown(m)
 }
 
-func (*StreamWorkflowReplicationMessagesRequest_SyncReplicationState) isStreamWorkflowReplicationMessagesRequest_Attributes() {
-}
+var xxx_messageInfo_ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility proto.InternalMessageInfo
 
-func (m *StreamWorkflowReplicationMessagesRequest) GetAttributes() isStreamWorkflowReplicationMessagesRequest_Attributes {
+func (m *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) GetTaskQueue() string {
 	if m != nil {
-		return m.Attributes
+		return m.TaskQueue
 	}
-	return nil
+	return ""
 }
 
-func (m *StreamWorkflowReplicationMessagesRequest) GetSyncReplicationState() *v15.SyncReplicationState {
-	if x, ok := m.GetAttributes().(*StreamWorkflowReplicationMessagesRequest_SyncReplicationState); ok {
-		return x.SyncReplicationState
+func (m *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) GetMajorVersionSets() []*v110.CompatibleVersionSet {
+	if m != nil {
+		return m.MajorVersionSets
 	}
 	return nil
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*StreamWorkflowReplicationMessagesRequest) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*StreamWorkflowReplicationMessagesRequest_SyncReplicationState)(nil),
-	}
+type DeleteWorkflowExecutionRequest struct {
+	Namespace string                `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Execution *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
 }
 
-type StreamWorkflowReplicationMessagesResponse struct {
-	// Types that are valid to be assigned to Attributes:
-	//	*StreamWorkflowReplicationMessagesResponse_Messages
-	Attributes isStreamWorkflowReplicationMessagesResponse_Attributes `protobuf_oneof:"attributes"`
+func (m *DeleteWorkflowExecutionRequest) Reset()      { *m = DeleteWorkflowExecutionRequest{} }
+func (*DeleteWorkflowExecutionRequest) ProtoMessage() {}
+func (*DeleteWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{57}
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *DeleteWorkflowExecutionRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *DeleteWorkflowExecutionRequest) GetExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.Execution
+	}
+	return nil
+}
+
+type DeleteWorkflowExecutionResponse struct {
+	Warnings []string `protobuf:"bytes,1,rep,name=warnings,proto3" json:"warnings,omitempty"`
+}
+
+func (m *DeleteWorkflowExecutionResponse) Reset()      { *m = DeleteWorkflowExecutionResponse{} }
+func (*DeleteWorkflowExecutionResponse) ProtoMessage() {}
+func (*DeleteWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{58}
+}
+func (m *DeleteWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *DeleteWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteWorkflowExecutionResponse proto.InternalMessageInfo
+
+func (m *DeleteWorkflowExecutionResponse) GetWarnings() []string {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
+type StreamWorkflowReplicationMessagesRequest struct {
+	// Types that are valid to be assigned to Attributes:
+	//	*StreamWorkflowReplicationMessagesRequest_SyncReplicationState
+	Attributes isStreamWorkflowReplicationMessagesRequest_Attributes `protobuf_oneof:"attributes"`
+}
+
+func (m *StreamWorkflowReplicationMessagesRequest) Reset() {
+	*m = StreamWorkflowReplicationMessagesRequest{}
+}
+func (*StreamWorkflowReplicationMessagesRequest) ProtoMessage() {}
+func (*StreamWorkflowReplicationMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc07c1a2abe7cb51, []int{59}
+}
+func (m *StreamWorkflowReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamWorkflowReplicationMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamWorkflowReplicationMessagesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamWorkflowReplicationMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamWorkflowReplicationMessagesRequest.Merge(m, src)
+}
+func (m *StreamWorkflowReplicationMessagesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamWorkflowReplicationMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamWorkflowReplicationMessagesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamWorkflowReplicationMessagesRequest proto.InternalMessageInfo
+
+type isStreamWorkflowReplicationMessagesRequest_Attributes interface {
+	isStreamWorkflowReplicationMessagesRequest_Attributes()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type StreamWorkflowReplicationMessagesRequest_SyncReplicationState struct {
+	SyncReplicationState *v15.SyncReplicationState `protobuf:"bytes,1,opt,name=sync_replication_state,json=syncReplicationState,proto3,oneof" json:"sync_replication_state,omitempty"`
+}
+
+func (*StreamWorkflowReplicationMessagesRequest_SyncReplicationState) isStreamWorkflowReplicationMessagesRequest_Attributes() {
+}
+
+func (m *StreamWorkflowReplicationMessagesRequest) GetAttributes() isStreamWorkflowReplicationMessagesRequest_Attributes {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *StreamWorkflowReplicationMessagesRequest) GetSyncReplicationState() *v15.SyncReplicationState {
+	if x, ok := m.GetAttributes().(*StreamWorkflowReplicationMessagesRequest_SyncReplicationState); ok {
+		return x.SyncReplicationState
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*StreamWorkflowReplicationMessagesRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*StreamWorkflowReplicationMessagesRequest_SyncReplicationState)(nil),
+	}
+}
+
+type StreamWorkflowReplicationMessagesResponse struct {
+	// Types that are valid to be assigned to Attributes:
+	//	*StreamWorkflowReplicationMessagesResponse_Messages
+	Attributes isStreamWorkflowReplicationMessagesResponse_Attributes `protobuf_oneof:"attributes"`
 }
 
 func (m *StreamWorkflowReplicationMessagesResponse) Reset() {
@@ -3268,7 +3551,7 @@ func (m *StreamWorkflowReplicationMessagesResponse) Reset() {
 }
 func (*StreamWorkflowReplicationMessagesResponse) ProtoMessage() {}
 func (*StreamWorkflowReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc07c1a2abe7cb51, []int{56}
+	return fileDescriptor_cc07c1a2abe7cb51, []int{60}
 }
 func (m *StreamWorkflowReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3348,6 +3631,8 @@ func init() {
 	proto.RegisterType((*Task)(nil), "temporal.server.api.adminservice.v1.Task")
 	proto.RegisterType((*RemoveTaskRequest)(nil), "temporal.server.api.adminservice.v1.RemoveTaskRequest")
 	proto.RegisterType((*RemoveTaskResponse)(nil), "temporal.server.api.adminservice.v1.RemoveTaskResponse")
+	proto.RegisterType((*DescribeHistoryQueueRequest)(nil), "temporal.server.api.adminservice.v1.DescribeHistoryQueueRequest")
+	proto.RegisterType((*DescribeHistoryQueueResponse)(nil), "temporal.server.api.adminservice.v1.DescribeHistoryQueueResponse")
 	proto.RegisterType((*GetWorkflowExecutionRawHistoryV2Request)(nil), "temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Request")
 	proto.RegisterType((*GetWorkflowExecutionRawHistoryV2Response)(nil), "temporal.server.api.adminservice.v1.GetWorkflowExecutionRawHistoryV2Response")
 	proto.RegisterType((*GetReplicationMessagesRequest)(nil), "temporal.server.api.adminservice.v1.GetReplicationMessagesRequest")
@@ -3392,6 +3677,9 @@ func init() {
 	proto.RegisterType((*ResendReplicationTasksResponse)(nil), "temporal.server.api.adminservice.v1.ResendReplicationTasksResponse")
 	proto.RegisterType((*GetTaskQueueTasksRequest)(nil), "temporal.server.api.adminservice.v1.GetTaskQueueTasksRequest")
 	proto.RegisterType((*GetTaskQueueTasksResponse)(nil), "temporal.server.api.adminservice.v1.GetTaskQueueTasksResponse")
+	proto.RegisterType((*ListWorkerBuildIdCompatibilityRequest)(nil), "temporal.server.api.adminservice.v1.ListWorkerBuildIdCompatibilityRequest")
+	proto.RegisterType((*ListWorkerBuildIdCompatibilityResponse)(nil), "temporal.server.api.adminservice.v1.ListWorkerBuildIdCompatibilityResponse")
+	proto.RegisterType((*ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility)(nil), "temporal.server.api.adminservice.v1.ListWorkerBuildIdCompatibilityResponse.TaskQueueBuildIdCompatibility")
 	proto.RegisterType((*DeleteWorkflowExecutionRequest)(nil), "temporal.server.api.adminservice.v1.DeleteWorkflowExecutionRequest")
 	proto.RegisterType((*DeleteWorkflowExecutionResponse)(nil), "temporal.server.api.adminservice.v1.DeleteWorkflowExecutionResponse")
 	proto.RegisterType((*StreamWorkflowReplicationMessagesRequest)(nil), "temporal.server.api.adminservice.v1.StreamWorkflowReplicationMessagesRequest")
@@ -3403,201 +3691,214 @@ func init() {
 }
 
 var fileDescriptor_cc07c1a2abe7cb51 = []byte{
-	// 3095 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3a, 0x4d, 0x6c, 0x1b, 0xd7,
-	0xd1, 0x5a, 0xfe, 0x48, 0xe4, 0xe8, 0x7f, 0x2d, 0x59, 0x34, 0x15, 0x51, 0x0a, 0xe3, 0x38, 0xb2,
-	0xbf, 0x84, 0xfa, 0xac, 0xb4, 0x8d, 0x93, 0xd4, 0x30, 0x24, 0xd9, 0x91, 0x95, 0x4a, 0xf9, 0x59,
-	0x39, 0x76, 0x1b, 0x20, 0xd8, 0x2c, 0x77, 0x9f, 0xa8, 0x85, 0xb9, 0x3f, 0xd9, 0xf7, 0x48, 0x5b,
-	0x01, 0xfa, 0x83, 0xa6, 0x45, 0xd1, 0x43, 0x51, 0x03, 0x45, 0x81, 0x20, 0xa7, 0x1e, 0xdb, 0xa2,
-	0x45, 0x6f, 0xbd, 0xf7, 0xd6, 0x63, 0xd0, 0x5e, 0x82, 0x16, 0x68, 0x1a, 0xe5, 0xd2, 0x63, 0xce,
-	0x3d, 0x15, 0xef, 0x6f, 0xff, 0xb8, 0xa4, 0xa8, 0xda, 0x4e, 0x81, 0xdc, 0xb8, 0xf3, 0x66, 0xe6,
-	0xcd, 0x9b, 0xbf, 0x37, 0x33, 0x8f, 0xf0, 0x12, 0x41, 0x8e, 0xef, 0x05, 0x46, 0x7b, 0x0d, 0xa3,
-	0xa0, 0x8b, 0x82, 0x35, 0xc3, 0xb7, 0xd7, 0x0c, 0xcb, 0xb1, 0x5d, 0xfa, 0x6d, 0x9b, 0x68, 0xad,
-	0x7b, 0x79, 0x2d, 0x40, 0xef, 0x75, 0x10, 0x26, 0x7a, 0x80, 0xb0, 0xef, 0xb9, 0x18, 0x35, 0xfc,
-	0xc0, 0x23, 0x9e, 0xfa, 0x94, 0xa4, 0x6d, 0x70, 0xda, 0x86, 0xe1, 0xdb, 0x8d, 0x38, 0x6d, 0xa3,
-	0x7b, 0xb9, 0xba, 0xdc, 0xf2, 0xbc, 0x56, 0x1b, 0xad, 0x31, 0x92, 0x66, 0xe7, 0x60, 0x8d, 0xd8,
-	0x0e, 0xc2, 0xc4, 0x70, 0x7c, 0xce, 0xa5, 0x5a, 0x4b, 0x23, 0x58, 0x9d, 0xc0, 0x20, 0xb6, 0xe7,
-	0x8a, 0xf5, 0x27, 0x2d, 0xe4, 0x23, 0xd7, 0x42, 0xae, 0x69, 0x23, 0xbc, 0xd6, 0xf2, 0x5a, 0x1e,
-	0x83, 0xb3, 0x5f, 0x02, 0xa5, 0x1e, 0x1e, 0x82, 0x4a, 0x8f, 0xdc, 0x8e, 0x83, 0xa9, 0xd8, 0xa6,
-	0xe7, 0x38, 0x21, 0x9b, 0x0b, 0xd9, 0x38, 0xc4, 0xc0, 0x77, 0xf5, 0xf7, 0x3a, 0xa8, 0x23, 0x0e,
-	0x55, 0x3d, 0x9f, 0xc0, 0xe3, 0x2c, 0x28, 0xa2, 0x83, 0x30, 0x36, 0x5a, 0x12, 0xeb, 0xe9, 0x04,
-	0x56, 0x17, 0x05, 0xd8, 0xce, 0x42, 0x4b, 0x6e, 0x7a, 0xcf, 0x0b, 0xee, 0x1e, 0xb4, 0xbd, 0x7b,
-	0xbd, 0x78, 0xcf, 0x66, 0x59, 0xc1, 0x6c, 0x77, 0x30, 0x41, 0x41, 0x2f, 0xf6, 0xc5, 0x2c, 0xec,
-	0xec, 0x53, 0x5f, 0x1a, 0x8c, 0xca, 0x77, 0x10, 0xb8, 0xcf, 0x0c, 0xc4, 0xa5, 0x8a, 0x1a, 0x24,
-	0xed, 0xa1, 0x8d, 0x89, 0x17, 0x1c, 0xf5, 0x4a, 0xdb, 0xc8, 0xc2, 0x76, 0x0d, 0x07, 0x61, 0xdf,
-	0xe0, 0xee, 0x95, 0xc4, 0xff, 0xff, 0x2c, 0xfc, 0x00, 0xf9, 0x6d, 0xdb, 0x64, 0x6e, 0xd1, 0x4b,
-	0xf1, 0x62, 0x16, 0x85, 0x4f, 0x6d, 0x82, 0x09, 0x72, 0xf9, 0x1e, 0xe2, 0xa8, 0xba, 0x83, 0x88,
-	0x61, 0x19, 0xc4, 0x10, 0xa4, 0xcf, 0x0f, 0x41, 0x8a, 0xee, 0x23, 0xb3, 0x43, 0x77, 0xc6, 0x82,
-	0xe8, 0xda, 0x10, 0x44, 0xd2, 0xd6, 0xba, 0xd3, 0x21, 0x46, 0xb3, 0x8d, 0x74, 0x4c, 0x0c, 0x32,
-	0x50, 0x25, 0x29, 0x06, 0x54, 0xdf, 0x62, 0xc3, 0xfa, 0x07, 0x0a, 0x54, 0x35, 0xd4, 0xec, 0xd8,
-	0x6d, 0x6b, 0x8f, 0xb3, 0xdb, 0xa7, 0xdc, 0x34, 0x1e, 0x96, 0xea, 0x13, 0x50, 0x0e, 0xf5, 0x59,
-	0x51, 0x56, 0x94, 0xd5, 0xb2, 0x16, 0x01, 0xd4, 0x6d, 0x28, 0x87, 0x27, 0xa8, 0xe4, 0x56, 0x94,
-	0xd5, 0xf1, 0xf5, 0x8b, 0xa1, 0x00, 0x2c, 0x64, 0x85, 0xc7, 0x74, 0x2f, 0x37, 0xee, 0x08, 0xa9,
-	0x6f, 0x48, 0x02, 0x2d, 0xa2, 0xad, 0x2f, 0xc1, 0x62, 0xa6, 0x10, 0x3c, 0x27, 0xd4, 0x7f, 0xa4,
-	0xc0, 0xe2, 0x75, 0x84, 0xcd, 0xc0, 0x6e, 0xa2, 0xff, 0xa1, 0x94, 0x7f, 0xcc, 0xc1, 0x13, 0xd9,
-	0x62, 0x70, 0x39, 0xd5, 0x73, 0x50, 0xc2, 0x87, 0x46, 0x60, 0xe9, 0xb6, 0x25, 0xc4, 0x18, 0x63,
-	0xdf, 0x3b, 0x96, 0xfa, 0x24, 0x4c, 0x08, 0x37, 0xd6, 0x0d, 0xcb, 0x0a, 0x98, 0x1c, 0x65, 0x6d,
-	0x5c, 0xc0, 0x36, 0x2c, 0x2b, 0x50, 0x0f, 0xe1, 0x8c, 0x69, 0x98, 0x87, 0x28, 0x69, 0xd7, 0x4a,
-	0x9e, 0x49, 0x7c, 0xa5, 0x91, 0x95, 0x11, 0x63, 0x86, 0x8d, 0x4b, 0x9f, 0x10, 0x6e, 0x96, 0x31,
-	0x8d, 0x83, 0x54, 0x17, 0xce, 0x52, 0x47, 0x6d, 0x1a, 0x38, 0xbd, 0x59, 0xe1, 0x21, 0x37, 0x9b,
-	0x93, 0x7c, 0xe3, 0xd0, 0xfa, 0x5f, 0x14, 0xa8, 0x4a, 0xc5, 0xdd, 0xe4, 0x27, 0xbe, 0xe9, 0x61,
-	0x22, 0xcd, 0x47, 0x75, 0xe3, 0x61, 0xc2, 0x14, 0x83, 0x30, 0x16, 0xaa, 0x1b, 0xa7, 0xb0, 0x0d,
-	0x0e, 0x4a, 0x68, 0x96, 0xaa, 0xae, 0x18, 0x69, 0x36, 0x61, 0xfc, 0x7c, 0xda, 0xf8, 0xdf, 0x06,
-	0x35, 0x8c, 0x97, 0xc8, 0x0b, 0x0a, 0xa7, 0xf5, 0x82, 0xd9, 0x7b, 0x69, 0x50, 0xfd, 0x1f, 0x31,
-	0xa7, 0x4c, 0x1c, 0x4a, 0x38, 0xc3, 0x53, 0x30, 0xc9, 0x44, 0xc4, 0xba, 0xdb, 0x71, 0x9a, 0x28,
-	0x60, 0xc7, 0x2a, 0x6a, 0x13, 0x1c, 0xf8, 0x1a, 0x83, 0xa9, 0x8b, 0x50, 0x96, 0xe7, 0xc2, 0x95,
-	0xdc, 0x4a, 0x7e, 0xb5, 0xa8, 0x95, 0xc4, 0xc1, 0xb0, 0xfa, 0x0e, 0x4c, 0x87, 0x07, 0xd1, 0x99,
-	0x15, 0x85, 0x33, 0x7c, 0x2d, 0xd3, 0x3e, 0x21, 0x2e, 0x3d, 0xc2, 0x6b, 0xf2, 0x63, 0x8b, 0xd2,
-	0xed, 0xb8, 0x07, 0x9e, 0x36, 0xe5, 0x26, 0x60, 0x6a, 0x05, 0xc6, 0xa4, 0xc6, 0x8b, 0xdc, 0x59,
-	0xc5, 0xe7, 0xab, 0x85, 0x52, 0x61, 0xa6, 0x58, 0x6f, 0xc0, 0xec, 0x56, 0xdb, 0xc3, 0x68, 0x9f,
-	0xca, 0x23, 0x6d, 0x95, 0x76, 0xf1, 0xc8, 0x10, 0xf5, 0x39, 0x50, 0xe3, 0xf8, 0x22, 0x76, 0x9f,
-	0x85, 0xe9, 0x6d, 0x44, 0x86, 0xe5, 0xf1, 0x2e, 0xcc, 0x44, 0xd8, 0x42, 0x91, 0xbb, 0x00, 0x02,
-	0xdd, 0x3d, 0xf0, 0x18, 0xc1, 0xf8, 0xfa, 0x73, 0xc3, 0x78, 0x28, 0x63, 0xc3, 0x8e, 0xce, 0x95,
-	0x4c, 0x7f, 0xd6, 0x7f, 0x96, 0x83, 0x85, 0x5d, 0x1b, 0x13, 0x61, 0xb2, 0x5b, 0x34, 0x17, 0x9e,
-	0x2c, 0x98, 0xfa, 0x0a, 0x94, 0x4c, 0x83, 0xa0, 0x96, 0x17, 0x1c, 0x31, 0x07, 0x9c, 0x5a, 0xbf,
-	0x94, 0x29, 0x02, 0xbb, 0xd4, 0xe8, 0xe6, 0x94, 0xf1, 0x96, 0xa0, 0xd0, 0x42, 0x5a, 0xf5, 0x26,
-	0x00, 0xab, 0x0b, 0x02, 0xc3, 0x6d, 0x49, 0x73, 0x5e, 0xcc, 0xe4, 0x24, 0x52, 0x83, 0xe4, 0xa5,
-	0x51, 0x02, 0xad, 0x4c, 0xe4, 0x4f, 0x75, 0x09, 0xa0, 0x69, 0x10, 0xf3, 0x50, 0xc7, 0xf6, 0xfb,
-	0x3c, 0x70, 0x8b, 0x5a, 0x99, 0x41, 0xf6, 0xed, 0xf7, 0x91, 0x7a, 0x01, 0xa6, 0x5d, 0x74, 0x9f,
-	0xe8, 0xbe, 0xd1, 0x42, 0x3a, 0xf1, 0xee, 0x22, 0x97, 0x59, 0x79, 0x42, 0x9b, 0xa4, 0xe0, 0x37,
-	0x8c, 0x16, 0xba, 0x45, 0x81, 0xf4, 0x02, 0xa8, 0xf4, 0xea, 0x43, 0xa8, 0xfe, 0x1a, 0x14, 0xd9,
-	0x65, 0x51, 0x51, 0x56, 0xf2, 0x7d, 0x05, 0x4d, 0x95, 0x65, 0x5c, 0x5a, 0x4e, 0x97, 0x25, 0x45,
-	0x2e, 0x4b, 0x8a, 0x0f, 0x73, 0x50, 0xa0, 0x74, 0x34, 0x17, 0x44, 0x3e, 0x1f, 0xa6, 0xd1, 0xf1,
-	0x10, 0xb6, 0x63, 0xa9, 0xcb, 0x30, 0x1e, 0x86, 0xb4, 0x48, 0x07, 0x65, 0x0d, 0x24, 0x68, 0xc7,
-	0x52, 0xe7, 0x61, 0x34, 0xe8, 0xb8, 0x74, 0x8d, 0xa7, 0x83, 0x62, 0xd0, 0x71, 0x77, 0x2c, 0x75,
-	0x01, 0xc6, 0x98, 0xea, 0x6d, 0x8b, 0x69, 0x2b, 0xaf, 0x8d, 0xd2, 0xcf, 0x1d, 0x4b, 0xdd, 0x02,
-	0xa6, 0x56, 0x9d, 0x1c, 0xf9, 0x88, 0x29, 0x69, 0x6a, 0xfd, 0xc2, 0xc9, 0xc6, 0xbd, 0x75, 0xe4,
-	0x23, 0xad, 0x44, 0xc4, 0x2f, 0xf5, 0x2a, 0x94, 0x0f, 0xec, 0x00, 0xe9, 0xb4, 0x06, 0xad, 0x8c,
-	0x32, 0xbb, 0x56, 0x1b, 0xbc, 0xfe, 0x6c, 0xc8, 0xfa, 0xb3, 0x71, 0x4b, 0x16, 0xa8, 0x9b, 0x85,
-	0x07, 0x9f, 0x2e, 0x2b, 0x5a, 0x89, 0x92, 0x50, 0x20, 0x0d, 0x46, 0x51, 0xea, 0x55, 0xc6, 0x98,
-	0x70, 0xf2, 0xb3, 0xfe, 0x37, 0x05, 0x66, 0x35, 0xe4, 0x78, 0x5d, 0xc4, 0x14, 0xfb, 0xe5, 0xb9,
-	0x6a, 0x4c, 0x5f, 0xf9, 0x84, 0xbe, 0x76, 0x60, 0xba, 0x6b, 0x63, 0xbb, 0x69, 0xb7, 0x6d, 0x72,
-	0xc4, 0x0f, 0x5c, 0x18, 0xf2, 0xc0, 0x53, 0x11, 0x21, 0x5d, 0xa2, 0x39, 0x23, 0x7e, 0x36, 0x91,
-	0x33, 0x7e, 0x91, 0x87, 0x67, 0xb6, 0x11, 0xe9, 0x4d, 0xc3, 0xc6, 0x3d, 0xe1, 0xa6, 0xb7, 0xd7,
-	0x63, 0x97, 0x47, 0xc2, 0x61, 0xca, 0xbd, 0x0e, 0xf3, 0xa8, 0x0a, 0x00, 0xf5, 0x3c, 0x4c, 0x61,
-	0x62, 0x04, 0x44, 0x47, 0x5d, 0xe4, 0x92, 0x48, 0x31, 0x13, 0x0c, 0x7a, 0x83, 0x02, 0x77, 0x2c,
-	0xb5, 0x01, 0x67, 0xe2, 0x58, 0xd2, 0xac, 0xdc, 0xe7, 0x66, 0x23, 0xd4, 0xdb, 0x7c, 0x41, 0x5d,
-	0x81, 0x09, 0xe4, 0x5a, 0x11, 0xcf, 0x22, 0x43, 0x04, 0xe4, 0x5a, 0x92, 0xe3, 0x25, 0x98, 0x8d,
-	0x30, 0x24, 0xbf, 0x51, 0x86, 0x36, 0x2d, 0xd1, 0x24, 0xb7, 0x4b, 0x30, 0xeb, 0x18, 0xf7, 0x6d,
-	0xa7, 0xe3, 0xf0, 0xa0, 0x63, 0xd9, 0x61, 0x8c, 0x79, 0xc8, 0xb4, 0x58, 0xa0, 0x61, 0xd7, 0x2f,
-	0x47, 0x94, 0x32, 0xa2, 0xf3, 0xd5, 0x42, 0x49, 0x99, 0xc9, 0xd5, 0x7f, 0x95, 0x83, 0xd5, 0x93,
-	0xad, 0x22, 0x32, 0x47, 0x06, 0x6b, 0x25, 0x83, 0x35, 0xf5, 0x25, 0x59, 0x17, 0xb1, 0xdc, 0x85,
-	0xf8, 0x35, 0x38, 0xbe, 0xbe, 0xd2, 0xcf, 0x42, 0xd7, 0x0d, 0x62, 0x6c, 0xb6, 0xbd, 0xa6, 0x36,
-	0x25, 0x08, 0x37, 0x39, 0x9d, 0x7a, 0x07, 0xa6, 0x85, 0x6e, 0x74, 0xb1, 0x22, 0xf2, 0x6b, 0xe3,
-	0xa4, 0xfc, 0x2a, 0x74, 0x27, 0x4e, 0xa1, 0x4d, 0x75, 0x13, 0xdf, 0xea, 0x2a, 0xcc, 0x48, 0x19,
-	0x5d, 0xcf, 0x42, 0xec, 0xae, 0x2e, 0xac, 0xe4, 0x57, 0xf3, 0xa1, 0x08, 0xaf, 0x79, 0x16, 0xda,
-	0xb1, 0x70, 0xfd, 0x81, 0x02, 0x4b, 0xdb, 0x88, 0x68, 0x51, 0x4b, 0xb1, 0xc7, 0xdb, 0x89, 0xf0,
-	0x8a, 0xd9, 0x85, 0x51, 0xa6, 0x0d, 0x99, 0x52, 0xb3, 0xaf, 0xf2, 0x58, 0x4f, 0x42, 0xe5, 0x8b,
-	0xf1, 0x63, 0x5a, 0xd3, 0x04, 0x0f, 0xea, 0xfc, 0xb2, 0xfb, 0xa0, 0x0e, 0x2f, 0xab, 0x4a, 0x01,
-	0xa3, 0x35, 0x40, 0xfd, 0xa3, 0x1c, 0xd4, 0xfa, 0x89, 0x24, 0x6c, 0xf5, 0x5d, 0x98, 0xe2, 0xb9,
-	0x44, 0xf4, 0x3e, 0x52, 0xb6, 0xdb, 0x43, 0xa5, 0xfb, 0xc1, 0xcc, 0xf9, 0x25, 0x2c, 0xa1, 0x37,
-	0x5c, 0x12, 0x1c, 0x69, 0xbc, 0x2e, 0x92, 0xb0, 0xea, 0x11, 0xa8, 0xbd, 0x48, 0xea, 0x0c, 0xe4,
-	0xef, 0xa2, 0x23, 0x91, 0xdb, 0xe8, 0x4f, 0x75, 0x0f, 0x8a, 0x5d, 0xa3, 0xdd, 0x41, 0x22, 0x84,
-	0x5f, 0x38, 0xa5, 0xe6, 0x42, 0xc9, 0x38, 0x97, 0x97, 0x72, 0x57, 0x94, 0xfa, 0x9f, 0x14, 0xb8,
-	0xb0, 0x8d, 0x48, 0x58, 0x2c, 0x0d, 0x30, 0xdc, 0x8b, 0x70, 0xae, 0x6d, 0xb0, 0x41, 0x05, 0x09,
-	0x6c, 0xd4, 0x45, 0xa1, 0xb6, 0x64, 0x06, 0xce, 0x6b, 0x67, 0x29, 0x82, 0x26, 0xd7, 0x05, 0x83,
-	0x1d, 0x2b, 0x24, 0xf5, 0x03, 0xcf, 0x44, 0x18, 0x27, 0x49, 0x73, 0x11, 0xe9, 0x1b, 0x72, 0x3d,
-	0x22, 0x4d, 0x1b, 0x38, 0xdf, 0x6b, 0xe0, 0xef, 0xb1, 0x5c, 0x39, 0xf8, 0x08, 0xc2, 0xd0, 0xfb,
-	0x50, 0x8a, 0x99, 0xf8, 0xa1, 0x94, 0x18, 0x32, 0xaa, 0xbf, 0x0f, 0x2b, 0xdb, 0x88, 0x5c, 0xdf,
-	0x7d, 0x73, 0x80, 0xf2, 0x6e, 0x8b, 0xaa, 0x87, 0x56, 0x70, 0xd2, 0xbb, 0x4e, 0xbb, 0x35, 0xbd,
-	0x21, 0x78, 0x31, 0x47, 0xc4, 0x2f, 0x5c, 0xff, 0xb1, 0x02, 0x4f, 0x0e, 0xd8, 0x5c, 0x1c, 0xfb,
-	0x5d, 0x98, 0x8d, 0xb1, 0xd5, 0xe3, 0x15, 0xcd, 0xf3, 0xff, 0x85, 0x10, 0xda, 0x4c, 0x90, 0x04,
-	0xe0, 0xfa, 0x5f, 0x15, 0x98, 0xd3, 0x90, 0xe1, 0xfb, 0xed, 0x23, 0x96, 0x8c, 0x71, 0xbf, 0xdb,
-	0xa9, 0xd0, 0x7b, 0x3b, 0x65, 0x77, 0x28, 0xb9, 0x87, 0xef, 0x50, 0xd4, 0x2b, 0x30, 0xca, 0xae,
-	0x0c, 0x2c, 0xf2, 0xe0, 0xc9, 0x29, 0x55, 0xe0, 0x8b, 0x84, 0xbf, 0x00, 0xf3, 0xa9, 0x43, 0x89,
-	0xfb, 0xf9, 0xdf, 0x39, 0xa8, 0x6e, 0x58, 0xd6, 0x3e, 0x32, 0x02, 0xf3, 0x70, 0x83, 0x90, 0xc0,
-	0x6e, 0x76, 0x48, 0x64, 0xed, 0x1f, 0x2a, 0x30, 0x8b, 0xd9, 0x9a, 0x6e, 0x84, 0x8b, 0x42, 0xe1,
-	0x6f, 0x0d, 0x95, 0x53, 0xfa, 0x33, 0x6f, 0xa4, 0xe1, 0x3c, 0xa5, 0xcc, 0xe0, 0x14, 0x98, 0x96,
-	0xc7, 0xb6, 0x6b, 0xa1, 0xfb, 0xf1, 0xc4, 0x58, 0x66, 0x10, 0x1a, 0x2a, 0xea, 0xb3, 0xa0, 0xe2,
-	0xbb, 0xb6, 0xaf, 0x63, 0xf3, 0x10, 0x39, 0x86, 0xde, 0xf1, 0x2d, 0xd9, 0x6b, 0x97, 0xb4, 0x19,
-	0xba, 0xb2, 0xcf, 0x16, 0xde, 0x62, 0xf0, 0x64, 0x8f, 0x59, 0x48, 0xf5, 0x98, 0xd5, 0x36, 0xcc,
-	0x67, 0x4a, 0x15, 0xcf, 0x61, 0x65, 0x9e, 0xc3, 0xae, 0xc6, 0x73, 0xd8, 0xd4, 0xfa, 0x33, 0x49,
-	0x8b, 0x84, 0x15, 0xd9, 0x0e, 0x95, 0x13, 0x59, 0xb7, 0x29, 0x2a, 0xab, 0x33, 0x63, 0x39, 0x6b,
-	0x09, 0x16, 0x33, 0xd5, 0x23, 0x6c, 0xf3, 0x53, 0x05, 0x96, 0x78, 0x49, 0xd5, 0xcf, 0x3c, 0xff,
-	0xd7, 0xcf, 0x3a, 0xe5, 0xd3, 0xab, 0x71, 0x60, 0xf3, 0x5d, 0x5f, 0x81, 0x5a, 0x3f, 0x51, 0x84,
-	0xb4, 0xdf, 0x81, 0x2a, 0xed, 0xf7, 0xfa, 0x48, 0x9a, 0xdc, 0x5c, 0x19, 0xb8, 0x79, 0x2e, 0xbd,
-	0xf9, 0x47, 0xa3, 0xb0, 0x98, 0xc9, 0x5b, 0x64, 0x85, 0x0f, 0x14, 0x98, 0x35, 0x3b, 0x98, 0x78,
-	0x4e, 0xaf, 0x97, 0x0e, 0x7d, 0xf3, 0xf5, 0xe3, 0xde, 0xd8, 0x62, 0x9c, 0x7b, 0xdc, 0xd4, 0x4c,
-	0x81, 0x99, 0x14, 0xf8, 0x08, 0x13, 0x94, 0x90, 0x22, 0xf7, 0x88, 0xa4, 0xd8, 0x67, 0x9c, 0x7b,
-	0x83, 0x25, 0x05, 0x56, 0x5b, 0x30, 0xe6, 0x18, 0xbe, 0x6f, 0xbb, 0xad, 0x4a, 0x9e, 0x6d, 0xbd,
-	0xf7, 0xd0, 0x5b, 0xef, 0x71, 0x7e, 0x7c, 0x47, 0xc9, 0x5d, 0x75, 0x61, 0xd1, 0xb0, 0x2c, 0xbd,
-	0x37, 0xe1, 0xf1, 0xe6, 0x9e, 0xb7, 0x11, 0x6b, 0xc9, 0xa8, 0x90, 0xc8, 0x99, 0x79, 0x8f, 0xdd,
-	0x08, 0x15, 0xc3, 0xb2, 0x32, 0x57, 0x68, 0x68, 0x66, 0x5a, 0xe2, 0xb1, 0x84, 0x26, 0x4b, 0x04,
-	0x59, 0x1a, 0x7f, 0x3c, 0xbb, 0xbd, 0x04, 0x13, 0x71, 0x25, 0x67, 0x6c, 0x32, 0x17, 0xdf, 0xa4,
-	0x1c, 0x4f, 0x22, 0x2f, 0xc3, 0x59, 0x39, 0xbb, 0xda, 0xe2, 0xb5, 0x44, 0xec, 0xc6, 0x4a, 0x54,
-	0x1c, 0x4a, 0x6f, 0xc5, 0xf1, 0x9b, 0x51, 0x58, 0xe8, 0xa1, 0x16, 0x51, 0xf5, 0x7d, 0x98, 0xc5,
-	0x1d, 0xdf, 0xf7, 0x02, 0x82, 0x2c, 0xdd, 0x6c, 0xdb, 0xec, 0xfa, 0xe1, 0x41, 0xa5, 0x0d, 0xe5,
-	0x53, 0x7d, 0x18, 0x37, 0xf6, 0x25, 0xd7, 0x2d, 0xce, 0x54, 0xba, 0x72, 0x0a, 0xac, 0x3e, 0x0d,
-	0x53, 0x9c, 0x7b, 0xd8, 0x28, 0xf1, 0xc3, 0x4f, 0x72, 0xa8, 0x6c, 0x93, 0xee, 0xc0, 0xb4, 0x83,
-	0x9c, 0x26, 0x0a, 0xf0, 0xa1, 0xed, 0x73, 0xe7, 0x1b, 0xd4, 0x2c, 0xc8, 0xe7, 0x8c, 0xee, 0xe5,
-	0xc6, 0x5e, 0x48, 0xc6, 0xa7, 0x6a, 0x4e, 0xe2, 0x9b, 0xe6, 0x2c, 0xa9, 0xbf, 0xf0, 0xbe, 0x2f,
-	0x0b, 0x48, 0x46, 0x41, 0x57, 0xec, 0x51, 0x2f, 0xed, 0x1f, 0x65, 0xbb, 0xc1, 0xcb, 0x72, 0xd3,
-	0xeb, 0xb8, 0x84, 0xf5, 0x7b, 0x45, 0x6d, 0x56, 0x2c, 0xb1, 0x8a, 0x79, 0x8b, 0x2e, 0xd0, 0x7c,
-	0x1e, 0x1b, 0x7c, 0xe9, 0x74, 0x99, 0x77, 0x7c, 0x65, 0x6d, 0x26, 0xb6, 0xb0, 0x4f, 0xe1, 0xea,
-	0x45, 0x98, 0x89, 0xf5, 0xee, 0x1c, 0xb7, 0xc4, 0x70, 0x63, 0x3d, 0x3d, 0x47, 0xdd, 0x86, 0x09,
-	0xd9, 0x4f, 0x31, 0xfd, 0x94, 0x99, 0x7e, 0xce, 0x27, 0x3d, 0x55, 0x60, 0xc4, 0xba, 0x28, 0xa6,
-	0x95, 0xf1, 0x6e, 0xf4, 0xa1, 0x7e, 0x13, 0xaa, 0x07, 0x86, 0xdd, 0xf6, 0x62, 0x46, 0xd1, 0x6d,
-	0xd7, 0x0c, 0x90, 0x83, 0x5c, 0x52, 0x01, 0x56, 0x00, 0x57, 0x24, 0x46, 0xc8, 0x45, 0xac, 0xab,
-	0x57, 0xa0, 0x62, 0xbb, 0x36, 0xb1, 0x8d, 0xb6, 0x9e, 0xe6, 0x52, 0x19, 0xe7, 0xc5, 0xb3, 0x58,
-	0x7f, 0x25, 0xc9, 0x42, 0xbd, 0x0a, 0x8b, 0x36, 0xd6, 0x5b, 0x6d, 0xaf, 0x69, 0xb4, 0xf5, 0xa8,
-	0x0c, 0x43, 0xae, 0xd1, 0x6c, 0x23, 0xab, 0x32, 0xc1, 0x2e, 0xfb, 0x8a, 0x8d, 0xb7, 0x19, 0x46,
-	0x58, 0x41, 0xdf, 0xe0, 0xeb, 0xd5, 0x2d, 0x98, 0xcf, 0x74, 0xba, 0x53, 0x05, 0xda, 0xdb, 0x70,
-	0x66, 0xd7, 0xc6, 0x44, 0x78, 0x73, 0x78, 0xb3, 0x2d, 0x42, 0x39, 0xea, 0xce, 0x79, 0x8f, 0x53,
-	0xf2, 0x07, 0xb4, 0xe5, 0x99, 0x43, 0xb3, 0x9f, 0x2b, 0x30, 0x97, 0x64, 0x2e, 0x82, 0xf0, 0x75,
-	0x28, 0x09, 0x87, 0x1a, 0x5c, 0xe7, 0xa6, 0xe6, 0xa5, 0x82, 0xcf, 0x9e, 0x78, 0xc7, 0xd2, 0x42,
-	0x26, 0x43, 0x4b, 0xf4, 0x4b, 0x05, 0x96, 0x37, 0x2c, 0xeb, 0xf5, 0x80, 0xd7, 0x4d, 0xf4, 0xf2,
-	0x27, 0xe9, 0x04, 0x73, 0x11, 0x66, 0x0e, 0x02, 0xcf, 0x25, 0xc8, 0xb5, 0x52, 0x13, 0xff, 0x69,
-	0x09, 0x97, 0x53, 0xff, 0x6d, 0x58, 0xe1, 0xc6, 0xd2, 0x03, 0xc6, 0x49, 0x97, 0xa1, 0x63, 0x7a,
-	0xae, 0x8b, 0xcc, 0xb0, 0x50, 0x2e, 0x69, 0x4b, 0x1c, 0x2f, 0xb1, 0xe1, 0x56, 0x88, 0x54, 0xaf,
-	0xc3, 0x4a, 0x7f, 0xb1, 0x44, 0x29, 0x72, 0x0d, 0xaa, 0xbc, 0x58, 0xc9, 0x94, 0x7a, 0x88, 0xb4,
-	0xc8, 0x1e, 0xb1, 0x32, 0x18, 0x44, 0x43, 0xad, 0x73, 0x31, 0x6b, 0x89, 0x34, 0x22, 0xf9, 0xef,
-	0xc3, 0x3c, 0xeb, 0x11, 0x0f, 0x91, 0x11, 0x90, 0x26, 0x32, 0x88, 0x7e, 0xcf, 0x26, 0x87, 0xb6,
-	0x2b, 0xfa, 0xb4, 0x73, 0x3d, 0x93, 0xb5, 0xeb, 0xe2, 0x29, 0x7b, 0xb3, 0xf0, 0xe1, 0xa7, 0xcb,
-	0x8a, 0x76, 0x86, 0x52, 0xdf, 0x94, 0xc4, 0x77, 0x18, 0xad, 0xba, 0x0c, 0xe3, 0x81, 0x6f, 0x86,
-	0x5a, 0x16, 0x93, 0xd2, 0xc0, 0x37, 0xa5, 0x82, 0x17, 0x60, 0x8c, 0xbd, 0xbc, 0x84, 0xa3, 0xd2,
-	0x51, 0xfa, 0xc9, 0x46, 0xa2, 0x85, 0xc0, 0x6b, 0xf3, 0x5a, 0x77, 0x2a, 0x7e, 0x21, 0x67, 0xcd,
-	0x0f, 0x13, 0x27, 0xd2, 0xbc, 0x36, 0xd2, 0x18, 0xb1, 0xfa, 0x0e, 0x54, 0x31, 0xc2, 0x2c, 0xdc,
-	0xd9, 0xd4, 0x0b, 0x59, 0xba, 0x71, 0x40, 0x35, 0xc8, 0x46, 0x86, 0xc5, 0x21, 0x47, 0x86, 0x0b,
-	0x82, 0xc7, 0x3e, 0x67, 0xb1, 0x41, 0x39, 0xb0, 0x91, 0x69, 0x22, 0x86, 0x46, 0x4f, 0x8e, 0xa1,
-	0xb1, 0x2c, 0x8f, 0xfd, 0x48, 0x81, 0x6a, 0x96, 0x55, 0x44, 0x24, 0xdd, 0x82, 0x29, 0xc3, 0x24,
-	0x76, 0x17, 0xe9, 0x22, 0xcd, 0x8b, 0x78, 0x7a, 0xee, 0xa4, 0x5b, 0x22, 0xa9, 0x93, 0x49, 0xce,
-	0x44, 0x70, 0x1f, 0x3a, 0x9c, 0x7e, 0x9f, 0x83, 0x79, 0xde, 0xde, 0xa6, 0x1b, 0xea, 0x1b, 0x50,
-	0x60, 0xd3, 0x6a, 0x85, 0xd9, 0xe7, 0xf2, 0x60, 0xfb, 0x5c, 0x47, 0x86, 0xb5, 0x8b, 0x08, 0x41,
-	0xc1, 0x9b, 0x1d, 0x24, 0xea, 0x08, 0x46, 0x3e, 0xe8, 0x59, 0x8d, 0xde, 0xa3, 0x5e, 0x27, 0x30,
-	0xc3, 0xa0, 0x13, 0x1e, 0x32, 0xc9, 0xa1, 0xe2, 0x7c, 0xea, 0x0b, 0x34, 0x3b, 0x53, 0x0c, 0xaa,
-	0x23, 0x1a, 0xd2, 0xb1, 0xd1, 0x06, 0x9f, 0x78, 0xce, 0x87, 0xeb, 0x37, 0xdc, 0xd8, 0x64, 0x23,
-	0x73, 0x4e, 0x59, 0x1c, 0x7a, 0x4e, 0x39, 0x9a, 0xa5, 0xaf, 0x4f, 0x72, 0x70, 0x36, 0xad, 0x2f,
-	0x61, 0xc8, 0x47, 0xa4, 0xb0, 0xcc, 0x51, 0x42, 0xee, 0x11, 0x8e, 0x12, 0xb2, 0xce, 0x9a, 0xcf,
-	0x1a, 0x9c, 0x3a, 0x70, 0xb6, 0x47, 0x12, 0x59, 0x44, 0x3f, 0xd4, 0x78, 0x65, 0x2e, 0x2d, 0x12,
-	0x7b, 0x36, 0xfb, 0xbb, 0x02, 0x0b, 0x6f, 0x74, 0x82, 0x16, 0xfa, 0x2a, 0x3a, 0x63, 0xbd, 0x0a,
-	0x95, 0xde, 0xc3, 0x89, 0xbc, 0xfd, 0x87, 0x1c, 0x2c, 0xec, 0xa1, 0xaf, 0xe8, 0xc9, 0x1f, 0x4b,
-	0x18, 0x6e, 0x42, 0xa5, 0x57, 0x61, 0xa7, 0x7b, 0x17, 0xa0, 0xb5, 0xcd, 0xa2, 0x86, 0x0e, 0x02,
-	0x84, 0x0f, 0x65, 0x67, 0x97, 0x78, 0xaa, 0x4d, 0x0f, 0xd6, 0xf2, 0x8f, 0xef, 0xd9, 0x47, 0x4c,
-	0xc3, 0x6a, 0xf0, 0x44, 0xb6, 0x40, 0x91, 0x9f, 0x2c, 0x69, 0x08, 0x23, 0xd7, 0x4a, 0x45, 0x55,
-	0x5f, 0x99, 0x1f, 0xe1, 0xdb, 0xe6, 0xd3, 0x30, 0x95, 0x2c, 0x91, 0x44, 0xe7, 0x31, 0x19, 0xc4,
-	0x6b, 0x91, 0x8c, 0x07, 0xac, 0x62, 0xc6, 0x03, 0xd6, 0x53, 0x30, 0xc9, 0xb1, 0x92, 0x4f, 0x4d,
-	0x1c, 0xa9, 0xdf, 0xab, 0xd5, 0x58, 0xcf, 0xab, 0xd5, 0x32, 0x8c, 0x53, 0x0c, 0xc9, 0xa4, 0x14,
-	0x22, 0x08, 0x16, 0x7c, 0x3c, 0x94, 0xad, 0x30, 0xa1, 0xd3, 0xdf, 0xe5, 0xa0, 0xb2, 0x8d, 0x08,
-	0x05, 0xf2, 0x98, 0x89, 0xab, 0x73, 0xf0, 0xbf, 0x7e, 0x96, 0xc4, 0xc8, 0x99, 0xfd, 0x01, 0x4f,
-	0x4e, 0x87, 0x88, 0x64, 0xa4, 0xee, 0xc2, 0x74, 0xb4, 0xcc, 0x5f, 0x7e, 0xf3, 0x2c, 0x88, 0xcf,
-	0xf7, 0xe9, 0xc4, 0x23, 0x19, 0x68, 0xdc, 0x4e, 0x92, 0xf8, 0xa7, 0x5a, 0x83, 0x71, 0xc7, 0xe6,
-	0x49, 0x38, 0x8a, 0xb8, 0xb2, 0x63, 0xf3, 0xac, 0x6a, 0xb1, 0x75, 0xe3, 0x7e, 0xb8, 0x5e, 0x14,
-	0xeb, 0xc6, 0x7d, 0xb1, 0x9e, 0x7c, 0xcb, 0x1f, 0x1d, 0xe2, 0x2d, 0x3f, 0xb3, 0x98, 0x79, 0xa0,
-	0xc0, 0xb9, 0x0c, 0x75, 0x89, 0xd0, 0xfb, 0x56, 0xf2, 0x31, 0xff, 0xeb, 0xc3, 0xb4, 0x04, 0x1b,
-	0xed, 0xb6, 0x67, 0x1a, 0x04, 0x59, 0xe1, 0xf5, 0x70, 0xca, 0x87, 0xfd, 0x9f, 0x28, 0x50, 0xbb,
-	0x8e, 0xda, 0x88, 0xa0, 0xde, 0x10, 0xfb, 0x72, 0xff, 0xbd, 0x75, 0x15, 0x96, 0xfb, 0x0a, 0x22,
-	0x34, 0x54, 0x85, 0xd2, 0x3d, 0x23, 0x70, 0x6d, 0xb7, 0x25, 0x07, 0xa2, 0xe1, 0x77, 0xfd, 0xb7,
-	0x0a, 0xac, 0xee, 0x93, 0x00, 0x19, 0x8e, 0xa4, 0x1f, 0xf0, 0xde, 0xe1, 0xc3, 0x59, 0x7c, 0xe4,
-	0x9a, 0x7a, 0xfc, 0x86, 0xe6, 0x7f, 0xb0, 0x52, 0x06, 0xfc, 0xc1, 0x2a, 0x75, 0x39, 0xef, 0x1f,
-	0xb9, 0x66, 0x6c, 0x0f, 0xf6, 0x57, 0xaa, 0x9b, 0x23, 0xda, 0x1c, 0xce, 0x80, 0x6f, 0x4e, 0x00,
-	0x44, 0xf3, 0xc3, 0xfa, 0x87, 0x0a, 0x5c, 0x1c, 0x42, 0x58, 0x71, 0xec, 0x77, 0x7a, 0x9e, 0x85,
-	0xae, 0x0d, 0x23, 0xdf, 0x00, 0xd6, 0x37, 0x47, 0xa2, 0x07, 0xa2, 0xa4, 0x68, 0x9b, 0xed, 0x8f,
-	0x3f, 0xab, 0x8d, 0x7c, 0xf2, 0x59, 0x6d, 0xe4, 0x8b, 0xcf, 0x6a, 0xca, 0x0f, 0x8e, 0x6b, 0xca,
-	0xaf, 0x8f, 0x6b, 0xca, 0x9f, 0x8f, 0x6b, 0xca, 0xc7, 0xc7, 0x35, 0xe5, 0x9f, 0xc7, 0x35, 0xe5,
-	0x5f, 0xc7, 0xb5, 0x91, 0x2f, 0x8e, 0x6b, 0xca, 0x83, 0xcf, 0x6b, 0x23, 0x1f, 0x7f, 0x5e, 0x1b,
-	0xf9, 0xe4, 0xf3, 0xda, 0xc8, 0xdb, 0xdf, 0x68, 0x79, 0x91, 0x48, 0xb6, 0x37, 0xe0, 0x1f, 0xc5,
-	0x2f, 0xc7, 0xbf, 0x9b, 0xa3, 0xac, 0xad, 0x78, 0xfe, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd2,
-	0xe2, 0x79, 0x71, 0x8c, 0x2c, 0x00, 0x00,
+	// 3302 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1b, 0x5b, 0x6c, 0x1c, 0x57,
+	0xd5, 0xb3, 0x2f, 0xef, 0x1e, 0xbf, 0x27, 0x0f, 0x6f, 0xd6, 0xf1, 0xc6, 0x9d, 0x26, 0x69, 0x12,
+	0xda, 0x75, 0xe3, 0x02, 0x4d, 0x5b, 0xa2, 0x28, 0x76, 0x52, 0xc7, 0x25, 0xee, 0x63, 0x36, 0x4d,
+	0xa0, 0x52, 0x98, 0x8e, 0x67, 0xae, 0xd7, 0x43, 0x76, 0x1e, 0x9d, 0x7b, 0xd7, 0x89, 0x2b, 0x01,
+	0x15, 0x05, 0x21, 0x90, 0x10, 0x91, 0x2a, 0xa4, 0xaa, 0x12, 0x12, 0x3f, 0x48, 0x80, 0x40, 0xfc,
+	0x21, 0x7e, 0xf9, 0xe3, 0xb3, 0x82, 0x9f, 0x0a, 0x24, 0xa0, 0xe9, 0x0f, 0x9f, 0xfd, 0x46, 0x42,
+	0x42, 0xf7, 0x35, 0xaf, 0x9d, 0x5d, 0x6f, 0x48, 0x52, 0xa4, 0xfe, 0x79, 0xcf, 0x3d, 0xe7, 0xdc,
+	0x73, 0xcf, 0xeb, 0x9e, 0x73, 0xee, 0x18, 0x9e, 0x27, 0xc8, 0x0d, 0xfc, 0xd0, 0xec, 0x2e, 0x63,
+	0x14, 0xee, 0xa2, 0x70, 0xd9, 0x0c, 0x9c, 0x65, 0xd3, 0x76, 0x1d, 0x8f, 0xfe, 0x76, 0x2c, 0xb4,
+	0xbc, 0x7b, 0x76, 0x39, 0x44, 0x6f, 0xf5, 0x10, 0x26, 0x46, 0x88, 0x70, 0xe0, 0x7b, 0x18, 0xb5,
+	0x82, 0xd0, 0x27, 0xbe, 0xfa, 0xb8, 0xa4, 0x6d, 0x71, 0xda, 0x96, 0x19, 0x38, 0xad, 0x24, 0x6d,
+	0x6b, 0xf7, 0x6c, 0xe3, 0x58, 0xc7, 0xf7, 0x3b, 0x5d, 0xb4, 0xcc, 0x48, 0xb6, 0x7a, 0xdb, 0xcb,
+	0xc4, 0x71, 0x11, 0x26, 0xa6, 0x1b, 0x70, 0x2e, 0x8d, 0x66, 0x16, 0xc1, 0xee, 0x85, 0x26, 0x71,
+	0x7c, 0x4f, 0xac, 0x3f, 0x66, 0xa3, 0x00, 0x79, 0x36, 0xf2, 0x2c, 0x07, 0xe1, 0xe5, 0x8e, 0xdf,
+	0xf1, 0x19, 0x9c, 0xfd, 0x25, 0x50, 0xb4, 0xe8, 0x10, 0x54, 0x7a, 0xe4, 0xf5, 0x5c, 0x4c, 0xc5,
+	0xb6, 0x7c, 0xd7, 0x8d, 0xd8, 0x9c, 0xcc, 0xc7, 0x21, 0x26, 0xbe, 0x65, 0xbc, 0xd5, 0x43, 0x3d,
+	0x71, 0xa8, 0xc6, 0xf1, 0x14, 0x1e, 0x67, 0x41, 0x11, 0x5d, 0x84, 0xb1, 0xd9, 0x91, 0x58, 0x4f,
+	0xa4, 0xb0, 0x28, 0x13, 0xc6, 0xa3, 0x1f, 0xf1, 0x44, 0x0a, 0x71, 0x17, 0x85, 0xd8, 0xc9, 0xe3,
+	0x97, 0x96, 0xee, 0xb6, 0x1f, 0xde, 0xda, 0xee, 0xfa, 0xb7, 0xfb, 0xf1, 0x9e, 0xcc, 0x33, 0x97,
+	0xd5, 0xed, 0x61, 0x82, 0xc2, 0x7e, 0xec, 0xd3, 0x79, 0xd8, 0xf9, 0xea, 0x39, 0x33, 0x1c, 0x95,
+	0xef, 0xd0, 0x77, 0xf8, 0x3c, 0x5c, 0xaa, 0x8c, 0x61, 0xd2, 0xee, 0x38, 0x98, 0xf8, 0xe1, 0x5e,
+	0xbf, 0xb4, 0xad, 0x3c, 0x6c, 0xcf, 0x74, 0x11, 0x0e, 0x4c, 0x2b, 0x47, 0xb5, 0x4f, 0xe7, 0xe1,
+	0x87, 0x28, 0xe8, 0x3a, 0x16, 0xf3, 0x9f, 0x7e, 0x8a, 0xe7, 0xf2, 0x28, 0x02, 0x6a, 0x13, 0x4c,
+	0x90, 0x67, 0xa1, 0xc4, 0x51, 0x0d, 0x17, 0x11, 0xd3, 0x36, 0x89, 0x29, 0x48, 0x9f, 0x19, 0x81,
+	0x14, 0xdd, 0x41, 0x56, 0x8f, 0xee, 0x8c, 0x05, 0xd1, 0x85, 0x11, 0x88, 0xa4, 0xad, 0x0d, 0xb7,
+	0x47, 0xcc, 0xad, 0x2e, 0x32, 0x30, 0x31, 0xc9, 0x50, 0x95, 0x64, 0x18, 0x50, 0x7d, 0xcb, 0x0d,
+	0x97, 0x47, 0xc0, 0x67, 0x8e, 0x2a, 0x08, 0xb4, 0x77, 0x15, 0x68, 0xe8, 0x68, 0xab, 0xe7, 0x74,
+	0xed, 0x4d, 0xbe, 0x7f, 0x9b, 0x6e, 0xaf, 0xf3, 0x80, 0x57, 0x8f, 0x42, 0x2d, 0x32, 0x40, 0x5d,
+	0x59, 0x52, 0x4e, 0xd5, 0xf4, 0x18, 0xa0, 0xae, 0x43, 0x2d, 0x3a, 0x72, 0xbd, 0xb0, 0xa4, 0x9c,
+	0x9a, 0x58, 0x39, 0x1d, 0x49, 0xcc, 0x92, 0x81, 0x70, 0xb1, 0xdd, 0xb3, 0xad, 0x1b, 0xe2, 0x98,
+	0x97, 0x25, 0x81, 0x1e, 0xd3, 0x6a, 0x8b, 0xb0, 0x90, 0x2b, 0x04, 0xcf, 0x36, 0xda, 0xf7, 0x14,
+	0x58, 0xb8, 0x84, 0xb0, 0x15, 0x3a, 0x5b, 0xe8, 0xff, 0x28, 0xe5, 0xef, 0x0b, 0x70, 0x34, 0x5f,
+	0x0c, 0x2e, 0xa7, 0x7a, 0x04, 0xaa, 0x78, 0xc7, 0x0c, 0x6d, 0xc3, 0xb1, 0x85, 0x18, 0xe3, 0xec,
+	0xf7, 0x86, 0xad, 0x3e, 0x06, 0x93, 0xc2, 0xef, 0x0d, 0xd3, 0xb6, 0x43, 0x26, 0x47, 0x4d, 0x9f,
+	0x10, 0xb0, 0x8b, 0xb6, 0x1d, 0xaa, 0x3b, 0x70, 0xc0, 0x32, 0xad, 0x1d, 0x94, 0x76, 0x84, 0x7a,
+	0x91, 0x49, 0x7c, 0xae, 0x95, 0x97, 0x6b, 0x13, 0x96, 0x4d, 0x4a, 0x9f, 0x12, 0x6e, 0x8e, 0x31,
+	0x4d, 0x82, 0x54, 0x0f, 0x0e, 0x53, 0xcf, 0xde, 0x32, 0x71, 0x76, 0xb3, 0xd2, 0x03, 0x6e, 0x76,
+	0x50, 0xf2, 0x4d, 0x42, 0xb5, 0x3f, 0x2b, 0xd0, 0x90, 0x8a, 0xbb, 0xc2, 0x4f, 0x7c, 0xc5, 0xc7,
+	0x44, 0x9a, 0x8f, 0xea, 0xc6, 0xc7, 0x84, 0x29, 0x06, 0x61, 0x2c, 0x54, 0x37, 0x41, 0x61, 0x17,
+	0x39, 0x28, 0xa5, 0x59, 0xaa, 0xba, 0x72, 0xac, 0xd9, 0x94, 0xf1, 0x8b, 0x59, 0xe3, 0x7f, 0x0d,
+	0xd4, 0x28, 0xc0, 0x62, 0x2f, 0x28, 0xdd, 0xaf, 0x17, 0xcc, 0xdd, 0xce, 0x82, 0xb4, 0xbf, 0x27,
+	0x9c, 0x32, 0x75, 0x28, 0xe1, 0x0c, 0x8f, 0xc3, 0x14, 0x13, 0x11, 0x1b, 0x5e, 0xcf, 0xdd, 0x42,
+	0x21, 0x3b, 0x56, 0x59, 0x9f, 0xe4, 0xc0, 0x97, 0x19, 0x4c, 0x5d, 0x80, 0x9a, 0x3c, 0x17, 0xae,
+	0x17, 0x96, 0x8a, 0xa7, 0xca, 0x7a, 0x55, 0x1c, 0x0c, 0xab, 0x37, 0x61, 0x26, 0x3a, 0x88, 0xc1,
+	0xac, 0x28, 0x9c, 0xe1, 0x8b, 0xb9, 0xf6, 0x89, 0x70, 0xe9, 0x11, 0x5e, 0x96, 0x3f, 0xd6, 0x28,
+	0xdd, 0x86, 0xb7, 0xed, 0xeb, 0xd3, 0x5e, 0x0a, 0xa6, 0xd6, 0x61, 0x5c, 0x6a, 0xbc, 0xcc, 0x9d,
+	0x55, 0xfc, 0x7c, 0xa9, 0x54, 0x2d, 0xcd, 0x96, 0xb5, 0x16, 0xcc, 0xad, 0x75, 0x7d, 0x8c, 0xda,
+	0x54, 0x1e, 0x69, 0xab, 0xac, 0x8b, 0xc7, 0x86, 0xd0, 0x0e, 0x82, 0x9a, 0xc4, 0x17, 0xb1, 0xfb,
+	0x24, 0xcc, 0xac, 0x23, 0x32, 0x2a, 0x8f, 0x37, 0x61, 0x36, 0xc6, 0x16, 0x8a, 0xbc, 0x0a, 0x20,
+	0xd0, 0xbd, 0x6d, 0x9f, 0x11, 0x4c, 0xac, 0x3c, 0x35, 0x8a, 0x87, 0x32, 0x36, 0xec, 0xe8, 0x35,
+	0x2c, 0xff, 0xd4, 0x7e, 0x5c, 0x80, 0xf9, 0xab, 0x0e, 0x26, 0xc2, 0x64, 0xd7, 0x68, 0xf2, 0xdc,
+	0x5f, 0x30, 0xf5, 0x45, 0xa8, 0x5a, 0x26, 0x41, 0x1d, 0x3f, 0xdc, 0x63, 0x0e, 0x38, 0xbd, 0x72,
+	0x26, 0x57, 0x04, 0x76, 0x0b, 0xd2, 0xcd, 0x29, 0xe3, 0x35, 0x41, 0xa1, 0x47, 0xb4, 0xea, 0x15,
+	0x00, 0x56, 0x71, 0x84, 0xa6, 0xd7, 0x91, 0xe6, 0x3c, 0x9d, 0xcb, 0x49, 0xa4, 0x06, 0xc9, 0x4b,
+	0xa7, 0x04, 0x7a, 0x8d, 0xc8, 0x3f, 0xd5, 0x45, 0x80, 0x2d, 0x93, 0x58, 0x3b, 0x06, 0x76, 0xde,
+	0xe6, 0x81, 0x5b, 0xd6, 0x6b, 0x0c, 0xd2, 0x76, 0xde, 0x46, 0xea, 0x49, 0x98, 0xf1, 0xd0, 0x1d,
+	0x62, 0x04, 0x66, 0x07, 0x19, 0xc4, 0xbf, 0x85, 0x3c, 0x66, 0xe5, 0x49, 0x7d, 0x8a, 0x82, 0x5f,
+	0x35, 0x3b, 0xe8, 0x1a, 0x05, 0xd2, 0x0b, 0xa0, 0xde, 0xaf, 0x0f, 0xa1, 0xfa, 0x0b, 0x50, 0xa6,
+	0x1b, 0xd2, 0x90, 0x2c, 0x0e, 0x14, 0x34, 0x53, 0xf0, 0x71, 0x69, 0x39, 0x5d, 0x9e, 0x14, 0x85,
+	0x3c, 0x29, 0xde, 0x2f, 0x40, 0x89, 0xd2, 0xd1, 0x5c, 0x10, 0xfb, 0x7c, 0x94, 0x46, 0x27, 0x22,
+	0xd8, 0x86, 0xad, 0x1e, 0x83, 0x89, 0x28, 0xa4, 0x45, 0x3a, 0xa8, 0xe9, 0x20, 0x41, 0x1b, 0xb6,
+	0x7a, 0x08, 0x2a, 0x61, 0xcf, 0xa3, 0x6b, 0x3c, 0x1d, 0x94, 0xc3, 0x9e, 0xb7, 0x61, 0xab, 0xf3,
+	0x30, 0xce, 0x54, 0xef, 0xd8, 0x4c, 0x5b, 0x45, 0xbd, 0x42, 0x7f, 0x6e, 0xd8, 0xea, 0x1a, 0x30,
+	0xb5, 0x1a, 0x64, 0x2f, 0x40, 0x4c, 0x49, 0xd3, 0x2b, 0x27, 0xf7, 0x37, 0xee, 0xb5, 0xbd, 0x00,
+	0xe9, 0x55, 0x22, 0xfe, 0x52, 0xcf, 0x43, 0x6d, 0xdb, 0x09, 0x91, 0x41, 0x1c, 0x17, 0xd5, 0x2b,
+	0xcc, 0xae, 0x8d, 0x16, 0xaf, 0x6c, 0x5b, 0xb2, 0xb2, 0x6d, 0x5d, 0x93, 0xa5, 0xef, 0x6a, 0xe9,
+	0xee, 0x3f, 0x8e, 0x29, 0x7a, 0x95, 0x92, 0x50, 0x20, 0x0d, 0x46, 0x51, 0x1b, 0xd6, 0xc7, 0x99,
+	0x70, 0xf2, 0xa7, 0xf6, 0x57, 0x05, 0xe6, 0x74, 0xe4, 0xfa, 0xbb, 0x88, 0x29, 0xf6, 0xb3, 0x73,
+	0xd5, 0x84, 0xbe, 0x8a, 0x29, 0x7d, 0x6d, 0xc0, 0xcc, 0xae, 0x83, 0x9d, 0x2d, 0xa7, 0xeb, 0x90,
+	0x3d, 0x7e, 0xe0, 0xd2, 0x88, 0x07, 0x9e, 0x8e, 0x09, 0xe9, 0x12, 0xcd, 0x19, 0xc9, 0xb3, 0x89,
+	0x9c, 0xf1, 0x4e, 0x7f, 0x6a, 0x7d, 0x8d, 0x16, 0x2d, 0x9f, 0xdd, 0xe1, 0xb5, 0x1d, 0x38, 0x9a,
+	0x2f, 0x81, 0x88, 0x8c, 0x2b, 0x50, 0xe1, 0x75, 0x94, 0x08, 0x8d, 0xa7, 0xf7, 0x8b, 0xe1, 0x24,
+	0x17, 0x96, 0x93, 0x04, 0xbd, 0xf6, 0x5e, 0x11, 0x9e, 0x58, 0x47, 0xa4, 0xff, 0xce, 0x31, 0x6f,
+	0x0b, 0x9a, 0xeb, 0x2b, 0x89, 0x9b, 0x32, 0x15, 0x1d, 0xb5, 0xfe, 0xe8, 0x78, 0x58, 0xd5, 0x8e,
+	0x7a, 0x1c, 0xa6, 0x31, 0x31, 0x43, 0x62, 0xa0, 0x5d, 0xe4, 0x91, 0xd8, 0x0b, 0x26, 0x19, 0xf4,
+	0x32, 0x05, 0x6e, 0xd8, 0x6a, 0x0b, 0x0e, 0x24, 0xb1, 0xa4, 0x0f, 0xf3, 0x00, 0x9b, 0x8b, 0x51,
+	0xaf, 0xf3, 0x05, 0x75, 0x09, 0x26, 0x91, 0x67, 0xc7, 0x3c, 0xcb, 0x0c, 0x11, 0x90, 0x67, 0x4b,
+	0x8e, 0x67, 0x60, 0x2e, 0xc6, 0x90, 0xfc, 0x2a, 0x0c, 0x6d, 0x46, 0xa2, 0x49, 0x6e, 0x67, 0x60,
+	0xce, 0x35, 0xef, 0x38, 0x6e, 0xcf, 0xe5, 0x19, 0x86, 0xa5, 0xc2, 0x71, 0xe6, 0x11, 0x33, 0x62,
+	0x81, 0xe6, 0x98, 0x41, 0x09, 0xb1, 0x9a, 0x93, 0x8a, 0x5e, 0x2a, 0x55, 0x95, 0xd9, 0x82, 0xf6,
+	0xf3, 0x02, 0x9c, 0xda, 0xdf, 0x2a, 0xc2, 0x19, 0x72, 0x58, 0x2b, 0x39, 0xac, 0x69, 0xe0, 0xc8,
+	0x22, 0x90, 0x25, 0x6a, 0xc4, 0xef, 0xfc, 0x89, 0x95, 0xa5, 0x41, 0x16, 0xba, 0x64, 0x12, 0x73,
+	0xb5, 0xeb, 0x6f, 0xe9, 0xd3, 0x82, 0x70, 0x95, 0xd3, 0xa9, 0x37, 0x60, 0x46, 0xe8, 0xc6, 0x10,
+	0x2b, 0xe2, 0x32, 0x69, 0xed, 0xe7, 0x88, 0x42, 0x77, 0xe2, 0x14, 0xfa, 0xf4, 0x6e, 0xea, 0xb7,
+	0x7a, 0x0a, 0x66, 0xa5, 0x8c, 0x9e, 0x6f, 0x23, 0x56, 0x98, 0x94, 0x96, 0x8a, 0xa7, 0x8a, 0x91,
+	0x08, 0x2f, 0xfb, 0x36, 0xda, 0xb0, 0xb1, 0x76, 0x57, 0x81, 0xc5, 0x75, 0x44, 0xf4, 0xb8, 0xe1,
+	0xda, 0xe4, 0xcd, 0x56, 0x74, 0x9f, 0x5e, 0x85, 0x0a, 0xd3, 0x86, 0x0c, 0x92, 0xfc, 0xba, 0x25,
+	0xd1, 0xb1, 0x51, 0xf9, 0x12, 0xfc, 0x98, 0xd6, 0x74, 0xc1, 0x83, 0x3a, 0xbf, 0xec, 0xcd, 0xa8,
+	0xc3, 0xcb, 0x12, 0x5a, 0xc0, 0x68, 0xc1, 0xa3, 0x7d, 0x50, 0x80, 0xe6, 0x20, 0x91, 0x84, 0xad,
+	0xbe, 0x05, 0xd3, 0x3c, 0x77, 0x88, 0xce, 0x50, 0xca, 0x76, 0x7d, 0xa4, 0xbb, 0x6d, 0x38, 0x73,
+	0x5e, 0x71, 0x48, 0xe8, 0x65, 0x8f, 0x84, 0x7b, 0xfa, 0x14, 0x4e, 0xc2, 0x1a, 0x7b, 0xa0, 0xf6,
+	0x23, 0xa9, 0xb3, 0x50, 0xbc, 0x85, 0xf6, 0x44, 0x2e, 0xa3, 0x7f, 0xaa, 0x9b, 0x50, 0xde, 0x35,
+	0xbb, 0x3d, 0x24, 0x42, 0xf8, 0xd9, 0xfb, 0xd4, 0x5c, 0x24, 0x19, 0xe7, 0xf2, 0x7c, 0xe1, 0x9c,
+	0xa2, 0xfd, 0x51, 0x81, 0x93, 0xeb, 0x88, 0x44, 0x95, 0xe1, 0x10, 0xc3, 0x3d, 0x07, 0x47, 0xba,
+	0x26, 0x9b, 0xf7, 0x90, 0xd0, 0x41, 0xbb, 0x28, 0xd2, 0x96, 0xcc, 0xb8, 0x45, 0xfd, 0x30, 0x45,
+	0xd0, 0xe5, 0xba, 0x60, 0xb0, 0x61, 0x47, 0xa4, 0x41, 0xe8, 0x5b, 0x08, 0xe3, 0x34, 0x69, 0x21,
+	0x26, 0x7d, 0x55, 0xae, 0xc7, 0xa4, 0x59, 0x03, 0x17, 0xfb, 0x0d, 0xfc, 0x6d, 0x96, 0x2b, 0x87,
+	0x1f, 0x41, 0x18, 0xba, 0x0d, 0xd5, 0x84, 0x89, 0x1f, 0x48, 0x89, 0x11, 0x23, 0xed, 0x6d, 0x58,
+	0x5a, 0x47, 0xe4, 0xd2, 0xd5, 0xd7, 0x86, 0x28, 0xef, 0xba, 0x28, 0xf1, 0x68, 0xb9, 0x2a, 0xbd,
+	0xeb, 0x7e, 0xb7, 0xa6, 0xb7, 0x12, 0xaf, 0x5c, 0x89, 0xf8, 0x0b, 0x6b, 0xdf, 0x57, 0xe0, 0xb1,
+	0x21, 0x9b, 0x8b, 0x63, 0xbf, 0x09, 0x73, 0x09, 0xb6, 0x46, 0xb2, 0x7c, 0x7b, 0xe6, 0x7f, 0x10,
+	0x42, 0x9f, 0x0d, 0xd3, 0x00, 0xac, 0xfd, 0x45, 0x81, 0x83, 0x3a, 0x32, 0x83, 0xa0, 0xbb, 0xc7,
+	0x92, 0x31, 0x1e, 0x74, 0x3b, 0x95, 0xfa, 0x6f, 0xa7, 0xfc, 0x76, 0xac, 0xf0, 0xe0, 0xed, 0x98,
+	0x7a, 0x0e, 0x2a, 0xec, 0xca, 0xc0, 0x22, 0x0f, 0xee, 0x9f, 0x52, 0x05, 0xbe, 0x48, 0xf8, 0xf3,
+	0x70, 0x28, 0x73, 0x28, 0x51, 0x8c, 0xfc, 0xbb, 0x00, 0x8d, 0x8b, 0xb6, 0xdd, 0x46, 0x66, 0x68,
+	0xed, 0x5c, 0x24, 0x24, 0x74, 0xb6, 0x7a, 0x24, 0xb6, 0xf6, 0x77, 0x15, 0x98, 0xc3, 0x6c, 0xcd,
+	0x30, 0xa3, 0x45, 0xa1, 0xf0, 0xd7, 0x47, 0xca, 0x29, 0x83, 0x99, 0xb7, 0xb2, 0x70, 0x9e, 0x52,
+	0x66, 0x71, 0x06, 0x4c, 0x7b, 0x01, 0xc7, 0xb3, 0xd1, 0x9d, 0x64, 0x62, 0xac, 0x31, 0x08, 0x0d,
+	0x15, 0xf5, 0x49, 0x50, 0xf1, 0x2d, 0x27, 0x30, 0xb0, 0xb5, 0x83, 0x5c, 0xd3, 0xe8, 0x05, 0xb6,
+	0x1c, 0x2c, 0x54, 0xf5, 0x59, 0xba, 0xd2, 0x66, 0x0b, 0xaf, 0x33, 0x78, 0xba, 0xa1, 0x2e, 0x65,
+	0x1a, 0xea, 0x46, 0x17, 0x0e, 0xe5, 0x4a, 0x95, 0xcc, 0x61, 0x35, 0x9e, 0xc3, 0xce, 0x27, 0x73,
+	0xd8, 0xf4, 0xca, 0x13, 0x69, 0x8b, 0x44, 0x15, 0xd8, 0x06, 0x95, 0x13, 0xd9, 0xd7, 0x29, 0x2a,
+	0x2b, 0xaa, 0x13, 0x39, 0x6b, 0x11, 0x16, 0x72, 0xd5, 0x23, 0x6c, 0xf3, 0x43, 0x05, 0x16, 0x79,
+	0xfd, 0x38, 0xc8, 0x3c, 0x5f, 0x18, 0x64, 0x9d, 0xda, 0xfd, 0xab, 0x71, 0xe8, 0xa4, 0x41, 0x5b,
+	0x82, 0xe6, 0x20, 0x51, 0x84, 0xb4, 0x5f, 0x87, 0x06, 0x6d, 0x6e, 0x07, 0x48, 0x9a, 0xde, 0x5c,
+	0x19, 0xba, 0x79, 0x21, 0xbb, 0xf9, 0x07, 0x15, 0x58, 0xc8, 0xe5, 0x2d, 0xb2, 0xc2, 0xbb, 0x0a,
+	0xcc, 0x59, 0x3d, 0x4c, 0x7c, 0xb7, 0xdf, 0x4b, 0x47, 0xbe, 0xf9, 0x06, 0x71, 0x6f, 0xad, 0x31,
+	0xce, 0x7d, 0x6e, 0x6a, 0x65, 0xc0, 0x4c, 0x0a, 0xbc, 0x87, 0x09, 0x4a, 0x49, 0x51, 0x78, 0x48,
+	0x52, 0xb4, 0x19, 0xe7, 0xfe, 0x60, 0xc9, 0x80, 0xd5, 0x0e, 0x8c, 0xbb, 0x66, 0x10, 0x38, 0x5e,
+	0xa7, 0x5e, 0x64, 0x5b, 0x6f, 0x3e, 0xf0, 0xd6, 0x9b, 0x9c, 0x1f, 0xdf, 0x51, 0x72, 0x57, 0x3d,
+	0x58, 0x30, 0x6d, 0xdb, 0xe8, 0x4f, 0x78, 0x7c, 0x92, 0xc1, 0x7b, 0xa6, 0xe5, 0x74, 0x54, 0x48,
+	0xe4, 0xdc, 0xbc, 0xc7, 0x6e, 0x84, 0xba, 0x69, 0xdb, 0xb9, 0x2b, 0x34, 0x34, 0x73, 0x2d, 0xf1,
+	0x48, 0x42, 0x93, 0x25, 0x82, 0x3c, 0x8d, 0x3f, 0x9a, 0xdd, 0x9e, 0x87, 0xc9, 0xa4, 0x92, 0x73,
+	0x36, 0x39, 0x98, 0xdc, 0xa4, 0x96, 0x4c, 0x22, 0x2f, 0xc0, 0x61, 0xd9, 0xcb, 0xad, 0xf1, 0x5a,
+	0x22, 0x71, 0x63, 0xa5, 0x2a, 0x0e, 0xa5, 0xbf, 0xe2, 0xf8, 0x55, 0x05, 0xe6, 0xfb, 0xa8, 0x45,
+	0x54, 0x7d, 0x07, 0xe6, 0x70, 0x2f, 0x08, 0xfc, 0x90, 0x20, 0xdb, 0xb0, 0xba, 0x0e, 0xbb, 0x7e,
+	0x78, 0x50, 0xe9, 0x23, 0xf9, 0xd4, 0x00, 0xc6, 0xad, 0xb6, 0xe4, 0xba, 0xc6, 0x99, 0x4a, 0x57,
+	0xce, 0x80, 0xd5, 0x13, 0x30, 0xcd, 0xb9, 0x47, 0x8d, 0x12, 0x3f, 0xfc, 0x14, 0x87, 0xca, 0x36,
+	0xe9, 0x06, 0xcc, 0xb8, 0x88, 0xce, 0x1b, 0xf1, 0x8e, 0x13, 0x70, 0xe7, 0x1b, 0xd6, 0x2c, 0x88,
+	0xe3, 0x53, 0x01, 0x37, 0x23, 0x32, 0x3e, 0x42, 0x74, 0x53, 0xbf, 0x69, 0xce, 0x92, 0xfa, 0x8b,
+	0xee, 0xfb, 0x9a, 0x80, 0xe4, 0x14, 0x74, 0xe5, 0x3e, 0xf5, 0xd2, 0xfe, 0x51, 0xb6, 0x1b, 0xbc,
+	0x2c, 0xb7, 0xfc, 0x9e, 0x47, 0x58, 0xbf, 0x57, 0xd6, 0xe7, 0xc4, 0x12, 0xab, 0x98, 0xd7, 0xe8,
+	0x02, 0xcd, 0xe7, 0x89, 0x29, 0x9f, 0x41, 0x97, 0x79, 0xc7, 0x57, 0xd3, 0x67, 0x13, 0x0b, 0x6d,
+	0x0a, 0x57, 0x4f, 0xc3, 0x6c, 0x62, 0x50, 0xc1, 0x71, 0xab, 0x0c, 0x37, 0x31, 0xc0, 0xe0, 0xa8,
+	0xeb, 0x30, 0x29, 0xfb, 0x29, 0xa6, 0x9f, 0x1a, 0xd3, 0xcf, 0xf1, 0xb4, 0xa7, 0x0a, 0x8c, 0x44,
+	0x17, 0xc5, 0xb4, 0x32, 0xb1, 0x1b, 0xff, 0x50, 0xbf, 0x02, 0x8d, 0x6d, 0xd3, 0xe9, 0xfa, 0x09,
+	0xa3, 0x18, 0x8e, 0x67, 0x85, 0xc8, 0x45, 0x1e, 0xa9, 0x03, 0x2b, 0x80, 0xeb, 0x12, 0x23, 0xe2,
+	0x22, 0xd6, 0xd5, 0x73, 0x50, 0x77, 0x3c, 0x87, 0x38, 0x66, 0xd7, 0xc8, 0x72, 0xa9, 0x4f, 0xf0,
+	0xe2, 0x59, 0xac, 0xbf, 0x98, 0x66, 0xa1, 0x9e, 0x87, 0x05, 0x07, 0x1b, 0x9d, 0xae, 0xbf, 0x65,
+	0x76, 0x8d, 0xb8, 0x0c, 0x43, 0x1e, 0x1d, 0xc3, 0xdb, 0xf5, 0x49, 0x76, 0xd9, 0xd7, 0x1d, 0xbc,
+	0xce, 0x30, 0xa2, 0x0a, 0xfa, 0x32, 0x5f, 0x6f, 0xac, 0xc1, 0xa1, 0x5c, 0xa7, 0xbb, 0xaf, 0x40,
+	0x7b, 0x03, 0x0e, 0xd0, 0x51, 0xa2, 0xf0, 0xe6, 0xe8, 0x66, 0x5b, 0x80, 0x5a, 0xdc, 0x9d, 0xf3,
+	0x1e, 0xa7, 0x1a, 0x0c, 0x69, 0xcb, 0x73, 0x27, 0x84, 0x3f, 0x51, 0xe0, 0x60, 0x9a, 0xb9, 0x08,
+	0xc2, 0x57, 0xa0, 0x2a, 0x1c, 0x6a, 0x78, 0x9d, 0x9b, 0x19, 0x0e, 0x0b, 0x3e, 0x9b, 0xe2, 0x95,
+	0x4f, 0x8f, 0x98, 0x8c, 0x2c, 0xd1, 0x4f, 0x15, 0x38, 0x76, 0xd1, 0xb6, 0x5f, 0x09, 0x79, 0xdd,
+	0x44, 0x2f, 0x7f, 0x92, 0x4d, 0x30, 0xa7, 0x61, 0x76, 0x3b, 0xf4, 0x3d, 0x42, 0x27, 0x1a, 0xe9,
+	0xe7, 0x8d, 0x19, 0x09, 0x97, 0x4f, 0x1c, 0xeb, 0xb0, 0xc4, 0x8d, 0x65, 0x84, 0x8c, 0x93, 0x21,
+	0x43, 0xc7, 0xf2, 0x3d, 0x0f, 0x59, 0x51, 0xa1, 0x5c, 0xd5, 0x17, 0x39, 0x5e, 0x6a, 0xc3, 0xb5,
+	0x08, 0x49, 0xd3, 0x60, 0x69, 0xb0, 0x58, 0xa2, 0x14, 0xb9, 0x00, 0x0d, 0x5e, 0xac, 0xe4, 0x4a,
+	0x3d, 0x42, 0x5a, 0x64, 0x2f, 0x76, 0x39, 0x0c, 0x04, 0xff, 0xf7, 0x8a, 0x70, 0x24, 0x61, 0x2d,
+	0x91, 0x46, 0x24, 0xff, 0x36, 0x1c, 0x62, 0x3d, 0xe2, 0x0e, 0x32, 0x43, 0xb2, 0x85, 0x4c, 0x62,
+	0xdc, 0x76, 0xc8, 0x8e, 0xe3, 0x89, 0x3e, 0xed, 0x48, 0xdf, 0x18, 0xf1, 0x92, 0xf8, 0x22, 0x60,
+	0xb5, 0xf4, 0x3e, 0x9d, 0x22, 0x1e, 0xa0, 0xd4, 0x57, 0x24, 0xf1, 0x0d, 0x46, 0x4b, 0xc7, 0xc2,
+	0x61, 0x60, 0x45, 0x5a, 0x16, 0x63, 0xe1, 0x30, 0xb0, 0xa4, 0x82, 0xe7, 0x61, 0x9c, 0x3d, 0x33,
+	0x45, 0x73, 0xe1, 0x0a, 0xfd, 0xc9, 0xe6, 0xbf, 0xa5, 0xd0, 0xef, 0xf2, 0x5a, 0x77, 0x7a, 0x65,
+	0x39, 0xd7, 0x7b, 0xa2, 0x4b, 0x2a, 0x75, 0x22, 0xdd, 0xef, 0x22, 0x9d, 0x11, 0xab, 0x37, 0xa1,
+	0x81, 0x11, 0x66, 0xe1, 0xce, 0xa6, 0x5e, 0xc8, 0x36, 0xcc, 0x6d, 0xaa, 0x41, 0xe2, 0x88, 0xcc,
+	0x37, 0xca, 0x7c, 0x74, 0x5e, 0xf0, 0x68, 0x73, 0x16, 0x17, 0x29, 0x07, 0x8a, 0x93, 0x8e, 0xa1,
+	0xca, 0xfe, 0x31, 0x34, 0x9e, 0xe7, 0xb1, 0x1f, 0x28, 0xd0, 0xc8, 0xb3, 0x8a, 0x88, 0xa4, 0x6b,
+	0x30, 0x6d, 0x5a, 0xc4, 0xd9, 0x45, 0x86, 0x48, 0xf3, 0x22, 0x9e, 0x9e, 0xda, 0xef, 0x96, 0x48,
+	0xeb, 0x64, 0x8a, 0x33, 0x11, 0xdc, 0x47, 0x0e, 0xa7, 0xdf, 0x16, 0xe0, 0x10, 0x6f, 0x6f, 0xb3,
+	0x0d, 0xf5, 0x65, 0x28, 0xb1, 0xd1, 0xbc, 0xc2, 0xec, 0x73, 0x76, 0xb8, 0x7d, 0x2e, 0x21, 0xd3,
+	0xbe, 0x8a, 0x08, 0x41, 0x21, 0x1b, 0xb5, 0xb2, 0x3a, 0x82, 0x91, 0x0f, 0x7b, 0x43, 0xa4, 0xf7,
+	0xa8, 0xdf, 0x0b, 0xad, 0x28, 0xe8, 0x84, 0x87, 0x4c, 0x71, 0xa8, 0x38, 0x9f, 0xfa, 0x2c, 0xcd,
+	0xce, 0x14, 0x83, 0xea, 0x88, 0x86, 0x74, 0x62, 0xb4, 0xc1, 0x27, 0x9e, 0x87, 0xa2, 0xf5, 0xcb,
+	0x5e, 0x62, 0xb2, 0x91, 0x3b, 0xa7, 0x2c, 0x8f, 0x3c, 0xa7, 0xac, 0xe4, 0xe9, 0xeb, 0x0f, 0x45,
+	0x38, 0x9c, 0xd5, 0x97, 0x30, 0xe4, 0x43, 0x52, 0x58, 0xee, 0x28, 0xa1, 0xf0, 0x10, 0x47, 0x09,
+	0x79, 0x67, 0x2d, 0xe6, 0x0d, 0x4e, 0x5d, 0x38, 0xdc, 0x27, 0x89, 0x2c, 0xa2, 0x1f, 0x68, 0xbc,
+	0x72, 0x30, 0x2b, 0x12, 0x85, 0xaa, 0xdf, 0x80, 0x29, 0x59, 0x94, 0xf0, 0x43, 0x97, 0xd9, 0x2e,
+	0xcf, 0x8d, 0x72, 0xaf, 0x24, 0xde, 0xd1, 0x62, 0xd3, 0xe8, 0x93, 0x3b, 0x31, 0x18, 0x6b, 0x7f,
+	0x53, 0x60, 0xfe, 0xd5, 0x5e, 0xd8, 0x41, 0x9f, 0x47, 0x67, 0xd7, 0x1a, 0x50, 0xef, 0x3f, 0x9c,
+	0xb8, 0x17, 0x7e, 0x57, 0x80, 0xf9, 0x4d, 0xf4, 0x39, 0x3d, 0xf9, 0x23, 0x09, 0xf3, 0x55, 0xa8,
+	0x6f, 0xa2, 0x7c, 0x6d, 0x8e, 0xfa, 0xee, 0x40, 0x6b, 0xa7, 0x05, 0x1d, 0x6d, 0x87, 0x08, 0xef,
+	0xc8, 0xce, 0x31, 0xf5, 0xee, 0x9d, 0x1d, 0xdc, 0x15, 0x1f, 0xdd, 0xb3, 0x92, 0x98, 0xb6, 0x35,
+	0xe1, 0x68, 0xbe, 0x40, 0xb1, 0x9f, 0x2c, 0xea, 0x08, 0x23, 0xcf, 0xce, 0x44, 0xed, 0x40, 0x99,
+	0x1f, 0xe2, 0x43, 0xf1, 0x09, 0x98, 0x4e, 0x97, 0x60, 0xa2, 0xb3, 0x99, 0x0a, 0x93, 0xb5, 0x4e,
+	0xce, 0x03, 0x59, 0x39, 0xe7, 0x81, 0x8c, 0x7e, 0x06, 0xc2, 0xb0, 0xd2, 0x4f, 0x59, 0x1c, 0x69,
+	0xd0, 0xab, 0xd8, 0x78, 0xdf, 0xab, 0xd8, 0x31, 0x98, 0xa0, 0x18, 0x92, 0x49, 0x35, 0x42, 0x10,
+	0x2c, 0xf8, 0xf8, 0x29, 0x5f, 0x61, 0x42, 0xa7, 0xbf, 0x29, 0x40, 0x7d, 0x1d, 0x11, 0x0a, 0xe4,
+	0x31, 0x93, 0x54, 0xe7, 0xf0, 0x4f, 0xa8, 0x16, 0xc5, 0x48, 0x9b, 0x3d, 0x59, 0xca, 0xe9, 0x13,
+	0x91, 0x8c, 0xd4, 0xab, 0x30, 0x13, 0x2f, 0xf3, 0x67, 0xf4, 0x22, 0x0b, 0xe2, 0xe3, 0x03, 0x3a,
+	0xfd, 0x58, 0x06, 0x1a, 0xb7, 0x53, 0x24, 0xf9, 0x53, 0x6d, 0xc2, 0x84, 0xeb, 0xf0, 0x24, 0x1f,
+	0x47, 0x5c, 0xcd, 0x75, 0x78, 0xd6, 0xb6, 0xd9, 0xba, 0x79, 0x27, 0x5a, 0x2f, 0x8b, 0x75, 0xf3,
+	0x8e, 0x58, 0x4f, 0x7f, 0x18, 0x51, 0x19, 0xe1, 0xc3, 0x88, 0xdc, 0x62, 0xe9, 0xae, 0x02, 0x47,
+	0x72, 0xd4, 0x25, 0x42, 0xef, 0xab, 0xe9, 0x2f, 0x23, 0xbe, 0x34, 0xca, 0xd5, 0x70, 0xb1, 0xdb,
+	0xf5, 0x2d, 0x93, 0x20, 0x3b, 0xba, 0x7e, 0xee, 0xf3, 0x2b, 0x89, 0x5f, 0x28, 0x70, 0x82, 0xd6,
+	0x6f, 0x34, 0x66, 0x50, 0xb8, 0x4a, 0xbf, 0x97, 0xdb, 0xb0, 0xd7, 0x7c, 0x37, 0x30, 0x89, 0x68,
+	0x66, 0x47, 0x33, 0x67, 0xaa, 0x98, 0x2c, 0xec, 0x5f, 0x4c, 0xe6, 0xde, 0xc9, 0x47, 0xa0, 0x4a,
+	0xcd, 0x80, 0x11, 0xc1, 0xe2, 0xeb, 0x93, 0x71, 0xd7, 0xbc, 0xd3, 0x46, 0x04, 0x6b, 0xff, 0x29,
+	0xc0, 0xc9, 0xfd, 0xe4, 0x14, 0x7a, 0xfc, 0x91, 0x02, 0x13, 0xb1, 0xef, 0x48, 0x75, 0x3a, 0x23,
+	0x4d, 0x4f, 0x46, 0xdb, 0x22, 0x76, 0xb6, 0x5c, 0x2c, 0x88, 0x9c, 0x6f, 0x64, 0x3b, 0x34, 0x7e,
+	0xa6, 0xc0, 0xe2, 0x50, 0xae, 0x99, 0x80, 0x51, 0xb2, 0x01, 0x73, 0x13, 0x54, 0xd7, 0xfc, 0xa6,
+	0x1f, 0x4f, 0x08, 0x98, 0x16, 0x79, 0x69, 0x95, 0x19, 0x08, 0x46, 0x9f, 0x16, 0xb3, 0x42, 0x5b,
+	0x6c, 0xd2, 0x45, 0x22, 0xec, 0xdb, 0x88, 0xe8, 0xb3, 0x8c, 0x55, 0x0c, 0xc0, 0xda, 0x0f, 0x14,
+	0x68, 0x5e, 0x42, 0x5d, 0x44, 0x50, 0x7f, 0x2a, 0xfe, 0x6c, 0x3f, 0x99, 0x3c, 0x0f, 0xc7, 0x06,
+	0x0a, 0x22, 0x3c, 0xa0, 0x01, 0xd5, 0xdb, 0x66, 0xe8, 0x39, 0x5e, 0x47, 0x0e, 0xe6, 0xa3, 0xdf,
+	0xda, 0xaf, 0x15, 0x38, 0xd5, 0x26, 0x21, 0x32, 0x5d, 0x49, 0x3f, 0xe4, 0xdd, 0x2d, 0x80, 0xc3,
+	0x78, 0xcf, 0xb3, 0x8c, 0x64, 0xa5, 0xc8, 0xbf, 0x6a, 0x54, 0x86, 0x7c, 0xd5, 0x98, 0x29, 0x12,
+	0xdb, 0x7b, 0x9e, 0x95, 0xd8, 0x83, 0x7d, 0xbf, 0x78, 0x65, 0x4c, 0x3f, 0x88, 0x73, 0xe0, 0xab,
+	0x93, 0x00, 0xf1, 0x1c, 0x5b, 0x7b, 0x5f, 0x81, 0xd3, 0x23, 0x08, 0x2b, 0x8e, 0x7d, 0xb3, 0xef,
+	0x79, 0xf2, 0xc2, 0x28, 0xf2, 0x0d, 0x61, 0x7d, 0x65, 0x2c, 0x7e, 0xa8, 0x4c, 0x8b, 0xb6, 0xda,
+	0xfd, 0xf0, 0xe3, 0xe6, 0xd8, 0x47, 0x1f, 0x37, 0xc7, 0x3e, 0xfd, 0xb8, 0xa9, 0xbc, 0x73, 0xaf,
+	0xa9, 0xfc, 0xf2, 0x5e, 0x53, 0xf9, 0xd3, 0xbd, 0xa6, 0xf2, 0xe1, 0xbd, 0xa6, 0xf2, 0xcf, 0x7b,
+	0x4d, 0xe5, 0x5f, 0xf7, 0x9a, 0x63, 0x9f, 0xde, 0x6b, 0x2a, 0x77, 0x3f, 0x69, 0x8e, 0x7d, 0xf8,
+	0x49, 0x73, 0xec, 0xa3, 0x4f, 0x9a, 0x63, 0x6f, 0x7c, 0xb9, 0xe3, 0xc7, 0x22, 0x39, 0xfe, 0x90,
+	0x7f, 0x10, 0x78, 0x21, 0xf9, 0x7b, 0xab, 0xc2, 0xda, 0xdb, 0x67, 0xfe, 0x3b, 0x00, 0x9a, 0x7e,
+	0x02, 0x2a, 0x5b, 0x30, 0x00, 0x00,
 }
 
 func (this *RebuildMutableStateRequest) Equal(that interface{}) bool {
@@ -4044,6 +4345,62 @@ func (this *RemoveTaskResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DescribeHistoryQueueRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DescribeHistoryQueueRequest)
+	if !ok {
+		that2, ok := that.(DescribeHistoryQueueRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.Category != that1.Category {
+		return false
+	}
+	return true
+}
+func (this *DescribeHistoryQueueResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DescribeHistoryQueueResponse)
+	if !ok {
+		that2, ok := that.(DescribeHistoryQueueResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Queues) != len(that1.Queues) {
+		return false
+	}
+	for i := range this.Queues {
+		if !this.Queues[i].Equal(that1.Queues[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *GetWorkflowExecutionRawHistoryV2Request) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -4948,6 +5305,14 @@ func (this *GetDLQMessagesResponse) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.HistoryTasks) != len(that1.HistoryTasks) {
+		return false
+	}
+	for i := range this.HistoryTasks {
+		if !this.HistoryTasks[i].Equal(that1.HistoryTasks[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *PurgeDLQMessagesRequest) Equal(that interface{}) bool {
@@ -5255,14 +5620,14 @@ func (this *GetTaskQueueTasksResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *DeleteWorkflowExecutionRequest) Equal(that interface{}) bool {
+func (this *ListWorkerBuildIdCompatibilityRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DeleteWorkflowExecutionRequest)
+	that1, ok := that.(*ListWorkerBuildIdCompatibilityRequest)
 	if !ok {
-		that2, ok := that.(DeleteWorkflowExecutionRequest)
+		that2, ok := that.(ListWorkerBuildIdCompatibilityRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5277,19 +5642,25 @@ func (this *DeleteWorkflowExecutionRequest) Equal(that interface{}) bool {
 	if this.Namespace != that1.Namespace {
 		return false
 	}
-	if !this.Execution.Equal(that1.Execution) {
+	if this.PageSize != that1.PageSize {
+		return false
+	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	if this.MaxSets != that1.MaxSets {
 		return false
 	}
 	return true
 }
-func (this *DeleteWorkflowExecutionResponse) Equal(that interface{}) bool {
+func (this *ListWorkerBuildIdCompatibilityResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DeleteWorkflowExecutionResponse)
+	that1, ok := that.(*ListWorkerBuildIdCompatibilityResponse)
 	if !ok {
-		that2, ok := that.(DeleteWorkflowExecutionResponse)
+		that2, ok := that.(ListWorkerBuildIdCompatibilityResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5301,24 +5672,27 @@ func (this *DeleteWorkflowExecutionResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Warnings) != len(that1.Warnings) {
+	if len(this.TaskQueues) != len(that1.TaskQueues) {
 		return false
 	}
-	for i := range this.Warnings {
-		if this.Warnings[i] != that1.Warnings[i] {
+	for i := range this.TaskQueues {
+		if !this.TaskQueues[i].Equal(that1.TaskQueues[i]) {
 			return false
 		}
 	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
 	return true
 }
-func (this *StreamWorkflowReplicationMessagesRequest) Equal(that interface{}) bool {
+func (this *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*StreamWorkflowReplicationMessagesRequest)
+	that1, ok := that.(*ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility)
 	if !ok {
-		that2, ok := that.(StreamWorkflowReplicationMessagesRequest)
+		that2, ok := that.(ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5330,25 +5704,27 @@ func (this *StreamWorkflowReplicationMessagesRequest) Equal(that interface{}) bo
 	} else if this == nil {
 		return false
 	}
-	if that1.Attributes == nil {
-		if this.Attributes != nil {
-			return false
-		}
-	} else if this.Attributes == nil {
+	if this.TaskQueue != that1.TaskQueue {
 		return false
-	} else if !this.Attributes.Equal(that1.Attributes) {
+	}
+	if len(this.MajorVersionSets) != len(that1.MajorVersionSets) {
 		return false
 	}
+	for i := range this.MajorVersionSets {
+		if !this.MajorVersionSets[i].Equal(that1.MajorVersionSets[i]) {
+			return false
+		}
+	}
 	return true
 }
-func (this *StreamWorkflowReplicationMessagesRequest_SyncReplicationState) Equal(that interface{}) bool {
+func (this *DeleteWorkflowExecutionRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*StreamWorkflowReplicationMessagesRequest_SyncReplicationState)
+	that1, ok := that.(*DeleteWorkflowExecutionRequest)
 	if !ok {
-		that2, ok := that.(StreamWorkflowReplicationMessagesRequest_SyncReplicationState)
+		that2, ok := that.(DeleteWorkflowExecutionRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -5360,7 +5736,93 @@ func (this *StreamWorkflowReplicationMessagesRequest_SyncReplicationState) Equal
 	} else if this == nil {
 		return false
 	}
-	if !this.SyncReplicationState.Equal(that1.SyncReplicationState) {
+	if this.Namespace != that1.Namespace {
+		return false
+	}
+	if !this.Execution.Equal(that1.Execution) {
+		return false
+	}
+	return true
+}
+func (this *DeleteWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeleteWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(DeleteWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Warnings) != len(that1.Warnings) {
+		return false
+	}
+	for i := range this.Warnings {
+		if this.Warnings[i] != that1.Warnings[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *StreamWorkflowReplicationMessagesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StreamWorkflowReplicationMessagesRequest)
+	if !ok {
+		that2, ok := that.(StreamWorkflowReplicationMessagesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.Attributes == nil {
+		if this.Attributes != nil {
+			return false
+		}
+	} else if this.Attributes == nil {
+		return false
+	} else if !this.Attributes.Equal(that1.Attributes) {
+		return false
+	}
+	return true
+}
+func (this *StreamWorkflowReplicationMessagesRequest_SyncReplicationState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StreamWorkflowReplicationMessagesRequest_SyncReplicationState)
+	if !ok {
+		that2, ok := that.(StreamWorkflowReplicationMessagesRequest_SyncReplicationState)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.SyncReplicationState.Equal(that1.SyncReplicationState) {
 		return false
 	}
 	return true
@@ -5609,6 +6071,29 @@ func (this *RemoveTaskResponse) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *DescribeHistoryQueueRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.DescribeHistoryQueueRequest{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "Category: "+fmt.Sprintf("%#v", this.Category)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DescribeHistoryQueueResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&adminservice.DescribeHistoryQueueResponse{")
+	if this.Queues != nil {
+		s = append(s, "Queues: "+fmt.Sprintf("%#v", this.Queues)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *GetWorkflowExecutionRawHistoryV2Request) GoString() string {
 	if this == nil {
 		return "nil"
@@ -6028,7 +6513,7 @@ func (this *GetDLQMessagesResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 9)
 	s = append(s, "&adminservice.GetDLQMessagesResponse{")
 	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
 	if this.ReplicationTasks != nil {
@@ -6038,6 +6523,9 @@ func (this *GetDLQMessagesResponse) GoString() string {
 	if this.ReplicationTasksInfo != nil {
 		s = append(s, "ReplicationTasksInfo: "+fmt.Sprintf("%#v", this.ReplicationTasksInfo)+",\n")
 	}
+	if this.HistoryTasks != nil {
+		s = append(s, "HistoryTasks: "+fmt.Sprintf("%#v", this.HistoryTasks)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -6165,6 +6653,45 @@ func (this *GetTaskQueueTasksResponse) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *ListWorkerBuildIdCompatibilityRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&adminservice.ListWorkerBuildIdCompatibilityRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "PageSize: "+fmt.Sprintf("%#v", this.PageSize)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "MaxSets: "+fmt.Sprintf("%#v", this.MaxSets)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListWorkerBuildIdCompatibilityResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.ListWorkerBuildIdCompatibilityResponse{")
+	if this.TaskQueues != nil {
+		s = append(s, "TaskQueues: "+fmt.Sprintf("%#v", this.TaskQueues)+",\n")
+	}
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&adminservice.ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility{")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	if this.MajorVersionSets != nil {
+		s = append(s, "MajorVersionSets: "+fmt.Sprintf("%#v", this.MajorVersionSets)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *DeleteWorkflowExecutionRequest) GoString() string {
 	if this == nil {
 		return "nil"
@@ -6879,6 +7406,76 @@ func (m *RemoveTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DescribeHistoryQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeHistoryQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeHistoryQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Category != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Category))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeHistoryQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeHistoryQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeHistoryQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Queues) > 0 {
+		for iNdEx := len(m.Queues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GetWorkflowExecutionRawHistoryV2Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8172,6 +8769,20 @@ func (m *GetDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.HistoryTasks) > 0 {
+		for iNdEx := len(m.HistoryTasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HistoryTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.ReplicationTasksInfo) > 0 {
 		for iNdEx := len(m.ReplicationTasksInfo) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -8637,7 +9248,7 @@ func (m *GetTaskQueueTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *DeleteWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListWorkerBuildIdCompatibilityRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8647,27 +9258,32 @@ func (m *DeleteWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListWorkerBuildIdCompatibilityRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeleteWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListWorkerBuildIdCompatibilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Execution != nil {
-		{
-			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
+	if m.MaxSets != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaxSets))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x20
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x10
 	}
 	if len(m.Namespace) > 0 {
 		i -= len(m.Namespace)
@@ -8679,7 +9295,7 @@ func (m *DeleteWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *DeleteWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+func (m *ListWorkerBuildIdCompatibilityResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8689,21 +9305,33 @@ func (m *DeleteWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListWorkerBuildIdCompatibilityResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeleteWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListWorkerBuildIdCompatibilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Warnings) > 0 {
-		for iNdEx := len(m.Warnings) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Warnings[iNdEx])
-			copy(dAtA[i:], m.Warnings[iNdEx])
-			i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Warnings[iNdEx])))
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskQueues) > 0 {
+		for iNdEx := len(m.TaskQueues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskQueues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0xa
 		}
@@ -8711,7 +9339,7 @@ func (m *DeleteWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *StreamWorkflowReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8721,12 +9349,130 @@ func (m *StreamWorkflowReplicationMessagesRequest) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *StreamWorkflowReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StreamWorkflowReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MajorVersionSets) > 0 {
+		for iNdEx := len(m.MajorVersionSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MajorVersionSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Warnings) > 0 {
+		for iNdEx := len(m.Warnings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Warnings[iNdEx])
+			copy(dAtA[i:], m.Warnings[iNdEx])
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Warnings[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamWorkflowReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamWorkflowReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamWorkflowReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9103,6 +9849,36 @@ func (m *RemoveTaskResponse) Size() (n int) {
 	return n
 }
 
+func (m *DescribeHistoryQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	if m.Category != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Category))
+	}
+	return n
+}
+
+func (m *DescribeHistoryQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Queues) > 0 {
+		for _, e := range m.Queues {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *GetWorkflowExecutionRawHistoryV2Request) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9685,6 +10461,12 @@ func (m *GetDLQMessagesResponse) Size() (n int) {
 			n += 1 + l + sovRequestResponse(uint64(l))
 		}
 	}
+	if len(m.HistoryTasks) > 0 {
+		for _, e := range m.HistoryTasks {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -9885,6 +10667,67 @@ func (m *GetTaskQueueTasksResponse) Size() (n int) {
 	return n
 }
 
+func (m *ListWorkerBuildIdCompatibilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.PageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.MaxSets != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MaxSets))
+	}
+	return n
+}
+
+func (m *ListWorkerBuildIdCompatibilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaskQueues) > 0 {
+		for _, e := range m.TaskQueues {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if len(m.MajorVersionSets) > 0 {
+		for _, e := range m.MajorVersionSets {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *DeleteWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10149,6 +10992,32 @@ func (this *RemoveTaskResponse) String() string {
 	}, "")
 	return s
 }
+func (this *DescribeHistoryQueueRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeHistoryQueueRequest{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`Category:` + fmt.Sprintf("%v", this.Category) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeHistoryQueueResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForQueues := "[]*HistoryQueueInfo{"
+	for _, f := range this.Queues {
+		repeatedStringForQueues += strings.Replace(fmt.Sprintf("%v", f), "HistoryQueueInfo", "v14.HistoryQueueInfo", 1) + ","
+	}
+	repeatedStringForQueues += "}"
+	s := strings.Join([]string{`&DescribeHistoryQueueResponse{`,
+		`Queues:` + repeatedStringForQueues + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GetWorkflowExecutionRawHistoryV2Request) String() string {
 	if this == nil {
 		return "nil"
@@ -10568,11 +11437,17 @@ func (this *GetDLQMessagesResponse) String() string {
 		repeatedStringForReplicationTasksInfo += strings.Replace(fmt.Sprintf("%v", f), "ReplicationTaskInfo", "v15.ReplicationTaskInfo", 1) + ","
 	}
 	repeatedStringForReplicationTasksInfo += "}"
+	repeatedStringForHistoryTasks := "[]*HistoryTaskDLQMessage{"
+	for _, f := range this.HistoryTasks {
+		repeatedStringForHistoryTasks += strings.Replace(fmt.Sprintf("%v", f), "HistoryTaskDLQMessage", "v11.HistoryTaskDLQMessage", 1) + ","
+	}
+	repeatedStringForHistoryTasks += "}"
 	s := strings.Join([]string{`&GetDLQMessagesResponse{`,
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
 		`ReplicationTasks:` + repeatedStringForReplicationTasks + `,`,
 		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
 		`ReplicationTasksInfo:` + repeatedStringForReplicationTasksInfo + `,`,
+		`HistoryTasks:` + repeatedStringForHistoryTasks + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10702,6 +11577,51 @@ func (this *GetTaskQueueTasksResponse) String() string {
 	}, "")
 	return s
 }
+func (this *ListWorkerBuildIdCompatibilityRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListWorkerBuildIdCompatibilityRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`PageSize:` + fmt.Sprintf("%v", this.PageSize) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`MaxSets:` + fmt.Sprintf("%v", this.MaxSets) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListWorkerBuildIdCompatibilityResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTaskQueues := "[]*ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility{"
+	for _, f := range this.TaskQueues {
+		repeatedStringForTaskQueues += strings.Replace(fmt.Sprintf("%v", f), "ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility", "ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility", 1) + ","
+	}
+	repeatedStringForTaskQueues += "}"
+	s := strings.Join([]string{`&ListWorkerBuildIdCompatibilityResponse{`,
+		`TaskQueues:` + repeatedStringForTaskQueues + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMajorVersionSets := "[]*CompatibleVersionSet{"
+	for _, f := range this.MajorVersionSets {
+		repeatedStringForMajorVersionSets += strings.Replace(fmt.Sprintf("%v", f), "CompatibleVersionSet", "v110.CompatibleVersionSet", 1) + ","
+	}
+	repeatedStringForMajorVersionSets += "}"
+	s := strings.Join([]string{`&ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility{`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`MajorVersionSets:` + repeatedStringForMajorVersionSets + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *DeleteWorkflowExecutionRequest) String() string {
 	if this == nil {
 		return "nil"
@@ -12671,7 +13591,7 @@ func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
+func (m *DescribeHistoryQueueRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12694,17 +13614,17 @@ func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeHistoryQueueRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeHistoryQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			var msglen int
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -12714,7 +13634,185 @@ func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			m.Category = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Category |= v13.TaskCategory(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeHistoryQueueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeHistoryQueueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeHistoryQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queues = append(m.Queues, &v14.HistoryQueueInfo{})
+			if err := m.Queues[len(m.Queues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -16925,6 +18023,40 @@ func (m *GetDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryTasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HistoryTasks = append(m.HistoryTasks, &v11.HistoryTaskDLQMessage{})
+			if err := m.HistoryTasks[len(m.HistoryTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -18258,6 +19390,403 @@ func (m *GetTaskQueueTasksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ListWorkerBuildIdCompatibilityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListWorkerBuildIdCompatibilityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListWorkerBuildIdCompatibilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSets", wireType)
+			}
+			m.MaxSets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSets |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListWorkerBuildIdCompatibilityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListWorkerBuildIdCompatibilityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListWorkerBuildIdCompatibilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskQueues = append(m.TaskQueues, &ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility{})
+			if err := m.TaskQueues[len(m.TaskQueues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskQueueBuildIdCompatibility: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskQueueBuildIdCompatibility: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MajorVersionSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MajorVersionSets = append(m.MajorVersionSets, &v110.CompatibleVersionSet{})
+			if err := m.MajorVersionSets[len(m.MajorVersionSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DeleteWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
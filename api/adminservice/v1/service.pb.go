@@ -54,63 +54,67 @@ func init() {
 }
 
 var fileDescriptor_cf5ca5e0c737570d = []byte{
-	// 895 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0x4d, 0x6f, 0xd3, 0x48,
-	0x18, 0xc7, 0x33, 0x97, 0xd5, 0x6a, 0xd4, 0x7d, 0xf3, 0xae, 0xf6, 0xa5, 0x07, 0xef, 0x6e, 0xf7,
-	0xb2, 0xa7, 0x84, 0x16, 0x28, 0xf4, 0xbd, 0x69, 0x12, 0x52, 0x89, 0xa4, 0xd0, 0x84, 0x17, 0x89,
-	0x0b, 0x9a, 0xc4, 0x4f, 0x5b, 0xab, 0x4e, 0x6c, 0x66, 0xc6, 0x29, 0x3d, 0xc1, 0x05, 0x09, 0x09,
-	0x09, 0x81, 0x84, 0x84, 0x84, 0xc4, 0x09, 0x09, 0x81, 0xc4, 0x67, 0x40, 0xea, 0xad, 0xc7, 0x1e,
-	0x7b, 0xa4, 0xe9, 0x85, 0x63, 0x3f, 0x02, 0x72, 0x9d, 0x99, 0xda, 0xc9, 0x50, 0xc6, 0x4e, 0x6f,
-	0x4d, 0x3d, 0xbf, 0xff, 0xfc, 0xfc, 0xc4, 0x33, 0xcf, 0x38, 0x78, 0x9c, 0x43, 0xcb, 0x73, 0x29,
-	0x71, 0x72, 0x0c, 0x68, 0x07, 0x68, 0x8e, 0x78, 0x76, 0x8e, 0x58, 0x2d, 0xbb, 0x1d, 0x7c, 0xb6,
-	0x9b, 0x90, 0xeb, 0x8c, 0xe7, 0x7a, 0x7f, 0x66, 0x3d, 0xea, 0x72, 0xd7, 0xf8, 0x4f, 0x20, 0xd9,
-	0x10, 0xc9, 0x12, 0xcf, 0xce, 0x46, 0x91, 0x6c, 0x67, 0x7c, 0x74, 0x5a, 0x27, 0x97, 0xc2, 0x3d,
-	0x1f, 0x18, 0xbf, 0x4b, 0x81, 0x79, 0x6e, 0x9b, 0xf5, 0x26, 0x98, 0xd8, 0x19, 0xc3, 0x23, 0xf9,
-	0x60, 0x68, 0x3d, 0x1c, 0x6a, 0xbc, 0x42, 0xf8, 0xd7, 0x1a, 0x34, 0x7c, 0xdb, 0xb1, 0xaa, 0x3e,
-	0x27, 0x0d, 0x07, 0xea, 0x9c, 0x70, 0x30, 0x16, 0xb2, 0x1a, 0x2a, 0x59, 0x05, 0x59, 0x0b, 0x27,
-	0x1e, 0x5d, 0x4c, 0x1f, 0x10, 0x1a, 0x8f, 0x65, 0x8c, 0xd7, 0x08, 0xff, 0x56, 0x04, 0xd6, 0xa4,
-	0x76, 0x03, 0x62, 0x76, 0x7a, 0xe1, 0x2a, 0x54, 0xe8, 0xe5, 0x87, 0x48, 0x90, 0x7e, 0x41, 0xf1,
-	0xc4, 0x90, 0x65, 0x9b, 0x71, 0x97, 0x6e, 0x2f, 0xbb, 0x8c, 0x6b, 0x16, 0x4f, 0x41, 0x26, 0x2b,
-	0x9e, 0x32, 0x40, 0xca, 0x6d, 0xe3, 0xef, 0xcb, 0xc0, 0xeb, 0x1b, 0x84, 0x5a, 0xc6, 0x05, 0xad,
-	0x3c, 0x31, 0x5c, 0x58, 0x5c, 0x4c, 0x48, 0xc9, 0xa9, 0x1f, 0x60, 0x5c, 0x70, 0x5c, 0x06, 0xe1,
-	0xe4, 0x93, 0x5a, 0x31, 0x27, 0x80, 0x98, 0xfe, 0x52, 0x62, 0x4e, 0x0a, 0x3c, 0x47, 0xf8, 0xe7,
-	0x8a, 0xcd, 0x78, 0xaf, 0x32, 0x37, 0x08, 0xdb, 0x64, 0xc6, 0xac, 0x56, 0x5e, 0x3f, 0x26, 0x6c,
-	0xe6, 0x52, 0xd2, 0xd1, 0xa2, 0xd4, 0xa0, 0xe5, 0x76, 0x20, 0xb8, 0xa0, 0x59, 0x94, 0x13, 0x20,
-	0x59, 0x51, 0xa2, 0x9c, 0x14, 0xd8, 0x41, 0xf8, 0x9f, 0x32, 0xf0, 0xdb, 0x2e, 0xdd, 0x5c, 0x73,
-	0xdc, 0xad, 0xd2, 0x7d, 0x68, 0xfa, 0xdc, 0x76, 0xdb, 0x35, 0xb2, 0xd5, 0x53, 0xbe, 0x35, 0x61,
-	0x54, 0x74, 0xbf, 0xf3, 0x53, 0x63, 0x84, 0x6d, 0xf5, 0x8c, 0xd2, 0xe4, 0x3d, 0xbc, 0x41, 0xf8,
-	0xf7, 0x32, 0xf0, 0x1a, 0x78, 0x8e, 0xdd, 0x24, 0xc1, 0xc0, 0x2a, 0x30, 0x46, 0xd6, 0x81, 0x19,
-	0x4b, 0xba, 0x73, 0x29, 0x60, 0xe1, 0x5b, 0x18, 0x2a, 0x43, 0x5a, 0x7e, 0x44, 0xf8, 0xef, 0x32,
-	0xf0, 0x15, 0xd2, 0x02, 0xe6, 0x91, 0x26, 0xa8, 0x74, 0xaf, 0xea, 0x4e, 0x75, 0x5a, 0x8a, 0xf0,
-	0xae, 0x9c, 0x4d, 0x98, 0xbc, 0x81, 0x0f, 0x08, 0xff, 0x55, 0x06, 0x5e, 0xac, 0xac, 0xaa, 0xd4,
-	0x4b, 0xba, 0xb3, 0xa9, 0x79, 0x21, 0x7d, 0x65, 0xd8, 0x18, 0xa9, 0xfb, 0x18, 0xe1, 0x1f, 0x6a,
-	0x40, 0x3c, 0xcf, 0xd9, 0x2e, 0x75, 0xa0, 0xcd, 0x99, 0x31, 0xa5, 0xb9, 0x4c, 0x22, 0x8c, 0xd0,
-	0x9a, 0x4e, 0x83, 0xc6, 0x5a, 0x42, 0xde, 0xb2, 0xea, 0x40, 0x68, 0x73, 0x23, 0xcf, 0x39, 0xb5,
-	0x1b, 0x3e, 0x07, 0xa6, 0xd9, 0x12, 0x14, 0x64, 0xb2, 0x96, 0xa0, 0x0c, 0x88, 0xad, 0x9e, 0x70,
-	0x6b, 0x18, 0xf0, 0x5b, 0x4a, 0xb0, 0xaf, 0x7c, 0x4d, 0xb1, 0x30, 0x54, 0x46, 0xac, 0x84, 0x41,
-	0x53, 0x49, 0x57, 0x42, 0x05, 0x99, 0xac, 0x84, 0xca, 0x00, 0x29, 0xf7, 0x14, 0xe1, 0x9f, 0x44,
-	0xdf, 0x2d, 0x38, 0x3e, 0xe3, 0x40, 0x8d, 0x99, 0x44, 0xdd, 0xba, 0x47, 0x09, 0xa9, 0xd9, 0x74,
-	0xb0, 0x14, 0x7a, 0x84, 0xf0, 0x48, 0xd0, 0x75, 0x7a, 0x57, 0x98, 0x71, 0x59, 0xbb, 0x51, 0x09,
-	0x44, 0xa8, 0x4c, 0xa5, 0x20, 0xa5, 0xc7, 0x4b, 0x84, 0x8d, 0xc8, 0xa5, 0x2a, 0xb4, 0x1a, 0x81,
-	0xcd, 0x7c, 0xd2, 0xcc, 0x1e, 0x28, 0x9c, 0x16, 0x52, 0xf3, 0xd2, 0xec, 0x3d, 0xc2, 0x7f, 0xe6,
-	0x2d, 0xeb, 0x1a, 0xbd, 0xe9, 0x59, 0xc7, 0xe7, 0xb7, 0x96, 0xcb, 0xe5, 0x77, 0x57, 0xd4, 0x5d,
-	0x56, 0x4a, 0x5c, 0x58, 0x96, 0x86, 0x4c, 0x89, 0x3d, 0xfb, 0xe1, 0x02, 0x89, 0x6b, 0x2e, 0x24,
-	0x58, 0x5a, 0x4a, 0xc3, 0xc5, 0xf4, 0x01, 0x52, 0xee, 0x09, 0xc2, 0x3f, 0x86, 0xdb, 0xb1, 0x6c,
-	0x05, 0xd3, 0x09, 0xf6, 0xf0, 0xfe, 0xfd, 0x7f, 0x26, 0x15, 0x1b, 0x3b, 0xe3, 0x5d, 0xf7, 0xe9,
-	0x3a, 0x44, 0x7d, 0xf4, 0x56, 0x53, 0x3f, 0x96, 0xec, 0x8c, 0x37, 0x48, 0xc7, 0x9c, 0xaa, 0x90,
-	0xca, 0xa9, 0x1f, 0x4b, 0xe6, 0x34, 0x48, 0xc7, 0x5e, 0xa2, 0x6a, 0xb0, 0x46, 0x81, 0x6d, 0x88,
-	0x53, 0x56, 0x78, 0x1e, 0xd6, 0x7d, 0x24, 0x06, 0xd1, 0x64, 0x2f, 0x51, 0xea, 0x84, 0xbe, 0xa6,
-	0xc4, 0xa0, 0x6d, 0x45, 0x9a, 0x7c, 0x68, 0xa8, 0xdb, 0x94, 0x54, 0x70, 0xd2, 0xa6, 0xa4, 0xce,
-	0x90, 0x96, 0x2f, 0x10, 0xfe, 0xa5, 0x0c, 0x3c, 0xf8, 0xf7, 0xaa, 0x0f, 0x3e, 0x84, 0x82, 0x73,
-	0xba, 0x8f, 0x70, 0x9c, 0x13, 0x6e, 0xf3, 0x69, 0x71, 0xa9, 0xf5, 0x16, 0xe1, 0x3f, 0x8a, 0xe0,
-	0x00, 0x87, 0x81, 0x13, 0xb4, 0x51, 0xd0, 0xec, 0x2c, 0x4a, 0x5a, 0x28, 0x16, 0x87, 0x0b, 0x91,
-	0xa2, 0xbb, 0x08, 0xff, 0x5b, 0xe7, 0x14, 0x48, 0x4b, 0x8c, 0x52, 0x9d, 0x2c, 0xf5, 0xde, 0x17,
-	0xbe, 0x99, 0x23, 0xe4, 0x57, 0xce, 0x2a, 0x4e, 0xdc, 0xc6, 0xff, 0xe8, 0x1c, 0x5a, 0x72, 0xf6,
-	0x0e, 0xcc, 0xcc, 0xfe, 0x81, 0x99, 0x39, 0x3a, 0x30, 0xd1, 0xc3, 0xae, 0x89, 0xde, 0x75, 0x4d,
-	0xb4, 0xdb, 0x35, 0xd1, 0x5e, 0xd7, 0x44, 0x9f, 0xba, 0x26, 0xfa, 0xdc, 0x35, 0x33, 0x47, 0x5d,
-	0x13, 0x3d, 0x3b, 0x34, 0x33, 0x7b, 0x87, 0x66, 0x66, 0xff, 0xd0, 0xcc, 0xdc, 0x99, 0x5c, 0x77,
-	0x4f, 0x6c, 0x6c, 0xf7, 0x94, 0xdf, 0x6e, 0x66, 0xa2, 0x9f, 0x1b, 0xdf, 0x1d, 0xff, 0x70, 0x73,
-	0xfe, 0x4b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1f, 0xf7, 0xa0, 0x23, 0x4e, 0x12, 0x00, 0x00,
+	// 949 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0xcb, 0x6f, 0xe3, 0x44,
+	0x1c, 0xc7, 0x33, 0x17, 0x84, 0x46, 0xe5, 0x65, 0x10, 0x8f, 0x1e, 0xcc, 0x4b, 0x48, 0x9c, 0x12,
+	0x5a, 0xa0, 0xd0, 0x77, 0xf3, 0x22, 0x85, 0x26, 0x85, 0x26, 0x3c, 0x24, 0x2e, 0x68, 0x12, 0xff,
+	0xda, 0x5a, 0xb5, 0x63, 0x33, 0x33, 0x4e, 0xc9, 0x09, 0x2e, 0x48, 0x48, 0x48, 0x08, 0x24, 0x24,
+	0x24, 0x24, 0xc4, 0x01, 0x09, 0x81, 0xb4, 0xff, 0xc1, 0x4a, 0x2b, 0xed, 0xad, 0xc7, 0x1e, 0x7b,
+	0xdc, 0xa6, 0x97, 0x3d, 0xf6, 0x4f, 0x58, 0xb9, 0xf6, 0x4c, 0xed, 0x64, 0xda, 0x1d, 0x3b, 0xb9,
+	0x35, 0xf5, 0x7c, 0xbe, 0xf3, 0xf1, 0x2f, 0x9e, 0xf9, 0x4d, 0x8c, 0x17, 0x38, 0xb8, 0xbe, 0x47,
+	0x89, 0x53, 0x62, 0x40, 0x07, 0x40, 0x4b, 0xc4, 0xb7, 0x4b, 0xc4, 0x72, 0xed, 0x7e, 0xf8, 0xd9,
+	0xee, 0x41, 0x69, 0xb0, 0x50, 0x8a, 0xff, 0x2c, 0xfa, 0xd4, 0xe3, 0x9e, 0xf1, 0xa6, 0x40, 0x8a,
+	0x11, 0x52, 0x24, 0xbe, 0x5d, 0x4c, 0x22, 0xc5, 0xc1, 0xc2, 0xfc, 0x8a, 0x4e, 0x2e, 0x85, 0x6f,
+	0x03, 0x60, 0xfc, 0x1b, 0x0a, 0xcc, 0xf7, 0xfa, 0x2c, 0x9e, 0x60, 0xf1, 0xef, 0xb7, 0xf0, 0x5c,
+	0x39, 0x1c, 0xda, 0x89, 0x86, 0x1a, 0x7f, 0x22, 0xfc, 0x7c, 0x1b, 0xba, 0x81, 0xed, 0x58, 0xad,
+	0x80, 0x93, 0xae, 0x03, 0x1d, 0x4e, 0x38, 0x18, 0x9b, 0x45, 0x0d, 0x95, 0xa2, 0x82, 0x6c, 0x47,
+	0x13, 0xcf, 0x6f, 0xe5, 0x0f, 0x88, 0x8c, 0xdf, 0x28, 0x18, 0x7f, 0x21, 0xfc, 0x42, 0x0d, 0x58,
+	0x8f, 0xda, 0x5d, 0x48, 0xd9, 0xe9, 0x85, 0xab, 0x50, 0xa1, 0x57, 0x9e, 0x22, 0x41, 0xfa, 0x85,
+	0xc5, 0x13, 0x43, 0xb6, 0x6d, 0xc6, 0x3d, 0x3a, 0xdc, 0xf6, 0x18, 0xd7, 0x2c, 0x9e, 0x82, 0xcc,
+	0x56, 0x3c, 0x65, 0x80, 0x94, 0x1b, 0xe2, 0x27, 0x1b, 0xc0, 0x3b, 0x87, 0x84, 0x5a, 0xc6, 0x7b,
+	0x5a, 0x79, 0x62, 0xb8, 0xb0, 0x78, 0x3f, 0x23, 0x25, 0xa7, 0xfe, 0x1e, 0xe3, 0xaa, 0xe3, 0x31,
+	0x88, 0x26, 0x5f, 0xd2, 0x8a, 0xb9, 0x06, 0xc4, 0xf4, 0x1f, 0x64, 0xe6, 0xa4, 0xc0, 0x6f, 0x08,
+	0x3f, 0xdb, 0xb4, 0x19, 0x8f, 0x2b, 0xf3, 0x39, 0x61, 0x47, 0xcc, 0x58, 0xd3, 0xca, 0x1b, 0xc7,
+	0x84, 0xcd, 0x7a, 0x4e, 0x3a, 0x59, 0x94, 0x36, 0xb8, 0xde, 0x00, 0xc2, 0x0b, 0x9a, 0x45, 0xb9,
+	0x06, 0xb2, 0x15, 0x25, 0xc9, 0x29, 0x57, 0x53, 0xec, 0xb8, 0x17, 0x40, 0x90, 0x75, 0x35, 0x25,
+	0xd1, 0x7c, 0xab, 0x29, 0x9d, 0x20, 0xfd, 0xee, 0x23, 0xfc, 0x5a, 0x03, 0xf8, 0x57, 0x1e, 0x3d,
+	0xda, 0x77, 0xbc, 0xe3, 0xfa, 0x77, 0xd0, 0x0b, 0xb8, 0xed, 0xf5, 0xdb, 0xe4, 0x38, 0x26, 0xbe,
+	0x5c, 0x34, 0x9a, 0xba, 0xcf, 0xe4, 0xad, 0x31, 0xc2, 0xbb, 0x35, 0xa3, 0x34, 0x79, 0x0f, 0xff,
+	0x20, 0xfc, 0x62, 0x03, 0x78, 0x1b, 0x7c, 0xc7, 0xee, 0x91, 0x70, 0x60, 0x0b, 0x18, 0x23, 0x07,
+	0xc0, 0x8c, 0x8a, 0xee, 0x5c, 0x0a, 0x58, 0xf8, 0x56, 0xa7, 0xca, 0x90, 0x96, 0xf7, 0x10, 0x7e,
+	0xb5, 0x01, 0x7c, 0x97, 0xb8, 0xc0, 0x7c, 0xd2, 0x03, 0x95, 0xee, 0x8e, 0xee, 0x54, 0xb7, 0xa5,
+	0x08, 0xef, 0xe6, 0x6c, 0xc2, 0xe4, 0x0d, 0xdc, 0x41, 0xf8, 0x95, 0x06, 0xf0, 0x5a, 0x73, 0x4f,
+	0xa5, 0x5e, 0xd7, 0x9d, 0x4d, 0xcd, 0x0b, 0xe9, 0x8f, 0xa6, 0x8d, 0x91, 0xba, 0x3f, 0x21, 0xfc,
+	0x54, 0x1b, 0x88, 0xef, 0x3b, 0xc3, 0xfa, 0x00, 0xfa, 0x9c, 0x19, 0xcb, 0x9a, 0xcb, 0x38, 0xc1,
+	0x08, 0xad, 0x95, 0x3c, 0x68, 0xaa, 0x65, 0x95, 0x2d, 0xab, 0x03, 0x84, 0xf6, 0x0e, 0xcb, 0x9c,
+	0x53, 0xbb, 0x1b, 0x70, 0x60, 0x9a, 0x2d, 0x4b, 0x41, 0x66, 0x6b, 0x59, 0xca, 0x80, 0xd4, 0xea,
+	0x89, 0xb6, 0xae, 0x09, 0xbf, 0x4a, 0x86, 0x7d, 0xef, 0x26, 0xc5, 0xea, 0x54, 0x19, 0xa9, 0x12,
+	0x86, 0x4d, 0x2f, 0x5f, 0x09, 0x15, 0x64, 0xb6, 0x12, 0x2a, 0x03, 0xa4, 0xdc, 0x2f, 0x08, 0x3f,
+	0x23, 0xf6, 0xd9, 0xaa, 0x13, 0x30, 0x0e, 0xd4, 0x58, 0xcd, 0xb4, 0x3b, 0xc7, 0x94, 0x90, 0x5a,
+	0xcb, 0x07, 0x4b, 0xa1, 0x1f, 0x11, 0x9e, 0x0b, 0xbb, 0x62, 0x7c, 0x85, 0x19, 0x1f, 0x6a, 0x37,
+	0x52, 0x81, 0x08, 0x95, 0xe5, 0x1c, 0xa4, 0xf4, 0xf8, 0x03, 0x61, 0x23, 0x71, 0xa9, 0x05, 0x6e,
+	0x37, 0xb4, 0xd9, 0xc8, 0x9a, 0x19, 0x83, 0xc2, 0x69, 0x33, 0x37, 0x2f, 0xcd, 0xfe, 0x47, 0xf8,
+	0xe5, 0xb2, 0x65, 0x7d, 0x4a, 0xbf, 0xf0, 0xad, 0xab, 0xf3, 0xa5, 0xeb, 0x71, 0xf9, 0xdd, 0xd5,
+	0x74, 0x97, 0x95, 0x12, 0x17, 0x96, 0xf5, 0x29, 0x53, 0x52, 0xcf, 0x7e, 0xb4, 0x40, 0xd2, 0x9a,
+	0x9b, 0x19, 0x96, 0x96, 0xd2, 0x70, 0x2b, 0x7f, 0x80, 0x94, 0xfb, 0x19, 0xe1, 0xa7, 0xa3, 0xed,
+	0x58, 0xb6, 0x82, 0x95, 0x0c, 0x7b, 0xf8, 0xf8, 0xfe, 0xbf, 0x9a, 0x8b, 0x4d, 0x9d, 0x41, 0x3f,
+	0x0b, 0xe8, 0x01, 0x24, 0x7d, 0xf4, 0x56, 0xd3, 0x38, 0x96, 0xed, 0x0c, 0x3a, 0x49, 0xa7, 0x9c,
+	0x5a, 0x90, 0xcb, 0xa9, 0x05, 0xd3, 0x38, 0xb5, 0xe0, 0x46, 0xa7, 0xf0, 0x58, 0xda, 0x86, 0x7d,
+	0x0a, 0xec, 0x50, 0x9c, 0xb2, 0xa2, 0xf3, 0xba, 0xee, 0x23, 0x31, 0x89, 0x66, 0x3b, 0x96, 0xaa,
+	0x13, 0xc6, 0x9a, 0x12, 0x83, 0xbe, 0x95, 0x68, 0xf2, 0x91, 0xa1, 0x6e, 0x53, 0x52, 0xc1, 0x59,
+	0x9b, 0x92, 0x3a, 0x43, 0x5a, 0xfe, 0x8e, 0xf0, 0x73, 0x0d, 0xe0, 0xe1, 0xbf, 0xaf, 0xce, 0xd5,
+	0x91, 0xe0, 0xba, 0xee, 0x23, 0x9c, 0xe6, 0x84, 0xdb, 0x46, 0x5e, 0x5c, 0x6a, 0xdd, 0x45, 0xd8,
+	0x0c, 0x37, 0xbf, 0xb0, 0xb8, 0x40, 0x2b, 0xe1, 0x6f, 0xfd, 0x8f, 0xad, 0xaa, 0xe7, 0xfa, 0x84,
+	0xdb, 0x5d, 0xdb, 0xb1, 0xf9, 0xd0, 0xf8, 0x44, 0x7b, 0x07, 0xbd, 0x39, 0x44, 0x08, 0xef, 0xcc,
+	0x24, 0x4b, 0xda, 0xff, 0x8b, 0xf0, 0x4b, 0x35, 0x70, 0x80, 0xc3, 0xc4, 0xf9, 0xdf, 0xa8, 0x6a,
+	0xf6, 0x45, 0x25, 0x2d, 0x7c, 0x6b, 0xd3, 0x85, 0x48, 0xd1, 0x13, 0x84, 0x5f, 0xef, 0x70, 0x0a,
+	0xc4, 0x15, 0xa3, 0x54, 0xe7, 0x62, 0xbd, 0x5f, 0x3b, 0x8f, 0xcd, 0x11, 0xf2, 0xbb, 0xb3, 0x8a,
+	0x13, 0xb7, 0xf1, 0x36, 0x7a, 0x07, 0x55, 0x9c, 0xd3, 0x73, 0xb3, 0x70, 0x76, 0x6e, 0x16, 0x2e,
+	0xcf, 0x4d, 0xf4, 0xc3, 0xc8, 0x44, 0xff, 0x8d, 0x4c, 0x74, 0x32, 0x32, 0xd1, 0xe9, 0xc8, 0x44,
+	0x0f, 0x46, 0x26, 0x7a, 0x38, 0x32, 0x0b, 0x97, 0x23, 0x13, 0xfd, 0x7a, 0x61, 0x16, 0x4e, 0x2f,
+	0xcc, 0xc2, 0xd9, 0x85, 0x59, 0xf8, 0x7a, 0xe9, 0xc0, 0xbb, 0xb6, 0xb1, 0xbd, 0x5b, 0xde, 0x8c,
+	0xad, 0x26, 0x3f, 0x77, 0x9f, 0xb8, 0x7a, 0x2d, 0xf6, 0xee, 0xa3, 0x01, 0x00, 0x58, 0x97, 0xef,
+	0x5a, 0xac, 0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -136,6 +140,9 @@ type AdminServiceClient interface {
 	CloseShard(ctx context.Context, in *CloseShardRequest, opts ...grpc.CallOption) (*CloseShardResponse, error)
 	ListHistoryTasks(ctx context.Context, in *ListHistoryTasksRequest, opts ...grpc.CallOption) (*ListHistoryTasksResponse, error)
 	RemoveTask(ctx context.Context, in *RemoveTaskRequest, opts ...grpc.CallOption) (*RemoveTaskResponse, error)
+	// DescribeHistoryQueue returns, for a shard, the reader watermarks, estimated backlog and oldest task age of
+	// its history task queues.
+	DescribeHistoryQueue(ctx context.Context, in *DescribeHistoryQueueRequest, opts ...grpc.CallOption) (*DescribeHistoryQueueResponse, error)
 	// Returns the raw history of specified workflow execution.  It fails with 'NotFound' if specified workflow
 	// execution in unknown to the service.
 	// StartEventId defines the beginning of the event to fetch. The first event is inclusive.
@@ -182,6 +189,8 @@ type AdminServiceClient interface {
 	ResendReplicationTasks(ctx context.Context, in *ResendReplicationTasksRequest, opts ...grpc.CallOption) (*ResendReplicationTasksResponse, error)
 	// GetTaskQueueTasks returns tasks from task queue.
 	GetTaskQueueTasks(ctx context.Context, in *GetTaskQueueTasksRequest, opts ...grpc.CallOption) (*GetTaskQueueTasksResponse, error)
+	// ListWorkerBuildIdCompatibility lists the compatible version sets of every versioned task queue of a namespace.
+	ListWorkerBuildIdCompatibility(ctx context.Context, in *ListWorkerBuildIdCompatibilityRequest, opts ...grpc.CallOption) (*ListWorkerBuildIdCompatibilityResponse, error)
 	// DeleteWorkflowExecution force deletes a workflow's visibility record, current & concrete execution record and history if possible
 	DeleteWorkflowExecution(ctx context.Context, in *DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*DeleteWorkflowExecutionResponse, error)
 	StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (AdminService_StreamWorkflowReplicationMessagesClient, error)
@@ -258,6 +267,15 @@ func (c *adminServiceClient) RemoveTask(ctx context.Context, in *RemoveTaskReque
 	return out, nil
 }
 
+func (c *adminServiceClient) DescribeHistoryQueue(ctx context.Context, in *DescribeHistoryQueueRequest, opts ...grpc.CallOption) (*DescribeHistoryQueueResponse, error) {
+	out := new(DescribeHistoryQueueResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DescribeHistoryQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) GetWorkflowExecutionRawHistoryV2(ctx context.Context, in *GetWorkflowExecutionRawHistoryV2Request, opts ...grpc.CallOption) (*GetWorkflowExecutionRawHistoryV2Response, error) {
 	out := new(GetWorkflowExecutionRawHistoryV2Response)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/GetWorkflowExecutionRawHistoryV2", in, out, opts...)
@@ -429,6 +447,15 @@ func (c *adminServiceClient) GetTaskQueueTasks(ctx context.Context, in *GetTaskQ
 	return out, nil
 }
 
+func (c *adminServiceClient) ListWorkerBuildIdCompatibility(ctx context.Context, in *ListWorkerBuildIdCompatibilityRequest, opts ...grpc.CallOption) (*ListWorkerBuildIdCompatibilityResponse, error) {
+	out := new(ListWorkerBuildIdCompatibilityResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/ListWorkerBuildIdCompatibility", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*DeleteWorkflowExecutionResponse, error) {
 	out := new(DeleteWorkflowExecutionResponse)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.adminservice.v1.AdminService/DeleteWorkflowExecution", in, out, opts...)
@@ -482,6 +509,9 @@ type AdminServiceServer interface {
 	CloseShard(context.Context, *CloseShardRequest) (*CloseShardResponse, error)
 	ListHistoryTasks(context.Context, *ListHistoryTasksRequest) (*ListHistoryTasksResponse, error)
 	RemoveTask(context.Context, *RemoveTaskRequest) (*RemoveTaskResponse, error)
+	// DescribeHistoryQueue returns, for a shard, the reader watermarks, estimated backlog and oldest task age of
+	// its history task queues.
+	DescribeHistoryQueue(context.Context, *DescribeHistoryQueueRequest) (*DescribeHistoryQueueResponse, error)
 	// Returns the raw history of specified workflow execution.  It fails with 'NotFound' if specified workflow
 	// execution in unknown to the service.
 	// StartEventId defines the beginning of the event to fetch. The first event is inclusive.
@@ -528,6 +558,8 @@ type AdminServiceServer interface {
 	ResendReplicationTasks(context.Context, *ResendReplicationTasksRequest) (*ResendReplicationTasksResponse, error)
 	// GetTaskQueueTasks returns tasks from task queue.
 	GetTaskQueueTasks(context.Context, *GetTaskQueueTasksRequest) (*GetTaskQueueTasksResponse, error)
+	// ListWorkerBuildIdCompatibility lists the compatible version sets of every versioned task queue of a namespace.
+	ListWorkerBuildIdCompatibility(context.Context, *ListWorkerBuildIdCompatibilityRequest) (*ListWorkerBuildIdCompatibilityResponse, error)
 	// DeleteWorkflowExecution force deletes a workflow's visibility record, current & concrete execution record and history if possible
 	DeleteWorkflowExecution(context.Context, *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error)
 	StreamWorkflowReplicationMessages(AdminService_StreamWorkflowReplicationMessagesServer) error
@@ -558,6 +590,9 @@ func (*UnimplementedAdminServiceServer) ListHistoryTasks(ctx context.Context, re
 func (*UnimplementedAdminServiceServer) RemoveTask(ctx context.Context, req *RemoveTaskRequest) (*RemoveTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveTask not implemented")
 }
+func (*UnimplementedAdminServiceServer) DescribeHistoryQueue(ctx context.Context, req *DescribeHistoryQueueRequest) (*DescribeHistoryQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeHistoryQueue not implemented")
+}
 func (*UnimplementedAdminServiceServer) GetWorkflowExecutionRawHistoryV2(ctx context.Context, req *GetWorkflowExecutionRawHistoryV2Request) (*GetWorkflowExecutionRawHistoryV2Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowExecutionRawHistoryV2 not implemented")
 }
@@ -615,6 +650,9 @@ func (*UnimplementedAdminServiceServer) ResendReplicationTasks(ctx context.Conte
 func (*UnimplementedAdminServiceServer) GetTaskQueueTasks(ctx context.Context, req *GetTaskQueueTasksRequest) (*GetTaskQueueTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTaskQueueTasks not implemented")
 }
+func (*UnimplementedAdminServiceServer) ListWorkerBuildIdCompatibility(ctx context.Context, req *ListWorkerBuildIdCompatibilityRequest) (*ListWorkerBuildIdCompatibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkerBuildIdCompatibility not implemented")
+}
 func (*UnimplementedAdminServiceServer) DeleteWorkflowExecution(ctx context.Context, req *DeleteWorkflowExecutionRequest) (*DeleteWorkflowExecutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkflowExecution not implemented")
 }
@@ -752,6 +790,24 @@ func _AdminService_RemoveTask_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_DescribeHistoryQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeHistoryQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeHistoryQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/DescribeHistoryQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeHistoryQueue(ctx, req.(*DescribeHistoryQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_GetWorkflowExecutionRawHistoryV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetWorkflowExecutionRawHistoryV2Request)
 	if err := dec(in); err != nil {
@@ -1094,6 +1150,24 @@ func _AdminService_GetTaskQueueTasks_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ListWorkerBuildIdCompatibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkerBuildIdCompatibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListWorkerBuildIdCompatibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.adminservice.v1.AdminService/ListWorkerBuildIdCompatibility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListWorkerBuildIdCompatibility(ctx, req.(*ListWorkerBuildIdCompatibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_DeleteWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteWorkflowExecutionRequest)
 	if err := dec(in); err != nil {
@@ -1170,6 +1244,10 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveTask",
 			Handler:    _AdminService_RemoveTask_Handler,
 		},
+		{
+			MethodName: "DescribeHistoryQueue",
+			Handler:    _AdminService_DescribeHistoryQueue_Handler,
+		},
 		{
 			MethodName: "GetWorkflowExecutionRawHistoryV2",
 			Handler:    _AdminService_GetWorkflowExecutionRawHistoryV2_Handler,
@@ -1246,6 +1324,10 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTaskQueueTasks",
 			Handler:    _AdminService_GetTaskQueueTasks_Handler,
 		},
+		{
+			MethodName: "ListWorkerBuildIdCompatibility",
+			Handler:    _AdminService_ListWorkerBuildIdCompatibility_Handler,
+		},
 		{
 			MethodName: "DeleteWorkflowExecution",
 			Handler:    _AdminService_DeleteWorkflowExecution_Handler,
@@ -181,6 +181,26 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeHistoryHost(ctx, in interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryHost", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeHistoryHost), varargs...)
 }
 
+// DescribeHistoryQueue mocks base method.
+func (m *MockAdminServiceClient) DescribeHistoryQueue(ctx context.Context, in *adminservice.DescribeHistoryQueueRequest, opts ...grpc.CallOption) (*adminservice.DescribeHistoryQueueResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeHistoryQueue", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeHistoryQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeHistoryQueue indicates an expected call of DescribeHistoryQueue.
+func (mr *MockAdminServiceClientMockRecorder) DescribeHistoryQueue(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryQueue", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeHistoryQueue), varargs...)
+}
+
 // DescribeMutableState mocks base method.
 func (m *MockAdminServiceClient) DescribeMutableState(ctx context.Context, in *adminservice.DescribeMutableStateRequest, opts ...grpc.CallOption) (*adminservice.DescribeMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -421,6 +441,26 @@ func (mr *MockAdminServiceClientMockRecorder) ListHistoryTasks(ctx, in interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ListHistoryTasks), varargs...)
 }
 
+// ListWorkerBuildIdCompatibility mocks base method.
+func (m *MockAdminServiceClient) ListWorkerBuildIdCompatibility(ctx context.Context, in *adminservice.ListWorkerBuildIdCompatibilityRequest, opts ...grpc.CallOption) (*adminservice.ListWorkerBuildIdCompatibilityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWorkerBuildIdCompatibility", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListWorkerBuildIdCompatibilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkerBuildIdCompatibility indicates an expected call of ListWorkerBuildIdCompatibility.
+func (mr *MockAdminServiceClientMockRecorder) ListWorkerBuildIdCompatibility(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkerBuildIdCompatibility", reflect.TypeOf((*MockAdminServiceClient)(nil).ListWorkerBuildIdCompatibility), varargs...)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) MergeDLQMessages(ctx context.Context, in *adminservice.MergeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -871,6 +911,21 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeHistoryHost(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryHost", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeHistoryHost), arg0, arg1)
 }
 
+// DescribeHistoryQueue mocks base method.
+func (m *MockAdminServiceServer) DescribeHistoryQueue(arg0 context.Context, arg1 *adminservice.DescribeHistoryQueueRequest) (*adminservice.DescribeHistoryQueueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeHistoryQueue", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeHistoryQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeHistoryQueue indicates an expected call of DescribeHistoryQueue.
+func (mr *MockAdminServiceServerMockRecorder) DescribeHistoryQueue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryQueue", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeHistoryQueue), arg0, arg1)
+}
+
 // DescribeMutableState mocks base method.
 func (m *MockAdminServiceServer) DescribeMutableState(arg0 context.Context, arg1 *adminservice.DescribeMutableStateRequest) (*adminservice.DescribeMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -1051,6 +1106,21 @@ func (mr *MockAdminServiceServerMockRecorder) ListHistoryTasks(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistoryTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ListHistoryTasks), arg0, arg1)
 }
 
+// ListWorkerBuildIdCompatibility mocks base method.
+func (m *MockAdminServiceServer) ListWorkerBuildIdCompatibility(arg0 context.Context, arg1 *adminservice.ListWorkerBuildIdCompatibilityRequest) (*adminservice.ListWorkerBuildIdCompatibilityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkerBuildIdCompatibility", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListWorkerBuildIdCompatibilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkerBuildIdCompatibility indicates an expected call of ListWorkerBuildIdCompatibility.
+func (mr *MockAdminServiceServerMockRecorder) ListWorkerBuildIdCompatibility(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkerBuildIdCompatibility", reflect.TypeOf((*MockAdminServiceServer)(nil).ListWorkerBuildIdCompatibility), arg0, arg1)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) MergeDLQMessages(arg0 context.Context, arg1 *adminservice.MergeDLQMessagesRequest) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
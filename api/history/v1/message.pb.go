@@ -42,6 +42,7 @@ import (
 	_ "github.com/gogo/protobuf/types"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	v1 "go.temporal.io/api/history/v1"
+	v11 "go.temporal.io/server/api/enums/v1"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -401,6 +402,139 @@ func (m *HistoryEventPointer) GetEventId() int64 {
 	return 0
 }
 
+// HistoryQueueInfo describes the processing progress of a history task queue of a shard.
+type HistoryQueueInfo struct {
+	Category v11.TaskCategory `protobuf:"varint,1,opt,name=category,proto3,enum=temporal.server.api.enums.v1.TaskCategory" json:"category,omitempty"`
+	// Tasks with key below this watermark have been loaded by the readers of the queue.
+	ExclusiveReaderHighWatermark *TaskKey                  `protobuf:"bytes,2,opt,name=exclusive_reader_high_watermark,json=exclusiveReaderHighWatermark,proto3" json:"exclusive_reader_high_watermark,omitempty"`
+	Readers                      []*HistoryQueueReaderInfo `protobuf:"bytes,3,rep,name=readers,proto3" json:"readers,omitempty"`
+}
+
+func (m *HistoryQueueInfo) Reset()      { *m = HistoryQueueInfo{} }
+func (*HistoryQueueInfo) ProtoMessage() {}
+func (*HistoryQueueInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_670cd05c700ece14, []int{7}
+}
+func (m *HistoryQueueInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoryQueueInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoryQueueInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoryQueueInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryQueueInfo.Merge(m, src)
+}
+func (m *HistoryQueueInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoryQueueInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryQueueInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryQueueInfo proto.InternalMessageInfo
+
+func (m *HistoryQueueInfo) GetCategory() v11.TaskCategory {
+	if m != nil {
+		return m.Category
+	}
+	return v11.TASK_CATEGORY_UNSPECIFIED
+}
+
+func (m *HistoryQueueInfo) GetExclusiveReaderHighWatermark() *TaskKey {
+	if m != nil {
+		return m.ExclusiveReaderHighWatermark
+	}
+	return nil
+}
+
+func (m *HistoryQueueInfo) GetReaders() []*HistoryQueueReaderInfo {
+	if m != nil {
+		return m.Readers
+	}
+	return nil
+}
+
+// HistoryQueueReaderInfo describes the backlog of a reader of a history task queue.
+type HistoryQueueReaderInfo struct {
+	ReaderId int64 `protobuf:"varint,1,opt,name=reader_id,json=readerId,proto3" json:"reader_id,omitempty"`
+	// The reader won't load or process tasks with key below this watermark.
+	InclusiveMinPendingTaskKey *TaskKey `protobuf:"bytes,2,opt,name=inclusive_min_pending_task_key,json=inclusiveMinPendingTaskKey,proto3" json:"inclusive_min_pending_task_key,omitempty"`
+	// Estimated number of tasks loaded by the reader but not yet acked.
+	// Tasks which are not loaded yet, e.g. when the reader is throttled, are not counted.
+	BacklogCount int64 `protobuf:"varint,3,opt,name=backlog_count,json=backlogCount,proto3" json:"backlog_count,omitempty"`
+	// How long ago the oldest task not yet acked by the reader became ready to be processed.
+	OldestTaskAge *time.Duration `protobuf:"bytes,4,opt,name=oldest_task_age,json=oldestTaskAge,proto3,stdduration" json:"oldest_task_age,omitempty"`
+}
+
+func (m *HistoryQueueReaderInfo) Reset()      { *m = HistoryQueueReaderInfo{} }
+func (*HistoryQueueReaderInfo) ProtoMessage() {}
+func (*HistoryQueueReaderInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_670cd05c700ece14, []int{8}
+}
+func (m *HistoryQueueReaderInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoryQueueReaderInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoryQueueReaderInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoryQueueReaderInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryQueueReaderInfo.Merge(m, src)
+}
+func (m *HistoryQueueReaderInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoryQueueReaderInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryQueueReaderInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryQueueReaderInfo proto.InternalMessageInfo
+
+func (m *HistoryQueueReaderInfo) GetReaderId() int64 {
+	if m != nil {
+		return m.ReaderId
+	}
+	return 0
+}
+
+func (m *HistoryQueueReaderInfo) GetInclusiveMinPendingTaskKey() *TaskKey {
+	if m != nil {
+		return m.InclusiveMinPendingTaskKey
+	}
+	return nil
+}
+
+func (m *HistoryQueueReaderInfo) GetBacklogCount() int64 {
+	if m != nil {
+		return m.BacklogCount
+	}
+	return 0
+}
+
+func (m *HistoryQueueReaderInfo) GetOldestTaskAge() *time.Duration {
+	if m != nil {
+		return m.OldestTaskAge
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TransientWorkflowTaskInfo)(nil), "temporal.server.api.history.v1.TransientWorkflowTaskInfo")
 	proto.RegisterType((*VersionHistoryItem)(nil), "temporal.server.api.history.v1.VersionHistoryItem")
@@ -409,6 +543,8 @@ func init() {
 	proto.RegisterType((*TaskKey)(nil), "temporal.server.api.history.v1.TaskKey")
 	proto.RegisterType((*TaskRange)(nil), "temporal.server.api.history.v1.TaskRange")
 	proto.RegisterType((*HistoryEventPointer)(nil), "temporal.server.api.history.v1.HistoryEventPointer")
+	proto.RegisterType((*HistoryQueueInfo)(nil), "temporal.server.api.history.v1.HistoryQueueInfo")
+	proto.RegisterType((*HistoryQueueReaderInfo)(nil), "temporal.server.api.history.v1.HistoryQueueReaderInfo")
 }
 
 func init() {
@@ -416,44 +552,58 @@ func init() {
 }
 
 var fileDescriptor_670cd05c700ece14 = []byte{
-	// 590 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x4b, 0x1b, 0x41,
-	0x14, 0xc7, 0x33, 0x89, 0x9a, 0x38, 0xb1, 0x22, 0x2b, 0xd8, 0x18, 0xe8, 0xa8, 0x0b, 0x52, 0x0f,
-	0x32, 0x5b, 0xd3, 0x63, 0xe9, 0xa1, 0x42, 0xc1, 0x58, 0x85, 0xb2, 0x0d, 0x2d, 0x14, 0x61, 0x99,
-	0x24, 0x2f, 0xeb, 0x90, 0xec, 0x4c, 0x98, 0xd9, 0xa4, 0xf1, 0x50, 0xe8, 0x47, 0xf0, 0x3b, 0xf4,
-	0xd2, 0x6f, 0xd2, 0x1e, 0x3d, 0x7a, 0x6b, 0x5d, 0x2f, 0x3d, 0xfa, 0x11, 0xca, 0xce, 0xce, 0x26,
-	0xb5, 0x2d, 0x16, 0x6f, 0x33, 0x6f, 0xfe, 0xef, 0x37, 0xff, 0x37, 0xef, 0x31, 0x78, 0x37, 0x86,
-	0x68, 0x28, 0x15, 0x1b, 0x78, 0x1a, 0xd4, 0x18, 0x94, 0xc7, 0x86, 0xdc, 0x3b, 0xe5, 0x3a, 0x96,
-	0xea, 0xcc, 0x1b, 0xef, 0x79, 0x11, 0x68, 0xcd, 0x42, 0xa0, 0x43, 0x25, 0x63, 0xe9, 0x90, 0x5c,
-	0x4d, 0x33, 0x35, 0x65, 0x43, 0x4e, 0xad, 0x9a, 0x8e, 0xf7, 0xea, 0x1b, 0xa1, 0x94, 0xe1, 0x00,
-	0x3c, 0xa3, 0x6e, 0x8f, 0x7a, 0x5e, 0xcc, 0x23, 0xd0, 0x31, 0x8b, 0x86, 0x19, 0xa0, 0xbe, 0xd5,
-	0x85, 0x21, 0x88, 0x2e, 0x88, 0x0e, 0x07, 0xed, 0x85, 0x32, 0x94, 0x26, 0x6e, 0x56, 0x56, 0xb2,
-	0x3d, 0x75, 0x74, 0x97, 0x15, 0x77, 0x84, 0xd7, 0x5b, 0x8a, 0x09, 0xcd, 0x41, 0xc4, 0xef, 0xa4,
-	0xea, 0xf7, 0x06, 0xf2, 0x43, 0x8b, 0xe9, 0x7e, 0x53, 0xf4, 0xa4, 0x73, 0x84, 0x97, 0x6d, 0x62,
-	0xa0, 0x47, 0xbd, 0x1e, 0x9f, 0xd4, 0x4a, 0x9b, 0xa5, 0x9d, 0x6a, 0x63, 0x9b, 0x4e, 0x0b, 0xb8,
-	0xed, 0x9c, 0x1e, 0x64, 0xcb, 0x97, 0x63, 0x10, 0xb1, 0xff, 0xc0, 0x1e, 0xbc, 0x31, 0xb9, 0x87,
-	0x73, 0x15, 0xb4, 0x52, 0x3c, 0x9c, 0xab, 0x14, 0x57, 0x4a, 0x6e, 0x13, 0x3b, 0x6f, 0x41, 0x69,
-	0x2e, 0x85, 0xcd, 0x68, 0xc6, 0x10, 0x39, 0xeb, 0xb8, 0x02, 0x69, 0x66, 0xc0, 0xbb, 0x35, 0xb4,
-	0x89, 0x76, 0x4a, 0x7e, 0xd9, 0xec, 0x9b, 0x5d, 0xa7, 0x86, 0xcb, 0xe3, 0x2c, 0xa1, 0x56, 0xcc,
-	0x4e, 0xec, 0xd6, 0xfd, 0x88, 0x97, 0x6f, 0xa3, 0x9c, 0x2d, 0xbc, 0xd4, 0x56, 0x4c, 0x74, 0x4e,
-	0x83, 0x58, 0xf6, 0x41, 0x18, 0xd4, 0x92, 0x5f, 0xcd, 0x62, 0xad, 0x34, 0xe4, 0x1c, 0xe0, 0x79,
-	0x1e, 0x43, 0xa4, 0x6b, 0x45, 0x53, 0x50, 0x83, 0xde, 0xdd, 0x11, 0xfa, 0xb7, 0x59, 0x3f, 0x03,
-	0xb8, 0x9f, 0x11, 0x5e, 0xb9, 0x75, 0xca, 0x41, 0x3b, 0x2f, 0xf0, 0xa3, 0xce, 0x48, 0xa9, 0xb4,
-	0x14, 0x6b, 0x33, 0xc8, 0x1f, 0x92, 0x8b, 0x2e, 0x4c, 0x8c, 0xa5, 0x79, 0xbf, 0x6e, 0x45, 0x7f,
-	0xd0, 0x53, 0x85, 0x73, 0x84, 0x17, 0x4f, 0x73, 0x9e, 0x75, 0x49, 0xef, 0xe7, 0xd2, 0x9f, 0x01,
-	0x5c, 0x86, 0xcb, 0x69, 0x57, 0x5f, 0xc1, 0x99, 0xf3, 0x10, 0x97, 0x63, 0xa6, 0xfb, 0xb3, 0x37,
-	0x5e, 0x48, 0xb7, 0xcd, 0xae, 0xf3, 0x1c, 0x2f, 0xf6, 0xb8, 0x82, 0x20, 0x1d, 0x36, 0xf3, 0xc8,
-	0xd5, 0x46, 0x9d, 0x66, 0x93, 0x48, 0xf3, 0x49, 0xa4, 0xad, 0x7c, 0x12, 0xf7, 0xe7, 0xce, 0xbf,
-	0x6f, 0x20, 0xbf, 0x92, 0xa6, 0xa4, 0x41, 0xf7, 0x2b, 0xc2, 0x8b, 0xe9, 0x1d, 0x3e, 0x13, 0x21,
-	0x38, 0x27, 0x78, 0x8d, 0x8b, 0xce, 0x60, 0xa4, 0xf9, 0x18, 0x82, 0x88, 0x8b, 0xc0, 0xdc, 0xd9,
-	0x87, 0x33, 0x73, 0x69, 0xb5, 0xf1, 0xf8, 0x7f, 0xb5, 0x58, 0xbb, 0xfe, 0xea, 0x14, 0x73, 0xcc,
-	0x45, 0x5e, 0xc3, 0x09, 0x5e, 0x83, 0xc9, 0x94, 0xce, 0x26, 0x33, 0x7a, 0xf1, 0x9e, 0xf4, 0x29,
-	0xe6, 0x98, 0x4d, 0x6c, 0xd0, 0x7d, 0x82, 0x57, 0x7f, 0x9f, 0xe3, 0xd7, 0x92, 0x8b, 0x18, 0xd4,
-	0x1d, 0xd3, 0xb9, 0xdf, 0xbe, 0xb8, 0x22, 0x85, 0xcb, 0x2b, 0x52, 0xb8, 0xb9, 0x22, 0xe8, 0x53,
-	0x42, 0xd0, 0x97, 0x84, 0xa0, 0x6f, 0x09, 0x41, 0x17, 0x09, 0x41, 0x3f, 0x12, 0x82, 0x7e, 0x26,
-	0xa4, 0x70, 0x93, 0x10, 0x74, 0x7e, 0x4d, 0x0a, 0x17, 0xd7, 0xa4, 0x70, 0x79, 0x4d, 0x0a, 0xef,
-	0x77, 0x43, 0x39, 0xf3, 0xc9, 0xe5, 0xbf, 0xbf, 0x8e, 0x67, 0x76, 0xd9, 0x5e, 0x30, 0x3d, 0x78,
-	0xfa, 0x2b, 0x00, 0x00, 0xff, 0xff, 0xeb, 0x9b, 0x7d, 0x47, 0x6b, 0x04, 0x00, 0x00,
+	// 808 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xda, 0x6e, 0xed, 0x4c, 0x7e, 0x10, 0x4d, 0xa5, 0xe0, 0x18, 0xd8, 0xb4, 0x8b, 0xaa,
+	0x56, 0xa8, 0x5a, 0x53, 0x23, 0x71, 0x41, 0x1c, 0x9a, 0xf2, 0x23, 0x2e, 0xad, 0x14, 0x96, 0x88,
+	0x4a, 0xa8, 0xd2, 0x6a, 0xec, 0x7d, 0x5e, 0x8f, 0xec, 0x9d, 0xb1, 0x66, 0x66, 0x5d, 0xe7, 0x80,
+	0xc4, 0x9f, 0x90, 0x23, 0x07, 0x6e, 0x5c, 0xf8, 0x4f, 0xe0, 0x98, 0x63, 0x6f, 0x10, 0xe7, 0xc2,
+	0xb1, 0x7f, 0x02, 0x9a, 0x1f, 0xbb, 0x4e, 0x5a, 0x2b, 0x55, 0xb8, 0xcd, 0xbc, 0xf9, 0xde, 0xf7,
+	0xbe, 0xef, 0xcd, 0x9b, 0x5d, 0xf4, 0x40, 0x41, 0x36, 0xe5, 0x82, 0x4c, 0x3a, 0x12, 0xc4, 0x0c,
+	0x44, 0x87, 0x4c, 0x69, 0x67, 0x44, 0xa5, 0xe2, 0xe2, 0xb8, 0x33, 0x7b, 0xd8, 0xc9, 0x40, 0x4a,
+	0x92, 0x42, 0x38, 0x15, 0x5c, 0x71, 0xec, 0x17, 0xe8, 0xd0, 0xa2, 0x43, 0x32, 0xa5, 0xa1, 0x43,
+	0x87, 0xb3, 0x87, 0x6d, 0x3f, 0xe5, 0x3c, 0x9d, 0x40, 0xc7, 0xa0, 0xfb, 0xf9, 0xb0, 0x93, 0xe4,
+	0x82, 0x28, 0xca, 0x99, 0xcd, 0x6f, 0xef, 0xbd, 0x79, 0xae, 0x68, 0x06, 0x52, 0x91, 0x6c, 0xea,
+	0x00, 0x77, 0x12, 0x98, 0x02, 0x4b, 0x80, 0x0d, 0x28, 0xc8, 0x4e, 0xca, 0x53, 0x6e, 0xe2, 0x66,
+	0xe5, 0x20, 0x77, 0x4b, 0xc5, 0x57, 0x49, 0x6d, 0xdf, 0x5b, 0x65, 0x0c, 0x58, 0x9e, 0x49, 0x8d,
+	0x55, 0x44, 0x8e, 0x2d, 0x30, 0xc8, 0xd1, 0xee, 0x91, 0x20, 0x4c, 0x52, 0x60, 0xea, 0x39, 0x17,
+	0xe3, 0xe1, 0x84, 0xbf, 0x3c, 0x22, 0x72, 0xdc, 0x63, 0x43, 0x8e, 0x9f, 0xa2, 0x2d, 0x57, 0x21,
+	0x96, 0xf9, 0x70, 0x48, 0xe7, 0xad, 0xda, 0xed, 0xda, 0xfd, 0xf5, 0xee, 0xdd, 0xb0, 0xec, 0xc4,
+	0xe5, 0x16, 0x84, 0x07, 0x76, 0xf9, 0xf5, 0x0c, 0x98, 0x8a, 0x36, 0xdd, 0xc1, 0x0f, 0x26, 0xf7,
+	0x49, 0xbd, 0xe9, 0x6d, 0x57, 0x9f, 0xd4, 0x9b, 0xd5, 0xed, 0x5a, 0xd0, 0x43, 0xf8, 0x47, 0x10,
+	0x92, 0x72, 0xe6, 0x32, 0x7a, 0x0a, 0x32, 0xbc, 0x8b, 0x9a, 0xa0, 0x33, 0x63, 0x9a, 0xb4, 0xbc,
+	0xdb, 0xde, 0xfd, 0x5a, 0xd4, 0x30, 0xfb, 0x5e, 0x82, 0x5b, 0xa8, 0x31, 0xb3, 0x09, 0xad, 0xaa,
+	0x3d, 0x71, 0xdb, 0xe0, 0x67, 0xb4, 0x75, 0x99, 0x0a, 0xdf, 0x41, 0x1b, 0x7d, 0x41, 0xd8, 0x60,
+	0x14, 0x2b, 0x3e, 0x06, 0x66, 0xa8, 0x36, 0xa2, 0x75, 0x1b, 0x3b, 0xd2, 0x21, 0x7c, 0x80, 0x6e,
+	0x50, 0x05, 0x99, 0x6c, 0x55, 0x8d, 0xa1, 0x6e, 0x78, 0xf5, 0xd5, 0x86, 0x6f, 0x8b, 0x8d, 0x2c,
+	0x41, 0xf0, 0xbb, 0x87, 0xb6, 0x2f, 0x9d, 0x52, 0x90, 0xf8, 0x11, 0xfa, 0x68, 0x90, 0x0b, 0xa1,
+	0xad, 0x38, 0x99, 0x71, 0xd1, 0x48, 0xca, 0x12, 0x98, 0x1b, 0x49, 0x37, 0xa2, 0xb6, 0x03, 0xbd,
+	0xc1, 0xae, 0x11, 0xf8, 0x29, 0x5a, 0x1b, 0x15, 0x7c, 0x4e, 0x65, 0x78, 0x3d, 0x95, 0xd1, 0x92,
+	0x20, 0x20, 0xa8, 0xa1, 0x6f, 0xf5, 0x3b, 0x38, 0xc6, 0xef, 0xa3, 0x86, 0xbe, 0xff, 0x65, 0x8f,
+	0x6f, 0xea, 0x6d, 0x2f, 0xc1, 0x5f, 0xa2, 0xb5, 0x21, 0x15, 0x10, 0xeb, 0xa9, 0x34, 0x4d, 0x5e,
+	0xef, 0xb6, 0x43, 0x3b, 0xb2, 0x61, 0x31, 0xb2, 0xe1, 0x51, 0x31, 0xb2, 0xfb, 0xf5, 0x93, 0xbf,
+	0xf7, 0xbc, 0xa8, 0xa9, 0x53, 0x74, 0x30, 0xf8, 0xd3, 0x43, 0x6b, 0xba, 0x46, 0x44, 0x58, 0x0a,
+	0xf8, 0x05, 0xda, 0xa1, 0x6c, 0x30, 0xc9, 0x25, 0x9d, 0x41, 0x9c, 0x51, 0x16, 0x9b, 0x9a, 0x63,
+	0x38, 0x36, 0x45, 0xd7, 0xbb, 0xf7, 0xde, 0xe5, 0xc5, 0xc9, 0x8d, 0x6e, 0x95, 0x34, 0xcf, 0x28,
+	0x2b, 0x3c, 0xbc, 0x40, 0x3b, 0x30, 0x2f, 0xd9, 0xc9, 0x7c, 0xc9, 0x5e, 0xbd, 0x26, 0x7b, 0x49,
+	0xf3, 0x8c, 0xcc, 0x5d, 0x30, 0xf8, 0x14, 0xdd, 0xba, 0x38, 0xc7, 0x87, 0x9c, 0x32, 0x05, 0xe2,
+	0x8a, 0xe9, 0x0c, 0x7e, 0xab, 0xa2, 0x6d, 0x97, 0xf2, 0x7d, 0x0e, 0x39, 0x98, 0xd7, 0xf3, 0x0d,
+	0x6a, 0x0e, 0x88, 0x82, 0x94, 0x0b, 0x6b, 0x7a, 0xab, 0xfb, 0xc9, 0x4a, 0x59, 0xe6, 0x59, 0x16,
+	0xa2, 0x1e, 0xbb, 0x8c, 0xa8, 0xcc, 0xc5, 0x0c, 0xed, 0x2d, 0xcd, 0x0a, 0x20, 0x09, 0x88, 0x78,
+	0x44, 0xd3, 0x51, 0xfc, 0x92, 0x28, 0x10, 0x19, 0x11, 0xe3, 0xeb, 0xba, 0xfe, 0xb0, 0xe4, 0x8b,
+	0x0c, 0xdd, 0x01, 0x4d, 0x47, 0xcf, 0x0b, 0x32, 0x7c, 0x88, 0x1a, 0xb6, 0x8a, 0x74, 0xcf, 0xfd,
+	0xf3, 0x77, 0xf1, 0x5e, 0xb4, 0x6e, 0x19, 0x75, 0x03, 0xa2, 0x82, 0x26, 0x38, 0xa9, 0xa2, 0x9d,
+	0xd5, 0x18, 0xfc, 0x01, 0x5a, 0x73, 0x96, 0xca, 0xae, 0x36, 0x6d, 0xa0, 0x97, 0xe0, 0x31, 0xf2,
+	0x2f, 0x0f, 0x91, 0xfe, 0x3a, 0x52, 0x96, 0xfe, 0xef, 0xeb, 0x6e, 0x5f, 0x1c, 0xa6, 0x43, 0x4b,
+	0x56, 0xcc, 0xd4, 0xc7, 0x68, 0xb3, 0x4f, 0x06, 0xe3, 0x09, 0x4f, 0xe3, 0x01, 0xcf, 0x99, 0x6a,
+	0xd5, 0x8c, 0x9a, 0x0d, 0x17, 0x7c, 0xac, 0x63, 0xf8, 0x5b, 0xf4, 0x1e, 0x9f, 0x24, 0x20, 0x95,
+	0x95, 0x40, 0x52, 0x68, 0xd5, 0x8d, 0x84, 0xdd, 0xb7, 0x5e, 0xca, 0x57, 0xee, 0xe3, 0xbf, 0x5f,
+	0xff, 0x55, 0x3f, 0x94, 0x4d, 0x9b, 0xa7, 0xab, 0x3d, 0x4a, 0x61, 0xbf, 0x7f, 0x7a, 0xe6, 0x57,
+	0x5e, 0x9d, 0xf9, 0x95, 0xd7, 0x67, 0xbe, 0xf7, 0xcb, 0xc2, 0xf7, 0xfe, 0x58, 0xf8, 0xde, 0x5f,
+	0x0b, 0xdf, 0x3b, 0x5d, 0xf8, 0xde, 0x3f, 0x0b, 0xdf, 0xfb, 0x77, 0xe1, 0x57, 0x5e, 0x2f, 0x7c,
+	0xef, 0xe4, 0xdc, 0xaf, 0x9c, 0x9e, 0xfb, 0x95, 0x57, 0xe7, 0x7e, 0xe5, 0xa7, 0x07, 0x29, 0x5f,
+	0x5a, 0xa5, 0x7c, 0xf5, 0x5f, 0xeb, 0x0b, 0xb7, 0xec, 0xdf, 0x34, 0x5a, 0x3e, 0xfb, 0x6f, 0x00,
+	0x4b, 0xd5, 0x4c, 0x3c, 0xe6, 0x06, 0x00, 0x00,
 }
 
 func (this *TransientWorkflowTaskInfo) Equal(that interface{}) bool {
@@ -658,6 +808,80 @@ func (this *HistoryEventPointer) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *HistoryQueueInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HistoryQueueInfo)
+	if !ok {
+		that2, ok := that.(HistoryQueueInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Category != that1.Category {
+		return false
+	}
+	if !this.ExclusiveReaderHighWatermark.Equal(that1.ExclusiveReaderHighWatermark) {
+		return false
+	}
+	if len(this.Readers) != len(that1.Readers) {
+		return false
+	}
+	for i := range this.Readers {
+		if !this.Readers[i].Equal(that1.Readers[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *HistoryQueueReaderInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HistoryQueueReaderInfo)
+	if !ok {
+		that2, ok := that.(HistoryQueueReaderInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ReaderId != that1.ReaderId {
+		return false
+	}
+	if !this.InclusiveMinPendingTaskKey.Equal(that1.InclusiveMinPendingTaskKey) {
+		return false
+	}
+	if this.BacklogCount != that1.BacklogCount {
+		return false
+	}
+	if this.OldestTaskAge != nil && that1.OldestTaskAge != nil {
+		if *this.OldestTaskAge != *that1.OldestTaskAge {
+			return false
+		}
+	} else if this.OldestTaskAge != nil {
+		return false
+	} else if that1.OldestTaskAge != nil {
+		return false
+	}
+	return true
+}
 func (this *TransientWorkflowTaskInfo) GoString() string {
 	if this == nil {
 		return "nil"
@@ -743,6 +967,37 @@ func (this *HistoryEventPointer) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *HistoryQueueInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&history.HistoryQueueInfo{")
+	s = append(s, "Category: "+fmt.Sprintf("%#v", this.Category)+",\n")
+	if this.ExclusiveReaderHighWatermark != nil {
+		s = append(s, "ExclusiveReaderHighWatermark: "+fmt.Sprintf("%#v", this.ExclusiveReaderHighWatermark)+",\n")
+	}
+	if this.Readers != nil {
+		s = append(s, "Readers: "+fmt.Sprintf("%#v", this.Readers)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *HistoryQueueReaderInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&history.HistoryQueueReaderInfo{")
+	s = append(s, "ReaderId: "+fmt.Sprintf("%#v", this.ReaderId)+",\n")
+	if this.InclusiveMinPendingTaskKey != nil {
+		s = append(s, "InclusiveMinPendingTaskKey: "+fmt.Sprintf("%#v", this.InclusiveMinPendingTaskKey)+",\n")
+	}
+	s = append(s, "BacklogCount: "+fmt.Sprintf("%#v", this.BacklogCount)+",\n")
+	s = append(s, "OldestTaskAge: "+fmt.Sprintf("%#v", this.OldestTaskAge)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringMessage(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1020,6 +1275,115 @@ func (m *HistoryEventPointer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HistoryQueueInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryQueueInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryQueueInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Readers) > 0 {
+		for iNdEx := len(m.Readers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Readers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ExclusiveReaderHighWatermark != nil {
+		{
+			size, err := m.ExclusiveReaderHighWatermark.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Category != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Category))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HistoryQueueReaderInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryQueueReaderInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryQueueReaderInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OldestTaskAge != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.OldestTaskAge, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.OldestTaskAge):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintMessage(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BacklogCount != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.BacklogCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InclusiveMinPendingTaskKey != nil {
+		{
+			size, err := m.InclusiveMinPendingTaskKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ReaderId != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ReaderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMessage(v)
 	base := offset
@@ -1143,30 +1507,75 @@ func (m *HistoryEventPointer) Size() (n int) {
 	return n
 }
 
-func sovMessage(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMessage(x uint64) (n int) {
-	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *TransientWorkflowTaskInfo) String() string {
-	if this == nil {
-		return "nil"
+func (m *HistoryQueueInfo) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForHistorySuffix := "[]*HistoryEvent{"
-	for _, f := range this.HistorySuffix {
-		repeatedStringForHistorySuffix += strings.Replace(fmt.Sprintf("%v", f), "HistoryEvent", "v1.HistoryEvent", 1) + ","
+	var l int
+	_ = l
+	if m.Category != 0 {
+		n += 1 + sovMessage(uint64(m.Category))
 	}
-	repeatedStringForHistorySuffix += "}"
-	s := strings.Join([]string{`&TransientWorkflowTaskInfo{`,
-		`HistorySuffix:` + repeatedStringForHistorySuffix + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *VersionHistoryItem) String() string {
-	if this == nil {
-		return "nil"
+	if m.ExclusiveReaderHighWatermark != nil {
+		l = m.ExclusiveReaderHighWatermark.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.Readers) > 0 {
+		for _, e := range m.Readers {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HistoryQueueReaderInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReaderId != 0 {
+		n += 1 + sovMessage(uint64(m.ReaderId))
+	}
+	if m.InclusiveMinPendingTaskKey != nil {
+		l = m.InclusiveMinPendingTaskKey.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.BacklogCount != 0 {
+		n += 1 + sovMessage(uint64(m.BacklogCount))
+	}
+	if m.OldestTaskAge != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.OldestTaskAge)
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	return n
+}
+
+func sovMessage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMessage(x uint64) (n int) {
+	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *TransientWorkflowTaskInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHistorySuffix := "[]*HistoryEvent{"
+	for _, f := range this.HistorySuffix {
+		repeatedStringForHistorySuffix += strings.Replace(fmt.Sprintf("%v", f), "HistoryEvent", "v1.HistoryEvent", 1) + ","
+	}
+	repeatedStringForHistorySuffix += "}"
+	s := strings.Join([]string{`&TransientWorkflowTaskInfo{`,
+		`HistorySuffix:` + repeatedStringForHistorySuffix + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VersionHistoryItem) String() string {
+	if this == nil {
+		return "nil"
 	}
 	s := strings.Join([]string{`&VersionHistoryItem{`,
 		`EventId:` + fmt.Sprintf("%v", this.EventId) + `,`,
@@ -1239,6 +1648,36 @@ func (this *HistoryEventPointer) String() string {
 	}, "")
 	return s
 }
+func (this *HistoryQueueInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForReaders := "[]*HistoryQueueReaderInfo{"
+	for _, f := range this.Readers {
+		repeatedStringForReaders += strings.Replace(f.String(), "HistoryQueueReaderInfo", "HistoryQueueReaderInfo", 1) + ","
+	}
+	repeatedStringForReaders += "}"
+	s := strings.Join([]string{`&HistoryQueueInfo{`,
+		`Category:` + fmt.Sprintf("%v", this.Category) + `,`,
+		`ExclusiveReaderHighWatermark:` + strings.Replace(this.ExclusiveReaderHighWatermark.String(), "TaskKey", "TaskKey", 1) + `,`,
+		`Readers:` + repeatedStringForReaders + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HistoryQueueReaderInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HistoryQueueReaderInfo{`,
+		`ReaderId:` + fmt.Sprintf("%v", this.ReaderId) + `,`,
+		`InclusiveMinPendingTaskKey:` + strings.Replace(this.InclusiveMinPendingTaskKey.String(), "TaskKey", "TaskKey", 1) + `,`,
+		`BacklogCount:` + fmt.Sprintf("%v", this.BacklogCount) + `,`,
+		`OldestTaskAge:` + strings.Replace(fmt.Sprintf("%v", this.OldestTaskAge), "Duration", "types.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringMessage(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1957,6 +2396,311 @@ func (m *HistoryEventPointer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HistoryQueueInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryQueueInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryQueueInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			m.Category = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Category |= v11.TaskCategory(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExclusiveReaderHighWatermark", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExclusiveReaderHighWatermark == nil {
+				m.ExclusiveReaderHighWatermark = &TaskKey{}
+			}
+			if err := m.ExclusiveReaderHighWatermark.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Readers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Readers = append(m.Readers, &HistoryQueueReaderInfo{})
+			if err := m.Readers[len(m.Readers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoryQueueReaderInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryQueueReaderInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryQueueReaderInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReaderId", wireType)
+			}
+			m.ReaderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReaderId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveMinPendingTaskKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InclusiveMinPendingTaskKey == nil {
+				m.InclusiveMinPendingTaskKey = &TaskKey{}
+			}
+			if err := m.InclusiveMinPendingTaskKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BacklogCount", wireType)
+			}
+			m.BacklogCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BacklogCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestTaskAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldestTaskAge == nil {
+				m.OldestTaskAge = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.OldestTaskAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMessage(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2277,6 +2277,12 @@ var xxx_messageInfo_DeleteWorkflowExecutionResponse proto.InternalMessageInfo
 type ResetWorkflowExecutionRequest struct {
 	NamespaceId  string                            `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	ResetRequest *v1.ResetWorkflowExecutionRequest `protobuf:"bytes,2,opt,name=reset_request,json=resetRequest,proto3" json:"reset_request,omitempty"`
+	// If true, the first workflow task of the reset run is dispatched to the build id that
+	// the base run was last processed by, instead of the task queue's current default.
+	PreserveBuildId bool `protobuf:"varint,3,opt,name=preserve_build_id,json=preserveBuildId,proto3" json:"preserve_build_id,omitempty"`
+	// If set, the first workflow task of the reset run is dispatched to this build id.
+	// Takes precedence over preserve_build_id.
+	TargetBuildId string `protobuf:"bytes,4,opt,name=target_build_id,json=targetBuildId,proto3" json:"target_build_id,omitempty"`
 }
 
 func (m *ResetWorkflowExecutionRequest) Reset()      { *m = ResetWorkflowExecutionRequest{} }
@@ -2325,6 +2331,20 @@ func (m *ResetWorkflowExecutionRequest) GetResetRequest() *v1.ResetWorkflowExecu
 	return nil
 }
 
+func (m *ResetWorkflowExecutionRequest) GetPreserveBuildId() bool {
+	if m != nil {
+		return m.PreserveBuildId
+	}
+	return false
+}
+
+func (m *ResetWorkflowExecutionRequest) GetTargetBuildId() string {
+	if m != nil {
+		return m.TargetBuildId
+	}
+	return ""
+}
+
 type ResetWorkflowExecutionResponse struct {
 	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
 }
@@ -2368,6 +2388,119 @@ func (m *ResetWorkflowExecutionResponse) GetRunId() string {
 	return ""
 }
 
+type RepinWorkflowBuildIdRequest struct {
+	NamespaceId string                 `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Execution   *v14.WorkflowExecution `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution,omitempty"`
+	// The workflow is only repinned if it's currently pinned to this build id.
+	BuildId string `protobuf:"bytes,3,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	// The build id the workflow is pinned to and its next workflow task is dispatched to.
+	TargetBuildId string `protobuf:"bytes,4,opt,name=target_build_id,json=targetBuildId,proto3" json:"target_build_id,omitempty"`
+}
+
+func (m *RepinWorkflowBuildIdRequest) Reset()      { *m = RepinWorkflowBuildIdRequest{} }
+func (*RepinWorkflowBuildIdRequest) ProtoMessage() {}
+func (*RepinWorkflowBuildIdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{36}
+}
+func (m *RepinWorkflowBuildIdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepinWorkflowBuildIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepinWorkflowBuildIdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RepinWorkflowBuildIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepinWorkflowBuildIdRequest.Merge(m, src)
+}
+func (m *RepinWorkflowBuildIdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepinWorkflowBuildIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepinWorkflowBuildIdRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepinWorkflowBuildIdRequest proto.InternalMessageInfo
+
+func (m *RepinWorkflowBuildIdRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *RepinWorkflowBuildIdRequest) GetExecution() *v14.WorkflowExecution {
+	if m != nil {
+		return m.Execution
+	}
+	return nil
+}
+
+func (m *RepinWorkflowBuildIdRequest) GetBuildId() string {
+	if m != nil {
+		return m.BuildId
+	}
+	return ""
+}
+
+func (m *RepinWorkflowBuildIdRequest) GetTargetBuildId() string {
+	if m != nil {
+		return m.TargetBuildId
+	}
+	return ""
+}
+
+type RepinWorkflowBuildIdResponse struct {
+	// False if the workflow was no longer pinned to build_id and was left as is.
+	Repinned bool `protobuf:"varint,1,opt,name=repinned,proto3" json:"repinned,omitempty"`
+}
+
+func (m *RepinWorkflowBuildIdResponse) Reset()      { *m = RepinWorkflowBuildIdResponse{} }
+func (*RepinWorkflowBuildIdResponse) ProtoMessage() {}
+func (*RepinWorkflowBuildIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{37}
+}
+func (m *RepinWorkflowBuildIdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepinWorkflowBuildIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepinWorkflowBuildIdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RepinWorkflowBuildIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepinWorkflowBuildIdResponse.Merge(m, src)
+}
+func (m *RepinWorkflowBuildIdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepinWorkflowBuildIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepinWorkflowBuildIdResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepinWorkflowBuildIdResponse proto.InternalMessageInfo
+
+func (m *RepinWorkflowBuildIdResponse) GetRepinned() bool {
+	if m != nil {
+		return m.Repinned
+	}
+	return false
+}
+
 type RequestCancelWorkflowExecutionRequest struct {
 	NamespaceId               string                                    `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	CancelRequest             *v1.RequestCancelWorkflowExecutionRequest `protobuf:"bytes,2,opt,name=cancel_request,json=cancelRequest,proto3" json:"cancel_request,omitempty"`
@@ -2379,7 +2512,7 @@ type RequestCancelWorkflowExecutionRequest struct {
 func (m *RequestCancelWorkflowExecutionRequest) Reset()      { *m = RequestCancelWorkflowExecutionRequest{} }
 func (*RequestCancelWorkflowExecutionRequest) ProtoMessage() {}
 func (*RequestCancelWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{36}
+	return fileDescriptor_b8c78c1d460a3711, []int{38}
 }
 func (m *RequestCancelWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2451,7 +2584,7 @@ func (m *RequestCancelWorkflowExecutionResponse) Reset() {
 }
 func (*RequestCancelWorkflowExecutionResponse) ProtoMessage() {}
 func (*RequestCancelWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{37}
+	return fileDescriptor_b8c78c1d460a3711, []int{39}
 }
 func (m *RequestCancelWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2491,7 +2624,7 @@ type ScheduleWorkflowTaskRequest struct {
 func (m *ScheduleWorkflowTaskRequest) Reset()      { *m = ScheduleWorkflowTaskRequest{} }
 func (*ScheduleWorkflowTaskRequest) ProtoMessage() {}
 func (*ScheduleWorkflowTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{38}
+	return fileDescriptor_b8c78c1d460a3711, []int{40}
 }
 func (m *ScheduleWorkflowTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2561,7 +2694,7 @@ type ScheduleWorkflowTaskResponse struct {
 func (m *ScheduleWorkflowTaskResponse) Reset()      { *m = ScheduleWorkflowTaskResponse{} }
 func (*ScheduleWorkflowTaskResponse) ProtoMessage() {}
 func (*ScheduleWorkflowTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{39}
+	return fileDescriptor_b8c78c1d460a3711, []int{41}
 }
 func (m *ScheduleWorkflowTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2601,7 +2734,7 @@ func (m *VerifyFirstWorkflowTaskScheduledRequest) Reset() {
 }
 func (*VerifyFirstWorkflowTaskScheduledRequest) ProtoMessage() {}
 func (*VerifyFirstWorkflowTaskScheduledRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{40}
+	return fileDescriptor_b8c78c1d460a3711, []int{42}
 }
 func (m *VerifyFirstWorkflowTaskScheduledRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2659,7 +2792,7 @@ func (m *VerifyFirstWorkflowTaskScheduledResponse) Reset() {
 }
 func (*VerifyFirstWorkflowTaskScheduledResponse) ProtoMessage() {}
 func (*VerifyFirstWorkflowTaskScheduledResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{41}
+	return fileDescriptor_b8c78c1d460a3711, []int{43}
 }
 func (m *VerifyFirstWorkflowTaskScheduledResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2707,7 +2840,7 @@ type RecordChildExecutionCompletedRequest struct {
 func (m *RecordChildExecutionCompletedRequest) Reset()      { *m = RecordChildExecutionCompletedRequest{} }
 func (*RecordChildExecutionCompletedRequest) ProtoMessage() {}
 func (*RecordChildExecutionCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{42}
+	return fileDescriptor_b8c78c1d460a3711, []int{44}
 }
 func (m *RecordChildExecutionCompletedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2791,7 +2924,7 @@ type RecordChildExecutionCompletedResponse struct {
 func (m *RecordChildExecutionCompletedResponse) Reset()      { *m = RecordChildExecutionCompletedResponse{} }
 func (*RecordChildExecutionCompletedResponse) ProtoMessage() {}
 func (*RecordChildExecutionCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{43}
+	return fileDescriptor_b8c78c1d460a3711, []int{45}
 }
 func (m *RecordChildExecutionCompletedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2834,7 +2967,7 @@ func (m *VerifyChildExecutionCompletionRecordedRequest) Reset() {
 }
 func (*VerifyChildExecutionCompletionRecordedRequest) ProtoMessage() {}
 func (*VerifyChildExecutionCompletionRecordedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{44}
+	return fileDescriptor_b8c78c1d460a3711, []int{46}
 }
 func (m *VerifyChildExecutionCompletionRecordedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2913,7 +3046,7 @@ func (m *VerifyChildExecutionCompletionRecordedResponse) Reset() {
 }
 func (*VerifyChildExecutionCompletionRecordedResponse) ProtoMessage() {}
 func (*VerifyChildExecutionCompletionRecordedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{45}
+	return fileDescriptor_b8c78c1d460a3711, []int{47}
 }
 func (m *VerifyChildExecutionCompletionRecordedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2950,7 +3083,7 @@ type DescribeWorkflowExecutionRequest struct {
 func (m *DescribeWorkflowExecutionRequest) Reset()      { *m = DescribeWorkflowExecutionRequest{} }
 func (*DescribeWorkflowExecutionRequest) ProtoMessage() {}
 func (*DescribeWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{46}
+	return fileDescriptor_b8c78c1d460a3711, []int{48}
 }
 func (m *DescribeWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3004,7 +3137,7 @@ type DescribeWorkflowExecutionResponse struct {
 func (m *DescribeWorkflowExecutionResponse) Reset()      { *m = DescribeWorkflowExecutionResponse{} }
 func (*DescribeWorkflowExecutionResponse) ProtoMessage() {}
 func (*DescribeWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{47}
+	return fileDescriptor_b8c78c1d460a3711, []int{49}
 }
 func (m *DescribeWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3081,7 +3214,7 @@ type ReplicateEventsV2Request struct {
 func (m *ReplicateEventsV2Request) Reset()      { *m = ReplicateEventsV2Request{} }
 func (*ReplicateEventsV2Request) ProtoMessage() {}
 func (*ReplicateEventsV2Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{48}
+	return fileDescriptor_b8c78c1d460a3711, []int{50}
 }
 func (m *ReplicateEventsV2Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3158,7 +3291,7 @@ type ReplicateEventsV2Response struct {
 func (m *ReplicateEventsV2Response) Reset()      { *m = ReplicateEventsV2Response{} }
 func (*ReplicateEventsV2Response) ProtoMessage() {}
 func (*ReplicateEventsV2Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{49}
+	return fileDescriptor_b8c78c1d460a3711, []int{51}
 }
 func (m *ReplicateEventsV2Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3196,7 +3329,7 @@ type ReplicateWorkflowStateRequest struct {
 func (m *ReplicateWorkflowStateRequest) Reset()      { *m = ReplicateWorkflowStateRequest{} }
 func (*ReplicateWorkflowStateRequest) ProtoMessage() {}
 func (*ReplicateWorkflowStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{50}
+	return fileDescriptor_b8c78c1d460a3711, []int{52}
 }
 func (m *ReplicateWorkflowStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3252,7 +3385,7 @@ type ReplicateWorkflowStateResponse struct {
 func (m *ReplicateWorkflowStateResponse) Reset()      { *m = ReplicateWorkflowStateResponse{} }
 func (*ReplicateWorkflowStateResponse) ProtoMessage() {}
 func (*ReplicateWorkflowStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{51}
+	return fileDescriptor_b8c78c1d460a3711, []int{53}
 }
 func (m *ReplicateWorkflowStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3290,7 +3423,7 @@ type SyncShardStatusRequest struct {
 func (m *SyncShardStatusRequest) Reset()      { *m = SyncShardStatusRequest{} }
 func (*SyncShardStatusRequest) ProtoMessage() {}
 func (*SyncShardStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{52}
+	return fileDescriptor_b8c78c1d460a3711, []int{54}
 }
 func (m *SyncShardStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3346,7 +3479,7 @@ type SyncShardStatusResponse struct {
 func (m *SyncShardStatusResponse) Reset()      { *m = SyncShardStatusResponse{} }
 func (*SyncShardStatusResponse) ProtoMessage() {}
 func (*SyncShardStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{53}
+	return fileDescriptor_b8c78c1d460a3711, []int{55}
 }
 func (m *SyncShardStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3396,7 +3529,7 @@ type SyncActivityRequest struct {
 func (m *SyncActivityRequest) Reset()      { *m = SyncActivityRequest{} }
 func (*SyncActivityRequest) ProtoMessage() {}
 func (*SyncActivityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{54}
+	return fileDescriptor_b8c78c1d460a3711, []int{56}
 }
 func (m *SyncActivityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3536,7 +3669,7 @@ type SyncActivityResponse struct {
 func (m *SyncActivityResponse) Reset()      { *m = SyncActivityResponse{} }
 func (*SyncActivityResponse) ProtoMessage() {}
 func (*SyncActivityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{55}
+	return fileDescriptor_b8c78c1d460a3711, []int{57}
 }
 func (m *SyncActivityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3573,7 +3706,7 @@ type DescribeMutableStateRequest struct {
 func (m *DescribeMutableStateRequest) Reset()      { *m = DescribeMutableStateRequest{} }
 func (*DescribeMutableStateRequest) ProtoMessage() {}
 func (*DescribeMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{56}
+	return fileDescriptor_b8c78c1d460a3711, []int{58}
 }
 func (m *DescribeMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3624,7 +3757,7 @@ type DescribeMutableStateResponse struct {
 func (m *DescribeMutableStateResponse) Reset()      { *m = DescribeMutableStateResponse{} }
 func (*DescribeMutableStateResponse) ProtoMessage() {}
 func (*DescribeMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{57}
+	return fileDescriptor_b8c78c1d460a3711, []int{59}
 }
 func (m *DescribeMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3679,7 +3812,7 @@ type DescribeHistoryHostRequest struct {
 func (m *DescribeHistoryHostRequest) Reset()      { *m = DescribeHistoryHostRequest{} }
 func (*DescribeHistoryHostRequest) ProtoMessage() {}
 func (*DescribeHistoryHostRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{58}
+	return fileDescriptor_b8c78c1d460a3711, []int{60}
 }
 func (m *DescribeHistoryHostRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3746,7 +3879,7 @@ type DescribeHistoryHostResponse struct {
 func (m *DescribeHistoryHostResponse) Reset()      { *m = DescribeHistoryHostResponse{} }
 func (*DescribeHistoryHostResponse) ProtoMessage() {}
 func (*DescribeHistoryHostResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{59}
+	return fileDescriptor_b8c78c1d460a3711, []int{61}
 }
 func (m *DescribeHistoryHostResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3810,7 +3943,7 @@ type CloseShardRequest struct {
 func (m *CloseShardRequest) Reset()      { *m = CloseShardRequest{} }
 func (*CloseShardRequest) ProtoMessage() {}
 func (*CloseShardRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{60}
+	return fileDescriptor_b8c78c1d460a3711, []int{62}
 }
 func (m *CloseShardRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3852,7 +3985,7 @@ type CloseShardResponse struct {
 func (m *CloseShardResponse) Reset()      { *m = CloseShardResponse{} }
 func (*CloseShardResponse) ProtoMessage() {}
 func (*CloseShardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{61}
+	return fileDescriptor_b8c78c1d460a3711, []int{63}
 }
 func (m *CloseShardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3888,7 +4021,7 @@ type GetShardRequest struct {
 func (m *GetShardRequest) Reset()      { *m = GetShardRequest{} }
 func (*GetShardRequest) ProtoMessage() {}
 func (*GetShardRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{62}
+	return fileDescriptor_b8c78c1d460a3711, []int{64}
 }
 func (m *GetShardRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3931,7 +4064,7 @@ type GetShardResponse struct {
 func (m *GetShardResponse) Reset()      { *m = GetShardResponse{} }
 func (*GetShardResponse) ProtoMessage() {}
 func (*GetShardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{63}
+	return fileDescriptor_b8c78c1d460a3711, []int{65}
 }
 func (m *GetShardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3977,7 +4110,7 @@ type RemoveTaskRequest struct {
 func (m *RemoveTaskRequest) Reset()      { *m = RemoveTaskRequest{} }
 func (*RemoveTaskRequest) ProtoMessage() {}
 func (*RemoveTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{64}
+	return fileDescriptor_b8c78c1d460a3711, []int{66}
 }
 func (m *RemoveTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4040,7 +4173,7 @@ type RemoveTaskResponse struct {
 func (m *RemoveTaskResponse) Reset()      { *m = RemoveTaskResponse{} }
 func (*RemoveTaskResponse) ProtoMessage() {}
 func (*RemoveTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{65}
+	return fileDescriptor_b8c78c1d460a3711, []int{67}
 }
 func (m *RemoveTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4069,6 +4202,101 @@ func (m *RemoveTaskResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RemoveTaskResponse proto.InternalMessageInfo
 
+type DescribeHistoryQueueRequest struct {
+	ShardId int32 `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	// Describe all the queues of the shard if unspecified.
+	Category v17.TaskCategory `protobuf:"varint,2,opt,name=category,proto3,enum=temporal.server.api.enums.v1.TaskCategory" json:"category,omitempty"`
+}
+
+func (m *DescribeHistoryQueueRequest) Reset()      { *m = DescribeHistoryQueueRequest{} }
+func (*DescribeHistoryQueueRequest) ProtoMessage() {}
+func (*DescribeHistoryQueueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{68}
+}
+func (m *DescribeHistoryQueueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeHistoryQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeHistoryQueueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DescribeHistoryQueueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeHistoryQueueRequest.Merge(m, src)
+}
+func (m *DescribeHistoryQueueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeHistoryQueueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeHistoryQueueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeHistoryQueueRequest proto.InternalMessageInfo
+
+func (m *DescribeHistoryQueueRequest) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *DescribeHistoryQueueRequest) GetCategory() v17.TaskCategory {
+	if m != nil {
+		return m.Category
+	}
+	return v17.TASK_CATEGORY_UNSPECIFIED
+}
+
+type DescribeHistoryQueueResponse struct {
+	Queues []*v18.HistoryQueueInfo `protobuf:"bytes,1,rep,name=queues,proto3" json:"queues,omitempty"`
+}
+
+func (m *DescribeHistoryQueueResponse) Reset()      { *m = DescribeHistoryQueueResponse{} }
+func (*DescribeHistoryQueueResponse) ProtoMessage() {}
+func (*DescribeHistoryQueueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8c78c1d460a3711, []int{69}
+}
+func (m *DescribeHistoryQueueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeHistoryQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeHistoryQueueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DescribeHistoryQueueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeHistoryQueueResponse.Merge(m, src)
+}
+func (m *DescribeHistoryQueueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeHistoryQueueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeHistoryQueueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeHistoryQueueResponse proto.InternalMessageInfo
+
+func (m *DescribeHistoryQueueResponse) GetQueues() []*v18.HistoryQueueInfo {
+	if m != nil {
+		return m.Queues
+	}
+	return nil
+}
+
 type GetReplicationMessagesRequest struct {
 	Tokens      []*v115.ReplicationToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
 	ClusterName string                   `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
@@ -4077,7 +4305,7 @@ type GetReplicationMessagesRequest struct {
 func (m *GetReplicationMessagesRequest) Reset()      { *m = GetReplicationMessagesRequest{} }
 func (*GetReplicationMessagesRequest) ProtoMessage() {}
 func (*GetReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{66}
+	return fileDescriptor_b8c78c1d460a3711, []int{70}
 }
 func (m *GetReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4127,7 +4355,7 @@ type GetReplicationMessagesResponse struct {
 func (m *GetReplicationMessagesResponse) Reset()      { *m = GetReplicationMessagesResponse{} }
 func (*GetReplicationMessagesResponse) ProtoMessage() {}
 func (*GetReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{67}
+	return fileDescriptor_b8c78c1d460a3711, []int{71}
 }
 func (m *GetReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4170,7 +4398,7 @@ type GetDLQReplicationMessagesRequest struct {
 func (m *GetDLQReplicationMessagesRequest) Reset()      { *m = GetDLQReplicationMessagesRequest{} }
 func (*GetDLQReplicationMessagesRequest) ProtoMessage() {}
 func (*GetDLQReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{68}
+	return fileDescriptor_b8c78c1d460a3711, []int{72}
 }
 func (m *GetDLQReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4213,7 +4441,7 @@ type GetDLQReplicationMessagesResponse struct {
 func (m *GetDLQReplicationMessagesResponse) Reset()      { *m = GetDLQReplicationMessagesResponse{} }
 func (*GetDLQReplicationMessagesResponse) ProtoMessage() {}
 func (*GetDLQReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{69}
+	return fileDescriptor_b8c78c1d460a3711, []int{73}
 }
 func (m *GetDLQReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4257,7 +4485,7 @@ type QueryWorkflowRequest struct {
 func (m *QueryWorkflowRequest) Reset()      { *m = QueryWorkflowRequest{} }
 func (*QueryWorkflowRequest) ProtoMessage() {}
 func (*QueryWorkflowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{70}
+	return fileDescriptor_b8c78c1d460a3711, []int{74}
 }
 func (m *QueryWorkflowRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4307,7 +4535,7 @@ type QueryWorkflowResponse struct {
 func (m *QueryWorkflowResponse) Reset()      { *m = QueryWorkflowResponse{} }
 func (*QueryWorkflowResponse) ProtoMessage() {}
 func (*QueryWorkflowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{71}
+	return fileDescriptor_b8c78c1d460a3711, []int{75}
 }
 func (m *QueryWorkflowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4351,7 +4579,7 @@ type ReapplyEventsRequest struct {
 func (m *ReapplyEventsRequest) Reset()      { *m = ReapplyEventsRequest{} }
 func (*ReapplyEventsRequest) ProtoMessage() {}
 func (*ReapplyEventsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{72}
+	return fileDescriptor_b8c78c1d460a3711, []int{76}
 }
 func (m *ReapplyEventsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4400,7 +4628,7 @@ type ReapplyEventsResponse struct {
 func (m *ReapplyEventsResponse) Reset()      { *m = ReapplyEventsResponse{} }
 func (*ReapplyEventsResponse) ProtoMessage() {}
 func (*ReapplyEventsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{73}
+	return fileDescriptor_b8c78c1d460a3711, []int{77}
 }
 func (m *ReapplyEventsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4441,7 +4669,7 @@ type GetDLQMessagesRequest struct {
 func (m *GetDLQMessagesRequest) Reset()      { *m = GetDLQMessagesRequest{} }
 func (*GetDLQMessagesRequest) ProtoMessage() {}
 func (*GetDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{74}
+	return fileDescriptor_b8c78c1d460a3711, []int{78}
 }
 func (m *GetDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4522,7 +4750,7 @@ type GetDLQMessagesResponse struct {
 func (m *GetDLQMessagesResponse) Reset()      { *m = GetDLQMessagesResponse{} }
 func (*GetDLQMessagesResponse) ProtoMessage() {}
 func (*GetDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{75}
+	return fileDescriptor_b8c78c1d460a3711, []int{79}
 }
 func (m *GetDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4589,7 +4817,7 @@ type PurgeDLQMessagesRequest struct {
 func (m *PurgeDLQMessagesRequest) Reset()      { *m = PurgeDLQMessagesRequest{} }
 func (*PurgeDLQMessagesRequest) ProtoMessage() {}
 func (*PurgeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{76}
+	return fileDescriptor_b8c78c1d460a3711, []int{80}
 }
 func (m *PurgeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4652,7 +4880,7 @@ type PurgeDLQMessagesResponse struct {
 func (m *PurgeDLQMessagesResponse) Reset()      { *m = PurgeDLQMessagesResponse{} }
 func (*PurgeDLQMessagesResponse) ProtoMessage() {}
 func (*PurgeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{77}
+	return fileDescriptor_b8c78c1d460a3711, []int{81}
 }
 func (m *PurgeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4693,7 +4921,7 @@ type MergeDLQMessagesRequest struct {
 func (m *MergeDLQMessagesRequest) Reset()      { *m = MergeDLQMessagesRequest{} }
 func (*MergeDLQMessagesRequest) ProtoMessage() {}
 func (*MergeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{78}
+	return fileDescriptor_b8c78c1d460a3711, []int{82}
 }
 func (m *MergeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4771,7 +4999,7 @@ type MergeDLQMessagesResponse struct {
 func (m *MergeDLQMessagesResponse) Reset()      { *m = MergeDLQMessagesResponse{} }
 func (*MergeDLQMessagesResponse) ProtoMessage() {}
 func (*MergeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{79}
+	return fileDescriptor_b8c78c1d460a3711, []int{83}
 }
 func (m *MergeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4815,7 +5043,7 @@ type RefreshWorkflowTasksRequest struct {
 func (m *RefreshWorkflowTasksRequest) Reset()      { *m = RefreshWorkflowTasksRequest{} }
 func (*RefreshWorkflowTasksRequest) ProtoMessage() {}
 func (*RefreshWorkflowTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{80}
+	return fileDescriptor_b8c78c1d460a3711, []int{84}
 }
 func (m *RefreshWorkflowTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4864,7 +5092,7 @@ type RefreshWorkflowTasksResponse struct {
 func (m *RefreshWorkflowTasksResponse) Reset()      { *m = RefreshWorkflowTasksResponse{} }
 func (*RefreshWorkflowTasksResponse) ProtoMessage() {}
 func (*RefreshWorkflowTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{81}
+	return fileDescriptor_b8c78c1d460a3711, []int{85}
 }
 func (m *RefreshWorkflowTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4903,7 +5131,7 @@ func (m *GenerateLastHistoryReplicationTasksRequest) Reset() {
 }
 func (*GenerateLastHistoryReplicationTasksRequest) ProtoMessage() {}
 func (*GenerateLastHistoryReplicationTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{82}
+	return fileDescriptor_b8c78c1d460a3711, []int{86}
 }
 func (m *GenerateLastHistoryReplicationTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4955,7 +5183,7 @@ func (m *GenerateLastHistoryReplicationTasksResponse) Reset() {
 }
 func (*GenerateLastHistoryReplicationTasksResponse) ProtoMessage() {}
 func (*GenerateLastHistoryReplicationTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{83}
+	return fileDescriptor_b8c78c1d460a3711, []int{87}
 }
 func (m *GenerateLastHistoryReplicationTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4999,7 +5227,7 @@ type GetReplicationStatusRequest struct {
 func (m *GetReplicationStatusRequest) Reset()      { *m = GetReplicationStatusRequest{} }
 func (*GetReplicationStatusRequest) ProtoMessage() {}
 func (*GetReplicationStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{84}
+	return fileDescriptor_b8c78c1d460a3711, []int{88}
 }
 func (m *GetReplicationStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5042,7 +5270,7 @@ type GetReplicationStatusResponse struct {
 func (m *GetReplicationStatusResponse) Reset()      { *m = GetReplicationStatusResponse{} }
 func (*GetReplicationStatusResponse) ProtoMessage() {}
 func (*GetReplicationStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{85}
+	return fileDescriptor_b8c78c1d460a3711, []int{89}
 }
 func (m *GetReplicationStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5092,7 +5320,7 @@ type ShardReplicationStatus struct {
 func (m *ShardReplicationStatus) Reset()      { *m = ShardReplicationStatus{} }
 func (*ShardReplicationStatus) ProtoMessage() {}
 func (*ShardReplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{86}
+	return fileDescriptor_b8c78c1d460a3711, []int{90}
 }
 func (m *ShardReplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5172,7 +5400,7 @@ type HandoverNamespaceInfo struct {
 func (m *HandoverNamespaceInfo) Reset()      { *m = HandoverNamespaceInfo{} }
 func (*HandoverNamespaceInfo) ProtoMessage() {}
 func (*HandoverNamespaceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{87}
+	return fileDescriptor_b8c78c1d460a3711, []int{91}
 }
 func (m *HandoverNamespaceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5218,7 +5446,7 @@ type ShardReplicationStatusPerCluster struct {
 func (m *ShardReplicationStatusPerCluster) Reset()      { *m = ShardReplicationStatusPerCluster{} }
 func (*ShardReplicationStatusPerCluster) ProtoMessage() {}
 func (*ShardReplicationStatusPerCluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{88}
+	return fileDescriptor_b8c78c1d460a3711, []int{92}
 }
 func (m *ShardReplicationStatusPerCluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5269,7 +5497,7 @@ type RebuildMutableStateRequest struct {
 func (m *RebuildMutableStateRequest) Reset()      { *m = RebuildMutableStateRequest{} }
 func (*RebuildMutableStateRequest) ProtoMessage() {}
 func (*RebuildMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{89}
+	return fileDescriptor_b8c78c1d460a3711, []int{93}
 }
 func (m *RebuildMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5318,7 +5546,7 @@ type RebuildMutableStateResponse struct {
 func (m *RebuildMutableStateResponse) Reset()      { *m = RebuildMutableStateResponse{} }
 func (*RebuildMutableStateResponse) ProtoMessage() {}
 func (*RebuildMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{90}
+	return fileDescriptor_b8c78c1d460a3711, []int{94}
 }
 func (m *RebuildMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5357,7 +5585,7 @@ type DeleteWorkflowVisibilityRecordRequest struct {
 func (m *DeleteWorkflowVisibilityRecordRequest) Reset()      { *m = DeleteWorkflowVisibilityRecordRequest{} }
 func (*DeleteWorkflowVisibilityRecordRequest) ProtoMessage() {}
 func (*DeleteWorkflowVisibilityRecordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{91}
+	return fileDescriptor_b8c78c1d460a3711, []int{95}
 }
 func (m *DeleteWorkflowVisibilityRecordRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5422,7 +5650,7 @@ func (m *DeleteWorkflowVisibilityRecordResponse) Reset() {
 }
 func (*DeleteWorkflowVisibilityRecordResponse) ProtoMessage() {}
 func (*DeleteWorkflowVisibilityRecordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{92}
+	return fileDescriptor_b8c78c1d460a3711, []int{96}
 }
 func (m *DeleteWorkflowVisibilityRecordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5462,7 +5690,7 @@ type UpdateWorkflowExecutionRequest struct {
 func (m *UpdateWorkflowExecutionRequest) Reset()      { *m = UpdateWorkflowExecutionRequest{} }
 func (*UpdateWorkflowExecutionRequest) ProtoMessage() {}
 func (*UpdateWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{93}
+	return fileDescriptor_b8c78c1d460a3711, []int{97}
 }
 func (m *UpdateWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5512,7 +5740,7 @@ type UpdateWorkflowExecutionResponse struct {
 func (m *UpdateWorkflowExecutionResponse) Reset()      { *m = UpdateWorkflowExecutionResponse{} }
 func (*UpdateWorkflowExecutionResponse) ProtoMessage() {}
 func (*UpdateWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{94}
+	return fileDescriptor_b8c78c1d460a3711, []int{98}
 }
 func (m *UpdateWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5550,7 +5778,6 @@ func (m *UpdateWorkflowExecutionResponse) GetResponse() *v1.UpdateWorkflowExecut
 
 type StreamWorkflowReplicationMessagesRequest struct {
 	// Types that are valid to be assigned to Attributes:
-	//
 	//	*StreamWorkflowReplicationMessagesRequest_SyncReplicationState
 	Attributes isStreamWorkflowReplicationMessagesRequest_Attributes `protobuf_oneof:"attributes"`
 }
@@ -5560,7 +5787,7 @@ func (m *StreamWorkflowReplicationMessagesRequest) Reset() {
 }
 func (*StreamWorkflowReplicationMessagesRequest) ProtoMessage() {}
 func (*StreamWorkflowReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{95}
+	return fileDescriptor_b8c78c1d460a3711, []int{99}
 }
 func (m *StreamWorkflowReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5626,7 +5853,6 @@ func (*StreamWorkflowReplicationMessagesRequest) XXX_OneofWrappers() []interface
 
 type StreamWorkflowReplicationMessagesResponse struct {
 	// Types that are valid to be assigned to Attributes:
-	//
 	//	*StreamWorkflowReplicationMessagesResponse_Messages
 	Attributes isStreamWorkflowReplicationMessagesResponse_Attributes `protobuf_oneof:"attributes"`
 }
@@ -5636,7 +5862,7 @@ func (m *StreamWorkflowReplicationMessagesResponse) Reset() {
 }
 func (*StreamWorkflowReplicationMessagesResponse) ProtoMessage() {}
 func (*StreamWorkflowReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{96}
+	return fileDescriptor_b8c78c1d460a3711, []int{100}
 }
 func (m *StreamWorkflowReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5708,7 +5934,7 @@ type PollWorkflowExecutionUpdateRequest struct {
 func (m *PollWorkflowExecutionUpdateRequest) Reset()      { *m = PollWorkflowExecutionUpdateRequest{} }
 func (*PollWorkflowExecutionUpdateRequest) ProtoMessage() {}
 func (*PollWorkflowExecutionUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{97}
+	return fileDescriptor_b8c78c1d460a3711, []int{101}
 }
 func (m *PollWorkflowExecutionUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5758,7 +5984,7 @@ type PollWorkflowExecutionUpdateResponse struct {
 func (m *PollWorkflowExecutionUpdateResponse) Reset()      { *m = PollWorkflowExecutionUpdateResponse{} }
 func (*PollWorkflowExecutionUpdateResponse) ProtoMessage() {}
 func (*PollWorkflowExecutionUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b8c78c1d460a3711, []int{98}
+	return fileDescriptor_b8c78c1d460a3711, []int{102}
 }
 func (m *PollWorkflowExecutionUpdateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5832,6 +6058,8 @@ func init() {
 	proto.RegisterType((*DeleteWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.DeleteWorkflowExecutionResponse")
 	proto.RegisterType((*ResetWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.ResetWorkflowExecutionRequest")
 	proto.RegisterType((*ResetWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.ResetWorkflowExecutionResponse")
+	proto.RegisterType((*RepinWorkflowBuildIdRequest)(nil), "temporal.server.api.historyservice.v1.RepinWorkflowBuildIdRequest")
+	proto.RegisterType((*RepinWorkflowBuildIdResponse)(nil), "temporal.server.api.historyservice.v1.RepinWorkflowBuildIdResponse")
 	proto.RegisterType((*RequestCancelWorkflowExecutionRequest)(nil), "temporal.server.api.historyservice.v1.RequestCancelWorkflowExecutionRequest")
 	proto.RegisterType((*RequestCancelWorkflowExecutionResponse)(nil), "temporal.server.api.historyservice.v1.RequestCancelWorkflowExecutionResponse")
 	proto.RegisterType((*ScheduleWorkflowTaskRequest)(nil), "temporal.server.api.historyservice.v1.ScheduleWorkflowTaskRequest")
@@ -5862,6 +6090,8 @@ func init() {
 	proto.RegisterType((*GetShardResponse)(nil), "temporal.server.api.historyservice.v1.GetShardResponse")
 	proto.RegisterType((*RemoveTaskRequest)(nil), "temporal.server.api.historyservice.v1.RemoveTaskRequest")
 	proto.RegisterType((*RemoveTaskResponse)(nil), "temporal.server.api.historyservice.v1.RemoveTaskResponse")
+	proto.RegisterType((*DescribeHistoryQueueRequest)(nil), "temporal.server.api.historyservice.v1.DescribeHistoryQueueRequest")
+	proto.RegisterType((*DescribeHistoryQueueResponse)(nil), "temporal.server.api.historyservice.v1.DescribeHistoryQueueResponse")
 	proto.RegisterType((*GetReplicationMessagesRequest)(nil), "temporal.server.api.historyservice.v1.GetReplicationMessagesRequest")
 	proto.RegisterType((*GetReplicationMessagesResponse)(nil), "temporal.server.api.historyservice.v1.GetReplicationMessagesResponse")
 	proto.RegisterMapType((map[int32]*v115.ReplicationMessages)(nil), "temporal.server.api.historyservice.v1.GetReplicationMessagesResponse.ShardMessagesEntry")
@@ -5905,309 +6135,315 @@ func init() {
 }
 
 var fileDescriptor_b8c78c1d460a3711 = []byte{
-	// 4819 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x49, 0x6c, 0x1c, 0xd9,
-	0x75, 0x2a, 0x76, 0x37, 0xd9, 0x7c, 0x24, 0x7b, 0x29, 0x6e, 0x2d, 0x4a, 0x6a, 0x51, 0xa5, 0x8d,
-	0xd2, 0x8c, 0x5a, 0xdb, 0xd8, 0x23, 0x2b, 0x9e, 0x19, 0x8b, 0xd4, 0x46, 0x41, 0x92, 0x39, 0x45,
-	0x8e, 0x66, 0x32, 0x1e, 0xb9, 0xa6, 0x58, 0xf5, 0x49, 0x56, 0xd8, 0x5d, 0xd5, 0xaa, 0x5f, 0x4d,
-	0xb2, 0x27, 0x07, 0x07, 0x30, 0xb2, 0xd8, 0x87, 0x64, 0x80, 0x5c, 0x8c, 0xc0, 0xc9, 0x21, 0x40,
-	0x12, 0x23, 0x40, 0x90, 0x43, 0x0e, 0x86, 0x0f, 0xbe, 0x24, 0x40, 0x10, 0x04, 0x39, 0x0c, 0x72,
-	0xc9, 0x20, 0x01, 0xe2, 0x8c, 0x06, 0x41, 0x6c, 0x24, 0x07, 0x1f, 0x83, 0x20, 0x87, 0xe0, 0x6f,
-	0xd5, 0xb5, 0xf6, 0x42, 0x4a, 0xd1, 0x78, 0x3c, 0x37, 0xf6, 0xff, 0xff, 0xbd, 0xff, 0xf6, 0xf7,
-	0xff, 0xfb, 0xaf, 0x08, 0x5f, 0xf5, 0x50, 0xa3, 0xe9, 0xb8, 0x7a, 0xfd, 0x22, 0x46, 0xee, 0x0e,
-	0x72, 0x2f, 0xea, 0x4d, 0xeb, 0xe2, 0x96, 0x85, 0x3d, 0xc7, 0x6d, 0x93, 0x11, 0xcb, 0x40, 0x17,
-	0x77, 0x2e, 0x5f, 0x74, 0xd1, 0x93, 0x16, 0xc2, 0x9e, 0xe6, 0x22, 0xdc, 0x74, 0x6c, 0x8c, 0x6a,
-	0x4d, 0xd7, 0xf1, 0x1c, 0xf9, 0xb4, 0x80, 0xae, 0x31, 0xe8, 0x9a, 0xde, 0xb4, 0x6a, 0x61, 0xe8,
-	0xda, 0xce, 0xe5, 0xb9, 0xea, 0xa6, 0xe3, 0x6c, 0xd6, 0xd1, 0x45, 0x0a, 0xb4, 0xde, 0xda, 0xb8,
-	0x68, 0xb6, 0x5c, 0xdd, 0xb3, 0x1c, 0x9b, 0xa1, 0x99, 0x3b, 0x1e, 0x9d, 0xf7, 0xac, 0x06, 0xc2,
-	0x9e, 0xde, 0x68, 0xf2, 0x05, 0x27, 0x4c, 0xd4, 0x44, 0xb6, 0x89, 0x6c, 0xc3, 0x42, 0xf8, 0xe2,
-	0xa6, 0xb3, 0xe9, 0xd0, 0x71, 0xfa, 0x17, 0x5f, 0x72, 0xca, 0x67, 0x84, 0x70, 0x60, 0x38, 0x8d,
-	0x86, 0x63, 0x13, 0xca, 0x1b, 0x08, 0x63, 0x7d, 0x93, 0x13, 0x3c, 0x77, 0x3a, 0xb4, 0x8a, 0x53,
-	0x1a, 0x5f, 0x76, 0x36, 0xb4, 0xcc, 0xd3, 0xf1, 0xf6, 0x93, 0x16, 0x6a, 0xa1, 0xf8, 0xc2, 0xf0,
-	0xae, 0xc8, 0x6e, 0x35, 0x30, 0x59, 0xb4, 0xeb, 0xb8, 0xdb, 0x1b, 0x75, 0x67, 0x97, 0xaf, 0x3a,
-	0x13, 0x5a, 0x25, 0x26, 0xe3, 0xd8, 0x4e, 0x86, 0xd6, 0x3d, 0x69, 0xa1, 0x24, 0xda, 0xc2, 0xc8,
-	0xe8, 0x98, 0xe1, 0xd4, 0x7b, 0xb1, 0xba, 0xa1, 0x5b, 0xf5, 0x96, 0x9b, 0xc0, 0xc1, 0xf9, 0x24,
-	0x03, 0x30, 0xea, 0x8e, 0xb1, 0x1d, 0x5f, 0xfb, 0x72, 0x17, 0x63, 0x89, 0xaf, 0x3e, 0x97, 0xb4,
-	0xda, 0x17, 0x11, 0xd3, 0x10, 0x5f, 0xfa, 0x52, 0xd7, 0xa5, 0x11, 0x69, 0x9e, 0xed, 0xba, 0x98,
-	0x28, 0x8b, 0x2f, 0xbc, 0x90, 0xb4, 0x30, 0x5d, 0xfa, 0xb5, 0xa4, 0xe5, 0xb6, 0xde, 0x40, 0xb8,
-	0xa9, 0x1b, 0x09, 0x92, 0xbb, 0x94, 0xb4, 0xde, 0x45, 0xcd, 0xba, 0x65, 0x50, 0xe3, 0x8e, 0x43,
-	0x5c, 0x4d, 0x82, 0x68, 0x22, 0x17, 0x5b, 0xd8, 0x43, 0x36, 0xdb, 0x03, 0xed, 0x21, 0xa3, 0x45,
-	0xc0, 0x31, 0x07, 0x7a, 0xa3, 0x0f, 0x20, 0xc1, 0x94, 0xd6, 0x68, 0x79, 0xfa, 0x7a, 0x1d, 0x69,
-	0xd8, 0xd3, 0x3d, 0xb1, 0xeb, 0x97, 0x13, 0xad, 0xaf, 0xa7, 0x73, 0xcf, 0x5d, 0x4f, 0xda, 0x58,
-	0x37, 0x1b, 0x96, 0xdd, 0x13, 0x56, 0xf9, 0xd9, 0x30, 0x1c, 0x5b, 0xf5, 0x74, 0xd7, 0x7b, 0x9b,
-	0x6f, 0x77, 0x4b, 0xb0, 0xa5, 0x32, 0x00, 0xf9, 0x04, 0x8c, 0xfb, 0xb2, 0xd5, 0x2c, 0xb3, 0x22,
-	0xcd, 0x4b, 0x0b, 0xa3, 0xea, 0x98, 0x3f, 0xb6, 0x6c, 0xca, 0x06, 0x4c, 0x60, 0x82, 0x43, 0xe3,
-	0x9b, 0x54, 0x86, 0xe6, 0xa5, 0x85, 0xb1, 0x2b, 0xaf, 0xfb, 0x8a, 0xa2, 0xe1, 0x26, 0xc2, 0x50,
-	0x6d, 0xe7, 0x72, 0xad, 0xeb, 0xce, 0xea, 0x38, 0x45, 0x2a, 0xe8, 0xd8, 0x82, 0xe9, 0xa6, 0xee,
-	0x22, 0xdb, 0xd3, 0x7c, 0xc9, 0x6b, 0x96, 0xbd, 0xe1, 0x54, 0x32, 0x74, 0xb3, 0x57, 0x6a, 0x49,
-	0x21, 0xce, 0xb7, 0xc8, 0x9d, 0xcb, 0xb5, 0x15, 0x0a, 0xed, 0xef, 0xb2, 0x6c, 0x6f, 0x38, 0xea,
-	0x64, 0x33, 0x3e, 0x28, 0x57, 0x60, 0x44, 0xf7, 0x08, 0x36, 0xaf, 0x92, 0x9d, 0x97, 0x16, 0x72,
-	0xaa, 0xf8, 0x29, 0x37, 0x40, 0xf1, 0x35, 0xd8, 0xa1, 0x02, 0xed, 0x35, 0x2d, 0x16, 0x26, 0x35,
-	0x12, 0x0f, 0x2b, 0x39, 0x4a, 0xd0, 0x5c, 0x8d, 0x05, 0xcb, 0x9a, 0x08, 0x96, 0xb5, 0x35, 0x11,
-	0x2c, 0x17, 0xb3, 0x1f, 0xfe, 0xe4, 0xb8, 0xa4, 0x1e, 0xdf, 0x8d, 0x72, 0x7e, 0xcb, 0xc7, 0x44,
-	0xd6, 0xca, 0x5b, 0x70, 0xd8, 0x70, 0x6c, 0xcf, 0xb2, 0x5b, 0x48, 0xd3, 0xb1, 0x66, 0xa3, 0x5d,
-	0xcd, 0xb2, 0x2d, 0xcf, 0xd2, 0x3d, 0xc7, 0xad, 0x0c, 0xcf, 0x4b, 0x0b, 0x85, 0x2b, 0x17, 0xc2,
-	0x32, 0xa6, 0xde, 0x45, 0x98, 0x5d, 0xe2, 0x70, 0x37, 0xf0, 0x43, 0xb4, 0xbb, 0x2c, 0x80, 0xd4,
-	0x19, 0x23, 0x71, 0x5c, 0x7e, 0x00, 0x65, 0x31, 0x63, 0x6a, 0x3c, 0x04, 0x55, 0x46, 0x28, 0x1f,
-	0xf3, 0xe1, 0x1d, 0xf8, 0x24, 0xd9, 0xe3, 0x36, 0xfb, 0x53, 0x2d, 0xf9, 0xa0, 0x7c, 0x44, 0x7e,
-	0x04, 0x33, 0x75, 0x1d, 0x7b, 0x9a, 0xe1, 0x34, 0x9a, 0x75, 0x44, 0x25, 0xe3, 0x22, 0xdc, 0xaa,
-	0x7b, 0x95, 0x7c, 0x12, 0x4e, 0x1e, 0x62, 0xa8, 0x8e, 0xda, 0x75, 0x47, 0x37, 0xb1, 0x3a, 0x45,
-	0xe0, 0x97, 0x7c, 0x70, 0x95, 0x42, 0xcb, 0xdf, 0x84, 0x23, 0x1b, 0x96, 0x8b, 0x3d, 0xcd, 0xd7,
-	0x02, 0x89, 0x22, 0xda, 0xba, 0x6e, 0x6c, 0x3b, 0x1b, 0x1b, 0x95, 0x51, 0x8a, 0xfc, 0x70, 0x4c,
-	0xf0, 0x37, 0x79, 0x16, 0x5b, 0xcc, 0x7e, 0x8f, 0xc8, 0xbd, 0x42, 0x71, 0x08, 0xb3, 0x5b, 0xd3,
-	0xf1, 0xf6, 0x22, 0x43, 0x20, 0xbf, 0x07, 0x53, 0xd8, 0x69, 0xb9, 0x06, 0xd2, 0x76, 0x88, 0xdf,
-	0x3a, 0xb6, 0x46, 0xf5, 0x55, 0x01, 0x8a, 0xf8, 0x7c, 0x1a, 0xd5, 0x04, 0x15, 0x72, 0x1f, 0x31,
-	0x90, 0x55, 0x02, 0xa1, 0xca, 0x0c, 0x4f, 0x70, 0x4c, 0xf9, 0xa9, 0x04, 0xd5, 0x34, 0x8b, 0x67,
-	0x4e, 0x29, 0x4f, 0xc3, 0xb0, 0xdb, 0xb2, 0x3b, 0x6e, 0x96, 0x73, 0x5b, 0xf6, 0xb2, 0x29, 0xbf,
-	0x01, 0x39, 0x1a, 0xe9, 0xb9, 0x63, 0x9d, 0x4b, 0xb4, 0x75, 0xba, 0x82, 0x90, 0xf3, 0x08, 0x19,
-	0x9e, 0xe3, 0x2e, 0x91, 0x9f, 0x2a, 0x83, 0x93, 0x6d, 0x98, 0x44, 0xfa, 0x26, 0x72, 0xc3, 0x82,
-	0xe3, 0xae, 0xd3, 0xdb, 0x4f, 0x57, 0x9c, 0x7a, 0x3d, 0x28, 0xaf, 0x37, 0x49, 0x92, 0x15, 0x44,
-	0xab, 0x65, 0x8a, 0x3a, 0x38, 0xaf, 0xfc, 0xa7, 0x04, 0x33, 0x77, 0x90, 0xf7, 0x80, 0x45, 0xb9,
-	0x55, 0x12, 0xe4, 0x06, 0x88, 0x27, 0x77, 0x60, 0xd4, 0xf7, 0xae, 0x38, 0xcb, 0x71, 0xd9, 0x87,
-	0x65, 0xd9, 0x81, 0x95, 0xaf, 0xc2, 0x0c, 0xda, 0x6b, 0x22, 0xc3, 0x43, 0xa6, 0x66, 0xa3, 0x3d,
-	0x4f, 0x43, 0x3b, 0x24, 0x80, 0x58, 0x26, 0xe5, 0x3c, 0xa3, 0x4e, 0x8a, 0xd9, 0x87, 0x68, 0xcf,
-	0xbb, 0x45, 0xe6, 0x96, 0x4d, 0xf9, 0x12, 0x4c, 0x19, 0x2d, 0x97, 0x46, 0x9a, 0x75, 0x57, 0xb7,
-	0x8d, 0x2d, 0xcd, 0x73, 0xb6, 0x91, 0x4d, 0x63, 0xc1, 0xb8, 0x2a, 0xf3, 0xb9, 0x45, 0x3a, 0xb5,
-	0x46, 0x66, 0x94, 0x1f, 0x8f, 0xc2, 0x6c, 0x8c, 0x5b, 0xae, 0xd1, 0x10, 0x2f, 0xd2, 0x01, 0x78,
-	0x59, 0x86, 0x89, 0x8e, 0xf2, 0xda, 0x4d, 0xc4, 0x05, 0x73, 0xaa, 0x17, 0xb2, 0xb5, 0x76, 0x13,
-	0xa9, 0xe3, 0xbb, 0x81, 0x5f, 0xb2, 0x02, 0x13, 0x49, 0xd2, 0x18, 0xb3, 0x03, 0x52, 0xf8, 0x0a,
-	0x1c, 0x6e, 0xba, 0x68, 0xc7, 0x72, 0x5a, 0x58, 0xa3, 0x71, 0x18, 0x99, 0x9d, 0xf5, 0x59, 0xba,
-	0x7e, 0x46, 0x2c, 0x58, 0x65, 0xf3, 0x02, 0xf4, 0x02, 0x4c, 0x52, 0xef, 0x67, 0xae, 0xea, 0x03,
-	0xe5, 0x28, 0x50, 0x89, 0x4c, 0xdd, 0x26, 0x33, 0x62, 0xf9, 0x12, 0x00, 0xf5, 0x62, 0x7a, 0x72,
-	0xa3, 0x61, 0x2d, 0xc6, 0x95, 0x7f, 0xb0, 0x23, 0x8c, 0x75, 0x0c, 0x70, 0xd4, 0x13, 0x7f, 0xca,
-	0x2b, 0x50, 0xc6, 0x9e, 0x65, 0x6c, 0xb7, 0xb5, 0x00, 0xae, 0x91, 0x01, 0x70, 0x15, 0x19, 0xb8,
-	0x3f, 0x20, 0xff, 0x3a, 0xbc, 0x14, 0xc3, 0xa8, 0x61, 0x63, 0x0b, 0x99, 0xad, 0x3a, 0xd2, 0x3c,
-	0x87, 0x49, 0x85, 0x46, 0x7c, 0xa7, 0xe5, 0x55, 0xc6, 0xfa, 0x8b, 0x3d, 0xa7, 0x23, 0xdb, 0xac,
-	0x72, 0x84, 0x6b, 0x0e, 0x15, 0xe2, 0x1a, 0xc3, 0x96, 0x6a, 0x83, 0x13, 0x69, 0x36, 0x28, 0x7f,
-	0x03, 0x0a, 0xbe, 0x79, 0xd0, 0x43, 0x45, 0xa5, 0x48, 0x13, 0x44, 0x72, 0x5e, 0xf4, 0xf3, 0x44,
-	0xcc, 0xe4, 0x98, 0xf5, 0xfa, 0xa6, 0x46, 0x7f, 0xca, 0x6f, 0x43, 0x31, 0x84, 0xbc, 0x85, 0x2b,
-	0x25, 0x8a, 0xbd, 0x96, 0x92, 0x7e, 0x12, 0xd1, 0xb6, 0xb0, 0x5a, 0x08, 0xe2, 0x6d, 0x61, 0xf9,
-	0x31, 0x94, 0x45, 0xa4, 0x65, 0xc7, 0x53, 0x0b, 0xe1, 0x4a, 0x99, 0x8a, 0xf2, 0x52, 0xad, 0xcb,
-	0x9d, 0x85, 0x85, 0x39, 0x0a, 0x78, 0x57, 0xc0, 0xa9, 0xa5, 0x9d, 0xc8, 0x88, 0xfc, 0x3a, 0x1c,
-	0xb5, 0x88, 0xf9, 0x46, 0xd5, 0x88, 0x6c, 0xe2, 0xa8, 0x66, 0x45, 0x9e, 0x97, 0x16, 0xf2, 0x6a,
-	0xc5, 0xc2, 0xab, 0x61, 0xad, 0xdc, 0x62, 0xf3, 0xf2, 0x2b, 0x30, 0x1b, 0xb3, 0x64, 0x6f, 0x8f,
-	0xc6, 0xe7, 0x49, 0x16, 0x40, 0xc2, 0xd6, 0xbc, 0xb6, 0x47, 0xa2, 0xf5, 0x55, 0x98, 0xe1, 0x00,
-	0xfe, 0x11, 0x81, 0x07, 0xf5, 0x29, 0x1a, 0xeb, 0x26, 0xe9, 0x6c, 0xc7, 0xc9, 0x69, 0x88, 0x7f,
-	0x0f, 0xa6, 0x76, 0x69, 0x1a, 0x89, 0xa4, 0x9e, 0xe9, 0xc1, 0x53, 0xcf, 0x6e, 0x6c, 0xec, 0x5e,
-	0x36, 0x9f, 0x2f, 0x8d, 0xde, 0xcb, 0xe6, 0x47, 0x4b, 0x70, 0x2f, 0x9b, 0x87, 0xd2, 0xd8, 0xbd,
-	0x6c, 0x7e, 0xbc, 0x34, 0x71, 0x2f, 0x9b, 0x2f, 0x94, 0x8a, 0xca, 0x7f, 0x49, 0x30, 0x4b, 0x42,
-	0xfc, 0x2f, 0x49, 0xb8, 0xfe, 0x83, 0x3c, 0x54, 0xe2, 0xec, 0x7e, 0x11, 0xaf, 0xbf, 0x88, 0xd7,
-	0xcf, 0x3c, 0x5e, 0x8f, 0xa7, 0xc6, 0xeb, 0xc4, 0xc8, 0x57, 0x78, 0x66, 0x91, 0xef, 0x17, 0x33,
-	0x1d, 0x74, 0x89, 0xb7, 0xe5, 0xfd, 0xc4, 0x5b, 0x39, 0x35, 0xde, 0x26, 0x46, 0xc4, 0x89, 0x52,
-	0x41, 0xf9, 0x8e, 0x04, 0x47, 0x54, 0x84, 0x91, 0x17, 0x49, 0x09, 0x2f, 0x20, 0x1e, 0x2a, 0x55,
-	0x38, 0x9a, 0x4c, 0x0a, 0x8b, 0x55, 0xca, 0x0f, 0x32, 0x30, 0xaf, 0x22, 0xc3, 0x71, 0xcd, 0xe0,
-	0xe1, 0x9b, 0x7b, 0xf7, 0x00, 0x04, 0xbf, 0x03, 0x72, 0xfc, 0x5a, 0x3b, 0x38, 0xe5, 0xe5, 0xd8,
-	0x7d, 0x56, 0x7e, 0x19, 0x64, 0xe1, 0x82, 0x66, 0x34, 0x7c, 0x95, 0xfc, 0x19, 0x11, 0x59, 0x66,
-	0x61, 0x84, 0xfa, 0xae, 0x1f, 0xb1, 0x86, 0xc9, 0xcf, 0x65, 0x53, 0x3e, 0x06, 0x20, 0xea, 0x17,
-	0x3c, 0x30, 0x8d, 0xaa, 0xa3, 0x7c, 0x64, 0xd9, 0x94, 0xdf, 0x87, 0xf1, 0xa6, 0x53, 0xaf, 0xfb,
-	0xe5, 0x07, 0x16, 0x93, 0x5e, 0xdb, 0xef, 0xb5, 0x86, 0x55, 0x1f, 0xc6, 0x08, 0x4a, 0x21, 0x44,
-	0xff, 0x02, 0x36, 0xb2, 0xbf, 0x0b, 0x98, 0xf2, 0x93, 0x3c, 0x9c, 0xe8, 0xa2, 0x2a, 0x9e, 0x7c,
-	0x62, 0x39, 0x43, 0xda, 0x77, 0xce, 0xe8, 0x9a, 0x0f, 0x86, 0xba, 0xe6, 0x83, 0xc1, 0x94, 0xb6,
-	0x00, 0xa5, 0x94, 0x7c, 0x53, 0xc0, 0x61, 0xbc, 0xb1, 0x34, 0x96, 0x8b, 0xa7, 0xb1, 0x40, 0xed,
-	0x65, 0x38, 0x5c, 0x7b, 0xb9, 0x06, 0x15, 0x1e, 0xdf, 0x03, 0x95, 0x17, 0x7e, 0x8e, 0x1b, 0xa1,
-	0xe7, 0xb8, 0x19, 0x36, 0xdf, 0xa9, 0xa6, 0xf0, 0x53, 0xdc, 0x13, 0x98, 0xf5, 0x5c, 0xdd, 0xc6,
-	0x16, 0xd9, 0x36, 0x7c, 0x01, 0x66, 0xe5, 0x88, 0xaf, 0xf4, 0x0a, 0xb8, 0x6b, 0x02, 0x3c, 0xa8,
-	0x3c, 0x5a, 0x40, 0x9a, 0xf6, 0x92, 0xa6, 0xe4, 0x4d, 0x38, 0x96, 0x50, 0x28, 0x0a, 0xa4, 0xba,
-	0xd1, 0x01, 0x52, 0xdd, 0x5c, 0xcc, 0xaf, 0x3a, 0x59, 0xef, 0x04, 0x8c, 0x87, 0x12, 0xce, 0x18,
-	0x4d, 0x38, 0x63, 0xeb, 0x81, 0x4c, 0x73, 0x07, 0x0a, 0x1d, 0x75, 0xd2, 0x02, 0xd5, 0x78, 0x9f,
-	0x05, 0xaa, 0x09, 0x1f, 0x8e, 0x96, 0xa3, 0x96, 0x60, 0x5c, 0x68, 0x9a, 0xa2, 0x99, 0xe8, 0x13,
-	0xcd, 0x18, 0x87, 0xa2, 0x48, 0x1c, 0x18, 0x79, 0xd2, 0x42, 0x3c, 0xdb, 0x65, 0x16, 0xc6, 0xae,
-	0xbc, 0x55, 0xeb, 0xeb, 0x6d, 0xa2, 0xd6, 0xd3, 0x7b, 0x6a, 0x6f, 0x32, 0xbc, 0xb7, 0x6c, 0xcf,
-	0x6d, 0xab, 0x62, 0x97, 0x8e, 0xeb, 0x16, 0xf7, 0x59, 0x3b, 0x79, 0x0d, 0xf2, 0xbc, 0x3a, 0x4c,
-	0xd2, 0x1c, 0x21, 0xf9, 0x44, 0x58, 0x6d, 0xa2, 0xb4, 0x4f, 0xe0, 0x1f, 0xb0, 0x95, 0xaa, 0x0f,
-	0x32, 0xf7, 0x3e, 0x8c, 0x07, 0x09, 0x93, 0x4b, 0x90, 0xd9, 0x46, 0x6d, 0x1e, 0x86, 0xc9, 0x9f,
-	0xf2, 0x75, 0xc8, 0xed, 0xe8, 0xf5, 0x56, 0xca, 0x09, 0x91, 0xbe, 0x2e, 0x04, 0x9d, 0x9d, 0x60,
-	0x6b, 0xab, 0x0c, 0xe4, 0xfa, 0xd0, 0x35, 0x89, 0xa5, 0xaf, 0x40, 0x32, 0xb8, 0x61, 0x78, 0xd6,
-	0x8e, 0xe5, 0xb5, 0xbf, 0x48, 0x06, 0x83, 0x26, 0x83, 0xa0, 0xe4, 0x9e, 0x63, 0x32, 0xf8, 0x9b,
-	0xac, 0x48, 0x06, 0x89, 0xaa, 0xe2, 0xc9, 0xe0, 0x21, 0x14, 0x23, 0xe2, 0xe2, 0xe9, 0xe0, 0x74,
-	0x98, 0x97, 0x40, 0x9c, 0x62, 0xe7, 0xbf, 0x36, 0x15, 0xa1, 0x5a, 0x08, 0x8b, 0x34, 0xe6, 0xbe,
-	0x43, 0xfb, 0x71, 0xdf, 0x40, 0x7c, 0xce, 0x84, 0xe3, 0x33, 0x82, 0xaa, 0x38, 0x02, 0xf3, 0x21,
-	0x2d, 0x12, 0x76, 0xb2, 0x7d, 0x6e, 0x78, 0x84, 0xe3, 0xb9, 0xc1, 0xd0, 0xac, 0x86, 0x82, 0xd0,
-	0x03, 0x28, 0x6f, 0x21, 0xdd, 0xf5, 0xd6, 0x91, 0xee, 0x69, 0x26, 0xf2, 0x74, 0xab, 0x8e, 0x79,
-	0xc5, 0xbd, 0x77, 0x55, 0xb9, 0xe4, 0x83, 0xde, 0x64, 0x90, 0xf1, 0x8c, 0x3b, 0xbc, 0xef, 0x8c,
-	0x7b, 0x21, 0xe0, 0x38, 0xbe, 0x43, 0x51, 0x1b, 0x19, 0xed, 0x78, 0xc3, 0x43, 0x31, 0xd1, 0xb1,
-	0xa2, 0xfc, 0x3e, 0xad, 0xe8, 0x47, 0x12, 0x9c, 0x64, 0xc6, 0x12, 0x8a, 0x8a, 0xbc, 0x68, 0x3e,
-	0x90, 0xcf, 0x3b, 0x50, 0xe2, 0xa5, 0x7a, 0x14, 0x79, 0xc3, 0xb9, 0xd9, 0xd3, 0x6f, 0xfa, 0x20,
-	0x41, 0x2d, 0x0a, 0xec, 0x7c, 0x40, 0xf9, 0xe1, 0x10, 0x9c, 0xea, 0x0e, 0xc8, 0x9d, 0x00, 0x77,
-	0x4e, 0x17, 0xe2, 0xe5, 0x8a, 0x7b, 0xc1, 0xdd, 0x67, 0x95, 0x37, 0xc8, 0x55, 0x32, 0xec, 0x79,
-	0x08, 0x0a, 0x3a, 0x77, 0x4c, 0x9a, 0xb3, 0x71, 0x65, 0x88, 0xc6, 0xfd, 0xd7, 0xf7, 0x1b, 0x44,
-	0xf8, 0x46, 0x13, 0x7a, 0x60, 0x0a, 0x93, 0x7b, 0x8b, 0x4b, 0x8e, 0xf7, 0xfc, 0x02, 0xd8, 0x8e,
-	0x95, 0x3b, 0xe8, 0x6c, 0xd0, 0xa7, 0x97, 0x4d, 0xe5, 0x2f, 0x25, 0x12, 0xe6, 0x63, 0x92, 0xbb,
-	0xad, 0x5b, 0xf5, 0x81, 0x54, 0xbe, 0x05, 0x85, 0x0d, 0x0a, 0x13, 0x51, 0xf8, 0x8d, 0xfd, 0x28,
-	0x3c, 0xb4, 0xbb, 0x3a, 0xb1, 0x11, 0xfc, 0xa9, 0x9c, 0x24, 0xc1, 0x2e, 0x15, 0x84, 0x5f, 0x65,
-	0x7e, 0x24, 0x81, 0x12, 0x0f, 0x89, 0x77, 0x85, 0xbb, 0x0e, 0xc0, 0x58, 0x33, 0x18, 0x20, 0xc2,
-	0xbc, 0x2d, 0xf5, 0xc1, 0x5b, 0x2f, 0x12, 0x02, 0x31, 0x44, 0x30, 0xb8, 0x42, 0xfc, 0xb0, 0x0b,
-	0x1c, 0xb7, 0xaa, 0x73, 0x50, 0x32, 0x74, 0xdb, 0x40, 0x7e, 0x6a, 0x42, 0x8c, 0xfe, 0xbc, 0x5a,
-	0x64, 0xe3, 0xaa, 0x18, 0x0e, 0xba, 0x76, 0x10, 0xe7, 0x0b, 0x72, 0xed, 0x6e, 0x24, 0xc4, 0x5d,
-	0xfb, 0x8c, 0xef, 0xd9, 0x29, 0x70, 0x5c, 0xe3, 0x01, 0x43, 0x0e, 0x2e, 0xfc, 0xff, 0x37, 0xe4,
-	0xd4, 0xdd, 0xd3, 0x0d, 0x39, 0x09, 0x84, 0xb3, 0xf5, 0x57, 0xd4, 0x90, 0xe3, 0xfc, 0x53, 0x0d,
-	0x0f, 0xc4, 0xd8, 0xaf, 0x41, 0x21, 0x6c, 0x2f, 0x03, 0x58, 0x71, 0xaf, 0xfd, 0xd5, 0x89, 0x90,
-	0xc9, 0x29, 0xa7, 0x93, 0xed, 0xcd, 0x07, 0xe2, 0xcc, 0xfd, 0xed, 0x10, 0x54, 0x57, 0xad, 0x4d,
-	0x5b, 0xaf, 0x1f, 0xa4, 0x5d, 0x60, 0x03, 0x0a, 0x98, 0x22, 0x89, 0x30, 0xf6, 0x46, 0xef, 0x7e,
-	0x81, 0xae, 0x7b, 0xab, 0x13, 0x0c, 0xad, 0x20, 0xc5, 0x82, 0x23, 0x68, 0xcf, 0x43, 0x2e, 0xd9,
-	0x29, 0xe1, 0x48, 0x9b, 0x19, 0xf4, 0x48, 0x7b, 0x58, 0x60, 0x8b, 0x4d, 0xc9, 0x35, 0x98, 0x34,
-	0xb6, 0xac, 0xba, 0xd9, 0xd9, 0xc7, 0xb1, 0xeb, 0x6d, 0x7a, 0xe2, 0xc9, 0xab, 0x65, 0x3a, 0x25,
-	0x80, 0xbe, 0x6e, 0xd7, 0xdb, 0xca, 0x09, 0x38, 0x9e, 0xca, 0x0b, 0x97, 0xf5, 0x3f, 0x4a, 0x70,
-	0x96, 0xaf, 0xb1, 0xbc, 0xad, 0x03, 0xf7, 0x68, 0x7c, 0x5b, 0x82, 0xc3, 0x5c, 0xea, 0xbb, 0x96,
-	0xb7, 0xa5, 0x25, 0x35, 0x6c, 0xdc, 0xed, 0x57, 0x01, 0xbd, 0x08, 0x52, 0x67, 0x70, 0x78, 0xa1,
-	0xb0, 0xb3, 0x1b, 0xb0, 0xd0, 0x1b, 0x45, 0xd7, 0xb7, 0x70, 0xe5, 0xc7, 0x12, 0x1c, 0x57, 0x51,
-	0xc3, 0xd9, 0x41, 0x0c, 0xd3, 0x3e, 0x1f, 0x2d, 0x9e, 0xdf, 0x35, 0x27, 0x7c, 0x3f, 0xc9, 0x44,
-	0xee, 0x27, 0x8a, 0x42, 0xc2, 0x5e, 0x1a, 0xf9, 0x42, 0xf7, 0x43, 0x70, 0x62, 0x0d, 0xb9, 0x0d,
-	0xcb, 0xd6, 0x3d, 0x74, 0x10, 0xad, 0x3b, 0x50, 0xf6, 0x04, 0x9e, 0x88, 0xb2, 0x17, 0x7b, 0x2a,
-	0xbb, 0x27, 0x05, 0x6a, 0xc9, 0x47, 0xfe, 0x0b, 0xe0, 0x73, 0xa7, 0x40, 0xe9, 0xc6, 0x11, 0x17,
-	0xfd, 0xff, 0x48, 0x50, 0xbd, 0x89, 0x48, 0xaa, 0x3a, 0x88, 0xdc, 0x9f, 0x9f, 0x75, 0x9d, 0x83,
-	0x92, 0x8f, 0x99, 0x57, 0xfd, 0xf9, 0x71, 0xd1, 0xaf, 0xc9, 0xf3, 0xe7, 0x01, 0xfa, 0x28, 0x51,
-	0x77, 0x30, 0x4a, 0x96, 0x90, 0xcc, 0xe6, 0xa2, 0x61, 0x29, 0x95, 0x77, 0x2e, 0x9f, 0x3f, 0x93,
-	0xe0, 0x18, 0x2d, 0x4a, 0x1f, 0xb0, 0x61, 0x8c, 0x9d, 0x7c, 0x07, 0x6d, 0x18, 0xeb, 0xba, 0xb3,
-	0x3a, 0x4e, 0x91, 0x8a, 0x58, 0xf3, 0x2a, 0x54, 0xd3, 0x96, 0x77, 0x8f, 0x30, 0xbf, 0x9f, 0x81,
-	0xd3, 0x1c, 0x09, 0xcb, 0x80, 0x07, 0x61, 0xb5, 0x91, 0x92, 0xc5, 0x6f, 0xf7, 0xc1, 0x6b, 0x1f,
-	0x24, 0x44, 0x12, 0xb9, 0xfc, 0x5a, 0xc0, 0xff, 0x78, 0xaf, 0x58, 0xbc, 0xd8, 0x52, 0x11, 0x4b,
-	0x96, 0xc5, 0x0a, 0x51, 0x74, 0xe9, 0xe1, 0xbe, 0xd9, 0xe7, 0xef, 0xbe, 0xb9, 0x34, 0xf7, 0x5d,
-	0x80, 0x33, 0xbd, 0x24, 0xc2, 0x4d, 0xf4, 0x67, 0x43, 0x70, 0x44, 0x14, 0x0d, 0x82, 0x57, 0x8e,
-	0xcf, 0x84, 0xff, 0x5e, 0x85, 0x19, 0x0b, 0x6b, 0x09, 0x5d, 0x6c, 0x54, 0x37, 0x79, 0x75, 0xd2,
-	0xc2, 0xb7, 0xa3, 0xed, 0x69, 0xf2, 0x3d, 0x18, 0x63, 0xb2, 0x62, 0x15, 0x83, 0xec, 0xa0, 0x15,
-	0x03, 0xa0, 0xd0, 0xf4, 0x6f, 0xf9, 0x3e, 0x8c, 0xf3, 0x3e, 0x4a, 0x86, 0x2c, 0x37, 0x28, 0xb2,
-	0x31, 0x06, 0x4e, 0x7f, 0x28, 0x55, 0x38, 0x9a, 0x2c, 0x6a, 0xae, 0x8b, 0xff, 0x90, 0xe0, 0xec,
-	0x23, 0xe4, 0x5a, 0x1b, 0xed, 0x18, 0x57, 0x7e, 0x5d, 0xe7, 0x33, 0xa1, 0x17, 0xbf, 0x1c, 0x93,
-	0xd9, 0x67, 0x39, 0xe6, 0x3c, 0x2c, 0xf4, 0x66, 0x94, 0x4b, 0xe5, 0x7f, 0x33, 0xe4, 0x92, 0x44,
-	0xae, 0x8c, 0x4b, 0x44, 0x31, 0x3e, 0x15, 0xfb, 0xb9, 0xe0, 0x3d, 0x3f, 0x91, 0xd4, 0x80, 0xb7,
-	0xc7, 0x06, 0x22, 0x89, 0x1f, 0x43, 0xca, 0x6c, 0xca, 0x8f, 0x20, 0xcb, 0xa6, 0xfc, 0x2e, 0x4c,
-	0x8a, 0xcb, 0xa0, 0x79, 0x90, 0xa0, 0x21, 0xfb, 0x58, 0x3a, 0xb4, 0xac, 0xf8, 0xd7, 0x58, 0xfa,
-	0xee, 0x43, 0xab, 0xa1, 0xb9, 0x41, 0xaa, 0xa1, 0xc5, 0x0e, 0x38, 0x2b, 0x87, 0xfa, 0x0a, 0x1f,
-	0xde, 0xe7, 0xbb, 0xc0, 0x35, 0xa8, 0xc4, 0xc4, 0x23, 0x32, 0xf2, 0x08, 0x7f, 0x60, 0x0b, 0xcb,
-	0x88, 0x27, 0x66, 0xe5, 0x2c, 0xc9, 0x2f, 0x5d, 0xb5, 0x2f, 0x92, 0x6d, 0x06, 0x2e, 0x30, 0xa3,
-	0x4a, 0x5c, 0x49, 0x83, 0x1e, 0xc1, 0x33, 0x90, 0xc1, 0xac, 0x41, 0x29, 0xda, 0x48, 0x3d, 0xb8,
-	0xb9, 0x14, 0x23, 0x8d, 0xd3, 0xb2, 0x0a, 0x45, 0x16, 0xa2, 0x0e, 0x70, 0xd8, 0x2b, 0x18, 0x21,
-	0x2e, 0xd3, 0x0c, 0x30, 0x9b, 0x66, 0x80, 0xdd, 0x34, 0x92, 0xeb, 0xa6, 0x91, 0x03, 0x1b, 0x83,
-	0x72, 0x09, 0x6a, 0xfd, 0x2a, 0x8a, 0xeb, 0xf6, 0x8f, 0x25, 0x98, 0xbf, 0x89, 0xb0, 0xe1, 0x5a,
-	0xeb, 0x07, 0x3a, 0x6a, 0x7e, 0x03, 0x46, 0x06, 0x2d, 0x7c, 0xf4, 0xda, 0x56, 0x15, 0x18, 0x95,
-	0xdf, 0xcb, 0xc2, 0x89, 0x2e, 0xab, 0xf9, 0x39, 0xea, 0x3d, 0x28, 0x75, 0x1e, 0x39, 0x0d, 0xc7,
-	0xde, 0xb0, 0x36, 0x79, 0x91, 0xf6, 0x72, 0x32, 0x2d, 0x89, 0xea, 0x5f, 0xa2, 0x80, 0x6a, 0x11,
-	0x85, 0x07, 0xe4, 0x4d, 0x98, 0x4d, 0x78, 0x4b, 0xa5, 0xad, 0xff, 0x8c, 0xe1, 0x8b, 0x03, 0x6c,
-	0xc2, 0x1e, 0x6d, 0x77, 0x93, 0x86, 0xe5, 0xf7, 0x40, 0x6e, 0x22, 0xdb, 0xb4, 0xec, 0x4d, 0x8d,
-	0x17, 0x6a, 0x2d, 0x84, 0x2b, 0x19, 0x5a, 0xfa, 0xbd, 0x90, 0xbe, 0xc7, 0x0a, 0x83, 0x11, 0x85,
-	0x13, 0xba, 0x43, 0xb9, 0x19, 0x1a, 0xb4, 0x10, 0x96, 0xbf, 0x09, 0x25, 0x81, 0x9d, 0x9a, 0xb9,
-	0x4b, 0x7b, 0xd4, 0x08, 0xee, 0xab, 0x3d, 0x71, 0x87, 0x8d, 0x8a, 0xee, 0x50, 0x6c, 0x06, 0xa6,
-	0x5c, 0x64, 0xcb, 0x08, 0xa6, 0x05, 0xfe, 0xf0, 0xb9, 0x22, 0xd7, 0x4b, 0x13, 0x7c, 0x93, 0xd8,
-	0xdb, 0xf6, 0x64, 0x33, 0x3e, 0xa1, 0xfc, 0x7b, 0x06, 0x2a, 0x2a, 0xff, 0x76, 0x06, 0xd1, 0x48,
-	0x8a, 0x1f, 0x5d, 0xf9, 0x4c, 0xa4, 0xab, 0x0d, 0x98, 0x0e, 0x77, 0x54, 0xb5, 0x35, 0xcb, 0x43,
-	0x0d, 0xa1, 0xc1, 0x2b, 0x03, 0x75, 0x55, 0xb5, 0x97, 0x3d, 0xd4, 0x50, 0x27, 0x77, 0x62, 0x63,
-	0x58, 0xbe, 0x06, 0xc3, 0x34, 0xff, 0x60, 0x9e, 0xd9, 0x52, 0x9f, 0x9d, 0x6e, 0xea, 0x9e, 0xbe,
-	0x58, 0x77, 0xd6, 0x55, 0xbe, 0x5e, 0xbe, 0x0d, 0x05, 0x1b, 0xed, 0xd2, 0xe6, 0x24, 0x8e, 0x21,
-	0xd7, 0x27, 0x86, 0x71, 0x1b, 0xed, 0xaa, 0x2d, 0x96, 0xb9, 0xb0, 0xbc, 0x0e, 0x93, 0xeb, 0x3a,
-	0x46, 0x51, 0x6f, 0x60, 0xb1, 0xeb, 0x4a, 0xcf, 0x0f, 0x61, 0x16, 0x75, 0x8c, 0xc2, 0xc6, 0x54,
-	0x5e, 0x8f, 0x0e, 0x29, 0x47, 0xe0, 0x70, 0x82, 0x9a, 0x79, 0xec, 0xfa, 0x7b, 0x7a, 0x09, 0xe4,
-	0xb3, 0x6f, 0x07, 0x7b, 0xc3, 0x84, 0x25, 0x68, 0xb1, 0xfe, 0x33, 0x16, 0x10, 0xae, 0x25, 0x52,
-	0x17, 0xf8, 0x4a, 0x2a, 0xa8, 0xee, 0x50, 0x6d, 0x24, 0xd2, 0x83, 0x76, 0x1a, 0x0a, 0x2e, 0x6a,
-	0x38, 0x1e, 0xd2, 0x8c, 0x7a, 0x0b, 0x7b, 0xc8, 0xa5, 0x36, 0x34, 0xaa, 0x4e, 0xb0, 0xd1, 0x25,
-	0x36, 0x18, 0xb3, 0xc8, 0x4c, 0xcc, 0x22, 0x95, 0x79, 0x72, 0x4f, 0x4c, 0xe6, 0x85, 0xb3, 0xfb,
-	0x87, 0x12, 0xcc, 0xac, 0xb6, 0x6d, 0x63, 0x75, 0x4b, 0x77, 0x4d, 0xde, 0xba, 0xc6, 0xf9, 0x3c,
-	0x0d, 0x05, 0xfe, 0xc5, 0x88, 0x20, 0x83, 0xd9, 0xfc, 0x04, 0x1b, 0x15, 0x64, 0x1c, 0x86, 0x3c,
-	0x26, 0xc0, 0xa2, 0xf9, 0x26, 0xa7, 0x8e, 0xd0, 0xdf, 0xcb, 0xa6, 0x7c, 0x03, 0xc6, 0x58, 0x0f,
-	0x1d, 0x7b, 0x24, 0xcd, 0xf4, 0xf9, 0x48, 0x0a, 0x0c, 0x88, 0x0c, 0x2b, 0x87, 0x61, 0x36, 0x46,
-	0x1e, 0x27, 0xfd, 0x1f, 0x86, 0x61, 0x92, 0xcc, 0x89, 0xe8, 0x34, 0x80, 0xa7, 0x1e, 0x87, 0x31,
-	0x5f, 0x85, 0x9c, 0xec, 0x51, 0x15, 0xc4, 0xd0, 0xb2, 0x19, 0xb8, 0x3e, 0x67, 0x82, 0x1f, 0xab,
-	0x54, 0x60, 0x44, 0x24, 0x5d, 0x96, 0xa9, 0xc5, 0xcf, 0x94, 0x06, 0x80, 0x5c, 0x4a, 0x03, 0x40,
-	0xbc, 0x6f, 0x65, 0x78, 0x7f, 0x7d, 0x2b, 0x49, 0x1d, 0x4a, 0x23, 0x89, 0x1d, 0x4a, 0xd1, 0x27,
-	0xf2, 0xfc, 0x7e, 0x9e, 0xc8, 0x57, 0x78, 0x3b, 0x6d, 0xe7, 0x15, 0x8a, 0xe2, 0x1a, 0xed, 0x13,
-	0x57, 0x99, 0x00, 0xfb, 0xaf, 0x47, 0x14, 0xe3, 0x75, 0x18, 0x11, 0x2f, 0xdd, 0xd0, 0xe7, 0x4b,
-	0xb7, 0x00, 0x08, 0x3e, 0xd8, 0x8f, 0x85, 0x1f, 0xec, 0x97, 0x60, 0x9c, 0x35, 0x5b, 0xf2, 0xcf,
-	0xbd, 0xc6, 0xfb, 0xfc, 0xdc, 0x6b, 0x8c, 0xf6, 0x60, 0xf2, 0x2f, 0xbd, 0x2e, 0x01, 0xfd, 0x52,
-	0x4b, 0xe3, 0xbd, 0xeb, 0x96, 0x89, 0x6c, 0xcf, 0xf2, 0xda, 0xb4, 0x37, 0x68, 0x54, 0x95, 0xc9,
-	0x1c, 0x6b, 0x51, 0x5f, 0xe6, 0x33, 0xf2, 0xdb, 0x50, 0x8c, 0x84, 0x69, 0xde, 0xf6, 0x5a, 0x1b,
-	0x2c, 0x40, 0xab, 0x85, 0x70, 0x70, 0x4e, 0x8b, 0x8a, 0xc5, 0x67, 0x19, 0x15, 0x67, 0x60, 0x2a,
-	0xec, 0x4d, 0xdc, 0xcd, 0xbe, 0x23, 0xc1, 0x11, 0x71, 0x4e, 0x7a, 0xc1, 0x5d, 0xf4, 0xca, 0x7f,
-	0x4b, 0x70, 0x34, 0x99, 0x16, 0x7e, 0x5c, 0xdb, 0x82, 0x49, 0x43, 0x37, 0xb6, 0x50, 0xf8, 0x23,
-	0xd4, 0x03, 0x07, 0xe8, 0x32, 0x45, 0x1a, 0x1c, 0x92, 0x6d, 0x98, 0x31, 0x75, 0x4f, 0xa7, 0x6a,
-	0x09, 0x6f, 0x36, 0x74, 0xc0, 0xcd, 0xa6, 0x04, 0xde, 0xe0, 0xa8, 0xf2, 0x4f, 0x12, 0xcc, 0x09,
-	0xd6, 0xb9, 0x59, 0xdc, 0x75, 0x70, 0xf0, 0xf5, 0x78, 0xcb, 0xc1, 0x9e, 0xa6, 0x9b, 0xa6, 0x8b,
-	0x30, 0x16, 0x5a, 0x20, 0x63, 0x37, 0xd8, 0x50, 0xb7, 0x40, 0xdd, 0x3b, 0x95, 0xa4, 0x1c, 0x6e,
-	0xb2, 0x07, 0x3f, 0xdc, 0x28, 0xff, 0x1a, 0x30, 0xb0, 0x10, 0x67, 0x5c, 0xa7, 0x27, 0x61, 0x82,
-	0xd2, 0x89, 0x35, 0xbb, 0xd5, 0x58, 0xe7, 0x69, 0x28, 0xa7, 0x8e, 0xb3, 0xc1, 0x87, 0x74, 0x4c,
-	0x3e, 0x02, 0xa3, 0x82, 0x39, 0xd6, 0xd2, 0x90, 0x53, 0xf3, 0x9c, 0x3b, 0x2c, 0x3f, 0x86, 0x62,
-	0x87, 0x3d, 0xaa, 0xca, 0xae, 0x5f, 0xd6, 0xfa, 0x6b, 0x09, 0x0b, 0x7e, 0x57, 0xcb, 0x12, 0x81,
-	0xa3, 0xce, 0x53, 0xb0, 0x43, 0x63, 0x34, 0x0e, 0x71, 0xb1, 0xb3, 0x96, 0x2d, 0xf1, 0xf3, 0x5e,
-	0x36, 0x9f, 0x2d, 0xe5, 0x94, 0x1a, 0x94, 0x97, 0xea, 0x0e, 0x46, 0x34, 0x89, 0x09, 0x85, 0x05,
-	0xb5, 0x21, 0x85, 0xb4, 0xa1, 0x4c, 0x81, 0x1c, 0x5c, 0xcf, 0xfd, 0xf0, 0x65, 0x28, 0xde, 0x41,
-	0x5e, 0xbf, 0x38, 0xde, 0x87, 0x52, 0x67, 0x35, 0x17, 0xe4, 0x7d, 0x00, 0xbe, 0x9c, 0x04, 0x0f,
-	0xe6, 0x13, 0x17, 0xfa, 0x31, 0x53, 0x8a, 0x86, 0xb2, 0xce, 0x84, 0x4c, 0xe3, 0xc5, 0x3f, 0x4b,
-	0x50, 0x66, 0xaf, 0x3d, 0xc1, 0x02, 0x64, 0x3a, 0x49, 0xf2, 0x6d, 0xc8, 0x93, 0x73, 0xc8, 0x26,
-	0x09, 0x8b, 0x43, 0xb4, 0xa7, 0xfe, 0x7c, 0xf7, 0x8e, 0x7d, 0xf6, 0x4e, 0xcb, 0x20, 0x54, 0x1f,
-	0x36, 0xd8, 0x3d, 0x97, 0x09, 0x75, 0xcf, 0x2d, 0x43, 0x71, 0xc7, 0xc2, 0xd6, 0xba, 0x55, 0xa7,
-	0xdd, 0x2d, 0x83, 0xf4, 0x65, 0x15, 0x3a, 0x80, 0xf4, 0xd8, 0x31, 0x05, 0x72, 0x90, 0x37, 0xae,
-	0x82, 0x0f, 0x25, 0x38, 0x76, 0x07, 0x79, 0x6a, 0xe7, 0xfb, 0x7a, 0xde, 0x13, 0xe9, 0x9f, 0x99,
-	0xee, 0xc3, 0x30, 0x6d, 0x56, 0x25, 0x0e, 0x98, 0x49, 0x35, 0xb0, 0xc0, 0x07, 0xfa, 0xac, 0x1a,
-	0xee, 0xff, 0xa4, 0x6d, 0xad, 0x2a, 0xc7, 0x41, 0xdc, 0x92, 0x1f, 0xbd, 0x68, 0xd7, 0x15, 0x3f,
-	0xa7, 0x8c, 0xf1, 0x31, 0x62, 0x99, 0xca, 0xf7, 0x87, 0xa0, 0x9a, 0x46, 0x12, 0x57, 0xfb, 0xb7,
-	0xa0, 0xc0, 0x54, 0xe2, 0xb7, 0x7a, 0x32, 0xda, 0xde, 0xe9, 0xb3, 0xcb, 0xa8, 0x3b, 0x7a, 0x66,
-	0x1c, 0x62, 0x94, 0x35, 0xa8, 0x32, 0x7f, 0x15, 0x63, 0x73, 0x6d, 0x90, 0xe3, 0x8b, 0x82, 0xcd,
-	0xa2, 0x39, 0xd6, 0x2c, 0xfa, 0x20, 0xdc, 0x2c, 0xfa, 0xea, 0x80, 0xb2, 0xf3, 0x29, 0xeb, 0xf4,
-	0x8f, 0x2a, 0x1f, 0xc0, 0xfc, 0x1d, 0xe4, 0xdd, 0xbc, 0xff, 0x66, 0x17, 0x9d, 0x3d, 0xe2, 0x1f,
-	0xfd, 0x10, 0xaf, 0x10, 0xb2, 0x19, 0x74, 0x6f, 0xff, 0x62, 0x49, 0xbf, 0x03, 0x22, 0x7f, 0x61,
-	0xe5, 0x37, 0x25, 0x38, 0xd1, 0x65, 0x73, 0xae, 0x9d, 0xf7, 0xa1, 0x1c, 0x40, 0xcb, 0x7b, 0xb2,
-	0xa4, 0xe8, 0xe5, 0xb9, 0x6f, 0x22, 0xd4, 0x92, 0x1b, 0x1e, 0xc0, 0xca, 0x77, 0x25, 0x98, 0xa2,
-	0x8d, 0xb5, 0x22, 0x1a, 0x0f, 0x90, 0xb9, 0xbf, 0x1e, 0xad, 0xc0, 0x7c, 0xa9, 0x67, 0x05, 0x26,
-	0x69, 0xab, 0x4e, 0xd5, 0x65, 0x1b, 0xa6, 0x23, 0x0b, 0xb8, 0x1c, 0x54, 0xc8, 0x47, 0xba, 0xe0,
-	0xbe, 0x3c, 0xe8, 0x56, 0xbc, 0x15, 0xcd, 0xc7, 0xa3, 0xfc, 0xae, 0x04, 0x53, 0x2a, 0xd2, 0x9b,
-	0xcd, 0x3a, 0xab, 0x94, 0xe2, 0x01, 0x38, 0x5f, 0x8d, 0x72, 0x9e, 0xdc, 0x49, 0x1f, 0xfc, 0x5f,
-	0x14, 0x4c, 0x1d, 0xf1, 0xed, 0x3a, 0xdc, 0xcf, 0xc2, 0x74, 0x64, 0x01, 0xa7, 0xf4, 0x2f, 0x86,
-	0x60, 0x9a, 0xd9, 0x4a, 0xd4, 0x3a, 0x6f, 0x41, 0xd6, 0xff, 0x5c, 0xa2, 0x10, 0x2c, 0x75, 0x24,
-	0x45, 0xcc, 0x9b, 0x48, 0x37, 0xef, 0x23, 0xcf, 0x43, 0x2e, 0xed, 0xce, 0xa3, 0x9d, 0x9c, 0x14,
-	0xbc, 0x5b, 0xf2, 0x8f, 0xdf, 0xf3, 0x32, 0x49, 0xf7, 0xbc, 0x57, 0xa1, 0x62, 0xd9, 0x64, 0x85,
-	0xb5, 0x83, 0x34, 0x64, 0xfb, 0xe1, 0xa4, 0x53, 0xb6, 0x9c, 0xf6, 0xe7, 0x6f, 0xd9, 0xc2, 0xd9,
-	0x97, 0x4d, 0xf9, 0x3c, 0x94, 0x1b, 0xfa, 0x9e, 0xd5, 0x68, 0x35, 0xb4, 0x26, 0x59, 0x8f, 0xad,
-	0x0f, 0xd8, 0x3f, 0x92, 0xc8, 0xa9, 0x45, 0x3e, 0xb1, 0xa2, 0x6f, 0xa2, 0x55, 0xeb, 0x03, 0x24,
-	0x9f, 0x81, 0x22, 0xfd, 0x8e, 0x82, 0x2e, 0x64, 0x6d, 0xff, 0xc3, 0xb4, 0xed, 0x9f, 0x7e, 0x5e,
-	0x41, 0x96, 0xb1, 0xef, 0x1c, 0x3f, 0x1e, 0xa2, 0x1f, 0xe1, 0x87, 0xe4, 0xc5, 0x0d, 0xe9, 0x19,
-	0x09, 0x2c, 0xd1, 0x2f, 0x87, 0x9e, 0xa1, 0x5f, 0x26, 0xf1, 0x9a, 0x49, 0xe0, 0x55, 0x6e, 0xc0,
-	0x4c, 0x8c, 0x12, 0x96, 0xc2, 0xb3, 0x07, 0x8b, 0x55, 0x53, 0x51, 0x92, 0x68, 0x5e, 0xff, 0x17,
-	0x09, 0x66, 0x57, 0x5a, 0xee, 0x26, 0xfa, 0x3c, 0x1a, 0xa3, 0x32, 0x07, 0x95, 0x38, 0x73, 0xa2,
-	0x6d, 0x6f, 0x08, 0x66, 0x1f, 0xa0, 0xcf, 0x29, 0xe7, 0xcf, 0xc5, 0x0d, 0x17, 0xa1, 0x12, 0x17,
-	0x18, 0xf7, 0xc3, 0x04, 0x1c, 0x52, 0x12, 0x8e, 0xef, 0xd3, 0xaf, 0x12, 0x37, 0x5c, 0x84, 0xb7,
-	0x82, 0xd5, 0xd8, 0x41, 0x62, 0xf5, 0xbb, 0xd1, 0x58, 0xfd, 0xb5, 0x3e, 0x63, 0x75, 0xea, 0xae,
-	0x9d, 0x90, 0x4d, 0x3f, 0x54, 0x4c, 0x5a, 0xc7, 0x8d, 0xe6, 0x7b, 0x12, 0x9c, 0xbf, 0x83, 0x6c,
-	0xe4, 0xea, 0x1e, 0xba, 0xaf, 0x63, 0xd1, 0xd3, 0x1c, 0xf1, 0x37, 0xfc, 0x22, 0x6e, 0xcb, 0x06,
-	0xbc, 0xd4, 0x17, 0x65, 0x5c, 0x61, 0xaf, 0xc0, 0x0c, 0xbd, 0xc0, 0x6a, 0xec, 0xbb, 0x2f, 0xfe,
-	0xe2, 0xd1, 0xe2, 0xdf, 0x66, 0x64, 0xd4, 0x29, 0x3a, 0xbb, 0xe6, 0x4f, 0x2e, 0x91, 0x39, 0xe5,
-	0x36, 0x1c, 0x09, 0x1f, 0x10, 0xc3, 0x45, 0xc4, 0xb3, 0x50, 0x0c, 0xd7, 0x32, 0xd9, 0xe1, 0x66,
-	0x54, 0x2d, 0x84, 0x8a, 0x99, 0x58, 0x69, 0xc1, 0xd1, 0x64, 0x3c, 0x9c, 0xba, 0xb7, 0x60, 0x98,
-	0x5d, 0xf8, 0xf8, 0xe1, 0xe8, 0xb5, 0x3e, 0x4f, 0xaf, 0xfc, 0x0a, 0x14, 0x45, 0xcb, 0x91, 0x29,
-	0x7f, 0x3d, 0x0c, 0x33, 0xc9, 0x4b, 0xba, 0x5d, 0x65, 0xbe, 0x04, 0xb3, 0x0d, 0x7d, 0x4f, 0x8b,
-	0x86, 0xe5, 0xce, 0xf7, 0x87, 0x53, 0x0d, 0x7d, 0x2f, 0x1a, 0x72, 0x4d, 0xf9, 0x3e, 0x94, 0x18,
-	0xc6, 0xba, 0x63, 0xe8, 0xf5, 0x7e, 0x8b, 0xa2, 0xc3, 0xe4, 0x86, 0x52, 0x91, 0x54, 0x76, 0x8a,
-	0xbf, 0x4f, 0x40, 0x69, 0xe9, 0xec, 0x83, 0xb8, 0x68, 0x59, 0x42, 0x78, 0xf3, 0x40, 0xa2, 0xa9,
-	0xa9, 0x21, 0xc5, 0xb0, 0x13, 0x7d, 0x44, 0x5b, 0xf2, 0x6f, 0x49, 0x30, 0xb9, 0xa5, 0xdb, 0xa6,
-	0xb3, 0xc3, 0xef, 0x26, 0xd4, 0x78, 0xc9, 0xfd, 0x77, 0x90, 0xef, 0xde, 0x52, 0x08, 0xb8, 0xcb,
-	0x11, 0xfb, 0x57, 0x6f, 0x4e, 0x84, 0xbc, 0x15, 0x9b, 0x90, 0x9b, 0x70, 0x2a, 0x51, 0x13, 0xd1,
-	0x8b, 0x60, 0xbf, 0xf5, 0xd5, 0xf9, 0xb8, 0xe2, 0x1e, 0x85, 0xae, 0x86, 0x73, 0xdf, 0x95, 0x60,
-	0x32, 0x41, 0x44, 0x09, 0x1f, 0xbf, 0x3d, 0x0e, 0xdf, 0x67, 0xee, 0x1c, 0x48, 0x2a, 0x2b, 0xc8,
-	0xe5, 0xfb, 0x05, 0xee, 0x37, 0x73, 0xdf, 0x96, 0x60, 0x36, 0x45, 0x5c, 0x09, 0x04, 0xa9, 0x61,
-	0x82, 0xbe, 0xda, 0x27, 0x41, 0xb1, 0x0d, 0xe8, 0xe9, 0x21, 0x70, 0xcb, 0x7a, 0x07, 0xa6, 0x13,
-	0xd7, 0xc8, 0x6f, 0xc0, 0x51, 0xdf, 0x4a, 0x92, 0x9c, 0x85, 0x05, 0x96, 0xc3, 0x62, 0x4d, 0xcc,
-	0x63, 0x94, 0x3f, 0x91, 0x60, 0xbe, 0x97, 0x3c, 0x64, 0x05, 0x26, 0x74, 0x63, 0x1b, 0x99, 0x11,
-	0xb4, 0x63, 0x74, 0x90, 0xbb, 0xde, 0x63, 0x98, 0x0b, 0xac, 0x89, 0x5a, 0x47, 0xbf, 0xdf, 0x8b,
-	0xcd, 0xfa, 0x28, 0xc3, 0x46, 0xa1, 0xfc, 0x8e, 0x04, 0x73, 0x2a, 0x5a, 0x6f, 0x59, 0x75, 0xf3,
-	0x45, 0xd7, 0x48, 0x8f, 0x91, 0x74, 0x9a, 0x40, 0x09, 0xcf, 0x57, 0x3f, 0x1c, 0x82, 0xd3, 0xe1,
-	0x46, 0xc8, 0x0e, 0x2b, 0xec, 0x21, 0xff, 0x45, 0xfc, 0x7b, 0x94, 0x15, 0x98, 0x0c, 0xbe, 0xa9,
-	0xf1, 0x7f, 0x66, 0xd1, 0xf7, 0x8b, 0x51, 0x39, 0xf0, 0x80, 0xc6, 0xfe, 0x73, 0x45, 0x08, 0x23,
-	0x6d, 0x07, 0x1d, 0xac, 0x20, 0xe4, 0x63, 0xa4, 0x95, 0x38, 0xaa, 0xe3, 0x05, 0x38, 0xd3, 0x4b,
-	0x70, 0x5c, 0xc6, 0x7f, 0x24, 0x41, 0xf5, 0xad, 0xa6, 0x79, 0xc0, 0x06, 0xe7, 0x5f, 0x8d, 0x9e,
-	0x6a, 0x7a, 0x7f, 0x44, 0xd0, 0x7d, 0xd3, 0xce, 0xa1, 0xe6, 0x5b, 0x70, 0x3c, 0x75, 0xa9, 0xdf,
-	0xf8, 0x10, 0xbd, 0x8f, 0x7f, 0x6d, 0xff, 0xdb, 0xc7, 0x6e, 0xe6, 0x7f, 0x2e, 0xc1, 0xc2, 0xaa,
-	0xe7, 0x22, 0xbd, 0xd1, 0xb9, 0xbe, 0xa7, 0x16, 0x68, 0x9a, 0x30, 0x83, 0xdb, 0xb6, 0x11, 0x8a,
-	0x20, 0xbd, 0xeb, 0xfa, 0x91, 0x0b, 0xd0, 0x6a, 0xdb, 0x36, 0x22, 0x41, 0x04, 0xdd, 0x3d, 0xa4,
-	0x4e, 0xe1, 0x84, 0xf1, 0xc5, 0x71, 0x00, 0xdd, 0xf3, 0x5c, 0x6b, 0xbd, 0xe5, 0x21, 0x4c, 0x8e,
-	0x78, 0xe7, 0xfa, 0x20, 0x96, 0x0b, 0xee, 0x71, 0xe0, 0x9b, 0x6a, 0x29, 0xaa, 0xb7, 0x74, 0xfa,
-	0xba, 0xa0, 0xbe, 0x7b, 0xa8, 0xf3, 0xcd, 0x75, 0x84, 0xb4, 0x3f, 0x95, 0x40, 0x09, 0xfe, 0xab,
-	0x07, 0x5f, 0xe6, 0x4c, 0x15, 0x03, 0x58, 0xdb, 0xe3, 0xa8, 0xb5, 0x2d, 0x0d, 0xf4, 0x3f, 0x26,
-	0x92, 0x37, 0xee, 0x58, 0xdc, 0x6f, 0x4b, 0x70, 0xb2, 0xeb, 0x7a, 0xbf, 0x1c, 0x16, 0x35, 0xbb,
-	0x9b, 0x07, 0xa3, 0x23, 0x6a, 0x7a, 0x8b, 0xcd, 0x8f, 0x3e, 0xa9, 0x1e, 0xfa, 0xf8, 0x93, 0xea,
-	0xa1, 0x9f, 0x7f, 0x52, 0x95, 0x7e, 0xe3, 0x69, 0x55, 0xfa, 0xc1, 0xd3, 0xaa, 0xf4, 0x77, 0x4f,
-	0xab, 0xd2, 0x47, 0x4f, 0xab, 0xd2, 0xbf, 0x3d, 0xad, 0x4a, 0x3f, 0x7d, 0x5a, 0x3d, 0xf4, 0xf3,
-	0xa7, 0x55, 0xe9, 0xc3, 0x4f, 0xab, 0x87, 0x3e, 0xfa, 0xb4, 0x7a, 0xe8, 0xe3, 0x4f, 0xab, 0x87,
-	0xde, 0xbd, 0xbe, 0xe9, 0x74, 0xe8, 0xb0, 0x9c, 0xae, 0xff, 0xa9, 0xf8, 0x57, 0xc2, 0x23, 0xeb,
-	0xc3, 0x34, 0xca, 0x5c, 0xfd, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb9, 0xa6, 0xf3, 0x9d, 0xe8,
-	0x58, 0x00, 0x00,
+	// 4916 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x4b, 0x6c, 0x1c, 0xc9,
+	0x75, 0x6a, 0xce, 0x0c, 0x39, 0xf3, 0x48, 0xce, 0x0c, 0x9b, 0xbf, 0x21, 0x25, 0x8d, 0xa8, 0x96,
+	0x28, 0x51, 0xda, 0xd5, 0x68, 0x25, 0xad, 0xbd, 0xb2, 0xe2, 0xf5, 0x5a, 0xa4, 0x7e, 0x14, 0x24,
+	0x59, 0xdb, 0xe4, 0x6a, 0x37, 0xeb, 0x95, 0x7b, 0x9b, 0xdd, 0x45, 0xb2, 0xc3, 0x99, 0xee, 0xd9,
+	0xae, 0x1e, 0x92, 0xb3, 0x39, 0xd8, 0x80, 0x91, 0x9f, 0x0f, 0xc9, 0x02, 0xb9, 0x18, 0x81, 0x93,
+	0x43, 0x80, 0x24, 0x46, 0x80, 0x20, 0x87, 0x1c, 0x0c, 0x1f, 0x7c, 0x49, 0x80, 0x20, 0x08, 0x7c,
+	0x58, 0xe4, 0x92, 0x45, 0x02, 0xc4, 0x59, 0x2d, 0x82, 0xd8, 0x48, 0x0e, 0x3e, 0x06, 0x49, 0x0e,
+	0x41, 0xfd, 0x7a, 0xfa, 0x37, 0x9f, 0x26, 0xa5, 0x68, 0xbd, 0xde, 0xdb, 0x74, 0x55, 0xbd, 0x57,
+	0xef, 0x5f, 0x55, 0xaf, 0x5e, 0x0d, 0x7c, 0xd9, 0x43, 0x8d, 0xa6, 0xe3, 0xea, 0xf5, 0x8b, 0x18,
+	0xb9, 0xbb, 0xc8, 0xbd, 0xa8, 0x37, 0xad, 0x8b, 0xdb, 0x16, 0xf6, 0x1c, 0xb7, 0x4d, 0x5a, 0x2c,
+	0x03, 0x5d, 0xdc, 0xbd, 0x74, 0xd1, 0x45, 0xef, 0xb5, 0x10, 0xf6, 0x34, 0x17, 0xe1, 0xa6, 0x63,
+	0x63, 0x54, 0x6b, 0xba, 0x8e, 0xe7, 0xc8, 0x8b, 0x02, 0xba, 0xc6, 0xa0, 0x6b, 0x7a, 0xd3, 0xaa,
+	0x85, 0xa1, 0x6b, 0xbb, 0x97, 0xe6, 0xab, 0x5b, 0x8e, 0xb3, 0x55, 0x47, 0x17, 0x29, 0xd0, 0x46,
+	0x6b, 0xf3, 0xa2, 0xd9, 0x72, 0x75, 0xcf, 0x72, 0x6c, 0x86, 0x66, 0xfe, 0x44, 0xb4, 0xdf, 0xb3,
+	0x1a, 0x08, 0x7b, 0x7a, 0xa3, 0xc9, 0x07, 0x9c, 0x34, 0x51, 0x13, 0xd9, 0x26, 0xb2, 0x0d, 0x0b,
+	0xe1, 0x8b, 0x5b, 0xce, 0x96, 0x43, 0xdb, 0xe9, 0x2f, 0x3e, 0xe4, 0xb4, 0xcf, 0x08, 0xe1, 0xc0,
+	0x70, 0x1a, 0x0d, 0xc7, 0x26, 0x94, 0x37, 0x10, 0xc6, 0xfa, 0x16, 0x27, 0x78, 0x7e, 0x31, 0x34,
+	0x8a, 0x53, 0x1a, 0x1f, 0x76, 0x36, 0x34, 0xcc, 0xd3, 0xf1, 0xce, 0x7b, 0x2d, 0xd4, 0x42, 0xf1,
+	0x81, 0xe1, 0x59, 0x91, 0xdd, 0x6a, 0x60, 0x32, 0x68, 0xcf, 0x71, 0x77, 0x36, 0xeb, 0xce, 0x1e,
+	0x1f, 0x75, 0x26, 0x34, 0x4a, 0x74, 0xc6, 0xb1, 0x9d, 0x0a, 0x8d, 0x7b, 0xaf, 0x85, 0x92, 0x68,
+	0x0b, 0x23, 0xa3, 0x6d, 0x86, 0x53, 0xef, 0xc7, 0xea, 0xa6, 0x6e, 0xd5, 0x5b, 0x6e, 0x02, 0x07,
+	0xe7, 0x93, 0x0c, 0xc0, 0xa8, 0x3b, 0xc6, 0x4e, 0x7c, 0xec, 0x8b, 0x3d, 0x8c, 0x25, 0x3e, 0xfa,
+	0x5c, 0xd2, 0x68, 0x5f, 0x44, 0x4c, 0x43, 0x7c, 0xe8, 0x0b, 0x3d, 0x87, 0x46, 0xa4, 0x79, 0xb6,
+	0xe7, 0x60, 0xa2, 0x2c, 0x3e, 0xf0, 0x42, 0xd2, 0xc0, 0xee, 0xd2, 0xaf, 0x25, 0x0d, 0xb7, 0xf5,
+	0x06, 0xc2, 0x4d, 0xdd, 0x48, 0x90, 0xdc, 0x4b, 0x49, 0xe3, 0x5d, 0xd4, 0xac, 0x5b, 0x06, 0x35,
+	0xee, 0x38, 0xc4, 0x95, 0x24, 0x88, 0x26, 0x72, 0xb1, 0x85, 0x3d, 0x64, 0xb3, 0x39, 0xd0, 0x3e,
+	0x32, 0x5a, 0x04, 0x1c, 0x73, 0xa0, 0xd7, 0x06, 0x00, 0x12, 0x4c, 0x69, 0x8d, 0x96, 0xa7, 0x6f,
+	0xd4, 0x91, 0x86, 0x3d, 0xdd, 0x13, 0xb3, 0x7e, 0x31, 0xd1, 0xfa, 0xfa, 0x3a, 0xf7, 0xfc, 0xb5,
+	0xa4, 0x89, 0x75, 0xb3, 0x61, 0xd9, 0x7d, 0x61, 0x95, 0x9f, 0x0d, 0xc3, 0xf1, 0x35, 0x4f, 0x77,
+	0xbd, 0x37, 0xf9, 0x74, 0x37, 0x05, 0x5b, 0x2a, 0x03, 0x90, 0x4f, 0xc2, 0x98, 0x2f, 0x5b, 0xcd,
+	0x32, 0x2b, 0xd2, 0x82, 0xb4, 0x54, 0x50, 0x47, 0xfd, 0xb6, 0x55, 0x53, 0x36, 0x60, 0x1c, 0x13,
+	0x1c, 0x1a, 0x9f, 0xa4, 0x32, 0xb4, 0x20, 0x2d, 0x8d, 0x5e, 0xfe, 0x8a, 0xaf, 0x28, 0x1a, 0x6e,
+	0x22, 0x0c, 0xd5, 0x76, 0x2f, 0xd5, 0x7a, 0xce, 0xac, 0x8e, 0x51, 0xa4, 0x82, 0x8e, 0x6d, 0x98,
+	0x6e, 0xea, 0x2e, 0xb2, 0x3d, 0xcd, 0x97, 0xbc, 0x66, 0xd9, 0x9b, 0x4e, 0x25, 0x43, 0x27, 0x7b,
+	0xb9, 0x96, 0x14, 0xe2, 0x7c, 0x8b, 0xdc, 0xbd, 0x54, 0x7b, 0x48, 0xa1, 0xfd, 0x59, 0x56, 0xed,
+	0x4d, 0x47, 0x9d, 0x6c, 0xc6, 0x1b, 0xe5, 0x0a, 0x8c, 0xe8, 0x1e, 0xc1, 0xe6, 0x55, 0xb2, 0x0b,
+	0xd2, 0x52, 0x4e, 0x15, 0x9f, 0x72, 0x03, 0x14, 0x5f, 0x83, 0x1d, 0x2a, 0xd0, 0x7e, 0xd3, 0x62,
+	0x61, 0x52, 0x23, 0xf1, 0xb0, 0x92, 0xa3, 0x04, 0xcd, 0xd7, 0x58, 0xb0, 0xac, 0x89, 0x60, 0x59,
+	0x5b, 0x17, 0xc1, 0x72, 0x39, 0xfb, 0xc1, 0x4f, 0x4e, 0x48, 0xea, 0x89, 0xbd, 0x28, 0xe7, 0x37,
+	0x7d, 0x4c, 0x64, 0xac, 0xbc, 0x0d, 0x73, 0x86, 0x63, 0x7b, 0x96, 0xdd, 0x42, 0x9a, 0x8e, 0x35,
+	0x1b, 0xed, 0x69, 0x96, 0x6d, 0x79, 0x96, 0xee, 0x39, 0x6e, 0x65, 0x78, 0x41, 0x5a, 0x2a, 0x5e,
+	0xbe, 0x10, 0x96, 0x31, 0xf5, 0x2e, 0xc2, 0xec, 0x0a, 0x87, 0xbb, 0x8e, 0x1f, 0xa0, 0xbd, 0x55,
+	0x01, 0xa4, 0xce, 0x18, 0x89, 0xed, 0xf2, 0x7d, 0x98, 0x10, 0x3d, 0xa6, 0xc6, 0x43, 0x50, 0x65,
+	0x84, 0xf2, 0xb1, 0x10, 0x9e, 0x81, 0x77, 0x92, 0x39, 0x6e, 0xb1, 0x9f, 0x6a, 0xd9, 0x07, 0xe5,
+	0x2d, 0xf2, 0x23, 0x98, 0xa9, 0xeb, 0xd8, 0xd3, 0x0c, 0xa7, 0xd1, 0xac, 0x23, 0x2a, 0x19, 0x17,
+	0xe1, 0x56, 0xdd, 0xab, 0xe4, 0x93, 0x70, 0xf2, 0x10, 0x43, 0x75, 0xd4, 0xae, 0x3b, 0xba, 0x89,
+	0xd5, 0x29, 0x02, 0xbf, 0xe2, 0x83, 0xab, 0x14, 0x5a, 0xfe, 0x06, 0x1c, 0xdd, 0xb4, 0x5c, 0xec,
+	0x69, 0xbe, 0x16, 0x48, 0x14, 0xd1, 0x36, 0x74, 0x63, 0xc7, 0xd9, 0xdc, 0xac, 0x14, 0x28, 0xf2,
+	0xb9, 0x98, 0xe0, 0x6f, 0xf0, 0x55, 0x6c, 0x39, 0xfb, 0x5d, 0x22, 0xf7, 0x0a, 0xc5, 0x21, 0xcc,
+	0x6e, 0x5d, 0xc7, 0x3b, 0xcb, 0x0c, 0x81, 0xfc, 0x0e, 0x4c, 0x61, 0xa7, 0xe5, 0x1a, 0x48, 0xdb,
+	0x25, 0x7e, 0xeb, 0xd8, 0x1a, 0xd5, 0x57, 0x05, 0x28, 0xe2, 0xf3, 0xdd, 0xa8, 0x26, 0xa8, 0x90,
+	0xfb, 0x88, 0x81, 0xac, 0x11, 0x08, 0x55, 0x66, 0x78, 0x82, 0x6d, 0xca, 0x4f, 0x25, 0xa8, 0x76,
+	0xb3, 0x78, 0xe6, 0x94, 0xf2, 0x34, 0x0c, 0xbb, 0x2d, 0xbb, 0xe3, 0x66, 0x39, 0xb7, 0x65, 0xaf,
+	0x9a, 0xf2, 0x6b, 0x90, 0xa3, 0x91, 0x9e, 0x3b, 0xd6, 0xb9, 0x44, 0x5b, 0xa7, 0x23, 0x08, 0x39,
+	0x8f, 0x90, 0xe1, 0x39, 0xee, 0x0a, 0xf9, 0x54, 0x19, 0x9c, 0x6c, 0xc3, 0x24, 0xd2, 0xb7, 0x90,
+	0x1b, 0x16, 0x5c, 0x25, 0x33, 0xa0, 0x9f, 0x3e, 0x74, 0xea, 0xf5, 0xa0, 0xbc, 0x5e, 0x27, 0x8b,
+	0xac, 0x20, 0x5a, 0x9d, 0xa0, 0xa8, 0x83, 0xfd, 0xca, 0x7f, 0x48, 0x30, 0x73, 0x1b, 0x79, 0xf7,
+	0x59, 0x94, 0x5b, 0xf3, 0x74, 0x0f, 0xa5, 0x88, 0x27, 0xb7, 0xa1, 0xe0, 0x7b, 0x57, 0x9c, 0xe5,
+	0xb8, 0xec, 0xc3, 0xb2, 0xec, 0xc0, 0xca, 0x57, 0x60, 0x06, 0xed, 0x37, 0x91, 0xe1, 0x21, 0x53,
+	0xb3, 0xd1, 0xbe, 0xa7, 0xa1, 0x5d, 0x12, 0x40, 0x2c, 0x93, 0x72, 0x9e, 0x51, 0x27, 0x45, 0xef,
+	0x03, 0xb4, 0xef, 0xdd, 0x24, 0x7d, 0xab, 0xa6, 0xfc, 0x12, 0x4c, 0x19, 0x2d, 0x97, 0x46, 0x9a,
+	0x0d, 0x57, 0xb7, 0x8d, 0x6d, 0xcd, 0x73, 0x76, 0x90, 0x4d, 0x63, 0xc1, 0x98, 0x2a, 0xf3, 0xbe,
+	0x65, 0xda, 0xb5, 0x4e, 0x7a, 0x94, 0x1f, 0x15, 0x60, 0x36, 0xc6, 0x2d, 0xd7, 0x68, 0x88, 0x17,
+	0xe9, 0x10, 0xbc, 0xac, 0xc2, 0x78, 0x47, 0x79, 0xed, 0x26, 0xe2, 0x82, 0x39, 0xdd, 0x0f, 0xd9,
+	0x7a, 0xbb, 0x89, 0xd4, 0xb1, 0xbd, 0xc0, 0x97, 0xac, 0xc0, 0x78, 0x92, 0x34, 0x46, 0xed, 0x80,
+	0x14, 0xbe, 0x04, 0x73, 0x4d, 0x17, 0xed, 0x5a, 0x4e, 0x0b, 0x6b, 0x34, 0x0e, 0x23, 0xb3, 0x33,
+	0x3e, 0x4b, 0xc7, 0xcf, 0x88, 0x01, 0x6b, 0xac, 0x5f, 0x80, 0x5e, 0x80, 0x49, 0xea, 0xfd, 0xcc,
+	0x55, 0x7d, 0xa0, 0x1c, 0x05, 0x2a, 0x93, 0xae, 0x5b, 0xa4, 0x47, 0x0c, 0x5f, 0x01, 0xa0, 0x5e,
+	0x4c, 0x77, 0x6e, 0x95, 0xe1, 0x24, 0xae, 0xfc, 0x8d, 0x1d, 0x61, 0xac, 0x63, 0x80, 0x05, 0x4f,
+	0xfc, 0x94, 0x1f, 0xc2, 0x04, 0xf6, 0x2c, 0x63, 0xa7, 0xad, 0x05, 0x70, 0x8d, 0xa4, 0xc0, 0x55,
+	0x62, 0xe0, 0x7e, 0x83, 0xfc, 0xeb, 0xf0, 0x42, 0x0c, 0xa3, 0x86, 0x8d, 0x6d, 0x64, 0xb6, 0xea,
+	0x48, 0xf3, 0x1c, 0x26, 0x15, 0x1a, 0xf1, 0x9d, 0x96, 0x57, 0x19, 0x1d, 0x2c, 0xf6, 0x2c, 0x46,
+	0xa6, 0x59, 0xe3, 0x08, 0xd7, 0x1d, 0x2a, 0xc4, 0x75, 0x86, 0xad, 0xab, 0x0d, 0x8e, 0x77, 0xb3,
+	0x41, 0xf9, 0xeb, 0x50, 0xf4, 0xcd, 0x83, 0x6e, 0x2a, 0x2a, 0x25, 0xba, 0x40, 0x24, 0xaf, 0x8b,
+	0xfe, 0x3a, 0x11, 0x33, 0x39, 0x66, 0xbd, 0xbe, 0xa9, 0xd1, 0x4f, 0xf9, 0x4d, 0x28, 0x85, 0x90,
+	0xb7, 0x70, 0xa5, 0x4c, 0xb1, 0xd7, 0xba, 0x2c, 0x3f, 0x89, 0x68, 0x5b, 0x58, 0x2d, 0x06, 0xf1,
+	0xb6, 0xb0, 0xfc, 0x18, 0x26, 0x44, 0xa4, 0x65, 0xdb, 0x53, 0x0b, 0xe1, 0xca, 0x04, 0x15, 0xe5,
+	0x4b, 0xb5, 0x1e, 0x67, 0x16, 0x16, 0xe6, 0x28, 0xe0, 0x1d, 0x01, 0xa7, 0x96, 0x77, 0x23, 0x2d,
+	0xf2, 0x57, 0xe0, 0x98, 0x85, 0x35, 0x26, 0xf2, 0xa0, 0x1a, 0x91, 0x4d, 0x1c, 0xd5, 0xac, 0xc8,
+	0x0b, 0xd2, 0x52, 0x5e, 0xad, 0x58, 0x78, 0x2d, 0xac, 0x95, 0x9b, 0xac, 0x5f, 0x7e, 0x19, 0x66,
+	0x63, 0x96, 0xec, 0xed, 0xd3, 0xf8, 0x3c, 0xc9, 0x02, 0x48, 0xd8, 0x9a, 0xd7, 0xf7, 0x49, 0xb4,
+	0xbe, 0x02, 0x33, 0x1c, 0xc0, 0xdf, 0x22, 0xf0, 0xa0, 0x3e, 0x45, 0x63, 0xdd, 0x24, 0xed, 0xed,
+	0x38, 0x39, 0x0d, 0xf1, 0xef, 0xc0, 0xd4, 0x1e, 0x5d, 0x46, 0x22, 0x4b, 0xcf, 0x74, 0xfa, 0xa5,
+	0x67, 0x2f, 0xd6, 0x76, 0x37, 0x9b, 0xcf, 0x97, 0x0b, 0x77, 0xb3, 0xf9, 0x42, 0x19, 0xee, 0x66,
+	0xf3, 0x50, 0x1e, 0xbd, 0x9b, 0xcd, 0x8f, 0x95, 0xc7, 0xef, 0x66, 0xf3, 0xc5, 0x72, 0x49, 0xf9,
+	0x4f, 0x09, 0x66, 0x49, 0x88, 0xff, 0x25, 0x09, 0xd7, 0x7f, 0x90, 0x87, 0x4a, 0x9c, 0xdd, 0xcf,
+	0xe3, 0xf5, 0xe7, 0xf1, 0xfa, 0xa9, 0xc7, 0xeb, 0xb1, 0xae, 0xf1, 0x3a, 0x31, 0xf2, 0x15, 0x9f,
+	0x5a, 0xe4, 0xfb, 0xc5, 0x5c, 0x0e, 0x7a, 0xc4, 0xdb, 0x89, 0x83, 0xc4, 0x5b, 0xb9, 0x6b, 0xbc,
+	0x4d, 0x8c, 0x88, 0xe3, 0xe5, 0xa2, 0xf2, 0x3b, 0x12, 0x1c, 0x55, 0x11, 0x46, 0x5e, 0x64, 0x49,
+	0x78, 0x0e, 0xf1, 0x50, 0xa9, 0xc2, 0xb1, 0x64, 0x52, 0x58, 0xac, 0x52, 0xbe, 0x9f, 0x81, 0x05,
+	0x15, 0x19, 0x8e, 0x6b, 0x06, 0x37, 0xdf, 0xdc, 0xbb, 0x53, 0x10, 0xfc, 0x16, 0xc8, 0xf1, 0x63,
+	0x6d, 0x7a, 0xca, 0x27, 0x62, 0xe7, 0x59, 0xf9, 0x45, 0x90, 0x85, 0x0b, 0x9a, 0xd1, 0xf0, 0x55,
+	0xf6, 0x7b, 0x44, 0x64, 0x99, 0x85, 0x11, 0xea, 0xbb, 0x7e, 0xc4, 0x1a, 0x26, 0x9f, 0xab, 0xa6,
+	0x7c, 0x1c, 0x40, 0xe4, 0x2f, 0x78, 0x60, 0x2a, 0xa8, 0x05, 0xde, 0xb2, 0x6a, 0xca, 0xef, 0xc2,
+	0x58, 0xd3, 0xa9, 0xd7, 0xfd, 0xf4, 0x03, 0x8b, 0x49, 0xaf, 0x1e, 0xf4, 0x58, 0x43, 0x91, 0xa8,
+	0xa3, 0x04, 0xa5, 0x10, 0xa2, 0x7f, 0x00, 0x1b, 0x39, 0xd8, 0x01, 0x4c, 0xf9, 0x49, 0x1e, 0x4e,
+	0xf6, 0x50, 0x15, 0x5f, 0x7c, 0x62, 0x6b, 0x86, 0x74, 0xe0, 0x35, 0xa3, 0xe7, 0x7a, 0x30, 0xd4,
+	0x73, 0x3d, 0x48, 0xa7, 0xb4, 0x25, 0x28, 0x77, 0x59, 0x6f, 0x8a, 0x38, 0x8c, 0x37, 0xb6, 0x8c,
+	0xe5, 0xe2, 0xcb, 0x58, 0x20, 0xf7, 0x32, 0x1c, 0xce, 0xbd, 0x5c, 0x85, 0x0a, 0x8f, 0xef, 0x1d,
+	0x37, 0x17, 0xfb, 0xb8, 0x11, 0xba, 0x8f, 0x9b, 0x61, 0xfd, 0x9d, 0x6c, 0x0a, 0xeb, 0x95, 0xdf,
+	0x83, 0x59, 0xcf, 0xd5, 0x6d, 0x6c, 0x91, 0x69, 0xc3, 0x07, 0x60, 0x96, 0x8e, 0xf8, 0x52, 0xbf,
+	0x80, 0xbb, 0x2e, 0xc0, 0x83, 0xca, 0xa3, 0x09, 0xa4, 0x69, 0x2f, 0xa9, 0x4b, 0xde, 0x82, 0xe3,
+	0x09, 0x89, 0xa2, 0xc0, 0x52, 0x57, 0x48, 0xb1, 0xd4, 0xcd, 0xc7, 0xfc, 0xca, 0xef, 0x23, 0xde,
+	0x1d, 0x5a, 0x70, 0x46, 0xe9, 0x82, 0x33, 0xba, 0x11, 0x58, 0x69, 0x6e, 0x43, 0xb1, 0xa3, 0x4e,
+	0x9a, 0xa0, 0x1a, 0x1b, 0x30, 0x41, 0x35, 0xee, 0xc3, 0x91, 0x1e, 0x79, 0x05, 0xc6, 0x84, 0xa6,
+	0x29, 0x9a, 0xf1, 0x01, 0xd1, 0x8c, 0x72, 0x28, 0x8a, 0xc4, 0x81, 0x11, 0x92, 0x2f, 0x67, 0xab,
+	0x5d, 0x66, 0x69, 0xf4, 0xf2, 0x1b, 0xb5, 0x81, 0xee, 0x26, 0x6a, 0x7d, 0xbd, 0xa7, 0xf6, 0x3a,
+	0xc3, 0x7b, 0xd3, 0xf6, 0xdc, 0xb6, 0x2a, 0x66, 0xe9, 0xb8, 0x6e, 0xe9, 0x80, 0xb9, 0x93, 0x57,
+	0x21, 0xcf, 0xb3, 0xc3, 0x64, 0x99, 0x23, 0x24, 0x9f, 0x0c, 0xab, 0x4d, 0xa4, 0xf6, 0x09, 0xfc,
+	0x7d, 0x36, 0x52, 0xf5, 0x41, 0xe6, 0xdf, 0x85, 0xb1, 0x20, 0x61, 0x72, 0x19, 0x32, 0x3b, 0xa8,
+	0xcd, 0xc3, 0x30, 0xf9, 0x29, 0x5f, 0x83, 0xdc, 0xae, 0x5e, 0x6f, 0x75, 0xd9, 0x21, 0xd2, 0xdb,
+	0x85, 0xa0, 0xb3, 0x13, 0x6c, 0x6d, 0x95, 0x81, 0x5c, 0x1b, 0xba, 0x2a, 0xb1, 0xe5, 0x2b, 0xb0,
+	0x18, 0x5c, 0x37, 0x3c, 0x6b, 0xd7, 0xf2, 0xda, 0x9f, 0x2f, 0x06, 0x69, 0x17, 0x83, 0xa0, 0xe4,
+	0x9e, 0xe1, 0x62, 0xf0, 0x37, 0x59, 0xb1, 0x18, 0x24, 0xaa, 0x8a, 0x2f, 0x06, 0x0f, 0xa0, 0x14,
+	0x11, 0x17, 0x5f, 0x0e, 0x16, 0xc3, 0xbc, 0x04, 0xe2, 0x14, 0xdb, 0xff, 0xb5, 0xa9, 0x08, 0xd5,
+	0x62, 0x58, 0xa4, 0x31, 0xf7, 0x1d, 0x3a, 0x88, 0xfb, 0x06, 0xe2, 0x73, 0x26, 0x1c, 0x9f, 0x11,
+	0x54, 0xc5, 0x16, 0x98, 0x37, 0x69, 0x91, 0xb0, 0x93, 0x1d, 0x70, 0xc2, 0xa3, 0x1c, 0xcf, 0x75,
+	0x86, 0x66, 0x2d, 0x14, 0x84, 0xee, 0xc3, 0xc4, 0x36, 0xd2, 0x5d, 0x6f, 0x03, 0xe9, 0x9e, 0x66,
+	0x22, 0x4f, 0xb7, 0xea, 0xb8, 0x92, 0x1b, 0x30, 0xab, 0x5c, 0xf6, 0x41, 0x6f, 0x30, 0xc8, 0xf8,
+	0x8a, 0x3b, 0x7c, 0xe0, 0x15, 0xf7, 0x42, 0xc0, 0x71, 0x7c, 0x87, 0xa2, 0x36, 0x52, 0xe8, 0x78,
+	0xc3, 0x03, 0xd1, 0xd1, 0xb1, 0xa2, 0xfc, 0x01, 0xad, 0xe8, 0x87, 0x12, 0x9c, 0x62, 0xc6, 0x12,
+	0x8a, 0x8a, 0x3c, 0x69, 0x9e, 0xca, 0xe7, 0x1d, 0x28, 0xf3, 0x54, 0x3d, 0x8a, 0xdc, 0xe1, 0xdc,
+	0xe8, 0xeb, 0x37, 0x03, 0x90, 0xa0, 0x96, 0x04, 0x76, 0xde, 0xa0, 0xfc, 0x60, 0x08, 0x4e, 0xf7,
+	0x06, 0xe4, 0x4e, 0x80, 0x3b, 0xbb, 0x0b, 0x71, 0x73, 0xc5, 0xbd, 0xe0, 0xce, 0xd3, 0x5a, 0x37,
+	0xc8, 0x51, 0x32, 0xec, 0x79, 0x08, 0x8a, 0x3a, 0x77, 0x4c, 0xba, 0x66, 0xe3, 0xca, 0xd0, 0x42,
+	0x66, 0xe0, 0x44, 0x79, 0x42, 0x10, 0xe1, 0x13, 0x8d, 0xeb, 0x81, 0x2e, 0x4c, 0xce, 0x2d, 0x2e,
+	0xc2, 0xc8, 0xe3, 0x07, 0xc0, 0x76, 0x2c, 0xdd, 0x41, 0x7b, 0x83, 0x3e, 0xbd, 0x6a, 0x2a, 0x7f,
+	0x29, 0xc1, 0x02, 0x43, 0x18, 0xe2, 0x89, 0xdc, 0xbc, 0xa4, 0x52, 0xf9, 0x36, 0x14, 0x37, 0x29,
+	0x4c, 0x44, 0xe1, 0xd7, 0x0f, 0xa2, 0xf0, 0xd0, 0xec, 0xea, 0xf8, 0x66, 0xf0, 0x53, 0x39, 0x05,
+	0x27, 0x7b, 0x80, 0xf0, 0xa3, 0xcc, 0x0f, 0x25, 0x50, 0xe2, 0x21, 0xf1, 0x8e, 0x70, 0xd7, 0x14,
+	0x8c, 0x35, 0x83, 0x01, 0x22, 0xcc, 0xdb, 0xca, 0x00, 0xbc, 0xf5, 0x23, 0x21, 0x10, 0x43, 0x04,
+	0x83, 0x0f, 0xe1, 0x54, 0x4f, 0x38, 0x6e, 0x55, 0xe7, 0xa0, 0x6c, 0xe8, 0xb6, 0x81, 0xfc, 0xa5,
+	0x09, 0x31, 0xfa, 0xf3, 0x6a, 0x89, 0xb5, 0xab, 0xa2, 0x39, 0xe8, 0xda, 0x41, 0x9c, 0xcf, 0xc9,
+	0xb5, 0x7b, 0x91, 0x10, 0x77, 0xed, 0x33, 0x70, 0xba, 0x37, 0x1c, 0xd7, 0x78, 0xc0, 0x90, 0x83,
+	0x03, 0xff, 0xff, 0x0d, 0xb9, 0xeb, 0xec, 0xdd, 0x0d, 0x39, 0x09, 0x84, 0xb3, 0xf5, 0x57, 0xd4,
+	0x90, 0xe3, 0xfc, 0x53, 0x0d, 0xa7, 0x62, 0xec, 0xd7, 0xa0, 0x18, 0xb6, 0x97, 0x14, 0x56, 0xdc,
+	0x6f, 0x7e, 0x75, 0x3c, 0x64, 0x72, 0xca, 0x62, 0xb2, 0xbd, 0xf9, 0x40, 0x9c, 0xb9, 0xbf, 0x1d,
+	0x82, 0xea, 0x9a, 0xb5, 0x65, 0xeb, 0xf5, 0xc3, 0x94, 0x0b, 0x6c, 0x42, 0x11, 0x53, 0x24, 0x11,
+	0xc6, 0x5e, 0xeb, 0x5f, 0x2f, 0xd0, 0x73, 0x6e, 0x75, 0x9c, 0xa1, 0x15, 0xa4, 0x58, 0x70, 0x14,
+	0xed, 0x7b, 0xc8, 0x25, 0x33, 0x25, 0x6c, 0x69, 0x33, 0x69, 0xb7, 0xb4, 0x73, 0x02, 0x5b, 0xac,
+	0x4b, 0xae, 0xc1, 0xa4, 0xb1, 0x6d, 0xd5, 0xcd, 0xce, 0x3c, 0x8e, 0x5d, 0x6f, 0xd3, 0x1d, 0x4f,
+	0x5e, 0x9d, 0xa0, 0x5d, 0x02, 0xe8, 0x6b, 0x76, 0xbd, 0xad, 0x9c, 0x84, 0x13, 0x5d, 0x79, 0xe1,
+	0xb2, 0xfe, 0x07, 0x09, 0xce, 0xf2, 0x31, 0x96, 0xb7, 0x7d, 0xe8, 0x1a, 0x8d, 0x6f, 0x4b, 0x30,
+	0xc7, 0xa5, 0xbe, 0x67, 0x79, 0xdb, 0x5a, 0x52, 0xc1, 0xc6, 0x9d, 0x41, 0x15, 0xd0, 0x8f, 0x20,
+	0x75, 0x06, 0x87, 0x07, 0x0a, 0x3b, 0xbb, 0x0e, 0x4b, 0xfd, 0x51, 0xf4, 0xbc, 0x0b, 0x57, 0x7e,
+	0x24, 0xc1, 0x09, 0x15, 0x35, 0x9c, 0x5d, 0xc4, 0x30, 0x1d, 0xf0, 0xd2, 0xe2, 0xd9, 0x1d, 0x73,
+	0xc2, 0xe7, 0x93, 0x4c, 0xe4, 0x7c, 0xa2, 0x28, 0xb0, 0xd0, 0x9d, 0x7c, 0xa1, 0xfb, 0x21, 0x38,
+	0xb9, 0x8e, 0xdc, 0x86, 0x65, 0xeb, 0x1e, 0x3a, 0x8c, 0xd6, 0x1d, 0x98, 0xf0, 0x04, 0x9e, 0x88,
+	0xb2, 0x97, 0xfb, 0x2a, 0xbb, 0x2f, 0x05, 0x6a, 0xd9, 0x47, 0xfe, 0x0b, 0xe0, 0x73, 0xa7, 0x41,
+	0xe9, 0xc5, 0x11, 0x17, 0xfd, 0x7f, 0x4b, 0x50, 0xbd, 0x81, 0xea, 0xe8, 0x70, 0x72, 0x7f, 0x76,
+	0xd6, 0x75, 0x0e, 0xca, 0x3e, 0x66, 0x9e, 0xf5, 0xe7, 0xdb, 0x45, 0x3f, 0x27, 0xcf, 0xaf, 0x07,
+	0xe8, 0xa5, 0x44, 0xdd, 0xc1, 0x28, 0x59, 0x42, 0x32, 0xeb, 0x8b, 0x86, 0xa5, 0xae, 0xbc, 0x73,
+	0xf9, 0xfc, 0x8f, 0x04, 0xc7, 0x69, 0x52, 0xfa, 0x90, 0x05, 0x63, 0x6c, 0xe7, 0x9b, 0xb6, 0x60,
+	0xac, 0xe7, 0xcc, 0xea, 0x18, 0x45, 0x2a, 0xe8, 0x38, 0x0f, 0x13, 0x4d, 0xd2, 0xe0, 0xee, 0x22,
+	0x6d, 0xa3, 0x45, 0x0c, 0x85, 0xbb, 0x63, 0x5e, 0x2d, 0x89, 0x8e, 0x65, 0xd2, 0xbe, 0x6a, 0xca,
+	0x67, 0xa0, 0xe4, 0xe9, 0xee, 0x16, 0xf2, 0x3a, 0x23, 0xb3, 0x94, 0xec, 0x71, 0xd6, 0xcc, 0xc7,
+	0x29, 0xaf, 0x40, 0xb5, 0x1b, 0x09, 0xbd, 0xa3, 0xd6, 0x8f, 0xe9, 0xb5, 0x42, 0xd3, 0xb2, 0x05,
+	0x24, 0xc7, 0xf8, 0x3c, 0xae, 0x59, 0xe7, 0x20, 0x1f, 0x92, 0x47, 0x41, 0x1d, 0xd9, 0x48, 0x29,
+	0x87, 0x6b, 0x70, 0x2c, 0x99, 0x1b, 0x2e, 0x85, 0x79, 0xc8, 0xbb, 0xa4, 0xdf, 0xf6, 0xf7, 0xb8,
+	0xfe, 0xb7, 0xf2, 0xfb, 0x19, 0x58, 0xe4, 0x6c, 0xb3, 0x0d, 0xc6, 0x61, 0x2c, 0xa9, 0xd1, 0x65,
+	0x93, 0x74, 0x6b, 0x00, 0x53, 0x1a, 0x80, 0x84, 0xc8, 0x3e, 0x49, 0x7e, 0x35, 0x10, 0xde, 0x78,
+	0x29, 0x5e, 0x3c, 0x97, 0x55, 0x11, 0x43, 0x56, 0xc5, 0x08, 0x91, 0xd3, 0xea, 0x13, 0x1d, 0xb3,
+	0xcf, 0x3e, 0x3a, 0xe6, 0xba, 0x45, 0xc7, 0x25, 0x38, 0xd3, 0x4f, 0x22, 0x3c, 0x02, 0xfc, 0x6c,
+	0x08, 0x8e, 0x8a, 0x9c, 0x4c, 0xf0, 0x44, 0xf7, 0xa9, 0x08, 0x8f, 0x57, 0x60, 0xc6, 0xc2, 0x5a,
+	0x42, 0x91, 0x20, 0xf7, 0xfc, 0x49, 0x0b, 0xdf, 0x8a, 0x56, 0xff, 0xc9, 0x77, 0x61, 0x94, 0xc9,
+	0x8a, 0x25, 0x64, 0xb2, 0x69, 0x13, 0x32, 0x40, 0xa1, 0xe9, 0x6f, 0xf9, 0x1e, 0x8c, 0xf1, 0x32,
+	0x55, 0x86, 0x2c, 0x97, 0x16, 0xd9, 0x28, 0x03, 0xa7, 0x1f, 0xe4, 0x06, 0x30, 0x59, 0xd4, 0x5c,
+	0x17, 0xff, 0x2e, 0xc1, 0xd9, 0x47, 0xc8, 0xb5, 0x36, 0xdb, 0x31, 0xae, 0x04, 0xdc, 0xa7, 0x23,
+	0xf7, 0xeb, 0x67, 0xbb, 0x32, 0x07, 0xcc, 0x76, 0x9d, 0x87, 0xa5, 0xfe, 0x8c, 0x72, 0xa9, 0xfc,
+	0x6f, 0x06, 0x4e, 0xb3, 0x13, 0xf9, 0x0a, 0x51, 0x8c, 0x4f, 0xc5, 0x41, 0xce, 0xcf, 0xcf, 0x4e,
+	0x24, 0x35, 0xe0, 0xd5, 0xc7, 0x81, 0x48, 0xe2, 0xc7, 0x90, 0x09, 0xd6, 0xe5, 0x47, 0x90, 0x55,
+	0x53, 0x7e, 0x1b, 0x26, 0xc5, 0x59, 0xdb, 0x3c, 0x4c, 0xd0, 0x90, 0x7d, 0x2c, 0x1d, 0x5a, 0x1e,
+	0xfa, 0x59, 0x02, 0x7a, 0xad, 0x46, 0x93, 0xcd, 0xb9, 0x34, 0xc9, 0xe6, 0x52, 0x07, 0x9c, 0x36,
+	0x74, 0x14, 0x3e, 0x7c, 0xc0, 0x6b, 0x97, 0xab, 0x50, 0x89, 0x89, 0x47, 0x6c, 0x78, 0x46, 0xf8,
+	0xfd, 0x65, 0x58, 0x46, 0x7c, 0xdf, 0xa3, 0x9c, 0x85, 0xc5, 0x3e, 0xda, 0xe7, 0x76, 0xf2, 0x67,
+	0x19, 0xb8, 0xc0, 0x8c, 0x2a, 0x71, 0x24, 0x0d, 0x7a, 0x04, 0x4f, 0x2a, 0x83, 0x59, 0x87, 0x72,
+	0xb4, 0x4e, 0x3d, 0xbd, 0xb9, 0x94, 0x22, 0x75, 0xe9, 0xb2, 0x0a, 0x25, 0x16, 0xa2, 0x0e, 0xb1,
+	0x97, 0x2e, 0x1a, 0x21, 0x2e, 0xbb, 0x19, 0x60, 0xb6, 0x9b, 0x01, 0xf6, 0xd2, 0x48, 0xae, 0x97,
+	0x46, 0x0e, 0x6d, 0x0c, 0xca, 0x4b, 0x50, 0x1b, 0x54, 0x51, 0x5c, 0xb7, 0x7f, 0x2c, 0xc1, 0xc2,
+	0x0d, 0x84, 0x0d, 0xd7, 0xda, 0x38, 0xd4, 0x4e, 0xfe, 0xeb, 0x30, 0x92, 0x36, 0xaf, 0xd4, 0x6f,
+	0x5a, 0x55, 0x60, 0x54, 0x7e, 0x2f, 0x0b, 0x27, 0x7b, 0x8c, 0xe6, 0x9b, 0xa9, 0x77, 0xa0, 0xdc,
+	0xb9, 0x43, 0x36, 0x1c, 0x7b, 0xd3, 0xda, 0xe2, 0x39, 0xf0, 0x4b, 0xc9, 0xb4, 0x24, 0xaa, 0x7f,
+	0x85, 0x02, 0xaa, 0x25, 0x14, 0x6e, 0x90, 0xb7, 0x60, 0x36, 0xe1, 0xaa, 0x9a, 0xbe, 0xac, 0x60,
+	0x0c, 0x5f, 0x4c, 0x31, 0x09, 0xbb, 0x13, 0xdf, 0x4b, 0x6a, 0x96, 0xdf, 0x01, 0xb9, 0x89, 0x6c,
+	0xd3, 0xb2, 0xb7, 0x34, 0x9e, 0x07, 0xb7, 0x10, 0xae, 0x64, 0x68, 0x66, 0xfd, 0x42, 0xf7, 0x39,
+	0x1e, 0x32, 0x18, 0x91, 0x97, 0xa2, 0x33, 0x4c, 0x34, 0x43, 0x8d, 0x16, 0xc2, 0xf2, 0x37, 0xa0,
+	0x2c, 0xb0, 0x53, 0x33, 0x77, 0x69, 0x09, 0x20, 0xc1, 0x7d, 0xa5, 0x2f, 0xee, 0xb0, 0x51, 0xd1,
+	0x19, 0x4a, 0xcd, 0x40, 0x97, 0x8b, 0x6c, 0x19, 0xc1, 0xb4, 0xc0, 0x1f, 0xde, 0x57, 0xe4, 0xfa,
+	0x69, 0x82, 0x4f, 0x12, 0x2b, 0x1d, 0x98, 0x6c, 0xc6, 0x3b, 0x94, 0x7f, 0xcb, 0x40, 0x45, 0xe5,
+	0x4f, 0x93, 0x10, 0x8d, 0xa4, 0xf8, 0xd1, 0xe5, 0x4f, 0xc5, 0x72, 0xb5, 0x09, 0xd3, 0xe1, 0x82,
+	0xb5, 0xb6, 0x66, 0x79, 0xa8, 0x21, 0x34, 0x78, 0x39, 0x55, 0xd1, 0x5a, 0x7b, 0xd5, 0x43, 0x0d,
+	0x75, 0x72, 0x37, 0xd6, 0x86, 0xe5, 0xab, 0x30, 0x4c, 0xd7, 0x1f, 0x5c, 0xc9, 0xf6, 0xbe, 0xd5,
+	0xbb, 0xa1, 0x7b, 0xfa, 0x72, 0xdd, 0xd9, 0x50, 0xf9, 0x78, 0xf9, 0x16, 0x14, 0xc9, 0x13, 0x19,
+	0x72, 0xfc, 0xe2, 0x18, 0x72, 0x03, 0x62, 0x18, 0xb3, 0xd1, 0x9e, 0xda, 0x62, 0x2b, 0x17, 0x96,
+	0x37, 0x60, 0x72, 0x43, 0xc7, 0x28, 0xea, 0x0d, 0x2c, 0x76, 0x5d, 0xee, 0xfb, 0xce, 0x68, 0x59,
+	0xc7, 0x28, 0x6c, 0x4c, 0x13, 0x1b, 0xd1, 0x26, 0xe5, 0x28, 0xcc, 0x25, 0xa8, 0x99, 0xc7, 0xae,
+	0xbf, 0xa7, 0x67, 0x6c, 0xde, 0xfb, 0x66, 0xb0, 0xf4, 0x4e, 0x58, 0x82, 0x16, 0x2b, 0xef, 0x63,
+	0x01, 0xe1, 0x6a, 0x22, 0x75, 0x81, 0x47, 0x68, 0x41, 0x75, 0x87, 0x52, 0x4f, 0x91, 0x12, 0xbf,
+	0x45, 0x28, 0xba, 0xa8, 0xe1, 0x78, 0x48, 0x33, 0xea, 0x2d, 0xec, 0x21, 0x97, 0xda, 0x50, 0x41,
+	0x1d, 0x67, 0xad, 0x2b, 0xac, 0x31, 0x66, 0x91, 0x99, 0x98, 0x45, 0x2a, 0x0b, 0x50, 0xed, 0xc6,
+	0x0b, 0x67, 0xf7, 0x0f, 0x25, 0x98, 0x59, 0x6b, 0xdb, 0xc6, 0xda, 0xb6, 0xee, 0x9a, 0xbc, 0x32,
+	0x90, 0xf3, 0xb9, 0x08, 0x45, 0xfe, 0x20, 0x47, 0x90, 0xc1, 0x6c, 0x7e, 0x9c, 0xb5, 0x0a, 0x32,
+	0xe6, 0x20, 0x8f, 0x09, 0xb0, 0xa8, 0x6d, 0xca, 0xa9, 0x23, 0xf4, 0x7b, 0xd5, 0x94, 0xaf, 0xc3,
+	0x28, 0x2b, 0x51, 0x64, 0x77, 0xd0, 0x99, 0x01, 0xef, 0xa0, 0x81, 0x01, 0x91, 0x66, 0x65, 0x0e,
+	0x66, 0x63, 0xe4, 0x71, 0xd2, 0x7f, 0x3c, 0x0c, 0x93, 0xa4, 0x4f, 0x44, 0xa7, 0x14, 0x9e, 0x7a,
+	0x02, 0x46, 0x7d, 0x15, 0x72, 0xb2, 0x0b, 0x2a, 0x88, 0xa6, 0x55, 0x33, 0x90, 0x49, 0xc8, 0x04,
+	0xdf, 0x02, 0x55, 0x60, 0x44, 0x2c, 0xba, 0x6c, 0xa5, 0x16, 0x9f, 0x5d, 0xea, 0x2b, 0x72, 0x5d,
+	0xea, 0x2b, 0xe2, 0x65, 0x41, 0xc3, 0x07, 0x2b, 0x0b, 0x4a, 0x2a, 0x00, 0x1b, 0x49, 0x2c, 0x00,
+	0x8b, 0x56, 0x20, 0xe4, 0x0f, 0x52, 0x81, 0xf0, 0x90, 0x57, 0x2b, 0x77, 0x2e, 0xf9, 0x28, 0xae,
+	0xc2, 0x80, 0xb8, 0x26, 0x08, 0xb0, 0x7f, 0x39, 0x47, 0x31, 0x5e, 0x83, 0x11, 0x51, 0x48, 0x00,
+	0x03, 0x16, 0x12, 0x08, 0x80, 0x60, 0x3d, 0xc4, 0x68, 0xb8, 0x1e, 0x62, 0x05, 0xc6, 0x28, 0x9d,
+	0xe2, 0x35, 0xdd, 0xd8, 0x80, 0xaf, 0xe9, 0x46, 0x69, 0x89, 0x2b, 0xfb, 0x20, 0x29, 0x3c, 0x8a,
+	0x84, 0x3f, 0x0d, 0xb0, 0x4c, 0x64, 0x7b, 0x96, 0xd7, 0xa6, 0xa5, 0x57, 0x05, 0x55, 0x26, 0x7d,
+	0xec, 0x05, 0xc0, 0x2a, 0xef, 0x21, 0xb5, 0xb9, 0x91, 0x30, 0xcd, 0xab, 0x8a, 0x6b, 0xe9, 0x02,
+	0xb4, 0x5a, 0x0c, 0x07, 0xe7, 0x6e, 0x51, 0xb1, 0xf4, 0x34, 0xa3, 0xe2, 0x0c, 0x4c, 0x85, 0xbd,
+	0x89, 0xbb, 0x19, 0x29, 0xca, 0x15, 0xfb, 0xa4, 0xe7, 0xfc, 0x48, 0x41, 0xf9, 0x2f, 0x09, 0x8e,
+	0x25, 0xd3, 0xc2, 0xb7, 0x6b, 0xdb, 0x30, 0x69, 0xe8, 0xc6, 0x36, 0x0a, 0xbf, 0xf1, 0x3d, 0x74,
+	0x80, 0x9e, 0xa0, 0x48, 0x83, 0x4d, 0xb2, 0x0d, 0x33, 0xa6, 0xee, 0xe9, 0x54, 0x2d, 0xe1, 0xc9,
+	0x86, 0x0e, 0x39, 0xd9, 0x94, 0xc0, 0x1b, 0x6c, 0x55, 0xfe, 0x51, 0x82, 0x79, 0xc1, 0x3a, 0x37,
+	0x8b, 0x3b, 0x0e, 0x0e, 0x5e, 0xce, 0x6f, 0x3b, 0xd8, 0xd3, 0x74, 0xd3, 0x74, 0x11, 0xc6, 0x42,
+	0x0b, 0xa4, 0xed, 0x3a, 0x6b, 0xea, 0x15, 0xa8, 0xfb, 0x2f, 0x25, 0x5d, 0x36, 0x37, 0xd9, 0xc3,
+	0x6f, 0x6e, 0x94, 0x7f, 0x09, 0x18, 0x58, 0x88, 0x33, 0xae, 0xd3, 0x53, 0x30, 0x4e, 0xe9, 0xc4,
+	0x9a, 0xdd, 0x6a, 0x6c, 0xf0, 0x65, 0x28, 0xa7, 0x8e, 0xb1, 0xc6, 0x07, 0xb4, 0x4d, 0x3e, 0x0a,
+	0x05, 0xc1, 0x1c, 0xab, 0x18, 0xc9, 0xa9, 0x79, 0xce, 0x1d, 0x79, 0xe9, 0x54, 0xea, 0xb0, 0x47,
+	0x55, 0xd9, 0xf3, 0xe1, 0xb2, 0x3f, 0x96, 0xb0, 0xe0, 0x17, 0x0d, 0xad, 0x10, 0x38, 0xea, 0x3c,
+	0x45, 0x3b, 0xd4, 0x46, 0xe3, 0x10, 0x17, 0x3b, 0xab, 0x88, 0x13, 0x9f, 0x77, 0xb3, 0xf9, 0x6c,
+	0x39, 0xa7, 0xd4, 0x60, 0x62, 0xa5, 0xee, 0x60, 0x44, 0x17, 0x31, 0xa1, 0xb0, 0xa0, 0x36, 0xa4,
+	0x90, 0x36, 0x94, 0x29, 0x90, 0x83, 0xe3, 0xb9, 0x1f, 0xbe, 0x08, 0xa5, 0xdb, 0xc8, 0x1b, 0x14,
+	0xc7, 0xbb, 0x50, 0xee, 0x8c, 0xe6, 0x82, 0xbc, 0x07, 0xc0, 0x87, 0x93, 0xe0, 0xc1, 0x7c, 0xe2,
+	0xc2, 0x20, 0x66, 0x4a, 0xd1, 0x50, 0xd6, 0x0b, 0x58, 0xfc, 0x54, 0xfe, 0x49, 0x82, 0x09, 0x76,
+	0x99, 0x16, 0x4c, 0x40, 0x76, 0x27, 0x49, 0xbe, 0x05, 0x79, 0x43, 0xf7, 0xd0, 0x16, 0x09, 0x8b,
+	0x43, 0xf4, 0xc9, 0xc2, 0xf9, 0xde, 0x0f, 0x22, 0xd8, 0x35, 0x38, 0x83, 0x50, 0x7d, 0xd8, 0x60,
+	0x71, 0x62, 0x26, 0x54, 0x9c, 0xb8, 0x0a, 0xa5, 0x5d, 0x0b, 0x5b, 0x1b, 0x56, 0x9d, 0x16, 0x0f,
+	0xa5, 0x29, 0x7b, 0x2b, 0x76, 0x00, 0xe9, 0xb6, 0x63, 0x0a, 0xe4, 0x20, 0x6f, 0x5c, 0x05, 0xdf,
+	0x8a, 0x5b, 0x6a, 0xe8, 0x7d, 0xc2, 0xb3, 0x67, 0x5e, 0xd9, 0x86, 0x63, 0xc9, 0x14, 0x70, 0x1d,
+	0xdf, 0x81, 0x61, 0x5a, 0xc5, 0x4c, 0x22, 0x40, 0x66, 0x90, 0xf7, 0x2c, 0x41, 0x2c, 0x54, 0xc5,
+	0x1c, 0x5e, 0xf9, 0x40, 0x82, 0xe3, 0xb7, 0x91, 0xa7, 0x76, 0xfe, 0xab, 0x81, 0xd7, 0xd7, 0xfa,
+	0x1b, 0xc4, 0x7b, 0x30, 0x4c, 0x0b, 0x9f, 0xc5, 0x5c, 0xc9, 0xde, 0x14, 0xf8, 0xb3, 0x07, 0x96,
+	0xfa, 0xf7, 0x3f, 0x69, 0x89, 0xb4, 0xca, 0x71, 0x90, 0x18, 0xc4, 0xf7, 0x99, 0xb4, 0x82, 0x8f,
+	0x6f, 0xca, 0x46, 0x79, 0x1b, 0x71, 0x43, 0xe5, 0x7b, 0x43, 0x50, 0xed, 0x46, 0x12, 0xe7, 0xff,
+	0x9b, 0x50, 0x64, 0x2a, 0xf0, 0xcb, 0x86, 0x19, 0x6d, 0x6f, 0x0d, 0x58, 0xb1, 0xd6, 0x1b, 0x3d,
+	0xf3, 0x04, 0xd1, 0xca, 0x8a, 0x9d, 0xc7, 0x71, 0xb0, 0x6d, 0xbe, 0x0d, 0x72, 0x7c, 0x50, 0xb0,
+	0xf0, 0x38, 0xc7, 0x0a, 0x8f, 0xef, 0x87, 0x0b, 0x8f, 0x5f, 0x49, 0x29, 0x3b, 0x9f, 0xb2, 0x4e,
+	0x2d, 0xb2, 0xf2, 0x3e, 0x2c, 0xdc, 0x46, 0xde, 0x8d, 0x7b, 0xaf, 0xf7, 0xd0, 0xd9, 0x23, 0xfe,
+	0x80, 0x8c, 0x84, 0x00, 0x21, 0x9b, 0xb4, 0x73, 0xfb, 0xa7, 0xe8, 0x82, 0xc7, 0x7f, 0x61, 0xe5,
+	0x37, 0x24, 0x38, 0xd9, 0x63, 0x72, 0xae, 0x9d, 0x77, 0x61, 0x22, 0x80, 0x96, 0xd7, 0xf7, 0x49,
+	0xd1, 0x4c, 0xc1, 0xc0, 0x44, 0xa8, 0x65, 0x37, 0xdc, 0x80, 0x95, 0xef, 0x48, 0x30, 0x45, 0x8b,
+	0xb4, 0xc5, 0xd2, 0x93, 0x62, 0x9b, 0xf2, 0xb5, 0x68, 0xba, 0xe9, 0x0b, 0x7d, 0xd3, 0x4d, 0x49,
+	0x53, 0x75, 0x52, 0x4c, 0x3b, 0x30, 0x1d, 0x19, 0xc0, 0xe5, 0xa0, 0x92, 0x2b, 0xba, 0x50, 0x45,
+	0xe5, 0x17, 0xd3, 0x4e, 0xc5, 0xa0, 0x55, 0x1f, 0x8f, 0xf2, 0xbb, 0x12, 0x4c, 0xa9, 0x48, 0x6f,
+	0x36, 0xeb, 0x2c, 0x2d, 0x8c, 0x53, 0x70, 0xbe, 0x16, 0xe5, 0x3c, 0xf9, 0x55, 0x46, 0xf0, 0x7f,
+	0x4d, 0x98, 0x3a, 0xe2, 0xd3, 0x75, 0xb8, 0x9f, 0x85, 0xe9, 0xc8, 0x00, 0x4e, 0xe9, 0x5f, 0x0c,
+	0xc1, 0x34, 0xb3, 0x95, 0xa8, 0x75, 0xde, 0x84, 0xac, 0xff, 0xf4, 0xa6, 0x18, 0xcc, 0xeb, 0x24,
+	0x45, 0xc8, 0x1b, 0x48, 0x37, 0xef, 0x21, 0xcf, 0x43, 0x2e, 0x0d, 0x5e, 0xb4, 0x2a, 0x98, 0x82,
+	0xf7, 0xda, 0xe9, 0xc4, 0x0f, 0xb5, 0x99, 0xa4, 0x43, 0xed, 0x2b, 0x50, 0xb1, 0x6c, 0x32, 0xc2,
+	0xda, 0x45, 0x1a, 0xb2, 0xfd, 0x70, 0xd2, 0xc9, 0xd1, 0x4e, 0xfb, 0xfd, 0x37, 0x6d, 0xe1, 0xec,
+	0xab, 0x26, 0xb9, 0xf8, 0x6e, 0xe8, 0xfb, 0x56, 0xa3, 0xd5, 0xd0, 0x9a, 0x64, 0x3c, 0xb6, 0xde,
+	0x67, 0x7f, 0x4a, 0x92, 0x53, 0x4b, 0xbc, 0xe3, 0xa1, 0xbe, 0x85, 0xd6, 0xac, 0xf7, 0x11, 0xb9,
+	0xf0, 0xa5, 0x6f, 0x72, 0xe8, 0x40, 0xf6, 0x84, 0x64, 0x98, 0x3e, 0x21, 0xa1, 0x4f, 0x75, 0xc8,
+	0x30, 0xf6, 0x66, 0xf6, 0xa3, 0x21, 0x98, 0x89, 0xca, 0x8b, 0x1b, 0xd2, 0x53, 0x12, 0x58, 0xa2,
+	0x5f, 0x0e, 0x3d, 0x45, 0xbf, 0x4c, 0xe2, 0x35, 0x93, 0xc0, 0xab, 0xdc, 0x80, 0x99, 0x00, 0x2c,
+	0xa3, 0x84, 0xed, 0x57, 0xb2, 0x87, 0x8b, 0x55, 0x53, 0x51, 0x92, 0xe8, 0x26, 0xe6, 0x9f, 0xc9,
+	0xeb, 0xeb, 0x96, 0xbb, 0x85, 0x3e, 0x8b, 0xc6, 0xa8, 0xcc, 0x43, 0x25, 0xce, 0x9c, 0x28, 0x01,
+	0x1d, 0x82, 0xd9, 0xfb, 0xe8, 0x33, 0xca, 0xf9, 0x33, 0x71, 0xc3, 0x65, 0xa8, 0xdc, 0x47, 0xc9,
+	0xd2, 0x4c, 0xc2, 0x21, 0x25, 0xe1, 0xf8, 0x1e, 0x2d, 0x45, 0xd9, 0x74, 0x11, 0xde, 0x0e, 0xa6,
+	0x9e, 0xd3, 0xc4, 0xea, 0xb7, 0xa3, 0xb1, 0xfa, 0xab, 0x03, 0xc6, 0xea, 0xae, 0xb3, 0x76, 0x42,
+	0x36, 0x7d, 0xf4, 0x9a, 0x34, 0x8e, 0x1b, 0xcd, 0x77, 0x25, 0x38, 0x7f, 0x1b, 0xd9, 0xc8, 0xd5,
+	0x3d, 0x74, 0x8f, 0xe4, 0x72, 0x78, 0xbe, 0x22, 0xe2, 0x5a, 0xcf, 0x23, 0x35, 0x60, 0xc0, 0x0b,
+	0x03, 0x51, 0xc6, 0x15, 0xf6, 0x32, 0xcc, 0xd0, 0xd3, 0xba, 0xc6, 0xde, 0x10, 0xf2, 0xeb, 0x9d,
+	0x16, 0x7f, 0xe7, 0x93, 0x51, 0xa7, 0x68, 0xef, 0xba, 0xdf, 0xb9, 0x42, 0xfa, 0x94, 0x5b, 0x70,
+	0x34, 0xbc, 0x41, 0x0c, 0x67, 0x4c, 0xcf, 0x42, 0x29, 0x9c, 0xb8, 0x65, 0x9b, 0x9b, 0x82, 0x5a,
+	0x0c, 0x65, 0x6e, 0xb1, 0xd2, 0x82, 0x63, 0xc9, 0x78, 0x38, 0x75, 0x6f, 0xc0, 0x30, 0x3b, 0xdd,
+	0xf2, 0xcd, 0xd1, 0xab, 0x03, 0xee, 0x5e, 0xf9, 0x79, 0x2f, 0x8a, 0x96, 0x23, 0x53, 0xfe, 0x7a,
+	0x18, 0x66, 0x92, 0x87, 0xf4, 0x3a, 0xba, 0x7c, 0x01, 0x66, 0x1b, 0xfa, 0xbe, 0x16, 0x0d, 0xcb,
+	0x9d, 0xb7, 0xac, 0x53, 0x0d, 0x7d, 0x3f, 0x1a, 0x72, 0x4d, 0xf9, 0x1e, 0x94, 0x19, 0xc6, 0xba,
+	0x63, 0xe8, 0xf5, 0x41, 0x33, 0xc0, 0xc3, 0xe4, 0x38, 0x56, 0x91, 0x54, 0xb6, 0x8b, 0xbf, 0x47,
+	0x40, 0x49, 0xa7, 0xfc, 0x7e, 0x5c, 0xb4, 0x6c, 0x41, 0x78, 0xfd, 0x50, 0xa2, 0xa9, 0xa9, 0x21,
+	0xc5, 0xb0, 0x1d, 0x7d, 0x44, 0x5b, 0xf2, 0x6f, 0x4a, 0x30, 0xb9, 0xad, 0xdb, 0xa6, 0xb3, 0xcb,
+	0xcf, 0x26, 0xd4, 0x78, 0xc9, 0x61, 0x3f, 0xcd, 0x1b, 0xca, 0x2e, 0x04, 0xdc, 0xe1, 0x88, 0xfd,
+	0x3c, 0x03, 0x27, 0x42, 0xde, 0x8e, 0x75, 0xc8, 0x4d, 0x38, 0x9d, 0xa8, 0x89, 0xe8, 0xa9, 0x77,
+	0xd0, 0x64, 0xf2, 0x42, 0x5c, 0x71, 0x8f, 0x42, 0xe7, 0xe0, 0xf9, 0xef, 0x48, 0x30, 0x99, 0x20,
+	0xa2, 0x84, 0x87, 0x94, 0x8f, 0xc3, 0xe7, 0x99, 0xdb, 0x87, 0x92, 0xca, 0x43, 0xe4, 0xf2, 0xf9,
+	0x02, 0xe7, 0x9b, 0xf9, 0x6f, 0x4b, 0x30, 0xdb, 0x45, 0x5c, 0x09, 0x04, 0xa9, 0x61, 0x82, 0xbe,
+	0x3c, 0x20, 0x41, 0xb1, 0x09, 0xe8, 0xee, 0x21, 0x70, 0xca, 0x7a, 0x0b, 0xa6, 0x13, 0xc7, 0xc8,
+	0xaf, 0xc1, 0x31, 0xdf, 0x4a, 0x92, 0x9c, 0x85, 0x05, 0x96, 0x39, 0x31, 0x26, 0xe6, 0x31, 0xca,
+	0x9f, 0x48, 0xb0, 0xd0, 0x4f, 0x1e, 0xe4, 0x21, 0xb7, 0x6e, 0xec, 0x20, 0x33, 0x82, 0x76, 0x94,
+	0x36, 0x72, 0xd7, 0x7b, 0x0c, 0xf3, 0x81, 0x31, 0x51, 0xeb, 0x18, 0xf4, 0xed, 0xe1, 0xac, 0x8f,
+	0x32, 0x6c, 0x14, 0xca, 0x6f, 0x4b, 0x30, 0xaf, 0x22, 0x5a, 0xa4, 0xf8, 0xbc, 0x13, 0xc2, 0xc7,
+	0xe1, 0x68, 0x22, 0x25, 0x7c, 0xbd, 0xfa, 0xc1, 0x10, 0x2c, 0x86, 0x8b, 0x6a, 0x3b, 0xac, 0xb0,
+	0xaa, 0x85, 0xe7, 0x40, 0x34, 0xb9, 0x45, 0x09, 0x5e, 0x20, 0xba, 0xde, 0xa0, 0xc1, 0x91, 0xdf,
+	0xa2, 0x04, 0x6e, 0x0b, 0xd9, 0xbf, 0xa0, 0x84, 0x30, 0xd2, 0xd2, 0xe2, 0x74, 0xd9, 0x2f, 0x1f,
+	0x23, 0x4d, 0x3b, 0x52, 0x1d, 0x2f, 0xc1, 0x99, 0x7e, 0x82, 0xe3, 0x32, 0xfe, 0x23, 0x09, 0xaa,
+	0x6f, 0x34, 0xcd, 0x43, 0x16, 0xcb, 0xff, 0x2a, 0x8c, 0xa4, 0x7d, 0x90, 0xd2, 0x7b, 0xd2, 0xce,
+	0xa6, 0xe6, 0x9b, 0x70, 0xa2, 0xeb, 0x50, 0xbf, 0xca, 0x23, 0x7a, 0x1e, 0xff, 0xea, 0xc1, 0xa7,
+	0x8f, 0x9d, 0xcc, 0xff, 0x5c, 0x82, 0xa5, 0x35, 0xcf, 0x45, 0x7a, 0xa3, 0x73, 0x7c, 0xef, 0x9a,
+	0xa0, 0x69, 0xc2, 0x0c, 0x6e, 0xdb, 0x46, 0x28, 0x82, 0xf4, 0xbf, 0xc4, 0x88, 0x1c, 0x80, 0xc8,
+	0x45, 0x4e, 0x24, 0x88, 0xa0, 0x3b, 0x47, 0xd4, 0x29, 0x9c, 0xd0, 0xbe, 0x3c, 0x06, 0xa0, 0x7b,
+	0x9e, 0x6b, 0x6d, 0xb4, 0x3c, 0x84, 0xc9, 0x16, 0xef, 0xdc, 0x00, 0xc4, 0x72, 0xc1, 0x3d, 0x0e,
+	0xbc, 0xcf, 0x97, 0xa2, 0x7a, 0xeb, 0x4e, 0x5f, 0x0f, 0xd4, 0x77, 0x8e, 0x74, 0xde, 0xef, 0x47,
+	0x48, 0xfb, 0x53, 0x09, 0x94, 0xe0, 0xdf, 0x86, 0xf8, 0x32, 0x67, 0xaa, 0x48, 0x61, 0x6d, 0x8f,
+	0x61, 0x24, 0xed, 0xbb, 0xae, 0xfe, 0x13, 0x77, 0x2c, 0xee, 0xb7, 0x24, 0x38, 0xd5, 0x73, 0xbc,
+	0x9f, 0x0e, 0x8b, 0x9a, 0xdd, 0x8d, 0xc3, 0xd1, 0x11, 0x35, 0xbd, 0xe5, 0xe6, 0x87, 0x1f, 0x57,
+	0x8f, 0x7c, 0xf4, 0x71, 0xf5, 0xc8, 0xcf, 0x3f, 0xae, 0x4a, 0xdf, 0x7a, 0x52, 0x95, 0xbe, 0xff,
+	0xa4, 0x2a, 0xfd, 0xdd, 0x93, 0xaa, 0xf4, 0xe1, 0x93, 0xaa, 0xf4, 0xaf, 0x4f, 0xaa, 0xd2, 0x4f,
+	0x9f, 0x54, 0x8f, 0xfc, 0xfc, 0x49, 0x55, 0xfa, 0xe0, 0x93, 0xea, 0x91, 0x0f, 0x3f, 0xa9, 0x1e,
+	0xf9, 0xe8, 0x93, 0xea, 0x91, 0xb7, 0xaf, 0x6d, 0x39, 0x1d, 0x3a, 0x2c, 0xa7, 0xe7, 0xbf, 0x5e,
+	0xff, 0x4a, 0xb8, 0x65, 0x63, 0x98, 0x46, 0x99, 0x2b, 0xff, 0x37, 0x00, 0x28, 0x42, 0x0b, 0x73,
+	0x34, 0x5b, 0x00, 0x00,
 }
 
 func (this *StartWorkflowExecutionRequest) Equal(that interface{}) bool {
@@ -7374,6 +7610,12 @@ func (this *ResetWorkflowExecutionRequest) Equal(that interface{}) bool {
 	if !this.ResetRequest.Equal(that1.ResetRequest) {
 		return false
 	}
+	if this.PreserveBuildId != that1.PreserveBuildId {
+		return false
+	}
+	if this.TargetBuildId != that1.TargetBuildId {
+		return false
+	}
 	return true
 }
 func (this *ResetWorkflowExecutionResponse) Equal(that interface{}) bool {
@@ -7400,6 +7642,63 @@ func (this *ResetWorkflowExecutionResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *RepinWorkflowBuildIdRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RepinWorkflowBuildIdRequest)
+	if !ok {
+		that2, ok := that.(RepinWorkflowBuildIdRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if !this.Execution.Equal(that1.Execution) {
+		return false
+	}
+	if this.BuildId != that1.BuildId {
+		return false
+	}
+	if this.TargetBuildId != that1.TargetBuildId {
+		return false
+	}
+	return true
+}
+func (this *RepinWorkflowBuildIdResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RepinWorkflowBuildIdResponse)
+	if !ok {
+		that2, ok := that.(RepinWorkflowBuildIdResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Repinned != that1.Repinned {
+		return false
+	}
+	return true
+}
 func (this *RequestCancelWorkflowExecutionRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -8307,6 +8606,62 @@ func (this *RemoveTaskResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DescribeHistoryQueueRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DescribeHistoryQueueRequest)
+	if !ok {
+		that2, ok := that.(DescribeHistoryQueueRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.Category != that1.Category {
+		return false
+	}
+	return true
+}
+func (this *DescribeHistoryQueueResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DescribeHistoryQueueResponse)
+	if !ok {
+		that2, ok := that.(DescribeHistoryQueueResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Queues) != len(that1.Queues) {
+		return false
+	}
+	for i := range this.Queues {
+		if !this.Queues[i].Equal(that1.Queues[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *GetReplicationMessagesRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -9861,12 +10216,14 @@ func (this *ResetWorkflowExecutionRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 8)
 	s = append(s, "&historyservice.ResetWorkflowExecutionRequest{")
 	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
 	if this.ResetRequest != nil {
 		s = append(s, "ResetRequest: "+fmt.Sprintf("%#v", this.ResetRequest)+",\n")
 	}
+	s = append(s, "PreserveBuildId: "+fmt.Sprintf("%#v", this.PreserveBuildId)+",\n")
+	s = append(s, "TargetBuildId: "+fmt.Sprintf("%#v", this.TargetBuildId)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -9880,6 +10237,31 @@ func (this *ResetWorkflowExecutionResponse) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *RepinWorkflowBuildIdRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&historyservice.RepinWorkflowBuildIdRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+	}
+	s = append(s, "BuildId: "+fmt.Sprintf("%#v", this.BuildId)+",\n")
+	s = append(s, "TargetBuildId: "+fmt.Sprintf("%#v", this.TargetBuildId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RepinWorkflowBuildIdResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&historyservice.RepinWorkflowBuildIdResponse{")
+	s = append(s, "Repinned: "+fmt.Sprintf("%#v", this.Repinned)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *RequestCancelWorkflowExecutionRequest) GoString() string {
 	if this == nil {
 		return "nil"
@@ -10301,6 +10683,29 @@ func (this *RemoveTaskResponse) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *DescribeHistoryQueueRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&historyservice.DescribeHistoryQueueRequest{")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "Category: "+fmt.Sprintf("%#v", this.Category)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DescribeHistoryQueueResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&historyservice.DescribeHistoryQueueResponse{")
+	if this.Queues != nil {
+		s = append(s, "Queues: "+fmt.Sprintf("%#v", this.Queues)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *GetReplicationMessagesRequest) GoString() string {
 	if this == nil {
 		return "nil"
@@ -12711,6 +13116,23 @@ func (m *ResetWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.TargetBuildId) > 0 {
+		i -= len(m.TargetBuildId)
+		copy(dAtA[i:], m.TargetBuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TargetBuildId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PreserveBuildId {
+		i--
+		if m.PreserveBuildId {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.ResetRequest != nil {
 		{
 			size, err := m.ResetRequest.MarshalToSizedBuffer(dAtA[:i])
@@ -12763,6 +13185,95 @@ func (m *ResetWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *RepinWorkflowBuildIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepinWorkflowBuildIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RepinWorkflowBuildIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetBuildId) > 0 {
+		i -= len(m.TargetBuildId)
+		copy(dAtA[i:], m.TargetBuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TargetBuildId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BuildId) > 0 {
+		i -= len(m.BuildId)
+		copy(dAtA[i:], m.BuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BuildId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RepinWorkflowBuildIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepinWorkflowBuildIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RepinWorkflowBuildIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Repinned {
+		i--
+		if m.Repinned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RequestCancelWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -13578,12 +14089,12 @@ func (m *SyncShardStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	var l int
 	_ = l
 	if m.StatusTime != nil {
-		n83, err83 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
-		if err83 != nil {
-			return 0, err83
+		n84, err84 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StatusTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StatusTime):])
+		if err84 != nil {
+			return 0, err84
 		}
-		i -= n83
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n83))
+		i -= n84
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n84))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -13706,22 +14217,22 @@ func (m *SyncActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 	}
 	if m.LastHeartbeatTime != nil {
-		n88, err88 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime):])
-		if err88 != nil {
-			return 0, err88
+		n89, err89 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastHeartbeatTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastHeartbeatTime):])
+		if err89 != nil {
+			return 0, err89
 		}
-		i -= n88
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n88))
+		i -= n89
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n89))
 		i--
 		dAtA[i] = 0x4a
 	}
 	if m.StartedTime != nil {
-		n89, err89 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err89 != nil {
-			return 0, err89
+		n90, err90 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err90 != nil {
+			return 0, err90
 		}
-		i -= n89
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n89))
+		i -= n90
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n90))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -13731,12 +14242,12 @@ func (m *SyncActivityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x38
 	}
 	if m.ScheduledTime != nil {
-		n90, err90 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err90 != nil {
-			return 0, err90
+		n91, err91 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err91 != nil {
+			return 0, err91
 		}
-		i -= n90
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n90))
+		i -= n91
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n91))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -13980,21 +14491,21 @@ func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		dAtA[i] = 0x1a
 	}
 	if len(m.ShardIds) > 0 {
-		dAtA97 := make([]byte, len(m.ShardIds)*10)
-		var j96 int
+		dAtA98 := make([]byte, len(m.ShardIds)*10)
+		var j97 int
 		for _, num1 := range m.ShardIds {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA97[j96] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA98[j97] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j96++
+				j97++
 			}
-			dAtA97[j96] = uint8(num)
-			j96++
+			dAtA98[j97] = uint8(num)
+			j97++
 		}
-		i -= j96
-		copy(dAtA[i:], dAtA97[:j96])
-		i = encodeVarintRequestResponse(dAtA, i, uint64(j96))
+		i -= j97
+		copy(dAtA[i:], dAtA98[:j97])
+		i = encodeVarintRequestResponse(dAtA, i, uint64(j97))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -14141,12 +14652,12 @@ func (m *RemoveTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VisibilityTime != nil {
-		n99, err99 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
-		if err99 != nil {
-			return 0, err99
+		n100, err100 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VisibilityTime):])
+		if err100 != nil {
+			return 0, err100
 		}
-		i -= n99
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n99))
+		i -= n100
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n100))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -14191,7 +14702,7 @@ func (m *RemoveTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GetReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
+func (m *DescribeHistoryQueueRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14201,20 +14712,90 @@ func (m *GetReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DescribeHistoryQueueRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DescribeHistoryQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ClusterName) > 0 {
-		i -= len(m.ClusterName)
-		copy(dAtA[i:], m.ClusterName)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ClusterName)))
+	if m.Category != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Category))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeHistoryQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeHistoryQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeHistoryQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Queues) > 0 {
+		for iNdEx := len(m.Queues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ClusterName)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -14998,12 +15579,12 @@ func (m *ShardReplicationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	var l int
 	_ = l
 	if m.MaxReplicationTaskVisibilityTime != nil {
-		n106, err106 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.MaxReplicationTaskVisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.MaxReplicationTaskVisibilityTime):])
-		if err106 != nil {
-			return 0, err106
+		n107, err107 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.MaxReplicationTaskVisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.MaxReplicationTaskVisibilityTime):])
+		if err107 != nil {
+			return 0, err107
 		}
-		i -= n106
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n106))
+		i -= n107
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n107))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -15060,12 +15641,12 @@ func (m *ShardReplicationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		}
 	}
 	if m.ShardLocalTime != nil {
-		n109, err109 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ShardLocalTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ShardLocalTime):])
-		if err109 != nil {
-			return 0, err109
+		n110, err110 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ShardLocalTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ShardLocalTime):])
+		if err110 != nil {
+			return 0, err110
 		}
-		i -= n109
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n109))
+		i -= n110
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n110))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -15131,12 +15712,12 @@ func (m *ShardReplicationStatusPerCluster) MarshalToSizedBuffer(dAtA []byte) (in
 	var l int
 	_ = l
 	if m.AckedTaskVisibilityTime != nil {
-		n110, err110 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AckedTaskVisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AckedTaskVisibilityTime):])
-		if err110 != nil {
-			return 0, err110
+		n111, err111 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AckedTaskVisibilityTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AckedTaskVisibilityTime):])
+		if err111 != nil {
+			return 0, err111
 		}
-		i -= n110
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n110))
+		i -= n111
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n111))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -15234,22 +15815,22 @@ func (m *DeleteWorkflowVisibilityRecordRequest) MarshalToSizedBuffer(dAtA []byte
 	var l int
 	_ = l
 	if m.WorkflowCloseTime != nil {
-		n112, err112 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowCloseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowCloseTime):])
-		if err112 != nil {
-			return 0, err112
+		n113, err113 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowCloseTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowCloseTime):])
+		if err113 != nil {
+			return 0, err113
 		}
-		i -= n112
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n112))
+		i -= n113
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n113))
 		i--
 		dAtA[i] = 0x22
 	}
 	if m.WorkflowStartTime != nil {
-		n113, err113 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowStartTime):])
-		if err113 != nil {
-			return 0, err113
+		n114, err114 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.WorkflowStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.WorkflowStartTime):])
+		if err114 != nil {
+			return 0, err114
 		}
-		i -= n113
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n113))
+		i -= n114
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n114))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -16364,6 +16945,13 @@ func (m *ResetWorkflowExecutionRequest) Size() (n int) {
 		l = m.ResetRequest.Size()
 		n += 1 + l + sovRequestResponse(uint64(l))
 	}
+	if m.PreserveBuildId {
+		n += 2
+	}
+	l = len(m.TargetBuildId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
 	return n
 }
 
@@ -16380,6 +16968,43 @@ func (m *ResetWorkflowExecutionResponse) Size() (n int) {
 	return n
 }
 
+func (m *RepinWorkflowBuildIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.BuildId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TargetBuildId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RepinWorkflowBuildIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repinned {
+		n += 2
+	}
+	return n
+}
+
 func (m *RequestCancelWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16956,6 +17581,36 @@ func (m *RemoveTaskResponse) Size() (n int) {
 	return n
 }
 
+func (m *DescribeHistoryQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ShardId))
+	}
+	if m.Category != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Category))
+	}
+	return n
+}
+
+func (m *DescribeHistoryQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Queues) > 0 {
+		for _, e := range m.Queues {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *GetReplicationMessagesRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18006,6 +18661,8 @@ func (this *ResetWorkflowExecutionRequest) String() string {
 	s := strings.Join([]string{`&ResetWorkflowExecutionRequest{`,
 		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
 		`ResetRequest:` + strings.Replace(fmt.Sprintf("%v", this.ResetRequest), "ResetWorkflowExecutionRequest", "v1.ResetWorkflowExecutionRequest", 1) + `,`,
+		`PreserveBuildId:` + fmt.Sprintf("%v", this.PreserveBuildId) + `,`,
+		`TargetBuildId:` + fmt.Sprintf("%v", this.TargetBuildId) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -18020,6 +18677,29 @@ func (this *ResetWorkflowExecutionResponse) String() string {
 	}, "")
 	return s
 }
+func (this *RepinWorkflowBuildIdRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RepinWorkflowBuildIdRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v14.WorkflowExecution", 1) + `,`,
+		`BuildId:` + fmt.Sprintf("%v", this.BuildId) + `,`,
+		`TargetBuildId:` + fmt.Sprintf("%v", this.TargetBuildId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RepinWorkflowBuildIdResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RepinWorkflowBuildIdResponse{`,
+		`Repinned:` + fmt.Sprintf("%v", this.Repinned) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RequestCancelWorkflowExecutionRequest) String() string {
 	if this == nil {
 		return "nil"
@@ -18384,6 +19064,32 @@ func (this *RemoveTaskResponse) String() string {
 	}, "")
 	return s
 }
+func (this *DescribeHistoryQueueRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeHistoryQueueRequest{`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`Category:` + fmt.Sprintf("%v", this.Category) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeHistoryQueueResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForQueues := "[]*HistoryQueueInfo{"
+	for _, f := range this.Queues {
+		repeatedStringForQueues += strings.Replace(fmt.Sprintf("%v", f), "HistoryQueueInfo", "v18.HistoryQueueInfo", 1) + ","
+	}
+	repeatedStringForQueues += "}"
+	s := strings.Join([]string{`&DescribeHistoryQueueResponse{`,
+		`Queues:` + repeatedStringForQueues + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GetReplicationMessagesRequest) String() string {
 	if this == nil {
 		return "nil"
@@ -24513,28 +25219,354 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowRequestResponse
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResetRequest == nil {
+				m.ResetRequest = &v1.ResetWorkflowExecutionRequest{}
+			}
+			if err := m.ResetRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreserveBuildId", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PreserveBuildId = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBuildId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetBuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepinWorkflowBuildIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepinWorkflowBuildIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepinWorkflowBuildIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Execution == nil {
+				m.Execution = &v14.WorkflowExecution{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24562,13 +25594,13 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.BuildId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResetRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBuildId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -24578,27 +25610,23 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResetRequest == nil {
-				m.ResetRequest = &v1.ResetWorkflowExecutionRequest{}
-			}
-			if err := m.ResetRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TargetBuildId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24624,7 +25652,7 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *RepinWorkflowBuildIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24647,17 +25675,17 @@ func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepinWorkflowBuildIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepinWorkflowBuildIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repinned", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -24667,24 +25695,12 @@ func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Repinned = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -28784,6 +29800,184 @@ func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DescribeHistoryQueueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeHistoryQueueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeHistoryQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			m.Category = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Category |= v17.TaskCategory(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeHistoryQueueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeHistoryQueueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeHistoryQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queues = append(m.Queues, &v18.HistoryQueueInfo{})
+			if err := m.Queues[len(m.Queues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
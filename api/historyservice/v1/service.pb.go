@@ -54,89 +54,91 @@ func init() {
 }
 
 var fileDescriptor_655983da427ae822 = []byte{
-	// 1307 bytes of a gzipped FileDescriptorProto
+	// 1342 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x9a, 0xcd, 0x8b, 0x23, 0x45,
-	0x18, 0xc6, 0x53, 0x17, 0x91, 0x42, 0x57, 0x6d, 0xc5, 0x8f, 0x55, 0x1b, 0x3f, 0x50, 0x3c, 0x65,
-	0xdc, 0x5d, 0xd0, 0xfd, 0x98, 0x75, 0x77, 0x26, 0x33, 0x93, 0x99, 0xdd, 0x19, 0x9d, 0x49, 0x66,
-	0x47, 0xf0, 0x22, 0x95, 0xe4, 0x9d, 0x49, 0x31, 0x3d, 0xe9, 0xb6, 0xaa, 0x12, 0xcd, 0x41, 0x10,
-	0x3c, 0x09, 0x82, 0x1f, 0x20, 0x78, 0x12, 0x3c, 0x29, 0x82, 0x20, 0x08, 0x82, 0x20, 0x78, 0x12,
-	0x3c, 0x88, 0xcc, 0xcd, 0x3d, 0x3a, 0x99, 0x8b, 0xc7, 0xfd, 0x13, 0x24, 0xe9, 0x54, 0x4d, 0xaa,
-	0xbb, 0x3a, 0xa9, 0xea, 0xce, 0x6d, 0x37, 0xa9, 0xe7, 0xd7, 0x4f, 0x7d, 0xe4, 0xad, 0x67, 0xde,
-	0x04, 0x5f, 0x12, 0x70, 0x14, 0x85, 0x8c, 0x04, 0x0b, 0x1c, 0x58, 0x0f, 0xd8, 0x02, 0x89, 0xe8,
-	0x42, 0x9b, 0x72, 0x11, 0xb2, 0xfe, 0xf0, 0x15, 0xda, 0x84, 0x85, 0xde, 0x85, 0x85, 0xf1, 0x3f,
-	0xcb, 0x11, 0x0b, 0x45, 0xe8, 0xbd, 0x24, 0x45, 0xe5, 0x58, 0x54, 0x26, 0x11, 0x2d, 0xeb, 0xa2,
-	0x72, 0xef, 0xc2, 0xf9, 0x45, 0x3b, 0x36, 0x83, 0xf7, 0xba, 0xc0, 0xc5, 0xbb, 0x0c, 0x78, 0x14,
-	0x76, 0xf8, 0xf8, 0x21, 0x17, 0xbf, 0xb8, 0x89, 0xcf, 0xad, 0xc7, 0x83, 0xeb, 0xf1, 0x60, 0xef,
-	0x3b, 0x84, 0x1f, 0xaf, 0x0b, 0xc2, 0xc4, 0xdb, 0x21, 0x3b, 0xdc, 0x0f, 0xc2, 0xf7, 0x57, 0x3f,
-	0x80, 0x66, 0x57, 0xd0, 0xb0, 0xe3, 0xad, 0x94, 0xad, 0x3c, 0x95, 0xcd, 0xf2, 0x5a, 0x6c, 0xe1,
-	0xfc, 0x6a, 0x41, 0x4a, 0x3c, 0x81, 0x17, 0x4a, 0xde, 0x97, 0x08, 0x3f, 0x54, 0x05, 0xb1, 0xd5,
-	0x15, 0xa4, 0x11, 0x40, 0x5d, 0x10, 0x01, 0xde, 0x75, 0x4b, 0x78, 0x42, 0x27, 0xbd, 0xbd, 0x91,
-	0x57, 0xae, 0x4c, 0x7d, 0x85, 0xf0, 0xc3, 0xdb, 0x61, 0x10, 0x68, 0xae, 0x6c, 0xb1, 0x49, 0xa1,
-	0xb4, 0x75, 0x23, 0xb7, 0x5e, 0xf9, 0xfa, 0x16, 0xe1, 0xc7, 0x6a, 0xc0, 0x41, 0xd4, 0x05, 0x6d,
-	0x1e, 0xf6, 0x77, 0x09, 0x3f, 0xdc, 0xe9, 0x42, 0x17, 0xbc, 0x65, 0x4b, 0xb6, 0x49, 0x2c, 0xfd,
-	0x55, 0x0a, 0x31, 0x94, 0xc7, 0x9f, 0x10, 0x7e, 0xaa, 0x06, 0xcd, 0x90, 0xb5, 0xe4, 0xb6, 0x0f,
-	0x47, 0x8d, 0xce, 0x01, 0xb4, 0xbc, 0xaa, 0xf5, 0x43, 0x32, 0x08, 0xd2, 0xed, 0x7a, 0x71, 0x90,
-	0xc1, 0xf2, 0x52, 0x53, 0xd0, 0x1e, 0x15, 0xfd, 0xfc, 0x96, 0x0d, 0x84, 0x7c, 0x96, 0x8d, 0x20,
-	0x65, 0xf9, 0x57, 0x84, 0x9f, 0x89, 0xff, 0xab, 0xcd, 0xad, 0x12, 0x1e, 0x45, 0x01, 0x0c, 0x5d,
-	0xdf, 0xb2, 0xdf, 0xcd, 0x4c, 0x88, 0x34, 0x7e, 0x7b, 0x2e, 0xac, 0xc4, 0x72, 0xa7, 0x86, 0xae,
-	0x11, 0x1a, 0x38, 0x2d, 0x77, 0x06, 0xc1, 0x7d, 0xb9, 0x33, 0x41, 0xca, 0xf2, 0x2f, 0x08, 0x3f,
-	0x9d, 0xde, 0x96, 0x75, 0x20, 0x4c, 0x34, 0x80, 0x08, 0x6f, 0x23, 0xf7, 0xd6, 0x2a, 0x86, 0xb4,
-	0x7d, 0x6b, 0x1e, 0x28, 0xd3, 0x39, 0x99, 0x1c, 0x9a, 0xfb, 0x9c, 0x18, 0x21, 0x39, 0xcf, 0x49,
-	0x06, 0xcb, 0x74, 0x4e, 0x26, 0x87, 0xe6, 0x3b, 0x27, 0x69, 0x42, 0xce, 0x73, 0x62, 0x02, 0x25,
-	0xce, 0x49, 0x7a, 0x76, 0xa4, 0xd3, 0x84, 0xa1, 0xe9, 0x8d, 0x02, 0x2b, 0x34, 0x66, 0xb8, 0x9f,
-	0x93, 0x29, 0x28, 0x65, 0xfc, 0x07, 0x84, 0x9f, 0xa8, 0xd3, 0x83, 0x0e, 0x09, 0xd2, 0x89, 0xc1,
-	0xfa, 0xae, 0x37, 0xeb, 0xa5, 0xe1, 0xb5, 0xa2, 0x18, 0x65, 0xf6, 0x0f, 0x84, 0x9f, 0x1b, 0x8f,
-	0xa2, 0xa2, 0x9d, 0x91, 0x73, 0xde, 0x74, 0x7b, 0x5c, 0x26, 0x48, 0xda, 0x7f, 0x6b, 0x6e, 0x3c,
-	0x35, 0x8f, 0x1f, 0x11, 0x7e, 0xb2, 0x06, 0x47, 0x61, 0x0f, 0x62, 0x91, 0x16, 0x37, 0xd6, 0xac,
-	0xf7, 0xd7, 0x0c, 0x90, 0xbe, 0xab, 0x85, 0x39, 0xca, 0xef, 0xcf, 0x08, 0x9f, 0xdf, 0x05, 0x76,
-	0x44, 0x3b, 0x44, 0x40, 0x7a, 0xc5, 0x6d, 0x3f, 0x48, 0xd9, 0x08, 0xe9, 0x79, 0x63, 0x0e, 0x24,
-	0xed, 0x68, 0xaf, 0xc0, 0xb0, 0xb8, 0xe4, 0x3f, 0xda, 0x19, 0x7a, 0xd7, 0xa3, 0x9d, 0x89, 0x51,
-	0x66, 0x87, 0xc1, 0x7d, 0x14, 0xb0, 0xf2, 0x07, 0x77, 0xb3, 0xdc, 0x35, 0xb8, 0x67, 0x51, 0x94,
-	0xd3, 0xdf, 0x11, 0xf6, 0xc7, 0xd0, 0xb8, 0x9e, 0xa4, 0x1d, 0x6f, 0x5a, 0x3f, 0x6b, 0x1a, 0x46,
-	0x3a, 0xdf, 0x9a, 0x13, 0x4d, 0x4b, 0xd3, 0xf5, 0x66, 0x1b, 0x5a, 0xdd, 0x00, 0x26, 0x6f, 0x7f,
-	0xeb, 0x34, 0x6d, 0x12, 0xbb, 0xa6, 0x69, 0x33, 0x43, 0x2b, 0x75, 0x7b, 0xc0, 0xe8, 0x7e, 0x7f,
-	0x8d, 0x32, 0x2e, 0xb4, 0x1c, 0x3b, 0x56, 0xb6, 0xac, 0x4b, 0xdd, 0x2c, 0x90, 0x6b, 0xa9, 0x9b,
-	0xcd, 0x53, 0xf3, 0xf8, 0x0d, 0xe1, 0x67, 0xe3, 0xc4, 0x52, 0x69, 0xd3, 0xa0, 0xa5, 0xb6, 0xe3,
-	0x2c, 0x88, 0xdc, 0x76, 0xca, 0x3d, 0x19, 0x14, 0x39, 0x83, 0xcd, 0xf9, 0xc0, 0x94, 0xfd, 0x7f,
-	0x10, 0x7e, 0x39, 0x9e, 0xad, 0x71, 0xec, 0xe8, 0x5c, 0x0d, 0x49, 0xd0, 0xf2, 0x76, 0x9d, 0x16,
-	0x6f, 0x16, 0x4e, 0x4e, 0xe8, 0xce, 0x9c, 0xa9, 0x5a, 0xc8, 0x5a, 0x01, 0xde, 0x64, 0xb4, 0x61,
-	0xa8, 0x8f, 0x55, 0xeb, 0xc2, 0x96, 0x41, 0x70, 0x0d, 0x59, 0x53, 0x40, 0xca, 0xf2, 0xd7, 0x08,
-	0x3f, 0x52, 0x83, 0x28, 0xa0, 0x4d, 0x22, 0x60, 0xb5, 0x07, 0x1d, 0xc1, 0xf7, 0x2e, 0x7a, 0x37,
-	0xac, 0xb7, 0x3c, 0xa1, 0x94, 0x16, 0x6f, 0xe6, 0x07, 0x24, 0xca, 0xf7, 0xf8, 0x7d, 0x39, 0x87,
-	0xf8, 0x3e, 0x5f, 0x71, 0xc5, 0x6b, 0x72, 0xf7, 0xf2, 0x6d, 0xa6, 0x68, 0x7d, 0x97, 0x7a, 0xbf,
-	0xd3, 0xac, 0xb7, 0x09, 0x6b, 0x0d, 0xdf, 0xec, 0x72, 0xeb, 0xbe, 0x4b, 0x42, 0xe7, 0xda, 0x77,
-	0x49, 0xc9, 0x95, 0xa9, 0x4f, 0x10, 0x7e, 0x60, 0xf8, 0xae, 0x0c, 0xab, 0xde, 0x55, 0x07, 0xa4,
-	0x14, 0x49, 0x3b, 0xd7, 0x72, 0x69, 0xb5, 0xdb, 0x41, 0x9e, 0x46, 0x2d, 0x98, 0x2d, 0x3b, 0x1e,
-	0x65, 0x53, 0x28, 0xab, 0x14, 0x62, 0x28, 0x8f, 0xdf, 0x20, 0xfc, 0xa8, 0x1c, 0x32, 0xee, 0x00,
-	0xae, 0x87, 0x5c, 0x78, 0x4b, 0x8e, 0xf8, 0x09, 0xad, 0x74, 0xb8, 0x5c, 0x04, 0xa1, 0x0c, 0x7e,
-	0x8c, 0x30, 0xae, 0x04, 0x21, 0x87, 0xd1, 0x7e, 0x7b, 0x97, 0x2d, 0xa1, 0x67, 0x12, 0x69, 0xe7,
-	0x4a, 0x0e, 0xa5, 0x72, 0xf1, 0x21, 0xbe, 0xbf, 0x0a, 0x22, 0xb6, 0xf0, 0x9a, 0x7d, 0x73, 0x50,
-	0x33, 0xf0, 0xba, 0xb3, 0x4e, 0x5b, 0x84, 0x38, 0x5d, 0x8f, 0xd2, 0xc5, 0x65, 0xa7, 0x40, 0x3e,
-	0x99, 0x29, 0xae, 0xe4, 0x50, 0x6a, 0xa5, 0xa9, 0x0a, 0x42, 0x16, 0x06, 0x1a, 0x76, 0xb6, 0x80,
-	0x73, 0x72, 0x00, 0xdc, 0xba, 0x34, 0x99, 0xe5, 0xae, 0xa5, 0x29, 0x8b, 0xa2, 0x5d, 0x49, 0x55,
-	0x10, 0x2b, 0x9b, 0x3b, 0x26, 0xb3, 0x55, 0xfb, 0xc7, 0x98, 0x09, 0xae, 0x57, 0xd2, 0x14, 0x90,
-	0xb2, 0xfc, 0x29, 0xc2, 0x0f, 0xee, 0x74, 0x81, 0xf5, 0x65, 0xb9, 0xf5, 0x6c, 0xab, 0x8f, 0xa6,
-	0x92, 0xd6, 0x16, 0xf3, 0x89, 0x35, 0x3b, 0x35, 0x20, 0x51, 0x14, 0xf4, 0xe3, 0x4b, 0xca, 0xda,
-	0x8e, 0xa6, 0x72, 0xb5, 0x93, 0x10, 0x2b, 0x3b, 0x9f, 0x21, 0x7c, 0x2e, 0x5e, 0x45, 0xb5, 0x8b,
-	0x8b, 0x4e, 0x8b, 0x9f, 0xdc, 0xba, 0xeb, 0x39, 0xd5, 0x7a, 0x83, 0xbf, 0xcb, 0x0e, 0x60, 0xd2,
-	0x93, 0x75, 0x83, 0x3f, 0x21, 0x74, 0x6e, 0xf0, 0xa7, 0xf4, 0x9a, 0xaf, 0x2d, 0xc8, 0xe9, 0x2b,
-	0x29, 0x74, 0xf5, 0x95, 0xd6, 0x27, 0xbe, 0x78, 0xd8, 0x67, 0xc0, 0xdb, 0x93, 0x49, 0x9f, 0x3b,
-	0x7c, 0xf1, 0x90, 0x16, 0xbb, 0x7f, 0xf1, 0x60, 0x62, 0x28, 0x8f, 0x7f, 0x23, 0xfc, 0x62, 0x15,
-	0x3a, 0xc0, 0x88, 0x80, 0x4d, 0xc2, 0xc5, 0xf8, 0x46, 0x9a, 0xf8, 0xe0, 0xc6, 0x96, 0x77, 0xac,
-	0x0f, 0xcf, 0x4c, 0x96, 0x9c, 0x41, 0x6d, 0x9e, 0x48, 0x6d, 0xd1, 0xf5, 0x62, 0x39, 0xce, 0x69,
-	0xcb, 0xb9, 0x2a, 0xad, 0x1e, 0xd6, 0x2a, 0x85, 0x18, 0x5a, 0x02, 0xa9, 0x41, 0xa3, 0x4b, 0x83,
-	0x96, 0x16, 0x92, 0x96, 0xac, 0xf7, 0x34, 0xa5, 0x75, 0x4d, 0x20, 0x46, 0x84, 0xd6, 0xa6, 0xd0,
-	0xdb, 0x2e, 0x7b, 0x94, 0xd3, 0x06, 0x0d, 0x46, 0x69, 0x6f, 0xf8, 0xe7, 0x90, 0x75, 0x9b, 0x62,
-	0x3a, 0xc6, 0xb5, 0x4d, 0x31, 0x8b, 0xa6, 0xf5, 0xaf, 0xee, 0x44, 0x2d, 0x52, 0xa4, 0x7f, 0x95,
-	0xa1, 0x77, 0xed, 0x5f, 0x65, 0x62, 0xb4, 0x06, 0xf8, 0x76, 0x18, 0xa4, 0xfb, 0x2e, 0xb1, 0xd4,
-	0xba, 0x01, 0x3e, 0x85, 0xe1, 0xda, 0x00, 0x9f, 0x8a, 0x52, 0xc6, 0xff, 0x42, 0xf8, 0xf9, 0xba,
-	0x60, 0x40, 0x8e, 0xce, 0xee, 0xd3, 0x74, 0xf8, 0xb0, 0x6e, 0x02, 0xcf, 0x22, 0xc9, 0x49, 0x6c,
-	0xcf, 0x0f, 0x28, 0xa7, 0xf2, 0x0a, 0x7a, 0x15, 0x2d, 0x47, 0xc7, 0x27, 0x7e, 0xe9, 0xee, 0x89,
-	0x5f, 0xba, 0x77, 0xe2, 0xa3, 0x8f, 0x06, 0x3e, 0xfa, 0x7e, 0xe0, 0xa3, 0x3f, 0x07, 0x3e, 0x3a,
-	0x1e, 0xf8, 0xe8, 0xdf, 0x81, 0x8f, 0xfe, 0x1b, 0xf8, 0xa5, 0x7b, 0x03, 0x1f, 0x7d, 0x7e, 0xea,
-	0x97, 0x8e, 0x4f, 0xfd, 0xd2, 0xdd, 0x53, 0xbf, 0xf4, 0xce, 0xd5, 0x83, 0xf0, 0xcc, 0x0f, 0x0d,
-	0xa7, 0xfe, 0x1a, 0xe1, 0x9a, 0xfe, 0x4a, 0xe3, 0xbe, 0xd1, 0x8f, 0x11, 0x2e, 0xfd, 0x1f, 0x00,
-	0x00, 0xff, 0xff, 0xa1, 0x85, 0xab, 0xc0, 0x28, 0x21, 0x00, 0x00,
+	0x18, 0xc6, 0x53, 0x17, 0x91, 0x42, 0x57, 0x6d, 0xc5, 0x8f, 0x51, 0x1b, 0x3f, 0x50, 0x3c, 0x65,
+	0xdc, 0x5d, 0xd0, 0xfd, 0x98, 0x75, 0x9d, 0x64, 0x66, 0x32, 0xb3, 0x3b, 0xd1, 0x9d, 0x64, 0x76,
+	0x04, 0x2f, 0x52, 0x49, 0xde, 0x99, 0x14, 0xd3, 0x93, 0x6e, 0xab, 0x2b, 0xd1, 0x1c, 0x04, 0xc1,
+	0x93, 0x20, 0x28, 0x82, 0xe0, 0x49, 0xf0, 0xa4, 0x08, 0x82, 0x20, 0x08, 0x82, 0xe0, 0x49, 0xf0,
+	0x20, 0x32, 0x37, 0xd7, 0x9b, 0x93, 0xb9, 0x78, 0xdc, 0x3f, 0x61, 0xe9, 0x74, 0x57, 0x4d, 0x2a,
+	0x5d, 0x9d, 0x54, 0x75, 0xe7, 0xb6, 0x3b, 0xa9, 0xe7, 0xd7, 0x4f, 0x55, 0xbd, 0xa9, 0x7a, 0xba,
+	0x2a, 0xf8, 0x22, 0x87, 0xa3, 0xc0, 0x67, 0xc4, 0x5b, 0x0e, 0x81, 0x0d, 0x80, 0x2d, 0x93, 0x80,
+	0x2e, 0x77, 0x69, 0xc8, 0x7d, 0x36, 0x8c, 0xfe, 0x42, 0xdb, 0xb0, 0x3c, 0x38, 0xbf, 0x9c, 0xfc,
+	0xb3, 0x1c, 0x30, 0x9f, 0xfb, 0xce, 0x4b, 0x42, 0x54, 0x8e, 0x45, 0x65, 0x12, 0xd0, 0xb2, 0x2a,
+	0x2a, 0x0f, 0xce, 0x2f, 0xad, 0x98, 0xb1, 0x19, 0xbc, 0xdf, 0x87, 0x90, 0xbf, 0xc7, 0x20, 0x0c,
+	0xfc, 0x5e, 0x98, 0x3c, 0xe4, 0xc2, 0xbf, 0x15, 0x7c, 0x6e, 0x33, 0x6e, 0xdc, 0x8c, 0x1b, 0x3b,
+	0xdf, 0x21, 0xfc, 0x78, 0x93, 0x13, 0xc6, 0xdf, 0xf1, 0xd9, 0xe1, 0xbe, 0xe7, 0x7f, 0xb0, 0xfe,
+	0x21, 0xb4, 0xfb, 0x9c, 0xfa, 0x3d, 0x67, 0xad, 0x6c, 0xe4, 0xa9, 0xac, 0x97, 0x37, 0x62, 0x0b,
+	0x4b, 0xeb, 0x05, 0x29, 0x71, 0x07, 0x5e, 0x28, 0x39, 0x5f, 0x22, 0xfc, 0x50, 0x0d, 0x78, 0xbd,
+	0xcf, 0x49, 0xcb, 0x83, 0x26, 0x27, 0x1c, 0x9c, 0x6b, 0x86, 0xf0, 0x29, 0x9d, 0xf0, 0xf6, 0x46,
+	0x5e, 0xb9, 0x34, 0xf5, 0x15, 0xc2, 0x0f, 0xdf, 0xf2, 0x3d, 0x4f, 0x71, 0x65, 0x8a, 0x9d, 0x16,
+	0x0a, 0x5b, 0xd7, 0x73, 0xeb, 0xa5, 0xaf, 0x6f, 0x11, 0x7e, 0xac, 0x01, 0x21, 0xf0, 0x26, 0xa7,
+	0xed, 0xc3, 0xe1, 0x2e, 0x09, 0x0f, 0x77, 0xfa, 0xd0, 0x07, 0xa7, 0x62, 0xc8, 0xd6, 0x89, 0x85,
+	0xbf, 0x6a, 0x21, 0x86, 0xf4, 0xf8, 0x13, 0xc2, 0x4f, 0x35, 0xa0, 0xed, 0xb3, 0x8e, 0x98, 0xf6,
+	0xa8, 0xd5, 0xb8, 0x0e, 0xa0, 0xe3, 0xd4, 0x8c, 0x1f, 0x92, 0x41, 0x10, 0x6e, 0x37, 0x8b, 0x83,
+	0x34, 0x96, 0x57, 0xdb, 0x9c, 0x0e, 0x28, 0x1f, 0xe6, 0xb7, 0xac, 0x21, 0xe4, 0xb3, 0xac, 0x05,
+	0x49, 0xcb, 0xbf, 0x22, 0xfc, 0x4c, 0xfc, 0x5f, 0xa5, 0x6f, 0x55, 0xff, 0x28, 0xf0, 0x20, 0x72,
+	0x7d, 0xc3, 0x7c, 0x36, 0x33, 0x21, 0xc2, 0xf8, 0xcd, 0x85, 0xb0, 0xa6, 0x86, 0x3b, 0xd5, 0x74,
+	0x83, 0x50, 0xcf, 0x6a, 0xb8, 0x33, 0x08, 0xf6, 0xc3, 0x9d, 0x09, 0x92, 0x96, 0x7f, 0x41, 0xf8,
+	0xe9, 0xf4, 0xb4, 0x6c, 0x02, 0x61, 0xbc, 0x05, 0x84, 0x3b, 0x5b, 0xb9, 0xa7, 0x56, 0x32, 0x84,
+	0xed, 0x1b, 0x8b, 0x40, 0xe9, 0xea, 0x64, 0xb2, 0x69, 0xee, 0x3a, 0xd1, 0x42, 0x72, 0xd6, 0x49,
+	0x06, 0x4b, 0x57, 0x27, 0x93, 0x4d, 0xf3, 0xd5, 0x49, 0x9a, 0x90, 0xb3, 0x4e, 0x74, 0xa0, 0xa9,
+	0x3a, 0x49, 0xf7, 0x8e, 0xf4, 0xda, 0x10, 0x99, 0xde, 0x2a, 0x30, 0x42, 0x09, 0xc3, 0xbe, 0x4e,
+	0x66, 0xa0, 0xa4, 0xf1, 0x1f, 0x10, 0x7e, 0xa2, 0x49, 0x0f, 0x7a, 0xc4, 0x4b, 0x27, 0x06, 0xe3,
+	0xbd, 0x5e, 0xaf, 0x17, 0x86, 0x37, 0x8a, 0x62, 0xa4, 0xd9, 0x3f, 0x10, 0x7e, 0x2e, 0x69, 0x45,
+	0x79, 0x37, 0x23, 0xe7, 0xbc, 0x65, 0xf7, 0xb8, 0x4c, 0x90, 0xb0, 0xff, 0xf6, 0xc2, 0x78, 0xb2,
+	0x1f, 0x3f, 0x22, 0xfc, 0x64, 0x03, 0x8e, 0xfc, 0x01, 0xc4, 0x22, 0x25, 0x6e, 0x6c, 0x18, 0xcf,
+	0xaf, 0x1e, 0x20, 0x7c, 0xd7, 0x0a, 0x73, 0xa4, 0xdf, 0x9f, 0x11, 0x5e, 0xda, 0x05, 0x76, 0x44,
+	0x7b, 0x84, 0x43, 0x7a, 0xc4, 0x4d, 0xbf, 0x48, 0xd9, 0x08, 0xe1, 0x79, 0x6b, 0x01, 0x24, 0xa5,
+	0xb4, 0xd7, 0xc0, 0x03, 0x0e, 0xf9, 0x4b, 0x3b, 0x43, 0x6f, 0x5b, 0xda, 0x99, 0x18, 0x69, 0x36,
+	0x0a, 0xee, 0xe3, 0x80, 0x95, 0x3f, 0xb8, 0xeb, 0xe5, 0xb6, 0xc1, 0x3d, 0x8b, 0x32, 0x95, 0x45,
+	0x03, 0xda, 0x13, 0x8d, 0x2a, 0x7d, 0xea, 0x75, 0xb6, 0x3a, 0x16, 0x59, 0x34, 0x2d, 0xb6, 0xcf,
+	0xa2, 0x3a, 0x86, 0xf4, 0xf8, 0x3b, 0xc2, 0x6e, 0x82, 0x8c, 0xd7, 0xbc, 0xf4, 0xa8, 0x6e, 0x1b,
+	0x3f, 0x69, 0x16, 0x46, 0xf8, 0xae, 0x2f, 0x88, 0xa6, 0x8c, 0x72, 0xb3, 0xdd, 0x85, 0x4e, 0xdf,
+	0x83, 0xc9, 0x84, 0x62, 0x3c, 0xca, 0x3a, 0xb1, 0xed, 0x28, 0xeb, 0x19, 0xca, 0x72, 0xbc, 0x07,
+	0x8c, 0xee, 0x0f, 0x37, 0x28, 0x0b, 0xb9, 0x92, 0xb5, 0x13, 0x65, 0xc7, 0x78, 0x39, 0x9e, 0x07,
+	0xb2, 0x5d, 0x8e, 0xe7, 0xf3, 0x64, 0x3f, 0x7e, 0x43, 0xf8, 0xd9, 0x38, 0x55, 0x55, 0xbb, 0xd4,
+	0xeb, 0xc8, 0xe9, 0x38, 0x0b, 0x4b, 0x37, 0xad, 0xb2, 0x59, 0x06, 0x45, 0xf4, 0x60, 0x7b, 0x31,
+	0x30, 0x69, 0xff, 0x1f, 0x84, 0x5f, 0x8e, 0x7b, 0xab, 0x6d, 0x3b, 0xae, 0xab, 0x88, 0x04, 0x1d,
+	0x67, 0xd7, 0x6a, 0xf0, 0xe6, 0xe1, 0x44, 0x87, 0x6e, 0x2f, 0x98, 0xaa, 0x04, 0xc1, 0x35, 0x08,
+	0xdb, 0x8c, 0xb6, 0x34, 0x6b, 0x78, 0xcd, 0x78, 0xf1, 0xcd, 0x20, 0xd8, 0x06, 0xc1, 0x19, 0x20,
+	0x69, 0xf9, 0x6b, 0x84, 0x1f, 0x69, 0x40, 0xe0, 0xd1, 0x36, 0xe1, 0xb0, 0x3e, 0x80, 0x1e, 0x0f,
+	0xf7, 0x2e, 0x38, 0xd7, 0xcd, 0x97, 0x35, 0x55, 0x29, 0x2c, 0xbe, 0x99, 0x1f, 0x30, 0xb5, 0xc5,
+	0x24, 0x9f, 0x8b, 0x3e, 0xc4, 0x99, 0x63, 0xcd, 0x16, 0xaf, 0xc8, 0xed, 0xb7, 0x18, 0x3d, 0x45,
+	0x39, 0x1b, 0x6a, 0x0e, 0x7b, 0xed, 0x66, 0x97, 0xb0, 0x4e, 0xf4, 0x61, 0x3f, 0x34, 0x3e, 0x1b,
+	0x9a, 0xd2, 0xd9, 0x9e, 0x0d, 0xa5, 0xe4, 0xd2, 0xd4, 0xa7, 0x08, 0x3f, 0x10, 0x7d, 0x2a, 0x02,
+	0xb5, 0x73, 0xc5, 0x02, 0x29, 0x44, 0xc2, 0xce, 0xd5, 0x5c, 0x5a, 0x65, 0x77, 0x10, 0xd5, 0xa8,
+	0x84, 0xc7, 0x8a, 0x65, 0x29, 0xeb, 0x82, 0x63, 0xb5, 0x10, 0x43, 0x7a, 0xfc, 0x06, 0xe1, 0x47,
+	0x45, 0x93, 0xe4, 0x94, 0x72, 0xd3, 0x0f, 0xb9, 0xb3, 0x6a, 0x89, 0x9f, 0xd0, 0x0a, 0x87, 0x95,
+	0x22, 0x08, 0x69, 0xf0, 0x13, 0x84, 0x71, 0xd5, 0xf3, 0x43, 0x18, 0xcf, 0xb7, 0x73, 0xc9, 0x10,
+	0x7a, 0x26, 0x11, 0x76, 0x2e, 0xe7, 0x50, 0x4a, 0x17, 0x1f, 0xe1, 0xfb, 0x6b, 0xc0, 0x63, 0x0b,
+	0xaf, 0x99, 0x1f, 0x60, 0x2a, 0x06, 0x5e, 0xb7, 0xd6, 0x29, 0x83, 0x10, 0xbf, 0x01, 0x8c, 0xd3,
+	0xc5, 0x25, 0xab, 0x97, 0x86, 0xc9, 0x4c, 0x71, 0x39, 0x87, 0x52, 0x5b, 0xcf, 0xc9, 0x64, 0xd9,
+	0x9d, 0x6f, 0xea, 0xc4, 0x79, 0xeb, 0x59, 0x65, 0x28, 0xcb, 0x67, 0x0d, 0xb8, 0x58, 0xbc, 0xa8,
+	0xdf, 0xab, 0x43, 0x18, 0x92, 0x03, 0x08, 0x8d, 0x97, 0x4f, 0xbd, 0xdc, 0x76, 0xf9, 0xcc, 0xa2,
+	0x28, 0xdb, 0x66, 0x0d, 0xf8, 0xda, 0xf6, 0x8e, 0xce, 0x6c, 0xcd, 0xfc, 0x31, 0x7a, 0x82, 0xed,
+	0xb6, 0x39, 0x03, 0x24, 0x2d, 0x7f, 0x86, 0xf0, 0x83, 0x3b, 0x7d, 0x60, 0x43, 0xb1, 0x25, 0x38,
+	0xa6, 0x2b, 0xa4, 0xa2, 0x12, 0xd6, 0x56, 0xf2, 0x89, 0x15, 0x3b, 0x0d, 0x20, 0x41, 0xe0, 0x0d,
+	0xe3, 0x8d, 0xd4, 0xd8, 0x8e, 0xa2, 0xb2, 0xb5, 0x33, 0x25, 0x96, 0x76, 0x3e, 0x47, 0xf8, 0x5c,
+	0x3c, 0x8a, 0x72, 0x16, 0x57, 0xac, 0x06, 0x7f, 0x7a, 0xea, 0xae, 0xe5, 0x54, 0xab, 0x17, 0x25,
+	0x7d, 0x76, 0x00, 0x93, 0x9e, 0x8c, 0x2f, 0x4a, 0xa6, 0x84, 0xd6, 0x17, 0x25, 0x29, 0xbd, 0xe2,
+	0xab, 0x0e, 0x39, 0x7d, 0xd5, 0xa1, 0x98, 0xaf, 0x3a, 0x64, 0xfa, 0x8a, 0x5f, 0x9a, 0xf7, 0x19,
+	0x84, 0xdd, 0xc9, 0xb7, 0x91, 0xd0, 0xe2, 0xa5, 0x39, 0x2d, 0xb6, 0x7f, 0x69, 0xd6, 0x31, 0xa4,
+	0xc7, 0xbf, 0x11, 0x7e, 0xb1, 0x06, 0x3d, 0x60, 0x84, 0xc3, 0x36, 0x09, 0x79, 0xb2, 0x0e, 0x4e,
+	0x7c, 0x71, 0x63, 0xcb, 0x3b, 0xc6, 0xc5, 0x33, 0x97, 0x25, 0x7a, 0xd0, 0x58, 0x24, 0x52, 0x19,
+	0x74, 0x75, 0xb1, 0x4c, 0xb2, 0x64, 0x25, 0xd7, 0x4a, 0xab, 0x06, 0xca, 0x6a, 0x21, 0x86, 0x92,
+	0x92, 0x1a, 0xd0, 0x8a, 0x4e, 0x30, 0x94, 0x20, 0xb7, 0x6a, 0x3c, 0xa7, 0x29, 0xad, 0x6d, 0x4a,
+	0xd2, 0x22, 0x94, 0xa3, 0x14, 0xf5, 0xf8, 0x6a, 0x8f, 0x86, 0xb4, 0x45, 0xbd, 0x71, 0x22, 0x8d,
+	0x5e, 0xd9, 0x8c, 0x8f, 0x52, 0x66, 0x63, 0x6c, 0x8f, 0x52, 0xe6, 0xd1, 0x94, 0x73, 0xc0, 0xdb,
+	0x41, 0x87, 0x14, 0x39, 0x07, 0xcc, 0xd0, 0xdb, 0x9e, 0x03, 0x66, 0x62, 0x94, 0x8b, 0x84, 0xe8,
+	0x22, 0x38, 0xd5, 0x26, 0x96, 0x1a, 0x5f, 0x24, 0xcc, 0x60, 0xd8, 0x5e, 0x24, 0xcc, 0x44, 0x49,
+	0xe3, 0x7f, 0x21, 0xfc, 0x7c, 0x93, 0x33, 0x20, 0x47, 0x67, 0xfb, 0x69, 0x3a, 0x7c, 0x18, 0x1f,
+	0xa6, 0xcf, 0x23, 0x89, 0x4e, 0xdc, 0x5a, 0x1c, 0x50, 0x74, 0xe5, 0x15, 0xf4, 0x2a, 0xaa, 0x04,
+	0xc7, 0x27, 0x6e, 0xe9, 0xce, 0x89, 0x5b, 0xba, 0x7b, 0xe2, 0xa2, 0x8f, 0x47, 0x2e, 0xfa, 0x7e,
+	0xe4, 0xa2, 0x3f, 0x47, 0x2e, 0x3a, 0x1e, 0xb9, 0xe8, 0xbf, 0x91, 0x8b, 0xfe, 0x1f, 0xb9, 0xa5,
+	0xbb, 0x23, 0x17, 0x7d, 0x71, 0xea, 0x96, 0x8e, 0x4f, 0xdd, 0xd2, 0x9d, 0x53, 0xb7, 0xf4, 0xee,
+	0x95, 0x03, 0xff, 0xcc, 0x0f, 0xf5, 0x67, 0xfe, 0xaa, 0xe3, 0xaa, 0xfa, 0x97, 0xd6, 0x7d, 0xe3,
+	0x1f, 0x75, 0x5c, 0xbc, 0x37, 0x00, 0xdc, 0xf4, 0x46, 0x54, 0x70, 0x22, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -237,6 +239,10 @@ type HistoryServiceClient interface {
 	// in the history and immediately terminating the current execution instance.
 	// After reset, the history will grow from nextFirstEventId.
 	ResetWorkflowExecution(ctx context.Context, in *ResetWorkflowExecutionRequest, opts ...grpc.CallOption) (*ResetWorkflowExecutionResponse, error)
+	// RepinWorkflowBuildId pins a running workflow to another build id and schedules a workflow task, so that its
+	// next workflow task is processed by the new build id. It fails with FailedPrecondition if a workflow task is in
+	// flight.
+	RepinWorkflowBuildId(ctx context.Context, in *RepinWorkflowBuildIdRequest, opts ...grpc.CallOption) (*RepinWorkflowBuildIdResponse, error)
 	// RequestCancelWorkflowExecution is called by application worker when it wants to request cancellation of a workflow instance.
 	// It will result in a new 'WorkflowExecutionCancelRequested' event being written to the workflow history and a new WorkflowTask
 	// created for the workflow instance so new commands could be made. It fails with 'EntityNotExistsError' if the workflow is not valid
@@ -284,6 +290,8 @@ type HistoryServiceClient interface {
 	GetShard(ctx context.Context, in *GetShardRequest, opts ...grpc.CallOption) (*GetShardResponse, error)
 	// RemoveTask remove task based on type, taskid, shardid.
 	RemoveTask(ctx context.Context, in *RemoveTaskRequest, opts ...grpc.CallOption) (*RemoveTaskResponse, error)
+	// DescribeHistoryQueue returns the progress and backlog of the readers of the history task queues of a shard.
+	DescribeHistoryQueue(ctx context.Context, in *DescribeHistoryQueueRequest, opts ...grpc.CallOption) (*DescribeHistoryQueueResponse, error)
 	// GetReplicationMessages return replication messages based on the read level
 	GetReplicationMessages(ctx context.Context, in *GetReplicationMessagesRequest, opts ...grpc.CallOption) (*GetReplicationMessagesResponse, error)
 	// GetDLQReplicationMessages return replication messages based on dlq info
@@ -490,6 +498,15 @@ func (c *historyServiceClient) ResetWorkflowExecution(ctx context.Context, in *R
 	return out, nil
 }
 
+func (c *historyServiceClient) RepinWorkflowBuildId(ctx context.Context, in *RepinWorkflowBuildIdRequest, opts ...grpc.CallOption) (*RepinWorkflowBuildIdResponse, error) {
+	out := new(RepinWorkflowBuildIdResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/RepinWorkflowBuildId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *historyServiceClient) RequestCancelWorkflowExecution(ctx context.Context, in *RequestCancelWorkflowExecutionRequest, opts ...grpc.CallOption) (*RequestCancelWorkflowExecutionResponse, error) {
 	out := new(RequestCancelWorkflowExecutionResponse)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/RequestCancelWorkflowExecution", in, out, opts...)
@@ -625,6 +642,15 @@ func (c *historyServiceClient) RemoveTask(ctx context.Context, in *RemoveTaskReq
 	return out, nil
 }
 
+func (c *historyServiceClient) DescribeHistoryQueue(ctx context.Context, in *DescribeHistoryQueueRequest, opts ...grpc.CallOption) (*DescribeHistoryQueueResponse, error) {
+	out := new(DescribeHistoryQueueResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/DescribeHistoryQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *historyServiceClient) GetReplicationMessages(ctx context.Context, in *GetReplicationMessagesRequest, opts ...grpc.CallOption) (*GetReplicationMessagesResponse, error) {
 	out := new(GetReplicationMessagesResponse)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.historyservice.v1.HistoryService/GetReplicationMessages", in, out, opts...)
@@ -870,6 +896,10 @@ type HistoryServiceServer interface {
 	// in the history and immediately terminating the current execution instance.
 	// After reset, the history will grow from nextFirstEventId.
 	ResetWorkflowExecution(context.Context, *ResetWorkflowExecutionRequest) (*ResetWorkflowExecutionResponse, error)
+	// RepinWorkflowBuildId pins a running workflow to another build id and schedules a workflow task, so that its
+	// next workflow task is processed by the new build id. It fails with FailedPrecondition if a workflow task is in
+	// flight.
+	RepinWorkflowBuildId(context.Context, *RepinWorkflowBuildIdRequest) (*RepinWorkflowBuildIdResponse, error)
 	// RequestCancelWorkflowExecution is called by application worker when it wants to request cancellation of a workflow instance.
 	// It will result in a new 'WorkflowExecutionCancelRequested' event being written to the workflow history and a new WorkflowTask
 	// created for the workflow instance so new commands could be made. It fails with 'EntityNotExistsError' if the workflow is not valid
@@ -917,6 +947,8 @@ type HistoryServiceServer interface {
 	GetShard(context.Context, *GetShardRequest) (*GetShardResponse, error)
 	// RemoveTask remove task based on type, taskid, shardid.
 	RemoveTask(context.Context, *RemoveTaskRequest) (*RemoveTaskResponse, error)
+	// DescribeHistoryQueue returns the progress and backlog of the readers of the history task queues of a shard.
+	DescribeHistoryQueue(context.Context, *DescribeHistoryQueueRequest) (*DescribeHistoryQueueResponse, error)
 	// GetReplicationMessages return replication messages based on the read level
 	GetReplicationMessages(context.Context, *GetReplicationMessagesRequest) (*GetReplicationMessagesResponse, error)
 	// GetDLQReplicationMessages return replication messages based on dlq info
@@ -1011,6 +1043,9 @@ func (*UnimplementedHistoryServiceServer) DeleteWorkflowExecution(ctx context.Co
 func (*UnimplementedHistoryServiceServer) ResetWorkflowExecution(ctx context.Context, req *ResetWorkflowExecutionRequest) (*ResetWorkflowExecutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetWorkflowExecution not implemented")
 }
+func (*UnimplementedHistoryServiceServer) RepinWorkflowBuildId(ctx context.Context, req *RepinWorkflowBuildIdRequest) (*RepinWorkflowBuildIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepinWorkflowBuildId not implemented")
+}
 func (*UnimplementedHistoryServiceServer) RequestCancelWorkflowExecution(ctx context.Context, req *RequestCancelWorkflowExecutionRequest) (*RequestCancelWorkflowExecutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestCancelWorkflowExecution not implemented")
 }
@@ -1056,6 +1091,9 @@ func (*UnimplementedHistoryServiceServer) GetShard(ctx context.Context, req *Get
 func (*UnimplementedHistoryServiceServer) RemoveTask(ctx context.Context, req *RemoveTaskRequest) (*RemoveTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveTask not implemented")
 }
+func (*UnimplementedHistoryServiceServer) DescribeHistoryQueue(ctx context.Context, req *DescribeHistoryQueueRequest) (*DescribeHistoryQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeHistoryQueue not implemented")
+}
 func (*UnimplementedHistoryServiceServer) GetReplicationMessages(ctx context.Context, req *GetReplicationMessagesRequest) (*GetReplicationMessagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReplicationMessages not implemented")
 }
@@ -1430,6 +1468,24 @@ func _HistoryService_ResetWorkflowExecution_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_RepinWorkflowBuildId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepinWorkflowBuildIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).RepinWorkflowBuildId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/RepinWorkflowBuildId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).RepinWorkflowBuildId(ctx, req.(*RepinWorkflowBuildIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HistoryService_RequestCancelWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RequestCancelWorkflowExecutionRequest)
 	if err := dec(in); err != nil {
@@ -1700,6 +1756,24 @@ func _HistoryService_RemoveTask_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_DescribeHistoryQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeHistoryQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).DescribeHistoryQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.historyservice.v1.HistoryService/DescribeHistoryQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).DescribeHistoryQueue(ctx, req.(*DescribeHistoryQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HistoryService_GetReplicationMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetReplicationMessagesRequest)
 	if err := dec(in); err != nil {
@@ -2054,6 +2128,10 @@ var _HistoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ResetWorkflowExecution",
 			Handler:    _HistoryService_ResetWorkflowExecution_Handler,
 		},
+		{
+			MethodName: "RepinWorkflowBuildId",
+			Handler:    _HistoryService_RepinWorkflowBuildId_Handler,
+		},
 		{
 			MethodName: "RequestCancelWorkflowExecution",
 			Handler:    _HistoryService_RequestCancelWorkflowExecution_Handler,
@@ -2114,6 +2192,10 @@ var _HistoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveTask",
 			Handler:    _HistoryService_RemoveTask_Handler,
 		},
+		{
+			MethodName: "DescribeHistoryQueue",
+			Handler:    _HistoryService_DescribeHistoryQueue_Handler,
+		},
 		{
 			MethodName: "GetReplicationMessages",
 			Handler:    _HistoryService_GetReplicationMessages_Handler,
@@ -141,6 +141,26 @@ func (mr *MockHistoryServiceClientMockRecorder) DescribeHistoryHost(ctx, in inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryHost", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeHistoryHost), varargs...)
 }
 
+// DescribeHistoryQueue mocks base method.
+func (m *MockHistoryServiceClient) DescribeHistoryQueue(ctx context.Context, in *historyservice.DescribeHistoryQueueRequest, opts ...grpc.CallOption) (*historyservice.DescribeHistoryQueueResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeHistoryQueue", varargs...)
+	ret0, _ := ret[0].(*historyservice.DescribeHistoryQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeHistoryQueue indicates an expected call of DescribeHistoryQueue.
+func (mr *MockHistoryServiceClientMockRecorder) DescribeHistoryQueue(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryQueue", reflect.TypeOf((*MockHistoryServiceClient)(nil).DescribeHistoryQueue), varargs...)
+}
+
 // DescribeMutableState mocks base method.
 func (m *MockHistoryServiceClient) DescribeMutableState(ctx context.Context, in *historyservice.DescribeMutableStateRequest, opts ...grpc.CallOption) (*historyservice.DescribeMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -601,6 +621,26 @@ func (mr *MockHistoryServiceClientMockRecorder) RemoveTask(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockHistoryServiceClient)(nil).RemoveTask), varargs...)
 }
 
+// RepinWorkflowBuildId mocks base method.
+func (m *MockHistoryServiceClient) RepinWorkflowBuildId(ctx context.Context, in *historyservice.RepinWorkflowBuildIdRequest, opts ...grpc.CallOption) (*historyservice.RepinWorkflowBuildIdResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RepinWorkflowBuildId", varargs...)
+	ret0, _ := ret[0].(*historyservice.RepinWorkflowBuildIdResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RepinWorkflowBuildId indicates an expected call of RepinWorkflowBuildId.
+func (mr *MockHistoryServiceClientMockRecorder) RepinWorkflowBuildId(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepinWorkflowBuildId", reflect.TypeOf((*MockHistoryServiceClient)(nil).RepinWorkflowBuildId), varargs...)
+}
+
 // ReplicateEventsV2 mocks base method.
 func (m *MockHistoryServiceClient) ReplicateEventsV2(ctx context.Context, in *historyservice.ReplicateEventsV2Request, opts ...grpc.CallOption) (*historyservice.ReplicateEventsV2Response, error) {
 	m.ctrl.T.Helper()
@@ -1241,6 +1281,21 @@ func (mr *MockHistoryServiceServerMockRecorder) DescribeHistoryHost(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryHost", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeHistoryHost), arg0, arg1)
 }
 
+// DescribeHistoryQueue mocks base method.
+func (m *MockHistoryServiceServer) DescribeHistoryQueue(arg0 context.Context, arg1 *historyservice.DescribeHistoryQueueRequest) (*historyservice.DescribeHistoryQueueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeHistoryQueue", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.DescribeHistoryQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeHistoryQueue indicates an expected call of DescribeHistoryQueue.
+func (mr *MockHistoryServiceServerMockRecorder) DescribeHistoryQueue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryQueue", reflect.TypeOf((*MockHistoryServiceServer)(nil).DescribeHistoryQueue), arg0, arg1)
+}
+
 // DescribeMutableState mocks base method.
 func (m *MockHistoryServiceServer) DescribeMutableState(arg0 context.Context, arg1 *historyservice.DescribeMutableStateRequest) (*historyservice.DescribeMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -1586,6 +1641,21 @@ func (mr *MockHistoryServiceServerMockRecorder) RemoveTask(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTask", reflect.TypeOf((*MockHistoryServiceServer)(nil).RemoveTask), arg0, arg1)
 }
 
+// RepinWorkflowBuildId mocks base method.
+func (m *MockHistoryServiceServer) RepinWorkflowBuildId(arg0 context.Context, arg1 *historyservice.RepinWorkflowBuildIdRequest) (*historyservice.RepinWorkflowBuildIdResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RepinWorkflowBuildId", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.RepinWorkflowBuildIdResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RepinWorkflowBuildId indicates an expected call of RepinWorkflowBuildId.
+func (mr *MockHistoryServiceServerMockRecorder) RepinWorkflowBuildId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepinWorkflowBuildId", reflect.TypeOf((*MockHistoryServiceServer)(nil).RepinWorkflowBuildId), arg0, arg1)
+}
+
 // ReplicateEventsV2 mocks base method.
 func (m *MockHistoryServiceServer) ReplicateEventsV2(arg0 context.Context, arg1 *historyservice.ReplicateEventsV2Request) (*historyservice.ReplicateEventsV2Response, error) {
 	m.ctrl.T.Helper()
@@ -1166,6 +1166,8 @@ var xxx_messageInfo_CancelOutstandingPollResponse proto.InternalMessageInfo
 type DescribeTaskQueueRequest struct {
 	NamespaceId string                       `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	DescRequest *v1.DescribeTaskQueueRequest `protobuf:"bytes,2,opt,name=desc_request,json=descRequest,proto3" json:"desc_request,omitempty"`
+	// Also include pollers of the versioned queues of this partition for the given version set ids.
+	VersionSetIds []string `protobuf:"bytes,3,rep,name=version_set_ids,json=versionSetIds,proto3" json:"version_set_ids,omitempty"`
 }
 
 func (m *DescribeTaskQueueRequest) Reset()      { *m = DescribeTaskQueueRequest{} }
@@ -1214,9 +1216,23 @@ func (m *DescribeTaskQueueRequest) GetDescRequest() *v1.DescribeTaskQueueRequest
 	return nil
 }
 
+func (m *DescribeTaskQueueRequest) GetVersionSetIds() []string {
+	if m != nil {
+		return m.VersionSetIds
+	}
+	return nil
+}
+
 type DescribeTaskQueueResponse struct {
 	Pollers         []*v14.PollerInfo    `protobuf:"bytes,1,rep,name=pollers,proto3" json:"pollers,omitempty"`
 	TaskQueueStatus *v14.TaskQueueStatus `protobuf:"bytes,2,opt,name=task_queue_status,json=taskQueueStatus,proto3" json:"task_queue_status,omitempty"`
+	// Backlog of the unversioned queue of this partition, i.e. the tasks that are still not assigned to any build id,
+	// for example after versioning was enabled on a queue that had unversioned tasks.
+	// Only set if the task queue status was requested.
+	UnversionedBacklogCountHint int64 `protobuf:"varint,3,opt,name=unversioned_backlog_count_hint,json=unversionedBacklogCountHint,proto3" json:"unversioned_backlog_count_hint,omitempty"`
+	// Backlog of the versioned queues of this partition, by version set id, for the version_set_ids of the request
+	// that are loaded. Only set if the task queue status was requested.
+	VersionedBacklogCountHints map[string]int64 `protobuf:"bytes,4,rep,name=versioned_backlog_count_hints,json=versionedBacklogCountHints,proto3" json:"versioned_backlog_count_hints,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
 func (m *DescribeTaskQueueResponse) Reset()      { *m = DescribeTaskQueueResponse{} }
@@ -1265,6 +1281,20 @@ func (m *DescribeTaskQueueResponse) GetTaskQueueStatus() *v14.TaskQueueStatus {
 	return nil
 }
 
+func (m *DescribeTaskQueueResponse) GetUnversionedBacklogCountHint() int64 {
+	if m != nil {
+		return m.UnversionedBacklogCountHint
+	}
+	return 0
+}
+
+func (m *DescribeTaskQueueResponse) GetVersionedBacklogCountHints() map[string]int64 {
+	if m != nil {
+		return m.VersionedBacklogCountHints
+	}
+	return nil
+}
+
 type ListTaskQueuePartitionsRequest struct {
 	Namespace   string         `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	NamespaceId string         `protobuf:"bytes,3,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
@@ -1383,8 +1413,16 @@ func (m *ListTaskQueuePartitionsResponse) GetWorkflowTaskQueuePartitions() []*v1
 //
 //	aip.dev/not-precedent: UpdateWorkerBuildIdCompatibilityRequest RPC doesn't follow Google API format. --)
 type UpdateWorkerBuildIdCompatibilityRequest struct {
-	NamespaceId string                                      `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
-	Request     *v1.UpdateWorkerBuildIdCompatibilityRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	TaskQueue   string `protobuf:"bytes,4,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	// Types that are valid to be assigned to Operation:
+	//	*UpdateWorkerBuildIdCompatibilityRequest_Request
+	//	*UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining_
+	//	*UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet_
+	//	*UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels_
+	//	*UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets_
+	//	*UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId_
+	Operation isUpdateWorkerBuildIdCompatibilityRequest_Operation `protobuf_oneof:"operation"`
 }
 
 func (m *UpdateWorkerBuildIdCompatibilityRequest) Reset() {
@@ -1421,6 +1459,52 @@ func (m *UpdateWorkerBuildIdCompatibilityRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateWorkerBuildIdCompatibilityRequest proto.InternalMessageInfo
 
+type isUpdateWorkerBuildIdCompatibilityRequest_Operation interface {
+	isUpdateWorkerBuildIdCompatibilityRequest_Operation()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type UpdateWorkerBuildIdCompatibilityRequest_Request struct {
+	Request *v1.UpdateWorkerBuildIdCompatibilityRequest `protobuf:"bytes,2,opt,name=request,proto3,oneof" json:"request,omitempty"`
+}
+type UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining_ struct {
+	MarkBuildIdDraining *UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining `protobuf:"bytes,3,opt,name=mark_build_id_draining,json=markBuildIdDraining,proto3,oneof" json:"mark_build_id_draining,omitempty"`
+}
+type UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet_ struct {
+	SwapBuildIdsWithinSet *UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet `protobuf:"bytes,5,opt,name=swap_build_ids_within_set,json=swapBuildIdsWithinSet,proto3,oneof" json:"swap_build_ids_within_set,omitempty"`
+}
+type UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels_ struct {
+	SetBuildIdLabels *UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels `protobuf:"bytes,6,opt,name=set_build_id_labels,json=setBuildIdLabels,proto3,oneof" json:"set_build_id_labels,omitempty"`
+}
+type UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets_ struct {
+	SwapDefaultSets *UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets `protobuf:"bytes,7,opt,name=swap_default_sets,json=swapDefaultSets,proto3,oneof" json:"swap_default_sets,omitempty"`
+}
+type UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId_ struct {
+	RemoveBuildId *UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId `protobuf:"bytes,8,opt,name=remove_build_id,json=removeBuildId,proto3,oneof" json:"remove_build_id,omitempty"`
+}
+
+func (*UpdateWorkerBuildIdCompatibilityRequest_Request) isUpdateWorkerBuildIdCompatibilityRequest_Operation() {
+}
+func (*UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining_) isUpdateWorkerBuildIdCompatibilityRequest_Operation() {
+}
+func (*UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet_) isUpdateWorkerBuildIdCompatibilityRequest_Operation() {
+}
+func (*UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels_) isUpdateWorkerBuildIdCompatibilityRequest_Operation() {
+}
+func (*UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets_) isUpdateWorkerBuildIdCompatibilityRequest_Operation() {
+}
+func (*UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId_) isUpdateWorkerBuildIdCompatibilityRequest_Operation() {
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest) GetOperation() isUpdateWorkerBuildIdCompatibilityRequest_Operation {
+	if m != nil {
+		return m.Operation
+	}
+	return nil
+}
+
 func (m *UpdateWorkerBuildIdCompatibilityRequest) GetNamespaceId() string {
 	if m != nil {
 		return m.NamespaceId
@@ -1428,13 +1512,326 @@ func (m *UpdateWorkerBuildIdCompatibilityRequest) GetNamespaceId() string {
 	return ""
 }
 
+func (m *UpdateWorkerBuildIdCompatibilityRequest) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
+	}
+	return ""
+}
+
 func (m *UpdateWorkerBuildIdCompatibilityRequest) GetRequest() *v1.UpdateWorkerBuildIdCompatibilityRequest {
+	if x, ok := m.GetOperation().(*UpdateWorkerBuildIdCompatibilityRequest_Request); ok {
+		return x.Request
+	}
+	return nil
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest) GetMarkBuildIdDraining() *UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining {
+	if x, ok := m.GetOperation().(*UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining_); ok {
+		return x.MarkBuildIdDraining
+	}
+	return nil
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest) GetSwapBuildIdsWithinSet() *UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet {
+	if x, ok := m.GetOperation().(*UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet_); ok {
+		return x.SwapBuildIdsWithinSet
+	}
+	return nil
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest) GetSetBuildIdLabels() *UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels {
+	if x, ok := m.GetOperation().(*UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels_); ok {
+		return x.SetBuildIdLabels
+	}
+	return nil
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest) GetSwapDefaultSets() *UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets {
+	if x, ok := m.GetOperation().(*UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets_); ok {
+		return x.SwapDefaultSets
+	}
+	return nil
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest) GetRemoveBuildId() *UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId {
+	if x, ok := m.GetOperation().(*UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId_); ok {
+		return x.RemoveBuildId
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*UpdateWorkerBuildIdCompatibilityRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*UpdateWorkerBuildIdCompatibilityRequest_Request)(nil),
+		(*UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining_)(nil),
+		(*UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet_)(nil),
+		(*UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels_)(nil),
+		(*UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets_)(nil),
+		(*UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId_)(nil),
+	}
+}
+
+// Marks a build id as draining: new workflows and activities are no longer assigned to it,
+// but tasks already pinned to it continue to be dispatched there.
+type UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining struct {
+	BuildId string `protobuf:"bytes,1,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining) Reset() {
+	*m = UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining{}
+}
+func (*UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining) ProtoMessage() {}
+func (*UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{18, 0}
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining.Merge(m, src)
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining proto.InternalMessageInfo
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining) GetBuildId() string {
 	if m != nil {
-		return m.Request
+		return m.BuildId
+	}
+	return ""
+}
+
+// Swaps the positions of two build ids within the same compatible set. If either one is the set
+// default, the other becomes the new default.
+type UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet struct {
+	FirstBuildId  string `protobuf:"bytes,1,opt,name=first_build_id,json=firstBuildId,proto3" json:"first_build_id,omitempty"`
+	SecondBuildId string `protobuf:"bytes,2,opt,name=second_build_id,json=secondBuildId,proto3" json:"second_build_id,omitempty"`
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet) Reset() {
+	*m = UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet{}
+}
+func (*UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet) ProtoMessage() {}
+func (*UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{18, 1}
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet.Merge(m, src)
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet proto.InternalMessageInfo
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet) GetFirstBuildId() string {
+	if m != nil {
+		return m.FirstBuildId
+	}
+	return ""
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet) GetSecondBuildId() string {
+	if m != nil {
+		return m.SecondBuildId
+	}
+	return ""
+}
+
+// Replaces the labels attached to a build id, e.g. commit SHA, deploy time or owner. An empty
+// map clears them.
+type UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels struct {
+	BuildId string            `protobuf:"bytes,1,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	Labels  map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels) Reset() {
+	*m = UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels{}
+}
+func (*UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels) ProtoMessage() {}
+func (*UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{18, 2}
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels.Merge(m, src)
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels proto.InternalMessageInfo
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels) GetBuildId() string {
+	if m != nil {
+		return m.BuildId
+	}
+	return ""
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
 	}
 	return nil
 }
 
+// Swaps the positions of the sets of two build ids, one of which must be in the current default
+// set, atomically making the other set the overall default.
+type UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets struct {
+	FirstBuildId  string `protobuf:"bytes,1,opt,name=first_build_id,json=firstBuildId,proto3" json:"first_build_id,omitempty"`
+	SecondBuildId string `protobuf:"bytes,2,opt,name=second_build_id,json=secondBuildId,proto3" json:"second_build_id,omitempty"`
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets) Reset() {
+	*m = UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets{}
+}
+func (*UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets) ProtoMessage() {}
+func (*UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{18, 3}
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets.Merge(m, src)
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets proto.InternalMessageInfo
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets) GetFirstBuildId() string {
+	if m != nil {
+		return m.FirstBuildId
+	}
+	return ""
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets) GetSecondBuildId() string {
+	if m != nil {
+		return m.SecondBuildId
+	}
+	return ""
+}
+
+// Deletes a retired build id from its compatible set. Refused while open workflows are still pinned to it, or if
+// it is the default of the task queue or of a set with other build ids.
+type UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId struct {
+	BuildId string `protobuf:"bytes,1,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId) Reset() {
+	*m = UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId{}
+}
+func (*UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId) ProtoMessage() {}
+func (*UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{18, 4}
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId.Merge(m, src)
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId proto.InternalMessageInfo
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId) GetBuildId() string {
+	if m != nil {
+		return m.BuildId
+	}
+	return ""
+}
+
 type UpdateWorkerBuildIdCompatibilityResponse struct {
 }
 
@@ -1475,6 +1872,9 @@ var xxx_messageInfo_UpdateWorkerBuildIdCompatibilityResponse proto.InternalMessa
 type GetWorkerBuildIdCompatibilityRequest struct {
 	NamespaceId string                                   `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	Request     *v1.GetWorkerBuildIdCompatibilityRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	// If set, the response describes the version sets that would result from applying this
+	// update to the current versioning data. Nothing is persisted.
+	HypotheticalUpdate *v1.UpdateWorkerBuildIdCompatibilityRequest `protobuf:"bytes,3,opt,name=hypothetical_update,json=hypotheticalUpdate,proto3" json:"hypothetical_update,omitempty"`
 }
 
 func (m *GetWorkerBuildIdCompatibilityRequest) Reset()      { *m = GetWorkerBuildIdCompatibilityRequest{} }
@@ -1523,8 +1923,18 @@ func (m *GetWorkerBuildIdCompatibilityRequest) GetRequest() *v1.GetWorkerBuildId
 	return nil
 }
 
+func (m *GetWorkerBuildIdCompatibilityRequest) GetHypotheticalUpdate() *v1.UpdateWorkerBuildIdCompatibilityRequest {
+	if m != nil {
+		return m.HypotheticalUpdate
+	}
+	return nil
+}
+
 type GetWorkerBuildIdCompatibilityResponse struct {
 	Response *v1.GetWorkerBuildIdCompatibilityResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	// Labels of the build ids included in the response, keyed by build id. Build ids without
+	// labels are omitted.
+	BuildIdLabels map[string]*GetWorkerBuildIdCompatibilityResponse_BuildIdLabels `protobuf:"bytes,2,rep,name=build_id_labels,json=buildIdLabels,proto3" json:"build_id_labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *GetWorkerBuildIdCompatibilityResponse) Reset()      { *m = GetWorkerBuildIdCompatibilityResponse{} }
@@ -1566,6 +1976,58 @@ func (m *GetWorkerBuildIdCompatibilityResponse) GetResponse() *v1.GetWorkerBuild
 	return nil
 }
 
+func (m *GetWorkerBuildIdCompatibilityResponse) GetBuildIdLabels() map[string]*GetWorkerBuildIdCompatibilityResponse_BuildIdLabels {
+	if m != nil {
+		return m.BuildIdLabels
+	}
+	return nil
+}
+
+type GetWorkerBuildIdCompatibilityResponse_BuildIdLabels struct {
+	Labels map[string]string `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *GetWorkerBuildIdCompatibilityResponse_BuildIdLabels) Reset() {
+	*m = GetWorkerBuildIdCompatibilityResponse_BuildIdLabels{}
+}
+func (*GetWorkerBuildIdCompatibilityResponse_BuildIdLabels) ProtoMessage() {}
+func (*GetWorkerBuildIdCompatibilityResponse_BuildIdLabels) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{21, 0}
+}
+func (m *GetWorkerBuildIdCompatibilityResponse_BuildIdLabels) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetWorkerBuildIdCompatibilityResponse_BuildIdLabels) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetWorkerBuildIdCompatibilityResponse_BuildIdLabels.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetWorkerBuildIdCompatibilityResponse_BuildIdLabels) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWorkerBuildIdCompatibilityResponse_BuildIdLabels.Merge(m, src)
+}
+func (m *GetWorkerBuildIdCompatibilityResponse_BuildIdLabels) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetWorkerBuildIdCompatibilityResponse_BuildIdLabels) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWorkerBuildIdCompatibilityResponse_BuildIdLabels.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetWorkerBuildIdCompatibilityResponse_BuildIdLabels proto.InternalMessageInfo
+
+func (m *GetWorkerBuildIdCompatibilityResponse_BuildIdLabels) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
 type GetTaskQueueUserDataRequest struct {
 	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	// The task queue to fetch data from. The task queue is always considered as a normal
@@ -1581,6 +2043,9 @@ type GetTaskQueueUserDataRequest struct {
 	// If set and last_known_user_data_version is the current version, block until new data is
 	// available (or timeout).
 	WaitNewData bool `protobuf:"varint,4,opt,name=wait_new_data,json=waitNewData,proto3" json:"wait_new_data,omitempty"`
+	// If set, the partition is not loaded to serve this request. A partition which is not loaded
+	// replies with partition_not_loaded set instead.
+	OnlyIfLoaded bool `protobuf:"varint,6,opt,name=only_if_loaded,json=onlyIfLoaded,proto3" json:"only_if_loaded,omitempty"`
 }
 
 func (m *GetTaskQueueUserDataRequest) Reset()      { *m = GetTaskQueueUserDataRequest{} }
@@ -1650,12 +2115,21 @@ func (m *GetTaskQueueUserDataRequest) GetWaitNewData() bool {
 	return false
 }
 
+func (m *GetTaskQueueUserDataRequest) GetOnlyIfLoaded() bool {
+	if m != nil {
+		return m.OnlyIfLoaded
+	}
+	return false
+}
+
 type GetTaskQueueUserDataResponse struct {
 	// Whether this task queue has any stored user data
 	TaskQueueHasUserData bool `protobuf:"varint,1,opt,name=task_queue_has_user_data,json=taskQueueHasUserData,proto3" json:"task_queue_has_user_data,omitempty"`
 	// Versioned user data, set if the task queue has user data and the request's last_known_user_data_version is less
 	// than the version cached in the root partition.
 	UserData *v110.VersionedTaskQueueUserData `protobuf:"bytes,2,opt,name=user_data,json=userData,proto3" json:"user_data,omitempty"`
+	// Set if the request asked for only_if_loaded and the partition is not loaded.
+	PartitionNotLoaded bool `protobuf:"varint,3,opt,name=partition_not_loaded,json=partitionNotLoaded,proto3" json:"partition_not_loaded,omitempty"`
 }
 
 func (m *GetTaskQueueUserDataResponse) Reset()      { *m = GetTaskQueueUserDataResponse{} }
@@ -1704,6 +2178,13 @@ func (m *GetTaskQueueUserDataResponse) GetUserData() *v110.VersionedTaskQueueUse
 	return nil
 }
 
+func (m *GetTaskQueueUserDataResponse) GetPartitionNotLoaded() bool {
+	if m != nil {
+		return m.PartitionNotLoaded
+	}
+	return false
+}
+
 type ApplyTaskQueueUserDataReplicationEventRequest struct {
 	NamespaceId string                  `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	TaskQueue   string                  `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
@@ -1802,6 +2283,267 @@ func (m *ApplyTaskQueueUserDataReplicationEventResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplyTaskQueueUserDataReplicationEventResponse proto.InternalMessageInfo
 
+type DescribeVersioningRequest struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	TaskQueue   string `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	// Maximum number of build ids to describe, whole version sets are returned from the oldest to the default one
+	// until the next set would exceed it. A page always has at least one set. A default is used if not set.
+	PageSize      int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken []byte `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (m *DescribeVersioningRequest) Reset()      { *m = DescribeVersioningRequest{} }
+func (*DescribeVersioningRequest) ProtoMessage() {}
+func (*DescribeVersioningRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{26}
+}
+func (m *DescribeVersioningRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeVersioningRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeVersioningRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DescribeVersioningRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeVersioningRequest.Merge(m, src)
+}
+func (m *DescribeVersioningRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeVersioningRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeVersioningRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeVersioningRequest proto.InternalMessageInfo
+
+func (m *DescribeVersioningRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *DescribeVersioningRequest) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
+	}
+	return ""
+}
+
+func (m *DescribeVersioningRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *DescribeVersioningRequest) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
+}
+
+type DescribeVersioningResponse struct {
+	VersionSets []*DescribeVersioningResponse_VersionSet `protobuf:"bytes,1,rep,name=version_sets,json=versionSets,proto3" json:"version_sets,omitempty"`
+	// Token to fetch the next page, empty if this page ends with the default version set.
+	NextPageToken []byte `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (m *DescribeVersioningResponse) Reset()      { *m = DescribeVersioningResponse{} }
+func (*DescribeVersioningResponse) ProtoMessage() {}
+func (*DescribeVersioningResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{27}
+}
+func (m *DescribeVersioningResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeVersioningResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeVersioningResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DescribeVersioningResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeVersioningResponse.Merge(m, src)
+}
+func (m *DescribeVersioningResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeVersioningResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeVersioningResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeVersioningResponse proto.InternalMessageInfo
+
+func (m *DescribeVersioningResponse) GetVersionSets() []*DescribeVersioningResponse_VersionSet {
+	if m != nil {
+		return m.VersionSets
+	}
+	return nil
+}
+
+func (m *DescribeVersioningResponse) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
+}
+
+type DescribeVersioningResponse_BuildIdInfo struct {
+	BuildId string `protobuf:"bytes,1,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	// Whether this build id is the default of its version set.
+	IsSetDefault bool               `protobuf:"varint,2,opt,name=is_set_default,json=isSetDefault,proto3" json:"is_set_default,omitempty"`
+	State        v110.BuildId_State `protobuf:"varint,3,opt,name=state,proto3,enum=temporal.server.api.persistence.v1.BuildId_State" json:"state,omitempty"`
+	// Number of distinct pollers polling with this build id across all partitions and task queue types.
+	PollerCount  int32                  `protobuf:"varint,4,opt,name=poller_count,json=pollerCount,proto3" json:"poller_count,omitempty"`
+	Reachability []v19.TaskReachability `protobuf:"varint,5,rep,packed,name=reachability,proto3,enum=temporal.api.enums.v1.TaskReachability" json:"reachability,omitempty"`
+	// Number of open workflows on this task queue that have run on this build id, as reported by visibility.
+	OpenWorkflowCount int64 `protobuf:"varint,6,opt,name=open_workflow_count,json=openWorkflowCount,proto3" json:"open_workflow_count,omitempty"`
+}
+
+func (m *DescribeVersioningResponse_BuildIdInfo) Reset() {
+	*m = DescribeVersioningResponse_BuildIdInfo{}
+}
+func (*DescribeVersioningResponse_BuildIdInfo) ProtoMessage() {}
+func (*DescribeVersioningResponse_BuildIdInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{27, 0}
+}
+func (m *DescribeVersioningResponse_BuildIdInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeVersioningResponse_BuildIdInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeVersioningResponse_BuildIdInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DescribeVersioningResponse_BuildIdInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeVersioningResponse_BuildIdInfo.Merge(m, src)
+}
+func (m *DescribeVersioningResponse_BuildIdInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeVersioningResponse_BuildIdInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeVersioningResponse_BuildIdInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeVersioningResponse_BuildIdInfo proto.InternalMessageInfo
+
+func (m *DescribeVersioningResponse_BuildIdInfo) GetBuildId() string {
+	if m != nil {
+		return m.BuildId
+	}
+	return ""
+}
+
+func (m *DescribeVersioningResponse_BuildIdInfo) GetIsSetDefault() bool {
+	if m != nil {
+		return m.IsSetDefault
+	}
+	return false
+}
+
+func (m *DescribeVersioningResponse_BuildIdInfo) GetState() v110.BuildId_State {
+	if m != nil {
+		return m.State
+	}
+	return v110.STATE_UNSPECIFIED
+}
+
+func (m *DescribeVersioningResponse_BuildIdInfo) GetPollerCount() int32 {
+	if m != nil {
+		return m.PollerCount
+	}
+	return 0
+}
+
+func (m *DescribeVersioningResponse_BuildIdInfo) GetReachability() []v19.TaskReachability {
+	if m != nil {
+		return m.Reachability
+	}
+	return nil
+}
+
+func (m *DescribeVersioningResponse_BuildIdInfo) GetOpenWorkflowCount() int64 {
+	if m != nil {
+		return m.OpenWorkflowCount
+	}
+	return 0
+}
+
+type DescribeVersioningResponse_VersionSet struct {
+	BuildIds []*DescribeVersioningResponse_BuildIdInfo `protobuf:"bytes,1,rep,name=build_ids,json=buildIds,proto3" json:"build_ids,omitempty"`
+	// Whether this is the default version set of the task queue.
+	IsDefault bool `protobuf:"varint,2,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+}
+
+func (m *DescribeVersioningResponse_VersionSet) Reset()      { *m = DescribeVersioningResponse_VersionSet{} }
+func (*DescribeVersioningResponse_VersionSet) ProtoMessage() {}
+func (*DescribeVersioningResponse_VersionSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{27, 1}
+}
+func (m *DescribeVersioningResponse_VersionSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeVersioningResponse_VersionSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeVersioningResponse_VersionSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DescribeVersioningResponse_VersionSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeVersioningResponse_VersionSet.Merge(m, src)
+}
+func (m *DescribeVersioningResponse_VersionSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeVersioningResponse_VersionSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeVersioningResponse_VersionSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeVersioningResponse_VersionSet proto.InternalMessageInfo
+
+func (m *DescribeVersioningResponse_VersionSet) GetBuildIds() []*DescribeVersioningResponse_BuildIdInfo {
+	if m != nil {
+		return m.BuildIds
+	}
+	return nil
+}
+
+func (m *DescribeVersioningResponse_VersionSet) GetIsDefault() bool {
+	if m != nil {
+		return m.IsDefault
+	}
+	return false
+}
+
 type GetBuildIdTaskQueueMappingRequest struct {
 	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
 	BuildId     string `protobuf:"bytes,2,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
@@ -1810,7 +2552,7 @@ type GetBuildIdTaskQueueMappingRequest struct {
 func (m *GetBuildIdTaskQueueMappingRequest) Reset()      { *m = GetBuildIdTaskQueueMappingRequest{} }
 func (*GetBuildIdTaskQueueMappingRequest) ProtoMessage() {}
 func (*GetBuildIdTaskQueueMappingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a429a3813476c583, []int{26}
+	return fileDescriptor_a429a3813476c583, []int{28}
 }
 func (m *GetBuildIdTaskQueueMappingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1860,7 +2602,7 @@ type GetBuildIdTaskQueueMappingResponse struct {
 func (m *GetBuildIdTaskQueueMappingResponse) Reset()      { *m = GetBuildIdTaskQueueMappingResponse{} }
 func (*GetBuildIdTaskQueueMappingResponse) ProtoMessage() {}
 func (*GetBuildIdTaskQueueMappingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a429a3813476c583, []int{27}
+	return fileDescriptor_a429a3813476c583, []int{29}
 }
 func (m *GetBuildIdTaskQueueMappingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1905,7 +2647,7 @@ type ForceUnloadTaskQueueRequest struct {
 func (m *ForceUnloadTaskQueueRequest) Reset()      { *m = ForceUnloadTaskQueueRequest{} }
 func (*ForceUnloadTaskQueueRequest) ProtoMessage() {}
 func (*ForceUnloadTaskQueueRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a429a3813476c583, []int{28}
+	return fileDescriptor_a429a3813476c583, []int{30}
 }
 func (m *ForceUnloadTaskQueueRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1962,7 +2704,7 @@ type ForceUnloadTaskQueueResponse struct {
 func (m *ForceUnloadTaskQueueResponse) Reset()      { *m = ForceUnloadTaskQueueResponse{} }
 func (*ForceUnloadTaskQueueResponse) ProtoMessage() {}
 func (*ForceUnloadTaskQueueResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a429a3813476c583, []int{29}
+	return fileDescriptor_a429a3813476c583, []int{31}
 }
 func (m *ForceUnloadTaskQueueResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2020,7 +2762,7 @@ type UpdateTaskQueueUserDataRequest struct {
 func (m *UpdateTaskQueueUserDataRequest) Reset()      { *m = UpdateTaskQueueUserDataRequest{} }
 func (*UpdateTaskQueueUserDataRequest) ProtoMessage() {}
 func (*UpdateTaskQueueUserDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a429a3813476c583, []int{30}
+	return fileDescriptor_a429a3813476c583, []int{32}
 }
 func (m *UpdateTaskQueueUserDataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2090,7 +2832,7 @@ type UpdateTaskQueueUserDataResponse struct {
 func (m *UpdateTaskQueueUserDataResponse) Reset()      { *m = UpdateTaskQueueUserDataResponse{} }
 func (*UpdateTaskQueueUserDataResponse) ProtoMessage() {}
 func (*UpdateTaskQueueUserDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a429a3813476c583, []int{31}
+	return fileDescriptor_a429a3813476c583, []int{33}
 }
 func (m *UpdateTaskQueueUserDataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2128,7 +2870,7 @@ type ReplicateTaskQueueUserDataRequest struct {
 func (m *ReplicateTaskQueueUserDataRequest) Reset()      { *m = ReplicateTaskQueueUserDataRequest{} }
 func (*ReplicateTaskQueueUserDataRequest) ProtoMessage() {}
 func (*ReplicateTaskQueueUserDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a429a3813476c583, []int{32}
+	return fileDescriptor_a429a3813476c583, []int{34}
 }
 func (m *ReplicateTaskQueueUserDataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2184,7 +2926,7 @@ type ReplicateTaskQueueUserDataResponse struct {
 func (m *ReplicateTaskQueueUserDataResponse) Reset()      { *m = ReplicateTaskQueueUserDataResponse{} }
 func (*ReplicateTaskQueueUserDataResponse) ProtoMessage() {}
 func (*ReplicateTaskQueueUserDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a429a3813476c583, []int{33}
+	return fileDescriptor_a429a3813476c583, []int{35}
 }
 func (m *ReplicateTaskQueueUserDataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2213,674 +2955,1801 @@ func (m *ReplicateTaskQueueUserDataResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ReplicateTaskQueueUserDataResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*PollWorkflowTaskQueueRequest)(nil), "temporal.server.api.matchingservice.v1.PollWorkflowTaskQueueRequest")
-	proto.RegisterType((*PollWorkflowTaskQueueResponse)(nil), "temporal.server.api.matchingservice.v1.PollWorkflowTaskQueueResponse")
-	proto.RegisterMapType((map[string]*v12.WorkflowQuery)(nil), "temporal.server.api.matchingservice.v1.PollWorkflowTaskQueueResponse.QueriesEntry")
-	proto.RegisterType((*PollActivityTaskQueueRequest)(nil), "temporal.server.api.matchingservice.v1.PollActivityTaskQueueRequest")
-	proto.RegisterType((*PollActivityTaskQueueResponse)(nil), "temporal.server.api.matchingservice.v1.PollActivityTaskQueueResponse")
-	proto.RegisterType((*AddWorkflowTaskRequest)(nil), "temporal.server.api.matchingservice.v1.AddWorkflowTaskRequest")
-	proto.RegisterType((*AddWorkflowTaskResponse)(nil), "temporal.server.api.matchingservice.v1.AddWorkflowTaskResponse")
-	proto.RegisterType((*AddActivityTaskRequest)(nil), "temporal.server.api.matchingservice.v1.AddActivityTaskRequest")
-	proto.RegisterType((*AddActivityTaskResponse)(nil), "temporal.server.api.matchingservice.v1.AddActivityTaskResponse")
-	proto.RegisterType((*QueryWorkflowRequest)(nil), "temporal.server.api.matchingservice.v1.QueryWorkflowRequest")
-	proto.RegisterType((*QueryWorkflowResponse)(nil), "temporal.server.api.matchingservice.v1.QueryWorkflowResponse")
-	proto.RegisterType((*RespondQueryTaskCompletedRequest)(nil), "temporal.server.api.matchingservice.v1.RespondQueryTaskCompletedRequest")
-	proto.RegisterType((*RespondQueryTaskCompletedResponse)(nil), "temporal.server.api.matchingservice.v1.RespondQueryTaskCompletedResponse")
-	proto.RegisterType((*CancelOutstandingPollRequest)(nil), "temporal.server.api.matchingservice.v1.CancelOutstandingPollRequest")
-	proto.RegisterType((*CancelOutstandingPollResponse)(nil), "temporal.server.api.matchingservice.v1.CancelOutstandingPollResponse")
-	proto.RegisterType((*DescribeTaskQueueRequest)(nil), "temporal.server.api.matchingservice.v1.DescribeTaskQueueRequest")
-	proto.RegisterType((*DescribeTaskQueueResponse)(nil), "temporal.server.api.matchingservice.v1.DescribeTaskQueueResponse")
-	proto.RegisterType((*ListTaskQueuePartitionsRequest)(nil), "temporal.server.api.matchingservice.v1.ListTaskQueuePartitionsRequest")
-	proto.RegisterType((*ListTaskQueuePartitionsResponse)(nil), "temporal.server.api.matchingservice.v1.ListTaskQueuePartitionsResponse")
-	proto.RegisterType((*UpdateWorkerBuildIdCompatibilityRequest)(nil), "temporal.server.api.matchingservice.v1.UpdateWorkerBuildIdCompatibilityRequest")
-	proto.RegisterType((*UpdateWorkerBuildIdCompatibilityResponse)(nil), "temporal.server.api.matchingservice.v1.UpdateWorkerBuildIdCompatibilityResponse")
-	proto.RegisterType((*GetWorkerBuildIdCompatibilityRequest)(nil), "temporal.server.api.matchingservice.v1.GetWorkerBuildIdCompatibilityRequest")
-	proto.RegisterType((*GetWorkerBuildIdCompatibilityResponse)(nil), "temporal.server.api.matchingservice.v1.GetWorkerBuildIdCompatibilityResponse")
-	proto.RegisterType((*GetTaskQueueUserDataRequest)(nil), "temporal.server.api.matchingservice.v1.GetTaskQueueUserDataRequest")
-	proto.RegisterType((*GetTaskQueueUserDataResponse)(nil), "temporal.server.api.matchingservice.v1.GetTaskQueueUserDataResponse")
-	proto.RegisterType((*ApplyTaskQueueUserDataReplicationEventRequest)(nil), "temporal.server.api.matchingservice.v1.ApplyTaskQueueUserDataReplicationEventRequest")
-	proto.RegisterType((*ApplyTaskQueueUserDataReplicationEventResponse)(nil), "temporal.server.api.matchingservice.v1.ApplyTaskQueueUserDataReplicationEventResponse")
-	proto.RegisterType((*GetBuildIdTaskQueueMappingRequest)(nil), "temporal.server.api.matchingservice.v1.GetBuildIdTaskQueueMappingRequest")
-	proto.RegisterType((*GetBuildIdTaskQueueMappingResponse)(nil), "temporal.server.api.matchingservice.v1.GetBuildIdTaskQueueMappingResponse")
-	proto.RegisterType((*ForceUnloadTaskQueueRequest)(nil), "temporal.server.api.matchingservice.v1.ForceUnloadTaskQueueRequest")
-	proto.RegisterType((*ForceUnloadTaskQueueResponse)(nil), "temporal.server.api.matchingservice.v1.ForceUnloadTaskQueueResponse")
-	proto.RegisterType((*UpdateTaskQueueUserDataRequest)(nil), "temporal.server.api.matchingservice.v1.UpdateTaskQueueUserDataRequest")
-	proto.RegisterType((*UpdateTaskQueueUserDataResponse)(nil), "temporal.server.api.matchingservice.v1.UpdateTaskQueueUserDataResponse")
-	proto.RegisterType((*ReplicateTaskQueueUserDataRequest)(nil), "temporal.server.api.matchingservice.v1.ReplicateTaskQueueUserDataRequest")
-	proto.RegisterType((*ReplicateTaskQueueUserDataResponse)(nil), "temporal.server.api.matchingservice.v1.ReplicateTaskQueueUserDataResponse")
+type CleanupUnreachableBuildIdsRequest struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	TaskQueue   string `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("temporal/server/api/matchingservice/v1/request_response.proto", fileDescriptor_a429a3813476c583)
+func (m *CleanupUnreachableBuildIdsRequest) Reset()      { *m = CleanupUnreachableBuildIdsRequest{} }
+func (*CleanupUnreachableBuildIdsRequest) ProtoMessage() {}
+func (*CleanupUnreachableBuildIdsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{36}
+}
+func (m *CleanupUnreachableBuildIdsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CleanupUnreachableBuildIdsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CleanupUnreachableBuildIdsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CleanupUnreachableBuildIdsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CleanupUnreachableBuildIdsRequest.Merge(m, src)
+}
+func (m *CleanupUnreachableBuildIdsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CleanupUnreachableBuildIdsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CleanupUnreachableBuildIdsRequest.DiscardUnknown(m)
 }
 
-var fileDescriptor_a429a3813476c583 = []byte{
-	// 2302 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xdd, 0x6f, 0x1c, 0x57,
-	0x15, 0xf7, 0xd8, 0x6b, 0x7b, 0xf7, 0xec, 0xda, 0x5e, 0x0f, 0x6d, 0x32, 0x76, 0x9c, 0xb5, 0x3d,
-	0x4d, 0x13, 0x27, 0x4a, 0xd7, 0xc4, 0xd0, 0xa8, 0x2d, 0xa4, 0xc5, 0x71, 0xdc, 0xc4, 0x6d, 0x52,
-	0x92, 0x89, 0x13, 0x50, 0x8a, 0x34, 0xbd, 0x3b, 0x73, 0xb3, 0x1e, 0x3c, 0x3b, 0x33, 0x99, 0x7b,
-	0x67, 0xb7, 0xe6, 0x89, 0x77, 0x5e, 0x8a, 0x78, 0x01, 0xf1, 0x8e, 0x00, 0x09, 0x09, 0x09, 0x5e,
-	0xf8, 0x03, 0x90, 0x78, 0xe0, 0x21, 0x8f, 0x7d, 0x83, 0x38, 0x12, 0x42, 0xc0, 0x43, 0xf9, 0x0f,
-	0xd0, 0xfd, 0x98, 0x99, 0xfd, 0x18, 0xef, 0xae, 0x37, 0x0e, 0x45, 0xbc, 0xed, 0x9c, 0x7b, 0xce,
-	0xb9, 0xe7, 0x9c, 0xfb, 0x3b, 0x1f, 0xf7, 0xda, 0x70, 0x8d, 0xe2, 0x46, 0xe0, 0x87, 0xc8, 0x5d,
-	0x27, 0x38, 0x6c, 0xe2, 0x70, 0x1d, 0x05, 0xce, 0x7a, 0x03, 0x51, 0x6b, 0xcf, 0xf1, 0xea, 0x8c,
-	0xe4, 0x58, 0x78, 0xbd, 0x79, 0x65, 0x3d, 0xc4, 0x4f, 0x22, 0x4c, 0xa8, 0x19, 0x62, 0x12, 0xf8,
-	0x1e, 0xc1, 0xd5, 0x20, 0xf4, 0xa9, 0xaf, 0x9e, 0x8f, 0xc5, 0xab, 0x42, 0xbc, 0x8a, 0x02, 0xa7,
-	0xda, 0x25, 0x5e, 0x6d, 0x5e, 0x59, 0xac, 0xd4, 0x7d, 0xbf, 0xee, 0xe2, 0x75, 0x2e, 0x55, 0x8b,
-	0x1e, 0xaf, 0xdb, 0x51, 0x88, 0xa8, 0xe3, 0x7b, 0x42, 0xcf, 0xe2, 0x72, 0xf7, 0x3a, 0x75, 0x1a,
-	0x98, 0x50, 0xd4, 0x08, 0x24, 0xc3, 0xaa, 0x8d, 0x03, 0xec, 0xd9, 0xd8, 0xb3, 0x1c, 0x4c, 0xd6,
-	0xeb, 0x7e, 0xdd, 0xe7, 0x74, 0xfe, 0x4b, 0xb2, 0x9c, 0x4b, 0x5c, 0x61, 0x3e, 0x58, 0x7e, 0xa3,
-	0xe1, 0x7b, 0xcc, 0xf4, 0x06, 0x26, 0x04, 0xd5, 0xa5, 0xc5, 0x8b, 0xe7, 0x3b, 0xb8, 0xb0, 0x17,
-	0x35, 0x08, 0x63, 0xa2, 0x88, 0xec, 0x9b, 0x4f, 0x22, 0x1c, 0xc5, 0x7c, 0x17, 0x3a, 0xf8, 0xd8,
-	0x32, 0x5f, 0xed, 0x55, 0xf8, 0x5a, 0x07, 0xe3, 0x93, 0x08, 0x87, 0x07, 0x83, 0x76, 0xe5, 0x34,
-	0xcb, 0x77, 0x7b, 0xf9, 0x2e, 0x65, 0x1d, 0x87, 0xe5, 0xfa, 0xd6, 0x7e, 0x2f, 0xef, 0x85, 0x2c,
-	0xde, 0x0e, 0x87, 0x24, 0xe3, 0xe5, 0x2c, 0xc6, 0x3d, 0x87, 0x50, 0x3f, 0xcb, 0xd4, 0xaf, 0x67,
-	0x71, 0x07, 0x38, 0x24, 0x0e, 0xa1, 0xd8, 0x13, 0x68, 0x48, 0xa3, 0x45, 0xa4, 0x54, 0x35, 0x4b,
-	0xaa, 0x4f, 0xd4, 0xae, 0x76, 0x04, 0xa4, 0xe5, 0x87, 0xfb, 0x8f, 0x5d, 0xbf, 0x35, 0x10, 0x70,
-	0xfa, 0x3f, 0x15, 0x58, 0xba, 0xeb, 0xbb, 0xee, 0x77, 0xa4, 0xc4, 0x2e, 0x22, 0xfb, 0xf7, 0xd8,
-	0x16, 0x86, 0xe0, 0x57, 0x57, 0xa1, 0xe4, 0xa1, 0x06, 0x26, 0x01, 0xb2, 0xb0, 0xe9, 0xd8, 0x9a,
-	0xb2, 0xa2, 0xac, 0x15, 0x8c, 0x62, 0x42, 0xdb, 0xb1, 0xd5, 0x33, 0x50, 0x08, 0x7c, 0xd7, 0xc5,
-	0x21, 0x5b, 0x1f, 0xe7, 0xeb, 0x79, 0x41, 0xd8, 0xb1, 0xd5, 0x4f, 0xa0, 0xc4, 0x7e, 0x9b, 0x72,
-	0x7f, 0x6d, 0x62, 0x45, 0x59, 0x2b, 0x6e, 0x5c, 0x4b, 0xfc, 0xe3, 0x08, 0xef, 0xb2, 0xb7, 0xda,
-	0xbc, 0x52, 0xed, 0x67, 0x94, 0x51, 0x64, 0x2a, 0x63, 0x0b, 0x2f, 0x42, 0xf9, 0xb1, 0x1f, 0xb6,
-	0x50, 0x68, 0x63, 0xdb, 0x24, 0x7e, 0x14, 0x5a, 0x58, 0xcb, 0x71, 0x2b, 0xe6, 0x12, 0xfa, 0x7d,
-	0x4e, 0xd6, 0xff, 0x5c, 0x80, 0xb3, 0x47, 0x28, 0x16, 0x51, 0x51, 0xcf, 0x02, 0xf0, 0xc3, 0xa0,
-	0xfe, 0x3e, 0xf6, 0xb8, 0xb3, 0x25, 0xa3, 0xc0, 0x28, 0xbb, 0x8c, 0xa0, 0x7e, 0x17, 0xd4, 0xd8,
-	0x56, 0x13, 0x7f, 0x8a, 0xad, 0x88, 0xe5, 0x1c, 0xf7, 0xb9, 0xb8, 0x71, 0xb1, 0xd3, 0x27, 0x91,
-	0x30, 0xcc, 0x95, 0x78, 0xb7, 0xed, 0x58, 0xc0, 0x98, 0x6f, 0x75, 0x93, 0xd4, 0x1d, 0x98, 0x49,
-	0x34, 0xd3, 0x83, 0x00, 0xcb, 0x40, 0x9d, 0x1b, 0xa4, 0x74, 0xf7, 0x20, 0xc0, 0x46, 0xa9, 0xd5,
-	0xf6, 0xa5, 0xbe, 0x0d, 0x0b, 0x41, 0x88, 0x9b, 0x8e, 0x1f, 0x11, 0x93, 0x50, 0x14, 0x52, 0x6c,
-	0x9b, 0xb8, 0x89, 0x3d, 0xca, 0xce, 0x87, 0x45, 0x66, 0xc2, 0x38, 0x15, 0x33, 0xdc, 0x17, 0xeb,
-	0xdb, 0x6c, 0x79, 0xc7, 0x56, 0xd7, 0xa0, 0xdc, 0x23, 0x31, 0xc9, 0x25, 0x66, 0x49, 0x27, 0xa7,
-	0x06, 0xd3, 0x88, 0x32, 0xdb, 0xa8, 0x36, 0xb5, 0xa2, 0xac, 0x4d, 0x1a, 0xf1, 0xa7, 0xaa, 0xc3,
-	0x8c, 0x87, 0x3f, 0xa5, 0xa9, 0x82, 0x69, 0xae, 0xa0, 0xc8, 0x88, 0xb1, 0xf4, 0x65, 0x50, 0x6b,
-	0xc8, 0xda, 0x77, 0xfd, 0xba, 0x69, 0xf9, 0x91, 0x47, 0xcd, 0x3d, 0xc7, 0xa3, 0x5a, 0x9e, 0x33,
-	0x96, 0xe5, 0xca, 0x16, 0x5b, 0xb8, 0xe5, 0x78, 0x54, 0x7d, 0x0b, 0x34, 0x42, 0x1d, 0x6b, 0xff,
-	0x20, 0x8d, 0xb9, 0x89, 0x3d, 0x54, 0x73, 0xb1, 0xad, 0x15, 0x56, 0x94, 0xb5, 0xbc, 0x71, 0x4a,
-	0xac, 0x27, 0xe1, 0xdc, 0x16, 0xab, 0xea, 0x3b, 0x30, 0xc9, 0x2b, 0x88, 0x06, 0x59, 0xd1, 0xe4,
-	0x4b, 0xed, 0xc1, 0xbc, 0xc7, 0x08, 0x86, 0x10, 0x51, 0x9f, 0xc0, 0x69, 0x1a, 0x22, 0x8f, 0x38,
-	0xcc, 0x8d, 0xf4, 0x6c, 0x10, 0xd9, 0xd7, 0x8a, 0x5c, 0xdb, 0xdb, 0xd5, 0xac, 0x6a, 0x2d, 0x0b,
-	0x01, 0x53, 0xbb, 0x1b, 0x8b, 0xb7, 0xe3, 0x6d, 0xc7, 0x7b, 0xec, 0x1b, 0xaf, 0xd2, 0xac, 0x25,
-	0xb5, 0x0e, 0x67, 0x7b, 0xe1, 0x65, 0xa6, 0xd5, 0x41, 0x2b, 0x65, 0xb9, 0x91, 0x94, 0x05, 0xbe,
-	0x67, 0x02, 0xe9, 0xc5, 0x1e, 0x90, 0x25, 0x6b, 0x2c, 0xab, 0x6b, 0x21, 0xf2, 0xac, 0x3d, 0x09,
-	0xf4, 0x59, 0x0e, 0xf4, 0xa2, 0xa0, 0x09, 0xa8, 0xdf, 0x84, 0x59, 0x62, 0xed, 0x61, 0x3b, 0x72,
-	0xb1, 0x6d, 0xb2, 0xf6, 0xa1, 0xcd, 0xf1, 0xcd, 0x17, 0xab, 0xa2, 0xb7, 0x54, 0xe3, 0xde, 0x52,
-	0xdd, 0x8d, 0x7b, 0xcb, 0xf5, 0xdc, 0x67, 0x7f, 0x59, 0x56, 0x8c, 0x99, 0x44, 0x8e, 0xad, 0xa8,
-	0x5b, 0x50, 0x8a, 0x31, 0xc5, 0xd5, 0x94, 0x87, 0x54, 0x53, 0x94, 0x52, 0x5c, 0x89, 0x0b, 0xd3,
-	0xec, 0x54, 0x1c, 0x4c, 0xb4, 0xf9, 0x95, 0x89, 0xb5, 0xe2, 0x86, 0x51, 0x1d, 0xae, 0x55, 0x56,
-	0xfb, 0xe6, 0x7b, 0xf5, 0x9e, 0x50, 0xba, 0xed, 0xd1, 0xf0, 0xc0, 0x88, 0xb7, 0x50, 0xaf, 0x41,
-	0x5e, 0x96, 0x57, 0xa2, 0xa9, 0x7c, 0xbb, 0xd5, 0xce, 0x90, 0xc7, 0x1d, 0x87, 0x6d, 0x70, 0x47,
-	0x70, 0x1a, 0x89, 0xc8, 0xe2, 0x27, 0x50, 0x6a, 0xd7, 0xab, 0x96, 0x61, 0x62, 0x1f, 0x1f, 0xc8,
-	0xd2, 0xc9, 0x7e, 0x32, 0x5c, 0x36, 0x91, 0x1b, 0x61, 0x59, 0x3a, 0x86, 0xc4, 0x25, 0x17, 0x79,
-	0x67, 0xfc, 0x2d, 0xe5, 0x83, 0x5c, 0x7e, 0xa6, 0x3c, 0x9b, 0x14, 0xef, 0x4d, 0x8b, 0x3a, 0x4d,
-	0x87, 0x1e, 0xfc, 0x4f, 0x15, 0xef, 0xa3, 0x8c, 0x1a, 0xbd, 0x78, 0xe7, 0x45, 0xf1, 0xce, 0x50,
-	0xfc, 0x65, 0x17, 0xef, 0x65, 0x28, 0x22, 0x69, 0x15, 0x0b, 0xe3, 0x04, 0x77, 0x00, 0x62, 0xd2,
-	0x8e, 0xcd, 0xaa, 0x7b, 0xc2, 0xc0, 0xab, 0x7b, 0xae, 0x7f, 0x75, 0x4f, 0x7c, 0xe4, 0xd5, 0x1d,
-	0xb5, 0x7d, 0xa9, 0x57, 0x61, 0xd2, 0xf1, 0x82, 0x88, 0xf2, 0xba, 0x5c, 0xdc, 0x58, 0x39, 0x4a,
-	0xc5, 0x5d, 0x74, 0xe0, 0xfa, 0xc8, 0x26, 0x86, 0x60, 0xcf, 0xc8, 0xe7, 0xa9, 0xd1, 0xf2, 0xf9,
-	0x11, 0x2c, 0xc4, 0x04, 0x93, 0xfa, 0xa6, 0xe5, 0xfa, 0x04, 0x73, 0x85, 0x7e, 0x44, 0x79, 0xad,
-	0x2f, 0x6e, 0x2c, 0xf4, 0xe8, 0xbc, 0x21, 0xe7, 0xd3, 0xeb, 0xb9, 0x9f, 0x32, 0x95, 0xa7, 0x62,
-	0x0d, 0xbb, 0xfe, 0x16, 0x93, 0xdf, 0x15, 0xe2, 0x3d, 0xb5, 0x22, 0x3f, 0x4a, 0xad, 0xd8, 0x85,
-	0x53, 0xfc, 0xb3, 0xd7, 0xba, 0xc2, 0x70, 0xd6, 0x7d, 0x85, 0x8b, 0x77, 0x99, 0x76, 0x1b, 0xe6,
-	0xf7, 0x30, 0x0a, 0x69, 0x0d, 0x23, 0x9a, 0x28, 0x84, 0xe1, 0x14, 0x96, 0x13, 0xc9, 0x58, 0x5b,
-	0x5b, 0xfb, 0x2c, 0x76, 0xb6, 0x4f, 0x0c, 0x15, 0x2b, 0x0a, 0x43, 0xd6, 0x74, 0x24, 0xc9, 0xec,
-	0x3a, 0xb7, 0xd2, 0x90, 0x41, 0x39, 0x23, 0xf5, 0x6c, 0x0a, 0x35, 0xf7, 0x3b, 0x4e, 0xf1, 0x4e,
-	0xbb, 0x3b, 0x36, 0xa6, 0xc8, 0x71, 0x89, 0x36, 0x33, 0x24, 0xa4, 0x52, 0x7f, 0x6e, 0x08, 0xc9,
-	0xde, 0xf1, 0x65, 0x76, 0xe4, 0xf1, 0xe5, 0x8d, 0xb6, 0x34, 0x4d, 0x2a, 0x15, 0x6f, 0x3e, 0x85,
-	0x34, 0xf7, 0x3e, 0x8a, 0x17, 0xd4, 0xab, 0x30, 0xb5, 0x87, 0x91, 0x8d, 0x43, 0xd9, 0x58, 0x2a,
-	0x47, 0x6d, 0x79, 0x8b, 0x73, 0x19, 0x92, 0x5b, 0xff, 0x5b, 0x0e, 0x4e, 0x6d, 0xda, 0x76, 0x7b,
-	0x6b, 0x38, 0x46, 0xd9, 0xbc, 0x09, 0x85, 0x17, 0x28, 0x21, 0xa9, 0xac, 0xba, 0x25, 0x6b, 0x96,
-	0xe8, 0xef, 0x13, 0xc7, 0xe8, 0xef, 0xbc, 0xb2, 0x89, 0x76, 0x7e, 0x19, 0xd4, 0x14, 0x23, 0x5d,
-	0xa3, 0x5e, 0x39, 0x59, 0x89, 0x87, 0xaf, 0xae, 0x04, 0x96, 0xb9, 0x22, 0x11, 0x3d, 0x79, 0xec,
-	0x04, 0xe6, 0x23, 0x64, 0x8c, 0xeb, 0xac, 0x7a, 0x3e, 0x95, 0x59, 0xcf, 0xd5, 0x6f, 0xc1, 0x94,
-	0x64, 0x60, 0x45, 0x63, 0x76, 0x63, 0x2d, 0xb3, 0xa3, 0xf3, 0x0b, 0x58, 0xec, 0xb8, 0x90, 0x34,
-	0xa4, 0x9c, 0xfa, 0x1e, 0x4c, 0xf2, 0xbb, 0x9c, 0xcc, 0xeb, 0x8b, 0x99, 0x0a, 0x38, 0x07, 0x53,
-	0xf0, 0x10, 0x5b, 0xd4, 0x0f, 0xb7, 0xd8, 0xa7, 0x21, 0xe4, 0x54, 0x0b, 0xe6, 0x9b, 0xec, 0x26,
-	0xe6, 0x7b, 0xa6, 0xed, 0x84, 0x98, 0x95, 0x59, 0x2c, 0x73, 0xfa, 0x6a, 0xa6, 0xb2, 0x9e, 0xa3,
-	0x78, 0x28, 0xc4, 0x6f, 0xc4, 0xd2, 0x46, 0xb9, 0xd9, 0x45, 0xd1, 0x17, 0xe0, 0x74, 0x0f, 0xce,
-	0x44, 0xc3, 0xd2, 0xff, 0x25, 0x30, 0xd8, 0xde, 0xd1, 0xbe, 0x7c, 0x0c, 0xe6, 0x4e, 0x12, 0x83,
-	0x93, 0xa3, 0x60, 0x70, 0xea, 0xe4, 0x31, 0x38, 0x3d, 0x08, 0x83, 0xf9, 0xff, 0x67, 0x0c, 0x7e,
-	0x90, 0xcb, 0x4f, 0x94, 0x73, 0x12, 0x89, 0x9d, 0x68, 0x93, 0x48, 0xfc, 0xc7, 0x38, 0xbc, 0xc2,
-	0xa7, 0xcc, 0x18, 0x28, 0xc7, 0xc0, 0x61, 0x27, 0x7c, 0xc6, 0x47, 0x83, 0xcf, 0x23, 0x98, 0xe1,
-	0x63, 0x6f, 0xd7, 0xac, 0xf9, 0xe6, 0xc0, 0x59, 0x33, 0xcb, 0x6a, 0xa3, 0xc4, 0x75, 0x1d, 0x7f,
-	0xc8, 0xcc, 0x3e, 0x8d, 0xc9, 0x13, 0xae, 0x08, 0xbf, 0x52, 0xe0, 0xd5, 0x2e, 0xb3, 0xe5, 0x04,
-	0xbb, 0x05, 0xa5, 0x38, 0x0a, 0x24, 0x72, 0x29, 0x8f, 0xf6, 0x30, 0x0d, 0xb9, 0x28, 0xfd, 0x65,
-	0x42, 0xea, 0x87, 0x30, 0x1b, 0x2b, 0xf9, 0x3e, 0xb6, 0x28, 0xb6, 0x07, 0xdc, 0x32, 0xc4, 0xed,
-	0x42, 0xf2, 0x1a, 0x33, 0x4f, 0xda, 0x3f, 0xf5, 0x9f, 0x8c, 0xc3, 0x8a, 0x30, 0xcf, 0xe6, 0x7c,
-	0xcc, 0xc5, 0x2d, 0xbf, 0x11, 0xb8, 0x98, 0x31, 0xff, 0x97, 0x41, 0x72, 0x1a, 0xa6, 0xb9, 0x92,
-	0x64, 0xc6, 0x9e, 0x62, 0x9f, 0x3b, 0xb6, 0xea, 0xc1, 0xbc, 0x15, 0x1b, 0x95, 0x20, 0x48, 0x14,
-	0xb2, 0xcd, 0x81, 0x08, 0x1a, 0xe4, 0x9e, 0x51, 0xb6, 0xba, 0x28, 0xfa, 0x6b, 0xb0, 0xda, 0x47,
-	0x4a, 0xe6, 0xd4, 0xbf, 0x15, 0x58, 0xda, 0x42, 0x9e, 0x85, 0xdd, 0x6f, 0x47, 0x94, 0x50, 0xe4,
-	0xd9, 0x8e, 0x57, 0xbf, 0xdb, 0x76, 0xf9, 0x19, 0x22, 0x6c, 0xb7, 0x61, 0x2e, 0x0d, 0x9b, 0x98,
-	0xac, 0xc6, 0x79, 0xa5, 0xea, 0x8a, 0x5d, 0x47, 0x89, 0xe2, 0xc1, 0xe2, 0x93, 0xd5, 0x0c, 0x6d,
-	0xff, 0x3c, 0x99, 0x61, 0xa3, 0xe3, 0xc6, 0x98, 0xeb, 0xbc, 0x31, 0xea, 0xcb, 0x70, 0xf6, 0x08,
-	0x97, 0x65, 0x50, 0x7e, 0xae, 0x80, 0x76, 0x03, 0x13, 0x2b, 0x74, 0x6a, 0x78, 0x94, 0xfb, 0xea,
-	0xf7, 0xa0, 0x64, 0x63, 0x62, 0x25, 0x87, 0x3c, 0xde, 0xfd, 0x14, 0x73, 0xc4, 0x21, 0x1f, 0xb5,
-	0xa7, 0x51, 0x64, 0xea, 0xe2, 0x73, 0xfd, 0xbd, 0x02, 0x0b, 0x19, 0x9c, 0x32, 0x3b, 0xdf, 0x83,
-	0x69, 0xe1, 0x28, 0xd1, 0x14, 0xfe, 0x2a, 0xf0, 0x7a, 0x9f, 0xd8, 0xdd, 0x15, 0x21, 0xf1, 0x1e,
-	0xfb, 0x46, 0x2c, 0xa5, 0x3e, 0x84, 0xf9, 0xb6, 0xd3, 0x24, 0x14, 0xd1, 0x88, 0x48, 0x0f, 0x2e,
-	0x0d, 0x73, 0x0c, 0xf7, 0xb9, 0x84, 0x31, 0x47, 0x3b, 0x09, 0xfa, 0x2f, 0x14, 0xa8, 0xdc, 0x76,
-	0x08, 0x4d, 0x18, 0xef, 0xa2, 0x90, 0x3a, 0xac, 0x55, 0x92, 0x38, 0xb4, 0x4b, 0x50, 0x48, 0x87,
-	0x69, 0x11, 0xd7, 0x94, 0xd0, 0x13, 0xf8, 0x89, 0x97, 0x93, 0xc0, 0xfa, 0xcf, 0xc6, 0x61, 0xf9,
-	0x48, 0x43, 0x65, 0x94, 0x7f, 0x00, 0x95, 0xf4, 0xae, 0x9c, 0x46, 0x2b, 0x48, 0x38, 0x65, 0xf0,
-	0xdf, 0x1c, 0x66, 0xf3, 0x44, 0xff, 0x1d, 0x4c, 0x91, 0x8d, 0x28, 0x32, 0xce, 0xa0, 0xee, 0xf7,
-	0x83, 0xd4, 0x06, 0xb6, 0x77, 0xc7, 0x4b, 0x5f, 0xef, 0xde, 0xe3, 0x2f, 0xb4, 0x77, 0xab, 0xfb,
-	0x21, 0x2a, 0xdd, 0x5b, 0xff, 0xad, 0x02, 0x17, 0x1e, 0x04, 0x36, 0xa2, 0x98, 0xb5, 0x05, 0x1c,
-	0x5e, 0x8f, 0x1c, 0xd7, 0xde, 0xb1, 0x59, 0x5d, 0x41, 0xd4, 0xa9, 0x39, 0xae, 0x43, 0x0f, 0x8e,
-	0x91, 0x28, 0x35, 0x98, 0xee, 0xcc, 0x91, 0x5b, 0x03, 0x73, 0x64, 0xc8, 0xdd, 0x8d, 0x58, 0xb1,
-	0x7e, 0x09, 0xd6, 0x06, 0xcb, 0xc8, 0xc4, 0xff, 0xb5, 0x02, 0xe7, 0x6e, 0x62, 0x7a, 0x22, 0xbe,
-	0x99, 0xdd, 0xbe, 0x6d, 0x0f, 0xf4, 0x6d, 0x98, 0xad, 0x53, 0xc7, 0x7e, 0xa4, 0xc0, 0xeb, 0x03,
-	0x24, 0x24, 0x5a, 0x6b, 0x90, 0x8f, 0xff, 0xa4, 0x22, 0xbb, 0xf5, 0xfb, 0x2f, 0x6a, 0x8b, 0xd0,
-	0x66, 0x24, 0x7a, 0xf5, 0x1f, 0x8f, 0xc3, 0x99, 0x9b, 0x38, 0x4d, 0x9a, 0x07, 0x04, 0x87, 0x37,
-	0x18, 0x9e, 0x86, 0x8f, 0xd8, 0xd9, 0x9e, 0xec, 0x2d, 0xb4, 0xd7, 0xf4, 0x8c, 0x36, 0x33, 0x39,
-	0x7a, 0x9b, 0x79, 0x17, 0x96, 0x5c, 0x44, 0xa8, 0xb9, 0xef, 0xf9, 0x2d, 0xcf, 0x8c, 0x08, 0x0e,
-	0x4d, 0x06, 0x7f, 0x53, 0x0e, 0x42, 0xbc, 0xba, 0x4c, 0x18, 0x1a, 0xe3, 0xf9, 0x90, 0xb1, 0xc4,
-	0xfe, 0xc8, 0xd1, 0x49, 0xd5, 0x61, 0xa6, 0x85, 0x1c, 0x6a, 0x7a, 0xb8, 0xc5, 0x05, 0x79, 0x97,
-	0xc9, 0x1b, 0x45, 0x46, 0xfc, 0x08, 0xb7, 0x18, 0xab, 0xfe, 0x3b, 0x05, 0x96, 0xb2, 0x63, 0x22,
-	0x0f, 0xe6, 0x2a, 0x68, 0x6d, 0x2e, 0xed, 0x21, 0x92, 0x1a, 0xc2, 0x03, 0x94, 0x37, 0x5e, 0x49,
-	0xac, 0xbe, 0x85, 0x48, 0x2c, 0xaf, 0x7e, 0x0c, 0x85, 0x94, 0x51, 0xa0, 0xeb, 0xdd, 0xcc, 0xc9,
-	0xaf, 0xed, 0x6f, 0x78, 0x62, 0xb4, 0xe7, 0xc6, 0x63, 0xbb, 0xd7, 0xa4, 0x7c, 0x24, 0x7f, 0xe9,
-	0x7f, 0x54, 0xe0, 0x8d, 0xcd, 0x20, 0x70, 0x0f, 0x32, 0xec, 0x0e, 0x5c, 0xc7, 0xe2, 0xd7, 0x1b,
-	0x7e, 0x47, 0x3a, 0xb9, 0xb3, 0x35, 0xda, 0x1d, 0xea, 0x99, 0xaa, 0x8f, 0x76, 0xa8, 0x9f, 0x1f,
-	0x5f, 0x85, 0xea, 0xb0, 0x6e, 0x48, 0x0c, 0x23, 0x58, 0xbd, 0x89, 0xa9, 0x04, 0x7c, 0x22, 0x76,
-	0x07, 0x05, 0x81, 0xe3, 0xd5, 0x8f, 0xe1, 0xec, 0x02, 0xe4, 0x6b, 0x4c, 0x49, 0xfa, 0x5c, 0x3d,
-	0x5d, 0x13, 0x4a, 0xf5, 0x6d, 0xd0, 0xfb, 0x6d, 0x21, 0x71, 0xb1, 0x0c, 0xc5, 0xb6, 0x3f, 0xb7,
-	0xf2, 0x5e, 0x52, 0x30, 0x20, 0x09, 0x17, 0xd1, 0x7f, 0xa3, 0xc0, 0x99, 0xf7, 0xfd, 0xd0, 0xc2,
-	0x0f, 0x3c, 0x36, 0x5c, 0x8f, 0x32, 0xa4, 0x1c, 0x3f, 0xdb, 0x26, 0x46, 0xce, 0x36, 0xfd, 0x1a,
-	0x2c, 0x65, 0x9b, 0x9b, 0xbe, 0x8a, 0xb7, 0x10, 0x31, 0xd9, 0x22, 0xb6, 0x25, 0xf4, 0x0b, 0x2d,
-	0x44, 0x6e, 0x73, 0x02, 0x1b, 0xf0, 0x2b, 0xa2, 0x88, 0xbf, 0xc4, 0xfa, 0xf2, 0x71, 0x2f, 0x06,
-	0x4f, 0x2c, 0xa9, 0xd4, 0xf3, 0x30, 0x17, 0x43, 0x82, 0x98, 0xc8, 0x66, 0x5e, 0xe6, 0xf8, 0xa9,
-	0xce, 0x48, 0x64, 0x90, 0x4d, 0x46, 0x54, 0x2f, 0xc1, 0x7c, 0xca, 0x17, 0xe2, 0x86, 0xdf, 0xc4,
-	0xb6, 0x36, 0xc9, 0x39, 0xe7, 0x62, 0x4e, 0x43, 0x90, 0xf5, 0x55, 0x58, 0x3e, 0x32, 0x28, 0x12,
-	0xd1, 0x7f, 0x50, 0xd8, 0x25, 0x40, 0xc0, 0xfd, 0x65, 0xc6, 0xee, 0x65, 0xe4, 0xef, 0x39, 0xd0,
-	0xfb, 0x99, 0x2e, 0x3c, 0xbc, 0x1e, 0x3e, 0x7d, 0x56, 0x19, 0xfb, 0xfc, 0x59, 0x65, 0xec, 0x8b,
-	0x67, 0x15, 0xe5, 0x87, 0x87, 0x15, 0xe5, 0x97, 0x87, 0x15, 0xe5, 0x4f, 0x87, 0x15, 0xe5, 0xe9,
-	0x61, 0x45, 0xf9, 0xeb, 0x61, 0x45, 0xf9, 0xfb, 0x61, 0x65, 0xec, 0x8b, 0xc3, 0x8a, 0xf2, 0xd9,
-	0xf3, 0xca, 0xd8, 0xd3, 0xe7, 0x95, 0xb1, 0xcf, 0x9f, 0x57, 0xc6, 0x1e, 0x7d, 0xb3, 0xee, 0xa7,
-	0xe6, 0x39, 0x7e, 0xff, 0x7f, 0x84, 0xf9, 0x46, 0x17, 0xa9, 0x36, 0xc5, 0x5f, 0x7b, 0xbe, 0xf6,
-	0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xae, 0x4e, 0x3d, 0x79, 0x49, 0x23, 0x00, 0x00,
+var xxx_messageInfo_CleanupUnreachableBuildIdsRequest proto.InternalMessageInfo
+
+func (m *CleanupUnreachableBuildIdsRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
 }
 
-func (this *PollWorkflowTaskQueueRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *CleanupUnreachableBuildIdsRequest) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
 	}
+	return ""
+}
 
-	that1, ok := that.(*PollWorkflowTaskQueueRequest)
-	if !ok {
-		that2, ok := that.(PollWorkflowTaskQueueRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type CleanupUnreachableBuildIdsResponse struct {
+	// The build ids that were removed from the versioning data of the task queue.
+	RemovedBuildIds []string `protobuf:"bytes,1,rep,name=removed_build_ids,json=removedBuildIds,proto3" json:"removed_build_ids,omitempty"`
+}
+
+func (m *CleanupUnreachableBuildIdsResponse) Reset()      { *m = CleanupUnreachableBuildIdsResponse{} }
+func (*CleanupUnreachableBuildIdsResponse) ProtoMessage() {}
+func (*CleanupUnreachableBuildIdsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{37}
+}
+func (m *CleanupUnreachableBuildIdsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CleanupUnreachableBuildIdsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CleanupUnreachableBuildIdsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.NamespaceId != that1.NamespaceId {
-		return false
-	}
-	if this.PollerId != that1.PollerId {
-		return false
+}
+func (m *CleanupUnreachableBuildIdsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CleanupUnreachableBuildIdsResponse.Merge(m, src)
+}
+func (m *CleanupUnreachableBuildIdsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CleanupUnreachableBuildIdsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CleanupUnreachableBuildIdsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CleanupUnreachableBuildIdsResponse proto.InternalMessageInfo
+
+func (m *CleanupUnreachableBuildIdsResponse) GetRemovedBuildIds() []string {
+	if m != nil {
+		return m.RemovedBuildIds
 	}
-	if !this.PollRequest.Equal(that1.PollRequest) {
-		return false
+	return nil
+}
+
+type GetDefaultBuildIdTimelineRequest struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	TaskQueue   string `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+}
+
+func (m *GetDefaultBuildIdTimelineRequest) Reset()      { *m = GetDefaultBuildIdTimelineRequest{} }
+func (*GetDefaultBuildIdTimelineRequest) ProtoMessage() {}
+func (*GetDefaultBuildIdTimelineRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{38}
+}
+func (m *GetDefaultBuildIdTimelineRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetDefaultBuildIdTimelineRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetDefaultBuildIdTimelineRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.ForwardedSource != that1.ForwardedSource {
-		return false
+}
+func (m *GetDefaultBuildIdTimelineRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDefaultBuildIdTimelineRequest.Merge(m, src)
+}
+func (m *GetDefaultBuildIdTimelineRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetDefaultBuildIdTimelineRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDefaultBuildIdTimelineRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDefaultBuildIdTimelineRequest proto.InternalMessageInfo
+
+func (m *GetDefaultBuildIdTimelineRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
-	return true
+	return ""
 }
-func (this *PollWorkflowTaskQueueResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *GetDefaultBuildIdTimelineRequest) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
 	}
+	return ""
+}
 
-	that1, ok := that.(*PollWorkflowTaskQueueResponse)
-	if !ok {
-		that2, ok := that.(PollWorkflowTaskQueueResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type GetDefaultBuildIdTimelineResponse struct {
+	// Default build id transitions of the task queue, ordered by timestamp.
+	Timeline []*v110.VersioningAuditEntry `protobuf:"bytes,1,rep,name=timeline,proto3" json:"timeline,omitempty"`
+}
+
+func (m *GetDefaultBuildIdTimelineResponse) Reset()      { *m = GetDefaultBuildIdTimelineResponse{} }
+func (*GetDefaultBuildIdTimelineResponse) ProtoMessage() {}
+func (*GetDefaultBuildIdTimelineResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{39}
+}
+func (m *GetDefaultBuildIdTimelineResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetDefaultBuildIdTimelineResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetDefaultBuildIdTimelineResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.TaskToken, that1.TaskToken) {
-		return false
-	}
-	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
-		return false
+}
+func (m *GetDefaultBuildIdTimelineResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDefaultBuildIdTimelineResponse.Merge(m, src)
+}
+func (m *GetDefaultBuildIdTimelineResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetDefaultBuildIdTimelineResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDefaultBuildIdTimelineResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDefaultBuildIdTimelineResponse proto.InternalMessageInfo
+
+func (m *GetDefaultBuildIdTimelineResponse) GetTimeline() []*v110.VersioningAuditEntry {
+	if m != nil {
+		return m.Timeline
 	}
-	if !this.WorkflowType.Equal(that1.WorkflowType) {
-		return false
+	return nil
+}
+
+type ValidateDefaultBuildIdSwitchRequest struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	TaskQueue   string `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	// The build id proposed as the new default of the task queue.
+	BuildId string `protobuf:"bytes,3,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+}
+
+func (m *ValidateDefaultBuildIdSwitchRequest) Reset()      { *m = ValidateDefaultBuildIdSwitchRequest{} }
+func (*ValidateDefaultBuildIdSwitchRequest) ProtoMessage() {}
+func (*ValidateDefaultBuildIdSwitchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{40}
+}
+func (m *ValidateDefaultBuildIdSwitchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidateDefaultBuildIdSwitchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidateDefaultBuildIdSwitchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.PreviousStartedEventId != that1.PreviousStartedEventId {
-		return false
+}
+func (m *ValidateDefaultBuildIdSwitchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateDefaultBuildIdSwitchRequest.Merge(m, src)
+}
+func (m *ValidateDefaultBuildIdSwitchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidateDefaultBuildIdSwitchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateDefaultBuildIdSwitchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateDefaultBuildIdSwitchRequest proto.InternalMessageInfo
+
+func (m *ValidateDefaultBuildIdSwitchRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
-	if this.StartedEventId != that1.StartedEventId {
-		return false
+	return ""
+}
+
+func (m *ValidateDefaultBuildIdSwitchRequest) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
 	}
-	if this.Attempt != that1.Attempt {
-		return false
+	return ""
+}
+
+func (m *ValidateDefaultBuildIdSwitchRequest) GetBuildId() string {
+	if m != nil {
+		return m.BuildId
 	}
-	if this.NextEventId != that1.NextEventId {
-		return false
+	return ""
+}
+
+type ValidateDefaultBuildIdSwitchResponse struct {
+	// Number of open workflows already processed by a build id of the task queue, which would keep running on their
+	// version set.
+	StayingWorkflowCount int64 `protobuf:"varint,1,opt,name=staying_workflow_count,json=stayingWorkflowCount,proto3" json:"staying_workflow_count,omitempty"`
+	// Number of open workflows not yet processed by any build id, which would move to the new default.
+	MovingWorkflowCount int64 `protobuf:"varint,2,opt,name=moving_workflow_count,json=movingWorkflowCount,proto3" json:"moving_workflow_count,omitempty"`
+}
+
+func (m *ValidateDefaultBuildIdSwitchResponse) Reset()      { *m = ValidateDefaultBuildIdSwitchResponse{} }
+func (*ValidateDefaultBuildIdSwitchResponse) ProtoMessage() {}
+func (*ValidateDefaultBuildIdSwitchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{41}
+}
+func (m *ValidateDefaultBuildIdSwitchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidateDefaultBuildIdSwitchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidateDefaultBuildIdSwitchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.BacklogCountHint != that1.BacklogCountHint {
-		return false
+}
+func (m *ValidateDefaultBuildIdSwitchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateDefaultBuildIdSwitchResponse.Merge(m, src)
+}
+func (m *ValidateDefaultBuildIdSwitchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidateDefaultBuildIdSwitchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateDefaultBuildIdSwitchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateDefaultBuildIdSwitchResponse proto.InternalMessageInfo
+
+func (m *ValidateDefaultBuildIdSwitchResponse) GetStayingWorkflowCount() int64 {
+	if m != nil {
+		return m.StayingWorkflowCount
 	}
-	if this.StickyExecutionEnabled != that1.StickyExecutionEnabled {
-		return false
+	return 0
+}
+
+func (m *ValidateDefaultBuildIdSwitchResponse) GetMovingWorkflowCount() int64 {
+	if m != nil {
+		return m.MovingWorkflowCount
 	}
-	if !this.Query.Equal(that1.Query) {
-		return false
+	return 0
+}
+
+type GetClosedWorkflowBuildIdRequest struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// The workflow task queue the workflow ran on.
+	TaskQueue string                 `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	Execution *v11.WorkflowExecution `protobuf:"bytes,3,opt,name=execution,proto3" json:"execution,omitempty"`
+}
+
+func (m *GetClosedWorkflowBuildIdRequest) Reset()      { *m = GetClosedWorkflowBuildIdRequest{} }
+func (*GetClosedWorkflowBuildIdRequest) ProtoMessage() {}
+func (*GetClosedWorkflowBuildIdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{42}
+}
+func (m *GetClosedWorkflowBuildIdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetClosedWorkflowBuildIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetClosedWorkflowBuildIdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !this.TransientWorkflowTask.Equal(that1.TransientWorkflowTask) {
-		return false
+}
+func (m *GetClosedWorkflowBuildIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetClosedWorkflowBuildIdRequest.Merge(m, src)
+}
+func (m *GetClosedWorkflowBuildIdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetClosedWorkflowBuildIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetClosedWorkflowBuildIdRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetClosedWorkflowBuildIdRequest proto.InternalMessageInfo
+
+func (m *GetClosedWorkflowBuildIdRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
-	if !this.WorkflowExecutionTaskQueue.Equal(that1.WorkflowExecutionTaskQueue) {
-		return false
+	return ""
+}
+
+func (m *GetClosedWorkflowBuildIdRequest) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
 	}
-	if !bytes.Equal(this.BranchToken, that1.BranchToken) {
-		return false
+	return ""
+}
+
+func (m *GetClosedWorkflowBuildIdRequest) GetExecution() *v11.WorkflowExecution {
+	if m != nil {
+		return m.Execution
 	}
-	if that1.ScheduledTime == nil {
-		if this.ScheduledTime != nil {
-			return false
+	return nil
+}
+
+type GetClosedWorkflowBuildIdResponse struct {
+	// The build id of the worker that completed the last workflow task of the workflow. Empty if the workflow never
+	// ran on a worker with a build id.
+	BuildId string `protobuf:"bytes,1,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	// Whether the build id is still registered in the versioning data of the task queue, i.e. it was not deleted.
+	Registered bool `protobuf:"varint,2,opt,name=registered,proto3" json:"registered,omitempty"`
+}
+
+func (m *GetClosedWorkflowBuildIdResponse) Reset()      { *m = GetClosedWorkflowBuildIdResponse{} }
+func (*GetClosedWorkflowBuildIdResponse) ProtoMessage() {}
+func (*GetClosedWorkflowBuildIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{43}
+}
+func (m *GetClosedWorkflowBuildIdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetClosedWorkflowBuildIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetClosedWorkflowBuildIdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if !this.ScheduledTime.Equal(*that1.ScheduledTime) {
-		return false
+		return b[:n], nil
 	}
-	if that1.StartedTime == nil {
-		if this.StartedTime != nil {
-			return false
-		}
-	} else if !this.StartedTime.Equal(*that1.StartedTime) {
-		return false
+}
+func (m *GetClosedWorkflowBuildIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetClosedWorkflowBuildIdResponse.Merge(m, src)
+}
+func (m *GetClosedWorkflowBuildIdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetClosedWorkflowBuildIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetClosedWorkflowBuildIdResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetClosedWorkflowBuildIdResponse proto.InternalMessageInfo
+
+func (m *GetClosedWorkflowBuildIdResponse) GetBuildId() string {
+	if m != nil {
+		return m.BuildId
 	}
-	if len(this.Queries) != len(that1.Queries) {
-		return false
+	return ""
+}
+
+func (m *GetClosedWorkflowBuildIdResponse) GetRegistered() bool {
+	if m != nil {
+		return m.Registered
 	}
-	for i := range this.Queries {
-		if !this.Queries[i].Equal(that1.Queries[i]) {
-			return false
+	return false
+}
+
+type GetUserDataPropagationStatusRequest struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// Maximum number of task queues with user data to inspect, a default is used if not set.
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken []byte `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (m *GetUserDataPropagationStatusRequest) Reset()      { *m = GetUserDataPropagationStatusRequest{} }
+func (*GetUserDataPropagationStatusRequest) ProtoMessage() {}
+func (*GetUserDataPropagationStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{44}
+}
+func (m *GetUserDataPropagationStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetUserDataPropagationStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetUserDataPropagationStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(this.Messages) != len(that1.Messages) {
-		return false
+}
+func (m *GetUserDataPropagationStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUserDataPropagationStatusRequest.Merge(m, src)
+}
+func (m *GetUserDataPropagationStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetUserDataPropagationStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUserDataPropagationStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUserDataPropagationStatusRequest proto.InternalMessageInfo
+
+func (m *GetUserDataPropagationStatusRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
-	for i := range this.Messages {
-		if !this.Messages[i].Equal(that1.Messages[i]) {
-			return false
-		}
+	return ""
+}
+
+func (m *GetUserDataPropagationStatusRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
 	}
-	return true
+	return 0
 }
-func (this *PollActivityTaskQueueRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *GetUserDataPropagationStatusRequest) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
 	}
+	return nil
+}
 
-	that1, ok := that.(*PollActivityTaskQueueRequest)
-	if !ok {
-		that2, ok := that.(PollActivityTaskQueueRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type GetUserDataPropagationStatusResponse struct {
+	// Task queues of the namespace with versioning data in this page, in no particular order.
+	TaskQueues []*GetUserDataPropagationStatusResponse_TaskQueueStatus `protobuf:"bytes,1,rep,name=task_queues,json=taskQueues,proto3" json:"task_queues,omitempty"`
+	// Token to fetch the next page, empty if this is the last page.
+	NextPageToken []byte `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (m *GetUserDataPropagationStatusResponse) Reset()      { *m = GetUserDataPropagationStatusResponse{} }
+func (*GetUserDataPropagationStatusResponse) ProtoMessage() {}
+func (*GetUserDataPropagationStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{45}
+}
+func (m *GetUserDataPropagationStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetUserDataPropagationStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetUserDataPropagationStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *GetUserDataPropagationStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUserDataPropagationStatusResponse.Merge(m, src)
+}
+func (m *GetUserDataPropagationStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetUserDataPropagationStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUserDataPropagationStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUserDataPropagationStatusResponse proto.InternalMessageInfo
+
+func (m *GetUserDataPropagationStatusResponse) GetTaskQueues() []*GetUserDataPropagationStatusResponse_TaskQueueStatus {
+	if m != nil {
+		return m.TaskQueues
 	}
-	if this.NamespaceId != that1.NamespaceId {
-		return false
+	return nil
+}
+
+func (m *GetUserDataPropagationStatusResponse) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
 	}
-	if this.PollerId != that1.PollerId {
-		return false
+	return nil
+}
+
+type GetUserDataPropagationStatusResponse_TaskQueueStatus struct {
+	TaskQueue string `protobuf:"bytes,1,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	// Version of the user data owned by the root partition of the workflow task queue.
+	UserDataVersion int64 `protobuf:"varint,2,opt,name=user_data_version,json=userDataVersion,proto3" json:"user_data_version,omitempty"`
+	// Number of partitions, of both task queue types, which have loaded that version.
+	PropagatedPartitions int32 `protobuf:"varint,3,opt,name=propagated_partitions,json=propagatedPartitions,proto3" json:"propagated_partitions,omitempty"`
+	TotalPartitions      int32 `protobuf:"varint,4,opt,name=total_partitions,json=totalPartitions,proto3" json:"total_partitions,omitempty"`
+	// Whether all partitions have loaded that version, as opposed to only part of them.
+	FullyPropagated bool `protobuf:"varint,5,opt,name=fully_propagated,json=fullyPropagated,proto3" json:"fully_propagated,omitempty"`
+	// Number of partitions which are not loaded. They are counted as propagated since they load the latest
+	// version when they are next loaded.
+	UnloadedPartitions int32 `protobuf:"varint,6,opt,name=unloaded_partitions,json=unloadedPartitions,proto3" json:"unloaded_partitions,omitempty"`
+	// Partitions whose user data version could not be fetched. They are not counted as propagated.
+	PartitionErrors []*GetUserDataPropagationStatusResponse_PartitionError `protobuf:"bytes,7,rep,name=partition_errors,json=partitionErrors,proto3" json:"partition_errors,omitempty"`
+}
+
+func (m *GetUserDataPropagationStatusResponse_TaskQueueStatus) Reset() {
+	*m = GetUserDataPropagationStatusResponse_TaskQueueStatus{}
+}
+func (*GetUserDataPropagationStatusResponse_TaskQueueStatus) ProtoMessage() {}
+func (*GetUserDataPropagationStatusResponse_TaskQueueStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{45, 0}
+}
+func (m *GetUserDataPropagationStatusResponse_TaskQueueStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetUserDataPropagationStatusResponse_TaskQueueStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetUserDataPropagationStatusResponse_TaskQueueStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !this.PollRequest.Equal(that1.PollRequest) {
-		return false
+}
+func (m *GetUserDataPropagationStatusResponse_TaskQueueStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUserDataPropagationStatusResponse_TaskQueueStatus.Merge(m, src)
+}
+func (m *GetUserDataPropagationStatusResponse_TaskQueueStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetUserDataPropagationStatusResponse_TaskQueueStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUserDataPropagationStatusResponse_TaskQueueStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUserDataPropagationStatusResponse_TaskQueueStatus proto.InternalMessageInfo
+
+func (m *GetUserDataPropagationStatusResponse_TaskQueueStatus) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
 	}
-	if this.ForwardedSource != that1.ForwardedSource {
-		return false
+	return ""
+}
+
+func (m *GetUserDataPropagationStatusResponse_TaskQueueStatus) GetUserDataVersion() int64 {
+	if m != nil {
+		return m.UserDataVersion
 	}
-	return true
+	return 0
 }
-func (this *PollActivityTaskQueueResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *GetUserDataPropagationStatusResponse_TaskQueueStatus) GetPropagatedPartitions() int32 {
+	if m != nil {
+		return m.PropagatedPartitions
 	}
+	return 0
+}
 
-	that1, ok := that.(*PollActivityTaskQueueResponse)
-	if !ok {
-		that2, ok := that.(PollActivityTaskQueueResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *GetUserDataPropagationStatusResponse_TaskQueueStatus) GetTotalPartitions() int32 {
+	if m != nil {
+		return m.TotalPartitions
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return 0
+}
+
+func (m *GetUserDataPropagationStatusResponse_TaskQueueStatus) GetFullyPropagated() bool {
+	if m != nil {
+		return m.FullyPropagated
 	}
-	if !bytes.Equal(this.TaskToken, that1.TaskToken) {
-		return false
+	return false
+}
+
+func (m *GetUserDataPropagationStatusResponse_TaskQueueStatus) GetUnloadedPartitions() int32 {
+	if m != nil {
+		return m.UnloadedPartitions
 	}
-	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
-		return false
+	return 0
+}
+
+func (m *GetUserDataPropagationStatusResponse_TaskQueueStatus) GetPartitionErrors() []*GetUserDataPropagationStatusResponse_PartitionError {
+	if m != nil {
+		return m.PartitionErrors
 	}
-	if this.ActivityId != that1.ActivityId {
-		return false
+	return nil
+}
+
+type GetUserDataPropagationStatusResponse_PartitionError struct {
+	TaskQueue     string            `protobuf:"bytes,1,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	TaskQueueType v19.TaskQueueType `protobuf:"varint,2,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
+	Error         string            `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *GetUserDataPropagationStatusResponse_PartitionError) Reset() {
+	*m = GetUserDataPropagationStatusResponse_PartitionError{}
+}
+func (*GetUserDataPropagationStatusResponse_PartitionError) ProtoMessage() {}
+func (*GetUserDataPropagationStatusResponse_PartitionError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{45, 1}
+}
+func (m *GetUserDataPropagationStatusResponse_PartitionError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetUserDataPropagationStatusResponse_PartitionError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetUserDataPropagationStatusResponse_PartitionError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !this.ActivityType.Equal(that1.ActivityType) {
-		return false
+}
+func (m *GetUserDataPropagationStatusResponse_PartitionError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUserDataPropagationStatusResponse_PartitionError.Merge(m, src)
+}
+func (m *GetUserDataPropagationStatusResponse_PartitionError) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetUserDataPropagationStatusResponse_PartitionError) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUserDataPropagationStatusResponse_PartitionError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUserDataPropagationStatusResponse_PartitionError proto.InternalMessageInfo
+
+func (m *GetUserDataPropagationStatusResponse_PartitionError) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
 	}
-	if !this.Input.Equal(that1.Input) {
-		return false
+	return ""
+}
+
+func (m *GetUserDataPropagationStatusResponse_PartitionError) GetTaskQueueType() v19.TaskQueueType {
+	if m != nil {
+		return m.TaskQueueType
 	}
-	if that1.ScheduledTime == nil {
-		if this.ScheduledTime != nil {
-			return false
-		}
-	} else if !this.ScheduledTime.Equal(*that1.ScheduledTime) {
-		return false
+	return v19.TASK_QUEUE_TYPE_UNSPECIFIED
+}
+
+func (m *GetUserDataPropagationStatusResponse_PartitionError) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	if this.ScheduleToCloseTimeout != nil && that1.ScheduleToCloseTimeout != nil {
-		if *this.ScheduleToCloseTimeout != *that1.ScheduleToCloseTimeout {
-			return false
+	return ""
+}
+
+type ApplyVersioningTemplateRequest struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// The workflow task queue to apply the template to. It must not have any versioning data yet.
+	TaskQueue string `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	// Name of a template of the namespace, see the matching.versioningTemplates dynamic config.
+	TemplateName string `protobuf:"bytes,3,opt,name=template_name,json=templateName,proto3" json:"template_name,omitempty"`
+}
+
+func (m *ApplyVersioningTemplateRequest) Reset()      { *m = ApplyVersioningTemplateRequest{} }
+func (*ApplyVersioningTemplateRequest) ProtoMessage() {}
+func (*ApplyVersioningTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{46}
+}
+func (m *ApplyVersioningTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplyVersioningTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplyVersioningTemplateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if this.ScheduleToCloseTimeout != nil {
-		return false
-	} else if that1.ScheduleToCloseTimeout != nil {
-		return false
+		return b[:n], nil
 	}
-	if that1.StartedTime == nil {
-		if this.StartedTime != nil {
-			return false
-		}
-	} else if !this.StartedTime.Equal(*that1.StartedTime) {
-		return false
+}
+func (m *ApplyVersioningTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyVersioningTemplateRequest.Merge(m, src)
+}
+func (m *ApplyVersioningTemplateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplyVersioningTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyVersioningTemplateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyVersioningTemplateRequest proto.InternalMessageInfo
+
+func (m *ApplyVersioningTemplateRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
-	if this.StartToCloseTimeout != nil && that1.StartToCloseTimeout != nil {
-		if *this.StartToCloseTimeout != *that1.StartToCloseTimeout {
-			return false
-		}
-	} else if this.StartToCloseTimeout != nil {
-		return false
-	} else if that1.StartToCloseTimeout != nil {
-		return false
+	return ""
+}
+
+func (m *ApplyVersioningTemplateRequest) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
 	}
-	if this.HeartbeatTimeout != nil && that1.HeartbeatTimeout != nil {
-		if *this.HeartbeatTimeout != *that1.HeartbeatTimeout {
-			return false
-		}
-	} else if this.HeartbeatTimeout != nil {
-		return false
-	} else if that1.HeartbeatTimeout != nil {
-		return false
+	return ""
+}
+
+func (m *ApplyVersioningTemplateRequest) GetTemplateName() string {
+	if m != nil {
+		return m.TemplateName
 	}
-	if this.Attempt != that1.Attempt {
-		return false
+	return ""
+}
+
+type ApplyVersioningTemplateResponse struct {
+}
+
+func (m *ApplyVersioningTemplateResponse) Reset()      { *m = ApplyVersioningTemplateResponse{} }
+func (*ApplyVersioningTemplateResponse) ProtoMessage() {}
+func (*ApplyVersioningTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{47}
+}
+func (m *ApplyVersioningTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplyVersioningTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplyVersioningTemplateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if that1.CurrentAttemptScheduledTime == nil {
-		if this.CurrentAttemptScheduledTime != nil {
-			return false
+}
+func (m *ApplyVersioningTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyVersioningTemplateResponse.Merge(m, src)
+}
+func (m *ApplyVersioningTemplateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplyVersioningTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyVersioningTemplateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyVersioningTemplateResponse proto.InternalMessageInfo
+
+type ReassignBuildIdRequest struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// The workflow task queue whose open workflows are reassigned.
+	TaskQueue string `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	// Open workflows last processed by this build id are reassigned.
+	BuildId string `protobuf:"bytes,3,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	// The build id the reassigned workflows are pinned to. It must be registered in the versioning data of the task
+	// queue.
+	TargetBuildId string `protobuf:"bytes,4,opt,name=target_build_id,json=targetBuildId,proto3" json:"target_build_id,omitempty"`
+	// Token returned by a previous call, to continue reassigning where that call stopped.
+	NextPageToken []byte `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (m *ReassignBuildIdRequest) Reset()      { *m = ReassignBuildIdRequest{} }
+func (*ReassignBuildIdRequest) ProtoMessage() {}
+func (*ReassignBuildIdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{48}
+}
+func (m *ReassignBuildIdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReassignBuildIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReassignBuildIdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if !this.CurrentAttemptScheduledTime.Equal(*that1.CurrentAttemptScheduledTime) {
-		return false
+		return b[:n], nil
 	}
-	if !this.HeartbeatDetails.Equal(that1.HeartbeatDetails) {
-		return false
+}
+func (m *ReassignBuildIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReassignBuildIdRequest.Merge(m, src)
+}
+func (m *ReassignBuildIdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReassignBuildIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReassignBuildIdRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReassignBuildIdRequest proto.InternalMessageInfo
+
+func (m *ReassignBuildIdRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
-	if !this.WorkflowType.Equal(that1.WorkflowType) {
-		return false
+	return ""
+}
+
+func (m *ReassignBuildIdRequest) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
 	}
-	if this.WorkflowNamespace != that1.WorkflowNamespace {
-		return false
+	return ""
+}
+
+func (m *ReassignBuildIdRequest) GetBuildId() string {
+	if m != nil {
+		return m.BuildId
 	}
-	if !this.Header.Equal(that1.Header) {
-		return false
+	return ""
+}
+
+func (m *ReassignBuildIdRequest) GetTargetBuildId() string {
+	if m != nil {
+		return m.TargetBuildId
 	}
-	return true
+	return ""
 }
-func (this *AddWorkflowTaskRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ReassignBuildIdRequest) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
 	}
+	return nil
+}
 
-	that1, ok := that.(*AddWorkflowTaskRequest)
-	if !ok {
-		that2, ok := that.(AddWorkflowTaskRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ReassignBuildIdResponse struct {
+	// Number of workflows of this page that were repinned onto the target build id.
+	ReassignedWorkflows int32 `protobuf:"varint,1,opt,name=reassigned_workflows,json=reassignedWorkflows,proto3" json:"reassigned_workflows,omitempty"`
+	// Number of workflows of this page still on the build id that were not safe to move, see ReassignBuildId.
+	SkippedWorkflows int32 `protobuf:"varint,2,opt,name=skipped_workflows,json=skippedWorkflows,proto3" json:"skipped_workflows,omitempty"`
+	// Token to pass to the next call to reassign the next page of workflows. Empty once all open workflows were visited.
+	NextPageToken []byte `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (m *ReassignBuildIdResponse) Reset()      { *m = ReassignBuildIdResponse{} }
+func (*ReassignBuildIdResponse) ProtoMessage() {}
+func (*ReassignBuildIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{49}
+}
+func (m *ReassignBuildIdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReassignBuildIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReassignBuildIdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.NamespaceId != that1.NamespaceId {
-		return false
-	}
-	if !this.Execution.Equal(that1.Execution) {
-		return false
+}
+func (m *ReassignBuildIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReassignBuildIdResponse.Merge(m, src)
+}
+func (m *ReassignBuildIdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReassignBuildIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReassignBuildIdResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReassignBuildIdResponse proto.InternalMessageInfo
+
+func (m *ReassignBuildIdResponse) GetReassignedWorkflows() int32 {
+	if m != nil {
+		return m.ReassignedWorkflows
 	}
-	if !this.TaskQueue.Equal(that1.TaskQueue) {
-		return false
+	return 0
+}
+
+func (m *ReassignBuildIdResponse) GetSkippedWorkflows() int32 {
+	if m != nil {
+		return m.SkippedWorkflows
 	}
-	if this.ScheduledEventId != that1.ScheduledEventId {
-		return false
+	return 0
+}
+
+func (m *ReassignBuildIdResponse) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
 	}
-	if this.ScheduleToStartTimeout != nil && that1.ScheduleToStartTimeout != nil {
-		if *this.ScheduleToStartTimeout != *that1.ScheduleToStartTimeout {
-			return false
+	return nil
+}
+
+type GetTaskDispatchDecisionRequest struct {
+	NamespaceId   string            `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	TaskQueue     string            `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	TaskQueueType v19.TaskQueueType `protobuf:"varint,3,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
+	// Build id of the worker version stamp of the workflow the hypothetical task belongs to. Empty for a new workflow.
+	WorkflowBuildId string `protobuf:"bytes,4,opt,name=workflow_build_id,json=workflowBuildId,proto3" json:"workflow_build_id,omitempty"`
+	// Versioning intent of the hypothetical task: whether it should run on a build id compatible with
+	// workflow_build_id, as opposed to the current default of the task queue.
+	UseCompatibleVersion bool `protobuf:"varint,5,opt,name=use_compatible_version,json=useCompatibleVersion,proto3" json:"use_compatible_version,omitempty"`
+}
+
+func (m *GetTaskDispatchDecisionRequest) Reset()      { *m = GetTaskDispatchDecisionRequest{} }
+func (*GetTaskDispatchDecisionRequest) ProtoMessage() {}
+func (*GetTaskDispatchDecisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{50}
+}
+func (m *GetTaskDispatchDecisionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTaskDispatchDecisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTaskDispatchDecisionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if this.ScheduleToStartTimeout != nil {
-		return false
-	} else if that1.ScheduleToStartTimeout != nil {
-		return false
-	}
-	if this.ForwardedSource != that1.ForwardedSource {
-		return false
-	}
-	if this.Source != that1.Source {
-		return false
-	}
-	if !this.Clock.Equal(that1.Clock) {
-		return false
+		return b[:n], nil
 	}
-	if !this.VersionDirective.Equal(that1.VersionDirective) {
-		return false
+}
+func (m *GetTaskDispatchDecisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTaskDispatchDecisionRequest.Merge(m, src)
+}
+func (m *GetTaskDispatchDecisionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTaskDispatchDecisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTaskDispatchDecisionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTaskDispatchDecisionRequest proto.InternalMessageInfo
+
+func (m *GetTaskDispatchDecisionRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
-	return true
+	return ""
 }
-func (this *AddWorkflowTaskResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *GetTaskDispatchDecisionRequest) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
 	}
+	return ""
+}
 
-	that1, ok := that.(*AddWorkflowTaskResponse)
-	if !ok {
-		that2, ok := that.(AddWorkflowTaskResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *GetTaskDispatchDecisionRequest) GetTaskQueueType() v19.TaskQueueType {
+	if m != nil {
+		return m.TaskQueueType
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return v19.TASK_QUEUE_TYPE_UNSPECIFIED
+}
+
+func (m *GetTaskDispatchDecisionRequest) GetWorkflowBuildId() string {
+	if m != nil {
+		return m.WorkflowBuildId
 	}
-	return true
+	return ""
 }
-func (this *AddActivityTaskRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *GetTaskDispatchDecisionRequest) GetUseCompatibleVersion() bool {
+	if m != nil {
+		return m.UseCompatibleVersion
 	}
+	return false
+}
 
-	that1, ok := that.(*AddActivityTaskRequest)
-	if !ok {
-		that2, ok := that.(AddActivityTaskRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type GetTaskDispatchDecisionResponse struct {
+	// The build id the task would be dispatched to. Empty if it would go to the unversioned queue.
+	BuildId string `protobuf:"bytes,1,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	// Id of the compatible version set whose queue the task would be added to. Empty if unversioned.
+	VersionSetId string `protobuf:"bytes,2,opt,name=version_set_id,json=versionSetId,proto3" json:"version_set_id,omitempty"`
+}
+
+func (m *GetTaskDispatchDecisionResponse) Reset()      { *m = GetTaskDispatchDecisionResponse{} }
+func (*GetTaskDispatchDecisionResponse) ProtoMessage() {}
+func (*GetTaskDispatchDecisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{51}
+}
+func (m *GetTaskDispatchDecisionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTaskDispatchDecisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTaskDispatchDecisionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.NamespaceId != that1.NamespaceId {
-		return false
-	}
-	if !this.Execution.Equal(that1.Execution) {
-		return false
-	}
-	if !this.TaskQueue.Equal(that1.TaskQueue) {
-		return false
+}
+func (m *GetTaskDispatchDecisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTaskDispatchDecisionResponse.Merge(m, src)
+}
+func (m *GetTaskDispatchDecisionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTaskDispatchDecisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTaskDispatchDecisionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTaskDispatchDecisionResponse proto.InternalMessageInfo
+
+func (m *GetTaskDispatchDecisionResponse) GetBuildId() string {
+	if m != nil {
+		return m.BuildId
 	}
-	if this.ScheduledEventId != that1.ScheduledEventId {
-		return false
+	return ""
+}
+
+func (m *GetTaskDispatchDecisionResponse) GetVersionSetId() string {
+	if m != nil {
+		return m.VersionSetId
 	}
-	if this.ScheduleToStartTimeout != nil && that1.ScheduleToStartTimeout != nil {
-		if *this.ScheduleToStartTimeout != *that1.ScheduleToStartTimeout {
-			return false
+	return ""
+}
+
+type EnableWorkerVersioningRequest struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// The workflow task queue to enable versioning on. It must not have any versioning data yet.
+	TaskQueue string `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	// The first build id of the task queue, which becomes its default.
+	BuildId string `protobuf:"bytes,3,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+	// Register the build id even if no poller with it was seen, at the risk of stranding tasks until one shows up.
+	Force bool `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
+}
+
+func (m *EnableWorkerVersioningRequest) Reset()      { *m = EnableWorkerVersioningRequest{} }
+func (*EnableWorkerVersioningRequest) ProtoMessage() {}
+func (*EnableWorkerVersioningRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{52}
+}
+func (m *EnableWorkerVersioningRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnableWorkerVersioningRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnableWorkerVersioningRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if this.ScheduleToStartTimeout != nil {
-		return false
-	} else if that1.ScheduleToStartTimeout != nil {
-		return false
-	}
-	if this.ForwardedSource != that1.ForwardedSource {
-		return false
+		return b[:n], nil
 	}
-	if this.Source != that1.Source {
-		return false
+}
+func (m *EnableWorkerVersioningRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnableWorkerVersioningRequest.Merge(m, src)
+}
+func (m *EnableWorkerVersioningRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnableWorkerVersioningRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnableWorkerVersioningRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnableWorkerVersioningRequest proto.InternalMessageInfo
+
+func (m *EnableWorkerVersioningRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
-	if !this.Clock.Equal(that1.Clock) {
-		return false
+	return ""
+}
+
+func (m *EnableWorkerVersioningRequest) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
 	}
-	if !this.VersionDirective.Equal(that1.VersionDirective) {
-		return false
+	return ""
+}
+
+func (m *EnableWorkerVersioningRequest) GetBuildId() string {
+	if m != nil {
+		return m.BuildId
 	}
-	return true
+	return ""
 }
-func (this *AddActivityTaskResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *EnableWorkerVersioningRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
 	}
+	return false
+}
 
-	that1, ok := that.(*AddActivityTaskResponse)
-	if !ok {
-		that2, ok := that.(AddActivityTaskResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type EnableWorkerVersioningResponse struct {
+	// Number of distinct pollers with the build id seen across all partitions of the task queue.
+	PollerCount int32 `protobuf:"varint,1,opt,name=poller_count,json=pollerCount,proto3" json:"poller_count,omitempty"`
+}
+
+func (m *EnableWorkerVersioningResponse) Reset()      { *m = EnableWorkerVersioningResponse{} }
+func (*EnableWorkerVersioningResponse) ProtoMessage() {}
+func (*EnableWorkerVersioningResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{53}
+}
+func (m *EnableWorkerVersioningResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnableWorkerVersioningResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnableWorkerVersioningResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	return true
 }
-func (this *QueryWorkflowRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *EnableWorkerVersioningResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnableWorkerVersioningResponse.Merge(m, src)
+}
+func (m *EnableWorkerVersioningResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnableWorkerVersioningResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnableWorkerVersioningResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnableWorkerVersioningResponse proto.InternalMessageInfo
+
+func (m *EnableWorkerVersioningResponse) GetPollerCount() int32 {
+	if m != nil {
+		return m.PollerCount
 	}
+	return 0
+}
 
-	that1, ok := that.(*QueryWorkflowRequest)
-	if !ok {
-		that2, ok := that.(QueryWorkflowRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ListWorkerBuildIdCompatibilityRequest struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// Maximum number of task queues to return in a page. Defaults to 100 if not set.
+	PageSize      int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken []byte `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Limits how many major version sets are returned for each task queue, as in GetWorkerBuildIdCompatibility.
+	MaxSets int32 `protobuf:"varint,4,opt,name=max_sets,json=maxSets,proto3" json:"max_sets,omitempty"`
+}
+
+func (m *ListWorkerBuildIdCompatibilityRequest) Reset()      { *m = ListWorkerBuildIdCompatibilityRequest{} }
+func (*ListWorkerBuildIdCompatibilityRequest) ProtoMessage() {}
+func (*ListWorkerBuildIdCompatibilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{54}
+}
+func (m *ListWorkerBuildIdCompatibilityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListWorkerBuildIdCompatibilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListWorkerBuildIdCompatibilityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.NamespaceId != that1.NamespaceId {
-		return false
-	}
-	if !this.TaskQueue.Equal(that1.TaskQueue) {
-		return false
-	}
-	if !this.QueryRequest.Equal(that1.QueryRequest) {
-		return false
+}
+func (m *ListWorkerBuildIdCompatibilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWorkerBuildIdCompatibilityRequest.Merge(m, src)
+}
+func (m *ListWorkerBuildIdCompatibilityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListWorkerBuildIdCompatibilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWorkerBuildIdCompatibilityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWorkerBuildIdCompatibilityRequest proto.InternalMessageInfo
+
+func (m *ListWorkerBuildIdCompatibilityRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
 	}
-	if this.ForwardedSource != that1.ForwardedSource {
-		return false
+	return ""
+}
+
+func (m *ListWorkerBuildIdCompatibilityRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
 	}
-	if !this.VersionDirective.Equal(that1.VersionDirective) {
-		return false
+	return 0
+}
+
+func (m *ListWorkerBuildIdCompatibilityRequest) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
 	}
-	return true
+	return nil
 }
-func (this *QueryWorkflowResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ListWorkerBuildIdCompatibilityRequest) GetMaxSets() int32 {
+	if m != nil {
+		return m.MaxSets
 	}
+	return 0
+}
 
-	that1, ok := that.(*QueryWorkflowResponse)
-	if !ok {
-		that2, ok := that.(QueryWorkflowResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ListWorkerBuildIdCompatibilityResponse struct {
+	// Task queues of the page which have versioning data. A page may contain fewer entries than the page size, or
+	// none at all, while there are more pages to read.
+	TaskQueues    []*ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility `protobuf:"bytes,1,rep,name=task_queues,json=taskQueues,proto3" json:"task_queues,omitempty"`
+	NextPageToken []byte                                                                  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (m *ListWorkerBuildIdCompatibilityResponse) Reset() {
+	*m = ListWorkerBuildIdCompatibilityResponse{}
+}
+func (*ListWorkerBuildIdCompatibilityResponse) ProtoMessage() {}
+func (*ListWorkerBuildIdCompatibilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{55}
+}
+func (m *ListWorkerBuildIdCompatibilityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListWorkerBuildIdCompatibilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListWorkerBuildIdCompatibilityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.QueryResult.Equal(that1.QueryResult) {
-		return false
-	}
-	if !this.QueryRejected.Equal(that1.QueryRejected) {
-		return false
+}
+func (m *ListWorkerBuildIdCompatibilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWorkerBuildIdCompatibilityResponse.Merge(m, src)
+}
+func (m *ListWorkerBuildIdCompatibilityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListWorkerBuildIdCompatibilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWorkerBuildIdCompatibilityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWorkerBuildIdCompatibilityResponse proto.InternalMessageInfo
+
+func (m *ListWorkerBuildIdCompatibilityResponse) GetTaskQueues() []*ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility {
+	if m != nil {
+		return m.TaskQueues
 	}
-	return true
+	return nil
 }
-func (this *RespondQueryTaskCompletedRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ListWorkerBuildIdCompatibilityResponse) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
 	}
+	return nil
+}
 
-	that1, ok := that.(*RespondQueryTaskCompletedRequest)
-	if !ok {
-		that2, ok := that.(RespondQueryTaskCompletedRequest)
-		if ok {
-			that1 = &that2
+type ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility struct {
+	TaskQueue string `protobuf:"bytes,1,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	// Same as in GetWorkerBuildIdCompatibility, ordered from oldest to newest with the default set last.
+	MajorVersionSets []*v14.CompatibleVersionSet `protobuf:"bytes,2,rep,name=major_version_sets,json=majorVersionSets,proto3" json:"major_version_sets,omitempty"`
+}
+
+func (m *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) Reset() {
+	*m = ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility{}
+}
+func (*ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) ProtoMessage() {}
+func (*ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{55, 0}
+}
+func (m *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility.Merge(m, src)
+}
+func (m *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility proto.InternalMessageInfo
+
+func (m *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
+	}
+	return ""
+}
+
+func (m *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) GetMajorVersionSets() []*v14.CompatibleVersionSet {
+	if m != nil {
+		return m.MajorVersionSets
+	}
+	return nil
+}
+
+type GetBuildIdReachabilityRequest struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// The workflow task queue the build id belongs to.
+	TaskQueue string `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	BuildId   string `protobuf:"bytes,3,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
+}
+
+func (m *GetBuildIdReachabilityRequest) Reset()      { *m = GetBuildIdReachabilityRequest{} }
+func (*GetBuildIdReachabilityRequest) ProtoMessage() {}
+func (*GetBuildIdReachabilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{56}
+}
+func (m *GetBuildIdReachabilityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBuildIdReachabilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBuildIdReachabilityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBuildIdReachabilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBuildIdReachabilityRequest.Merge(m, src)
+}
+func (m *GetBuildIdReachabilityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBuildIdReachabilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBuildIdReachabilityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBuildIdReachabilityRequest proto.InternalMessageInfo
+
+func (m *GetBuildIdReachabilityRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *GetBuildIdReachabilityRequest) GetTaskQueue() string {
+	if m != nil {
+		return m.TaskQueue
+	}
+	return ""
+}
+
+func (m *GetBuildIdReachabilityRequest) GetBuildId() string {
+	if m != nil {
+		return m.BuildId
+	}
+	return ""
+}
+
+type GetBuildIdReachabilityResponse struct {
+	// Reachability of the build id within the task queue, classified the same way as in DescribeVersioning:
+	// NEW_WORKFLOWS if new workflows are assigned to the build id, OPEN_WORKFLOWS if open workflows compatible with it
+	// may still be dispatched to it, and CLOSED_WORKFLOWS if only queries on closed workflows may be. Empty if the
+	// build id cannot receive any task, i.e. it is safe to decommission the workers polling with it.
+	TaskQueueReachability *v14.TaskQueueReachability `protobuf:"bytes,1,opt,name=task_queue_reachability,json=taskQueueReachability,proto3" json:"task_queue_reachability,omitempty"`
+}
+
+func (m *GetBuildIdReachabilityResponse) Reset()      { *m = GetBuildIdReachabilityResponse{} }
+func (*GetBuildIdReachabilityResponse) ProtoMessage() {}
+func (*GetBuildIdReachabilityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a429a3813476c583, []int{57}
+}
+func (m *GetBuildIdReachabilityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBuildIdReachabilityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBuildIdReachabilityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBuildIdReachabilityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBuildIdReachabilityResponse.Merge(m, src)
+}
+func (m *GetBuildIdReachabilityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBuildIdReachabilityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBuildIdReachabilityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBuildIdReachabilityResponse proto.InternalMessageInfo
+
+func (m *GetBuildIdReachabilityResponse) GetTaskQueueReachability() *v14.TaskQueueReachability {
+	if m != nil {
+		return m.TaskQueueReachability
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PollWorkflowTaskQueueRequest)(nil), "temporal.server.api.matchingservice.v1.PollWorkflowTaskQueueRequest")
+	proto.RegisterType((*PollWorkflowTaskQueueResponse)(nil), "temporal.server.api.matchingservice.v1.PollWorkflowTaskQueueResponse")
+	proto.RegisterMapType((map[string]*v12.WorkflowQuery)(nil), "temporal.server.api.matchingservice.v1.PollWorkflowTaskQueueResponse.QueriesEntry")
+	proto.RegisterType((*PollActivityTaskQueueRequest)(nil), "temporal.server.api.matchingservice.v1.PollActivityTaskQueueRequest")
+	proto.RegisterType((*PollActivityTaskQueueResponse)(nil), "temporal.server.api.matchingservice.v1.PollActivityTaskQueueResponse")
+	proto.RegisterType((*AddWorkflowTaskRequest)(nil), "temporal.server.api.matchingservice.v1.AddWorkflowTaskRequest")
+	proto.RegisterType((*AddWorkflowTaskResponse)(nil), "temporal.server.api.matchingservice.v1.AddWorkflowTaskResponse")
+	proto.RegisterType((*AddActivityTaskRequest)(nil), "temporal.server.api.matchingservice.v1.AddActivityTaskRequest")
+	proto.RegisterType((*AddActivityTaskResponse)(nil), "temporal.server.api.matchingservice.v1.AddActivityTaskResponse")
+	proto.RegisterType((*QueryWorkflowRequest)(nil), "temporal.server.api.matchingservice.v1.QueryWorkflowRequest")
+	proto.RegisterType((*QueryWorkflowResponse)(nil), "temporal.server.api.matchingservice.v1.QueryWorkflowResponse")
+	proto.RegisterType((*RespondQueryTaskCompletedRequest)(nil), "temporal.server.api.matchingservice.v1.RespondQueryTaskCompletedRequest")
+	proto.RegisterType((*RespondQueryTaskCompletedResponse)(nil), "temporal.server.api.matchingservice.v1.RespondQueryTaskCompletedResponse")
+	proto.RegisterType((*CancelOutstandingPollRequest)(nil), "temporal.server.api.matchingservice.v1.CancelOutstandingPollRequest")
+	proto.RegisterType((*CancelOutstandingPollResponse)(nil), "temporal.server.api.matchingservice.v1.CancelOutstandingPollResponse")
+	proto.RegisterType((*DescribeTaskQueueRequest)(nil), "temporal.server.api.matchingservice.v1.DescribeTaskQueueRequest")
+	proto.RegisterType((*DescribeTaskQueueResponse)(nil), "temporal.server.api.matchingservice.v1.DescribeTaskQueueResponse")
+	proto.RegisterMapType((map[string]int64)(nil), "temporal.server.api.matchingservice.v1.DescribeTaskQueueResponse.VersionedBacklogCountHintsEntry")
+	proto.RegisterType((*ListTaskQueuePartitionsRequest)(nil), "temporal.server.api.matchingservice.v1.ListTaskQueuePartitionsRequest")
+	proto.RegisterType((*ListTaskQueuePartitionsResponse)(nil), "temporal.server.api.matchingservice.v1.ListTaskQueuePartitionsResponse")
+	proto.RegisterType((*UpdateWorkerBuildIdCompatibilityRequest)(nil), "temporal.server.api.matchingservice.v1.UpdateWorkerBuildIdCompatibilityRequest")
+	proto.RegisterType((*UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining)(nil), "temporal.server.api.matchingservice.v1.UpdateWorkerBuildIdCompatibilityRequest.MarkBuildIdDraining")
+	proto.RegisterType((*UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet)(nil), "temporal.server.api.matchingservice.v1.UpdateWorkerBuildIdCompatibilityRequest.SwapBuildIdsWithinSet")
+	proto.RegisterType((*UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels)(nil), "temporal.server.api.matchingservice.v1.UpdateWorkerBuildIdCompatibilityRequest.SetBuildIdLabels")
+	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.matchingservice.v1.UpdateWorkerBuildIdCompatibilityRequest.SetBuildIdLabels.LabelsEntry")
+	proto.RegisterType((*UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets)(nil), "temporal.server.api.matchingservice.v1.UpdateWorkerBuildIdCompatibilityRequest.SwapDefaultSets")
+	proto.RegisterType((*UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId)(nil), "temporal.server.api.matchingservice.v1.UpdateWorkerBuildIdCompatibilityRequest.RemoveBuildId")
+	proto.RegisterType((*UpdateWorkerBuildIdCompatibilityResponse)(nil), "temporal.server.api.matchingservice.v1.UpdateWorkerBuildIdCompatibilityResponse")
+	proto.RegisterType((*GetWorkerBuildIdCompatibilityRequest)(nil), "temporal.server.api.matchingservice.v1.GetWorkerBuildIdCompatibilityRequest")
+	proto.RegisterType((*GetWorkerBuildIdCompatibilityResponse)(nil), "temporal.server.api.matchingservice.v1.GetWorkerBuildIdCompatibilityResponse")
+	proto.RegisterMapType((map[string]*GetWorkerBuildIdCompatibilityResponse_BuildIdLabels)(nil), "temporal.server.api.matchingservice.v1.GetWorkerBuildIdCompatibilityResponse.BuildIdLabelsEntry")
+	proto.RegisterType((*GetWorkerBuildIdCompatibilityResponse_BuildIdLabels)(nil), "temporal.server.api.matchingservice.v1.GetWorkerBuildIdCompatibilityResponse.BuildIdLabels")
+	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.matchingservice.v1.GetWorkerBuildIdCompatibilityResponse.BuildIdLabels.LabelsEntry")
+	proto.RegisterType((*GetTaskQueueUserDataRequest)(nil), "temporal.server.api.matchingservice.v1.GetTaskQueueUserDataRequest")
+	proto.RegisterType((*GetTaskQueueUserDataResponse)(nil), "temporal.server.api.matchingservice.v1.GetTaskQueueUserDataResponse")
+	proto.RegisterType((*ApplyTaskQueueUserDataReplicationEventRequest)(nil), "temporal.server.api.matchingservice.v1.ApplyTaskQueueUserDataReplicationEventRequest")
+	proto.RegisterType((*ApplyTaskQueueUserDataReplicationEventResponse)(nil), "temporal.server.api.matchingservice.v1.ApplyTaskQueueUserDataReplicationEventResponse")
+	proto.RegisterType((*DescribeVersioningRequest)(nil), "temporal.server.api.matchingservice.v1.DescribeVersioningRequest")
+	proto.RegisterType((*DescribeVersioningResponse)(nil), "temporal.server.api.matchingservice.v1.DescribeVersioningResponse")
+	proto.RegisterType((*DescribeVersioningResponse_BuildIdInfo)(nil), "temporal.server.api.matchingservice.v1.DescribeVersioningResponse.BuildIdInfo")
+	proto.RegisterType((*DescribeVersioningResponse_VersionSet)(nil), "temporal.server.api.matchingservice.v1.DescribeVersioningResponse.VersionSet")
+	proto.RegisterType((*GetBuildIdTaskQueueMappingRequest)(nil), "temporal.server.api.matchingservice.v1.GetBuildIdTaskQueueMappingRequest")
+	proto.RegisterType((*GetBuildIdTaskQueueMappingResponse)(nil), "temporal.server.api.matchingservice.v1.GetBuildIdTaskQueueMappingResponse")
+	proto.RegisterType((*ForceUnloadTaskQueueRequest)(nil), "temporal.server.api.matchingservice.v1.ForceUnloadTaskQueueRequest")
+	proto.RegisterType((*ForceUnloadTaskQueueResponse)(nil), "temporal.server.api.matchingservice.v1.ForceUnloadTaskQueueResponse")
+	proto.RegisterType((*UpdateTaskQueueUserDataRequest)(nil), "temporal.server.api.matchingservice.v1.UpdateTaskQueueUserDataRequest")
+	proto.RegisterType((*UpdateTaskQueueUserDataResponse)(nil), "temporal.server.api.matchingservice.v1.UpdateTaskQueueUserDataResponse")
+	proto.RegisterType((*ReplicateTaskQueueUserDataRequest)(nil), "temporal.server.api.matchingservice.v1.ReplicateTaskQueueUserDataRequest")
+	proto.RegisterType((*ReplicateTaskQueueUserDataResponse)(nil), "temporal.server.api.matchingservice.v1.ReplicateTaskQueueUserDataResponse")
+	proto.RegisterType((*CleanupUnreachableBuildIdsRequest)(nil), "temporal.server.api.matchingservice.v1.CleanupUnreachableBuildIdsRequest")
+	proto.RegisterType((*CleanupUnreachableBuildIdsResponse)(nil), "temporal.server.api.matchingservice.v1.CleanupUnreachableBuildIdsResponse")
+	proto.RegisterType((*GetDefaultBuildIdTimelineRequest)(nil), "temporal.server.api.matchingservice.v1.GetDefaultBuildIdTimelineRequest")
+	proto.RegisterType((*GetDefaultBuildIdTimelineResponse)(nil), "temporal.server.api.matchingservice.v1.GetDefaultBuildIdTimelineResponse")
+	proto.RegisterType((*ValidateDefaultBuildIdSwitchRequest)(nil), "temporal.server.api.matchingservice.v1.ValidateDefaultBuildIdSwitchRequest")
+	proto.RegisterType((*ValidateDefaultBuildIdSwitchResponse)(nil), "temporal.server.api.matchingservice.v1.ValidateDefaultBuildIdSwitchResponse")
+	proto.RegisterType((*GetClosedWorkflowBuildIdRequest)(nil), "temporal.server.api.matchingservice.v1.GetClosedWorkflowBuildIdRequest")
+	proto.RegisterType((*GetClosedWorkflowBuildIdResponse)(nil), "temporal.server.api.matchingservice.v1.GetClosedWorkflowBuildIdResponse")
+	proto.RegisterType((*GetUserDataPropagationStatusRequest)(nil), "temporal.server.api.matchingservice.v1.GetUserDataPropagationStatusRequest")
+	proto.RegisterType((*GetUserDataPropagationStatusResponse)(nil), "temporal.server.api.matchingservice.v1.GetUserDataPropagationStatusResponse")
+	proto.RegisterType((*GetUserDataPropagationStatusResponse_TaskQueueStatus)(nil), "temporal.server.api.matchingservice.v1.GetUserDataPropagationStatusResponse.TaskQueueStatus")
+	proto.RegisterType((*GetUserDataPropagationStatusResponse_PartitionError)(nil), "temporal.server.api.matchingservice.v1.GetUserDataPropagationStatusResponse.PartitionError")
+	proto.RegisterType((*ApplyVersioningTemplateRequest)(nil), "temporal.server.api.matchingservice.v1.ApplyVersioningTemplateRequest")
+	proto.RegisterType((*ApplyVersioningTemplateResponse)(nil), "temporal.server.api.matchingservice.v1.ApplyVersioningTemplateResponse")
+	proto.RegisterType((*ReassignBuildIdRequest)(nil), "temporal.server.api.matchingservice.v1.ReassignBuildIdRequest")
+	proto.RegisterType((*ReassignBuildIdResponse)(nil), "temporal.server.api.matchingservice.v1.ReassignBuildIdResponse")
+	proto.RegisterType((*GetTaskDispatchDecisionRequest)(nil), "temporal.server.api.matchingservice.v1.GetTaskDispatchDecisionRequest")
+	proto.RegisterType((*GetTaskDispatchDecisionResponse)(nil), "temporal.server.api.matchingservice.v1.GetTaskDispatchDecisionResponse")
+	proto.RegisterType((*EnableWorkerVersioningRequest)(nil), "temporal.server.api.matchingservice.v1.EnableWorkerVersioningRequest")
+	proto.RegisterType((*EnableWorkerVersioningResponse)(nil), "temporal.server.api.matchingservice.v1.EnableWorkerVersioningResponse")
+	proto.RegisterType((*ListWorkerBuildIdCompatibilityRequest)(nil), "temporal.server.api.matchingservice.v1.ListWorkerBuildIdCompatibilityRequest")
+	proto.RegisterType((*ListWorkerBuildIdCompatibilityResponse)(nil), "temporal.server.api.matchingservice.v1.ListWorkerBuildIdCompatibilityResponse")
+	proto.RegisterType((*ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility)(nil), "temporal.server.api.matchingservice.v1.ListWorkerBuildIdCompatibilityResponse.TaskQueueBuildIdCompatibility")
+	proto.RegisterType((*GetBuildIdReachabilityRequest)(nil), "temporal.server.api.matchingservice.v1.GetBuildIdReachabilityRequest")
+	proto.RegisterType((*GetBuildIdReachabilityResponse)(nil), "temporal.server.api.matchingservice.v1.GetBuildIdReachabilityResponse")
+}
+
+func init() {
+	proto.RegisterFile("temporal/server/api/matchingservice/v1/request_response.proto", fileDescriptor_a429a3813476c583)
+}
+
+var fileDescriptor_a429a3813476c583 = []byte{
+	// 3843 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3b, 0x5d, 0x6f, 0x1c, 0xd7,
+	0x75, 0x9c, 0x5d, 0x2e, 0xb5, 0x7b, 0x76, 0xf9, 0x35, 0xa2, 0xa4, 0x15, 0x25, 0xae, 0xc8, 0x11,
+	0x2d, 0xd3, 0x6a, 0xb2, 0xb4, 0x98, 0x44, 0xb0, 0xd3, 0x3a, 0xa9, 0x44, 0x2a, 0x14, 0x63, 0xc9,
+	0xa5, 0x87, 0xb4, 0x52, 0xd8, 0x31, 0xc6, 0x97, 0x33, 0x97, 0xcb, 0x09, 0x67, 0x67, 0x46, 0x73,
+	0xef, 0x92, 0xa6, 0xd1, 0xa2, 0x41, 0x11, 0xd4, 0x45, 0x0b, 0x03, 0x6e, 0xfc, 0x92, 0x16, 0xc8,
+	0x83, 0x8b, 0xb6, 0x68, 0x8b, 0xf6, 0x39, 0xe8, 0x73, 0x11, 0xa0, 0x40, 0xfb, 0xe0, 0xc7, 0xbc,
+	0xb5, 0x96, 0x91, 0xa2, 0x68, 0x0b, 0x24, 0xfd, 0x07, 0xc5, 0xfd, 0x98, 0xcf, 0x9d, 0xfd, 0x20,
+	0xbd, 0x4c, 0x82, 0x3c, 0x89, 0x7b, 0xee, 0xf9, 0xbe, 0xe7, 0x9e, 0x73, 0xee, 0x99, 0x2b, 0x78,
+	0x85, 0xe2, 0xb6, 0xef, 0x05, 0xc8, 0x59, 0x25, 0x38, 0x38, 0xc2, 0xc1, 0x2a, 0xf2, 0xed, 0xd5,
+	0x36, 0xa2, 0xe6, 0x81, 0xed, 0xb6, 0x18, 0xc8, 0x36, 0xf1, 0xea, 0xd1, 0x9d, 0xd5, 0x00, 0x3f,
+	0xed, 0x60, 0x42, 0x8d, 0x00, 0x13, 0xdf, 0x73, 0x09, 0x6e, 0xfa, 0x81, 0x47, 0x3d, 0xf5, 0x56,
+	0x48, 0xde, 0x14, 0xe4, 0x4d, 0xe4, 0xdb, 0xcd, 0x0c, 0x79, 0xf3, 0xe8, 0xce, 0x7c, 0xa3, 0xe5,
+	0x79, 0x2d, 0x07, 0xaf, 0x72, 0xaa, 0xbd, 0xce, 0xfe, 0xaa, 0xd5, 0x09, 0x10, 0xb5, 0x3d, 0x57,
+	0xf0, 0x99, 0xbf, 0x91, 0x5d, 0xa7, 0x76, 0x1b, 0x13, 0x8a, 0xda, 0xbe, 0x44, 0x58, 0xb2, 0xb0,
+	0x8f, 0x5d, 0x0b, 0xbb, 0xa6, 0x8d, 0xc9, 0x6a, 0xcb, 0x6b, 0x79, 0x1c, 0xce, 0xff, 0x92, 0x28,
+	0xcb, 0x91, 0x29, 0xcc, 0x06, 0xd3, 0x6b, 0xb7, 0x3d, 0x97, 0xa9, 0xde, 0xc6, 0x84, 0xa0, 0x96,
+	0xd4, 0x78, 0xfe, 0x56, 0x0a, 0x0b, 0xbb, 0x9d, 0x36, 0x61, 0x48, 0x14, 0x91, 0x43, 0xe3, 0x69,
+	0x07, 0x77, 0x42, 0xbc, 0xe7, 0x53, 0x78, 0x6c, 0x99, 0xaf, 0x76, 0x33, 0xbc, 0x99, 0x42, 0x7c,
+	0xda, 0xc1, 0xc1, 0xc9, 0x20, 0xa9, 0x1c, 0x66, 0x7a, 0x4e, 0x37, 0xde, 0xed, 0xbc, 0xed, 0x30,
+	0x1d, 0xcf, 0x3c, 0xec, 0xc6, 0x7d, 0x3e, 0x0f, 0x37, 0x65, 0x90, 0x44, 0xfc, 0x42, 0x1e, 0xe2,
+	0x81, 0x4d, 0xa8, 0x97, 0xa7, 0xea, 0x97, 0xf3, 0xb0, 0x7d, 0x1c, 0x10, 0x9b, 0x50, 0xec, 0x9a,
+	0x38, 0x64, 0x2e, 0xbc, 0x45, 0x24, 0x55, 0x33, 0x8f, 0xaa, 0x8f, 0xd7, 0xee, 0xa6, 0x1c, 0x72,
+	0xec, 0x05, 0x87, 0xfb, 0x8e, 0x77, 0x3c, 0x30, 0xe0, 0xb4, 0xff, 0x51, 0xe0, 0xfa, 0xb6, 0xe7,
+	0x38, 0xdf, 0x92, 0x14, 0xbb, 0x88, 0x1c, 0xbe, 0xce, 0x44, 0xe8, 0x02, 0x5f, 0x5d, 0x82, 0x9a,
+	0x8b, 0xda, 0x98, 0xf8, 0xc8, 0xc4, 0x86, 0x6d, 0xd5, 0x95, 0x45, 0x65, 0xa5, 0xa2, 0x57, 0x23,
+	0xd8, 0x96, 0xa5, 0x5e, 0x83, 0x8a, 0xef, 0x39, 0x0e, 0x0e, 0xd8, 0x7a, 0x81, 0xaf, 0x97, 0x05,
+	0x60, 0xcb, 0x52, 0xdf, 0x81, 0x1a, 0xfb, 0xdb, 0x90, 0xf2, 0xeb, 0xc5, 0x45, 0x65, 0xa5, 0xba,
+	0xf6, 0x4a, 0x64, 0x1f, 0x8f, 0xf0, 0x8c, 0xbe, 0xcd, 0xa3, 0x3b, 0xcd, 0x7e, 0x4a, 0xe9, 0x55,
+	0xc6, 0x32, 0xd4, 0xf0, 0x05, 0x98, 0xd9, 0xf7, 0x82, 0x63, 0x14, 0x58, 0xd8, 0x32, 0x88, 0xd7,
+	0x09, 0x4c, 0x5c, 0x1f, 0xe7, 0x5a, 0x4c, 0x47, 0xf0, 0x1d, 0x0e, 0xd6, 0xfe, 0xad, 0x02, 0x0b,
+	0x3d, 0x18, 0x0b, 0xaf, 0xa8, 0x0b, 0x00, 0x7c, 0x33, 0xa8, 0x77, 0x88, 0x5d, 0x6e, 0x6c, 0x4d,
+	0xaf, 0x30, 0xc8, 0x2e, 0x03, 0xa8, 0xbf, 0x0b, 0x6a, 0xa8, 0xab, 0x81, 0xdf, 0xc5, 0x66, 0x87,
+	0x9d, 0x39, 0x6e, 0x73, 0x75, 0xed, 0x85, 0xb4, 0x4d, 0xe2, 0xc0, 0x30, 0x53, 0x42, 0x69, 0x0f,
+	0x42, 0x02, 0x7d, 0xf6, 0x38, 0x0b, 0x52, 0xb7, 0x60, 0x32, 0xe2, 0x4c, 0x4f, 0x7c, 0x2c, 0x1d,
+	0xb5, 0x3c, 0x88, 0xe9, 0xee, 0x89, 0x8f, 0xf5, 0xda, 0x71, 0xe2, 0x97, 0xfa, 0x32, 0x5c, 0xf5,
+	0x03, 0x7c, 0x64, 0x7b, 0x1d, 0x62, 0x10, 0x8a, 0x02, 0x8a, 0x2d, 0x03, 0x1f, 0x61, 0x97, 0xb2,
+	0xfd, 0x61, 0x9e, 0x29, 0xea, 0x97, 0x43, 0x84, 0x1d, 0xb1, 0xfe, 0x80, 0x2d, 0x6f, 0x59, 0xea,
+	0x0a, 0xcc, 0x74, 0x51, 0x94, 0x38, 0xc5, 0x14, 0x49, 0x63, 0xd6, 0xe1, 0x02, 0xa2, 0x4c, 0x37,
+	0x5a, 0x9f, 0x58, 0x54, 0x56, 0x4a, 0x7a, 0xf8, 0x53, 0xd5, 0x60, 0xd2, 0xc5, 0xef, 0xd2, 0x98,
+	0xc1, 0x05, 0xce, 0xa0, 0xca, 0x80, 0x21, 0xf5, 0x17, 0x40, 0xdd, 0x43, 0xe6, 0xa1, 0xe3, 0xb5,
+	0x0c, 0xd3, 0xeb, 0xb8, 0xd4, 0x38, 0xb0, 0x5d, 0x5a, 0x2f, 0x73, 0xc4, 0x19, 0xb9, 0xb2, 0xce,
+	0x16, 0x1e, 0xda, 0x2e, 0x55, 0x5f, 0x82, 0x3a, 0xa1, 0xb6, 0x79, 0x78, 0x12, 0xfb, 0xdc, 0xc0,
+	0x2e, 0xda, 0x73, 0xb0, 0x55, 0xaf, 0x2c, 0x2a, 0x2b, 0x65, 0xfd, 0xb2, 0x58, 0x8f, 0xdc, 0xf9,
+	0x40, 0xac, 0xaa, 0x5f, 0x85, 0x12, 0xcf, 0x20, 0x75, 0xc8, 0xf3, 0x26, 0x5f, 0x4a, 0x3a, 0xf3,
+	0x75, 0x06, 0xd0, 0x05, 0x89, 0xfa, 0x14, 0xae, 0xd0, 0x00, 0xb9, 0xc4, 0x66, 0x66, 0xc4, 0x7b,
+	0x83, 0xc8, 0x61, 0xbd, 0xca, 0xb9, 0xbd, 0xdc, 0xcc, 0xcb, 0xd6, 0x32, 0x11, 0x30, 0xb6, 0xbb,
+	0x21, 0x79, 0x32, 0xde, 0xb6, 0xdc, 0x7d, 0x4f, 0xbf, 0x44, 0xf3, 0x96, 0xd4, 0x16, 0x2c, 0x74,
+	0x87, 0x97, 0x11, 0x67, 0x87, 0x7a, 0x2d, 0xcf, 0x8c, 0x28, 0x2d, 0x70, 0x99, 0x51, 0x48, 0xcf,
+	0x77, 0x05, 0x59, 0xb4, 0xc6, 0x4e, 0xf5, 0x5e, 0x80, 0x5c, 0xf3, 0x40, 0x06, 0xfa, 0x14, 0x0f,
+	0xf4, 0xaa, 0x80, 0x89, 0x50, 0xdf, 0x84, 0x29, 0x62, 0x1e, 0x60, 0xab, 0xe3, 0x60, 0xcb, 0x60,
+	0xe5, 0xa3, 0x3e, 0xcd, 0x85, 0xcf, 0x37, 0x45, 0x6d, 0x69, 0x86, 0xb5, 0xa5, 0xb9, 0x1b, 0xd6,
+	0x96, 0xfb, 0xe3, 0x1f, 0xfe, 0xfb, 0x0d, 0x45, 0x9f, 0x8c, 0xe8, 0xd8, 0x8a, 0xba, 0x0e, 0xb5,
+	0x30, 0xa6, 0x38, 0x9b, 0x99, 0x21, 0xd9, 0x54, 0x25, 0x15, 0x67, 0xe2, 0xc0, 0x05, 0xb6, 0x2b,
+	0x36, 0x26, 0xf5, 0xd9, 0xc5, 0xe2, 0x4a, 0x75, 0x4d, 0x6f, 0x0e, 0x57, 0x2a, 0x9b, 0x7d, 0xcf,
+	0x7b, 0xf3, 0x75, 0xc1, 0xf4, 0x81, 0x4b, 0x83, 0x13, 0x3d, 0x14, 0xa1, 0xbe, 0x02, 0x65, 0x99,
+	0x5e, 0x49, 0x5d, 0xe5, 0xe2, 0x96, 0xd2, 0x2e, 0x0f, 0x2b, 0x0e, 0x13, 0xf0, 0x58, 0x60, 0xea,
+	0x11, 0xc9, 0xfc, 0x3b, 0x50, 0x4b, 0xf2, 0x55, 0x67, 0xa0, 0x78, 0x88, 0x4f, 0x64, 0xea, 0x64,
+	0x7f, 0xb2, 0xb8, 0x3c, 0x42, 0x4e, 0x07, 0xd7, 0x0b, 0x79, 0x1b, 0xda, 0x2b, 0x2e, 0x39, 0xc9,
+	0x57, 0x0b, 0x2f, 0x29, 0xdf, 0x1c, 0x2f, 0x4f, 0xce, 0x4c, 0x45, 0xc9, 0xfb, 0x9e, 0x49, 0xed,
+	0x23, 0x9b, 0x9e, 0xfc, 0x4a, 0x25, 0xef, 0x5e, 0x4a, 0x9d, 0x3d, 0x79, 0x97, 0x61, 0xa1, 0x07,
+	0xe3, 0x5f, 0x76, 0xf2, 0xbe, 0x01, 0x55, 0x24, 0xb5, 0x62, 0x6e, 0x2c, 0x72, 0x03, 0x20, 0x04,
+	0x6d, 0x59, 0x2c, 0xbb, 0x47, 0x08, 0x3c, 0xbb, 0x8f, 0xf7, 0xcf, 0xee, 0x91, 0x8d, 0x3c, 0xbb,
+	0xa3, 0xc4, 0x2f, 0xf5, 0x2e, 0x94, 0x6c, 0xd7, 0xef, 0x50, 0x9e, 0x97, 0xab, 0x6b, 0x8b, 0xbd,
+	0x58, 0x6c, 0xa3, 0x13, 0xc7, 0x43, 0x16, 0xd1, 0x05, 0x7a, 0xce, 0x79, 0x9e, 0x38, 0xdb, 0x79,
+	0x7e, 0x13, 0xae, 0x86, 0x00, 0x83, 0x7a, 0x86, 0xe9, 0x78, 0x04, 0x73, 0x86, 0x5e, 0x87, 0xf2,
+	0x5c, 0x5f, 0x5d, 0xbb, 0xda, 0xc5, 0x73, 0x43, 0xf6, 0xa7, 0xf7, 0xc7, 0x7f, 0xc0, 0x58, 0x5e,
+	0x0e, 0x39, 0xec, 0x7a, 0xeb, 0x8c, 0x7e, 0x57, 0x90, 0x77, 0xe5, 0x8a, 0xf2, 0x59, 0x72, 0xc5,
+	0x2e, 0x5c, 0xe6, 0x3f, 0xbb, 0xb5, 0xab, 0x0c, 0xa7, 0xdd, 0x45, 0x4e, 0x9e, 0x51, 0xed, 0x11,
+	0xcc, 0x1e, 0x60, 0x14, 0xd0, 0x3d, 0x8c, 0x68, 0xc4, 0x10, 0x86, 0x63, 0x38, 0x13, 0x51, 0x86,
+	0xdc, 0x12, 0xe5, 0xb3, 0x9a, 0x2e, 0x9f, 0x18, 0x1a, 0x66, 0x27, 0x08, 0x58, 0xd1, 0x91, 0x20,
+	0x23, 0xb3, 0x6f, 0xb5, 0x21, 0x9d, 0x72, 0x4d, 0xf2, 0xb9, 0x27, 0xd8, 0xec, 0xa4, 0x76, 0xf1,
+	0x71, 0xd2, 0x1c, 0x0b, 0x53, 0x64, 0x3b, 0xa4, 0x3e, 0x39, 0x64, 0x48, 0xc5, 0xf6, 0x6c, 0x08,
+	0xca, 0xee, 0xf6, 0x65, 0xea, 0xcc, 0xed, 0xcb, 0x17, 0x13, 0xc7, 0x34, 0xca, 0x54, 0xbc, 0xf8,
+	0x54, 0xe2, 0xb3, 0xf7, 0x5a, 0xb8, 0xa0, 0xde, 0x85, 0x89, 0x03, 0x8c, 0x2c, 0x1c, 0xc8, 0xc2,
+	0xd2, 0xe8, 0x25, 0xf2, 0x21, 0xc7, 0xd2, 0x25, 0xb6, 0xf6, 0x9f, 0xe3, 0x70, 0xf9, 0x9e, 0x65,
+	0x25, 0x4b, 0xc3, 0x29, 0xd2, 0xe6, 0x26, 0x54, 0x3e, 0x47, 0x0a, 0x89, 0x69, 0xd5, 0x75, 0x99,
+	0xb3, 0x44, 0x7d, 0x2f, 0x9e, 0xa2, 0xbe, 0x57, 0x68, 0xf8, 0x27, 0x6b, 0xa7, 0xe2, 0x18, 0xc9,
+	0xb4, 0x7a, 0x33, 0xd1, 0x4a, 0xd8, 0x7c, 0x65, 0x0e, 0xb0, 0x3c, 0x2b, 0x32, 0xa2, 0x4b, 0xa7,
+	0x3e, 0xc0, 0xbc, 0x85, 0x0c, 0xe3, 0x3a, 0x2f, 0x9f, 0x4f, 0xe4, 0xe6, 0x73, 0xf5, 0xb7, 0x61,
+	0x42, 0x22, 0xb0, 0xa4, 0x31, 0xb5, 0xb6, 0x92, 0x5b, 0xd1, 0xf9, 0x05, 0x2c, 0x34, 0x5c, 0x50,
+	0xea, 0x92, 0x4e, 0xfd, 0x3a, 0x94, 0xf8, 0x5d, 0xae, 0x5e, 0xc9, 0x6e, 0x40, 0x82, 0x01, 0xc7,
+	0x60, 0x0c, 0x9e, 0x60, 0x93, 0x7a, 0xc1, 0x3a, 0xfb, 0xa9, 0x0b, 0x3a, 0xd5, 0x84, 0xd9, 0x23,
+	0x1c, 0x10, 0xd6, 0x64, 0x59, 0x76, 0x80, 0x59, 0x9a, 0xc5, 0xf2, 0x4c, 0xdf, 0xcd, 0x65, 0xd6,
+	0xb5, 0x15, 0x4f, 0x04, 0xf9, 0x46, 0x48, 0xad, 0xcf, 0x1c, 0x65, 0x20, 0xda, 0x55, 0xb8, 0xd2,
+	0x15, 0x67, 0xa2, 0x60, 0x69, 0xff, 0x2b, 0x62, 0x30, 0x59, 0xd1, 0x7e, 0xf9, 0x31, 0x38, 0x3e,
+	0xca, 0x18, 0x2c, 0x9d, 0x25, 0x06, 0x27, 0x46, 0x1f, 0x83, 0x17, 0x06, 0xc5, 0x60, 0xf9, 0xd7,
+	0x39, 0x06, 0xbf, 0x39, 0x5e, 0x2e, 0xce, 0x8c, 0xcb, 0x48, 0x4c, 0x47, 0x9b, 0x8c, 0xc4, 0xff,
+	0x2e, 0xc0, 0x1c, 0xef, 0x32, 0xc3, 0x40, 0x39, 0x45, 0x1c, 0xa6, 0xc3, 0xa7, 0x70, 0xb6, 0xf0,
+	0x79, 0x13, 0x26, 0x79, 0xdb, 0x9b, 0xe9, 0x35, 0xbf, 0x32, 0xb0, 0xd7, 0xcc, 0xd3, 0x5a, 0xaf,
+	0x71, 0x5e, 0xa7, 0x6f, 0x32, 0xf3, 0x77, 0xa3, 0x34, 0xe2, 0x8c, 0xf0, 0x77, 0x0a, 0x5c, 0xca,
+	0xa8, 0x2d, 0x3b, 0xd8, 0x75, 0xa8, 0x85, 0x5e, 0x20, 0x1d, 0x87, 0xd6, 0x95, 0x21, 0x0b, 0x72,
+	0x55, 0xda, 0xcb, 0x88, 0xd4, 0x57, 0x61, 0x2a, 0x64, 0xf2, 0x1d, 0x6c, 0x52, 0x6c, 0x0d, 0xb8,
+	0x65, 0x88, 0xdb, 0x85, 0xc4, 0xd5, 0x27, 0x9f, 0x26, 0x7f, 0x6a, 0x1f, 0x15, 0x60, 0x51, 0xa8,
+	0x67, 0x71, 0x3c, 0x66, 0xe2, 0xba, 0xd7, 0xf6, 0x1d, 0xcc, 0x90, 0x7f, 0xc1, 0x41, 0x72, 0x05,
+	0x2e, 0x70, 0x26, 0x51, 0x8f, 0x3d, 0xc1, 0x7e, 0x6e, 0x59, 0xaa, 0x0b, 0xb3, 0x66, 0xa8, 0x54,
+	0x14, 0x41, 0x22, 0x91, 0xdd, 0x1b, 0x18, 0x41, 0x83, 0xcc, 0xd3, 0x67, 0xcc, 0x0c, 0x44, 0xbb,
+	0x09, 0x4b, 0x7d, 0xa8, 0xe4, 0x99, 0xfa, 0x3f, 0x05, 0xae, 0xaf, 0x23, 0xd7, 0xc4, 0xce, 0xef,
+	0x74, 0x28, 0xa1, 0xc8, 0xb5, 0x6c, 0xb7, 0xb5, 0x9d, 0xb8, 0xfc, 0x0c, 0xe1, 0xb6, 0x47, 0x30,
+	0x1d, 0xbb, 0x4d, 0x74, 0x56, 0x05, 0x9e, 0xa9, 0x32, 0xbe, 0x4b, 0xa5, 0x28, 0xee, 0x2c, 0xde,
+	0x59, 0x4d, 0xd2, 0xe4, 0xcf, 0xd1, 0x34, 0x1b, 0xa9, 0x1b, 0xe3, 0x78, 0xfa, 0xc6, 0xa8, 0xdd,
+	0x80, 0x85, 0x1e, 0x26, 0x4b, 0xa7, 0xfc, 0xb3, 0x02, 0xf5, 0x0d, 0x4c, 0xcc, 0xc0, 0xde, 0xc3,
+	0x67, 0xb9, 0xaf, 0x7e, 0x1b, 0x6a, 0x16, 0x26, 0x66, 0xb4, 0xc9, 0x85, 0xec, 0x28, 0xa6, 0xc7,
+	0x26, 0xf7, 0x92, 0xa9, 0x57, 0x19, 0xbb, 0x50, 0x81, 0x5b, 0x30, 0x1d, 0x1e, 0x7f, 0x82, 0x59,
+	0x01, 0x23, 0xf5, 0xe2, 0x62, 0x71, 0xa5, 0xa2, 0x4f, 0x4a, 0xf0, 0x0e, 0xa6, 0x5b, 0x16, 0xd1,
+	0x7e, 0x56, 0x84, 0xab, 0x39, 0x1c, 0xe5, 0x29, 0xfe, 0x3a, 0x5c, 0x10, 0x0e, 0x21, 0x75, 0x85,
+	0x4f, 0x0f, 0x9e, 0xeb, 0xe3, 0xe3, 0x6d, 0xe1, 0x3a, 0x36, 0x15, 0x0a, 0xa9, 0xd4, 0x27, 0x30,
+	0x9b, 0xd8, 0x75, 0x42, 0x11, 0xed, 0x10, 0x69, 0xe9, 0xed, 0x61, 0xb6, 0x6b, 0x87, 0x53, 0xe8,
+	0xd3, 0x34, 0x0d, 0x50, 0xd7, 0xa1, 0xd1, 0x71, 0xa5, 0x25, 0xd8, 0x32, 0x72, 0x46, 0x70, 0x45,
+	0x5e, 0xaf, 0xaf, 0x25, 0xb0, 0xee, 0x67, 0xa7, 0x71, 0x7f, 0xa5, 0xc0, 0x42, 0x3f, 0x1e, 0xa4,
+	0x3e, 0xce, 0x8d, 0x46, 0xc3, 0x4e, 0x68, 0x7a, 0x3a, 0xb2, 0xf9, 0xa4, 0x97, 0x12, 0x72, 0x60,
+	0x33, 0xdf, 0x53, 0x4b, 0x32, 0xff, 0x18, 0x6e, 0x0c, 0x20, 0xcf, 0x99, 0xcb, 0xcc, 0x25, 0xe7,
+	0x32, 0xc5, 0xc4, 0xc4, 0x45, 0xfb, 0x1b, 0x05, 0x1a, 0x8f, 0x6c, 0x42, 0x23, 0x25, 0xb7, 0x51,
+	0x40, 0x6d, 0xd6, 0x8d, 0x90, 0x30, 0x78, 0xae, 0x43, 0x25, 0xbe, 0xaf, 0x08, 0xa6, 0x31, 0xa0,
+	0x2b, 0xb6, 0x8b, 0xe7, 0x93, 0x23, 0xb5, 0x3f, 0x2f, 0xc0, 0x8d, 0x9e, 0x8a, 0xca, 0x00, 0x7d,
+	0x0f, 0x1a, 0xf1, 0x38, 0x22, 0x0e, 0x34, 0x3f, 0xc2, 0x94, 0x71, 0xfb, 0x95, 0x61, 0x84, 0x47,
+	0xfc, 0x1f, 0x63, 0x8a, 0x2c, 0x44, 0x91, 0x7e, 0x0d, 0x65, 0x47, 0x34, 0xb1, 0x0e, 0x4c, 0x76,
+	0x6a, 0x98, 0xda, 0x2d, 0xbb, 0xf0, 0xb9, 0x64, 0x1f, 0x67, 0x67, 0x7d, 0xb1, 0x6c, 0xed, 0xa7,
+	0x55, 0x78, 0xfe, 0x0d, 0xdf, 0x42, 0x14, 0xb3, 0xca, 0x8b, 0x83, 0xfb, 0x1d, 0xdb, 0xb1, 0xb6,
+	0x2c, 0x96, 0xba, 0x11, 0xb5, 0xf7, 0x6c, 0xc7, 0xa6, 0x27, 0xa7, 0xc8, 0x45, 0x0b, 0x5d, 0x7d,
+	0x73, 0x25, 0x99, 0x28, 0x2d, 0xb8, 0x90, 0xce, 0x52, 0x0f, 0x07, 0x66, 0xa9, 0x21, 0x95, 0x7b,
+	0x38, 0xa6, 0x87, 0xac, 0xd5, 0xbf, 0x50, 0xe0, 0x72, 0x1b, 0x05, 0x87, 0xc6, 0x1e, 0xc3, 0x37,
+	0x6c, 0xcb, 0xb0, 0x02, 0x64, 0xbb, 0xb6, 0xdb, 0x92, 0x09, 0xde, 0x1c, 0xf6, 0x1c, 0x0e, 0x29,
+	0xbc, 0xf9, 0x18, 0x05, 0x87, 0x72, 0x7d, 0x43, 0x8a, 0x7a, 0x38, 0xa6, 0x5f, 0x6c, 0x77, 0x83,
+	0xd5, 0x8f, 0x15, 0xb8, 0x4a, 0x8e, 0x91, 0x1f, 0x29, 0x47, 0x8c, 0x63, 0x9b, 0x1e, 0xd8, 0x3c,
+	0xbd, 0xca, 0xbe, 0x0a, 0x8f, 0x5a, 0xbf, 0x9d, 0x63, 0xe4, 0xcb, 0x75, 0xf2, 0x2d, 0x2e, 0x6d,
+	0x07, 0x33, 0x97, 0x5d, 0x22, 0x79, 0x0b, 0xea, 0x9f, 0x29, 0x70, 0x91, 0x25, 0xfb, 0xc8, 0x7f,
+	0x0e, 0xda, 0xc3, 0x0e, 0x91, 0xb7, 0x90, 0x77, 0x46, 0xae, 0x1d, 0xa6, 0x72, 0xf9, 0x11, 0x97,
+	0xf3, 0x70, 0x4c, 0x9f, 0x21, 0x19, 0x98, 0xfa, 0x81, 0x02, 0xb3, 0xdc, 0x6f, 0x16, 0xde, 0x47,
+	0x1d, 0x87, 0x32, 0x77, 0x11, 0x39, 0x5c, 0x33, 0xce, 0xc3, 0x5f, 0x1b, 0x42, 0xce, 0x0e, 0xa6,
+	0x4c, 0xa1, 0x69, 0x92, 0x06, 0xa9, 0xef, 0x2b, 0x30, 0x1d, 0xe0, 0xb6, 0x77, 0x84, 0x23, 0x37,
+	0xc9, 0xd9, 0xdc, 0xdb, 0xa3, 0xd6, 0x46, 0xe7, 0x62, 0x24, 0xc6, 0xc3, 0x31, 0x7d, 0x32, 0x48,
+	0x02, 0xe6, 0x5f, 0x84, 0x8b, 0x39, 0xf1, 0xa7, 0x5e, 0x85, 0x72, 0xa4, 0x98, 0x38, 0xa9, 0x17,
+	0xf6, 0x24, 0x05, 0x86, 0x4b, 0xb9, 0x11, 0xa1, 0x2e, 0xc3, 0xd4, 0xbe, 0x1d, 0x10, 0x6a, 0x64,
+	0x28, 0x6b, 0x1c, 0x2a, 0xf1, 0x59, 0x4b, 0x40, 0xb0, 0xe9, 0xb9, 0x56, 0x8c, 0x26, 0xc6, 0xe4,
+	0x93, 0x02, 0x1c, 0x2a, 0xf6, 0x33, 0x05, 0x66, 0xb2, 0x7b, 0xdb, 0x47, 0x2d, 0xf5, 0x7b, 0x0a,
+	0x4c, 0xc8, 0x48, 0x13, 0x09, 0xcf, 0x39, 0xef, 0x48, 0x6b, 0x8a, 0x7f, 0x44, 0xe9, 0x94, 0xb2,
+	0xe7, 0x5f, 0x86, 0x6a, 0x02, 0x3c, 0xa8, 0x24, 0x56, 0x12, 0x25, 0x71, 0xde, 0x80, 0xe9, 0x4c,
+	0xe8, 0x8c, 0xd8, 0xa5, 0xb7, 0x61, 0x32, 0x15, 0x0d, 0x7d, 0xdc, 0x79, 0xbf, 0x0a, 0x15, 0xcf,
+	0xc7, 0x62, 0x3e, 0xa0, 0xdd, 0x86, 0x95, 0xc1, 0x4e, 0x92, 0x0d, 0xe9, 0x5f, 0x17, 0x60, 0x79,
+	0x13, 0xd3, 0x91, 0x14, 0x04, 0x23, 0x9b, 0xf1, 0x1f, 0x0c, 0xcc, 0xf8, 0xc3, 0x88, 0x8e, 0x93,
+	0xfd, 0x09, 0x5c, 0x3c, 0x38, 0xf1, 0x3d, 0x7a, 0x80, 0xa9, 0x6d, 0x22, 0xc7, 0xe8, 0x70, 0x2b,
+	0xeb, 0xc5, 0xd1, 0x96, 0x17, 0x5d, 0x4d, 0x0a, 0x11, 0x44, 0xda, 0xf7, 0x4a, 0xf0, 0xdc, 0x00,
+	0x65, 0x65, 0x77, 0xb1, 0x07, 0xe5, 0xf0, 0x95, 0x81, 0xbc, 0xc0, 0x7e, 0xe3, 0xf3, 0xba, 0x41,
+	0x70, 0xd3, 0x23, 0xbe, 0xea, 0x1f, 0x2b, 0x30, 0x9d, 0x4d, 0xd8, 0xe2, 0x18, 0x0d, 0x9d, 0xb0,
+	0x87, 0x12, 0xd9, 0x4c, 0x9d, 0x20, 0x71, 0x74, 0x26, 0xf7, 0x92, 0xb0, 0xf9, 0x7f, 0x55, 0x60,
+	0x32, 0x7d, 0xea, 0xff, 0x20, 0x3a, 0xd9, 0xa2, 0x8d, 0x6a, 0x9d, 0xa3, 0x4a, 0xa3, 0x3e, 0xd4,
+	0x3f, 0x54, 0x40, 0xed, 0xb6, 0x39, 0x87, 0xc5, 0xd3, 0xf4, 0x27, 0xcc, 0xb7, 0xce, 0xd1, 0xc6,
+	0x64, 0x1f, 0xfe, 0xa3, 0x02, 0x5c, 0xdb, 0xc4, 0x71, 0x77, 0xfb, 0x06, 0xc1, 0xc1, 0x06, 0x6b,
+	0xfc, 0xce, 0xda, 0xb6, 0x15, 0xb2, 0x6d, 0x5b, 0xce, 0x95, 0xbb, 0x74, 0xf6, 0x2b, 0xf7, 0xd7,
+	0xe0, 0xba, 0x83, 0x08, 0x35, 0x0e, 0x5d, 0xef, 0xd8, 0x35, 0x3a, 0x04, 0x07, 0x86, 0x85, 0x28,
+	0x32, 0xe4, 0xcd, 0x45, 0x5e, 0xb8, 0xea, 0x0c, 0xe7, 0x55, 0x86, 0x12, 0xda, 0x23, 0xef, 0x2e,
+	0xec, 0x35, 0xc5, 0x31, 0xb2, 0xa9, 0xe1, 0xe2, 0x63, 0x4e, 0xc8, 0xdb, 0xcc, 0xb2, 0x5e, 0x65,
+	0xc0, 0xd7, 0xf0, 0x31, 0x43, 0x65, 0x59, 0xd7, 0x73, 0x9d, 0x13, 0xc3, 0xde, 0x37, 0xd8, 0x38,
+	0x08, 0x5b, 0xbc, 0x77, 0x29, 0xeb, 0x35, 0x06, 0xdd, 0xda, 0x7f, 0xc4, 0x61, 0xda, 0x4f, 0x15,
+	0xb8, 0x9e, 0xef, 0x39, 0x79, 0xa6, 0xee, 0x42, 0x3d, 0x61, 0xf8, 0x01, 0x22, 0xb1, 0xba, 0xdc,
+	0x8d, 0x65, 0x7d, 0x2e, 0xb2, 0xed, 0x21, 0x22, 0x21, 0xbd, 0xfa, 0x16, 0x54, 0x62, 0x44, 0x11,
+	0x0d, 0x5f, 0xcb, 0x8d, 0x86, 0xc4, 0xab, 0x27, 0x31, 0x0c, 0x95, 0xd7, 0xb3, 0x6e, 0x95, 0xca,
+	0x9d, 0x90, 0xf9, 0x8b, 0x30, 0x17, 0x5d, 0x0d, 0x0c, 0xd7, 0xa3, 0xa1, 0x85, 0x45, 0xae, 0x90,
+	0x1a, 0xad, 0xbd, 0xe6, 0x51, 0x69, 0xe7, 0x8f, 0x15, 0xf8, 0xe2, 0x3d, 0xdf, 0x77, 0x4e, 0x72,
+	0x2c, 0xf5, 0x1d, 0xdb, 0xe4, 0x25, 0x82, 0xcf, 0xa1, 0x47, 0x17, 0x33, 0x7a, 0xd2, 0x05, 0x5d,
+	0x93, 0xcb, 0xde, 0x2e, 0xe8, 0x63, 0xb9, 0xf6, 0x22, 0x34, 0x87, 0x35, 0x43, 0x96, 0xb2, 0xbf,
+	0x54, 0xe2, 0xa9, 0x84, 0x74, 0xae, 0xed, 0xb6, 0x46, 0x67, 0x25, 0x9b, 0xfc, 0xa0, 0x16, 0x36,
+	0x88, 0xfd, 0x9e, 0xa8, 0x39, 0x25, 0xbd, 0xcc, 0x00, 0x3b, 0xf6, 0x7b, 0x98, 0x15, 0x75, 0xfe,
+	0xec, 0x87, 0x63, 0x88, 0x2f, 0xf0, 0xe3, 0xfc, 0x0b, 0x3c, 0x7f, 0x0d, 0xb4, 0x8d, 0x5a, 0x98,
+	0x7f, 0x85, 0xd7, 0xfe, 0xb4, 0x04, 0xf3, 0x79, 0x4a, 0xca, 0x20, 0xf4, 0xa1, 0x96, 0x98, 0xc0,
+	0x84, 0x19, 0xf4, 0xf1, 0x69, 0x67, 0x09, 0xdd, 0x9c, 0xc3, 0x70, 0xdb, 0xc1, 0x54, 0xaf, 0xc6,
+	0xd3, 0x1c, 0x92, 0xa7, 0x78, 0x21, 0x47, 0xf1, 0xf9, 0x1f, 0x15, 0xa0, 0x2a, 0xd3, 0x12, 0x9b,
+	0xd6, 0xf4, 0xeb, 0xed, 0x96, 0x61, 0xca, 0x26, 0x7c, 0x82, 0x24, 0xfb, 0x77, 0xce, 0xb1, 0xac,
+	0xd7, 0x6c, 0xb2, 0x83, 0xa9, 0x6c, 0x98, 0xd4, 0x4d, 0x28, 0x11, 0x1a, 0x96, 0xef, 0xa9, 0xb5,
+	0x3b, 0xc3, 0x04, 0x8c, 0x54, 0xa0, 0xc9, 0x06, 0x3a, 0x58, 0x17, 0xf4, 0x6c, 0x67, 0xe5, 0x44,
+	0x8e, 0x4f, 0x61, 0xb8, 0xdf, 0x4b, 0xe2, 0x9d, 0x05, 0x0e, 0xf8, 0xd0, 0x43, 0x7d, 0x15, 0x6a,
+	0x01, 0x46, 0xe6, 0x01, 0x12, 0x79, 0xb6, 0x5e, 0x5a, 0x2c, 0xae, 0x4c, 0xad, 0x3d, 0xdf, 0x27,
+	0xa3, 0xe9, 0x09, 0x74, 0x3d, 0x45, 0xac, 0x36, 0xe1, 0xa2, 0xe7, 0x63, 0x37, 0x7e, 0x14, 0x25,
+	0xc4, 0x4e, 0xf0, 0x54, 0x36, 0xcb, 0x96, 0xc2, 0xc1, 0x36, 0x17, 0x3e, 0xff, 0x03, 0x05, 0x20,
+	0xf6, 0xbe, 0x7a, 0x08, 0x95, 0xe8, 0x3a, 0x28, 0xf7, 0xf7, 0xb5, 0x11, 0xec, 0x6f, 0x62, 0x6f,
+	0xf4, 0xb2, 0xdc, 0x09, 0xc2, 0x42, 0xda, 0x26, 0x99, 0x6d, 0xa8, 0xd8, 0x44, 0xee, 0x81, 0x86,
+	0x60, 0x69, 0x33, 0x6a, 0x93, 0xa3, 0x93, 0xf6, 0x18, 0xf9, 0xfe, 0xe9, 0x4e, 0x4e, 0x32, 0x18,
+	0x0a, 0xa9, 0x60, 0xd0, 0x1e, 0x80, 0xd6, 0x4f, 0x84, 0x8c, 0xfb, 0x1b, 0x50, 0x8d, 0x8f, 0x9e,
+	0x70, 0x4b, 0x45, 0x87, 0xe8, 0xec, 0x11, 0xed, 0x1f, 0x15, 0xb8, 0xf6, 0x0d, 0x2f, 0x30, 0xf1,
+	0x1b, 0x2e, 0x4b, 0x81, 0x67, 0x99, 0x9d, 0x9e, 0xbe, 0xf0, 0x15, 0xcf, 0x5c, 0xf8, 0xb4, 0x57,
+	0xe0, 0x7a, 0xbe, 0xba, 0xf1, 0x63, 0x9d, 0x63, 0x44, 0xc2, 0x74, 0x2e, 0xea, 0x4b, 0xe5, 0x18,
+	0x11, 0x99, 0xc5, 0x3f, 0x2a, 0x40, 0x43, 0x74, 0x9e, 0xe7, 0x58, 0xea, 0xdf, 0xea, 0x4e, 0xdb,
+	0xa3, 0xab, 0x5c, 0xb7, 0xe2, 0x0e, 0x95, 0x18, 0xc8, 0x62, 0x56, 0x8e, 0x8b, 0x59, 0x72, 0x18,
+	0x9c, 0xf7, 0x18, 0x50, 0xbd, 0x0d, 0xb3, 0x31, 0x9e, 0xb8, 0xec, 0x5a, 0xfc, 0x7c, 0x56, 0xf4,
+	0xe9, 0x10, 0x53, 0x5c, 0x83, 0x2c, 0x6d, 0x09, 0x6e, 0xf4, 0x74, 0x8a, 0x2c, 0x02, 0xff, 0xa4,
+	0xc0, 0x52, 0x58, 0x21, 0xce, 0xd3, 0x77, 0xe7, 0x51, 0xf2, 0x96, 0x41, 0xeb, 0xa7, 0xba, 0xb4,
+	0x10, 0xc3, 0xd2, 0xba, 0x83, 0x91, 0xdb, 0xf1, 0xdf, 0x70, 0x65, 0x5e, 0x72, 0xc2, 0x2b, 0x22,
+	0x19, 0x99, 0x81, 0xda, 0x36, 0x68, 0xfd, 0xc4, 0xc8, 0x30, 0xbe, 0x0d, 0xb3, 0x72, 0xcf, 0x8c,
+	0x74, 0x52, 0xab, 0xe8, 0x72, 0x62, 0x12, 0x5e, 0x67, 0x89, 0x66, 0xc1, 0xe2, 0x66, 0x94, 0xfe,
+	0xc3, 0x84, 0x60, 0xb7, 0xb1, 0x63, 0xbb, 0xa3, 0x3b, 0xc6, 0xda, 0x09, 0x2c, 0xf5, 0x91, 0x22,
+	0xd5, 0xde, 0x85, 0x32, 0x95, 0x30, 0x99, 0x82, 0x5f, 0x3a, 0x45, 0xe0, 0xdb, 0x6e, 0xeb, 0x5e,
+	0xc7, 0xb2, 0xa9, 0xb8, 0x75, 0x44, 0x9c, 0xb4, 0x3f, 0x54, 0xe0, 0xe6, 0x13, 0xe4, 0xd8, 0x2c,
+	0x42, 0xd3, 0x0a, 0xec, 0x1c, 0xdb, 0xd4, 0x3c, 0x18, 0x5d, 0xf4, 0x25, 0xf3, 0x6d, 0x31, 0x9d,
+	0x6f, 0x3f, 0x54, 0x60, 0xb9, 0xbf, 0x12, 0xd2, 0x07, 0x5f, 0xe6, 0xef, 0xc4, 0x4e, 0x6c, 0xb7,
+	0x95, 0xad, 0x64, 0x0a, 0xaf, 0x64, 0x73, 0x72, 0x35, 0x55, 0xcc, 0xd4, 0x35, 0xb8, 0xd4, 0xf6,
+	0x8e, 0x72, 0x88, 0xc4, 0x27, 0x83, 0x8b, 0x62, 0x31, 0x45, 0xa3, 0xfd, 0x83, 0x02, 0x37, 0x36,
+	0x31, 0xe5, 0xef, 0xc9, 0xa2, 0x97, 0x20, 0x52, 0xa9, 0xd1, 0xf9, 0x24, 0xf5, 0x1e, 0xa4, 0x78,
+	0xf6, 0xf7, 0x20, 0xda, 0xdb, 0xb0, 0xd8, 0x5b, 0x5b, 0xe9, 0xbc, 0x3e, 0xdd, 0x4f, 0x03, 0x20,
+	0xc0, 0x2d, 0x16, 0x35, 0x81, 0xfc, 0xf6, 0x5c, 0xd6, 0x13, 0x10, 0xed, 0x03, 0x05, 0x6e, 0x6e,
+	0x62, 0x1a, 0x9e, 0xeb, 0xed, 0xc0, 0xf3, 0x51, 0x8b, 0xb7, 0xb3, 0xf2, 0xbb, 0xd5, 0xe9, 0x5e,
+	0xaf, 0x46, 0x1d, 0x69, 0x61, 0x70, 0x47, 0x5a, 0xcc, 0xeb, 0x48, 0xdf, 0x9f, 0x80, 0xe5, 0xfe,
+	0xfa, 0x48, 0x9b, 0x7f, 0xbf, 0xbb, 0x46, 0x57, 0xd7, 0xbe, 0x7d, 0x8a, 0x8b, 0xef, 0x40, 0x11,
+	0x5d, 0x9f, 0xf0, 0x12, 0x1d, 0xc0, 0xd0, 0x8d, 0xea, 0xc7, 0x45, 0x98, 0xce, 0xf0, 0xc9, 0x84,
+	0x8e, 0x92, 0x0d, 0x9d, 0xdb, 0x30, 0xdb, 0x7d, 0x35, 0x15, 0x01, 0x3d, 0xdd, 0xc9, 0xdc, 0x48,
+	0xbf, 0x04, 0x97, 0x7c, 0xa9, 0x3f, 0xb6, 0x92, 0xdf, 0x6d, 0xc4, 0x8d, 0x60, 0x2e, 0x5e, 0x4c,
+	0x7c, 0xf5, 0x79, 0x01, 0x66, 0xa8, 0x47, 0x91, 0x93, 0xc4, 0x17, 0x6d, 0xea, 0x34, 0x87, 0xa7,
+	0x51, 0xf7, 0x3b, 0x8e, 0x73, 0x62, 0xc4, 0x8c, 0xf8, 0x05, 0xbc, 0xac, 0x4f, 0x73, 0xf8, 0x76,
+	0x04, 0x56, 0x57, 0xe1, 0x62, 0xc7, 0x15, 0x1d, 0x44, 0x92, 0xb1, 0xf8, 0x0f, 0x09, 0x6a, 0xb8,
+	0x94, 0xe0, 0xfd, 0x47, 0x0a, 0xcc, 0x44, 0x88, 0x06, 0x0e, 0x02, 0x2f, 0x60, 0x63, 0xf5, 0xe2,
+	0x29, 0x07, 0x18, 0x83, 0xf7, 0x31, 0x92, 0xf9, 0x80, 0xc9, 0xd0, 0xa7, 0xfd, 0xd4, 0x6f, 0x32,
+	0xff, 0x91, 0x02, 0x53, 0x69, 0x9c, 0x41, 0x5b, 0x34, 0xda, 0x97, 0x00, 0x73, 0x50, 0xe2, 0xd6,
+	0xcb, 0xe4, 0x29, 0x7e, 0x68, 0xef, 0x2b, 0xd0, 0xe0, 0x77, 0xce, 0x38, 0xcf, 0xef, 0xe2, 0xb6,
+	0xef, 0x20, 0x3a, 0xc2, 0x36, 0xf3, 0x26, 0x4c, 0x52, 0xc9, 0x94, 0xbf, 0xef, 0x94, 0x2a, 0xd4,
+	0x42, 0x20, 0x7b, 0xda, 0xc9, 0x1a, 0x9d, 0x9e, 0x8a, 0xc8, 0x36, 0xe0, 0xc7, 0x0a, 0x5c, 0xd6,
+	0x31, 0x22, 0xc4, 0x6e, 0xb9, 0x23, 0xcf, 0xa5, 0xbd, 0xeb, 0x0b, 0x3b, 0x86, 0x14, 0x05, 0xad,
+	0xc4, 0x17, 0x23, 0xf9, 0xe9, 0x6f, 0x52, 0x80, 0x13, 0x53, 0xee, 0xec, 0x71, 0x2d, 0xe5, 0xa5,
+	0x9f, 0x8f, 0x15, 0xb8, 0xd2, 0x65, 0x87, 0xcc, 0x38, 0x77, 0x60, 0x2e, 0x90, 0x4b, 0xd8, 0x8a,
+	0x0a, 0x0e, 0xe1, 0x06, 0x95, 0xf4, 0x8b, 0xf1, 0x5a, 0x98, 0xa6, 0x89, 0xfa, 0x1b, 0x30, 0x4b,
+	0x0e, 0x6d, 0xdf, 0x4f, 0xe1, 0x8b, 0xd4, 0x38, 0x23, 0x17, 0x62, 0xe4, 0x61, 0x53, 0xe4, 0xf7,
+	0x0b, 0xd0, 0x90, 0xb3, 0xa3, 0x0d, 0x9b, 0xf8, 0xec, 0x50, 0x6c, 0x60, 0xd3, 0x66, 0x5b, 0xf3,
+	0x2b, 0x7a, 0xff, 0x60, 0x29, 0x2d, 0x2a, 0xd0, 0x99, 0x8d, 0x9a, 0x3e, 0x4e, 0x17, 0x35, 0xd6,
+	0x09, 0x74, 0x08, 0x36, 0x4c, 0x39, 0x8a, 0x74, 0x70, 0x94, 0x03, 0x45, 0xe2, 0x99, 0xeb, 0x10,
+	0xbc, 0x1e, 0x2d, 0xca, 0x98, 0xd4, 0xf6, 0x78, 0x51, 0xcf, 0xf7, 0xc9, 0xe0, 0x2a, 0xb9, 0x0c,
+	0x53, 0xe9, 0xa7, 0x26, 0xd2, 0x21, 0xb5, 0xe4, 0x4b, 0x13, 0xed, 0xfb, 0x0a, 0x2c, 0x88, 0xff,
+	0xcc, 0x24, 0x86, 0xa6, 0xe7, 0x31, 0xd6, 0xe9, 0x13, 0xeb, 0x73, 0x50, 0xda, 0xf7, 0xc2, 0xe7,
+	0x72, 0x65, 0x5d, 0xfc, 0xd0, 0xd6, 0xa1, 0xd1, 0x4b, 0x27, 0x69, 0x77, 0x76, 0x22, 0xa1, 0x74,
+	0x4d, 0x24, 0xb4, 0xbf, 0x57, 0xe0, 0x39, 0xf6, 0x4e, 0x61, 0x24, 0x1f, 0x5e, 0x46, 0xd1, 0x07,
+	0x30, 0x3f, 0xb4, 0xd1, 0xbb, 0x62, 0xec, 0x24, 0x6a, 0xd3, 0x85, 0x36, 0x7a, 0x97, 0xcd, 0x88,
+	0xb4, 0xef, 0x16, 0xe1, 0xd6, 0x20, 0x65, 0xa5, 0xe9, 0x1f, 0x28, 0x79, 0x5d, 0xc2, 0xd0, 0x1f,
+	0xf7, 0x86, 0x93, 0x12, 0x87, 0x7e, 0x2e, 0xd6, 0x59, 0xba, 0x86, 0x1f, 0x2a, 0xb0, 0xd0, 0x97,
+	0xeb, 0xa0, 0x02, 0xf5, 0x36, 0xa8, 0x6d, 0xf4, 0x1d, 0x2f, 0x30, 0x52, 0xf3, 0x3b, 0xf1, 0x51,
+	0x66, 0xb5, 0xcf, 0x63, 0x8e, 0xae, 0x83, 0xc5, 0x26, 0x74, 0x33, 0x9c, 0x55, 0x0c, 0x20, 0xda,
+	0xef, 0xc1, 0x42, 0x3c, 0x46, 0x49, 0x0d, 0xa7, 0x7e, 0x11, 0x97, 0x8a, 0x3f, 0x51, 0xa0, 0xd1,
+	0x4b, 0xbc, 0xdc, 0xf8, 0x03, 0xb8, 0x92, 0x48, 0x5f, 0xa9, 0x69, 0x9b, 0xf8, 0x0a, 0xf6, 0xe2,
+	0x50, 0x4f, 0x79, 0x92, 0xac, 0x2f, 0xd1, 0x3c, 0xf0, 0xfd, 0xe0, 0x93, 0x4f, 0x1b, 0x63, 0x3f,
+	0xf9, 0xb4, 0x31, 0xf6, 0xf3, 0x4f, 0x1b, 0xca, 0x77, 0x9f, 0x35, 0x94, 0xbf, 0x7d, 0xd6, 0x50,
+	0xfe, 0xe5, 0x59, 0x43, 0xf9, 0xe4, 0x59, 0x43, 0xf9, 0x8f, 0x67, 0x0d, 0xe5, 0xbf, 0x9e, 0x35,
+	0xc6, 0x7e, 0xfe, 0xac, 0xa1, 0x7c, 0xf8, 0x59, 0x63, 0xec, 0x93, 0xcf, 0x1a, 0x63, 0x3f, 0xf9,
+	0xac, 0x31, 0xf6, 0xe6, 0x6f, 0xb5, 0xbc, 0x58, 0x01, 0xdb, 0xeb, 0xff, 0x1f, 0xd4, 0x7f, 0x33,
+	0x03, 0xda, 0x9b, 0xe0, 0xaf, 0xb0, 0xbf, 0xf4, 0xff, 0x03, 0x00, 0xe7, 0x1a, 0x46, 0x58, 0xe1,
+	0x3e, 0x00, 0x00,
+}
+
+func (this *PollWorkflowTaskQueueRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PollWorkflowTaskQueueRequest)
+	if !ok {
+		that2, ok := that.(PollWorkflowTaskQueueRequest)
+		if ok {
+			that1 = &that2
 		} else {
 			return false
 		}
@@ -2893,25 +4762,25 @@ func (this *RespondQueryTaskCompletedRequest) Equal(that interface{}) bool {
 	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if !this.TaskQueue.Equal(that1.TaskQueue) {
+	if this.PollerId != that1.PollerId {
 		return false
 	}
-	if this.TaskId != that1.TaskId {
+	if !this.PollRequest.Equal(that1.PollRequest) {
 		return false
 	}
-	if !this.CompletedRequest.Equal(that1.CompletedRequest) {
+	if this.ForwardedSource != that1.ForwardedSource {
 		return false
 	}
 	return true
 }
-func (this *RespondQueryTaskCompletedResponse) Equal(that interface{}) bool {
+func (this *PollWorkflowTaskQueueResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*RespondQueryTaskCompletedResponse)
+	that1, ok := that.(*PollWorkflowTaskQueueResponse)
 	if !ok {
-		that2, ok := that.(RespondQueryTaskCompletedResponse)
+		that2, ok := that.(PollWorkflowTaskQueueResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2923,16 +4792,85 @@ func (this *RespondQueryTaskCompletedResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if !bytes.Equal(this.TaskToken, that1.TaskToken) {
+		return false
+	}
+	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
+		return false
+	}
+	if !this.WorkflowType.Equal(that1.WorkflowType) {
+		return false
+	}
+	if this.PreviousStartedEventId != that1.PreviousStartedEventId {
+		return false
+	}
+	if this.StartedEventId != that1.StartedEventId {
+		return false
+	}
+	if this.Attempt != that1.Attempt {
+		return false
+	}
+	if this.NextEventId != that1.NextEventId {
+		return false
+	}
+	if this.BacklogCountHint != that1.BacklogCountHint {
+		return false
+	}
+	if this.StickyExecutionEnabled != that1.StickyExecutionEnabled {
+		return false
+	}
+	if !this.Query.Equal(that1.Query) {
+		return false
+	}
+	if !this.TransientWorkflowTask.Equal(that1.TransientWorkflowTask) {
+		return false
+	}
+	if !this.WorkflowExecutionTaskQueue.Equal(that1.WorkflowExecutionTaskQueue) {
+		return false
+	}
+	if !bytes.Equal(this.BranchToken, that1.BranchToken) {
+		return false
+	}
+	if that1.ScheduledTime == nil {
+		if this.ScheduledTime != nil {
+			return false
+		}
+	} else if !this.ScheduledTime.Equal(*that1.ScheduledTime) {
+		return false
+	}
+	if that1.StartedTime == nil {
+		if this.StartedTime != nil {
+			return false
+		}
+	} else if !this.StartedTime.Equal(*that1.StartedTime) {
+		return false
+	}
+	if len(this.Queries) != len(that1.Queries) {
+		return false
+	}
+	for i := range this.Queries {
+		if !this.Queries[i].Equal(that1.Queries[i]) {
+			return false
+		}
+	}
+	if len(this.Messages) != len(that1.Messages) {
+		return false
+	}
+	for i := range this.Messages {
+		if !this.Messages[i].Equal(that1.Messages[i]) {
+			return false
+		}
+	}
 	return true
 }
-func (this *CancelOutstandingPollRequest) Equal(that interface{}) bool {
+func (this *PollActivityTaskQueueRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*CancelOutstandingPollRequest)
+	that1, ok := that.(*PollActivityTaskQueueRequest)
 	if !ok {
-		that2, ok := that.(CancelOutstandingPollRequest)
+		that2, ok := that.(PollActivityTaskQueueRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2947,25 +4885,25 @@ func (this *CancelOutstandingPollRequest) Equal(that interface{}) bool {
 	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if this.TaskQueueType != that1.TaskQueueType {
+	if this.PollerId != that1.PollerId {
 		return false
 	}
-	if !this.TaskQueue.Equal(that1.TaskQueue) {
+	if !this.PollRequest.Equal(that1.PollRequest) {
 		return false
 	}
-	if this.PollerId != that1.PollerId {
+	if this.ForwardedSource != that1.ForwardedSource {
 		return false
 	}
 	return true
 }
-func (this *CancelOutstandingPollResponse) Equal(that interface{}) bool {
+func (this *PollActivityTaskQueueResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*CancelOutstandingPollResponse)
+	that1, ok := that.(*PollActivityTaskQueueResponse)
 	if !ok {
-		that2, ok := that.(CancelOutstandingPollResponse)
+		that2, ok := that.(PollActivityTaskQueueResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2977,105 +4915,94 @@ func (this *CancelOutstandingPollResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	return true
-}
-func (this *DescribeTaskQueueRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*DescribeTaskQueueRequest)
-	if !ok {
-		that2, ok := that.(DescribeTaskQueueRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+	if !bytes.Equal(this.TaskToken, that1.TaskToken) {
+		return false
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	if !this.WorkflowExecution.Equal(that1.WorkflowExecution) {
 		return false
 	}
-	if this.NamespaceId != that1.NamespaceId {
+	if this.ActivityId != that1.ActivityId {
 		return false
 	}
-	if !this.DescRequest.Equal(that1.DescRequest) {
+	if !this.ActivityType.Equal(that1.ActivityType) {
 		return false
 	}
-	return true
-}
-func (this *DescribeTaskQueueResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+	if !this.Input.Equal(that1.Input) {
+		return false
 	}
-
-	that1, ok := that.(*DescribeTaskQueueResponse)
-	if !ok {
-		that2, ok := that.(DescribeTaskQueueResponse)
-		if ok {
-			that1 = &that2
-		} else {
+	if that1.ScheduledTime == nil {
+		if this.ScheduledTime != nil {
 			return false
 		}
+	} else if !this.ScheduledTime.Equal(*that1.ScheduledTime) {
+		return false
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	if this.ScheduleToCloseTimeout != nil && that1.ScheduleToCloseTimeout != nil {
+		if *this.ScheduleToCloseTimeout != *that1.ScheduleToCloseTimeout {
+			return false
+		}
+	} else if this.ScheduleToCloseTimeout != nil {
+		return false
+	} else if that1.ScheduleToCloseTimeout != nil {
 		return false
 	}
-	if len(this.Pollers) != len(that1.Pollers) {
+	if that1.StartedTime == nil {
+		if this.StartedTime != nil {
+			return false
+		}
+	} else if !this.StartedTime.Equal(*that1.StartedTime) {
 		return false
 	}
-	for i := range this.Pollers {
-		if !this.Pollers[i].Equal(that1.Pollers[i]) {
+	if this.StartToCloseTimeout != nil && that1.StartToCloseTimeout != nil {
+		if *this.StartToCloseTimeout != *that1.StartToCloseTimeout {
 			return false
 		}
+	} else if this.StartToCloseTimeout != nil {
+		return false
+	} else if that1.StartToCloseTimeout != nil {
+		return false
 	}
-	if !this.TaskQueueStatus.Equal(that1.TaskQueueStatus) {
+	if this.HeartbeatTimeout != nil && that1.HeartbeatTimeout != nil {
+		if *this.HeartbeatTimeout != *that1.HeartbeatTimeout {
+			return false
+		}
+	} else if this.HeartbeatTimeout != nil {
+		return false
+	} else if that1.HeartbeatTimeout != nil {
 		return false
 	}
-	return true
-}
-func (this *ListTaskQueuePartitionsRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+	if this.Attempt != that1.Attempt {
+		return false
 	}
-
-	that1, ok := that.(*ListTaskQueuePartitionsRequest)
-	if !ok {
-		that2, ok := that.(ListTaskQueuePartitionsRequest)
-		if ok {
-			that1 = &that2
-		} else {
+	if that1.CurrentAttemptScheduledTime == nil {
+		if this.CurrentAttemptScheduledTime != nil {
 			return false
 		}
+	} else if !this.CurrentAttemptScheduledTime.Equal(*that1.CurrentAttemptScheduledTime) {
+		return false
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	if !this.HeartbeatDetails.Equal(that1.HeartbeatDetails) {
 		return false
 	}
-	if this.Namespace != that1.Namespace {
+	if !this.WorkflowType.Equal(that1.WorkflowType) {
 		return false
 	}
-	if this.NamespaceId != that1.NamespaceId {
+	if this.WorkflowNamespace != that1.WorkflowNamespace {
 		return false
 	}
-	if !this.TaskQueue.Equal(that1.TaskQueue) {
+	if !this.Header.Equal(that1.Header) {
 		return false
 	}
 	return true
 }
-func (this *ListTaskQueuePartitionsResponse) Equal(that interface{}) bool {
+func (this *AddWorkflowTaskRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ListTaskQueuePartitionsResponse)
+	that1, ok := that.(*AddWorkflowTaskRequest)
 	if !ok {
-		that2, ok := that.(ListTaskQueuePartitionsResponse)
+		that2, ok := that.(AddWorkflowTaskRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3087,59 +5014,49 @@ func (this *ListTaskQueuePartitionsResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.ActivityTaskQueuePartitions) != len(that1.ActivityTaskQueuePartitions) {
+	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	for i := range this.ActivityTaskQueuePartitions {
-		if !this.ActivityTaskQueuePartitions[i].Equal(that1.ActivityTaskQueuePartitions[i]) {
-			return false
-		}
-	}
-	if len(this.WorkflowTaskQueuePartitions) != len(that1.WorkflowTaskQueuePartitions) {
+	if !this.Execution.Equal(that1.Execution) {
 		return false
 	}
-	for i := range this.WorkflowTaskQueuePartitions {
-		if !this.WorkflowTaskQueuePartitions[i].Equal(that1.WorkflowTaskQueuePartitions[i]) {
-			return false
-		}
+	if !this.TaskQueue.Equal(that1.TaskQueue) {
+		return false
 	}
-	return true
-}
-func (this *UpdateWorkerBuildIdCompatibilityRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+	if this.ScheduledEventId != that1.ScheduledEventId {
+		return false
 	}
-
-	that1, ok := that.(*UpdateWorkerBuildIdCompatibilityRequest)
-	if !ok {
-		that2, ok := that.(UpdateWorkerBuildIdCompatibilityRequest)
-		if ok {
-			that1 = &that2
-		} else {
+	if this.ScheduleToStartTimeout != nil && that1.ScheduleToStartTimeout != nil {
+		if *this.ScheduleToStartTimeout != *that1.ScheduleToStartTimeout {
 			return false
 		}
+	} else if this.ScheduleToStartTimeout != nil {
+		return false
+	} else if that1.ScheduleToStartTimeout != nil {
+		return false
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	if this.ForwardedSource != that1.ForwardedSource {
 		return false
 	}
-	if this.NamespaceId != that1.NamespaceId {
+	if this.Source != that1.Source {
 		return false
 	}
-	if !this.Request.Equal(that1.Request) {
+	if !this.Clock.Equal(that1.Clock) {
+		return false
+	}
+	if !this.VersionDirective.Equal(that1.VersionDirective) {
 		return false
 	}
 	return true
 }
-func (this *UpdateWorkerBuildIdCompatibilityResponse) Equal(that interface{}) bool {
+func (this *AddWorkflowTaskResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*UpdateWorkerBuildIdCompatibilityResponse)
+	that1, ok := that.(*AddWorkflowTaskResponse)
 	if !ok {
-		that2, ok := that.(UpdateWorkerBuildIdCompatibilityResponse)
+		that2, ok := that.(AddWorkflowTaskResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3153,14 +5070,14 @@ func (this *UpdateWorkerBuildIdCompatibilityResponse) Equal(that interface{}) bo
 	}
 	return true
 }
-func (this *GetWorkerBuildIdCompatibilityRequest) Equal(that interface{}) bool {
+func (this *AddActivityTaskRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetWorkerBuildIdCompatibilityRequest)
+	that1, ok := that.(*AddActivityTaskRequest)
 	if !ok {
-		that2, ok := that.(GetWorkerBuildIdCompatibilityRequest)
+		that2, ok := that.(AddActivityTaskRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3175,19 +5092,46 @@ func (this *GetWorkerBuildIdCompatibilityRequest) Equal(that interface{}) bool {
 	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if !this.Request.Equal(that1.Request) {
+	if !this.Execution.Equal(that1.Execution) {
+		return false
+	}
+	if !this.TaskQueue.Equal(that1.TaskQueue) {
+		return false
+	}
+	if this.ScheduledEventId != that1.ScheduledEventId {
+		return false
+	}
+	if this.ScheduleToStartTimeout != nil && that1.ScheduleToStartTimeout != nil {
+		if *this.ScheduleToStartTimeout != *that1.ScheduleToStartTimeout {
+			return false
+		}
+	} else if this.ScheduleToStartTimeout != nil {
+		return false
+	} else if that1.ScheduleToStartTimeout != nil {
+		return false
+	}
+	if this.ForwardedSource != that1.ForwardedSource {
+		return false
+	}
+	if this.Source != that1.Source {
+		return false
+	}
+	if !this.Clock.Equal(that1.Clock) {
+		return false
+	}
+	if !this.VersionDirective.Equal(that1.VersionDirective) {
 		return false
 	}
 	return true
 }
-func (this *GetWorkerBuildIdCompatibilityResponse) Equal(that interface{}) bool {
+func (this *AddActivityTaskResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetWorkerBuildIdCompatibilityResponse)
+	that1, ok := that.(*AddActivityTaskResponse)
 	if !ok {
-		that2, ok := that.(GetWorkerBuildIdCompatibilityResponse)
+		that2, ok := that.(AddActivityTaskResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3199,19 +5143,16 @@ func (this *GetWorkerBuildIdCompatibilityResponse) Equal(that interface{}) bool
 	} else if this == nil {
 		return false
 	}
-	if !this.Response.Equal(that1.Response) {
-		return false
-	}
 	return true
 }
-func (this *GetTaskQueueUserDataRequest) Equal(that interface{}) bool {
+func (this *QueryWorkflowRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetTaskQueueUserDataRequest)
+	that1, ok := that.(*QueryWorkflowRequest)
 	if !ok {
-		that2, ok := that.(GetTaskQueueUserDataRequest)
+		that2, ok := that.(QueryWorkflowRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3226,28 +5167,28 @@ func (this *GetTaskQueueUserDataRequest) Equal(that interface{}) bool {
 	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if this.TaskQueue != that1.TaskQueue {
+	if !this.TaskQueue.Equal(that1.TaskQueue) {
 		return false
 	}
-	if this.TaskQueueType != that1.TaskQueueType {
+	if !this.QueryRequest.Equal(that1.QueryRequest) {
 		return false
 	}
-	if this.LastKnownUserDataVersion != that1.LastKnownUserDataVersion {
+	if this.ForwardedSource != that1.ForwardedSource {
 		return false
 	}
-	if this.WaitNewData != that1.WaitNewData {
+	if !this.VersionDirective.Equal(that1.VersionDirective) {
 		return false
 	}
 	return true
 }
-func (this *GetTaskQueueUserDataResponse) Equal(that interface{}) bool {
+func (this *QueryWorkflowResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetTaskQueueUserDataResponse)
+	that1, ok := that.(*QueryWorkflowResponse)
 	if !ok {
-		that2, ok := that.(GetTaskQueueUserDataResponse)
+		that2, ok := that.(QueryWorkflowResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3259,22 +5200,22 @@ func (this *GetTaskQueueUserDataResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.TaskQueueHasUserData != that1.TaskQueueHasUserData {
+	if !this.QueryResult.Equal(that1.QueryResult) {
 		return false
 	}
-	if !this.UserData.Equal(that1.UserData) {
+	if !this.QueryRejected.Equal(that1.QueryRejected) {
 		return false
 	}
 	return true
 }
-func (this *ApplyTaskQueueUserDataReplicationEventRequest) Equal(that interface{}) bool {
+func (this *RespondQueryTaskCompletedRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ApplyTaskQueueUserDataReplicationEventRequest)
+	that1, ok := that.(*RespondQueryTaskCompletedRequest)
 	if !ok {
-		that2, ok := that.(ApplyTaskQueueUserDataReplicationEventRequest)
+		that2, ok := that.(RespondQueryTaskCompletedRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3289,22 +5230,25 @@ func (this *ApplyTaskQueueUserDataReplicationEventRequest) Equal(that interface{
 	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if this.TaskQueue != that1.TaskQueue {
+	if !this.TaskQueue.Equal(that1.TaskQueue) {
 		return false
 	}
-	if !this.UserData.Equal(that1.UserData) {
+	if this.TaskId != that1.TaskId {
+		return false
+	}
+	if !this.CompletedRequest.Equal(that1.CompletedRequest) {
 		return false
 	}
 	return true
 }
-func (this *ApplyTaskQueueUserDataReplicationEventResponse) Equal(that interface{}) bool {
+func (this *RespondQueryTaskCompletedResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ApplyTaskQueueUserDataReplicationEventResponse)
+	that1, ok := that.(*RespondQueryTaskCompletedResponse)
 	if !ok {
-		that2, ok := that.(ApplyTaskQueueUserDataReplicationEventResponse)
+		that2, ok := that.(RespondQueryTaskCompletedResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3318,14 +5262,14 @@ func (this *ApplyTaskQueueUserDataReplicationEventResponse) Equal(that interface
 	}
 	return true
 }
-func (this *GetBuildIdTaskQueueMappingRequest) Equal(that interface{}) bool {
+func (this *CancelOutstandingPollRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetBuildIdTaskQueueMappingRequest)
+	that1, ok := that.(*CancelOutstandingPollRequest)
 	if !ok {
-		that2, ok := that.(GetBuildIdTaskQueueMappingRequest)
+		that2, ok := that.(CancelOutstandingPollRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3340,19 +5284,25 @@ func (this *GetBuildIdTaskQueueMappingRequest) Equal(that interface{}) bool {
 	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if this.BuildId != that1.BuildId {
+	if this.TaskQueueType != that1.TaskQueueType {
 		return false
 	}
-	return true
-}
-func (this *GetBuildIdTaskQueueMappingResponse) Equal(that interface{}) bool {
+	if !this.TaskQueue.Equal(that1.TaskQueue) {
+		return false
+	}
+	if this.PollerId != that1.PollerId {
+		return false
+	}
+	return true
+}
+func (this *CancelOutstandingPollResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetBuildIdTaskQueueMappingResponse)
+	that1, ok := that.(*CancelOutstandingPollResponse)
 	if !ok {
-		that2, ok := that.(GetBuildIdTaskQueueMappingResponse)
+		that2, ok := that.(CancelOutstandingPollResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3364,24 +5314,16 @@ func (this *GetBuildIdTaskQueueMappingResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.TaskQueues) != len(that1.TaskQueues) {
-		return false
-	}
-	for i := range this.TaskQueues {
-		if this.TaskQueues[i] != that1.TaskQueues[i] {
-			return false
-		}
-	}
 	return true
 }
-func (this *ForceUnloadTaskQueueRequest) Equal(that interface{}) bool {
+func (this *DescribeTaskQueueRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ForceUnloadTaskQueueRequest)
+	that1, ok := that.(*DescribeTaskQueueRequest)
 	if !ok {
-		that2, ok := that.(ForceUnloadTaskQueueRequest)
+		that2, ok := that.(DescribeTaskQueueRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3396,22 +5338,27 @@ func (this *ForceUnloadTaskQueueRequest) Equal(that interface{}) bool {
 	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if this.TaskQueue != that1.TaskQueue {
+	if !this.DescRequest.Equal(that1.DescRequest) {
 		return false
 	}
-	if this.TaskQueueType != that1.TaskQueueType {
+	if len(this.VersionSetIds) != len(that1.VersionSetIds) {
 		return false
 	}
+	for i := range this.VersionSetIds {
+		if this.VersionSetIds[i] != that1.VersionSetIds[i] {
+			return false
+		}
+	}
 	return true
 }
-func (this *ForceUnloadTaskQueueResponse) Equal(that interface{}) bool {
+func (this *DescribeTaskQueueResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ForceUnloadTaskQueueResponse)
+	that1, ok := that.(*DescribeTaskQueueResponse)
 	if !ok {
-		that2, ok := that.(ForceUnloadTaskQueueResponse)
+		that2, ok := that.(DescribeTaskQueueResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3423,19 +5370,38 @@ func (this *ForceUnloadTaskQueueResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.WasLoaded != that1.WasLoaded {
+	if len(this.Pollers) != len(that1.Pollers) {
+		return false
+	}
+	for i := range this.Pollers {
+		if !this.Pollers[i].Equal(that1.Pollers[i]) {
+			return false
+		}
+	}
+	if !this.TaskQueueStatus.Equal(that1.TaskQueueStatus) {
+		return false
+	}
+	if this.UnversionedBacklogCountHint != that1.UnversionedBacklogCountHint {
 		return false
 	}
+	if len(this.VersionedBacklogCountHints) != len(that1.VersionedBacklogCountHints) {
+		return false
+	}
+	for i := range this.VersionedBacklogCountHints {
+		if this.VersionedBacklogCountHints[i] != that1.VersionedBacklogCountHints[i] {
+			return false
+		}
+	}
 	return true
 }
-func (this *UpdateTaskQueueUserDataRequest) Equal(that interface{}) bool {
+func (this *ListTaskQueuePartitionsRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*UpdateTaskQueueUserDataRequest)
+	that1, ok := that.(*ListTaskQueuePartitionsRequest)
 	if !ok {
-		that2, ok := that.(UpdateTaskQueueUserDataRequest)
+		that2, ok := that.(ListTaskQueuePartitionsRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3447,41 +5413,62 @@ func (this *UpdateTaskQueueUserDataRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.Namespace != that1.Namespace {
+		return false
+	}
 	if this.NamespaceId != that1.NamespaceId {
 		return false
 	}
-	if this.TaskQueue != that1.TaskQueue {
+	if !this.TaskQueue.Equal(that1.TaskQueue) {
 		return false
 	}
-	if !this.UserData.Equal(that1.UserData) {
+	return true
+}
+func (this *ListTaskQueuePartitionsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ListTaskQueuePartitionsResponse)
+	if !ok {
+		that2, ok := that.(ListTaskQueuePartitionsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if len(this.BuildIdsAdded) != len(that1.BuildIdsAdded) {
+	if len(this.ActivityTaskQueuePartitions) != len(that1.ActivityTaskQueuePartitions) {
 		return false
 	}
-	for i := range this.BuildIdsAdded {
-		if this.BuildIdsAdded[i] != that1.BuildIdsAdded[i] {
+	for i := range this.ActivityTaskQueuePartitions {
+		if !this.ActivityTaskQueuePartitions[i].Equal(that1.ActivityTaskQueuePartitions[i]) {
 			return false
 		}
 	}
-	if len(this.BuildIdsRemoved) != len(that1.BuildIdsRemoved) {
+	if len(this.WorkflowTaskQueuePartitions) != len(that1.WorkflowTaskQueuePartitions) {
 		return false
 	}
-	for i := range this.BuildIdsRemoved {
-		if this.BuildIdsRemoved[i] != that1.BuildIdsRemoved[i] {
+	for i := range this.WorkflowTaskQueuePartitions {
+		if !this.WorkflowTaskQueuePartitions[i].Equal(that1.WorkflowTaskQueuePartitions[i]) {
 			return false
 		}
 	}
 	return true
 }
-func (this *UpdateTaskQueueUserDataResponse) Equal(that interface{}) bool {
+func (this *UpdateWorkerBuildIdCompatibilityRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*UpdateTaskQueueUserDataResponse)
+	that1, ok := that.(*UpdateWorkerBuildIdCompatibilityRequest)
 	if !ok {
-		that2, ok := that.(UpdateTaskQueueUserDataResponse)
+		that2, ok := that.(UpdateWorkerBuildIdCompatibilityRequest)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3493,16 +5480,31 @@ func (this *UpdateTaskQueueUserDataResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.TaskQueue != that1.TaskQueue {
+		return false
+	}
+	if that1.Operation == nil {
+		if this.Operation != nil {
+			return false
+		}
+	} else if this.Operation == nil {
+		return false
+	} else if !this.Operation.Equal(that1.Operation) {
+		return false
+	}
 	return true
 }
-func (this *ReplicateTaskQueueUserDataRequest) Equal(that interface{}) bool {
+func (this *UpdateWorkerBuildIdCompatibilityRequest_Request) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ReplicateTaskQueueUserDataRequest)
+	that1, ok := that.(*UpdateWorkerBuildIdCompatibilityRequest_Request)
 	if !ok {
-		that2, ok := that.(ReplicateTaskQueueUserDataRequest)
+		that2, ok := that.(UpdateWorkerBuildIdCompatibilityRequest_Request)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3514,25 +5516,43 @@ func (this *ReplicateTaskQueueUserDataRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.NamespaceId != that1.NamespaceId {
+	if !this.Request.Equal(that1.Request) {
 		return false
 	}
-	if this.TaskQueue != that1.TaskQueue {
+	return true
+}
+func (this *UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining_)
+	if !ok {
+		that2, ok := that.(UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
 		return false
 	}
-	if !this.UserData.Equal(that1.UserData) {
+	if !this.MarkBuildIdDraining.Equal(that1.MarkBuildIdDraining) {
 		return false
 	}
 	return true
 }
-func (this *ReplicateTaskQueueUserDataResponse) Equal(that interface{}) bool {
+func (this *UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet_) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ReplicateTaskQueueUserDataResponse)
+	that1, ok := that.(*UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet_)
 	if !ok {
-		that2, ok := that.(ReplicateTaskQueueUserDataResponse)
+		that2, ok := that.(UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet_)
 		if ok {
 			that1 = &that2
 		} else {
@@ -3544,3614 +5564,13522 @@ func (this *ReplicateTaskQueueUserDataResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if !this.SwapBuildIdsWithinSet.Equal(that1.SwapBuildIdsWithinSet) {
+		return false
+	}
 	return true
 }
-func (this *PollWorkflowTaskQueueRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&matchingservice.PollWorkflowTaskQueueRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "PollerId: "+fmt.Sprintf("%#v", this.PollerId)+",\n")
-	if this.PollRequest != nil {
-		s = append(s, "PollRequest: "+fmt.Sprintf("%#v", this.PollRequest)+",\n")
+
+	that1, ok := that.(*UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels_)
+	if !ok {
+		that2, ok := that.(UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *PollWorkflowTaskQueueResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 21)
-	s = append(s, "&matchingservice.PollWorkflowTaskQueueResponse{")
-	s = append(s, "TaskToken: "+fmt.Sprintf("%#v", this.TaskToken)+",\n")
-	if this.WorkflowExecution != nil {
-		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	if !this.SetBuildIdLabels.Equal(that1.SetBuildIdLabels) {
+		return false
 	}
-	if this.WorkflowType != nil {
-		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
+	return true
+}
+func (this *UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s = append(s, "PreviousStartedEventId: "+fmt.Sprintf("%#v", this.PreviousStartedEventId)+",\n")
-	s = append(s, "StartedEventId: "+fmt.Sprintf("%#v", this.StartedEventId)+",\n")
-	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
-	s = append(s, "NextEventId: "+fmt.Sprintf("%#v", this.NextEventId)+",\n")
-	s = append(s, "BacklogCountHint: "+fmt.Sprintf("%#v", this.BacklogCountHint)+",\n")
-	s = append(s, "StickyExecutionEnabled: "+fmt.Sprintf("%#v", this.StickyExecutionEnabled)+",\n")
-	if this.Query != nil {
-		s = append(s, "Query: "+fmt.Sprintf("%#v", this.Query)+",\n")
+
+	that1, ok := that.(*UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets_)
+	if !ok {
+		that2, ok := that.(UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.TransientWorkflowTask != nil {
-		s = append(s, "TransientWorkflowTask: "+fmt.Sprintf("%#v", this.TransientWorkflowTask)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if this.WorkflowExecutionTaskQueue != nil {
-		s = append(s, "WorkflowExecutionTaskQueue: "+fmt.Sprintf("%#v", this.WorkflowExecutionTaskQueue)+",\n")
+	if !this.SwapDefaultSets.Equal(that1.SwapDefaultSets) {
+		return false
 	}
-	s = append(s, "BranchToken: "+fmt.Sprintf("%#v", this.BranchToken)+",\n")
-	s = append(s, "ScheduledTime: "+fmt.Sprintf("%#v", this.ScheduledTime)+",\n")
-	s = append(s, "StartedTime: "+fmt.Sprintf("%#v", this.StartedTime)+",\n")
-	keysForQueries := make([]string, 0, len(this.Queries))
-	for k, _ := range this.Queries {
-		keysForQueries = append(keysForQueries, k)
+	return true
+}
+func (this *UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId_) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForQueries)
-	mapStringForQueries := "map[string]*v12.WorkflowQuery{"
-	for _, k := range keysForQueries {
-		mapStringForQueries += fmt.Sprintf("%#v: %#v,", k, this.Queries[k])
+
+	that1, ok := that.(*UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId_)
+	if !ok {
+		that2, ok := that.(UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId_)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	mapStringForQueries += "}"
-	if this.Queries != nil {
-		s = append(s, "Queries: "+mapStringForQueries+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if this.Messages != nil {
-		s = append(s, "Messages: "+fmt.Sprintf("%#v", this.Messages)+",\n")
+	if !this.RemoveBuildId.Equal(that1.RemoveBuildId) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *PollActivityTaskQueueRequest) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 8)
-	s = append(s, "&matchingservice.PollActivityTaskQueueRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "PollerId: "+fmt.Sprintf("%#v", this.PollerId)+",\n")
-	if this.PollRequest != nil {
-		s = append(s, "PollRequest: "+fmt.Sprintf("%#v", this.PollRequest)+",\n")
+func (this *UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *PollActivityTaskQueueResponse) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining)
+	if !ok {
+		that2, ok := that.(UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 20)
-	s = append(s, "&matchingservice.PollActivityTaskQueueResponse{")
-	s = append(s, "TaskToken: "+fmt.Sprintf("%#v", this.TaskToken)+",\n")
-	if this.WorkflowExecution != nil {
-		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "ActivityId: "+fmt.Sprintf("%#v", this.ActivityId)+",\n")
-	if this.ActivityType != nil {
-		s = append(s, "ActivityType: "+fmt.Sprintf("%#v", this.ActivityType)+",\n")
+	if this.BuildId != that1.BuildId {
+		return false
 	}
-	if this.Input != nil {
-		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
+	return true
+}
+func (this *UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s = append(s, "ScheduledTime: "+fmt.Sprintf("%#v", this.ScheduledTime)+",\n")
-	s = append(s, "ScheduleToCloseTimeout: "+fmt.Sprintf("%#v", this.ScheduleToCloseTimeout)+",\n")
-	s = append(s, "StartedTime: "+fmt.Sprintf("%#v", this.StartedTime)+",\n")
-	s = append(s, "StartToCloseTimeout: "+fmt.Sprintf("%#v", this.StartToCloseTimeout)+",\n")
-	s = append(s, "HeartbeatTimeout: "+fmt.Sprintf("%#v", this.HeartbeatTimeout)+",\n")
-	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
-	s = append(s, "CurrentAttemptScheduledTime: "+fmt.Sprintf("%#v", this.CurrentAttemptScheduledTime)+",\n")
-	if this.HeartbeatDetails != nil {
-		s = append(s, "HeartbeatDetails: "+fmt.Sprintf("%#v", this.HeartbeatDetails)+",\n")
+
+	that1, ok := that.(*UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet)
+	if !ok {
+		that2, ok := that.(UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.WorkflowType != nil {
-		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "WorkflowNamespace: "+fmt.Sprintf("%#v", this.WorkflowNamespace)+",\n")
-	if this.Header != nil {
-		s = append(s, "Header: "+fmt.Sprintf("%#v", this.Header)+",\n")
+	if this.FirstBuildId != that1.FirstBuildId {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if this.SecondBuildId != that1.SecondBuildId {
+		return false
+	}
+	return true
 }
-func (this *AddWorkflowTaskRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 13)
-	s = append(s, "&matchingservice.AddWorkflowTaskRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.Execution != nil {
-		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+
+	that1, ok := that.(*UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels)
+	if !ok {
+		that2, ok := that.(UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.TaskQueue != nil {
-		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "ScheduledEventId: "+fmt.Sprintf("%#v", this.ScheduledEventId)+",\n")
-	s = append(s, "ScheduleToStartTimeout: "+fmt.Sprintf("%#v", this.ScheduleToStartTimeout)+",\n")
-	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
-	s = append(s, "Source: "+fmt.Sprintf("%#v", this.Source)+",\n")
-	if this.Clock != nil {
-		s = append(s, "Clock: "+fmt.Sprintf("%#v", this.Clock)+",\n")
+	if this.BuildId != that1.BuildId {
+		return false
 	}
-	if this.VersionDirective != nil {
-		s = append(s, "VersionDirective: "+fmt.Sprintf("%#v", this.VersionDirective)+",\n")
+	if len(this.Labels) != len(that1.Labels) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *AddWorkflowTaskResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	for i := range this.Labels {
+		if this.Labels[i] != that1.Labels[i] {
+			return false
+		}
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&matchingservice.AddWorkflowTaskResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *AddActivityTaskRequest) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 13)
-	s = append(s, "&matchingservice.AddActivityTaskRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.Execution != nil {
-		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
+func (this *UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if this.TaskQueue != nil {
-		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+
+	that1, ok := that.(*UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets)
+	if !ok {
+		that2, ok := that.(UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "ScheduledEventId: "+fmt.Sprintf("%#v", this.ScheduledEventId)+",\n")
-	s = append(s, "ScheduleToStartTimeout: "+fmt.Sprintf("%#v", this.ScheduleToStartTimeout)+",\n")
-	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
-	s = append(s, "Source: "+fmt.Sprintf("%#v", this.Source)+",\n")
-	if this.Clock != nil {
-		s = append(s, "Clock: "+fmt.Sprintf("%#v", this.Clock)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if this.VersionDirective != nil {
-		s = append(s, "VersionDirective: "+fmt.Sprintf("%#v", this.VersionDirective)+",\n")
+	if this.FirstBuildId != that1.FirstBuildId {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *AddActivityTaskResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.SecondBuildId != that1.SecondBuildId {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&matchingservice.AddActivityTaskResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *QueryWorkflowRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&matchingservice.QueryWorkflowRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.TaskQueue != nil {
-		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+
+	that1, ok := that.(*UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId)
+	if !ok {
+		that2, ok := that.(UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.QueryRequest != nil {
-		s = append(s, "QueryRequest: "+fmt.Sprintf("%#v", this.QueryRequest)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
-	if this.VersionDirective != nil {
-		s = append(s, "VersionDirective: "+fmt.Sprintf("%#v", this.VersionDirective)+",\n")
+	if this.BuildId != that1.BuildId {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *QueryWorkflowResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *UpdateWorkerBuildIdCompatibilityResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&matchingservice.QueryWorkflowResponse{")
-	if this.QueryResult != nil {
-		s = append(s, "QueryResult: "+fmt.Sprintf("%#v", this.QueryResult)+",\n")
+
+	that1, ok := that.(*UpdateWorkerBuildIdCompatibilityResponse)
+	if !ok {
+		that2, ok := that.(UpdateWorkerBuildIdCompatibilityResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.QueryRejected != nil {
-		s = append(s, "QueryRejected: "+fmt.Sprintf("%#v", this.QueryRejected)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *RespondQueryTaskCompletedRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *GetWorkerBuildIdCompatibilityRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&matchingservice.RespondQueryTaskCompletedRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.TaskQueue != nil {
-		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+
+	that1, ok := that.(*GetWorkerBuildIdCompatibilityRequest)
+	if !ok {
+		that2, ok := that.(GetWorkerBuildIdCompatibilityRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
-	if this.CompletedRequest != nil {
-		s = append(s, "CompletedRequest: "+fmt.Sprintf("%#v", this.CompletedRequest)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *RespondQueryTaskCompletedResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&matchingservice.RespondQueryTaskCompletedResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *CancelOutstandingPollRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.Request.Equal(that1.Request) {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&matchingservice.CancelOutstandingPollRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "TaskQueueType: "+fmt.Sprintf("%#v", this.TaskQueueType)+",\n")
-	if this.TaskQueue != nil {
-		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	if !this.HypotheticalUpdate.Equal(that1.HypotheticalUpdate) {
+		return false
 	}
-	s = append(s, "PollerId: "+fmt.Sprintf("%#v", this.PollerId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *CancelOutstandingPollResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *GetWorkerBuildIdCompatibilityResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&matchingservice.CancelOutstandingPollResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DescribeTaskQueueRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*GetWorkerBuildIdCompatibilityResponse)
+	if !ok {
+		that2, ok := that.(GetWorkerBuildIdCompatibilityResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&matchingservice.DescribeTaskQueueRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.DescRequest != nil {
-		s = append(s, "DescRequest: "+fmt.Sprintf("%#v", this.DescRequest)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DescribeTaskQueueResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.Response.Equal(that1.Response) {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&matchingservice.DescribeTaskQueueResponse{")
-	if this.Pollers != nil {
-		s = append(s, "Pollers: "+fmt.Sprintf("%#v", this.Pollers)+",\n")
+	if len(this.BuildIdLabels) != len(that1.BuildIdLabels) {
+		return false
 	}
-	if this.TaskQueueStatus != nil {
-		s = append(s, "TaskQueueStatus: "+fmt.Sprintf("%#v", this.TaskQueueStatus)+",\n")
+	for i := range this.BuildIdLabels {
+		if !this.BuildIdLabels[i].Equal(that1.BuildIdLabels[i]) {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *ListTaskQueuePartitionsRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *GetWorkerBuildIdCompatibilityResponse_BuildIdLabels) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&matchingservice.ListTaskQueuePartitionsRequest{")
-	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.TaskQueue != nil {
-		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+
+	that1, ok := that.(*GetWorkerBuildIdCompatibilityResponse_BuildIdLabels)
+	if !ok {
+		that2, ok := that.(GetWorkerBuildIdCompatibilityResponse_BuildIdLabels)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ListTaskQueuePartitionsResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&matchingservice.ListTaskQueuePartitionsResponse{")
-	if this.ActivityTaskQueuePartitions != nil {
-		s = append(s, "ActivityTaskQueuePartitions: "+fmt.Sprintf("%#v", this.ActivityTaskQueuePartitions)+",\n")
+	if len(this.Labels) != len(that1.Labels) {
+		return false
 	}
-	if this.WorkflowTaskQueuePartitions != nil {
-		s = append(s, "WorkflowTaskQueuePartitions: "+fmt.Sprintf("%#v", this.WorkflowTaskQueuePartitions)+",\n")
+	for i := range this.Labels {
+		if this.Labels[i] != that1.Labels[i] {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *UpdateWorkerBuildIdCompatibilityRequest) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *GetTaskQueueUserDataRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&matchingservice.UpdateWorkerBuildIdCompatibilityRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.Request != nil {
-		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+
+	that1, ok := that.(*GetTaskQueueUserDataRequest)
+	if !ok {
+		that2, ok := that.(GetTaskQueueUserDataRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *UpdateWorkerBuildIdCompatibilityResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&matchingservice.UpdateWorkerBuildIdCompatibilityResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetWorkerBuildIdCompatibilityRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&matchingservice.GetWorkerBuildIdCompatibilityRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	if this.Request != nil {
-		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
+	if this.TaskQueue != that1.TaskQueue {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetWorkerBuildIdCompatibilityResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.TaskQueueType != that1.TaskQueueType {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&matchingservice.GetWorkerBuildIdCompatibilityResponse{")
-	if this.Response != nil {
-		s = append(s, "Response: "+fmt.Sprintf("%#v", this.Response)+",\n")
+	if this.LastKnownUserDataVersion != that1.LastKnownUserDataVersion {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetTaskQueueUserDataRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.WaitNewData != that1.WaitNewData {
+		return false
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&matchingservice.GetTaskQueueUserDataRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
-	s = append(s, "TaskQueueType: "+fmt.Sprintf("%#v", this.TaskQueueType)+",\n")
-	s = append(s, "LastKnownUserDataVersion: "+fmt.Sprintf("%#v", this.LastKnownUserDataVersion)+",\n")
-	s = append(s, "WaitNewData: "+fmt.Sprintf("%#v", this.WaitNewData)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if this.OnlyIfLoaded != that1.OnlyIfLoaded {
+		return false
+	}
+	return true
 }
-func (this *GetTaskQueueUserDataResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *GetTaskQueueUserDataResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&matchingservice.GetTaskQueueUserDataResponse{")
-	s = append(s, "TaskQueueHasUserData: "+fmt.Sprintf("%#v", this.TaskQueueHasUserData)+",\n")
-	if this.UserData != nil {
-		s = append(s, "UserData: "+fmt.Sprintf("%#v", this.UserData)+",\n")
+
+	that1, ok := that.(*GetTaskQueueUserDataResponse)
+	if !ok {
+		that2, ok := that.(GetTaskQueueUserDataResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ApplyTaskQueueUserDataReplicationEventRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&matchingservice.ApplyTaskQueueUserDataReplicationEventRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
-	if this.UserData != nil {
-		s = append(s, "UserData: "+fmt.Sprintf("%#v", this.UserData)+",\n")
+	if this.TaskQueueHasUserData != that1.TaskQueueHasUserData {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ApplyTaskQueueUserDataReplicationEventResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if !this.UserData.Equal(that1.UserData) {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&matchingservice.ApplyTaskQueueUserDataReplicationEventResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetBuildIdTaskQueueMappingRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.PartitionNotLoaded != that1.PartitionNotLoaded {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&matchingservice.GetBuildIdTaskQueueMappingRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "BuildId: "+fmt.Sprintf("%#v", this.BuildId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *GetBuildIdTaskQueueMappingResponse) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&matchingservice.GetBuildIdTaskQueueMappingResponse{")
-	s = append(s, "TaskQueues: "+fmt.Sprintf("%#v", this.TaskQueues)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ForceUnloadTaskQueueRequest) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 7)
-	s = append(s, "&matchingservice.ForceUnloadTaskQueueRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
-	s = append(s, "TaskQueueType: "+fmt.Sprintf("%#v", this.TaskQueueType)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ForceUnloadTaskQueueResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ApplyTaskQueueUserDataReplicationEventRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&matchingservice.ForceUnloadTaskQueueResponse{")
-	s = append(s, "WasLoaded: "+fmt.Sprintf("%#v", this.WasLoaded)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *UpdateTaskQueueUserDataRequest) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*ApplyTaskQueueUserDataReplicationEventRequest)
+	if !ok {
+		that2, ok := that.(ApplyTaskQueueUserDataReplicationEventRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&matchingservice.UpdateTaskQueueUserDataRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
-	if this.UserData != nil {
-		s = append(s, "UserData: "+fmt.Sprintf("%#v", this.UserData)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "BuildIdsAdded: "+fmt.Sprintf("%#v", this.BuildIdsAdded)+",\n")
-	s = append(s, "BuildIdsRemoved: "+fmt.Sprintf("%#v", this.BuildIdsRemoved)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *UpdateTaskQueueUserDataResponse) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&matchingservice.UpdateTaskQueueUserDataResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *ReplicateTaskQueueUserDataRequest) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.TaskQueue != that1.TaskQueue {
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&matchingservice.ReplicateTaskQueueUserDataRequest{")
-	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
-	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
-	if this.UserData != nil {
-		s = append(s, "UserData: "+fmt.Sprintf("%#v", this.UserData)+",\n")
+	if !this.UserData.Equal(that1.UserData) {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *ReplicateTaskQueueUserDataResponse) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *ApplyTaskQueueUserDataReplicationEventResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&matchingservice.ReplicateTaskQueueUserDataResponse{")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func valueToGoStringRequestResponse(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	that1, ok := that.(*ApplyTaskQueueUserDataReplicationEventResponse)
+	if !ok {
+		that2, ok := that.(ApplyTaskQueueUserDataReplicationEventResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
-}
-func (m *PollWorkflowTaskQueueRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PollWorkflowTaskQueueRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
-
-func (m *PollWorkflowTaskQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ForwardedSource) > 0 {
-		i -= len(m.ForwardedSource)
-		copy(dAtA[i:], m.ForwardedSource)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ForwardedSource)))
-		i--
-		dAtA[i] = 0x22
+func (this *DescribeVersioningRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.PollRequest != nil {
-		{
-			size, err := m.PollRequest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+
+	that1, ok := that.(*DescribeVersioningRequest)
+	if !ok {
+		that2, ok := that.(DescribeVersioningRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if len(m.PollerId) > 0 {
-		i -= len(m.PollerId)
-		copy(dAtA[i:], m.PollerId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.PollerId)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0xa
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *PollWorkflowTaskQueueResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.TaskQueue != that1.TaskQueue {
+		return false
 	}
-	return dAtA[:n], nil
+	if this.PageSize != that1.PageSize {
+		return false
+	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	return true
 }
+func (this *DescribeVersioningResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *PollWorkflowTaskQueueResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*DescribeVersioningResponse)
+	if !ok {
+		that2, ok := that.(DescribeVersioningResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.VersionSets) != len(that1.VersionSets) {
+		return false
+	}
+	for i := range this.VersionSets {
+		if !this.VersionSets[i].Equal(that1.VersionSets[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	return true
 }
+func (this *DescribeVersioningResponse_BuildIdInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *PollWorkflowTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Messages) > 0 {
-		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x92
+	that1, ok := that.(*DescribeVersioningResponse_BuildIdInfo)
+	if !ok {
+		that2, ok := that.(DescribeVersioningResponse_BuildIdInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.Queries) > 0 {
-		for k := range m.Queries {
-			v := m.Queries[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x8a
-		}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.StartedTime != nil {
-		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err3 != nil {
-			return 0, err3
-		}
-		i -= n3
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n3))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+	if this.BuildId != that1.BuildId {
+		return false
 	}
-	if m.ScheduledTime != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err4 != nil {
-			return 0, err4
-		}
-		i -= n4
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n4))
-		i--
-		dAtA[i] = 0x7a
+	if this.IsSetDefault != that1.IsSetDefault {
+		return false
 	}
-	if len(m.BranchToken) > 0 {
-		i -= len(m.BranchToken)
-		copy(dAtA[i:], m.BranchToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BranchToken)))
-		i--
-		dAtA[i] = 0x72
+	if this.State != that1.State {
+		return false
 	}
-	if m.WorkflowExecutionTaskQueue != nil {
-		{
-			size, err := m.WorkflowExecutionTaskQueue.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x62
+	if this.PollerCount != that1.PollerCount {
+		return false
 	}
-	if m.TransientWorkflowTask != nil {
-		{
-			size, err := m.TransientWorkflowTask.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
+	if len(this.Reachability) != len(that1.Reachability) {
+		return false
 	}
-	if m.Query != nil {
-		{
-			size, err := m.Query.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	for i := range this.Reachability {
+		if this.Reachability[i] != that1.Reachability[i] {
+			return false
 		}
-		i--
-		dAtA[i] = 0x52
 	}
-	if m.StickyExecutionEnabled {
-		i--
-		if m.StickyExecutionEnabled {
-			dAtA[i] = 1
+	if this.OpenWorkflowCount != that1.OpenWorkflowCount {
+		return false
+	}
+	return true
+}
+func (this *DescribeVersioningResponse_VersionSet) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DescribeVersioningResponse_VersionSet)
+	if !ok {
+		that2, ok := that.(DescribeVersioningResponse_VersionSet)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x48
 	}
-	if m.BacklogCountHint != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.BacklogCountHint))
-		i--
-		dAtA[i] = 0x40
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.NextEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.NextEventId))
-		i--
-		dAtA[i] = 0x38
+	if len(this.BuildIds) != len(that1.BuildIds) {
+		return false
 	}
-	if m.Attempt != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Attempt))
-		i--
-		dAtA[i] = 0x30
+	for i := range this.BuildIds {
+		if !this.BuildIds[i].Equal(that1.BuildIds[i]) {
+			return false
+		}
 	}
-	if m.StartedEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartedEventId))
-		i--
-		dAtA[i] = 0x28
+	if this.IsDefault != that1.IsDefault {
+		return false
 	}
-	if m.PreviousStartedEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PreviousStartedEventId))
-		i--
-		dAtA[i] = 0x20
+	return true
+}
+func (this *GetBuildIdTaskQueueMappingRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.WorkflowType != nil {
-		{
-			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+
+	that1, ok := that.(*GetBuildIdTaskQueueMappingRequest)
+	if !ok {
+		that2, ok := that.(GetBuildIdTaskQueueMappingRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.TaskToken) > 0 {
-		i -= len(m.TaskToken)
-		copy(dAtA[i:], m.TaskToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskToken)))
-		i--
-		dAtA[i] = 0xa
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *PollActivityTaskQueueRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.BuildId != that1.BuildId {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PollActivityTaskQueueRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
-
-func (m *PollActivityTaskQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ForwardedSource) > 0 {
-		i -= len(m.ForwardedSource)
-		copy(dAtA[i:], m.ForwardedSource)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ForwardedSource)))
-		i--
-		dAtA[i] = 0x22
+func (this *GetBuildIdTaskQueueMappingResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.PollRequest != nil {
-		{
-			size, err := m.PollRequest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+
+	that1, ok := that.(*GetBuildIdTaskQueueMappingResponse)
+	if !ok {
+		that2, ok := that.(GetBuildIdTaskQueueMappingResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if len(m.PollerId) > 0 {
-		i -= len(m.PollerId)
-		copy(dAtA[i:], m.PollerId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.PollerId)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0xa
+	if len(this.TaskQueues) != len(that1.TaskQueues) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	for i := range this.TaskQueues {
+		if this.TaskQueues[i] != that1.TaskQueues[i] {
+			return false
+		}
+	}
+	return true
 }
-
-func (m *PollActivityTaskQueueResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ForceUnloadTaskQueueRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *PollActivityTaskQueueResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*ForceUnloadTaskQueueRequest)
+	if !ok {
+		that2, ok := that.(ForceUnloadTaskQueueRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.TaskQueue != that1.TaskQueue {
+		return false
+	}
+	if this.TaskQueueType != that1.TaskQueueType {
+		return false
+	}
+	return true
 }
+func (this *ForceUnloadTaskQueueResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *PollActivityTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		{
-			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	that1, ok := that.(*ForceUnloadTaskQueueResponse)
+	if !ok {
+		that2, ok := that.(ForceUnloadTaskQueueResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
 	}
-	if len(m.WorkflowNamespace) > 0 {
-		i -= len(m.WorkflowNamespace)
-		copy(dAtA[i:], m.WorkflowNamespace)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.WorkflowNamespace)))
-		i--
-		dAtA[i] = 0x7a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.WorkflowType != nil {
-		{
-			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x72
+	if this.WasLoaded != that1.WasLoaded {
+		return false
 	}
-	if m.HeartbeatDetails != nil {
-		{
-			size, err := m.HeartbeatDetails.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6a
+	return true
+}
+func (this *UpdateTaskQueueUserDataRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.CurrentAttemptScheduledTime != nil {
-		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CurrentAttemptScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CurrentAttemptScheduledTime):])
-		if err14 != nil {
-			return 0, err14
+
+	that1, ok := that.(*UpdateTaskQueueUserDataRequest)
+	if !ok {
+		that2, ok := that.(UpdateTaskQueueUserDataRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= n14
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n14))
-		i--
-		dAtA[i] = 0x62
 	}
-	if m.Attempt != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Attempt))
-		i--
-		dAtA[i] = 0x58
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.HeartbeatTimeout != nil {
-		n15, err15 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HeartbeatTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout):])
-		if err15 != nil {
-			return 0, err15
-		}
-		i -= n15
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n15))
-		i--
-		dAtA[i] = 0x52
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	if m.StartToCloseTimeout != nil {
-		n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StartToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout):])
-		if err16 != nil {
-			return 0, err16
-		}
-		i -= n16
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n16))
-		i--
-		dAtA[i] = 0x4a
+	if this.TaskQueue != that1.TaskQueue {
+		return false
 	}
-	if m.StartedTime != nil {
-		n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
-		if err17 != nil {
-			return 0, err17
-		}
-		i -= n17
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n17))
-		i--
-		dAtA[i] = 0x42
+	if !this.UserData.Equal(that1.UserData) {
+		return false
 	}
-	if m.ScheduleToCloseTimeout != nil {
-		n18, err18 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout):])
-		if err18 != nil {
-			return 0, err18
-		}
-		i -= n18
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n18))
-		i--
-		dAtA[i] = 0x3a
+	if len(this.BuildIdsAdded) != len(that1.BuildIdsAdded) {
+		return false
 	}
-	if m.ScheduledTime != nil {
-		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
-		if err19 != nil {
-			return 0, err19
+	for i := range this.BuildIdsAdded {
+		if this.BuildIdsAdded[i] != that1.BuildIdsAdded[i] {
+			return false
 		}
-		i -= n19
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n19))
-		i--
-		dAtA[i] = 0x32
 	}
-	if m.Input != nil {
-		{
-			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+	if len(this.BuildIdsRemoved) != len(that1.BuildIdsRemoved) {
+		return false
 	}
-	if m.ActivityType != nil {
-		{
-			size, err := m.ActivityType.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	for i := range this.BuildIdsRemoved {
+		if this.BuildIdsRemoved[i] != that1.BuildIdsRemoved[i] {
+			return false
 		}
-		i--
-		dAtA[i] = 0x22
 	}
-	if len(m.ActivityId) > 0 {
-		i -= len(m.ActivityId)
-		copy(dAtA[i:], m.ActivityId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ActivityId)))
-		i--
-		dAtA[i] = 0x1a
+	return true
+}
+func (this *UpdateTaskQueueUserDataResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+
+	that1, ok := that.(*UpdateTaskQueueUserDataResponse)
+	if !ok {
+		that2, ok := that.(UpdateTaskQueueUserDataResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if len(m.TaskToken) > 0 {
-		i -= len(m.TaskToken)
-		copy(dAtA[i:], m.TaskToken)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskToken)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
+func (this *ReplicateTaskQueueUserDataRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *AddWorkflowTaskRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*ReplicateTaskQueueUserDataRequest)
+	if !ok {
+		that2, ok := that.(ReplicateTaskQueueUserDataRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.TaskQueue != that1.TaskQueue {
+		return false
+	}
+	if !this.UserData.Equal(that1.UserData) {
+		return false
+	}
+	return true
 }
+func (this *ReplicateTaskQueueUserDataResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *AddWorkflowTaskRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*ReplicateTaskQueueUserDataResponse)
+	if !ok {
+		that2, ok := that.(ReplicateTaskQueueUserDataResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *CleanupUnreachableBuildIdsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *AddWorkflowTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VersionDirective != nil {
-		{
-			size, err := m.VersionDirective.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	that1, ok := that.(*CleanupUnreachableBuildIdsRequest)
+	if !ok {
+		that2, ok := that.(CleanupUnreachableBuildIdsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x52
 	}
-	if m.Clock != nil {
-		{
-			size, err := m.Clock.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Source != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Source))
-		i--
-		dAtA[i] = 0x38
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	if len(m.ForwardedSource) > 0 {
-		i -= len(m.ForwardedSource)
-		copy(dAtA[i:], m.ForwardedSource)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ForwardedSource)))
-		i--
-		dAtA[i] = 0x32
+	if this.TaskQueue != that1.TaskQueue {
+		return false
 	}
-	if m.ScheduleToStartTimeout != nil {
-		n25, err25 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
-		if err25 != nil {
-			return 0, err25
+	return true
+}
+func (this *CleanupUnreachableBuildIdsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CleanupUnreachableBuildIdsResponse)
+	if !ok {
+		that2, ok := that.(CleanupUnreachableBuildIdsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= n25
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n25))
-		i--
-		dAtA[i] = 0x2a
 	}
-	if m.ScheduledEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ScheduledEventId))
-		i--
-		dAtA[i] = 0x20
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.TaskQueue != nil {
-		{
-			size, err := m.TaskQueue.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if len(this.RemovedBuildIds) != len(that1.RemovedBuildIds) {
+		return false
 	}
-	if m.Execution != nil {
-		{
-			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	for i := range this.RemovedBuildIds {
+		if this.RemovedBuildIds[i] != that1.RemovedBuildIds[i] {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *AddWorkflowTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *GetDefaultBuildIdTimelineRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AddWorkflowTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *AddWorkflowTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *AddActivityTaskRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*GetDefaultBuildIdTimelineRequest)
+	if !ok {
+		that2, ok := that.(GetDefaultBuildIdTimelineRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AddActivityTaskRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.TaskQueue != that1.TaskQueue {
+		return false
+	}
+	return true
 }
+func (this *GetDefaultBuildIdTimelineResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *AddActivityTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VersionDirective != nil {
-		{
-			size, err := m.VersionDirective.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	that1, ok := that.(*GetDefaultBuildIdTimelineResponse)
+	if !ok {
+		that2, ok := that.(GetDefaultBuildIdTimelineResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x52
 	}
-	if m.Clock != nil {
-		{
-			size, err := m.Clock.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Timeline) != len(that1.Timeline) {
+		return false
+	}
+	for i := range this.Timeline {
+		if !this.Timeline[i].Equal(that1.Timeline[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0x4a
 	}
-	if m.Source != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Source))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.ForwardedSource) > 0 {
-		i -= len(m.ForwardedSource)
-		copy(dAtA[i:], m.ForwardedSource)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ForwardedSource)))
-		i--
-		dAtA[i] = 0x3a
+	return true
+}
+func (this *ValidateDefaultBuildIdSwitchRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.ScheduleToStartTimeout != nil {
-		n30, err30 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
-		if err30 != nil {
-			return 0, err30
+
+	that1, ok := that.(*ValidateDefaultBuildIdSwitchRequest)
+	if !ok {
+		that2, ok := that.(ValidateDefaultBuildIdSwitchRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= n30
-		i = encodeVarintRequestResponse(dAtA, i, uint64(n30))
-		i--
-		dAtA[i] = 0x32
 	}
-	if m.ScheduledEventId != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ScheduledEventId))
-		i--
-		dAtA[i] = 0x28
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.TaskQueue != nil {
-		{
-			size, err := m.TaskQueue.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	if m.Execution != nil {
-		{
-			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if this.TaskQueue != that1.TaskQueue {
+		return false
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0xa
+	if this.BuildId != that1.BuildId {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *AddActivityTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ValidateDefaultBuildIdSwitchResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AddActivityTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AddActivityTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
 
-func (m *QueryWorkflowRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*ValidateDefaultBuildIdSwitchResponse)
+	if !ok {
+		that2, ok := that.(ValidateDefaultBuildIdSwitchResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.StayingWorkflowCount != that1.StayingWorkflowCount {
+		return false
+	}
+	if this.MovingWorkflowCount != that1.MovingWorkflowCount {
+		return false
+	}
+	return true
 }
+func (this *GetClosedWorkflowBuildIdRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *QueryWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VersionDirective != nil {
-		{
-			size, err := m.VersionDirective.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	that1, ok := that.(*GetClosedWorkflowBuildIdRequest)
+	if !ok {
+		that2, ok := that.(GetClosedWorkflowBuildIdRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x2a
 	}
-	if len(m.ForwardedSource) > 0 {
-		i -= len(m.ForwardedSource)
-		copy(dAtA[i:], m.ForwardedSource)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ForwardedSource)))
-		i--
-		dAtA[i] = 0x22
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.QueryRequest != nil {
-		{
-			size, err := m.QueryRequest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	if m.TaskQueue != nil {
-		{
-			size, err := m.TaskQueue.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if this.TaskQueue != that1.TaskQueue {
+		return false
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0xa
+	if !this.Execution.Equal(that1.Execution) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *QueryWorkflowResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *GetClosedWorkflowBuildIdResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryWorkflowResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *QueryWorkflowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.QueryRejected != nil {
-		{
-			size, err := m.QueryRejected.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	that1, ok := that.(*GetClosedWorkflowBuildIdResponse)
+	if !ok {
+		that2, ok := that.(GetClosedWorkflowBuildIdResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.QueryResult != nil {
-		{
-			size, err := m.QueryResult.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if this.BuildId != that1.BuildId {
+		return false
+	}
+	if this.Registered != that1.Registered {
+		return false
+	}
+	return true
 }
+func (this *GetUserDataPropagationStatusRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RespondQueryTaskCompletedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*GetUserDataPropagationStatusRequest)
+	if !ok {
+		that2, ok := that.(GetUserDataPropagationStatusRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RespondQueryTaskCompletedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.PageSize != that1.PageSize {
+		return false
+	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	return true
 }
+func (this *GetUserDataPropagationStatusResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *RespondQueryTaskCompletedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CompletedRequest != nil {
-		{
-			size, err := m.CompletedRequest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	that1, ok := that.(*GetUserDataPropagationStatusResponse)
+	if !ok {
+		that2, ok := that.(GetUserDataPropagationStatusResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x22
 	}
-	if len(m.TaskId) > 0 {
-		i -= len(m.TaskId)
-		copy(dAtA[i:], m.TaskId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskId)))
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.TaskQueue != nil {
-		{
-			size, err := m.TaskQueue.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	if len(this.TaskQueues) != len(that1.TaskQueues) {
+		return false
+	}
+	for i := range this.TaskQueues {
+		if !this.TaskQueues[i].Equal(that1.TaskQueues[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0xa
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *RespondQueryTaskCompletedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *GetUserDataPropagationStatusResponse_TaskQueueStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RespondQueryTaskCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RespondQueryTaskCompletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
 
-func (m *CancelOutstandingPollRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*GetUserDataPropagationStatusResponse_TaskQueueStatus)
+	if !ok {
+		that2, ok := that.(GetUserDataPropagationStatusResponse_TaskQueueStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TaskQueue != that1.TaskQueue {
+		return false
+	}
+	if this.UserDataVersion != that1.UserDataVersion {
+		return false
+	}
+	if this.PropagatedPartitions != that1.PropagatedPartitions {
+		return false
+	}
+	if this.TotalPartitions != that1.TotalPartitions {
+		return false
+	}
+	if this.FullyPropagated != that1.FullyPropagated {
+		return false
+	}
+	if this.UnloadedPartitions != that1.UnloadedPartitions {
+		return false
+	}
+	if len(this.PartitionErrors) != len(that1.PartitionErrors) {
+		return false
+	}
+	for i := range this.PartitionErrors {
+		if !this.PartitionErrors[i].Equal(that1.PartitionErrors[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *GetUserDataPropagationStatusResponse_PartitionError) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CancelOutstandingPollRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*GetUserDataPropagationStatusResponse_PartitionError)
+	if !ok {
+		that2, ok := that.(GetUserDataPropagationStatusResponse_PartitionError)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TaskQueue != that1.TaskQueue {
+		return false
+	}
+	if this.TaskQueueType != that1.TaskQueueType {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
 }
-
-func (m *CancelOutstandingPollRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PollerId) > 0 {
-		i -= len(m.PollerId)
-		copy(dAtA[i:], m.PollerId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.PollerId)))
-		i--
-		dAtA[i] = 0x22
+func (this *ApplyVersioningTemplateRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.TaskQueue != nil {
-		{
-			size, err := m.TaskQueue.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+
+	that1, ok := that.(*ApplyVersioningTemplateRequest)
+	if !ok {
+		that2, ok := that.(ApplyVersioningTemplateRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if m.TaskQueueType != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskQueueType))
-		i--
-		dAtA[i] = 0x10
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0xa
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	return len(dAtA) - i, nil
+	if this.TaskQueue != that1.TaskQueue {
+		return false
+	}
+	if this.TemplateName != that1.TemplateName {
+		return false
+	}
+	return true
 }
+func (this *ApplyVersioningTemplateResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CancelOutstandingPollResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*ApplyVersioningTemplateResponse)
+	if !ok {
+		that2, ok := that.(ApplyVersioningTemplateResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *ReassignBuildIdRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CancelOutstandingPollResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CancelOutstandingPollResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *DescribeTaskQueueRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*ReassignBuildIdRequest)
+	if !ok {
+		that2, ok := that.(ReassignBuildIdRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DescribeTaskQueueRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.TaskQueue != that1.TaskQueue {
+		return false
+	}
+	if this.BuildId != that1.BuildId {
+		return false
+	}
+	if this.TargetBuildId != that1.TargetBuildId {
+		return false
+	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	return true
 }
+func (this *ReassignBuildIdResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DescribeTaskQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DescRequest != nil {
-		{
-			size, err := m.DescRequest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	that1, ok := that.(*ReassignBuildIdResponse)
+	if !ok {
+		that2, ok := that.(ReassignBuildIdResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *DescribeTaskQueueResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.ReassignedWorkflows != that1.ReassignedWorkflows {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DescribeTaskQueueResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.SkippedWorkflows != that1.SkippedWorkflows {
+		return false
+	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	return true
 }
+func (this *GetTaskDispatchDecisionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DescribeTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TaskQueueStatus != nil {
-		{
-			size, err := m.TaskQueueStatus.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	that1, ok := that.(*GetTaskDispatchDecisionRequest)
+	if !ok {
+		that2, ok := that.(GetTaskDispatchDecisionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if len(m.Pollers) > 0 {
-		for iNdEx := len(m.Pollers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pollers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ListTaskQueuePartitionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListTaskQueuePartitionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ListTaskQueuePartitionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0x1a
+	if this.TaskQueue != that1.TaskQueue {
+		return false
 	}
-	if m.TaskQueue != nil {
-		{
-			size, err := m.TaskQueue.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if this.TaskQueueType != that1.TaskQueueType {
+		return false
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0xa
+	if this.WorkflowBuildId != that1.WorkflowBuildId {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ListTaskQueuePartitionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.UseCompatibleVersion != that1.UseCompatibleVersion {
+		return false
 	}
-	return dAtA[:n], nil
+	return true
 }
+func (this *GetTaskDispatchDecisionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ListTaskQueuePartitionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*GetTaskDispatchDecisionResponse)
+	if !ok {
+		that2, ok := that.(GetTaskDispatchDecisionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BuildId != that1.BuildId {
+		return false
+	}
+	if this.VersionSetId != that1.VersionSetId {
+		return false
+	}
+	return true
 }
+func (this *EnableWorkerVersioningRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ListTaskQueuePartitionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.WorkflowTaskQueuePartitions) > 0 {
-		for iNdEx := len(m.WorkflowTaskQueuePartitions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.WorkflowTaskQueuePartitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	that1, ok := that.(*EnableWorkerVersioningRequest)
+	if !ok {
+		that2, ok := that.(EnableWorkerVersioningRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.ActivityTaskQueuePartitions) > 0 {
-		for iNdEx := len(m.ActivityTaskQueuePartitions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ActivityTaskQueuePartitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *UpdateWorkerBuildIdCompatibilityRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UpdateWorkerBuildIdCompatibilityRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *UpdateWorkerBuildIdCompatibilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if this.TaskQueue != that1.TaskQueue {
+		return false
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0xa
+	if this.BuildId != that1.BuildId {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *UpdateWorkerBuildIdCompatibilityResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Force != that1.Force {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UpdateWorkerBuildIdCompatibilityResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *UpdateWorkerBuildIdCompatibilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *GetWorkerBuildIdCompatibilityRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *EnableWorkerVersioningResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetWorkerBuildIdCompatibilityRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *GetWorkerBuildIdCompatibilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	that1, ok := that.(*EnableWorkerVersioningResponse)
+	if !ok {
+		that2, ok := that.(EnableWorkerVersioningResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GetWorkerBuildIdCompatibilityResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.PollerCount != that1.PollerCount {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetWorkerBuildIdCompatibilityResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *ListWorkerBuildIdCompatibilityRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetWorkerBuildIdCompatibilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Response != nil {
-		{
-			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	that1, ok := that.(*ListWorkerBuildIdCompatibilityRequest)
+	if !ok {
+		that2, ok := that.(ListWorkerBuildIdCompatibilityRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GetTaskQueueUserDataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetTaskQueueUserDataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.PageSize != that1.PageSize {
+		return false
+	}
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	if this.MaxSets != that1.MaxSets {
+		return false
+	}
+	return true
 }
-
-func (m *GetTaskQueueUserDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TaskQueueType != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskQueueType))
-		i--
-		dAtA[i] = 0x28
+func (this *ListWorkerBuildIdCompatibilityResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.WaitNewData {
-		i--
-		if m.WaitNewData {
-			dAtA[i] = 1
+
+	that1, ok := that.(*ListWorkerBuildIdCompatibilityResponse)
+	if !ok {
+		that2, ok := that.(ListWorkerBuildIdCompatibilityResponse)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x20
 	}
-	if m.LastKnownUserDataVersion != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.LastKnownUserDataVersion))
-		i--
-		dAtA[i] = 0x18
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.TaskQueue) > 0 {
-		i -= len(m.TaskQueue)
-		copy(dAtA[i:], m.TaskQueue)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
-		i--
-		dAtA[i] = 0x12
+	if len(this.TaskQueues) != len(that1.TaskQueues) {
+		return false
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0xa
+	for i := range this.TaskQueues {
+		if !this.TaskQueues[i].Equal(that1.TaskQueues[i]) {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
+	if !bytes.Equal(this.NextPageToken, that1.NextPageToken) {
+		return false
+	}
+	return true
 }
+func (this *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetTaskQueueUserDataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *GetTaskQueueUserDataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetTaskQueueUserDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UserData != nil {
-		{
-			size, err := m.UserData.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	that1, ok := that.(*ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility)
+	if !ok {
+		that2, ok := that.(ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.TaskQueueHasUserData {
-		i--
-		if m.TaskQueueHasUserData {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TaskQueue != that1.TaskQueue {
+		return false
+	}
+	if len(this.MajorVersionSets) != len(that1.MajorVersionSets) {
+		return false
+	}
+	for i := range this.MajorVersionSets {
+		if !this.MajorVersionSets[i].Equal(that1.MajorVersionSets[i]) {
+			return false
 		}
-		i--
-		dAtA[i] = 0x8
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *ApplyTaskQueueUserDataReplicationEventRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *GetBuildIdReachabilityRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ApplyTaskQueueUserDataReplicationEventRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *ApplyTaskQueueUserDataReplicationEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UserData != nil {
-		{
-			size, err := m.UserData.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+	that1, ok := that.(*GetBuildIdReachabilityRequest)
+	if !ok {
+		that2, ok := that.(GetBuildIdReachabilityRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if len(m.TaskQueue) > 0 {
-		i -= len(m.TaskQueue)
-		copy(dAtA[i:], m.TaskQueue)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0xa
+	if this.NamespaceId != that1.NamespaceId {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ApplyTaskQueueUserDataReplicationEventResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.TaskQueue != that1.TaskQueue {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ApplyTaskQueueUserDataReplicationEventResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ApplyTaskQueueUserDataReplicationEventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *GetBuildIdTaskQueueMappingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.BuildId != that1.BuildId {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetBuildIdTaskQueueMappingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *GetBuildIdReachabilityResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetBuildIdTaskQueueMappingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BuildId) > 0 {
-		i -= len(m.BuildId)
-		copy(dAtA[i:], m.BuildId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BuildId)))
-		i--
-		dAtA[i] = 0x12
+	that1, ok := that.(*GetBuildIdReachabilityResponse)
+	if !ok {
+		that2, ok := that.(GetBuildIdReachabilityResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GetBuildIdTaskQueueMappingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.TaskQueueReachability.Equal(that1.TaskQueueReachability) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetBuildIdTaskQueueMappingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
-
-func (m *GetBuildIdTaskQueueMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TaskQueues) > 0 {
-		for iNdEx := len(m.TaskQueues) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.TaskQueues[iNdEx])
-			copy(dAtA[i:], m.TaskQueues[iNdEx])
-			i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueues[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func (this *PollWorkflowTaskQueueRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ForceUnloadTaskQueueRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	s := make([]string, 0, 8)
+	s = append(s, "&matchingservice.PollWorkflowTaskQueueRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "PollerId: "+fmt.Sprintf("%#v", this.PollerId)+",\n")
+	if this.PollRequest != nil {
+		s = append(s, "PollRequest: "+fmt.Sprintf("%#v", this.PollRequest)+",\n")
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ForceUnloadTaskQueueRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ForceUnloadTaskQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TaskQueueType != 0 {
-		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskQueueType))
-		i--
-		dAtA[i] = 0x18
+func (this *PollWorkflowTaskQueueResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.TaskQueue) > 0 {
-		i -= len(m.TaskQueue)
-		copy(dAtA[i:], m.TaskQueue)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0xa
+	s := make([]string, 0, 21)
+	s = append(s, "&matchingservice.PollWorkflowTaskQueueResponse{")
+	s = append(s, "TaskToken: "+fmt.Sprintf("%#v", this.TaskToken)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ForceUnloadTaskQueueResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.WorkflowType != nil {
+		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ForceUnloadTaskQueueResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ForceUnloadTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.WasLoaded {
-		i--
-		if m.WasLoaded {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	s = append(s, "PreviousStartedEventId: "+fmt.Sprintf("%#v", this.PreviousStartedEventId)+",\n")
+	s = append(s, "StartedEventId: "+fmt.Sprintf("%#v", this.StartedEventId)+",\n")
+	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
+	s = append(s, "NextEventId: "+fmt.Sprintf("%#v", this.NextEventId)+",\n")
+	s = append(s, "BacklogCountHint: "+fmt.Sprintf("%#v", this.BacklogCountHint)+",\n")
+	s = append(s, "StickyExecutionEnabled: "+fmt.Sprintf("%#v", this.StickyExecutionEnabled)+",\n")
+	if this.Query != nil {
+		s = append(s, "Query: "+fmt.Sprintf("%#v", this.Query)+",\n")
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *UpdateTaskQueueUserDataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.TransientWorkflowTask != nil {
+		s = append(s, "TransientWorkflowTask: "+fmt.Sprintf("%#v", this.TransientWorkflowTask)+",\n")
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UpdateTaskQueueUserDataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *UpdateTaskQueueUserDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BuildIdsRemoved) > 0 {
-		for iNdEx := len(m.BuildIdsRemoved) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.BuildIdsRemoved[iNdEx])
-			copy(dAtA[i:], m.BuildIdsRemoved[iNdEx])
-			i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BuildIdsRemoved[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
+	if this.WorkflowExecutionTaskQueue != nil {
+		s = append(s, "WorkflowExecutionTaskQueue: "+fmt.Sprintf("%#v", this.WorkflowExecutionTaskQueue)+",\n")
 	}
-	if len(m.BuildIdsAdded) > 0 {
-		for iNdEx := len(m.BuildIdsAdded) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.BuildIdsAdded[iNdEx])
-			copy(dAtA[i:], m.BuildIdsAdded[iNdEx])
-			i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BuildIdsAdded[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+	s = append(s, "BranchToken: "+fmt.Sprintf("%#v", this.BranchToken)+",\n")
+	s = append(s, "ScheduledTime: "+fmt.Sprintf("%#v", this.ScheduledTime)+",\n")
+	s = append(s, "StartedTime: "+fmt.Sprintf("%#v", this.StartedTime)+",\n")
+	keysForQueries := make([]string, 0, len(this.Queries))
+	for k, _ := range this.Queries {
+		keysForQueries = append(keysForQueries, k)
 	}
-	if m.UserData != nil {
-		{
-			size, err := m.UserData.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	github_com_gogo_protobuf_sortkeys.Strings(keysForQueries)
+	mapStringForQueries := "map[string]*v12.WorkflowQuery{"
+	for _, k := range keysForQueries {
+		mapStringForQueries += fmt.Sprintf("%#v: %#v,", k, this.Queries[k])
 	}
-	if len(m.TaskQueue) > 0 {
-		i -= len(m.TaskQueue)
-		copy(dAtA[i:], m.TaskQueue)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
-		i--
-		dAtA[i] = 0x12
+	mapStringForQueries += "}"
+	if this.Queries != nil {
+		s = append(s, "Queries: "+mapStringForQueries+",\n")
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0xa
+	if this.Messages != nil {
+		s = append(s, "Messages: "+fmt.Sprintf("%#v", this.Messages)+",\n")
 	}
-	return len(dAtA) - i, nil
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *UpdateTaskQueueUserDataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *PollActivityTaskQueueRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UpdateTaskQueueUserDataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *UpdateTaskQueueUserDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *ReplicateTaskQueueUserDataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	s := make([]string, 0, 8)
+	s = append(s, "&matchingservice.PollActivityTaskQueueRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "PollerId: "+fmt.Sprintf("%#v", this.PollerId)+",\n")
+	if this.PollRequest != nil {
+		s = append(s, "PollRequest: "+fmt.Sprintf("%#v", this.PollRequest)+",\n")
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ReplicateTaskQueueUserDataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ReplicateTaskQueueUserDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UserData != nil {
-		{
-			size, err := m.UserData.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func (this *PollActivityTaskQueueResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.TaskQueue) > 0 {
-		i -= len(m.TaskQueue)
-		copy(dAtA[i:], m.TaskQueue)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
-		i--
-		dAtA[i] = 0x12
+	s := make([]string, 0, 20)
+	s = append(s, "&matchingservice.PollActivityTaskQueueResponse{")
+	s = append(s, "TaskToken: "+fmt.Sprintf("%#v", this.TaskToken)+",\n")
+	if this.WorkflowExecution != nil {
+		s = append(s, "WorkflowExecution: "+fmt.Sprintf("%#v", this.WorkflowExecution)+",\n")
 	}
-	if len(m.NamespaceId) > 0 {
-		i -= len(m.NamespaceId)
-		copy(dAtA[i:], m.NamespaceId)
-		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
-		i--
-		dAtA[i] = 0xa
+	s = append(s, "ActivityId: "+fmt.Sprintf("%#v", this.ActivityId)+",\n")
+	if this.ActivityType != nil {
+		s = append(s, "ActivityType: "+fmt.Sprintf("%#v", this.ActivityType)+",\n")
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ReplicateTaskQueueUserDataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Input != nil {
+		s = append(s, "Input: "+fmt.Sprintf("%#v", this.Input)+",\n")
 	}
-	return dAtA[:n], nil
+	s = append(s, "ScheduledTime: "+fmt.Sprintf("%#v", this.ScheduledTime)+",\n")
+	s = append(s, "ScheduleToCloseTimeout: "+fmt.Sprintf("%#v", this.ScheduleToCloseTimeout)+",\n")
+	s = append(s, "StartedTime: "+fmt.Sprintf("%#v", this.StartedTime)+",\n")
+	s = append(s, "StartToCloseTimeout: "+fmt.Sprintf("%#v", this.StartToCloseTimeout)+",\n")
+	s = append(s, "HeartbeatTimeout: "+fmt.Sprintf("%#v", this.HeartbeatTimeout)+",\n")
+	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
+	s = append(s, "CurrentAttemptScheduledTime: "+fmt.Sprintf("%#v", this.CurrentAttemptScheduledTime)+",\n")
+	if this.HeartbeatDetails != nil {
+		s = append(s, "HeartbeatDetails: "+fmt.Sprintf("%#v", this.HeartbeatDetails)+",\n")
+	}
+	if this.WorkflowType != nil {
+		s = append(s, "WorkflowType: "+fmt.Sprintf("%#v", this.WorkflowType)+",\n")
+	}
+	s = append(s, "WorkflowNamespace: "+fmt.Sprintf("%#v", this.WorkflowNamespace)+",\n")
+	if this.Header != nil {
+		s = append(s, "Header: "+fmt.Sprintf("%#v", this.Header)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ReplicateTaskQueueUserDataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ReplicateTaskQueueUserDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintRequestResponse(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRequestResponse(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *PollWorkflowTaskQueueRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *AddWorkflowTaskRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 13)
+	s = append(s, "&matchingservice.AddWorkflowTaskRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
 	}
-	l = len(m.PollerId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if this.TaskQueue != nil {
+		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
 	}
-	if m.PollRequest != nil {
-		l = m.PollRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s = append(s, "ScheduledEventId: "+fmt.Sprintf("%#v", this.ScheduledEventId)+",\n")
+	s = append(s, "ScheduleToStartTimeout: "+fmt.Sprintf("%#v", this.ScheduleToStartTimeout)+",\n")
+	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
+	s = append(s, "Source: "+fmt.Sprintf("%#v", this.Source)+",\n")
+	if this.Clock != nil {
+		s = append(s, "Clock: "+fmt.Sprintf("%#v", this.Clock)+",\n")
 	}
-	l = len(m.ForwardedSource)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if this.VersionDirective != nil {
+		s = append(s, "VersionDirective: "+fmt.Sprintf("%#v", this.VersionDirective)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PollWorkflowTaskQueueResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.TaskToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+func (this *AddWorkflowTaskResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 4)
+	s = append(s, "&matchingservice.AddWorkflowTaskResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AddActivityTaskRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.WorkflowType != nil {
-		l = m.WorkflowType.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 13)
+	s = append(s, "&matchingservice.AddActivityTaskRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
 	}
-	if m.PreviousStartedEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.PreviousStartedEventId))
+	if this.TaskQueue != nil {
+		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
 	}
-	if m.StartedEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.StartedEventId))
+	s = append(s, "ScheduledEventId: "+fmt.Sprintf("%#v", this.ScheduledEventId)+",\n")
+	s = append(s, "ScheduleToStartTimeout: "+fmt.Sprintf("%#v", this.ScheduleToStartTimeout)+",\n")
+	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
+	s = append(s, "Source: "+fmt.Sprintf("%#v", this.Source)+",\n")
+	if this.Clock != nil {
+		s = append(s, "Clock: "+fmt.Sprintf("%#v", this.Clock)+",\n")
 	}
-	if m.Attempt != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Attempt))
+	if this.VersionDirective != nil {
+		s = append(s, "VersionDirective: "+fmt.Sprintf("%#v", this.VersionDirective)+",\n")
 	}
-	if m.NextEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.NextEventId))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AddActivityTaskResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.BacklogCountHint != 0 {
-		n += 1 + sovRequestResponse(uint64(m.BacklogCountHint))
+	s := make([]string, 0, 4)
+	s = append(s, "&matchingservice.AddActivityTaskResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *QueryWorkflowRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.StickyExecutionEnabled {
-		n += 2
+	s := make([]string, 0, 9)
+	s = append(s, "&matchingservice.QueryWorkflowRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.TaskQueue != nil {
+		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
 	}
-	if m.Query != nil {
-		l = m.Query.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if this.QueryRequest != nil {
+		s = append(s, "QueryRequest: "+fmt.Sprintf("%#v", this.QueryRequest)+",\n")
 	}
-	if m.TransientWorkflowTask != nil {
-		l = m.TransientWorkflowTask.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s = append(s, "ForwardedSource: "+fmt.Sprintf("%#v", this.ForwardedSource)+",\n")
+	if this.VersionDirective != nil {
+		s = append(s, "VersionDirective: "+fmt.Sprintf("%#v", this.VersionDirective)+",\n")
 	}
-	if m.WorkflowExecutionTaskQueue != nil {
-		l = m.WorkflowExecutionTaskQueue.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *QueryWorkflowResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.BranchToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 6)
+	s = append(s, "&matchingservice.QueryWorkflowResponse{")
+	if this.QueryResult != nil {
+		s = append(s, "QueryResult: "+fmt.Sprintf("%#v", this.QueryResult)+",\n")
 	}
-	if m.ScheduledTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if this.QueryRejected != nil {
+		s = append(s, "QueryRejected: "+fmt.Sprintf("%#v", this.QueryRejected)+",\n")
 	}
-	if m.StartedTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime)
-		n += 2 + l + sovRequestResponse(uint64(l))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RespondQueryTaskCompletedRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if len(m.Queries) > 0 {
-		for k, v := range m.Queries {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovRequestResponse(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + l
-			n += mapEntrySize + 2 + sovRequestResponse(uint64(mapEntrySize))
-		}
+	s := make([]string, 0, 8)
+	s = append(s, "&matchingservice.RespondQueryTaskCompletedRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.TaskQueue != nil {
+		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
 	}
-	if len(m.Messages) > 0 {
-		for _, e := range m.Messages {
-			l = e.Size()
-			n += 2 + l + sovRequestResponse(uint64(l))
-		}
+	s = append(s, "TaskId: "+fmt.Sprintf("%#v", this.TaskId)+",\n")
+	if this.CompletedRequest != nil {
+		s = append(s, "CompletedRequest: "+fmt.Sprintf("%#v", this.CompletedRequest)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PollActivityTaskQueueRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.PollerId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+func (this *RespondQueryTaskCompletedResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.PollRequest != nil {
-		l = m.PollRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 4)
+	s = append(s, "&matchingservice.RespondQueryTaskCompletedResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CancelOutstandingPollRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.ForwardedSource)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 8)
+	s = append(s, "&matchingservice.CancelOutstandingPollRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueueType: "+fmt.Sprintf("%#v", this.TaskQueueType)+",\n")
+	if this.TaskQueue != nil {
+		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
 	}
-	return n
+	s = append(s, "PollerId: "+fmt.Sprintf("%#v", this.PollerId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *PollActivityTaskQueueResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *CancelOutstandingPollResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	l = len(m.TaskToken)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 4)
+	s = append(s, "&matchingservice.CancelOutstandingPollResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DescribeTaskQueueRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 7)
+	s = append(s, "&matchingservice.DescribeTaskQueueRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.DescRequest != nil {
+		s = append(s, "DescRequest: "+fmt.Sprintf("%#v", this.DescRequest)+",\n")
 	}
-	l = len(m.ActivityId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s = append(s, "VersionSetIds: "+fmt.Sprintf("%#v", this.VersionSetIds)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DescribeTaskQueueResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.ActivityType != nil {
-		l = m.ActivityType.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 8)
+	s = append(s, "&matchingservice.DescribeTaskQueueResponse{")
+	if this.Pollers != nil {
+		s = append(s, "Pollers: "+fmt.Sprintf("%#v", this.Pollers)+",\n")
 	}
-	if m.Input != nil {
-		l = m.Input.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if this.TaskQueueStatus != nil {
+		s = append(s, "TaskQueueStatus: "+fmt.Sprintf("%#v", this.TaskQueueStatus)+",\n")
 	}
-	if m.ScheduledTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s = append(s, "UnversionedBacklogCountHint: "+fmt.Sprintf("%#v", this.UnversionedBacklogCountHint)+",\n")
+	keysForVersionedBacklogCountHints := make([]string, 0, len(this.VersionedBacklogCountHints))
+	for k, _ := range this.VersionedBacklogCountHints {
+		keysForVersionedBacklogCountHints = append(keysForVersionedBacklogCountHints, k)
 	}
-	if m.ScheduleToCloseTimeout != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout)
-		n += 1 + l + sovRequestResponse(uint64(l))
+	github_com_gogo_protobuf_sortkeys.Strings(keysForVersionedBacklogCountHints)
+	mapStringForVersionedBacklogCountHints := "map[string]int64{"
+	for _, k := range keysForVersionedBacklogCountHints {
+		mapStringForVersionedBacklogCountHints += fmt.Sprintf("%#v: %#v,", k, this.VersionedBacklogCountHints[k])
 	}
-	if m.StartedTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
+	mapStringForVersionedBacklogCountHints += "}"
+	if this.VersionedBacklogCountHints != nil {
+		s = append(s, "VersionedBacklogCountHints: "+mapStringForVersionedBacklogCountHints+",\n")
 	}
-	if m.StartToCloseTimeout != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout)
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListTaskQueuePartitionsRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.HeartbeatTimeout != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout)
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 7)
+	s = append(s, "&matchingservice.ListTaskQueuePartitionsRequest{")
+	s = append(s, "Namespace: "+fmt.Sprintf("%#v", this.Namespace)+",\n")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.TaskQueue != nil {
+		s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
 	}
-	if m.Attempt != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Attempt))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListTaskQueuePartitionsResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.CurrentAttemptScheduledTime != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CurrentAttemptScheduledTime)
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 6)
+	s = append(s, "&matchingservice.ListTaskQueuePartitionsResponse{")
+	if this.ActivityTaskQueuePartitions != nil {
+		s = append(s, "ActivityTaskQueuePartitions: "+fmt.Sprintf("%#v", this.ActivityTaskQueuePartitions)+",\n")
 	}
-	if m.HeartbeatDetails != nil {
-		l = m.HeartbeatDetails.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if this.WorkflowTaskQueuePartitions != nil {
+		s = append(s, "WorkflowTaskQueuePartitions: "+fmt.Sprintf("%#v", this.WorkflowTaskQueuePartitions)+",\n")
 	}
-	if m.WorkflowType != nil {
-		l = m.WorkflowType.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateWorkerBuildIdCompatibilityRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.WorkflowNamespace)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 12)
+	s = append(s, "&matchingservice.UpdateWorkerBuildIdCompatibilityRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	if this.Operation != nil {
+		s = append(s, "Operation: "+fmt.Sprintf("%#v", this.Operation)+",\n")
 	}
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 2 + l + sovRequestResponse(uint64(l))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateWorkerBuildIdCompatibilityRequest_Request) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := strings.Join([]string{`&matchingservice.UpdateWorkerBuildIdCompatibilityRequest_Request{` +
+		`Request:` + fmt.Sprintf("%#v", this.Request) + `}`}, ", ")
+	return s
 }
-
-func (m *AddWorkflowTaskRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining_) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := strings.Join([]string{`&matchingservice.UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining_{` +
+		`MarkBuildIdDraining:` + fmt.Sprintf("%#v", this.MarkBuildIdDraining) + `}`}, ", ")
+	return s
+}
+func (this *UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet_) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := strings.Join([]string{`&matchingservice.UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet_{` +
+		`SwapBuildIdsWithinSet:` + fmt.Sprintf("%#v", this.SwapBuildIdsWithinSet) + `}`}, ", ")
+	return s
+}
+func (this *UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels_) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.TaskQueue != nil {
-		l = m.TaskQueue.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := strings.Join([]string{`&matchingservice.UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels_{` +
+		`SetBuildIdLabels:` + fmt.Sprintf("%#v", this.SetBuildIdLabels) + `}`}, ", ")
+	return s
+}
+func (this *UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets_) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.ScheduledEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ScheduledEventId))
+	s := strings.Join([]string{`&matchingservice.UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets_{` +
+		`SwapDefaultSets:` + fmt.Sprintf("%#v", this.SwapDefaultSets) + `}`}, ", ")
+	return s
+}
+func (this *UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId_) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.ScheduleToStartTimeout != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout)
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := strings.Join([]string{`&matchingservice.UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId_{` +
+		`RemoveBuildId:` + fmt.Sprintf("%#v", this.RemoveBuildId) + `}`}, ", ")
+	return s
+}
+func (this *UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.ForwardedSource)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 5)
+	s = append(s, "&matchingservice.UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining{")
+	s = append(s, "BuildId: "+fmt.Sprintf("%#v", this.BuildId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Source != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Source))
+	s := make([]string, 0, 6)
+	s = append(s, "&matchingservice.UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet{")
+	s = append(s, "FirstBuildId: "+fmt.Sprintf("%#v", this.FirstBuildId)+",\n")
+	s = append(s, "SecondBuildId: "+fmt.Sprintf("%#v", this.SecondBuildId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Clock != nil {
-		l = m.Clock.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 6)
+	s = append(s, "&matchingservice.UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels{")
+	s = append(s, "BuildId: "+fmt.Sprintf("%#v", this.BuildId)+",\n")
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k, _ := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
 	}
-	if m.VersionDirective != nil {
-		l = m.VersionDirective.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%#v: %#v,", k, this.Labels[k])
 	}
-	return n
+	mapStringForLabels += "}"
+	if this.Labels != nil {
+		s = append(s, "Labels: "+mapStringForLabels+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *AddWorkflowTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	return n
+	s := make([]string, 0, 6)
+	s = append(s, "&matchingservice.UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets{")
+	s = append(s, "FirstBuildId: "+fmt.Sprintf("%#v", this.FirstBuildId)+",\n")
+	s = append(s, "SecondBuildId: "+fmt.Sprintf("%#v", this.SecondBuildId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *AddActivityTaskRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 5)
+	s = append(s, "&matchingservice.UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId{")
+	s = append(s, "BuildId: "+fmt.Sprintf("%#v", this.BuildId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateWorkerBuildIdCompatibilityResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Execution != nil {
-		l = m.Execution.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 4)
+	s = append(s, "&matchingservice.UpdateWorkerBuildIdCompatibilityResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetWorkerBuildIdCompatibilityRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.TaskQueue != nil {
-		l = m.TaskQueue.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 7)
+	s = append(s, "&matchingservice.GetWorkerBuildIdCompatibilityRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	if this.Request != nil {
+		s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
 	}
-	if m.ScheduledEventId != 0 {
-		n += 1 + sovRequestResponse(uint64(m.ScheduledEventId))
+	if this.HypotheticalUpdate != nil {
+		s = append(s, "HypotheticalUpdate: "+fmt.Sprintf("%#v", this.HypotheticalUpdate)+",\n")
 	}
-	if m.ScheduleToStartTimeout != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout)
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetWorkerBuildIdCompatibilityResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.ForwardedSource)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 6)
+	s = append(s, "&matchingservice.GetWorkerBuildIdCompatibilityResponse{")
+	if this.Response != nil {
+		s = append(s, "Response: "+fmt.Sprintf("%#v", this.Response)+",\n")
 	}
-	if m.Source != 0 {
-		n += 1 + sovRequestResponse(uint64(m.Source))
+	keysForBuildIdLabels := make([]string, 0, len(this.BuildIdLabels))
+	for k, _ := range this.BuildIdLabels {
+		keysForBuildIdLabels = append(keysForBuildIdLabels, k)
 	}
-	if m.Clock != nil {
-		l = m.Clock.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	github_com_gogo_protobuf_sortkeys.Strings(keysForBuildIdLabels)
+	mapStringForBuildIdLabels := "map[string]*GetWorkerBuildIdCompatibilityResponse_BuildIdLabels{"
+	for _, k := range keysForBuildIdLabels {
+		mapStringForBuildIdLabels += fmt.Sprintf("%#v: %#v,", k, this.BuildIdLabels[k])
 	}
-	if m.VersionDirective != nil {
-		l = m.VersionDirective.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	mapStringForBuildIdLabels += "}"
+	if this.BuildIdLabels != nil {
+		s = append(s, "BuildIdLabels: "+mapStringForBuildIdLabels+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *AddActivityTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *GetWorkerBuildIdCompatibilityResponse_BuildIdLabels) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryWorkflowRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	s := make([]string, 0, 5)
+	s = append(s, "&matchingservice.GetWorkerBuildIdCompatibilityResponse_BuildIdLabels{")
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k, _ := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
 	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%#v: %#v,", k, this.Labels[k])
 	}
-	if m.TaskQueue != nil {
-		l = m.TaskQueue.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	mapStringForLabels += "}"
+	if this.Labels != nil {
+		s = append(s, "Labels: "+mapStringForLabels+",\n")
 	}
-	if m.QueryRequest != nil {
-		l = m.QueryRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetTaskQueueUserDataRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.ForwardedSource)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 10)
+	s = append(s, "&matchingservice.GetTaskQueueUserDataRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s = append(s, "TaskQueueType: "+fmt.Sprintf("%#v", this.TaskQueueType)+",\n")
+	s = append(s, "LastKnownUserDataVersion: "+fmt.Sprintf("%#v", this.LastKnownUserDataVersion)+",\n")
+	s = append(s, "WaitNewData: "+fmt.Sprintf("%#v", this.WaitNewData)+",\n")
+	s = append(s, "OnlyIfLoaded: "+fmt.Sprintf("%#v", this.OnlyIfLoaded)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetTaskQueueUserDataResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.VersionDirective != nil {
-		l = m.VersionDirective.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 7)
+	s = append(s, "&matchingservice.GetTaskQueueUserDataResponse{")
+	s = append(s, "TaskQueueHasUserData: "+fmt.Sprintf("%#v", this.TaskQueueHasUserData)+",\n")
+	if this.UserData != nil {
+		s = append(s, "UserData: "+fmt.Sprintf("%#v", this.UserData)+",\n")
 	}
-	return n
+	s = append(s, "PartitionNotLoaded: "+fmt.Sprintf("%#v", this.PartitionNotLoaded)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *QueryWorkflowResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.QueryResult != nil {
-		l = m.QueryResult.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+func (this *ApplyTaskQueueUserDataReplicationEventRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.QueryRejected != nil {
-		l = m.QueryRejected.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 7)
+	s = append(s, "&matchingservice.ApplyTaskQueueUserDataReplicationEventRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	if this.UserData != nil {
+		s = append(s, "UserData: "+fmt.Sprintf("%#v", this.UserData)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *RespondQueryTaskCompletedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+func (this *ApplyTaskQueueUserDataReplicationEventResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.TaskQueue != nil {
-		l = m.TaskQueue.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 4)
+	s = append(s, "&matchingservice.ApplyTaskQueueUserDataReplicationEventResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DescribeVersioningRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.TaskId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 8)
+	s = append(s, "&matchingservice.DescribeVersioningRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s = append(s, "PageSize: "+fmt.Sprintf("%#v", this.PageSize)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DescribeVersioningResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.CompletedRequest != nil {
-		l = m.CompletedRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 6)
+	s = append(s, "&matchingservice.DescribeVersioningResponse{")
+	if this.VersionSets != nil {
+		s = append(s, "VersionSets: "+fmt.Sprintf("%#v", this.VersionSets)+",\n")
 	}
-	return n
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *RespondQueryTaskCompletedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *DescribeVersioningResponse_BuildIdInfo) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	return n
+	s := make([]string, 0, 10)
+	s = append(s, "&matchingservice.DescribeVersioningResponse_BuildIdInfo{")
+	s = append(s, "BuildId: "+fmt.Sprintf("%#v", this.BuildId)+",\n")
+	s = append(s, "IsSetDefault: "+fmt.Sprintf("%#v", this.IsSetDefault)+",\n")
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "PollerCount: "+fmt.Sprintf("%#v", this.PollerCount)+",\n")
+	s = append(s, "Reachability: "+fmt.Sprintf("%#v", this.Reachability)+",\n")
+	s = append(s, "OpenWorkflowCount: "+fmt.Sprintf("%#v", this.OpenWorkflowCount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *CancelOutstandingPollRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *DescribeVersioningResponse_VersionSet) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 6)
+	s = append(s, "&matchingservice.DescribeVersioningResponse_VersionSet{")
+	if this.BuildIds != nil {
+		s = append(s, "BuildIds: "+fmt.Sprintf("%#v", this.BuildIds)+",\n")
 	}
-	if m.TaskQueueType != 0 {
-		n += 1 + sovRequestResponse(uint64(m.TaskQueueType))
+	s = append(s, "IsDefault: "+fmt.Sprintf("%#v", this.IsDefault)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetBuildIdTaskQueueMappingRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.TaskQueue != nil {
-		l = m.TaskQueue.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 6)
+	s = append(s, "&matchingservice.GetBuildIdTaskQueueMappingRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "BuildId: "+fmt.Sprintf("%#v", this.BuildId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetBuildIdTaskQueueMappingResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.PollerId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 5)
+	s = append(s, "&matchingservice.GetBuildIdTaskQueueMappingResponse{")
+	s = append(s, "TaskQueues: "+fmt.Sprintf("%#v", this.TaskQueues)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ForceUnloadTaskQueueRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 7)
+	s = append(s, "&matchingservice.ForceUnloadTaskQueueRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s = append(s, "TaskQueueType: "+fmt.Sprintf("%#v", this.TaskQueueType)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *CancelOutstandingPollResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ForceUnloadTaskQueueResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	return n
+	s := make([]string, 0, 5)
+	s = append(s, "&matchingservice.ForceUnloadTaskQueueResponse{")
+	s = append(s, "WasLoaded: "+fmt.Sprintf("%#v", this.WasLoaded)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *DescribeTaskQueueRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *UpdateTaskQueueUserDataRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 9)
+	s = append(s, "&matchingservice.UpdateTaskQueueUserDataRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	if this.UserData != nil {
+		s = append(s, "UserData: "+fmt.Sprintf("%#v", this.UserData)+",\n")
 	}
-	if m.DescRequest != nil {
-		l = m.DescRequest.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s = append(s, "BuildIdsAdded: "+fmt.Sprintf("%#v", this.BuildIdsAdded)+",\n")
+	s = append(s, "BuildIdsRemoved: "+fmt.Sprintf("%#v", this.BuildIdsRemoved)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateTaskQueueUserDataResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 4)
+	s = append(s, "&matchingservice.UpdateTaskQueueUserDataResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *DescribeTaskQueueResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ReplicateTaskQueueUserDataRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if len(m.Pollers) > 0 {
-		for _, e := range m.Pollers {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
-	}
-	if m.TaskQueueStatus != nil {
-		l = m.TaskQueueStatus.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 7)
+	s = append(s, "&matchingservice.ReplicateTaskQueueUserDataRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	if this.UserData != nil {
+		s = append(s, "UserData: "+fmt.Sprintf("%#v", this.UserData)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ListTaskQueuePartitionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ReplicateTaskQueueUserDataResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 4)
+	s = append(s, "&matchingservice.ReplicateTaskQueueUserDataResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CleanupUnreachableBuildIdsRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.TaskQueue != nil {
-		l = m.TaskQueue.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 6)
+	s = append(s, "&matchingservice.CleanupUnreachableBuildIdsRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CleanupUnreachableBuildIdsResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 5)
+	s = append(s, "&matchingservice.CleanupUnreachableBuildIdsResponse{")
+	s = append(s, "RemovedBuildIds: "+fmt.Sprintf("%#v", this.RemovedBuildIds)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetDefaultBuildIdTimelineRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 6)
+	s = append(s, "&matchingservice.GetDefaultBuildIdTimelineRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ListTaskQueuePartitionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *GetDefaultBuildIdTimelineResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if len(m.ActivityTaskQueuePartitions) > 0 {
-		for _, e := range m.ActivityTaskQueuePartitions {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
+	s := make([]string, 0, 5)
+	s = append(s, "&matchingservice.GetDefaultBuildIdTimelineResponse{")
+	if this.Timeline != nil {
+		s = append(s, "Timeline: "+fmt.Sprintf("%#v", this.Timeline)+",\n")
 	}
-	if len(m.WorkflowTaskQueuePartitions) > 0 {
-		for _, e := range m.WorkflowTaskQueuePartitions {
-			l = e.Size()
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ValidateDefaultBuildIdSwitchRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 7)
+	s = append(s, "&matchingservice.ValidateDefaultBuildIdSwitchRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s = append(s, "BuildId: "+fmt.Sprintf("%#v", this.BuildId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *UpdateWorkerBuildIdCompatibilityRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ValidateDefaultBuildIdSwitchResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 6)
+	s = append(s, "&matchingservice.ValidateDefaultBuildIdSwitchResponse{")
+	s = append(s, "StayingWorkflowCount: "+fmt.Sprintf("%#v", this.StayingWorkflowCount)+",\n")
+	s = append(s, "MovingWorkflowCount: "+fmt.Sprintf("%#v", this.MovingWorkflowCount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetClosedWorkflowBuildIdRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 7)
+	s = append(s, "&matchingservice.GetClosedWorkflowBuildIdRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	if this.Execution != nil {
+		s = append(s, "Execution: "+fmt.Sprintf("%#v", this.Execution)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *UpdateWorkerBuildIdCompatibilityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *GetClosedWorkflowBuildIdResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	return n
+	s := make([]string, 0, 6)
+	s = append(s, "&matchingservice.GetClosedWorkflowBuildIdResponse{")
+	s = append(s, "BuildId: "+fmt.Sprintf("%#v", this.BuildId)+",\n")
+	s = append(s, "Registered: "+fmt.Sprintf("%#v", this.Registered)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetWorkerBuildIdCompatibilityRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.Request != nil {
-		l = m.Request.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+func (this *GetUserDataPropagationStatusRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 7)
+	s = append(s, "&matchingservice.GetUserDataPropagationStatusRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "PageSize: "+fmt.Sprintf("%#v", this.PageSize)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetWorkerBuildIdCompatibilityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *GetUserDataPropagationStatusResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.Response != nil {
-		l = m.Response.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 6)
+	s = append(s, "&matchingservice.GetUserDataPropagationStatusResponse{")
+	if this.TaskQueues != nil {
+		s = append(s, "TaskQueues: "+fmt.Sprintf("%#v", this.TaskQueues)+",\n")
 	}
-	return n
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetTaskQueueUserDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+func (this *GetUserDataPropagationStatusResponse_TaskQueueStatus) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.TaskQueue)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 11)
+	s = append(s, "&matchingservice.GetUserDataPropagationStatusResponse_TaskQueueStatus{")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s = append(s, "UserDataVersion: "+fmt.Sprintf("%#v", this.UserDataVersion)+",\n")
+	s = append(s, "PropagatedPartitions: "+fmt.Sprintf("%#v", this.PropagatedPartitions)+",\n")
+	s = append(s, "TotalPartitions: "+fmt.Sprintf("%#v", this.TotalPartitions)+",\n")
+	s = append(s, "FullyPropagated: "+fmt.Sprintf("%#v", this.FullyPropagated)+",\n")
+	s = append(s, "UnloadedPartitions: "+fmt.Sprintf("%#v", this.UnloadedPartitions)+",\n")
+	if this.PartitionErrors != nil {
+		s = append(s, "PartitionErrors: "+fmt.Sprintf("%#v", this.PartitionErrors)+",\n")
 	}
-	if m.LastKnownUserDataVersion != 0 {
-		n += 1 + sovRequestResponse(uint64(m.LastKnownUserDataVersion))
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetUserDataPropagationStatusResponse_PartitionError) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.WaitNewData {
-		n += 2
+	s := make([]string, 0, 7)
+	s = append(s, "&matchingservice.GetUserDataPropagationStatusResponse_PartitionError{")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s = append(s, "TaskQueueType: "+fmt.Sprintf("%#v", this.TaskQueueType)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ApplyVersioningTemplateRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.TaskQueueType != 0 {
-		n += 1 + sovRequestResponse(uint64(m.TaskQueueType))
+	s := make([]string, 0, 7)
+	s = append(s, "&matchingservice.ApplyVersioningTemplateRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s = append(s, "TemplateName: "+fmt.Sprintf("%#v", this.TemplateName)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ApplyVersioningTemplateResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 4)
+	s = append(s, "&matchingservice.ApplyVersioningTemplateResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetTaskQueueUserDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ReassignBuildIdRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.TaskQueueHasUserData {
-		n += 2
+	s := make([]string, 0, 9)
+	s = append(s, "&matchingservice.ReassignBuildIdRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s = append(s, "BuildId: "+fmt.Sprintf("%#v", this.BuildId)+",\n")
+	s = append(s, "TargetBuildId: "+fmt.Sprintf("%#v", this.TargetBuildId)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReassignBuildIdResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.UserData != nil {
-		l = m.UserData.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 7)
+	s = append(s, "&matchingservice.ReassignBuildIdResponse{")
+	s = append(s, "ReassignedWorkflows: "+fmt.Sprintf("%#v", this.ReassignedWorkflows)+",\n")
+	s = append(s, "SkippedWorkflows: "+fmt.Sprintf("%#v", this.SkippedWorkflows)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetTaskDispatchDecisionRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 9)
+	s = append(s, "&matchingservice.GetTaskDispatchDecisionRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s = append(s, "TaskQueueType: "+fmt.Sprintf("%#v", this.TaskQueueType)+",\n")
+	s = append(s, "WorkflowBuildId: "+fmt.Sprintf("%#v", this.WorkflowBuildId)+",\n")
+	s = append(s, "UseCompatibleVersion: "+fmt.Sprintf("%#v", this.UseCompatibleVersion)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ApplyTaskQueueUserDataReplicationEventRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *GetTaskDispatchDecisionResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.TaskQueue)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.UserData != nil {
-		l = m.UserData.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 6)
+	s = append(s, "&matchingservice.GetTaskDispatchDecisionResponse{")
+	s = append(s, "BuildId: "+fmt.Sprintf("%#v", this.BuildId)+",\n")
+	s = append(s, "VersionSetId: "+fmt.Sprintf("%#v", this.VersionSetId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EnableWorkerVersioningRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	return n
+	s := make([]string, 0, 8)
+	s = append(s, "&matchingservice.EnableWorkerVersioningRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s = append(s, "BuildId: "+fmt.Sprintf("%#v", this.BuildId)+",\n")
+	s = append(s, "Force: "+fmt.Sprintf("%#v", this.Force)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ApplyTaskQueueUserDataReplicationEventResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *EnableWorkerVersioningResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	return n
+	s := make([]string, 0, 5)
+	s = append(s, "&matchingservice.EnableWorkerVersioningResponse{")
+	s = append(s, "PollerCount: "+fmt.Sprintf("%#v", this.PollerCount)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetBuildIdTaskQueueMappingRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ListWorkerBuildIdCompatibilityRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 8)
+	s = append(s, "&matchingservice.ListWorkerBuildIdCompatibilityRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "PageSize: "+fmt.Sprintf("%#v", this.PageSize)+",\n")
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "MaxSets: "+fmt.Sprintf("%#v", this.MaxSets)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ListWorkerBuildIdCompatibilityResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.BuildId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 6)
+	s = append(s, "&matchingservice.ListWorkerBuildIdCompatibilityResponse{")
+	if this.TaskQueues != nil {
+		s = append(s, "TaskQueues: "+fmt.Sprintf("%#v", this.TaskQueues)+",\n")
 	}
-	return n
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *GetBuildIdTaskQueueMappingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if len(m.TaskQueues) > 0 {
-		for _, s := range m.TaskQueues {
-			l = len(s)
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
+	s := make([]string, 0, 6)
+	s = append(s, "&matchingservice.ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility{")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	if this.MajorVersionSets != nil {
+		s = append(s, "MajorVersionSets: "+fmt.Sprintf("%#v", this.MajorVersionSets)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ForceUnloadTaskQueueRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+func (this *GetBuildIdReachabilityRequest) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	l = len(m.TaskQueue)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
+	s := make([]string, 0, 7)
+	s = append(s, "&matchingservice.GetBuildIdReachabilityRequest{")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "TaskQueue: "+fmt.Sprintf("%#v", this.TaskQueue)+",\n")
+	s = append(s, "BuildId: "+fmt.Sprintf("%#v", this.BuildId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetBuildIdReachabilityResponse) GoString() string {
+	if this == nil {
+		return "nil"
 	}
-	if m.TaskQueueType != 0 {
-		n += 1 + sovRequestResponse(uint64(m.TaskQueueType))
+	s := make([]string, 0, 5)
+	s = append(s, "&matchingservice.GetBuildIdReachabilityResponse{")
+	if this.TaskQueueReachability != nil {
+		s = append(s, "TaskQueueReachability: "+fmt.Sprintf("%#v", this.TaskQueueReachability)+",\n")
 	}
-	return n
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
-func (m *ForceUnloadTaskQueueResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func valueToGoStringRequestResponse(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
 	}
-	var l int
-	_ = l
-	if m.WasLoaded {
-		n += 2
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *PollWorkflowTaskQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UpdateTaskQueueUserDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PollWorkflowTaskQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PollWorkflowTaskQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.TaskQueue)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.UserData != nil {
-		l = m.UserData.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
+	if len(m.ForwardedSource) > 0 {
+		i -= len(m.ForwardedSource)
+		copy(dAtA[i:], m.ForwardedSource)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ForwardedSource)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.BuildIdsAdded) > 0 {
-		for _, s := range m.BuildIdsAdded {
-			l = len(s)
-			n += 1 + l + sovRequestResponse(uint64(l))
+	if m.PollRequest != nil {
+		{
+			size, err := m.PollRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.BuildIdsRemoved) > 0 {
-		for _, s := range m.BuildIdsRemoved {
-			l = len(s)
-			n += 1 + l + sovRequestResponse(uint64(l))
-		}
+	if len(m.PollerId) > 0 {
+		i -= len(m.PollerId)
+		copy(dAtA[i:], m.PollerId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.PollerId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *UpdateTaskQueueUserDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PollWorkflowTaskQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ReplicateTaskQueueUserDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NamespaceId)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	l = len(m.TaskQueue)
-	if l > 0 {
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	if m.UserData != nil {
-		l = m.UserData.Size()
-		n += 1 + l + sovRequestResponse(uint64(l))
-	}
-	return n
+func (m *PollWorkflowTaskQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReplicateTaskQueueUserDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PollWorkflowTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
-}
-
-func sovRequestResponse(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozRequestResponse(x uint64) (n int) {
-	return sovRequestResponse(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *PollWorkflowTaskQueueRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&PollWorkflowTaskQueueRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`PollerId:` + fmt.Sprintf("%v", this.PollerId) + `,`,
-		`PollRequest:` + strings.Replace(fmt.Sprintf("%v", this.PollRequest), "PollWorkflowTaskQueueRequest", "v1.PollWorkflowTaskQueueRequest", 1) + `,`,
-		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PollWorkflowTaskQueueResponse) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
 	}
-	repeatedStringForMessages := "[]*Message{"
-	for _, f := range this.Messages {
-		repeatedStringForMessages += strings.Replace(fmt.Sprintf("%v", f), "Message", "v15.Message", 1) + ","
+	if len(m.Queries) > 0 {
+		for k := range m.Queries {
+			v := m.Queries[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
 	}
-	repeatedStringForMessages += "}"
-	keysForQueries := make([]string, 0, len(this.Queries))
-	for k, _ := range this.Queries {
-		keysForQueries = append(keysForQueries, k)
+	if m.StartedTime != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForQueries)
-	mapStringForQueries := "map[string]*v12.WorkflowQuery{"
-	for _, k := range keysForQueries {
-		mapStringForQueries += fmt.Sprintf("%v: %v,", k, this.Queries[k])
+	if m.ScheduledTime != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x7a
 	}
-	mapStringForQueries += "}"
-	s := strings.Join([]string{`&PollWorkflowTaskQueueResponse{`,
-		`TaskToken:` + fmt.Sprintf("%v", this.TaskToken) + `,`,
-		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
-		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v11.WorkflowType", 1) + `,`,
-		`PreviousStartedEventId:` + fmt.Sprintf("%v", this.PreviousStartedEventId) + `,`,
-		`StartedEventId:` + fmt.Sprintf("%v", this.StartedEventId) + `,`,
-		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
-		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
-		`BacklogCountHint:` + fmt.Sprintf("%v", this.BacklogCountHint) + `,`,
-		`StickyExecutionEnabled:` + fmt.Sprintf("%v", this.StickyExecutionEnabled) + `,`,
-		`Query:` + strings.Replace(fmt.Sprintf("%v", this.Query), "WorkflowQuery", "v12.WorkflowQuery", 1) + `,`,
-		`TransientWorkflowTask:` + strings.Replace(fmt.Sprintf("%v", this.TransientWorkflowTask), "TransientWorkflowTaskInfo", "v13.TransientWorkflowTaskInfo", 1) + `,`,
-		`WorkflowExecutionTaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecutionTaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
-		`BranchToken:` + fmt.Sprintf("%v", this.BranchToken) + `,`,
-		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`Queries:` + mapStringForQueries + `,`,
-		`Messages:` + repeatedStringForMessages + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PollActivityTaskQueueRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.BranchToken) > 0 {
+		i -= len(m.BranchToken)
+		copy(dAtA[i:], m.BranchToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BranchToken)))
+		i--
+		dAtA[i] = 0x72
 	}
-	s := strings.Join([]string{`&PollActivityTaskQueueRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`PollerId:` + fmt.Sprintf("%v", this.PollerId) + `,`,
-		`PollRequest:` + strings.Replace(fmt.Sprintf("%v", this.PollRequest), "PollActivityTaskQueueRequest", "v1.PollActivityTaskQueueRequest", 1) + `,`,
-		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PollActivityTaskQueueResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.WorkflowExecutionTaskQueue != nil {
+		{
+			size, err := m.WorkflowExecutionTaskQueue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
 	}
-	s := strings.Join([]string{`&PollActivityTaskQueueResponse{`,
-		`TaskToken:` + fmt.Sprintf("%v", this.TaskToken) + `,`,
-		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
-		`ActivityId:` + fmt.Sprintf("%v", this.ActivityId) + `,`,
-		`ActivityType:` + strings.Replace(fmt.Sprintf("%v", this.ActivityType), "ActivityType", "v11.ActivityType", 1) + `,`,
-		`Input:` + strings.Replace(fmt.Sprintf("%v", this.Input), "Payloads", "v11.Payloads", 1) + `,`,
-		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`ScheduleToCloseTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToCloseTimeout), "Duration", "types.Duration", 1) + `,`,
-		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`StartToCloseTimeout:` + strings.Replace(fmt.Sprintf("%v", this.StartToCloseTimeout), "Duration", "types.Duration", 1) + `,`,
-		`HeartbeatTimeout:` + strings.Replace(fmt.Sprintf("%v", this.HeartbeatTimeout), "Duration", "types.Duration", 1) + `,`,
-		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
-		`CurrentAttemptScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.CurrentAttemptScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
-		`HeartbeatDetails:` + strings.Replace(fmt.Sprintf("%v", this.HeartbeatDetails), "Payloads", "v11.Payloads", 1) + `,`,
-		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v11.WorkflowType", 1) + `,`,
-		`WorkflowNamespace:` + fmt.Sprintf("%v", this.WorkflowNamespace) + `,`,
-		`Header:` + strings.Replace(fmt.Sprintf("%v", this.Header), "Header", "v11.Header", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AddWorkflowTaskRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.TransientWorkflowTask != nil {
+		{
+			size, err := m.TransientWorkflowTask.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	s := strings.Join([]string{`&AddWorkflowTaskRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
-		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
-		`ScheduledEventId:` + fmt.Sprintf("%v", this.ScheduledEventId) + `,`,
-		`ScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
-		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
-		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
-		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v17.VectorClock", 1) + `,`,
-		`VersionDirective:` + strings.Replace(fmt.Sprintf("%v", this.VersionDirective), "TaskVersionDirective", "v18.TaskVersionDirective", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AddWorkflowTaskResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.Query != nil {
+		{
+			size, err := m.Query.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
 	}
-	s := strings.Join([]string{`&AddWorkflowTaskResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AddActivityTaskRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.StickyExecutionEnabled {
+		i--
+		if m.StickyExecutionEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
 	}
-	s := strings.Join([]string{`&AddActivityTaskRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
-		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
-		`ScheduledEventId:` + fmt.Sprintf("%v", this.ScheduledEventId) + `,`,
-		`ScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
-		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
-		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
-		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v17.VectorClock", 1) + `,`,
-		`VersionDirective:` + strings.Replace(fmt.Sprintf("%v", this.VersionDirective), "TaskVersionDirective", "v18.TaskVersionDirective", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AddActivityTaskResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.BacklogCountHint != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.BacklogCountHint))
+		i--
+		dAtA[i] = 0x40
 	}
-	s := strings.Join([]string{`&AddActivityTaskResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *QueryWorkflowRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.NextEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.NextEventId))
+		i--
+		dAtA[i] = 0x38
 	}
-	s := strings.Join([]string{`&QueryWorkflowRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
-		`QueryRequest:` + strings.Replace(fmt.Sprintf("%v", this.QueryRequest), "QueryWorkflowRequest", "v1.QueryWorkflowRequest", 1) + `,`,
-		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
-		`VersionDirective:` + strings.Replace(fmt.Sprintf("%v", this.VersionDirective), "TaskVersionDirective", "v18.TaskVersionDirective", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *QueryWorkflowResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.Attempt != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x30
 	}
-	s := strings.Join([]string{`&QueryWorkflowResponse{`,
-		`QueryResult:` + strings.Replace(fmt.Sprintf("%v", this.QueryResult), "Payloads", "v11.Payloads", 1) + `,`,
-		`QueryRejected:` + strings.Replace(fmt.Sprintf("%v", this.QueryRejected), "QueryRejected", "v12.QueryRejected", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RespondQueryTaskCompletedRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.StartedEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StartedEventId))
+		i--
+		dAtA[i] = 0x28
 	}
-	s := strings.Join([]string{`&RespondQueryTaskCompletedRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
-		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
-		`CompletedRequest:` + strings.Replace(fmt.Sprintf("%v", this.CompletedRequest), "RespondQueryTaskCompletedRequest", "v1.RespondQueryTaskCompletedRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *RespondQueryTaskCompletedResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.PreviousStartedEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PreviousStartedEventId))
+		i--
+		dAtA[i] = 0x20
 	}
-	s := strings.Join([]string{`&RespondQueryTaskCompletedResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CancelOutstandingPollRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	s := strings.Join([]string{`&CancelOutstandingPollRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`TaskQueueType:` + fmt.Sprintf("%v", this.TaskQueueType) + `,`,
-		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
-		`PollerId:` + fmt.Sprintf("%v", this.PollerId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CancelOutstandingPollResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	s := strings.Join([]string{`&CancelOutstandingPollResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DescribeTaskQueueRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.TaskToken) > 0 {
+		i -= len(m.TaskToken)
+		copy(dAtA[i:], m.TaskToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskToken)))
+		i--
+		dAtA[i] = 0xa
 	}
-	s := strings.Join([]string{`&DescribeTaskQueueRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`DescRequest:` + strings.Replace(fmt.Sprintf("%v", this.DescRequest), "DescribeTaskQueueRequest", "v1.DescribeTaskQueueRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *DescribeTaskQueueResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForPollers := "[]*PollerInfo{"
-	for _, f := range this.Pollers {
-		repeatedStringForPollers += strings.Replace(fmt.Sprintf("%v", f), "PollerInfo", "v14.PollerInfo", 1) + ","
+
+func (m *PollActivityTaskQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForPollers += "}"
-	s := strings.Join([]string{`&DescribeTaskQueueResponse{`,
-		`Pollers:` + repeatedStringForPollers + `,`,
-		`TaskQueueStatus:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueueStatus), "TaskQueueStatus", "v14.TaskQueueStatus", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *ListTaskQueuePartitionsRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ListTaskQueuePartitionsRequest{`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *PollActivityTaskQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *ListTaskQueuePartitionsResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PollActivityTaskQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ForwardedSource) > 0 {
+		i -= len(m.ForwardedSource)
+		copy(dAtA[i:], m.ForwardedSource)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ForwardedSource)))
+		i--
+		dAtA[i] = 0x22
 	}
-	repeatedStringForActivityTaskQueuePartitions := "[]*TaskQueuePartitionMetadata{"
-	for _, f := range this.ActivityTaskQueuePartitions {
-		repeatedStringForActivityTaskQueuePartitions += strings.Replace(fmt.Sprintf("%v", f), "TaskQueuePartitionMetadata", "v14.TaskQueuePartitionMetadata", 1) + ","
+	if m.PollRequest != nil {
+		{
+			size, err := m.PollRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	repeatedStringForActivityTaskQueuePartitions += "}"
-	repeatedStringForWorkflowTaskQueuePartitions := "[]*TaskQueuePartitionMetadata{"
-	for _, f := range this.WorkflowTaskQueuePartitions {
-		repeatedStringForWorkflowTaskQueuePartitions += strings.Replace(fmt.Sprintf("%v", f), "TaskQueuePartitionMetadata", "v14.TaskQueuePartitionMetadata", 1) + ","
+	if len(m.PollerId) > 0 {
+		i -= len(m.PollerId)
+		copy(dAtA[i:], m.PollerId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.PollerId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	repeatedStringForWorkflowTaskQueuePartitions += "}"
-	s := strings.Join([]string{`&ListTaskQueuePartitionsResponse{`,
-		`ActivityTaskQueuePartitions:` + repeatedStringForActivityTaskQueuePartitions + `,`,
-		`WorkflowTaskQueuePartitions:` + repeatedStringForWorkflowTaskQueuePartitions + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *UpdateWorkerBuildIdCompatibilityRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	s := strings.Join([]string{`&UpdateWorkerBuildIdCompatibilityRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "UpdateWorkerBuildIdCompatibilityRequest", "v1.UpdateWorkerBuildIdCompatibilityRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *UpdateWorkerBuildIdCompatibilityResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PollActivityTaskQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&UpdateWorkerBuildIdCompatibilityResponse{`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *GetWorkerBuildIdCompatibilityRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&GetWorkerBuildIdCompatibilityRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "GetWorkerBuildIdCompatibilityRequest", "v1.GetWorkerBuildIdCompatibilityRequest", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *PollActivityTaskQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *GetWorkerBuildIdCompatibilityResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PollActivityTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	s := strings.Join([]string{`&GetWorkerBuildIdCompatibilityResponse{`,
-		`Response:` + strings.Replace(fmt.Sprintf("%v", this.Response), "GetWorkerBuildIdCompatibilityResponse", "v1.GetWorkerBuildIdCompatibilityResponse", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetTaskQueueUserDataRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.WorkflowNamespace) > 0 {
+		i -= len(m.WorkflowNamespace)
+		copy(dAtA[i:], m.WorkflowNamespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.WorkflowNamespace)))
+		i--
+		dAtA[i] = 0x7a
 	}
-	s := strings.Join([]string{`&GetTaskQueueUserDataRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
-		`LastKnownUserDataVersion:` + fmt.Sprintf("%v", this.LastKnownUserDataVersion) + `,`,
-		`WaitNewData:` + fmt.Sprintf("%v", this.WaitNewData) + `,`,
-		`TaskQueueType:` + fmt.Sprintf("%v", this.TaskQueueType) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetTaskQueueUserDataResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.WorkflowType != nil {
+		{
+			size, err := m.WorkflowType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
 	}
-	s := strings.Join([]string{`&GetTaskQueueUserDataResponse{`,
-		`TaskQueueHasUserData:` + fmt.Sprintf("%v", this.TaskQueueHasUserData) + `,`,
-		`UserData:` + strings.Replace(fmt.Sprintf("%v", this.UserData), "VersionedTaskQueueUserData", "v110.VersionedTaskQueueUserData", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ApplyTaskQueueUserDataReplicationEventRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.HeartbeatDetails != nil {
+		{
+			size, err := m.HeartbeatDetails.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
 	}
-	s := strings.Join([]string{`&ApplyTaskQueueUserDataReplicationEventRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
-		`UserData:` + strings.Replace(fmt.Sprintf("%v", this.UserData), "TaskQueueUserData", "v110.TaskQueueUserData", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ApplyTaskQueueUserDataReplicationEventResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.CurrentAttemptScheduledTime != nil {
+		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CurrentAttemptScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.CurrentAttemptScheduledTime):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x62
 	}
-	s := strings.Join([]string{`&ApplyTaskQueueUserDataReplicationEventResponse{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetBuildIdTaskQueueMappingRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.Attempt != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x58
 	}
-	s := strings.Join([]string{`&GetBuildIdTaskQueueMappingRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`BuildId:` + fmt.Sprintf("%v", this.BuildId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetBuildIdTaskQueueMappingResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.HeartbeatTimeout != nil {
+		n15, err15 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.HeartbeatTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout):])
+		if err15 != nil {
+			return 0, err15
+		}
+		i -= n15
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0x52
 	}
-	s := strings.Join([]string{`&GetBuildIdTaskQueueMappingResponse{`,
-		`TaskQueues:` + fmt.Sprintf("%v", this.TaskQueues) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ForceUnloadTaskQueueRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.StartToCloseTimeout != nil {
+		n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StartToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x4a
 	}
-	s := strings.Join([]string{`&ForceUnloadTaskQueueRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
-		`TaskQueueType:` + fmt.Sprintf("%v", this.TaskQueueType) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ForceUnloadTaskQueueResponse) String() string {
-	if this == nil {
-		return "nil"
+	if m.StartedTime != nil {
+		n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime):])
+		if err17 != nil {
+			return 0, err17
+		}
+		i -= n17
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n17))
+		i--
+		dAtA[i] = 0x42
 	}
-	s := strings.Join([]string{`&ForceUnloadTaskQueueResponse{`,
-		`WasLoaded:` + fmt.Sprintf("%v", this.WasLoaded) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *UpdateTaskQueueUserDataRequest) String() string {
-	if this == nil {
-		return "nil"
+	if m.ScheduleToCloseTimeout != nil {
+		n18, err18 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToCloseTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout):])
+		if err18 != nil {
+			return 0, err18
+		}
+		i -= n18
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n18))
+		i--
+		dAtA[i] = 0x3a
 	}
-	s := strings.Join([]string{`&UpdateTaskQueueUserDataRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
-		`UserData:` + strings.Replace(fmt.Sprintf("%v", this.UserData), "VersionedTaskQueueUserData", "v110.VersionedTaskQueueUserData", 1) + `,`,
-		`BuildIdsAdded:` + fmt.Sprintf("%v", this.BuildIdsAdded) + `,`,
-		`BuildIdsRemoved:` + fmt.Sprintf("%v", this.BuildIdsRemoved) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.ScheduledTime != nil {
+		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ScheduledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime):])
+		if err19 != nil {
+			return 0, err19
+		}
+		i -= n19
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n19))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ActivityType != nil {
+		{
+			size, err := m.ActivityType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ActivityId) > 0 {
+		i -= len(m.ActivityId)
+		copy(dAtA[i:], m.ActivityId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ActivityId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskToken) > 0 {
+		i -= len(m.TaskToken)
+		copy(dAtA[i:], m.TaskToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (this *UpdateTaskQueueUserDataResponse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *AddWorkflowTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&UpdateTaskQueueUserDataResponse{`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *ReplicateTaskQueueUserDataRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *AddWorkflowTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddWorkflowTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VersionDirective != nil {
+		{
+			size, err := m.VersionDirective.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Clock != nil {
+		{
+			size, err := m.Clock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Source != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Source))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ForwardedSource) > 0 {
+		i -= len(m.ForwardedSource)
+		copy(dAtA[i:], m.ForwardedSource)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ForwardedSource)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ScheduleToStartTimeout != nil {
+		n25, err25 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
+		if err25 != nil {
+			return 0, err25
+		}
+		i -= n25
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n25))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ScheduledEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ScheduledEventId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TaskQueue != nil {
+		{
+			size, err := m.TaskQueue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddWorkflowTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddWorkflowTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddWorkflowTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AddActivityTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddActivityTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddActivityTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VersionDirective != nil {
+		{
+			size, err := m.VersionDirective.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Clock != nil {
+		{
+			size, err := m.Clock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Source != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.Source))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ForwardedSource) > 0 {
+		i -= len(m.ForwardedSource)
+		copy(dAtA[i:], m.ForwardedSource)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ForwardedSource)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ScheduleToStartTimeout != nil {
+		n30, err30 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ScheduleToStartTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout):])
+		if err30 != nil {
+			return 0, err30
+		}
+		i -= n30
+		i = encodeVarintRequestResponse(dAtA, i, uint64(n30))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ScheduledEventId != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ScheduledEventId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TaskQueue != nil {
+		{
+			size, err := m.TaskQueue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddActivityTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddActivityTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddActivityTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWorkflowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VersionDirective != nil {
+		{
+			size, err := m.VersionDirective.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ForwardedSource) > 0 {
+		i -= len(m.ForwardedSource)
+		copy(dAtA[i:], m.ForwardedSource)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.ForwardedSource)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.QueryRequest != nil {
+		{
+			size, err := m.QueryRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TaskQueue != nil {
+		{
+			size, err := m.TaskQueue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWorkflowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWorkflowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWorkflowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.QueryRejected != nil {
+		{
+			size, err := m.QueryRejected.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.QueryResult != nil {
+		{
+			size, err := m.QueryResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RespondQueryTaskCompletedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RespondQueryTaskCompletedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RespondQueryTaskCompletedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletedRequest != nil {
+		{
+			size, err := m.CompletedRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TaskQueue != nil {
+		{
+			size, err := m.TaskQueue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RespondQueryTaskCompletedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RespondQueryTaskCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RespondQueryTaskCompletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelOutstandingPollRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelOutstandingPollRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelOutstandingPollRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PollerId) > 0 {
+		i -= len(m.PollerId)
+		copy(dAtA[i:], m.PollerId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.PollerId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TaskQueue != nil {
+		{
+			size, err := m.TaskQueue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TaskQueueType != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskQueueType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelOutstandingPollResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelOutstandingPollResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelOutstandingPollResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeTaskQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeTaskQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeTaskQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VersionSetIds) > 0 {
+		for iNdEx := len(m.VersionSetIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VersionSetIds[iNdEx])
+			copy(dAtA[i:], m.VersionSetIds[iNdEx])
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.VersionSetIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.DescRequest != nil {
+		{
+			size, err := m.DescRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeTaskQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeTaskQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VersionedBacklogCountHints) > 0 {
+		for k := range m.VersionedBacklogCountHints {
+			v := m.VersionedBacklogCountHints[k]
+			baseI := i
+			i = encodeVarintRequestResponse(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.UnversionedBacklogCountHint != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.UnversionedBacklogCountHint))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TaskQueueStatus != nil {
+		{
+			size, err := m.TaskQueueStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pollers) > 0 {
+		for iNdEx := len(m.Pollers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pollers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListTaskQueuePartitionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTaskQueuePartitionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListTaskQueuePartitionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TaskQueue != nil {
+		{
+			size, err := m.TaskQueue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListTaskQueuePartitionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTaskQueuePartitionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListTaskQueuePartitionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WorkflowTaskQueuePartitions) > 0 {
+		for iNdEx := len(m.WorkflowTaskQueuePartitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WorkflowTaskQueuePartitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ActivityTaskQueuePartitions) > 0 {
+		for iNdEx := len(m.ActivityTaskQueuePartitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActivityTaskQueuePartitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Operation != nil {
+		{
+			size := m.Operation.Size()
+			i -= size
+			if _, err := m.Operation.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MarkBuildIdDraining != nil {
+		{
+			size, err := m.MarkBuildIdDraining.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SwapBuildIdsWithinSet != nil {
+		{
+			size, err := m.SwapBuildIdsWithinSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SetBuildIdLabels != nil {
+		{
+			size, err := m.SetBuildIdLabels.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SwapDefaultSets != nil {
+		{
+			size, err := m.SwapDefaultSets.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RemoveBuildId != nil {
+		{
+			size, err := m.RemoveBuildId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BuildId) > 0 {
+		i -= len(m.BuildId)
+		copy(dAtA[i:], m.BuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BuildId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SecondBuildId) > 0 {
+		i -= len(m.SecondBuildId)
+		copy(dAtA[i:], m.SecondBuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SecondBuildId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FirstBuildId) > 0 {
+		i -= len(m.FirstBuildId)
+		copy(dAtA[i:], m.FirstBuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.FirstBuildId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.BuildId) > 0 {
+		i -= len(m.BuildId)
+		copy(dAtA[i:], m.BuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BuildId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SecondBuildId) > 0 {
+		i -= len(m.SecondBuildId)
+		copy(dAtA[i:], m.SecondBuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.SecondBuildId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FirstBuildId) > 0 {
+		i -= len(m.FirstBuildId)
+		copy(dAtA[i:], m.FirstBuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.FirstBuildId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BuildId) > 0 {
+		i -= len(m.BuildId)
+		copy(dAtA[i:], m.BuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BuildId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetWorkerBuildIdCompatibilityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetWorkerBuildIdCompatibilityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetWorkerBuildIdCompatibilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HypotheticalUpdate != nil {
+		{
+			size, err := m.HypotheticalUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetWorkerBuildIdCompatibilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetWorkerBuildIdCompatibilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetWorkerBuildIdCompatibilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BuildIdLabels) > 0 {
+		for k := range m.BuildIdLabels {
+			v := m.BuildIdLabels[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Response != nil {
+		{
+			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetWorkerBuildIdCompatibilityResponse_BuildIdLabels) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetWorkerBuildIdCompatibilityResponse_BuildIdLabels) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetWorkerBuildIdCompatibilityResponse_BuildIdLabels) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintRequestResponse(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTaskQueueUserDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTaskQueueUserDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTaskQueueUserDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OnlyIfLoaded {
+		i--
+		if m.OnlyIfLoaded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TaskQueueType != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskQueueType))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.WaitNewData {
+		i--
+		if m.WaitNewData {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastKnownUserDataVersion != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.LastKnownUserDataVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTaskQueueUserDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTaskQueueUserDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTaskQueueUserDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PartitionNotLoaded {
+		i--
+		if m.PartitionNotLoaded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.UserData != nil {
+		{
+			size, err := m.UserData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TaskQueueHasUserData {
+		i--
+		if m.TaskQueueHasUserData {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplyTaskQueueUserDataReplicationEventRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplyTaskQueueUserDataReplicationEventRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplyTaskQueueUserDataReplicationEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UserData != nil {
+		{
+			size, err := m.UserData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplyTaskQueueUserDataReplicationEventResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplyTaskQueueUserDataReplicationEventResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplyTaskQueueUserDataReplicationEventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeVersioningRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeVersioningRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeVersioningRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeVersioningResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeVersioningResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeVersioningResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VersionSets) > 0 {
+		for iNdEx := len(m.VersionSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VersionSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeVersioningResponse_BuildIdInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeVersioningResponse_BuildIdInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeVersioningResponse_BuildIdInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OpenWorkflowCount != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.OpenWorkflowCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Reachability) > 0 {
+		dAtA57 := make([]byte, len(m.Reachability)*10)
+		var j56 int
+		for _, num := range m.Reachability {
+			for num >= 1<<7 {
+				dAtA57[j56] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j56++
+			}
+			dAtA57[j56] = uint8(num)
+			j56++
+		}
+		i -= j56
+		copy(dAtA[i:], dAtA57[:j56])
+		i = encodeVarintRequestResponse(dAtA, i, uint64(j56))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PollerCount != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PollerCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.State != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IsSetDefault {
+		i--
+		if m.IsSetDefault {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BuildId) > 0 {
+		i -= len(m.BuildId)
+		copy(dAtA[i:], m.BuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BuildId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeVersioningResponse_VersionSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeVersioningResponse_VersionSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeVersioningResponse_VersionSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsDefault {
+		i--
+		if m.IsDefault {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BuildIds) > 0 {
+		for iNdEx := len(m.BuildIds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BuildIds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBuildIdTaskQueueMappingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBuildIdTaskQueueMappingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBuildIdTaskQueueMappingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BuildId) > 0 {
+		i -= len(m.BuildId)
+		copy(dAtA[i:], m.BuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BuildId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBuildIdTaskQueueMappingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBuildIdTaskQueueMappingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBuildIdTaskQueueMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaskQueues) > 0 {
+		for iNdEx := len(m.TaskQueues) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TaskQueues[iNdEx])
+			copy(dAtA[i:], m.TaskQueues[iNdEx])
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueues[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForceUnloadTaskQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForceUnloadTaskQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForceUnloadTaskQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TaskQueueType != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskQueueType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForceUnloadTaskQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForceUnloadTaskQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForceUnloadTaskQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WasLoaded {
+		i--
+		if m.WasLoaded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateTaskQueueUserDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateTaskQueueUserDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateTaskQueueUserDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BuildIdsRemoved) > 0 {
+		for iNdEx := len(m.BuildIdsRemoved) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BuildIdsRemoved[iNdEx])
+			copy(dAtA[i:], m.BuildIdsRemoved[iNdEx])
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BuildIdsRemoved[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.BuildIdsAdded) > 0 {
+		for iNdEx := len(m.BuildIdsAdded) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BuildIdsAdded[iNdEx])
+			copy(dAtA[i:], m.BuildIdsAdded[iNdEx])
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BuildIdsAdded[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.UserData != nil {
+		{
+			size, err := m.UserData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateTaskQueueUserDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateTaskQueueUserDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateTaskQueueUserDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplicateTaskQueueUserDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicateTaskQueueUserDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicateTaskQueueUserDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UserData != nil {
+		{
+			size, err := m.UserData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplicateTaskQueueUserDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicateTaskQueueUserDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicateTaskQueueUserDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CleanupUnreachableBuildIdsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CleanupUnreachableBuildIdsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CleanupUnreachableBuildIdsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CleanupUnreachableBuildIdsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CleanupUnreachableBuildIdsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CleanupUnreachableBuildIdsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemovedBuildIds) > 0 {
+		for iNdEx := len(m.RemovedBuildIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedBuildIds[iNdEx])
+			copy(dAtA[i:], m.RemovedBuildIds[iNdEx])
+			i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.RemovedBuildIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDefaultBuildIdTimelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDefaultBuildIdTimelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDefaultBuildIdTimelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDefaultBuildIdTimelineResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDefaultBuildIdTimelineResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDefaultBuildIdTimelineResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Timeline) > 0 {
+		for iNdEx := len(m.Timeline) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Timeline[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidateDefaultBuildIdSwitchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidateDefaultBuildIdSwitchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidateDefaultBuildIdSwitchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BuildId) > 0 {
+		i -= len(m.BuildId)
+		copy(dAtA[i:], m.BuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BuildId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidateDefaultBuildIdSwitchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidateDefaultBuildIdSwitchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidateDefaultBuildIdSwitchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MovingWorkflowCount != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MovingWorkflowCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StayingWorkflowCount != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.StayingWorkflowCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetClosedWorkflowBuildIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetClosedWorkflowBuildIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetClosedWorkflowBuildIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Execution != nil {
+		{
+			size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetClosedWorkflowBuildIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetClosedWorkflowBuildIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetClosedWorkflowBuildIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Registered {
+		i--
+		if m.Registered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BuildId) > 0 {
+		i -= len(m.BuildId)
+		copy(dAtA[i:], m.BuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BuildId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetUserDataPropagationStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetUserDataPropagationStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetUserDataPropagationStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetUserDataPropagationStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetUserDataPropagationStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetUserDataPropagationStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskQueues) > 0 {
+		for iNdEx := len(m.TaskQueues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskQueues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetUserDataPropagationStatusResponse_TaskQueueStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetUserDataPropagationStatusResponse_TaskQueueStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetUserDataPropagationStatusResponse_TaskQueueStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PartitionErrors) > 0 {
+		for iNdEx := len(m.PartitionErrors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PartitionErrors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.UnloadedPartitions != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.UnloadedPartitions))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FullyPropagated {
+		i--
+		if m.FullyPropagated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalPartitions != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TotalPartitions))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PropagatedPartitions != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PropagatedPartitions))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.UserDataVersion != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.UserDataVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetUserDataPropagationStatusResponse_PartitionError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetUserDataPropagationStatusResponse_PartitionError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetUserDataPropagationStatusResponse_PartitionError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TaskQueueType != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskQueueType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplyVersioningTemplateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplyVersioningTemplateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplyVersioningTemplateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TemplateName) > 0 {
+		i -= len(m.TemplateName)
+		copy(dAtA[i:], m.TemplateName)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TemplateName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplyVersioningTemplateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplyVersioningTemplateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplyVersioningTemplateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ReassignBuildIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReassignBuildIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReassignBuildIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TargetBuildId) > 0 {
+		i -= len(m.TargetBuildId)
+		copy(dAtA[i:], m.TargetBuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TargetBuildId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BuildId) > 0 {
+		i -= len(m.BuildId)
+		copy(dAtA[i:], m.BuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BuildId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReassignBuildIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReassignBuildIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReassignBuildIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SkippedWorkflows != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.SkippedWorkflows))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ReassignedWorkflows != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.ReassignedWorkflows))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTaskDispatchDecisionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTaskDispatchDecisionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTaskDispatchDecisionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UseCompatibleVersion {
+		i--
+		if m.UseCompatibleVersion {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.WorkflowBuildId) > 0 {
+		i -= len(m.WorkflowBuildId)
+		copy(dAtA[i:], m.WorkflowBuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.WorkflowBuildId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TaskQueueType != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.TaskQueueType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTaskDispatchDecisionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTaskDispatchDecisionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTaskDispatchDecisionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VersionSetId) > 0 {
+		i -= len(m.VersionSetId)
+		copy(dAtA[i:], m.VersionSetId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.VersionSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BuildId) > 0 {
+		i -= len(m.BuildId)
+		copy(dAtA[i:], m.BuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BuildId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnableWorkerVersioningRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnableWorkerVersioningRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnableWorkerVersioningRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Force {
+		i--
+		if m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BuildId) > 0 {
+		i -= len(m.BuildId)
+		copy(dAtA[i:], m.BuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BuildId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnableWorkerVersioningResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnableWorkerVersioningResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnableWorkerVersioningResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PollerCount != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PollerCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListWorkerBuildIdCompatibilityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListWorkerBuildIdCompatibilityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListWorkerBuildIdCompatibilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSets != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.MaxSets))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintRequestResponse(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListWorkerBuildIdCompatibilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListWorkerBuildIdCompatibilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListWorkerBuildIdCompatibilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskQueues) > 0 {
+		for iNdEx := len(m.TaskQueues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskQueues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MajorVersionSets) > 0 {
+		for iNdEx := len(m.MajorVersionSets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MajorVersionSets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBuildIdReachabilityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBuildIdReachabilityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBuildIdReachabilityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BuildId) > 0 {
+		i -= len(m.BuildId)
+		copy(dAtA[i:], m.BuildId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.BuildId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskQueue) > 0 {
+		i -= len(m.TaskQueue)
+		copy(dAtA[i:], m.TaskQueue)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.TaskQueue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintRequestResponse(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBuildIdReachabilityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBuildIdReachabilityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBuildIdReachabilityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TaskQueueReachability != nil {
+		{
+			size, err := m.TaskQueueReachability.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRequestResponse(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRequestResponse(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRequestResponse(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PollWorkflowTaskQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.PollerId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.PollRequest != nil {
+		l = m.PollRequest.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ForwardedSource)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *PollWorkflowTaskQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.PreviousStartedEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.PreviousStartedEventId))
+	}
+	if m.StartedEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.StartedEventId))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Attempt))
+	}
+	if m.NextEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.NextEventId))
+	}
+	if m.BacklogCountHint != 0 {
+		n += 1 + sovRequestResponse(uint64(m.BacklogCountHint))
+	}
+	if m.StickyExecutionEnabled {
+		n += 2
+	}
+	if m.Query != nil {
+		l = m.Query.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.TransientWorkflowTask != nil {
+		l = m.TransientWorkflowTask.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowExecutionTaskQueue != nil {
+		l = m.WorkflowExecutionTaskQueue.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.BranchToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.ScheduledTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.StartedTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime)
+		n += 2 + l + sovRequestResponse(uint64(l))
+	}
+	if len(m.Queries) > 0 {
+		for k, v := range m.Queries {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovRequestResponse(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sovRequestResponse(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 2 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PollActivityTaskQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.PollerId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.PollRequest != nil {
+		l = m.PollRequest.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ForwardedSource)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *PollActivityTaskQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ActivityId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.ActivityType != nil {
+		l = m.ActivityType.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.ScheduledTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ScheduledTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.ScheduleToCloseTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToCloseTimeout)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.StartedTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartedTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.StartToCloseTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StartToCloseTimeout)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.HeartbeatTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.HeartbeatTimeout)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Attempt))
+	}
+	if m.CurrentAttemptScheduledTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CurrentAttemptScheduledTime)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.HeartbeatDetails != nil {
+		l = m.HeartbeatDetails.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.WorkflowType != nil {
+		l = m.WorkflowType.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.WorkflowNamespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 2 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *AddWorkflowTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.TaskQueue != nil {
+		l = m.TaskQueue.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.ScheduledEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ScheduledEventId))
+	}
+	if m.ScheduleToStartTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ForwardedSource)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Source != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Source))
+	}
+	if m.Clock != nil {
+		l = m.Clock.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.VersionDirective != nil {
+		l = m.VersionDirective.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *AddWorkflowTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AddActivityTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.TaskQueue != nil {
+		l = m.TaskQueue.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.ScheduledEventId != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ScheduledEventId))
+	}
+	if m.ScheduleToStartTimeout != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ScheduleToStartTimeout)
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ForwardedSource)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Source != 0 {
+		n += 1 + sovRequestResponse(uint64(m.Source))
+	}
+	if m.Clock != nil {
+		l = m.Clock.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.VersionDirective != nil {
+		l = m.VersionDirective.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *AddActivityTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.TaskQueue != nil {
+		l = m.TaskQueue.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.QueryRequest != nil {
+		l = m.QueryRequest.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.ForwardedSource)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.VersionDirective != nil {
+		l = m.VersionDirective.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWorkflowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryResult != nil {
+		l = m.QueryResult.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.QueryRejected != nil {
+		l = m.QueryRejected.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RespondQueryTaskCompletedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.TaskQueue != nil {
+		l = m.TaskQueue.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.CompletedRequest != nil {
+		l = m.CompletedRequest.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *RespondQueryTaskCompletedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CancelOutstandingPollRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.TaskQueueType != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TaskQueueType))
+	}
+	if m.TaskQueue != nil {
+		l = m.TaskQueue.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.PollerId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *CancelOutstandingPollResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DescribeTaskQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.DescRequest != nil {
+		l = m.DescRequest.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if len(m.VersionSetIds) > 0 {
+		for _, s := range m.VersionSetIds {
+			l = len(s)
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DescribeTaskQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pollers) > 0 {
+		for _, e := range m.Pollers {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	if m.TaskQueueStatus != nil {
+		l = m.TaskQueueStatus.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.UnversionedBacklogCountHint != 0 {
+		n += 1 + sovRequestResponse(uint64(m.UnversionedBacklogCountHint))
+	}
+	if len(m.VersionedBacklogCountHints) > 0 {
+		for k, v := range m.VersionedBacklogCountHints {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + 1 + sovRequestResponse(uint64(v))
+			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ListTaskQueuePartitionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.TaskQueue != nil {
+		l = m.TaskQueue.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ListTaskQueuePartitionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ActivityTaskQueuePartitions) > 0 {
+		for _, e := range m.ActivityTaskQueuePartitions {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	if len(m.WorkflowTaskQueuePartitions) > 0 {
+		for _, e := range m.WorkflowTaskQueuePartitions {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Operation != nil {
+		n += m.Operation.Size()
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarkBuildIdDraining != nil {
+		l = m.MarkBuildIdDraining.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SwapBuildIdsWithinSet != nil {
+		l = m.SwapBuildIdsWithinSet.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SetBuildIdLabels != nil {
+		l = m.SetBuildIdLabels.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SwapDefaultSets != nil {
+		l = m.SwapDefaultSets.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RemoveBuildId != nil {
+		l = m.RemoveBuildId.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BuildId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FirstBuildId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.SecondBuildId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BuildId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + 1 + len(v) + sovRequestResponse(uint64(len(v)))
+			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FirstBuildId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.SecondBuildId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BuildId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateWorkerBuildIdCompatibilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetWorkerBuildIdCompatibilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.HypotheticalUpdate != nil {
+		l = m.HypotheticalUpdate.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetWorkerBuildIdCompatibilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if len(m.BuildIdLabels) > 0 {
+		for k, v := range m.BuildIdLabels {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovRequestResponse(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *GetWorkerBuildIdCompatibilityResponse_BuildIdLabels) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRequestResponse(uint64(len(k))) + 1 + len(v) + sovRequestResponse(uint64(len(v)))
+			n += mapEntrySize + 1 + sovRequestResponse(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *GetTaskQueueUserDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.LastKnownUserDataVersion != 0 {
+		n += 1 + sovRequestResponse(uint64(m.LastKnownUserDataVersion))
+	}
+	if m.WaitNewData {
+		n += 2
+	}
+	if m.TaskQueueType != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TaskQueueType))
+	}
+	if m.OnlyIfLoaded {
+		n += 2
+	}
+	return n
+}
+
+func (m *GetTaskQueueUserDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskQueueHasUserData {
+		n += 2
+	}
+	if m.UserData != nil {
+		l = m.UserData.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.PartitionNotLoaded {
+		n += 2
+	}
+	return n
+}
+
+func (m *ApplyTaskQueueUserDataReplicationEventRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.UserData != nil {
+		l = m.UserData.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplyTaskQueueUserDataReplicationEventResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DescribeVersioningRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.PageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *DescribeVersioningResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VersionSets) > 0 {
+		for _, e := range m.VersionSets {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *DescribeVersioningResponse_BuildIdInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BuildId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.IsSetDefault {
+		n += 2
+	}
+	if m.State != 0 {
+		n += 1 + sovRequestResponse(uint64(m.State))
+	}
+	if m.PollerCount != 0 {
+		n += 1 + sovRequestResponse(uint64(m.PollerCount))
+	}
+	if len(m.Reachability) > 0 {
+		l = 0
+		for _, e := range m.Reachability {
+			l += sovRequestResponse(uint64(e))
+		}
+		n += 1 + sovRequestResponse(uint64(l)) + l
+	}
+	if m.OpenWorkflowCount != 0 {
+		n += 1 + sovRequestResponse(uint64(m.OpenWorkflowCount))
+	}
+	return n
+}
+
+func (m *DescribeVersioningResponse_VersionSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BuildIds) > 0 {
+		for _, e := range m.BuildIds {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	if m.IsDefault {
+		n += 2
+	}
+	return n
+}
+
+func (m *GetBuildIdTaskQueueMappingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.BuildId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetBuildIdTaskQueueMappingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaskQueues) > 0 {
+		for _, s := range m.TaskQueues {
+			l = len(s)
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ForceUnloadTaskQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.TaskQueueType != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TaskQueueType))
+	}
+	return n
+}
+
+func (m *ForceUnloadTaskQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WasLoaded {
+		n += 2
+	}
+	return n
+}
+
+func (m *UpdateTaskQueueUserDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.UserData != nil {
+		l = m.UserData.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if len(m.BuildIdsAdded) > 0 {
+		for _, s := range m.BuildIdsAdded {
+			l = len(s)
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	if len(m.BuildIdsRemoved) > 0 {
+		for _, s := range m.BuildIdsRemoved {
+			l = len(s)
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpdateTaskQueueUserDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ReplicateTaskQueueUserDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.UserData != nil {
+		l = m.UserData.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ReplicateTaskQueueUserDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CleanupUnreachableBuildIdsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *CleanupUnreachableBuildIdsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RemovedBuildIds) > 0 {
+		for _, s := range m.RemovedBuildIds {
+			l = len(s)
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetDefaultBuildIdTimelineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetDefaultBuildIdTimelineResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Timeline) > 0 {
+		for _, e := range m.Timeline {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidateDefaultBuildIdSwitchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.BuildId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidateDefaultBuildIdSwitchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StayingWorkflowCount != 0 {
+		n += 1 + sovRequestResponse(uint64(m.StayingWorkflowCount))
+	}
+	if m.MovingWorkflowCount != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MovingWorkflowCount))
+	}
+	return n
+}
+
+func (m *GetClosedWorkflowBuildIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Execution != nil {
+		l = m.Execution.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetClosedWorkflowBuildIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BuildId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Registered {
+		n += 2
+	}
+	return n
+}
+
+func (m *GetUserDataPropagationStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.PageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetUserDataPropagationStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaskQueues) > 0 {
+		for _, e := range m.TaskQueues {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetUserDataPropagationStatusResponse_TaskQueueStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.UserDataVersion != 0 {
+		n += 1 + sovRequestResponse(uint64(m.UserDataVersion))
+	}
+	if m.PropagatedPartitions != 0 {
+		n += 1 + sovRequestResponse(uint64(m.PropagatedPartitions))
+	}
+	if m.TotalPartitions != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TotalPartitions))
+	}
+	if m.FullyPropagated {
+		n += 2
+	}
+	if m.UnloadedPartitions != 0 {
+		n += 1 + sovRequestResponse(uint64(m.UnloadedPartitions))
+	}
+	if len(m.PartitionErrors) > 0 {
+		for _, e := range m.PartitionErrors {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetUserDataPropagationStatusResponse_PartitionError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.TaskQueueType != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TaskQueueType))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplyVersioningTemplateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TemplateName)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplyVersioningTemplateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ReassignBuildIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.BuildId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TargetBuildId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ReassignBuildIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReassignedWorkflows != 0 {
+		n += 1 + sovRequestResponse(uint64(m.ReassignedWorkflows))
+	}
+	if m.SkippedWorkflows != 0 {
+		n += 1 + sovRequestResponse(uint64(m.SkippedWorkflows))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetTaskDispatchDecisionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.TaskQueueType != 0 {
+		n += 1 + sovRequestResponse(uint64(m.TaskQueueType))
+	}
+	l = len(m.WorkflowBuildId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.UseCompatibleVersion {
+		n += 2
+	}
+	return n
+}
+
+func (m *GetTaskDispatchDecisionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BuildId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.VersionSetId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *EnableWorkerVersioningRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.BuildId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
+	return n
+}
+
+func (m *EnableWorkerVersioningResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PollerCount != 0 {
+		n += 1 + sovRequestResponse(uint64(m.PollerCount))
+	}
+	return n
+}
+
+func (m *ListWorkerBuildIdCompatibilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovRequestResponse(uint64(m.PageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if m.MaxSets != 0 {
+		n += 1 + sovRequestResponse(uint64(m.MaxSets))
+	}
+	return n
+}
+
+func (m *ListWorkerBuildIdCompatibilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaskQueues) > 0 {
+		for _, e := range m.TaskQueues {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	if len(m.MajorVersionSets) > 0 {
+		for _, e := range m.MajorVersionSets {
+			l = e.Size()
+			n += 1 + l + sovRequestResponse(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetBuildIdReachabilityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.TaskQueue)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	l = len(m.BuildId)
+	if l > 0 {
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func (m *GetBuildIdReachabilityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskQueueReachability != nil {
+		l = m.TaskQueueReachability.Size()
+		n += 1 + l + sovRequestResponse(uint64(l))
+	}
+	return n
+}
+
+func sovRequestResponse(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRequestResponse(x uint64) (n int) {
+	return sovRequestResponse(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *PollWorkflowTaskQueueRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PollWorkflowTaskQueueRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`PollerId:` + fmt.Sprintf("%v", this.PollerId) + `,`,
+		`PollRequest:` + strings.Replace(fmt.Sprintf("%v", this.PollRequest), "PollWorkflowTaskQueueRequest", "v1.PollWorkflowTaskQueueRequest", 1) + `,`,
+		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PollWorkflowTaskQueueResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMessages := "[]*Message{"
+	for _, f := range this.Messages {
+		repeatedStringForMessages += strings.Replace(fmt.Sprintf("%v", f), "Message", "v15.Message", 1) + ","
+	}
+	repeatedStringForMessages += "}"
+	keysForQueries := make([]string, 0, len(this.Queries))
+	for k, _ := range this.Queries {
+		keysForQueries = append(keysForQueries, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForQueries)
+	mapStringForQueries := "map[string]*v12.WorkflowQuery{"
+	for _, k := range keysForQueries {
+		mapStringForQueries += fmt.Sprintf("%v: %v,", k, this.Queries[k])
+	}
+	mapStringForQueries += "}"
+	s := strings.Join([]string{`&PollWorkflowTaskQueueResponse{`,
+		`TaskToken:` + fmt.Sprintf("%v", this.TaskToken) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
+		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v11.WorkflowType", 1) + `,`,
+		`PreviousStartedEventId:` + fmt.Sprintf("%v", this.PreviousStartedEventId) + `,`,
+		`StartedEventId:` + fmt.Sprintf("%v", this.StartedEventId) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`NextEventId:` + fmt.Sprintf("%v", this.NextEventId) + `,`,
+		`BacklogCountHint:` + fmt.Sprintf("%v", this.BacklogCountHint) + `,`,
+		`StickyExecutionEnabled:` + fmt.Sprintf("%v", this.StickyExecutionEnabled) + `,`,
+		`Query:` + strings.Replace(fmt.Sprintf("%v", this.Query), "WorkflowQuery", "v12.WorkflowQuery", 1) + `,`,
+		`TransientWorkflowTask:` + strings.Replace(fmt.Sprintf("%v", this.TransientWorkflowTask), "TransientWorkflowTaskInfo", "v13.TransientWorkflowTaskInfo", 1) + `,`,
+		`WorkflowExecutionTaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecutionTaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
+		`BranchToken:` + fmt.Sprintf("%v", this.BranchToken) + `,`,
+		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Queries:` + mapStringForQueries + `,`,
+		`Messages:` + repeatedStringForMessages + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PollActivityTaskQueueRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PollActivityTaskQueueRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`PollerId:` + fmt.Sprintf("%v", this.PollerId) + `,`,
+		`PollRequest:` + strings.Replace(fmt.Sprintf("%v", this.PollRequest), "PollActivityTaskQueueRequest", "v1.PollActivityTaskQueueRequest", 1) + `,`,
+		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PollActivityTaskQueueResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PollActivityTaskQueueResponse{`,
+		`TaskToken:` + fmt.Sprintf("%v", this.TaskToken) + `,`,
+		`WorkflowExecution:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowExecution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
+		`ActivityId:` + fmt.Sprintf("%v", this.ActivityId) + `,`,
+		`ActivityType:` + strings.Replace(fmt.Sprintf("%v", this.ActivityType), "ActivityType", "v11.ActivityType", 1) + `,`,
+		`Input:` + strings.Replace(fmt.Sprintf("%v", this.Input), "Payloads", "v11.Payloads", 1) + `,`,
+		`ScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`ScheduleToCloseTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToCloseTimeout), "Duration", "types.Duration", 1) + `,`,
+		`StartedTime:` + strings.Replace(fmt.Sprintf("%v", this.StartedTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`StartToCloseTimeout:` + strings.Replace(fmt.Sprintf("%v", this.StartToCloseTimeout), "Duration", "types.Duration", 1) + `,`,
+		`HeartbeatTimeout:` + strings.Replace(fmt.Sprintf("%v", this.HeartbeatTimeout), "Duration", "types.Duration", 1) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`CurrentAttemptScheduledTime:` + strings.Replace(fmt.Sprintf("%v", this.CurrentAttemptScheduledTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`HeartbeatDetails:` + strings.Replace(fmt.Sprintf("%v", this.HeartbeatDetails), "Payloads", "v11.Payloads", 1) + `,`,
+		`WorkflowType:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowType), "WorkflowType", "v11.WorkflowType", 1) + `,`,
+		`WorkflowNamespace:` + fmt.Sprintf("%v", this.WorkflowNamespace) + `,`,
+		`Header:` + strings.Replace(fmt.Sprintf("%v", this.Header), "Header", "v11.Header", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddWorkflowTaskRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddWorkflowTaskRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
+		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
+		`ScheduledEventId:` + fmt.Sprintf("%v", this.ScheduledEventId) + `,`,
+		`ScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
+		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
+		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v17.VectorClock", 1) + `,`,
+		`VersionDirective:` + strings.Replace(fmt.Sprintf("%v", this.VersionDirective), "TaskVersionDirective", "v18.TaskVersionDirective", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddWorkflowTaskResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddWorkflowTaskResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddActivityTaskRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddActivityTaskRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
+		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
+		`ScheduledEventId:` + fmt.Sprintf("%v", this.ScheduledEventId) + `,`,
+		`ScheduleToStartTimeout:` + strings.Replace(fmt.Sprintf("%v", this.ScheduleToStartTimeout), "Duration", "types.Duration", 1) + `,`,
+		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
+		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`Clock:` + strings.Replace(fmt.Sprintf("%v", this.Clock), "VectorClock", "v17.VectorClock", 1) + `,`,
+		`VersionDirective:` + strings.Replace(fmt.Sprintf("%v", this.VersionDirective), "TaskVersionDirective", "v18.TaskVersionDirective", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddActivityTaskResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddActivityTaskResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueryWorkflowRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueryWorkflowRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
+		`QueryRequest:` + strings.Replace(fmt.Sprintf("%v", this.QueryRequest), "QueryWorkflowRequest", "v1.QueryWorkflowRequest", 1) + `,`,
+		`ForwardedSource:` + fmt.Sprintf("%v", this.ForwardedSource) + `,`,
+		`VersionDirective:` + strings.Replace(fmt.Sprintf("%v", this.VersionDirective), "TaskVersionDirective", "v18.TaskVersionDirective", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QueryWorkflowResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueryWorkflowResponse{`,
+		`QueryResult:` + strings.Replace(fmt.Sprintf("%v", this.QueryResult), "Payloads", "v11.Payloads", 1) + `,`,
+		`QueryRejected:` + strings.Replace(fmt.Sprintf("%v", this.QueryRejected), "QueryRejected", "v12.QueryRejected", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondQueryTaskCompletedRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondQueryTaskCompletedRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
+		`TaskId:` + fmt.Sprintf("%v", this.TaskId) + `,`,
+		`CompletedRequest:` + strings.Replace(fmt.Sprintf("%v", this.CompletedRequest), "RespondQueryTaskCompletedRequest", "v1.RespondQueryTaskCompletedRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondQueryTaskCompletedResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondQueryTaskCompletedResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CancelOutstandingPollRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CancelOutstandingPollRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueueType:` + fmt.Sprintf("%v", this.TaskQueueType) + `,`,
+		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
+		`PollerId:` + fmt.Sprintf("%v", this.PollerId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CancelOutstandingPollResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CancelOutstandingPollResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeTaskQueueRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeTaskQueueRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`DescRequest:` + strings.Replace(fmt.Sprintf("%v", this.DescRequest), "DescribeTaskQueueRequest", "v1.DescribeTaskQueueRequest", 1) + `,`,
+		`VersionSetIds:` + fmt.Sprintf("%v", this.VersionSetIds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeTaskQueueResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPollers := "[]*PollerInfo{"
+	for _, f := range this.Pollers {
+		repeatedStringForPollers += strings.Replace(fmt.Sprintf("%v", f), "PollerInfo", "v14.PollerInfo", 1) + ","
+	}
+	repeatedStringForPollers += "}"
+	keysForVersionedBacklogCountHints := make([]string, 0, len(this.VersionedBacklogCountHints))
+	for k, _ := range this.VersionedBacklogCountHints {
+		keysForVersionedBacklogCountHints = append(keysForVersionedBacklogCountHints, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForVersionedBacklogCountHints)
+	mapStringForVersionedBacklogCountHints := "map[string]int64{"
+	for _, k := range keysForVersionedBacklogCountHints {
+		mapStringForVersionedBacklogCountHints += fmt.Sprintf("%v: %v,", k, this.VersionedBacklogCountHints[k])
+	}
+	mapStringForVersionedBacklogCountHints += "}"
+	s := strings.Join([]string{`&DescribeTaskQueueResponse{`,
+		`Pollers:` + repeatedStringForPollers + `,`,
+		`TaskQueueStatus:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueueStatus), "TaskQueueStatus", "v14.TaskQueueStatus", 1) + `,`,
+		`UnversionedBacklogCountHint:` + fmt.Sprintf("%v", this.UnversionedBacklogCountHint) + `,`,
+		`VersionedBacklogCountHints:` + mapStringForVersionedBacklogCountHints + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListTaskQueuePartitionsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListTaskQueuePartitionsRequest{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`TaskQueue:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueue), "TaskQueue", "v14.TaskQueue", 1) + `,`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListTaskQueuePartitionsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForActivityTaskQueuePartitions := "[]*TaskQueuePartitionMetadata{"
+	for _, f := range this.ActivityTaskQueuePartitions {
+		repeatedStringForActivityTaskQueuePartitions += strings.Replace(fmt.Sprintf("%v", f), "TaskQueuePartitionMetadata", "v14.TaskQueuePartitionMetadata", 1) + ","
+	}
+	repeatedStringForActivityTaskQueuePartitions += "}"
+	repeatedStringForWorkflowTaskQueuePartitions := "[]*TaskQueuePartitionMetadata{"
+	for _, f := range this.WorkflowTaskQueuePartitions {
+		repeatedStringForWorkflowTaskQueuePartitions += strings.Replace(fmt.Sprintf("%v", f), "TaskQueuePartitionMetadata", "v14.TaskQueuePartitionMetadata", 1) + ","
+	}
+	repeatedStringForWorkflowTaskQueuePartitions += "}"
+	s := strings.Join([]string{`&ListTaskQueuePartitionsResponse{`,
+		`ActivityTaskQueuePartitions:` + repeatedStringForActivityTaskQueuePartitions + `,`,
+		`WorkflowTaskQueuePartitions:` + repeatedStringForWorkflowTaskQueuePartitions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateWorkerBuildIdCompatibilityRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateWorkerBuildIdCompatibilityRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Operation:` + fmt.Sprintf("%v", this.Operation) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateWorkerBuildIdCompatibilityRequest_Request) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateWorkerBuildIdCompatibilityRequest_Request{`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "UpdateWorkerBuildIdCompatibilityRequest", "v1.UpdateWorkerBuildIdCompatibilityRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining_{`,
+		`MarkBuildIdDraining:` + strings.Replace(fmt.Sprintf("%v", this.MarkBuildIdDraining), "UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining", "UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet_{`,
+		`SwapBuildIdsWithinSet:` + strings.Replace(fmt.Sprintf("%v", this.SwapBuildIdsWithinSet), "UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet", "UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels_{`,
+		`SetBuildIdLabels:` + strings.Replace(fmt.Sprintf("%v", this.SetBuildIdLabels), "UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels", "UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets_{`,
+		`SwapDefaultSets:` + strings.Replace(fmt.Sprintf("%v", this.SwapDefaultSets), "UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets", "UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId_) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId_{`,
+		`RemoveBuildId:` + strings.Replace(fmt.Sprintf("%v", this.RemoveBuildId), "UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId", "UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining{`,
+		`BuildId:` + fmt.Sprintf("%v", this.BuildId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet{`,
+		`FirstBuildId:` + fmt.Sprintf("%v", this.FirstBuildId) + `,`,
+		`SecondBuildId:` + fmt.Sprintf("%v", this.SecondBuildId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k, _ := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	s := strings.Join([]string{`&UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels{`,
+		`BuildId:` + fmt.Sprintf("%v", this.BuildId) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets{`,
+		`FirstBuildId:` + fmt.Sprintf("%v", this.FirstBuildId) + `,`,
+		`SecondBuildId:` + fmt.Sprintf("%v", this.SecondBuildId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId{`,
+		`BuildId:` + fmt.Sprintf("%v", this.BuildId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateWorkerBuildIdCompatibilityResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateWorkerBuildIdCompatibilityResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetWorkerBuildIdCompatibilityRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetWorkerBuildIdCompatibilityRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`Request:` + strings.Replace(fmt.Sprintf("%v", this.Request), "GetWorkerBuildIdCompatibilityRequest", "v1.GetWorkerBuildIdCompatibilityRequest", 1) + `,`,
+		`HypotheticalUpdate:` + strings.Replace(fmt.Sprintf("%v", this.HypotheticalUpdate), "UpdateWorkerBuildIdCompatibilityRequest", "v1.UpdateWorkerBuildIdCompatibilityRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetWorkerBuildIdCompatibilityResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForBuildIdLabels := make([]string, 0, len(this.BuildIdLabels))
+	for k, _ := range this.BuildIdLabels {
+		keysForBuildIdLabels = append(keysForBuildIdLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForBuildIdLabels)
+	mapStringForBuildIdLabels := "map[string]*GetWorkerBuildIdCompatibilityResponse_BuildIdLabels{"
+	for _, k := range keysForBuildIdLabels {
+		mapStringForBuildIdLabels += fmt.Sprintf("%v: %v,", k, this.BuildIdLabels[k])
+	}
+	mapStringForBuildIdLabels += "}"
+	s := strings.Join([]string{`&GetWorkerBuildIdCompatibilityResponse{`,
+		`Response:` + strings.Replace(fmt.Sprintf("%v", this.Response), "GetWorkerBuildIdCompatibilityResponse", "v1.GetWorkerBuildIdCompatibilityResponse", 1) + `,`,
+		`BuildIdLabels:` + mapStringForBuildIdLabels + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetWorkerBuildIdCompatibilityResponse_BuildIdLabels) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k, _ := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	s := strings.Join([]string{`&GetWorkerBuildIdCompatibilityResponse_BuildIdLabels{`,
+		`Labels:` + mapStringForLabels + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetTaskQueueUserDataRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetTaskQueueUserDataRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`LastKnownUserDataVersion:` + fmt.Sprintf("%v", this.LastKnownUserDataVersion) + `,`,
+		`WaitNewData:` + fmt.Sprintf("%v", this.WaitNewData) + `,`,
+		`TaskQueueType:` + fmt.Sprintf("%v", this.TaskQueueType) + `,`,
+		`OnlyIfLoaded:` + fmt.Sprintf("%v", this.OnlyIfLoaded) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetTaskQueueUserDataResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetTaskQueueUserDataResponse{`,
+		`TaskQueueHasUserData:` + fmt.Sprintf("%v", this.TaskQueueHasUserData) + `,`,
+		`UserData:` + strings.Replace(fmt.Sprintf("%v", this.UserData), "VersionedTaskQueueUserData", "v110.VersionedTaskQueueUserData", 1) + `,`,
+		`PartitionNotLoaded:` + fmt.Sprintf("%v", this.PartitionNotLoaded) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplyTaskQueueUserDataReplicationEventRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplyTaskQueueUserDataReplicationEventRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`UserData:` + strings.Replace(fmt.Sprintf("%v", this.UserData), "TaskQueueUserData", "v110.TaskQueueUserData", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplyTaskQueueUserDataReplicationEventResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplyTaskQueueUserDataReplicationEventResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeVersioningRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeVersioningRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`PageSize:` + fmt.Sprintf("%v", this.PageSize) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeVersioningResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForVersionSets := "[]*DescribeVersioningResponse_VersionSet{"
+	for _, f := range this.VersionSets {
+		repeatedStringForVersionSets += strings.Replace(fmt.Sprintf("%v", f), "DescribeVersioningResponse_VersionSet", "DescribeVersioningResponse_VersionSet", 1) + ","
+	}
+	repeatedStringForVersionSets += "}"
+	s := strings.Join([]string{`&DescribeVersioningResponse{`,
+		`VersionSets:` + repeatedStringForVersionSets + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeVersioningResponse_BuildIdInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DescribeVersioningResponse_BuildIdInfo{`,
+		`BuildId:` + fmt.Sprintf("%v", this.BuildId) + `,`,
+		`IsSetDefault:` + fmt.Sprintf("%v", this.IsSetDefault) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`PollerCount:` + fmt.Sprintf("%v", this.PollerCount) + `,`,
+		`Reachability:` + fmt.Sprintf("%v", this.Reachability) + `,`,
+		`OpenWorkflowCount:` + fmt.Sprintf("%v", this.OpenWorkflowCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DescribeVersioningResponse_VersionSet) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForBuildIds := "[]*DescribeVersioningResponse_BuildIdInfo{"
+	for _, f := range this.BuildIds {
+		repeatedStringForBuildIds += strings.Replace(fmt.Sprintf("%v", f), "DescribeVersioningResponse_BuildIdInfo", "DescribeVersioningResponse_BuildIdInfo", 1) + ","
+	}
+	repeatedStringForBuildIds += "}"
+	s := strings.Join([]string{`&DescribeVersioningResponse_VersionSet{`,
+		`BuildIds:` + repeatedStringForBuildIds + `,`,
+		`IsDefault:` + fmt.Sprintf("%v", this.IsDefault) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetBuildIdTaskQueueMappingRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetBuildIdTaskQueueMappingRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`BuildId:` + fmt.Sprintf("%v", this.BuildId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetBuildIdTaskQueueMappingResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetBuildIdTaskQueueMappingResponse{`,
+		`TaskQueues:` + fmt.Sprintf("%v", this.TaskQueues) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ForceUnloadTaskQueueRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ForceUnloadTaskQueueRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`TaskQueueType:` + fmt.Sprintf("%v", this.TaskQueueType) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ForceUnloadTaskQueueResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ForceUnloadTaskQueueResponse{`,
+		`WasLoaded:` + fmt.Sprintf("%v", this.WasLoaded) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateTaskQueueUserDataRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateTaskQueueUserDataRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`UserData:` + strings.Replace(fmt.Sprintf("%v", this.UserData), "VersionedTaskQueueUserData", "v110.VersionedTaskQueueUserData", 1) + `,`,
+		`BuildIdsAdded:` + fmt.Sprintf("%v", this.BuildIdsAdded) + `,`,
+		`BuildIdsRemoved:` + fmt.Sprintf("%v", this.BuildIdsRemoved) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateTaskQueueUserDataResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateTaskQueueUserDataResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReplicateTaskQueueUserDataRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReplicateTaskQueueUserDataRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`UserData:` + strings.Replace(fmt.Sprintf("%v", this.UserData), "TaskQueueUserData", "v110.TaskQueueUserData", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReplicateTaskQueueUserDataResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReplicateTaskQueueUserDataResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CleanupUnreachableBuildIdsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CleanupUnreachableBuildIdsRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CleanupUnreachableBuildIdsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CleanupUnreachableBuildIdsResponse{`,
+		`RemovedBuildIds:` + fmt.Sprintf("%v", this.RemovedBuildIds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDefaultBuildIdTimelineRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetDefaultBuildIdTimelineRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDefaultBuildIdTimelineResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTimeline := "[]*VersioningAuditEntry{"
+	for _, f := range this.Timeline {
+		repeatedStringForTimeline += strings.Replace(fmt.Sprintf("%v", f), "VersioningAuditEntry", "v110.VersioningAuditEntry", 1) + ","
+	}
+	repeatedStringForTimeline += "}"
+	s := strings.Join([]string{`&GetDefaultBuildIdTimelineResponse{`,
+		`Timeline:` + repeatedStringForTimeline + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ValidateDefaultBuildIdSwitchRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ValidateDefaultBuildIdSwitchRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`BuildId:` + fmt.Sprintf("%v", this.BuildId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ValidateDefaultBuildIdSwitchResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ValidateDefaultBuildIdSwitchResponse{`,
+		`StayingWorkflowCount:` + fmt.Sprintf("%v", this.StayingWorkflowCount) + `,`,
+		`MovingWorkflowCount:` + fmt.Sprintf("%v", this.MovingWorkflowCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetClosedWorkflowBuildIdRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetClosedWorkflowBuildIdRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`Execution:` + strings.Replace(fmt.Sprintf("%v", this.Execution), "WorkflowExecution", "v11.WorkflowExecution", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetClosedWorkflowBuildIdResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetClosedWorkflowBuildIdResponse{`,
+		`BuildId:` + fmt.Sprintf("%v", this.BuildId) + `,`,
+		`Registered:` + fmt.Sprintf("%v", this.Registered) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetUserDataPropagationStatusRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetUserDataPropagationStatusRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`PageSize:` + fmt.Sprintf("%v", this.PageSize) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetUserDataPropagationStatusResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTaskQueues := "[]*GetUserDataPropagationStatusResponse_TaskQueueStatus{"
+	for _, f := range this.TaskQueues {
+		repeatedStringForTaskQueues += strings.Replace(fmt.Sprintf("%v", f), "GetUserDataPropagationStatusResponse_TaskQueueStatus", "GetUserDataPropagationStatusResponse_TaskQueueStatus", 1) + ","
+	}
+	repeatedStringForTaskQueues += "}"
+	s := strings.Join([]string{`&GetUserDataPropagationStatusResponse{`,
+		`TaskQueues:` + repeatedStringForTaskQueues + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetUserDataPropagationStatusResponse_TaskQueueStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPartitionErrors := "[]*GetUserDataPropagationStatusResponse_PartitionError{"
+	for _, f := range this.PartitionErrors {
+		repeatedStringForPartitionErrors += strings.Replace(fmt.Sprintf("%v", f), "GetUserDataPropagationStatusResponse_PartitionError", "GetUserDataPropagationStatusResponse_PartitionError", 1) + ","
+	}
+	repeatedStringForPartitionErrors += "}"
+	s := strings.Join([]string{`&GetUserDataPropagationStatusResponse_TaskQueueStatus{`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`UserDataVersion:` + fmt.Sprintf("%v", this.UserDataVersion) + `,`,
+		`PropagatedPartitions:` + fmt.Sprintf("%v", this.PropagatedPartitions) + `,`,
+		`TotalPartitions:` + fmt.Sprintf("%v", this.TotalPartitions) + `,`,
+		`FullyPropagated:` + fmt.Sprintf("%v", this.FullyPropagated) + `,`,
+		`UnloadedPartitions:` + fmt.Sprintf("%v", this.UnloadedPartitions) + `,`,
+		`PartitionErrors:` + repeatedStringForPartitionErrors + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetUserDataPropagationStatusResponse_PartitionError) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetUserDataPropagationStatusResponse_PartitionError{`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`TaskQueueType:` + fmt.Sprintf("%v", this.TaskQueueType) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplyVersioningTemplateRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplyVersioningTemplateRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`TemplateName:` + fmt.Sprintf("%v", this.TemplateName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplyVersioningTemplateResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplyVersioningTemplateResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReassignBuildIdRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReassignBuildIdRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`BuildId:` + fmt.Sprintf("%v", this.BuildId) + `,`,
+		`TargetBuildId:` + fmt.Sprintf("%v", this.TargetBuildId) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReassignBuildIdResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReassignBuildIdResponse{`,
+		`ReassignedWorkflows:` + fmt.Sprintf("%v", this.ReassignedWorkflows) + `,`,
+		`SkippedWorkflows:` + fmt.Sprintf("%v", this.SkippedWorkflows) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetTaskDispatchDecisionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetTaskDispatchDecisionRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`TaskQueueType:` + fmt.Sprintf("%v", this.TaskQueueType) + `,`,
+		`WorkflowBuildId:` + fmt.Sprintf("%v", this.WorkflowBuildId) + `,`,
+		`UseCompatibleVersion:` + fmt.Sprintf("%v", this.UseCompatibleVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetTaskDispatchDecisionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetTaskDispatchDecisionResponse{`,
+		`BuildId:` + fmt.Sprintf("%v", this.BuildId) + `,`,
+		`VersionSetId:` + fmt.Sprintf("%v", this.VersionSetId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EnableWorkerVersioningRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EnableWorkerVersioningRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`BuildId:` + fmt.Sprintf("%v", this.BuildId) + `,`,
+		`Force:` + fmt.Sprintf("%v", this.Force) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EnableWorkerVersioningResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EnableWorkerVersioningResponse{`,
+		`PollerCount:` + fmt.Sprintf("%v", this.PollerCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListWorkerBuildIdCompatibilityRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListWorkerBuildIdCompatibilityRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`PageSize:` + fmt.Sprintf("%v", this.PageSize) + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`MaxSets:` + fmt.Sprintf("%v", this.MaxSets) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListWorkerBuildIdCompatibilityResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTaskQueues := "[]*ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility{"
+	for _, f := range this.TaskQueues {
+		repeatedStringForTaskQueues += strings.Replace(fmt.Sprintf("%v", f), "ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility", "ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility", 1) + ","
+	}
+	repeatedStringForTaskQueues += "}"
+	s := strings.Join([]string{`&ListWorkerBuildIdCompatibilityResponse{`,
+		`TaskQueues:` + repeatedStringForTaskQueues + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMajorVersionSets := "[]*CompatibleVersionSet{"
+	for _, f := range this.MajorVersionSets {
+		repeatedStringForMajorVersionSets += strings.Replace(fmt.Sprintf("%v", f), "CompatibleVersionSet", "v14.CompatibleVersionSet", 1) + ","
+	}
+	repeatedStringForMajorVersionSets += "}"
+	s := strings.Join([]string{`&ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility{`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`MajorVersionSets:` + repeatedStringForMajorVersionSets + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetBuildIdReachabilityRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetBuildIdReachabilityRequest{`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
+		`BuildId:` + fmt.Sprintf("%v", this.BuildId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetBuildIdReachabilityResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetBuildIdReachabilityResponse{`,
+		`TaskQueueReachability:` + strings.Replace(fmt.Sprintf("%v", this.TaskQueueReachability), "TaskQueueReachability", "v14.TaskQueueReachability", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringRequestResponse(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *PollWorkflowTaskQueueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PollWorkflowTaskQueueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PollWorkflowTaskQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PollerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PollRequest == nil {
+				m.PollRequest = &v1.PollWorkflowTaskQueueRequest{}
+			}
+			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedSource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardedSource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PollWorkflowTaskQueueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PollWorkflowTaskQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskToken = append(m.TaskToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.TaskToken == nil {
+				m.TaskToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v11.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v11.WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
+			}
+			m.PreviousStartedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousStartedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+			}
+			m.StartedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+			}
+			m.NextEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BacklogCountHint", wireType)
+			}
+			m.BacklogCountHint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BacklogCountHint |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyExecutionEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StickyExecutionEnabled = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Query == nil {
+				m.Query = &v12.WorkflowQuery{}
+			}
+			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransientWorkflowTask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TransientWorkflowTask == nil {
+				m.TransientWorkflowTask = &v13.TransientWorkflowTaskInfo{}
+			}
+			if err := m.TransientWorkflowTask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionTaskQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecutionTaskQueue == nil {
+				m.WorkflowExecutionTaskQueue = &v14.TaskQueue{}
+			}
+			if err := m.WorkflowExecutionTaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchToken = append(m.BranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.BranchToken == nil {
+				m.BranchToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTime == nil {
+				m.ScheduledTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedTime == nil {
+				m.StartedTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Queries == nil {
+				m.Queries = make(map[string]*v12.WorkflowQuery)
+			}
+			var mapkey string
+			var mapvalue *v12.WorkflowQuery
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v12.WorkflowQuery{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Queries[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &v15.Message{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PollActivityTaskQueueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PollActivityTaskQueueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PollActivityTaskQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PollerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PollRequest == nil {
+				m.PollRequest = &v1.PollActivityTaskQueueRequest{}
+			}
+			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedSource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardedSource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PollActivityTaskQueueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PollActivityTaskQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskToken = append(m.TaskToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.TaskToken == nil {
+				m.TaskToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v11.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActivityType == nil {
+				m.ActivityType = &v11.ActivityType{}
+			}
+			if err := m.ActivityType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &v11.Payloads{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTime == nil {
+				m.ScheduledTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToCloseTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduleToCloseTimeout == nil {
+				m.ScheduleToCloseTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ScheduleToCloseTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedTime == nil {
+				m.StartedTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartToCloseTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartToCloseTimeout == nil {
+				m.StartToCloseTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.StartToCloseTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeartbeatTimeout == nil {
+				m.HeartbeatTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.HeartbeatTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentAttemptScheduledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CurrentAttemptScheduledTime == nil {
+				m.CurrentAttemptScheduledTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CurrentAttemptScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatDetails", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeartbeatDetails == nil {
+				m.HeartbeatDetails = &v11.Payloads{}
+			}
+			if err := m.HeartbeatDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v11.WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &v11.Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddWorkflowTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddWorkflowTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &v11.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskQueue == nil {
+				m.TaskQueue = &v14.TaskQueue{}
+			}
+			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
+			}
+			m.ScheduledEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduleToStartTimeout == nil {
+				m.ScheduleToStartTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ScheduleToStartTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedSource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardedSource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= v16.TaskSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Clock == nil {
+				m.Clock = &v17.VectorClock{}
+			}
+			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionDirective", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersionDirective == nil {
+				m.VersionDirective = &v18.TaskVersionDirective{}
+			}
+			if err := m.VersionDirective.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddWorkflowTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddWorkflowTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddWorkflowTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddActivityTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddActivityTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Execution == nil {
+				m.Execution = &v11.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskQueue == nil {
+				m.TaskQueue = &v14.TaskQueue{}
+			}
+			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
+			}
+			m.ScheduledEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduleToStartTimeout == nil {
+				m.ScheduleToStartTimeout = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ScheduleToStartTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedSource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardedSource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= v16.TaskSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Clock == nil {
+				m.Clock = &v17.VectorClock{}
+			}
+			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionDirective", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersionDirective == nil {
+				m.VersionDirective = &v18.TaskVersionDirective{}
+			}
+			if err := m.VersionDirective.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddActivityTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddActivityTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddActivityTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWorkflowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskQueue == nil {
+				m.TaskQueue = &v14.TaskQueue{}
+			}
+			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QueryRequest == nil {
+				m.QueryRequest = &v1.QueryWorkflowRequest{}
+			}
+			if err := m.QueryRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedSource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardedSource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionDirective", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersionDirective == nil {
+				m.VersionDirective = &v18.TaskVersionDirective{}
+			}
+			if err := m.VersionDirective.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWorkflowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QueryResult == nil {
+				m.QueryResult = &v11.Payloads{}
+			}
+			if err := m.QueryResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryRejected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QueryRejected == nil {
+				m.QueryRejected = &v12.QueryRejected{}
+			}
+			if err := m.QueryRejected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondQueryTaskCompletedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondQueryTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskQueue == nil {
+				m.TaskQueue = &v14.TaskQueue{}
+			}
+			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompletedRequest == nil {
+				m.CompletedRequest = &v1.RespondQueryTaskCompletedRequest{}
+			}
+			if err := m.CompletedRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondQueryTaskCompletedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondQueryTaskCompletedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondQueryTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelOutstandingPollRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelOutstandingPollRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueType", wireType)
+			}
+			m.TaskQueueType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskQueueType |= v19.TaskQueueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskQueue == nil {
+				m.TaskQueue = &v14.TaskQueue{}
+			}
+			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PollerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelOutstandingPollResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelOutstandingPollResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelOutstandingPollResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeTaskQueueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeTaskQueueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeTaskQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DescRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DescRequest == nil {
+				m.DescRequest = &v1.DescribeTaskQueueRequest{}
+			}
+			if err := m.DescRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionSetIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionSetIds = append(m.VersionSetIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeTaskQueueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeTaskQueueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeTaskQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pollers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pollers = append(m.Pollers, &v14.PollerInfo{})
+			if err := m.Pollers[len(m.Pollers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskQueueStatus == nil {
+				m.TaskQueueStatus = &v14.TaskQueueStatus{}
+			}
+			if err := m.TaskQueueStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnversionedBacklogCountHint", wireType)
+			}
+			m.UnversionedBacklogCountHint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnversionedBacklogCountHint |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionedBacklogCountHints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersionedBacklogCountHints == nil {
+				m.VersionedBacklogCountHints = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.VersionedBacklogCountHints[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListTaskQueuePartitionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListTaskQueuePartitionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListTaskQueuePartitionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskQueue == nil {
+				m.TaskQueue = &v14.TaskQueue{}
+			}
+			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListTaskQueuePartitionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListTaskQueuePartitionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListTaskQueuePartitionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityTaskQueuePartitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivityTaskQueuePartitions = append(m.ActivityTaskQueuePartitions, &v14.TaskQueuePartitionMetadata{})
+			if err := m.ActivityTaskQueuePartitions[len(m.ActivityTaskQueuePartitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskQueuePartitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowTaskQueuePartitions = append(m.WorkflowTaskQueuePartitions, &v14.TaskQueuePartitionMetadata{})
+			if err := m.WorkflowTaskQueuePartitions[len(m.WorkflowTaskQueuePartitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateWorkerBuildIdCompatibilityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateWorkerBuildIdCompatibilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &v1.UpdateWorkerBuildIdCompatibilityRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &UpdateWorkerBuildIdCompatibilityRequest_Request{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkBuildIdDraining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining_{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapBuildIdsWithinSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet_{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetBuildIdLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels_{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapDefaultSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets_{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveBuildId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId_{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarkBuildIdDraining: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarkBuildIdDraining: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapBuildIdsWithinSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapBuildIdsWithinSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstBuildId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstBuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondBuildId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecondBuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetBuildIdLabels: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetBuildIdLabels: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&ReplicateTaskQueueUserDataRequest{`,
-		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
-		`TaskQueue:` + fmt.Sprintf("%v", this.TaskQueue) + `,`,
-		`UserData:` + strings.Replace(fmt.Sprintf("%v", this.UserData), "TaskQueueUserData", "v110.TaskQueueUserData", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ReplicateTaskQueueUserDataResponse) String() string {
-	if this == nil {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	s := strings.Join([]string{`&ReplicateTaskQueueUserDataResponse{`,
-		`}`,
-	}, "")
-	return s
+	return nil
 }
-func valueToStringRequestResponse(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+func (m *UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapDefaultSets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapDefaultSets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstBuildId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstBuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondBuildId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecondBuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *PollWorkflowTaskQueueRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7174,15 +19102,15 @@ func (m *PollWorkflowTaskQueueRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PollWorkflowTaskQueueRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveBuildId: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollWorkflowTaskQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveBuildId: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7210,11 +19138,117 @@ func (m *PollWorkflowTaskQueueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.BuildId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateWorkerBuildIdCompatibilityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateWorkerBuildIdCompatibilityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateWorkerBuildIdCompatibilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetWorkerBuildIdCompatibilityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetWorkerBuildIdCompatibilityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetWorkerBuildIdCompatibilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7242,11 +19276,11 @@ func (m *PollWorkflowTaskQueueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PollerId = string(dAtA[iNdEx:postIndex])
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7273,18 +19307,18 @@ func (m *PollWorkflowTaskQueueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PollRequest == nil {
-				m.PollRequest = &v1.PollWorkflowTaskQueueRequest{}
+			if m.Request == nil {
+				m.Request = &v1.GetWorkerBuildIdCompatibilityRequest{}
 			}
-			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedSource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HypotheticalUpdate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -7294,23 +19328,27 @@ func (m *PollWorkflowTaskQueueRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForwardedSource = string(dAtA[iNdEx:postIndex])
+			if m.HypotheticalUpdate == nil {
+				m.HypotheticalUpdate = &v1.UpdateWorkerBuildIdCompatibilityRequest{}
+			}
+			if err := m.HypotheticalUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7336,7 +19374,7 @@ func (m *PollWorkflowTaskQueueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
+func (m *GetWorkerBuildIdCompatibilityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7359,49 +19397,15 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PollWorkflowTaskQueueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetWorkerBuildIdCompatibilityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollWorkflowTaskQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetWorkerBuildIdCompatibilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskToken", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TaskToken = append(m.TaskToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.TaskToken == nil {
-				m.TaskToken = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7428,16 +19432,16 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v11.WorkflowExecution{}
+			if m.Response == nil {
+				m.Response = &v1.GetWorkerBuildIdCompatibilityResponse{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildIdLabels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7464,167 +19468,162 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &v11.WorkflowType{}
-			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
-			}
-			m.PreviousStartedEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PreviousStartedEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
-			}
-			m.StartedEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartedEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
-			}
-			m.Attempt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
-			}
-			m.NextEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NextEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BacklogCountHint", wireType)
-			}
-			m.BacklogCountHint = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BacklogCountHint |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StickyExecutionEnabled", wireType)
+			if m.BuildIdLabels == nil {
+				m.BuildIdLabels = make(map[string]*GetWorkerBuildIdCompatibilityResponse_BuildIdLabels)
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue *GetWorkerBuildIdCompatibilityResponse_BuildIdLabels
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &GetWorkerBuildIdCompatibilityResponse_BuildIdLabels{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			m.StickyExecutionEnabled = bool(v != 0)
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.BuildIdLabels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Query == nil {
-				m.Query = &v12.WorkflowQuery{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetWorkerBuildIdCompatibilityResponse_BuildIdLabels) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 11:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BuildIdLabels: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BuildIdLabels: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransientWorkflowTask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7651,54 +19650,162 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TransientWorkflowTask == nil {
-				m.TransientWorkflowTask = &v13.TransientWorkflowTaskInfo{}
-			}
-			if err := m.TransientWorkflowTask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionTaskQueue", wireType)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRequestResponse(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthRequestResponse
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecutionTaskQueue == nil {
-				m.WorkflowExecutionTaskQueue = &v14.TaskQueue{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTaskQueueUserDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-			if err := m.WorkflowExecutionTaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 14:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTaskQueueUserDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTaskQueueUserDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BranchToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -7708,31 +19815,29 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BranchToken = append(m.BranchToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.BranchToken == nil {
-				m.BranchToken = []byte{}
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -7742,33 +19847,29 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledTime == nil {
-				m.ScheduledTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastKnownUserDataVersion", wireType)
 			}
-			var msglen int
+			m.LastKnownUserDataVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -7778,33 +19879,55 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastKnownUserDataVersion |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitNewData", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.StartedTime == nil {
-				m.StartedTime = new(time.Time)
+			m.WaitNewData = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueType", wireType)
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TaskQueueType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskQueueType |= v19.TaskQueueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnlyIfLoaded", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -7814,124 +19937,88 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.OnlyIfLoaded = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Queries == nil {
-				m.Queries = make(map[string]*v12.WorkflowQuery)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTaskQueueUserDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-			var mapkey string
-			var mapvalue *v12.WorkflowQuery
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRequestResponse
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRequestResponse
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &v12.WorkflowQuery{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRequestResponse(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthRequestResponse
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTaskQueueUserDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTaskQueueUserDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueHasUserData", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Queries[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 18:
+			m.TaskQueueHasUserData = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserData", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7958,11 +20045,33 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, &v15.Message{})
-			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.UserData == nil {
+				m.UserData = &v110.VersionedTaskQueueUserData{}
+			}
+			if err := m.UserData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionNotLoaded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PartitionNotLoaded = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -7987,7 +20096,7 @@ func (m *PollWorkflowTaskQueueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PollActivityTaskQueueRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplyTaskQueueUserDataReplicationEventRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8010,10 +20119,10 @@ func (m *PollActivityTaskQueueRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PollActivityTaskQueueRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplyTaskQueueUserDataReplicationEventRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollActivityTaskQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplyTaskQueueUserDataReplicationEventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8050,7 +20159,7 @@ func (m *PollActivityTaskQueueRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8078,11 +20187,11 @@ func (m *PollActivityTaskQueueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PollerId = string(dAtA[iNdEx:postIndex])
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserData", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8109,45 +20218,13 @@ func (m *PollActivityTaskQueueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PollRequest == nil {
-				m.PollRequest = &v1.PollActivityTaskQueueRequest{}
+			if m.UserData == nil {
+				m.UserData = &v110.TaskQueueUserData{}
 			}
-			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UserData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedSource", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ForwardedSource = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -8172,7 +20249,7 @@ func (m *PollActivityTaskQueueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
+func (m *ApplyTaskQueueUserDataReplicationEventResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8195,155 +20272,70 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PollActivityTaskQueueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplyTaskQueueUserDataReplicationEventResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollActivityTaskQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplyTaskQueueUserDataReplicationEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskToken", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TaskToken = append(m.TaskToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.TaskToken == nil {
-				m.TaskToken = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v11.WorkflowExecution{}
-			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivityId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActivityId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivityType", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeVersioningRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ActivityType == nil {
-				m.ActivityType = &v11.ActivityType{}
-			}
-			if err := m.ActivityType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeVersioningRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeVersioningRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8353,33 +20345,29 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &v11.Payloads{}
-			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8389,33 +20377,29 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledTime == nil {
-				m.ScheduledTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToCloseTimeout", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
 			}
-			var msglen int
+			m.PageSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8425,33 +20409,16 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PageSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ScheduleToCloseTimeout == nil {
-				m.ScheduleToCloseTimeout = new(time.Duration)
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ScheduleToCloseTimeout, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8461,31 +20428,82 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedTime == nil {
-				m.StartedTime = new(time.Time)
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartedTime, dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 9:
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeVersioningResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeVersioningResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeVersioningResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartToCloseTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionSets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8512,18 +20530,16 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartToCloseTimeout == nil {
-				m.StartToCloseTimeout = new(time.Duration)
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.StartToCloseTimeout, dAtA[iNdEx:postIndex]); err != nil {
+			m.VersionSets = append(m.VersionSets, &DescribeVersioningResponse_VersionSet{})
+			if err := m.VersionSets[len(m.VersionSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8533,33 +20549,84 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HeartbeatTimeout == nil {
-				m.HeartbeatTimeout = new(time.Duration)
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.HeartbeatTimeout, dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			m.Attempt = 0
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeVersioningResponse_BuildIdInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BuildIdInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BuildIdInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8569,16 +20636,29 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentAttemptScheduledTime", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			var msglen int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsSetDefault", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8588,33 +20668,17 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CurrentAttemptScheduledTime == nil {
-				m.CurrentAttemptScheduledTime = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CurrentAttemptScheduledTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatDetails", wireType)
+			m.IsSetDefault = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var msglen int
+			m.State = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8624,33 +20688,16 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.State |= v110.BuildId_State(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.HeartbeatDetails == nil {
-				m.HeartbeatDetails = &v11.Payloads{}
-			}
-			if err := m.HeartbeatDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollerCount", wireType)
 			}
-			var msglen int
+			m.PollerCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8660,33 +20707,85 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PollerCount |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &v11.WorkflowType{}
-			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 5:
+			if wireType == 0 {
+				var v v19.TaskReachability
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= v19.TaskReachability(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Reachability = append(m.Reachability, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRequestResponse
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRequestResponse
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthRequestResponse
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Reachability) == 0 {
+					m.Reachability = make([]v19.TaskReachability, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v v19.TaskReachability
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRequestResponse
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= v19.TaskReachability(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Reachability = append(m.Reachability, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reachability", wireType)
 			}
-			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowNamespace", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenWorkflowCount", wireType)
 			}
-			var stringLen uint64
+			m.OpenWorkflowCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8696,27 +20795,67 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.OpenWorkflowCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkflowNamespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 16:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeVersioningResponse_VersionSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersionSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VersionSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildIds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8743,13 +20882,31 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &v11.Header{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BuildIds = append(m.BuildIds, &DescribeVersioningResponse_BuildIdInfo{})
+			if err := m.BuildIds[len(m.BuildIds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDefault", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsDefault = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -8774,7 +20931,7 @@ func (m *PollActivityTaskQueueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *GetBuildIdTaskQueueMappingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8797,10 +20954,10 @@ func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddWorkflowTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetBuildIdTaskQueueMappingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddWorkflowTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetBuildIdTaskQueueMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8837,9 +20994,9 @@ func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8849,88 +21006,82 @@ func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Execution == nil {
-				m.Execution = &v11.WorkflowExecution{}
-			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BuildId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskQueue == nil {
-				m.TaskQueue = &v14.TaskQueue{}
-			}
-			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBuildIdTaskQueueMappingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ScheduledEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ScheduledEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBuildIdTaskQueueMappingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBuildIdTaskQueueMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueues", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -8940,31 +21091,80 @@ func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduleToStartTimeout == nil {
-				m.ScheduleToStartTimeout = new(time.Duration)
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ScheduleToStartTimeout, dAtA[iNdEx:postIndex]); err != nil {
+			m.TaskQueues = append(m.TaskQueues, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForceUnloadTaskQueueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForceUnloadTaskQueueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForceUnloadTaskQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedSource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8992,32 +21192,13 @@ func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForwardedSource = string(dAtA[iNdEx:postIndex])
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
-			}
-			m.Source = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Source |= v16.TaskSource(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -9027,33 +21208,29 @@ func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Clock == nil {
-				m.Clock = &v17.VectorClock{}
-			}
-			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionDirective", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueType", wireType)
 			}
-			var msglen int
+			m.TaskQueueType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -9063,28 +21240,11 @@ func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TaskQueueType |= v19.TaskQueueType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.VersionDirective == nil {
-				m.VersionDirective = &v18.TaskVersionDirective{}
-			}
-			if err := m.VersionDirective.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -9109,7 +21269,7 @@ func (m *AddWorkflowTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddWorkflowTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *ForceUnloadTaskQueueResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9132,12 +21292,32 @@ func (m *AddWorkflowTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddWorkflowTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ForceUnloadTaskQueueResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddWorkflowTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ForceUnloadTaskQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasLoaded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WasLoaded = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -9162,7 +21342,7 @@ func (m *AddWorkflowTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateTaskQueueUserDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9185,10 +21365,10 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddActivityTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateTaskQueueUserDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddActivityTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateTaskQueueUserDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9225,9 +21405,9 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -9237,31 +21417,27 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Execution == nil {
-				m.Execution = &v11.WorkflowExecution{}
-			}
-			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserData", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9288,37 +21464,18 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskQueue == nil {
-				m.TaskQueue = &v14.TaskQueue{}
+			if m.UserData == nil {
+				m.UserData = &v110.VersionedTaskQueueUserData{}
 			}
-			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UserData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
-			}
-			m.ScheduledEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ScheduledEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleToStartTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildIdsAdded", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -9328,31 +21485,27 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduleToStartTimeout == nil {
-				m.ScheduleToStartTimeout = new(time.Duration)
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ScheduleToStartTimeout, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BuildIdsAdded = append(m.BuildIdsAdded, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedSource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildIdsRemoved", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9380,13 +21533,119 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForwardedSource = string(dAtA[iNdEx:postIndex])
+			m.BuildIdsRemoved = append(m.BuildIdsRemoved, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Source = 0
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateTaskQueueUserDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateTaskQueueUserDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateTaskQueueUserDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplicateTaskQueueUserDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicateTaskQueueUserDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicateTaskQueueUserDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -9396,16 +21655,29 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Source |= v16.TaskSource(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -9415,31 +21687,27 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Clock == nil {
-				m.Clock = &v17.VectorClock{}
-			}
-			if err := m.Clock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionDirective", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserData", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9466,10 +21734,10 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VersionDirective == nil {
-				m.VersionDirective = &v18.TaskVersionDirective{}
+			if m.UserData == nil {
+				m.UserData = &v110.TaskQueueUserData{}
 			}
-			if err := m.VersionDirective.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UserData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9497,7 +21765,7 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddActivityTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *ReplicateTaskQueueUserDataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9520,10 +21788,10 @@ func (m *AddActivityTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddActivityTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicateTaskQueueUserDataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddActivityTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicateTaskQueueUserDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9550,7 +21818,7 @@ func (m *AddActivityTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
+func (m *CleanupUnreachableBuildIdsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9573,10 +21841,10 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkflowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CleanupUnreachableBuildIdsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CleanupUnreachableBuildIdsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9615,7 +21883,7 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -9625,33 +21893,82 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskQueue == nil {
-				m.TaskQueue = &v14.TaskQueue{}
-			}
-			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CleanupUnreachableBuildIdsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CleanupUnreachableBuildIdsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CleanupUnreachableBuildIdsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedBuildIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -9661,31 +21978,80 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.QueryRequest == nil {
-				m.QueryRequest = &v1.QueryWorkflowRequest{}
-			}
-			if err := m.QueryRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RemovedBuildIds = append(m.RemovedBuildIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if skippy < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDefaultBuildIdTimelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDefaultBuildIdTimelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDefaultBuildIdTimelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedSource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9713,13 +22079,13 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForwardedSource = string(dAtA[iNdEx:postIndex])
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionDirective", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -9729,27 +22095,23 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VersionDirective == nil {
-				m.VersionDirective = &v18.TaskVersionDirective{}
-			}
-			if err := m.VersionDirective.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9775,7 +22137,7 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
+func (m *GetDefaultBuildIdTimelineResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9798,51 +22160,15 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkflowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDefaultBuildIdTimelineResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDefaultBuildIdTimelineResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryResult", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.QueryResult == nil {
-				m.QueryResult = &v11.Payloads{}
-			}
-			if err := m.QueryResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryRejected", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9869,10 +22195,8 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.QueryRejected == nil {
-				m.QueryRejected = &v12.QueryRejected{}
-			}
-			if err := m.QueryRejected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Timeline = append(m.Timeline, &v110.VersioningAuditEntry{})
+			if err := m.Timeline[len(m.Timeline)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9900,7 +22224,7 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
+func (m *ValidateDefaultBuildIdSwitchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9923,10 +22247,10 @@ func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondQueryTaskCompletedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidateDefaultBuildIdSwitchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondQueryTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidateDefaultBuildIdSwitchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9965,7 +22289,7 @@ func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -9975,31 +22299,27 @@ func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskQueue == nil {
-				m.TaskQueue = &v14.TaskQueue{}
-			}
-			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10027,43 +22347,7 @@ func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletedRequest", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CompletedRequest == nil {
-				m.CompletedRequest = &v1.RespondQueryTaskCompletedRequest{}
-			}
-			if err := m.CompletedRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BuildId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10089,7 +22373,7 @@ func (m *RespondQueryTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondQueryTaskCompletedResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidateDefaultBuildIdSwitchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10112,12 +22396,50 @@ func (m *RespondQueryTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondQueryTaskCompletedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidateDefaultBuildIdSwitchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondQueryTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidateDefaultBuildIdSwitchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StayingWorkflowCount", wireType)
+			}
+			m.StayingWorkflowCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StayingWorkflowCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MovingWorkflowCount", wireType)
+			}
+			m.MovingWorkflowCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MovingWorkflowCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -10142,7 +22464,7 @@ func (m *RespondQueryTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
+func (m *GetClosedWorkflowBuildIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10165,10 +22487,10 @@ func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelOutstandingPollRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetClosedWorkflowBuildIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelOutstandingPollRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetClosedWorkflowBuildIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10195,38 +22517,19 @@ func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthRequestResponse
 			}
 			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueType", wireType)
-			}
-			m.TaskQueueType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TaskQueueType |= v19.TaskQueueType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -10236,33 +22539,29 @@ func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskQueue == nil {
-				m.TaskQueue = &v14.TaskQueue{}
-			}
-			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -10272,23 +22571,27 @@ func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PollerId = string(dAtA[iNdEx:postIndex])
+			if m.Execution == nil {
+				m.Execution = &v11.WorkflowExecution{}
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10314,7 +22617,7 @@ func (m *CancelOutstandingPollRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelOutstandingPollResponse) Unmarshal(dAtA []byte) error {
+func (m *GetClosedWorkflowBuildIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10337,12 +22640,64 @@ func (m *CancelOutstandingPollResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelOutstandingPollResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetClosedWorkflowBuildIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelOutstandingPollResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetClosedWorkflowBuildIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Registered = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -10367,7 +22722,7 @@ func (m *CancelOutstandingPollResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeTaskQueueRequest) Unmarshal(dAtA []byte) error {
+func (m *GetUserDataPropagationStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10390,10 +22745,10 @@ func (m *DescribeTaskQueueRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeTaskQueueRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetUserDataPropagationStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeTaskQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetUserDataPropagationStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10429,10 +22784,29 @@ func (m *DescribeTaskQueueRequest) Unmarshal(dAtA []byte) error {
 			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DescRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -10442,26 +22816,24 @@ func (m *DescribeTaskQueueRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DescRequest == nil {
-				m.DescRequest = &v1.DescribeTaskQueueRequest{}
-			}
-			if err := m.DescRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -10488,7 +22860,7 @@ func (m *DescribeTaskQueueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeTaskQueueResponse) Unmarshal(dAtA []byte) error {
+func (m *GetUserDataPropagationStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10511,15 +22883,15 @@ func (m *DescribeTaskQueueResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeTaskQueueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetUserDataPropagationStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeTaskQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetUserDataPropagationStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pollers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueues", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10546,16 +22918,16 @@ func (m *DescribeTaskQueueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pollers = append(m.Pollers, &v14.PollerInfo{})
-			if err := m.Pollers[len(m.Pollers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TaskQueues = append(m.TaskQueues, &GetUserDataPropagationStatusResponse_TaskQueueStatus{})
+			if err := m.TaskQueues[len(m.TaskQueues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -10565,26 +22937,24 @@ func (m *DescribeTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskQueueStatus == nil {
-				m.TaskQueueStatus = &v14.TaskQueueStatus{}
-			}
-			if err := m.TaskQueueStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -10611,7 +22981,7 @@ func (m *DescribeTaskQueueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListTaskQueuePartitionsRequest) Unmarshal(dAtA []byte) error {
+func (m *GetUserDataPropagationStatusResponse_TaskQueueStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10634,15 +23004,15 @@ func (m *ListTaskQueuePartitionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListTaskQueuePartitionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskQueueStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTaskQueuePartitionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskQueueStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10670,13 +23040,13 @@ func (m *ListTaskQueuePartitionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserDataVersion", wireType)
 			}
-			var msglen int
+			m.UserDataVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -10686,33 +23056,16 @@ func (m *ListTaskQueuePartitionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.UserDataVersion |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TaskQueue == nil {
-				m.TaskQueue = &v14.TaskQueue{}
-			}
-			if err := m.TaskQueue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropagatedPartitions", wireType)
 			}
-			var stringLen uint64
+			m.PropagatedPartitions = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -10722,82 +23075,16 @@ func (m *ListTaskQueuePartitionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PropagatedPartitions |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListTaskQueuePartitionsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListTaskQueuePartitionsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTaskQueuePartitionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivityTaskQueuePartitions", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPartitions", wireType)
 			}
-			var msglen int
+			m.TotalPartitions = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -10807,29 +23094,53 @@ func (m *ListTaskQueuePartitionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TotalPartitions |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullyPropagated", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.FullyPropagated = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnloadedPartitions", wireType)
 			}
-			m.ActivityTaskQueuePartitions = append(m.ActivityTaskQueuePartitions, &v14.TaskQueuePartitionMetadata{})
-			if err := m.ActivityTaskQueuePartitions[len(m.ActivityTaskQueuePartitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.UnloadedPartitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnloadedPartitions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTaskQueuePartitions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionErrors", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10856,8 +23167,8 @@ func (m *ListTaskQueuePartitionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkflowTaskQueuePartitions = append(m.WorkflowTaskQueuePartitions, &v14.TaskQueuePartitionMetadata{})
-			if err := m.WorkflowTaskQueuePartitions[len(m.WorkflowTaskQueuePartitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PartitionErrors = append(m.PartitionErrors, &GetUserDataPropagationStatusResponse_PartitionError{})
+			if err := m.PartitionErrors[len(m.PartitionErrors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10885,7 +23196,7 @@ func (m *ListTaskQueuePartitionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateWorkerBuildIdCompatibilityRequest) Unmarshal(dAtA []byte) error {
+func (m *GetUserDataPropagationStatusResponse_PartitionError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10908,15 +23219,15 @@ func (m *UpdateWorkerBuildIdCompatibilityRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateWorkerBuildIdCompatibilityRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PartitionError: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateWorkerBuildIdCompatibilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PartitionError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10944,13 +23255,32 @@ func (m *UpdateWorkerBuildIdCompatibilityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueType", wireType)
+			}
+			m.TaskQueueType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskQueueType |= v19.TaskQueueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -10960,27 +23290,23 @@ func (m *UpdateWorkerBuildIdCompatibilityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.UpdateWorkerBuildIdCompatibilityRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11006,7 +23332,7 @@ func (m *UpdateWorkerBuildIdCompatibilityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateWorkerBuildIdCompatibilityResponse) Unmarshal(dAtA []byte) error {
+func (m *ApplyVersioningTemplateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11029,68 +23355,47 @@ func (m *UpdateWorkerBuildIdCompatibilityResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateWorkerBuildIdCompatibilityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplyVersioningTemplateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateWorkerBuildIdCompatibilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplyVersioningTemplateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetWorkerBuildIdCompatibilityRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetWorkerBuildIdCompatibilityRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetWorkerBuildIdCompatibilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11118,13 +23423,13 @@ func (m *GetWorkerBuildIdCompatibilityRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -11134,27 +23439,23 @@ func (m *GetWorkerBuildIdCompatibilityRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.GetWorkerBuildIdCompatibilityRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TemplateName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11180,7 +23481,7 @@ func (m *GetWorkerBuildIdCompatibilityRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetWorkerBuildIdCompatibilityResponse) Unmarshal(dAtA []byte) error {
+func (m *ApplyVersioningTemplateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11203,48 +23504,12 @@ func (m *GetWorkerBuildIdCompatibilityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetWorkerBuildIdCompatibilityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplyVersioningTemplateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetWorkerBuildIdCompatibilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplyVersioningTemplateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRequestResponse
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Response == nil {
-				m.Response = &v1.GetWorkerBuildIdCompatibilityResponse{}
-			}
-			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -11269,7 +23534,7 @@ func (m *GetWorkerBuildIdCompatibilityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTaskQueueUserDataRequest) Unmarshal(dAtA []byte) error {
+func (m *ReassignBuildIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11292,10 +23557,10 @@ func (m *GetTaskQueueUserDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTaskQueueUserDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReassignBuildIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTaskQueueUserDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReassignBuildIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11363,10 +23628,10 @@ func (m *GetTaskQueueUserDataRequest) Unmarshal(dAtA []byte) error {
 			m.TaskQueue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastKnownUserDataVersion", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
 			}
-			m.LastKnownUserDataVersion = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -11376,16 +23641,29 @@ func (m *GetTaskQueueUserDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastKnownUserDataVersion |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WaitNewData", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBuildId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -11395,17 +23673,29 @@ func (m *GetTaskQueueUserDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.WaitNewData = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetBuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			m.TaskQueueType = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -11415,11 +23705,26 @@ func (m *GetTaskQueueUserDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskQueueType |= v19.TaskQueueType(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -11444,7 +23749,7 @@ func (m *GetTaskQueueUserDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTaskQueueUserDataResponse) Unmarshal(dAtA []byte) error {
+func (m *ReassignBuildIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11467,17 +23772,17 @@ func (m *GetTaskQueueUserDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTaskQueueUserDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReassignBuildIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTaskQueueUserDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReassignBuildIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueHasUserData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReassignedWorkflows", wireType)
 			}
-			var v int
+			m.ReassignedWorkflows = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -11487,17 +23792,35 @@ func (m *GetTaskQueueUserDataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ReassignedWorkflows |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.TaskQueueHasUserData = bool(v != 0)
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedWorkflows", wireType)
+			}
+			m.SkippedWorkflows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SkippedWorkflows |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -11507,26 +23830,24 @@ func (m *GetTaskQueueUserDataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UserData == nil {
-				m.UserData = &v110.VersionedTaskQueueUserData{}
-			}
-			if err := m.UserData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -11553,7 +23874,7 @@ func (m *GetTaskQueueUserDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplyTaskQueueUserDataReplicationEventRequest) Unmarshal(dAtA []byte) error {
+func (m *GetTaskDispatchDecisionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11576,10 +23897,10 @@ func (m *ApplyTaskQueueUserDataReplicationEventRequest) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplyTaskQueueUserDataReplicationEventRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTaskDispatchDecisionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplyTaskQueueUserDataReplicationEventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTaskDispatchDecisionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11647,10 +23968,29 @@ func (m *ApplyTaskQueueUserDataReplicationEventRequest) Unmarshal(dAtA []byte) e
 			m.TaskQueue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueType", wireType)
+			}
+			m.TaskQueueType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskQueueType |= v19.TaskQueueType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowBuildId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -11660,81 +24000,44 @@ func (m *ApplyTaskQueueUserDataReplicationEventRequest) Unmarshal(dAtA []byte) e
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UserData == nil {
-				m.UserData = &v110.TaskQueueUserData{}
-			}
-			if err := m.UserData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WorkflowBuildId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplyTaskQueueUserDataReplicationEventResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseCompatibleVersion", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplyTaskQueueUserDataReplicationEventResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplyTaskQueueUserDataReplicationEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.UseCompatibleVersion = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -11759,7 +24062,7 @@ func (m *ApplyTaskQueueUserDataReplicationEventResponse) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *GetBuildIdTaskQueueMappingRequest) Unmarshal(dAtA []byte) error {
+func (m *GetTaskDispatchDecisionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11782,15 +24085,15 @@ func (m *GetBuildIdTaskQueueMappingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetBuildIdTaskQueueMappingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTaskDispatchDecisionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetBuildIdTaskQueueMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTaskDispatchDecisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11818,11 +24121,11 @@ func (m *GetBuildIdTaskQueueMappingRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.BuildId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionSetId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11850,7 +24153,7 @@ func (m *GetBuildIdTaskQueueMappingRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BuildId = string(dAtA[iNdEx:postIndex])
+			m.VersionSetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11876,7 +24179,7 @@ func (m *GetBuildIdTaskQueueMappingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetBuildIdTaskQueueMappingResponse) Unmarshal(dAtA []byte) error {
+func (m *EnableWorkerVersioningRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11899,15 +24202,15 @@ func (m *GetBuildIdTaskQueueMappingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetBuildIdTaskQueueMappingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EnableWorkerVersioningRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetBuildIdTaskQueueMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EnableWorkerVersioningRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueues", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11935,64 +24238,11 @@ func (m *GetBuildIdTaskQueueMappingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskQueues = append(m.TaskQueues, string(dAtA[iNdEx:postIndex]))
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRequestResponse(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthRequestResponse
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ForceUnloadTaskQueueRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRequestResponse
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ForceUnloadTaskQueueRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ForceUnloadTaskQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12020,11 +24270,11 @@ func (m *ForceUnloadTaskQueueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12052,13 +24302,13 @@ func (m *ForceUnloadTaskQueueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskQueue = string(dAtA[iNdEx:postIndex])
+			m.BuildId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
-			m.TaskQueueType = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -12068,11 +24318,12 @@ func (m *ForceUnloadTaskQueueRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskQueueType |= v19.TaskQueueType(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -12097,7 +24348,7 @@ func (m *ForceUnloadTaskQueueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ForceUnloadTaskQueueResponse) Unmarshal(dAtA []byte) error {
+func (m *EnableWorkerVersioningResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12120,17 +24371,17 @@ func (m *ForceUnloadTaskQueueResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ForceUnloadTaskQueueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EnableWorkerVersioningResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ForceUnloadTaskQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EnableWorkerVersioningResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WasLoaded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PollerCount", wireType)
 			}
-			var v int
+			m.PollerCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -12140,12 +24391,11 @@ func (m *ForceUnloadTaskQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.PollerCount |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.WasLoaded = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -12170,7 +24420,7 @@ func (m *ForceUnloadTaskQueueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateTaskQueueUserDataRequest) Unmarshal(dAtA []byte) error {
+func (m *ListWorkerBuildIdCompatibilityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12193,10 +24443,10 @@ func (m *UpdateTaskQueueUserDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateTaskQueueUserDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListWorkerBuildIdCompatibilityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateTaskQueueUserDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListWorkerBuildIdCompatibilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12232,10 +24482,29 @@ func (m *UpdateTaskQueueUserDataRequest) Unmarshal(dAtA []byte) error {
 			m.NamespaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -12245,29 +24514,31 @@ func (m *UpdateTaskQueueUserDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskQueue = string(dAtA[iNdEx:postIndex])
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserData", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSets", wireType)
 			}
-			var msglen int
+			m.MaxSets = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -12277,33 +24548,69 @@ func (m *UpdateTaskQueueUserDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxSets |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRequestResponse(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UserData == nil {
-				m.UserData = &v110.VersionedTaskQueueUserData{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListWorkerBuildIdCompatibilityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRequestResponse
 			}
-			if err := m.UserData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListWorkerBuildIdCompatibilityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListWorkerBuildIdCompatibilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuildIdsAdded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueues", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -12313,29 +24620,31 @@ func (m *UpdateTaskQueueUserDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BuildIdsAdded = append(m.BuildIdsAdded, string(dAtA[iNdEx:postIndex]))
+			m.TaskQueues = append(m.TaskQueues, &ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility{})
+			if err := m.TaskQueues[len(m.TaskQueues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuildIdsRemoved", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -12345,23 +24654,25 @@ func (m *UpdateTaskQueueUserDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BuildIdsRemoved = append(m.BuildIdsRemoved, string(dAtA[iNdEx:postIndex]))
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12387,7 +24698,7 @@ func (m *UpdateTaskQueueUserDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateTaskQueueUserDataResponse) Unmarshal(dAtA []byte) error {
+func (m *ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12410,12 +24721,78 @@ func (m *UpdateTaskQueueUserDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateTaskQueueUserDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskQueueBuildIdCompatibility: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateTaskQueueUserDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskQueueBuildIdCompatibility: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskQueue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MajorVersionSets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MajorVersionSets = append(m.MajorVersionSets, &v14.CompatibleVersionSet{})
+			if err := m.MajorVersionSets[len(m.MajorVersionSets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
@@ -12440,7 +24817,7 @@ func (m *UpdateTaskQueueUserDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReplicateTaskQueueUserDataRequest) Unmarshal(dAtA []byte) error {
+func (m *GetBuildIdReachabilityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12463,10 +24840,10 @@ func (m *ReplicateTaskQueueUserDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicateTaskQueueUserDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetBuildIdReachabilityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicateTaskQueueUserDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetBuildIdReachabilityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12535,9 +24912,9 @@ func (m *ReplicateTaskQueueUserDataRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRequestResponse
@@ -12547,27 +24924,23 @@ func (m *ReplicateTaskQueueUserDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRequestResponse
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UserData == nil {
-				m.UserData = &v110.TaskQueueUserData{}
-			}
-			if err := m.UserData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BuildId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12593,7 +24966,7 @@ func (m *ReplicateTaskQueueUserDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReplicateTaskQueueUserDataResponse) Unmarshal(dAtA []byte) error {
+func (m *GetBuildIdReachabilityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12616,12 +24989,48 @@ func (m *ReplicateTaskQueueUserDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReplicateTaskQueueUserDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetBuildIdReachabilityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReplicateTaskQueueUserDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetBuildIdReachabilityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskQueueReachability", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRequestResponse
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRequestResponse
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskQueueReachability == nil {
+				m.TaskQueueReachability = &v14.TaskQueueReachability{}
+			}
+			if err := m.TaskQueueReachability.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRequestResponse(dAtA[iNdEx:])
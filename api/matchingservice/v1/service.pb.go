@@ -54,49 +54,68 @@ func init() {
 }
 
 var fileDescriptor_1a5c83076e651916 = []byte{
-	// 663 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0x31, 0x6f, 0xd3, 0x4c,
-	0x1c, 0xc6, 0x7d, 0xcb, 0x3b, 0x9c, 0xf4, 0xaa, 0xc2, 0x02, 0x55, 0x54, 0xe2, 0x84, 0x18, 0x3a,
-	0x3a, 0x2a, 0xb0, 0xd1, 0x02, 0x69, 0xd3, 0x86, 0xa2, 0x56, 0x6d, 0x81, 0x80, 0xc4, 0x82, 0xae,
-	0xf6, 0x11, 0x4e, 0x75, 0x7c, 0xc7, 0xf9, 0x1c, 0x94, 0x8d, 0x4f, 0x80, 0x18, 0x98, 0x18, 0x58,
-	0x90, 0x10, 0x03, 0x13, 0x12, 0x2b, 0x2b, 0x8c, 0x19, 0xcb, 0x46, 0x9c, 0x85, 0xb1, 0x1f, 0x01,
-	0xb9, 0xce, 0x5d, 0xe2, 0xc4, 0x0e, 0xe7, 0x24, 0x5b, 0xeb, 0xdc, 0xf3, 0xbb, 0xdf, 0x3f, 0x7e,
-	0xce, 0x91, 0xe1, 0x4d, 0x49, 0x5a, 0x9c, 0x09, 0xec, 0x57, 0x42, 0x22, 0xda, 0x44, 0x54, 0x30,
-	0xa7, 0x95, 0x16, 0x96, 0xee, 0x0b, 0x1a, 0x34, 0x93, 0x4b, 0xd4, 0x25, 0x95, 0xf6, 0x5a, 0x65,
-	0xf0, 0xa7, 0xc3, 0x05, 0x93, 0xcc, 0x5e, 0x55, 0x29, 0x27, 0x4d, 0x39, 0x98, 0x53, 0x67, 0x2c,
-	0xe5, 0xb4, 0xd7, 0x56, 0x36, 0x0c, 0xe9, 0x82, 0xbc, 0x8c, 0x48, 0x28, 0x9f, 0x09, 0x12, 0x72,
-	0x16, 0x84, 0x83, 0x6d, 0xae, 0x7f, 0x58, 0x86, 0x4b, 0xfb, 0x83, 0xd5, 0x0f, 0xd3, 0xd5, 0xf6,
-	0x27, 0x00, 0x2f, 0x1d, 0x32, 0xdf, 0x7f, 0xc2, 0xc4, 0xc9, 0x73, 0x9f, 0xbd, 0x7a, 0x84, 0xc3,
-	0x93, 0xa3, 0x88, 0x44, 0xc4, 0xae, 0x39, 0x66, 0x56, 0x4e, 0x6e, 0xfc, 0x41, 0xaa, 0xb0, 0xb2,
-	0x3d, 0x27, 0x25, 0x1d, 0xe0, 0x9a, 0xa5, 0x45, 0xab, 0xae, 0xa4, 0x6d, 0x2a, 0x3b, 0x33, 0x8a,
-	0x4e, 0xc4, 0x67, 0x12, 0xcd, 0xa1, 0x68, 0xd1, 0x77, 0x00, 0x2e, 0x55, 0x3d, 0x6f, 0x74, 0x16,
-	0xfb, 0xb6, 0x29, 0x7c, 0x2c, 0xa8, 0xe4, 0xee, 0xcc, 0x9c, 0x1f, 0xd7, 0x1a, 0x35, 0x2f, 0xa5,
-	0x35, 0x1a, 0x9c, 0x45, 0x2b, 0x9b, 0xd7, 0x5a, 0x6f, 0x00, 0xfc, 0xff, 0x28, 0x22, 0xa2, 0xa3,
-	0xb4, 0xed, 0x75, 0x53, 0x68, 0x26, 0xa6, 0x94, 0x36, 0x66, 0x4c, 0x6b, 0xa1, 0xaf, 0x00, 0x5e,
-	0x4e, 0xff, 0xf5, 0xce, 0x97, 0x24, 0xbe, 0x5b, 0xac, 0xc5, 0x7d, 0x22, 0x89, 0x67, 0xdf, 0x33,
-	0xc5, 0x17, 0x22, 0x94, 0xe8, 0xee, 0x02, 0x48, 0x99, 0xc3, 0xb1, 0x85, 0x03, 0x97, 0xf8, 0x07,
-	0x91, 0x0c, 0x25, 0x0e, 0x3c, 0x1a, 0x34, 0x93, 0xa2, 0x9a, 0x1f, 0x8e, 0xdc, 0x78, 0xe9, 0xc3,
-	0x51, 0x40, 0xd1, 0xa2, 0xef, 0x01, 0xbc, 0x50, 0x23, 0xa1, 0x2b, 0xe8, 0x31, 0x19, 0x9e, 0xe0,
-	0xbb, 0xa6, 0xf8, 0x89, 0xa8, 0x12, 0xac, 0xce, 0x41, 0xd0, 0x72, 0x5f, 0x00, 0x5c, 0xde, 0xa3,
-	0xa1, 0xd4, 0x9f, 0x1d, 0x62, 0x21, 0xa9, 0xa4, 0x2c, 0x08, 0xed, 0x1d, 0xd3, 0x0d, 0x0a, 0x00,
-	0x4a, 0xb4, 0x3e, 0x37, 0x47, 0xeb, 0xfe, 0x00, 0xf0, 0x6a, 0x83, 0x7b, 0x58, 0x92, 0xa4, 0xc6,
-	0x44, 0x6c, 0x46, 0xd4, 0xf7, 0x76, 0xbd, 0xa4, 0x1f, 0x58, 0xd2, 0x63, 0xea, 0x53, 0xd9, 0xb1,
-	0x0f, 0x4c, 0xf7, 0xfb, 0x17, 0x49, 0x0d, 0x70, 0xb8, 0x38, 0xa0, 0x9e, 0xe4, 0x3b, 0x80, 0x57,
-	0xea, 0x44, 0x4e, 0x19, 0x63, 0xcf, 0x74, 0xd7, 0xa9, 0x18, 0x35, 0xc3, 0xfe, 0x82, 0x68, 0x7a,
-	0x80, 0x8f, 0x00, 0x5e, 0xac, 0x93, 0xe1, 0xfd, 0x6a, 0x84, 0x44, 0xd4, 0xb0, 0xc4, 0xf6, 0x56,
-	0x89, 0x9d, 0x26, 0xd2, 0x4a, 0xb7, 0x36, 0x1f, 0x44, 0x5b, 0xfe, 0x02, 0x70, 0xb5, 0xca, 0xb9,
-	0xdf, 0xc9, 0x59, 0xc4, 0x7d, 0xea, 0xe2, 0xa4, 0x61, 0xdb, 0x6d, 0x12, 0x48, 0xbb, 0x61, 0xfc,
-	0x64, 0x37, 0xe2, 0xa9, 0x49, 0x1e, 0x2f, 0x1a, 0xab, 0x67, 0xfb, 0x06, 0xe0, 0x4a, 0x9d, 0xc8,
-	0xc1, 0x7d, 0xd2, 0xc9, 0x7d, 0xcc, 0x39, 0x0d, 0x9a, 0xf6, 0x6e, 0x89, 0xaf, 0xb0, 0x80, 0xa1,
-	0x66, 0xb8, 0xbf, 0x08, 0x54, 0xa6, 0x39, 0x3b, 0x4c, 0xb8, 0xa4, 0x11, 0xf8, 0x0c, 0x0f, 0x57,
-	0x9a, 0x37, 0x27, 0x2f, 0x5d, 0xba, 0x39, 0xf9, 0x90, 0xcc, 0x93, 0x31, 0x3d, 0xcf, 0x93, 0x15,
-	0xdf, 0x29, 0xf7, 0x40, 0x28, 0x6c, 0x79, 0x7d, 0x6e, 0x4e, 0xa6, 0x0c, 0xaa, 0x2b, 0x39, 0xc6,
-	0x25, 0x7e, 0x7a, 0x8b, 0x18, 0xa5, 0xcb, 0x30, 0x0d, 0xa5, 0xbc, 0x37, 0x45, 0xb7, 0x87, 0xac,
-	0xd3, 0x1e, 0xb2, 0xce, 0x7a, 0x08, 0xbc, 0x8e, 0x11, 0xf8, 0x1c, 0x23, 0xf0, 0x33, 0x46, 0xa0,
-	0x1b, 0x23, 0xf0, 0x3b, 0x46, 0xe0, 0x4f, 0x8c, 0xac, 0xb3, 0x18, 0x81, 0xb7, 0x7d, 0x64, 0x75,
-	0xfb, 0xc8, 0x3a, 0xed, 0x23, 0xeb, 0xe9, 0x7a, 0x93, 0x0d, 0x2d, 0x28, 0x9b, 0xfe, 0x6e, 0x70,
-	0x6b, 0xec, 0xd2, 0xf1, 0x7f, 0xe7, 0xef, 0x06, 0x37, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x9e,
-	0x1f, 0xaf, 0x7b, 0xba, 0x0c, 0x00, 0x00,
+	// 969 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x98, 0xbf, 0x6f, 0x23, 0x45,
+	0x14, 0xc7, 0x3d, 0x0d, 0xc5, 0x48, 0xe8, 0xc4, 0x8a, 0x9f, 0x11, 0xac, 0x10, 0xc5, 0xd1, 0xd9,
+	0x3a, 0xa0, 0xe3, 0xee, 0x20, 0xb1, 0x93, 0xbd, 0x40, 0xc2, 0xe5, 0x2e, 0x71, 0x90, 0x68, 0xd0,
+	0x64, 0xfd, 0xce, 0x19, 0xdd, 0x78, 0x67, 0x98, 0x99, 0xf5, 0x29, 0x1d, 0x7f, 0x01, 0xa2, 0xa0,
+	0x42, 0xa2, 0x42, 0x42, 0x20, 0x21, 0x21, 0x21, 0x51, 0x21, 0x21, 0x51, 0x41, 0x99, 0xf2, 0xe8,
+	0x88, 0xd3, 0x20, 0xaa, 0xfb, 0x13, 0x4e, 0xeb, 0xf5, 0x8c, 0xb3, 0xf6, 0xee, 0xde, 0x8c, 0xd7,
+	0x5d, 0x62, 0xbf, 0xef, 0x67, 0xbe, 0x33, 0xfb, 0xde, 0xbc, 0xb7, 0xc6, 0xef, 0x69, 0x18, 0x09,
+	0x2e, 0x09, 0xeb, 0x28, 0x90, 0x63, 0x90, 0x1d, 0x22, 0x68, 0x67, 0x44, 0x74, 0x7c, 0x4a, 0x93,
+	0x61, 0xf6, 0x11, 0x8d, 0xa1, 0x33, 0xbe, 0xd1, 0x99, 0xfd, 0xd9, 0x16, 0x92, 0x6b, 0x1e, 0x5c,
+	0x37, 0xaa, 0x76, 0xae, 0x6a, 0x13, 0x41, 0xdb, 0x0b, 0xaa, 0xf6, 0xf8, 0xc6, 0xc6, 0x2d, 0x47,
+	0xba, 0x84, 0x2f, 0x52, 0x50, 0xfa, 0x73, 0x09, 0x4a, 0xf0, 0x44, 0xcd, 0x96, 0x79, 0xe7, 0xff,
+	0xb7, 0xf1, 0xb5, 0xfd, 0x59, 0xf4, 0x61, 0x1e, 0x1d, 0xfc, 0x80, 0xf0, 0x4b, 0x07, 0x9c, 0xb1,
+	0x4f, 0xb9, 0x7c, 0xf8, 0x80, 0xf1, 0x47, 0x47, 0x44, 0x3d, 0xbc, 0x97, 0x42, 0x0a, 0x41, 0xaf,
+	0xed, 0xe6, 0xaa, 0x5d, 0x2a, 0xbf, 0x9f, 0x5b, 0xd8, 0xd8, 0x6e, 0x48, 0xc9, 0x37, 0xf0, 0x56,
+	0xcb, 0x1a, 0xdd, 0x8c, 0x35, 0x1d, 0x53, 0x7d, 0xb6, 0xa2, 0xd1, 0x25, 0xf9, 0x4a, 0x46, 0x4b,
+	0x28, 0xd6, 0xe8, 0x37, 0x08, 0x5f, 0xdb, 0x1c, 0x0c, 0xae, 0xee, 0x25, 0xb8, 0xed, 0x0a, 0x5f,
+	0x10, 0x1a, 0x73, 0x1f, 0xac, 0xac, 0x5f, 0xb4, 0x75, 0xd5, 0xb9, 0x97, 0xad, 0xab, 0xc2, 0x55,
+	0x6c, 0x15, 0xf5, 0xd6, 0xd6, 0x57, 0x08, 0x3f, 0x7f, 0x2f, 0x05, 0x79, 0x66, 0x6c, 0x07, 0x37,
+	0x5d, 0xa1, 0x05, 0x99, 0xb1, 0x74, 0x6b, 0x45, 0xb5, 0x35, 0xf4, 0x2b, 0xc2, 0xaf, 0xe5, 0xff,
+	0x0e, 0xa6, 0x21, 0x99, 0xdf, 0x2e, 0x1f, 0x09, 0x06, 0x1a, 0x06, 0xc1, 0x1d, 0x57, 0x7c, 0x25,
+	0xc2, 0x18, 0xdd, 0x5d, 0x03, 0xa9, 0x50, 0x1c, 0x5d, 0x92, 0xc4, 0xc0, 0xee, 0xa6, 0x5a, 0x69,
+	0x92, 0x0c, 0x68, 0x32, 0xcc, 0x12, 0xd5, 0xbd, 0x38, 0x4a, 0xe5, 0xde, 0xc5, 0x51, 0x41, 0xb1,
+	0x46, 0xbf, 0x45, 0xf8, 0x85, 0x1e, 0xa8, 0x58, 0xd2, 0x13, 0x98, 0x57, 0xf0, 0x87, 0xae, 0xf8,
+	0x25, 0xa9, 0x31, 0xb8, 0xd9, 0x80, 0x60, 0xcd, 0xfd, 0x8c, 0xf0, 0x2b, 0x7b, 0x54, 0x69, 0xfb,
+	0xdd, 0x01, 0x91, 0x9a, 0x6a, 0xca, 0x13, 0x15, 0xec, 0xb8, 0x2e, 0x50, 0x01, 0x30, 0x46, 0xa3,
+	0xc6, 0x1c, 0x6b, 0xf7, 0x2f, 0x84, 0xdf, 0xec, 0x8b, 0x01, 0xd1, 0x90, 0xa5, 0x31, 0xc8, 0xad,
+	0x94, 0xb2, 0xc1, 0xee, 0x20, 0xcb, 0x0f, 0xa2, 0xe9, 0x09, 0x65, 0x54, 0x9f, 0x05, 0x77, 0x5d,
+	0xd7, 0x7b, 0x16, 0xc9, 0x6c, 0xe0, 0x60, 0x7d, 0x40, 0xbb, 0x93, 0x3f, 0x10, 0x7e, 0x23, 0x02,
+	0x5d, 0xb3, 0x8d, 0x3d, 0xd7, 0x55, 0x6b, 0x31, 0x66, 0x0f, 0xfb, 0x6b, 0xa2, 0xd9, 0x0d, 0x7c,
+	0x8f, 0xf0, 0x8b, 0x11, 0xcc, 0x9f, 0x57, 0x5f, 0x81, 0xec, 0x11, 0x4d, 0x82, 0xae, 0xc7, 0x4a,
+	0x4b, 0x6a, 0x63, 0xb7, 0xd7, 0x0c, 0x62, 0x5d, 0xfe, 0x83, 0xf0, 0xf5, 0x4d, 0x21, 0xd8, 0x59,
+	0x49, 0x90, 0x60, 0x34, 0x26, 0x59, 0x86, 0x6d, 0x8f, 0x21, 0xd1, 0x41, 0xdf, 0xf9, 0x66, 0x77,
+	0xe2, 0x99, 0x9d, 0x1c, 0xaf, 0x1b, 0x6b, 0xf7, 0xf6, 0x1d, 0xc2, 0x81, 0xa9, 0xed, 0x63, 0x90,
+	0x8a, 0xf2, 0x84, 0x26, 0xc3, 0xc0, 0xfb, 0x5e, 0x98, 0x6b, 0x8d, 0xe7, 0xad, 0x26, 0x08, 0xeb,
+	0xef, 0x37, 0x84, 0x37, 0xba, 0x0c, 0x48, 0x92, 0x8a, 0x7e, 0x22, 0x81, 0xc4, 0xa7, 0xe4, 0x84,
+	0xc1, 0x2c, 0xad, 0x54, 0xe0, 0xdc, 0x0d, 0xaa, 0x19, 0xc6, 0xef, 0x47, 0xeb, 0x40, 0x15, 0xda,
+	0x61, 0x04, 0xba, 0x07, 0x0f, 0x48, 0xca, 0xf4, 0x2c, 0xe0, 0x88, 0x8e, 0x80, 0xd1, 0x04, 0xdc,
+	0xdb, 0x61, 0x25, 0xc2, 0xbb, 0x1d, 0xd6, 0x90, 0xac, 0xe9, 0xdf, 0x11, 0x7e, 0xfd, 0x98, 0x30,
+	0x9a, 0x5d, 0x40, 0xc5, 0xe0, 0xc3, 0x47, 0x54, 0xc7, 0xa7, 0xc1, 0xc7, 0xae, 0xab, 0xd5, 0x51,
+	0x8c, 0xf5, 0xbd, 0xf5, 0xc0, 0xac, 0xfb, 0x5f, 0x10, 0x7e, 0x35, 0x02, 0xdd, 0x65, 0x5c, 0x81,
+	0x9d, 0xe6, 0x66, 0xc1, 0x41, 0xe4, 0x71, 0x4e, 0xa5, 0x04, 0xe3, 0xfa, 0x4e, 0x73, 0x50, 0xe1,
+	0xbc, 0x23, 0xd0, 0xa6, 0x4c, 0x0f, 0x24, 0x17, 0x64, 0x38, 0x2d, 0xd3, 0x43, 0x4d, 0x74, 0xaa,
+	0xdc, 0xcf, 0xbb, 0x8e, 0xe2, 0x7d, 0xde, 0xf5, 0xb0, 0x42, 0xdb, 0x9f, 0xde, 0x37, 0xf3, 0xc2,
+	0x3d, 0x82, 0x91, 0x60, 0x44, 0x83, 0x7b, 0xdb, 0xaf, 0x00, 0x78, 0xb7, 0xfd, 0x4a, 0x4e, 0x61,
+	0x90, 0xbf, 0x0f, 0x44, 0x29, 0x3a, 0x4c, 0x4c, 0x56, 0xdc, 0x76, 0x1f, 0x26, 0x0b, 0x42, 0xef,
+	0x41, 0x7e, 0x49, 0x6f, 0x6d, 0xfd, 0x84, 0xf0, 0xcb, 0xdb, 0x49, 0x76, 0x8b, 0xe4, 0x1d, 0xf3,
+	0xca, 0x25, 0xec, 0x3c, 0x3d, 0x96, 0xeb, 0x8d, 0xc9, 0x9d, 0xa6, 0x98, 0xc2, 0x13, 0x9f, 0xf5,
+	0xca, 0x1e, 0x55, 0x22, 0x23, 0xf4, 0x20, 0xa6, 0x59, 0xa0, 0xfb, 0x13, 0xaf, 0x00, 0x78, 0x3f,
+	0xf1, 0x4a, 0x8e, 0xb5, 0xfb, 0x27, 0xc2, 0x61, 0x36, 0x0e, 0xd6, 0xcc, 0x47, 0xfb, 0x3e, 0x63,
+	0xe5, 0xb3, 0x07, 0xa4, 0x4f, 0xd6, 0x85, 0x2b, 0xa4, 0x47, 0x04, 0xda, 0xe6, 0xcd, 0xb4, 0xe3,
+	0xe4, 0xde, 0xb7, 0x3d, 0x4e, 0xaa, 0x44, 0xef, 0x9d, 0x1e, 0x55, 0x98, 0x42, 0xaf, 0x9e, 0x07,
+	0xd9, 0x29, 0x64, 0x9f, 0x08, 0x91, 0xa5, 0xf3, 0xae, 0xff, 0x42, 0x8b, 0x0c, 0xef, 0x5e, 0x5d,
+	0x87, 0x2a, 0x4c, 0xa1, 0x3b, 0x5c, 0xc6, 0xd0, 0x4f, 0x18, 0x27, 0xf3, 0x48, 0xf7, 0x29, 0xb4,
+	0x4c, 0xed, 0x3d, 0x85, 0x96, 0x43, 0x0a, 0xc5, 0x97, 0xbf, 0x1b, 0x2c, 0x8f, 0xcb, 0x3b, 0x7e,
+	0x2f, 0x17, 0x95, 0x13, 0x73, 0xd4, 0x98, 0x53, 0x48, 0x06, 0x33, 0x77, 0x96, 0x38, 0xf6, 0x78,
+	0x8d, 0xaf, 0x62, 0x78, 0x27, 0x43, 0x1d, 0xca, 0xf8, 0xde, 0x92, 0xe7, 0x17, 0x61, 0xeb, 0xf1,
+	0x45, 0xd8, 0x7a, 0x72, 0x11, 0xa2, 0x2f, 0x27, 0x21, 0xfa, 0x71, 0x12, 0xa2, 0xbf, 0x27, 0x21,
+	0x3a, 0x9f, 0x84, 0xe8, 0xdf, 0x49, 0x88, 0xfe, 0x9b, 0x84, 0xad, 0x27, 0x93, 0x10, 0x7d, 0x7d,
+	0x19, 0xb6, 0xce, 0x2f, 0xc3, 0xd6, 0xe3, 0xcb, 0xb0, 0xf5, 0xd9, 0xcd, 0x21, 0x9f, 0xbb, 0xa0,
+	0xbc, 0xfe, 0x77, 0xc6, 0xf7, 0x17, 0x3e, 0x3a, 0x79, 0x6e, 0xfa, 0x3b, 0xe3, 0xbb, 0x4f, 0x07,
+	0x00, 0x4e, 0x1c, 0xf6, 0x7e, 0x06, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -150,6 +169,53 @@ type MatchingServiceClient interface {
 	GetTaskQueueUserData(ctx context.Context, in *GetTaskQueueUserDataRequest, opts ...grpc.CallOption) (*GetTaskQueueUserDataResponse, error)
 	// Apply a user data replication event.
 	ApplyTaskQueueUserDataReplicationEvent(ctx context.Context, in *ApplyTaskQueueUserDataReplicationEventRequest, opts ...grpc.CallOption) (*ApplyTaskQueueUserDataReplicationEventResponse, error)
+	// Describe the versioning state of a task queue: for every version set and build id, report whether it is the
+	// default, its state, how many pollers are currently polling with it, and its reachability.
+	// This request should always be routed to the node holding the root partition of the workflow task queue.
+	DescribeVersioning(ctx context.Context, in *DescribeVersioningRequest, opts ...grpc.CallOption) (*DescribeVersioningResponse, error)
+	// Remove all build ids of a task queue that are unreachable according to DescribeVersioning, together with their
+	// task queue mappings, in a single user data update. Returns the removed build ids.
+	// This request should always be routed to the node holding the root partition of the workflow task queue.
+	CleanupUnreachableBuildIds(ctx context.Context, in *CleanupUnreachableBuildIdsRequest, opts ...grpc.CallOption) (*CleanupUnreachableBuildIdsResponse, error)
+	// Return the history of task queue default build id changes recorded in the versioning audit log, ordered by
+	// timestamp.
+	// This request should always be routed to the node holding the root partition of the workflow task queue.
+	GetDefaultBuildIdTimeline(ctx context.Context, in *GetDefaultBuildIdTimelineRequest, opts ...grpc.CallOption) (*GetDefaultBuildIdTimelineResponse, error)
+	// Report how many open workflows of a task queue would keep running on the version sets they are already on versus
+	// move to the given build id if it became the new default.
+	// This request should always be routed to the node holding the root partition of the workflow task queue.
+	ValidateDefaultBuildIdSwitch(ctx context.Context, in *ValidateDefaultBuildIdSwitchRequest, opts ...grpc.CallOption) (*ValidateDefaultBuildIdSwitchResponse, error)
+	// Report the build id a closed workflow last ran on, according to its mutable state, and whether that build id is
+	// still registered on the workflow's task queue.
+	// This request should always be routed to the node holding the root partition of the workflow task queue.
+	GetClosedWorkflowBuildId(ctx context.Context, in *GetClosedWorkflowBuildIdRequest, opts ...grpc.CallOption) (*GetClosedWorkflowBuildIdResponse, error)
+	// Report, for every task queue of a namespace with versioning data, whether all of its partitions have loaded the
+	// latest version of its user data.
+	GetUserDataPropagationStatus(ctx context.Context, in *GetUserDataPropagationStatusRequest, opts ...grpc.CallOption) (*GetUserDataPropagationStatusResponse, error)
+	// Create all the compatible version sets of a task queue without versioning data from a named template of its
+	// namespace, in a single update.
+	// This request should always be routed to the node holding the root partition of the workflow task queue.
+	ApplyVersioningTemplate(ctx context.Context, in *ApplyVersioningTemplateRequest, opts ...grpc.CallOption) (*ApplyVersioningTemplateResponse, error)
+	// Force the open workflows of a task queue off a build id that must be retired, by repinning each of them to a
+	// target build id and scheduling its next workflow task there. Workflows with a workflow task in flight are
+	// skipped. Workflows are visited one page per call, the returned page token resumes with the next page.
+	// This request should always be routed to the node holding the root partition of the workflow task queue.
+	ReassignBuildId(ctx context.Context, in *ReassignBuildIdRequest, opts ...grpc.CallOption) (*ReassignBuildIdResponse, error)
+	// Enable versioning on a task queue without versioning data by registering its first build id as the default.
+	// Unless forced, this is refused when no poller with that build id is polling the task queue, since new tasks would
+	// then be stranded on a build id that no worker serves.
+	// This request should always be routed to the node holding the root partition of the workflow task queue.
+	EnableWorkerVersioning(ctx context.Context, in *EnableWorkerVersioningRequest, opts ...grpc.CallOption) (*EnableWorkerVersioningResponse, error)
+	// Report the build id a hypothetical task would be dispatched to under the current versioning data of a task
+	// queue, without adding any task.
+	GetTaskDispatchDecision(ctx context.Context, in *GetTaskDispatchDecisionRequest, opts ...grpc.CallOption) (*GetTaskDispatchDecisionResponse, error)
+	// List the compatible version sets of every task queue of a namespace with versioning data, one page of task queues
+	// at a time, straight from persistence.
+	ListWorkerBuildIdCompatibility(ctx context.Context, in *ListWorkerBuildIdCompatibilityRequest, opts ...grpc.CallOption) (*ListWorkerBuildIdCompatibilityResponse, error)
+	// Report whether a build id of a task queue can still receive new workflows, only open workflows compatible with
+	// it, or nothing at all, combining the versioning data of the task queue with visibility.
+	// This request should always be routed to the node holding the root partition of the workflow task queue.
+	GetBuildIdReachability(ctx context.Context, in *GetBuildIdReachabilityRequest, opts ...grpc.CallOption) (*GetBuildIdReachabilityResponse, error)
 	// Gets all task queue names mapped to a given build ID
 	GetBuildIdTaskQueueMapping(ctx context.Context, in *GetBuildIdTaskQueueMappingRequest, opts ...grpc.CallOption) (*GetBuildIdTaskQueueMappingResponse, error)
 	// Force unloading a task queue. Used for testing only.
@@ -289,6 +355,114 @@ func (c *matchingServiceClient) ApplyTaskQueueUserDataReplicationEvent(ctx conte
 	return out, nil
 }
 
+func (c *matchingServiceClient) DescribeVersioning(ctx context.Context, in *DescribeVersioningRequest, opts ...grpc.CallOption) (*DescribeVersioningResponse, error) {
+	out := new(DescribeVersioningResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/DescribeVersioning", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) CleanupUnreachableBuildIds(ctx context.Context, in *CleanupUnreachableBuildIdsRequest, opts ...grpc.CallOption) (*CleanupUnreachableBuildIdsResponse, error) {
+	out := new(CleanupUnreachableBuildIdsResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/CleanupUnreachableBuildIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) GetDefaultBuildIdTimeline(ctx context.Context, in *GetDefaultBuildIdTimelineRequest, opts ...grpc.CallOption) (*GetDefaultBuildIdTimelineResponse, error) {
+	out := new(GetDefaultBuildIdTimelineResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/GetDefaultBuildIdTimeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) ValidateDefaultBuildIdSwitch(ctx context.Context, in *ValidateDefaultBuildIdSwitchRequest, opts ...grpc.CallOption) (*ValidateDefaultBuildIdSwitchResponse, error) {
+	out := new(ValidateDefaultBuildIdSwitchResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/ValidateDefaultBuildIdSwitch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) GetClosedWorkflowBuildId(ctx context.Context, in *GetClosedWorkflowBuildIdRequest, opts ...grpc.CallOption) (*GetClosedWorkflowBuildIdResponse, error) {
+	out := new(GetClosedWorkflowBuildIdResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/GetClosedWorkflowBuildId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) GetUserDataPropagationStatus(ctx context.Context, in *GetUserDataPropagationStatusRequest, opts ...grpc.CallOption) (*GetUserDataPropagationStatusResponse, error) {
+	out := new(GetUserDataPropagationStatusResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/GetUserDataPropagationStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) ApplyVersioningTemplate(ctx context.Context, in *ApplyVersioningTemplateRequest, opts ...grpc.CallOption) (*ApplyVersioningTemplateResponse, error) {
+	out := new(ApplyVersioningTemplateResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/ApplyVersioningTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) ReassignBuildId(ctx context.Context, in *ReassignBuildIdRequest, opts ...grpc.CallOption) (*ReassignBuildIdResponse, error) {
+	out := new(ReassignBuildIdResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/ReassignBuildId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) EnableWorkerVersioning(ctx context.Context, in *EnableWorkerVersioningRequest, opts ...grpc.CallOption) (*EnableWorkerVersioningResponse, error) {
+	out := new(EnableWorkerVersioningResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/EnableWorkerVersioning", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) GetTaskDispatchDecision(ctx context.Context, in *GetTaskDispatchDecisionRequest, opts ...grpc.CallOption) (*GetTaskDispatchDecisionResponse, error) {
+	out := new(GetTaskDispatchDecisionResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/GetTaskDispatchDecision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) ListWorkerBuildIdCompatibility(ctx context.Context, in *ListWorkerBuildIdCompatibilityRequest, opts ...grpc.CallOption) (*ListWorkerBuildIdCompatibilityResponse, error) {
+	out := new(ListWorkerBuildIdCompatibilityResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/ListWorkerBuildIdCompatibility", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchingServiceClient) GetBuildIdReachability(ctx context.Context, in *GetBuildIdReachabilityRequest, opts ...grpc.CallOption) (*GetBuildIdReachabilityResponse, error) {
+	out := new(GetBuildIdReachabilityResponse)
+	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/GetBuildIdReachability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *matchingServiceClient) GetBuildIdTaskQueueMapping(ctx context.Context, in *GetBuildIdTaskQueueMappingRequest, opts ...grpc.CallOption) (*GetBuildIdTaskQueueMappingResponse, error) {
 	out := new(GetBuildIdTaskQueueMappingResponse)
 	err := c.cc.Invoke(ctx, "/temporal.server.api.matchingservice.v1.MatchingService/GetBuildIdTaskQueueMapping", in, out, opts...)
@@ -366,6 +540,53 @@ type MatchingServiceServer interface {
 	GetTaskQueueUserData(context.Context, *GetTaskQueueUserDataRequest) (*GetTaskQueueUserDataResponse, error)
 	// Apply a user data replication event.
 	ApplyTaskQueueUserDataReplicationEvent(context.Context, *ApplyTaskQueueUserDataReplicationEventRequest) (*ApplyTaskQueueUserDataReplicationEventResponse, error)
+	// Describe the versioning state of a task queue: for every version set and build id, report whether it is the
+	// default, its state, how many pollers are currently polling with it, and its reachability.
+	// This request should always be routed to the node holding the root partition of the workflow task queue.
+	DescribeVersioning(context.Context, *DescribeVersioningRequest) (*DescribeVersioningResponse, error)
+	// Remove all build ids of a task queue that are unreachable according to DescribeVersioning, together with their
+	// task queue mappings, in a single user data update. Returns the removed build ids.
+	// This request should always be routed to the node holding the root partition of the workflow task queue.
+	CleanupUnreachableBuildIds(context.Context, *CleanupUnreachableBuildIdsRequest) (*CleanupUnreachableBuildIdsResponse, error)
+	// Return the history of task queue default build id changes recorded in the versioning audit log, ordered by
+	// timestamp.
+	// This request should always be routed to the node holding the root partition of the workflow task queue.
+	GetDefaultBuildIdTimeline(context.Context, *GetDefaultBuildIdTimelineRequest) (*GetDefaultBuildIdTimelineResponse, error)
+	// Report how many open workflows of a task queue would keep running on the version sets they are already on versus
+	// move to the given build id if it became the new default.
+	// This request should always be routed to the node holding the root partition of the workflow task queue.
+	ValidateDefaultBuildIdSwitch(context.Context, *ValidateDefaultBuildIdSwitchRequest) (*ValidateDefaultBuildIdSwitchResponse, error)
+	// Report the build id a closed workflow last ran on, according to its mutable state, and whether that build id is
+	// still registered on the workflow's task queue.
+	// This request should always be routed to the node holding the root partition of the workflow task queue.
+	GetClosedWorkflowBuildId(context.Context, *GetClosedWorkflowBuildIdRequest) (*GetClosedWorkflowBuildIdResponse, error)
+	// Report, for every task queue of a namespace with versioning data, whether all of its partitions have loaded the
+	// latest version of its user data.
+	GetUserDataPropagationStatus(context.Context, *GetUserDataPropagationStatusRequest) (*GetUserDataPropagationStatusResponse, error)
+	// Create all the compatible version sets of a task queue without versioning data from a named template of its
+	// namespace, in a single update.
+	// This request should always be routed to the node holding the root partition of the workflow task queue.
+	ApplyVersioningTemplate(context.Context, *ApplyVersioningTemplateRequest) (*ApplyVersioningTemplateResponse, error)
+	// Force the open workflows of a task queue off a build id that must be retired, by repinning each of them to a
+	// target build id and scheduling its next workflow task there. Workflows with a workflow task in flight are
+	// skipped. Workflows are visited one page per call, the returned page token resumes with the next page.
+	// This request should always be routed to the node holding the root partition of the workflow task queue.
+	ReassignBuildId(context.Context, *ReassignBuildIdRequest) (*ReassignBuildIdResponse, error)
+	// Enable versioning on a task queue without versioning data by registering its first build id as the default.
+	// Unless forced, this is refused when no poller with that build id is polling the task queue, since new tasks would
+	// then be stranded on a build id that no worker serves.
+	// This request should always be routed to the node holding the root partition of the workflow task queue.
+	EnableWorkerVersioning(context.Context, *EnableWorkerVersioningRequest) (*EnableWorkerVersioningResponse, error)
+	// Report the build id a hypothetical task would be dispatched to under the current versioning data of a task
+	// queue, without adding any task.
+	GetTaskDispatchDecision(context.Context, *GetTaskDispatchDecisionRequest) (*GetTaskDispatchDecisionResponse, error)
+	// List the compatible version sets of every task queue of a namespace with versioning data, one page of task queues
+	// at a time, straight from persistence.
+	ListWorkerBuildIdCompatibility(context.Context, *ListWorkerBuildIdCompatibilityRequest) (*ListWorkerBuildIdCompatibilityResponse, error)
+	// Report whether a build id of a task queue can still receive new workflows, only open workflows compatible with
+	// it, or nothing at all, combining the versioning data of the task queue with visibility.
+	// This request should always be routed to the node holding the root partition of the workflow task queue.
+	GetBuildIdReachability(context.Context, *GetBuildIdReachabilityRequest) (*GetBuildIdReachabilityResponse, error)
 	// Gets all task queue names mapped to a given build ID
 	GetBuildIdTaskQueueMapping(context.Context, *GetBuildIdTaskQueueMappingRequest) (*GetBuildIdTaskQueueMappingResponse, error)
 	// Force unloading a task queue. Used for testing only.
@@ -423,6 +644,42 @@ func (*UnimplementedMatchingServiceServer) GetTaskQueueUserData(ctx context.Cont
 func (*UnimplementedMatchingServiceServer) ApplyTaskQueueUserDataReplicationEvent(ctx context.Context, req *ApplyTaskQueueUserDataReplicationEventRequest) (*ApplyTaskQueueUserDataReplicationEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApplyTaskQueueUserDataReplicationEvent not implemented")
 }
+func (*UnimplementedMatchingServiceServer) DescribeVersioning(ctx context.Context, req *DescribeVersioningRequest) (*DescribeVersioningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeVersioning not implemented")
+}
+func (*UnimplementedMatchingServiceServer) CleanupUnreachableBuildIds(ctx context.Context, req *CleanupUnreachableBuildIdsRequest) (*CleanupUnreachableBuildIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CleanupUnreachableBuildIds not implemented")
+}
+func (*UnimplementedMatchingServiceServer) GetDefaultBuildIdTimeline(ctx context.Context, req *GetDefaultBuildIdTimelineRequest) (*GetDefaultBuildIdTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDefaultBuildIdTimeline not implemented")
+}
+func (*UnimplementedMatchingServiceServer) ValidateDefaultBuildIdSwitch(ctx context.Context, req *ValidateDefaultBuildIdSwitchRequest) (*ValidateDefaultBuildIdSwitchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateDefaultBuildIdSwitch not implemented")
+}
+func (*UnimplementedMatchingServiceServer) GetClosedWorkflowBuildId(ctx context.Context, req *GetClosedWorkflowBuildIdRequest) (*GetClosedWorkflowBuildIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClosedWorkflowBuildId not implemented")
+}
+func (*UnimplementedMatchingServiceServer) GetUserDataPropagationStatus(ctx context.Context, req *GetUserDataPropagationStatusRequest) (*GetUserDataPropagationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserDataPropagationStatus not implemented")
+}
+func (*UnimplementedMatchingServiceServer) ApplyVersioningTemplate(ctx context.Context, req *ApplyVersioningTemplateRequest) (*ApplyVersioningTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyVersioningTemplate not implemented")
+}
+func (*UnimplementedMatchingServiceServer) ReassignBuildId(ctx context.Context, req *ReassignBuildIdRequest) (*ReassignBuildIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReassignBuildId not implemented")
+}
+func (*UnimplementedMatchingServiceServer) EnableWorkerVersioning(ctx context.Context, req *EnableWorkerVersioningRequest) (*EnableWorkerVersioningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableWorkerVersioning not implemented")
+}
+func (*UnimplementedMatchingServiceServer) GetTaskDispatchDecision(ctx context.Context, req *GetTaskDispatchDecisionRequest) (*GetTaskDispatchDecisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskDispatchDecision not implemented")
+}
+func (*UnimplementedMatchingServiceServer) ListWorkerBuildIdCompatibility(ctx context.Context, req *ListWorkerBuildIdCompatibilityRequest) (*ListWorkerBuildIdCompatibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkerBuildIdCompatibility not implemented")
+}
+func (*UnimplementedMatchingServiceServer) GetBuildIdReachability(ctx context.Context, req *GetBuildIdReachabilityRequest) (*GetBuildIdReachabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBuildIdReachability not implemented")
+}
 func (*UnimplementedMatchingServiceServer) GetBuildIdTaskQueueMapping(ctx context.Context, req *GetBuildIdTaskQueueMappingRequest) (*GetBuildIdTaskQueueMappingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBuildIdTaskQueueMapping not implemented")
 }
@@ -674,6 +931,222 @@ func _MatchingService_ApplyTaskQueueUserDataReplicationEvent_Handler(srv interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MatchingService_DescribeVersioning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeVersioningRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).DescribeVersioning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.matchingservice.v1.MatchingService/DescribeVersioning",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).DescribeVersioning(ctx, req.(*DescribeVersioningRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_CleanupUnreachableBuildIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CleanupUnreachableBuildIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).CleanupUnreachableBuildIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.matchingservice.v1.MatchingService/CleanupUnreachableBuildIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).CleanupUnreachableBuildIds(ctx, req.(*CleanupUnreachableBuildIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_GetDefaultBuildIdTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDefaultBuildIdTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).GetDefaultBuildIdTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.matchingservice.v1.MatchingService/GetDefaultBuildIdTimeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).GetDefaultBuildIdTimeline(ctx, req.(*GetDefaultBuildIdTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_ValidateDefaultBuildIdSwitch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateDefaultBuildIdSwitchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).ValidateDefaultBuildIdSwitch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.matchingservice.v1.MatchingService/ValidateDefaultBuildIdSwitch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).ValidateDefaultBuildIdSwitch(ctx, req.(*ValidateDefaultBuildIdSwitchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_GetClosedWorkflowBuildId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClosedWorkflowBuildIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).GetClosedWorkflowBuildId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.matchingservice.v1.MatchingService/GetClosedWorkflowBuildId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).GetClosedWorkflowBuildId(ctx, req.(*GetClosedWorkflowBuildIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_GetUserDataPropagationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserDataPropagationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).GetUserDataPropagationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.matchingservice.v1.MatchingService/GetUserDataPropagationStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).GetUserDataPropagationStatus(ctx, req.(*GetUserDataPropagationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_ApplyVersioningTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyVersioningTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).ApplyVersioningTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.matchingservice.v1.MatchingService/ApplyVersioningTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).ApplyVersioningTemplate(ctx, req.(*ApplyVersioningTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_ReassignBuildId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignBuildIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).ReassignBuildId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.matchingservice.v1.MatchingService/ReassignBuildId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).ReassignBuildId(ctx, req.(*ReassignBuildIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_EnableWorkerVersioning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableWorkerVersioningRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).EnableWorkerVersioning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.matchingservice.v1.MatchingService/EnableWorkerVersioning",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).EnableWorkerVersioning(ctx, req.(*EnableWorkerVersioningRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_GetTaskDispatchDecision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskDispatchDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).GetTaskDispatchDecision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.matchingservice.v1.MatchingService/GetTaskDispatchDecision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).GetTaskDispatchDecision(ctx, req.(*GetTaskDispatchDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_ListWorkerBuildIdCompatibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkerBuildIdCompatibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).ListWorkerBuildIdCompatibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.matchingservice.v1.MatchingService/ListWorkerBuildIdCompatibility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).ListWorkerBuildIdCompatibility(ctx, req.(*ListWorkerBuildIdCompatibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchingService_GetBuildIdReachability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBuildIdReachabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchingServiceServer).GetBuildIdReachability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/temporal.server.api.matchingservice.v1.MatchingService/GetBuildIdReachability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchingServiceServer).GetBuildIdReachability(ctx, req.(*GetBuildIdReachabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MatchingService_GetBuildIdTaskQueueMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetBuildIdTaskQueueMappingRequest)
 	if err := dec(in); err != nil {
@@ -802,6 +1275,54 @@ var _MatchingService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ApplyTaskQueueUserDataReplicationEvent",
 			Handler:    _MatchingService_ApplyTaskQueueUserDataReplicationEvent_Handler,
 		},
+		{
+			MethodName: "DescribeVersioning",
+			Handler:    _MatchingService_DescribeVersioning_Handler,
+		},
+		{
+			MethodName: "CleanupUnreachableBuildIds",
+			Handler:    _MatchingService_CleanupUnreachableBuildIds_Handler,
+		},
+		{
+			MethodName: "GetDefaultBuildIdTimeline",
+			Handler:    _MatchingService_GetDefaultBuildIdTimeline_Handler,
+		},
+		{
+			MethodName: "ValidateDefaultBuildIdSwitch",
+			Handler:    _MatchingService_ValidateDefaultBuildIdSwitch_Handler,
+		},
+		{
+			MethodName: "GetClosedWorkflowBuildId",
+			Handler:    _MatchingService_GetClosedWorkflowBuildId_Handler,
+		},
+		{
+			MethodName: "GetUserDataPropagationStatus",
+			Handler:    _MatchingService_GetUserDataPropagationStatus_Handler,
+		},
+		{
+			MethodName: "ApplyVersioningTemplate",
+			Handler:    _MatchingService_ApplyVersioningTemplate_Handler,
+		},
+		{
+			MethodName: "ReassignBuildId",
+			Handler:    _MatchingService_ReassignBuildId_Handler,
+		},
+		{
+			MethodName: "EnableWorkerVersioning",
+			Handler:    _MatchingService_EnableWorkerVersioning_Handler,
+		},
+		{
+			MethodName: "GetTaskDispatchDecision",
+			Handler:    _MatchingService_GetTaskDispatchDecision_Handler,
+		},
+		{
+			MethodName: "ListWorkerBuildIdCompatibility",
+			Handler:    _MatchingService_ListWorkerBuildIdCompatibility_Handler,
+		},
+		{
+			MethodName: "GetBuildIdReachability",
+			Handler:    _MatchingService_GetBuildIdReachability_Handler,
+		},
 		{
 			MethodName: "GetBuildIdTaskQueueMapping",
 			Handler:    _MatchingService_GetBuildIdTaskQueueMapping_Handler,
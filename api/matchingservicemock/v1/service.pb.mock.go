@@ -120,6 +120,26 @@ func (mr *MockMatchingServiceClientMockRecorder) ApplyTaskQueueUserDataReplicati
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyTaskQueueUserDataReplicationEvent", reflect.TypeOf((*MockMatchingServiceClient)(nil).ApplyTaskQueueUserDataReplicationEvent), varargs...)
 }
 
+// ApplyVersioningTemplate mocks base method.
+func (m *MockMatchingServiceClient) ApplyVersioningTemplate(ctx context.Context, in *matchingservice.ApplyVersioningTemplateRequest, opts ...grpc.CallOption) (*matchingservice.ApplyVersioningTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ApplyVersioningTemplate", varargs...)
+	ret0, _ := ret[0].(*matchingservice.ApplyVersioningTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyVersioningTemplate indicates an expected call of ApplyVersioningTemplate.
+func (mr *MockMatchingServiceClientMockRecorder) ApplyVersioningTemplate(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyVersioningTemplate", reflect.TypeOf((*MockMatchingServiceClient)(nil).ApplyVersioningTemplate), varargs...)
+}
+
 // CancelOutstandingPoll mocks base method.
 func (m *MockMatchingServiceClient) CancelOutstandingPoll(ctx context.Context, in *matchingservice.CancelOutstandingPollRequest, opts ...grpc.CallOption) (*matchingservice.CancelOutstandingPollResponse, error) {
 	m.ctrl.T.Helper()
@@ -140,6 +160,26 @@ func (mr *MockMatchingServiceClientMockRecorder) CancelOutstandingPoll(ctx, in i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOutstandingPoll", reflect.TypeOf((*MockMatchingServiceClient)(nil).CancelOutstandingPoll), varargs...)
 }
 
+// CleanupUnreachableBuildIds mocks base method.
+func (m *MockMatchingServiceClient) CleanupUnreachableBuildIds(ctx context.Context, in *matchingservice.CleanupUnreachableBuildIdsRequest, opts ...grpc.CallOption) (*matchingservice.CleanupUnreachableBuildIdsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CleanupUnreachableBuildIds", varargs...)
+	ret0, _ := ret[0].(*matchingservice.CleanupUnreachableBuildIdsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CleanupUnreachableBuildIds indicates an expected call of CleanupUnreachableBuildIds.
+func (mr *MockMatchingServiceClientMockRecorder) CleanupUnreachableBuildIds(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanupUnreachableBuildIds", reflect.TypeOf((*MockMatchingServiceClient)(nil).CleanupUnreachableBuildIds), varargs...)
+}
+
 // DescribeTaskQueue mocks base method.
 func (m *MockMatchingServiceClient) DescribeTaskQueue(ctx context.Context, in *matchingservice.DescribeTaskQueueRequest, opts ...grpc.CallOption) (*matchingservice.DescribeTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +200,46 @@ func (mr *MockMatchingServiceClientMockRecorder) DescribeTaskQueue(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueue", reflect.TypeOf((*MockMatchingServiceClient)(nil).DescribeTaskQueue), varargs...)
 }
 
+// DescribeVersioning mocks base method.
+func (m *MockMatchingServiceClient) DescribeVersioning(ctx context.Context, in *matchingservice.DescribeVersioningRequest, opts ...grpc.CallOption) (*matchingservice.DescribeVersioningResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeVersioning", varargs...)
+	ret0, _ := ret[0].(*matchingservice.DescribeVersioningResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVersioning indicates an expected call of DescribeVersioning.
+func (mr *MockMatchingServiceClientMockRecorder) DescribeVersioning(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVersioning", reflect.TypeOf((*MockMatchingServiceClient)(nil).DescribeVersioning), varargs...)
+}
+
+// EnableWorkerVersioning mocks base method.
+func (m *MockMatchingServiceClient) EnableWorkerVersioning(ctx context.Context, in *matchingservice.EnableWorkerVersioningRequest, opts ...grpc.CallOption) (*matchingservice.EnableWorkerVersioningResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EnableWorkerVersioning", varargs...)
+	ret0, _ := ret[0].(*matchingservice.EnableWorkerVersioningResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableWorkerVersioning indicates an expected call of EnableWorkerVersioning.
+func (mr *MockMatchingServiceClientMockRecorder) EnableWorkerVersioning(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableWorkerVersioning", reflect.TypeOf((*MockMatchingServiceClient)(nil).EnableWorkerVersioning), varargs...)
+}
+
 // ForceUnloadTaskQueue mocks base method.
 func (m *MockMatchingServiceClient) ForceUnloadTaskQueue(ctx context.Context, in *matchingservice.ForceUnloadTaskQueueRequest, opts ...grpc.CallOption) (*matchingservice.ForceUnloadTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -180,6 +260,26 @@ func (mr *MockMatchingServiceClientMockRecorder) ForceUnloadTaskQueue(ctx, in in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceUnloadTaskQueue", reflect.TypeOf((*MockMatchingServiceClient)(nil).ForceUnloadTaskQueue), varargs...)
 }
 
+// GetBuildIdReachability mocks base method.
+func (m *MockMatchingServiceClient) GetBuildIdReachability(ctx context.Context, in *matchingservice.GetBuildIdReachabilityRequest, opts ...grpc.CallOption) (*matchingservice.GetBuildIdReachabilityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBuildIdReachability", varargs...)
+	ret0, _ := ret[0].(*matchingservice.GetBuildIdReachabilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBuildIdReachability indicates an expected call of GetBuildIdReachability.
+func (mr *MockMatchingServiceClientMockRecorder) GetBuildIdReachability(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBuildIdReachability", reflect.TypeOf((*MockMatchingServiceClient)(nil).GetBuildIdReachability), varargs...)
+}
+
 // GetBuildIdTaskQueueMapping mocks base method.
 func (m *MockMatchingServiceClient) GetBuildIdTaskQueueMapping(ctx context.Context, in *matchingservice.GetBuildIdTaskQueueMappingRequest, opts ...grpc.CallOption) (*matchingservice.GetBuildIdTaskQueueMappingResponse, error) {
 	m.ctrl.T.Helper()
@@ -200,6 +300,66 @@ func (mr *MockMatchingServiceClientMockRecorder) GetBuildIdTaskQueueMapping(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBuildIdTaskQueueMapping", reflect.TypeOf((*MockMatchingServiceClient)(nil).GetBuildIdTaskQueueMapping), varargs...)
 }
 
+// GetClosedWorkflowBuildId mocks base method.
+func (m *MockMatchingServiceClient) GetClosedWorkflowBuildId(ctx context.Context, in *matchingservice.GetClosedWorkflowBuildIdRequest, opts ...grpc.CallOption) (*matchingservice.GetClosedWorkflowBuildIdResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetClosedWorkflowBuildId", varargs...)
+	ret0, _ := ret[0].(*matchingservice.GetClosedWorkflowBuildIdResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClosedWorkflowBuildId indicates an expected call of GetClosedWorkflowBuildId.
+func (mr *MockMatchingServiceClientMockRecorder) GetClosedWorkflowBuildId(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClosedWorkflowBuildId", reflect.TypeOf((*MockMatchingServiceClient)(nil).GetClosedWorkflowBuildId), varargs...)
+}
+
+// GetDefaultBuildIdTimeline mocks base method.
+func (m *MockMatchingServiceClient) GetDefaultBuildIdTimeline(ctx context.Context, in *matchingservice.GetDefaultBuildIdTimelineRequest, opts ...grpc.CallOption) (*matchingservice.GetDefaultBuildIdTimelineResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDefaultBuildIdTimeline", varargs...)
+	ret0, _ := ret[0].(*matchingservice.GetDefaultBuildIdTimelineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDefaultBuildIdTimeline indicates an expected call of GetDefaultBuildIdTimeline.
+func (mr *MockMatchingServiceClientMockRecorder) GetDefaultBuildIdTimeline(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefaultBuildIdTimeline", reflect.TypeOf((*MockMatchingServiceClient)(nil).GetDefaultBuildIdTimeline), varargs...)
+}
+
+// GetTaskDispatchDecision mocks base method.
+func (m *MockMatchingServiceClient) GetTaskDispatchDecision(ctx context.Context, in *matchingservice.GetTaskDispatchDecisionRequest, opts ...grpc.CallOption) (*matchingservice.GetTaskDispatchDecisionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTaskDispatchDecision", varargs...)
+	ret0, _ := ret[0].(*matchingservice.GetTaskDispatchDecisionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskDispatchDecision indicates an expected call of GetTaskDispatchDecision.
+func (mr *MockMatchingServiceClientMockRecorder) GetTaskDispatchDecision(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskDispatchDecision", reflect.TypeOf((*MockMatchingServiceClient)(nil).GetTaskDispatchDecision), varargs...)
+}
+
 // GetTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceClient) GetTaskQueueUserData(ctx context.Context, in *matchingservice.GetTaskQueueUserDataRequest, opts ...grpc.CallOption) (*matchingservice.GetTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -220,6 +380,26 @@ func (mr *MockMatchingServiceClientMockRecorder) GetTaskQueueUserData(ctx, in in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskQueueUserData", reflect.TypeOf((*MockMatchingServiceClient)(nil).GetTaskQueueUserData), varargs...)
 }
 
+// GetUserDataPropagationStatus mocks base method.
+func (m *MockMatchingServiceClient) GetUserDataPropagationStatus(ctx context.Context, in *matchingservice.GetUserDataPropagationStatusRequest, opts ...grpc.CallOption) (*matchingservice.GetUserDataPropagationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUserDataPropagationStatus", varargs...)
+	ret0, _ := ret[0].(*matchingservice.GetUserDataPropagationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserDataPropagationStatus indicates an expected call of GetUserDataPropagationStatus.
+func (mr *MockMatchingServiceClientMockRecorder) GetUserDataPropagationStatus(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserDataPropagationStatus", reflect.TypeOf((*MockMatchingServiceClient)(nil).GetUserDataPropagationStatus), varargs...)
+}
+
 // GetWorkerBuildIdCompatibility mocks base method.
 func (m *MockMatchingServiceClient) GetWorkerBuildIdCompatibility(ctx context.Context, in *matchingservice.GetWorkerBuildIdCompatibilityRequest, opts ...grpc.CallOption) (*matchingservice.GetWorkerBuildIdCompatibilityResponse, error) {
 	m.ctrl.T.Helper()
@@ -320,6 +500,46 @@ func (mr *MockMatchingServiceClientMockRecorder) QueryWorkflow(ctx, in interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryWorkflow", reflect.TypeOf((*MockMatchingServiceClient)(nil).QueryWorkflow), varargs...)
 }
 
+// ListWorkerBuildIdCompatibility mocks base method.
+func (m *MockMatchingServiceClient) ListWorkerBuildIdCompatibility(ctx context.Context, in *matchingservice.ListWorkerBuildIdCompatibilityRequest, opts ...grpc.CallOption) (*matchingservice.ListWorkerBuildIdCompatibilityResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWorkerBuildIdCompatibility", varargs...)
+	ret0, _ := ret[0].(*matchingservice.ListWorkerBuildIdCompatibilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkerBuildIdCompatibility indicates an expected call of ListWorkerBuildIdCompatibility.
+func (mr *MockMatchingServiceClientMockRecorder) ListWorkerBuildIdCompatibility(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkerBuildIdCompatibility", reflect.TypeOf((*MockMatchingServiceClient)(nil).ListWorkerBuildIdCompatibility), varargs...)
+}
+
+// ReassignBuildId mocks base method.
+func (m *MockMatchingServiceClient) ReassignBuildId(ctx context.Context, in *matchingservice.ReassignBuildIdRequest, opts ...grpc.CallOption) (*matchingservice.ReassignBuildIdResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReassignBuildId", varargs...)
+	ret0, _ := ret[0].(*matchingservice.ReassignBuildIdResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReassignBuildId indicates an expected call of ReassignBuildId.
+func (mr *MockMatchingServiceClientMockRecorder) ReassignBuildId(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReassignBuildId", reflect.TypeOf((*MockMatchingServiceClient)(nil).ReassignBuildId), varargs...)
+}
+
 // ReplicateTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceClient) ReplicateTaskQueueUserData(ctx context.Context, in *matchingservice.ReplicateTaskQueueUserDataRequest, opts ...grpc.CallOption) (*matchingservice.ReplicateTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -400,6 +620,26 @@ func (mr *MockMatchingServiceClientMockRecorder) UpdateWorkerBuildIdCompatibilit
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkerBuildIdCompatibility", reflect.TypeOf((*MockMatchingServiceClient)(nil).UpdateWorkerBuildIdCompatibility), varargs...)
 }
 
+// ValidateDefaultBuildIdSwitch mocks base method.
+func (m *MockMatchingServiceClient) ValidateDefaultBuildIdSwitch(ctx context.Context, in *matchingservice.ValidateDefaultBuildIdSwitchRequest, opts ...grpc.CallOption) (*matchingservice.ValidateDefaultBuildIdSwitchResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidateDefaultBuildIdSwitch", varargs...)
+	ret0, _ := ret[0].(*matchingservice.ValidateDefaultBuildIdSwitchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateDefaultBuildIdSwitch indicates an expected call of ValidateDefaultBuildIdSwitch.
+func (mr *MockMatchingServiceClientMockRecorder) ValidateDefaultBuildIdSwitch(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateDefaultBuildIdSwitch", reflect.TypeOf((*MockMatchingServiceClient)(nil).ValidateDefaultBuildIdSwitch), varargs...)
+}
+
 // MockMatchingServiceServer is a mock of MatchingServiceServer interface.
 type MockMatchingServiceServer struct {
 	ctrl     *gomock.Controller
@@ -468,6 +708,21 @@ func (mr *MockMatchingServiceServerMockRecorder) ApplyTaskQueueUserDataReplicati
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyTaskQueueUserDataReplicationEvent", reflect.TypeOf((*MockMatchingServiceServer)(nil).ApplyTaskQueueUserDataReplicationEvent), arg0, arg1)
 }
 
+// ApplyVersioningTemplate mocks base method.
+func (m *MockMatchingServiceServer) ApplyVersioningTemplate(arg0 context.Context, arg1 *matchingservice.ApplyVersioningTemplateRequest) (*matchingservice.ApplyVersioningTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyVersioningTemplate", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.ApplyVersioningTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyVersioningTemplate indicates an expected call of ApplyVersioningTemplate.
+func (mr *MockMatchingServiceServerMockRecorder) ApplyVersioningTemplate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyVersioningTemplate", reflect.TypeOf((*MockMatchingServiceServer)(nil).ApplyVersioningTemplate), arg0, arg1)
+}
+
 // CancelOutstandingPoll mocks base method.
 func (m *MockMatchingServiceServer) CancelOutstandingPoll(arg0 context.Context, arg1 *matchingservice.CancelOutstandingPollRequest) (*matchingservice.CancelOutstandingPollResponse, error) {
 	m.ctrl.T.Helper()
@@ -483,6 +738,21 @@ func (mr *MockMatchingServiceServerMockRecorder) CancelOutstandingPoll(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOutstandingPoll", reflect.TypeOf((*MockMatchingServiceServer)(nil).CancelOutstandingPoll), arg0, arg1)
 }
 
+// CleanupUnreachableBuildIds mocks base method.
+func (m *MockMatchingServiceServer) CleanupUnreachableBuildIds(arg0 context.Context, arg1 *matchingservice.CleanupUnreachableBuildIdsRequest) (*matchingservice.CleanupUnreachableBuildIdsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CleanupUnreachableBuildIds", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.CleanupUnreachableBuildIdsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CleanupUnreachableBuildIds indicates an expected call of CleanupUnreachableBuildIds.
+func (mr *MockMatchingServiceServerMockRecorder) CleanupUnreachableBuildIds(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanupUnreachableBuildIds", reflect.TypeOf((*MockMatchingServiceServer)(nil).CleanupUnreachableBuildIds), arg0, arg1)
+}
+
 // DescribeTaskQueue mocks base method.
 func (m *MockMatchingServiceServer) DescribeTaskQueue(arg0 context.Context, arg1 *matchingservice.DescribeTaskQueueRequest) (*matchingservice.DescribeTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -498,6 +768,36 @@ func (mr *MockMatchingServiceServerMockRecorder) DescribeTaskQueue(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueue", reflect.TypeOf((*MockMatchingServiceServer)(nil).DescribeTaskQueue), arg0, arg1)
 }
 
+// DescribeVersioning mocks base method.
+func (m *MockMatchingServiceServer) DescribeVersioning(arg0 context.Context, arg1 *matchingservice.DescribeVersioningRequest) (*matchingservice.DescribeVersioningResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeVersioning", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.DescribeVersioningResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeVersioning indicates an expected call of DescribeVersioning.
+func (mr *MockMatchingServiceServerMockRecorder) DescribeVersioning(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeVersioning", reflect.TypeOf((*MockMatchingServiceServer)(nil).DescribeVersioning), arg0, arg1)
+}
+
+// EnableWorkerVersioning mocks base method.
+func (m *MockMatchingServiceServer) EnableWorkerVersioning(arg0 context.Context, arg1 *matchingservice.EnableWorkerVersioningRequest) (*matchingservice.EnableWorkerVersioningResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableWorkerVersioning", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.EnableWorkerVersioningResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableWorkerVersioning indicates an expected call of EnableWorkerVersioning.
+func (mr *MockMatchingServiceServerMockRecorder) EnableWorkerVersioning(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableWorkerVersioning", reflect.TypeOf((*MockMatchingServiceServer)(nil).EnableWorkerVersioning), arg0, arg1)
+}
+
 // ForceUnloadTaskQueue mocks base method.
 func (m *MockMatchingServiceServer) ForceUnloadTaskQueue(arg0 context.Context, arg1 *matchingservice.ForceUnloadTaskQueueRequest) (*matchingservice.ForceUnloadTaskQueueResponse, error) {
 	m.ctrl.T.Helper()
@@ -513,6 +813,21 @@ func (mr *MockMatchingServiceServerMockRecorder) ForceUnloadTaskQueue(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceUnloadTaskQueue", reflect.TypeOf((*MockMatchingServiceServer)(nil).ForceUnloadTaskQueue), arg0, arg1)
 }
 
+// GetBuildIdReachability mocks base method.
+func (m *MockMatchingServiceServer) GetBuildIdReachability(arg0 context.Context, arg1 *matchingservice.GetBuildIdReachabilityRequest) (*matchingservice.GetBuildIdReachabilityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBuildIdReachability", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.GetBuildIdReachabilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBuildIdReachability indicates an expected call of GetBuildIdReachability.
+func (mr *MockMatchingServiceServerMockRecorder) GetBuildIdReachability(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBuildIdReachability", reflect.TypeOf((*MockMatchingServiceServer)(nil).GetBuildIdReachability), arg0, arg1)
+}
+
 // GetBuildIdTaskQueueMapping mocks base method.
 func (m *MockMatchingServiceServer) GetBuildIdTaskQueueMapping(arg0 context.Context, arg1 *matchingservice.GetBuildIdTaskQueueMappingRequest) (*matchingservice.GetBuildIdTaskQueueMappingResponse, error) {
 	m.ctrl.T.Helper()
@@ -528,6 +843,51 @@ func (mr *MockMatchingServiceServerMockRecorder) GetBuildIdTaskQueueMapping(arg0
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBuildIdTaskQueueMapping", reflect.TypeOf((*MockMatchingServiceServer)(nil).GetBuildIdTaskQueueMapping), arg0, arg1)
 }
 
+// GetClosedWorkflowBuildId mocks base method.
+func (m *MockMatchingServiceServer) GetClosedWorkflowBuildId(arg0 context.Context, arg1 *matchingservice.GetClosedWorkflowBuildIdRequest) (*matchingservice.GetClosedWorkflowBuildIdResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClosedWorkflowBuildId", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.GetClosedWorkflowBuildIdResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClosedWorkflowBuildId indicates an expected call of GetClosedWorkflowBuildId.
+func (mr *MockMatchingServiceServerMockRecorder) GetClosedWorkflowBuildId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClosedWorkflowBuildId", reflect.TypeOf((*MockMatchingServiceServer)(nil).GetClosedWorkflowBuildId), arg0, arg1)
+}
+
+// GetDefaultBuildIdTimeline mocks base method.
+func (m *MockMatchingServiceServer) GetDefaultBuildIdTimeline(arg0 context.Context, arg1 *matchingservice.GetDefaultBuildIdTimelineRequest) (*matchingservice.GetDefaultBuildIdTimelineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDefaultBuildIdTimeline", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.GetDefaultBuildIdTimelineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDefaultBuildIdTimeline indicates an expected call of GetDefaultBuildIdTimeline.
+func (mr *MockMatchingServiceServerMockRecorder) GetDefaultBuildIdTimeline(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefaultBuildIdTimeline", reflect.TypeOf((*MockMatchingServiceServer)(nil).GetDefaultBuildIdTimeline), arg0, arg1)
+}
+
+// GetTaskDispatchDecision mocks base method.
+func (m *MockMatchingServiceServer) GetTaskDispatchDecision(arg0 context.Context, arg1 *matchingservice.GetTaskDispatchDecisionRequest) (*matchingservice.GetTaskDispatchDecisionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskDispatchDecision", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.GetTaskDispatchDecisionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskDispatchDecision indicates an expected call of GetTaskDispatchDecision.
+func (mr *MockMatchingServiceServerMockRecorder) GetTaskDispatchDecision(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskDispatchDecision", reflect.TypeOf((*MockMatchingServiceServer)(nil).GetTaskDispatchDecision), arg0, arg1)
+}
+
 // GetTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceServer) GetTaskQueueUserData(arg0 context.Context, arg1 *matchingservice.GetTaskQueueUserDataRequest) (*matchingservice.GetTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -543,6 +903,21 @@ func (mr *MockMatchingServiceServerMockRecorder) GetTaskQueueUserData(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskQueueUserData", reflect.TypeOf((*MockMatchingServiceServer)(nil).GetTaskQueueUserData), arg0, arg1)
 }
 
+// GetUserDataPropagationStatus mocks base method.
+func (m *MockMatchingServiceServer) GetUserDataPropagationStatus(arg0 context.Context, arg1 *matchingservice.GetUserDataPropagationStatusRequest) (*matchingservice.GetUserDataPropagationStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserDataPropagationStatus", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.GetUserDataPropagationStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserDataPropagationStatus indicates an expected call of GetUserDataPropagationStatus.
+func (mr *MockMatchingServiceServerMockRecorder) GetUserDataPropagationStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserDataPropagationStatus", reflect.TypeOf((*MockMatchingServiceServer)(nil).GetUserDataPropagationStatus), arg0, arg1)
+}
+
 // GetWorkerBuildIdCompatibility mocks base method.
 func (m *MockMatchingServiceServer) GetWorkerBuildIdCompatibility(arg0 context.Context, arg1 *matchingservice.GetWorkerBuildIdCompatibilityRequest) (*matchingservice.GetWorkerBuildIdCompatibilityResponse, error) {
 	m.ctrl.T.Helper()
@@ -618,6 +993,36 @@ func (mr *MockMatchingServiceServerMockRecorder) QueryWorkflow(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryWorkflow", reflect.TypeOf((*MockMatchingServiceServer)(nil).QueryWorkflow), arg0, arg1)
 }
 
+// ListWorkerBuildIdCompatibility mocks base method.
+func (m *MockMatchingServiceServer) ListWorkerBuildIdCompatibility(arg0 context.Context, arg1 *matchingservice.ListWorkerBuildIdCompatibilityRequest) (*matchingservice.ListWorkerBuildIdCompatibilityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkerBuildIdCompatibility", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.ListWorkerBuildIdCompatibilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkerBuildIdCompatibility indicates an expected call of ListWorkerBuildIdCompatibility.
+func (mr *MockMatchingServiceServerMockRecorder) ListWorkerBuildIdCompatibility(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkerBuildIdCompatibility", reflect.TypeOf((*MockMatchingServiceServer)(nil).ListWorkerBuildIdCompatibility), arg0, arg1)
+}
+
+// ReassignBuildId mocks base method.
+func (m *MockMatchingServiceServer) ReassignBuildId(arg0 context.Context, arg1 *matchingservice.ReassignBuildIdRequest) (*matchingservice.ReassignBuildIdResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReassignBuildId", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.ReassignBuildIdResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReassignBuildId indicates an expected call of ReassignBuildId.
+func (mr *MockMatchingServiceServerMockRecorder) ReassignBuildId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReassignBuildId", reflect.TypeOf((*MockMatchingServiceServer)(nil).ReassignBuildId), arg0, arg1)
+}
+
 // ReplicateTaskQueueUserData mocks base method.
 func (m *MockMatchingServiceServer) ReplicateTaskQueueUserData(arg0 context.Context, arg1 *matchingservice.ReplicateTaskQueueUserDataRequest) (*matchingservice.ReplicateTaskQueueUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -677,3 +1082,18 @@ func (mr *MockMatchingServiceServerMockRecorder) UpdateWorkerBuildIdCompatibilit
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkerBuildIdCompatibility", reflect.TypeOf((*MockMatchingServiceServer)(nil).UpdateWorkerBuildIdCompatibility), arg0, arg1)
 }
+
+// ValidateDefaultBuildIdSwitch mocks base method.
+func (m *MockMatchingServiceServer) ValidateDefaultBuildIdSwitch(arg0 context.Context, arg1 *matchingservice.ValidateDefaultBuildIdSwitchRequest) (*matchingservice.ValidateDefaultBuildIdSwitchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateDefaultBuildIdSwitch", arg0, arg1)
+	ret0, _ := ret[0].(*matchingservice.ValidateDefaultBuildIdSwitchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateDefaultBuildIdSwitch indicates an expected call of ValidateDefaultBuildIdSwitch.
+func (mr *MockMatchingServiceServerMockRecorder) ValidateDefaultBuildIdSwitch(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateDefaultBuildIdSwitch", reflect.TypeOf((*MockMatchingServiceServer)(nil).ValidateDefaultBuildIdSwitch), arg0, arg1)
+}
@@ -34,15 +34,22 @@ import (
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
+	time "time"
 
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	v11 "go.temporal.io/api/common/v1"
+	v1 "go.temporal.io/server/api/enums/v1"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -104,6 +111,9 @@ func (m *QueueAckLevel) GetClusterAckLevel() map[string]int64 {
 type QueueState struct {
 	ReaderStates                 map[int64]*QueueReaderState `protobuf:"bytes,1,rep,name=reader_states,json=readerStates,proto3" json:"reader_states,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	ExclusiveReaderHighWatermark *TaskKey                    `protobuf:"bytes,2,opt,name=exclusive_reader_high_watermark,json=exclusiveReaderHighWatermark,proto3" json:"exclusive_reader_high_watermark,omitempty"`
+	// Set when deletion of the tasks acked by all readers is lagging behind, in which
+	// case tasks from this key up to the progress of the readers are yet to be deleted.
+	ExclusiveDeletionHighWatermark *TaskKey `protobuf:"bytes,3,opt,name=exclusive_deletion_high_watermark,json=exclusiveDeletionHighWatermark,proto3" json:"exclusive_deletion_high_watermark,omitempty"`
 }
 
 func (m *QueueState) Reset()      { *m = QueueState{} }
@@ -152,6 +162,13 @@ func (m *QueueState) GetExclusiveReaderHighWatermark() *TaskKey {
 	return nil
 }
 
+func (m *QueueState) GetExclusiveDeletionHighWatermark() *TaskKey {
+	if m != nil {
+		return m.ExclusiveDeletionHighWatermark
+	}
+	return nil
+}
+
 type QueueReaderState struct {
 	Scopes []*QueueSliceScope `protobuf:"bytes,1,rep,name=scopes,proto3" json:"scopes,omitempty"`
 }
@@ -297,6 +314,131 @@ func (m *QueueSliceRange) GetExclusiveMax() *TaskKey {
 	return nil
 }
 
+// HistoryTaskDLQMessage is a history task that exhausted its attempts and was moved to the dead letter queue.
+type HistoryTaskDLQMessage struct {
+	// Set when the message is read from the dead letter queue, not persisted.
+	MessageId   int64         `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	ShardId     int32         `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	CategoryId  int32         `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	TaskType    v1.TaskType   `protobuf:"varint,4,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
+	NamespaceId string        `protobuf:"bytes,5,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	WorkflowId  string        `protobuf:"bytes,6,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	RunId       string        `protobuf:"bytes,7,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Task        *v11.DataBlob `protobuf:"bytes,8,opt,name=task,proto3" json:"task,omitempty"`
+	Attempt     int32         `protobuf:"varint,9,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	LastError   string        `protobuf:"bytes,10,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	EnqueueTime *time.Time    `protobuf:"bytes,11,opt,name=enqueue_time,json=enqueueTime,proto3,stdtime" json:"enqueue_time,omitempty"`
+}
+
+func (m *HistoryTaskDLQMessage) Reset()      { *m = HistoryTaskDLQMessage{} }
+func (*HistoryTaskDLQMessage) ProtoMessage() {}
+func (*HistoryTaskDLQMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7fa5f143ac80378, []int{5}
+}
+func (m *HistoryTaskDLQMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoryTaskDLQMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoryTaskDLQMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoryTaskDLQMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryTaskDLQMessage.Merge(m, src)
+}
+func (m *HistoryTaskDLQMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoryTaskDLQMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryTaskDLQMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryTaskDLQMessage proto.InternalMessageInfo
+
+func (m *HistoryTaskDLQMessage) GetMessageId() int64 {
+	if m != nil {
+		return m.MessageId
+	}
+	return 0
+}
+
+func (m *HistoryTaskDLQMessage) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+func (m *HistoryTaskDLQMessage) GetCategoryId() int32 {
+	if m != nil {
+		return m.CategoryId
+	}
+	return 0
+}
+
+func (m *HistoryTaskDLQMessage) GetTaskType() v1.TaskType {
+	if m != nil {
+		return m.TaskType
+	}
+	return v1.TASK_TYPE_UNSPECIFIED
+}
+
+func (m *HistoryTaskDLQMessage) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *HistoryTaskDLQMessage) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
+	}
+	return ""
+}
+
+func (m *HistoryTaskDLQMessage) GetRunId() string {
+	if m != nil {
+		return m.RunId
+	}
+	return ""
+}
+
+func (m *HistoryTaskDLQMessage) GetTask() *v11.DataBlob {
+	if m != nil {
+		return m.Task
+	}
+	return nil
+}
+
+func (m *HistoryTaskDLQMessage) GetAttempt() int32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *HistoryTaskDLQMessage) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *HistoryTaskDLQMessage) GetEnqueueTime() *time.Time {
+	if m != nil {
+		return m.EnqueueTime
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueueAckLevel)(nil), "temporal.server.api.persistence.v1.QueueAckLevel")
 	proto.RegisterMapType((map[string]int64)(nil), "temporal.server.api.persistence.v1.QueueAckLevel.ClusterAckLevelEntry")
@@ -305,6 +447,7 @@ func init() {
 	proto.RegisterType((*QueueReaderState)(nil), "temporal.server.api.persistence.v1.QueueReaderState")
 	proto.RegisterType((*QueueSliceScope)(nil), "temporal.server.api.persistence.v1.QueueSliceScope")
 	proto.RegisterType((*QueueSliceRange)(nil), "temporal.server.api.persistence.v1.QueueSliceRange")
+	proto.RegisterType((*HistoryTaskDLQMessage)(nil), "temporal.server.api.persistence.v1.HistoryTaskDLQMessage")
 }
 
 func init() {
@@ -312,41 +455,62 @@ func init() {
 }
 
 var fileDescriptor_b7fa5f143ac80378 = []byte{
-	// 542 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0xe3, 0x56, 0x9b, 0xa8, 0xdb, 0x6a, 0x5b, 0xb4, 0x43, 0x55, 0x90, 0x99, 0x7a, 0x9a,
-	0x84, 0x48, 0xb4, 0x76, 0x07, 0x04, 0x17, 0x18, 0x02, 0x01, 0x65, 0xd2, 0xc8, 0x90, 0x90, 0xb8,
-	0x44, 0x26, 0xfb, 0xd4, 0x9a, 0xa4, 0x49, 0xb0, 0xdd, 0xd0, 0xde, 0x78, 0x04, 0x1e, 0x03, 0x1e,
-	0x80, 0x77, 0xe0, 0xd8, 0xe3, 0x4e, 0x88, 0xa6, 0x1c, 0x38, 0xee, 0x11, 0x50, 0x9c, 0x26, 0x4d,
-	0x07, 0x88, 0x8c, 0x5b, 0x3e, 0xdb, 0xff, 0xdf, 0xf7, 0xff, 0xfe, 0x75, 0x8d, 0x4d, 0x09, 0xa3,
-	0x30, 0xe0, 0xd4, 0x33, 0x05, 0xf0, 0x08, 0xb8, 0x49, 0x43, 0x66, 0x86, 0xc0, 0x05, 0x13, 0x12,
-	0x7c, 0x07, 0xcc, 0xe8, 0xc0, 0x7c, 0x37, 0x86, 0x31, 0x08, 0x23, 0xe4, 0x81, 0x0c, 0xf4, 0x4e,
-	0x26, 0x30, 0x52, 0x81, 0x41, 0x43, 0x66, 0x14, 0x04, 0x46, 0x74, 0xd0, 0xee, 0x95, 0x80, 0x86,
-	0x1c, 0xce, 0x98, 0x43, 0x65, 0x06, 0x6e, 0x1b, 0x25, 0x44, 0x92, 0x0a, 0x77, 0x79, 0xbe, 0xf3,
-	0x03, 0xe1, 0xe6, 0x8b, 0xc4, 0xd9, 0x03, 0xc7, 0x7d, 0x0e, 0x11, 0x78, 0xfa, 0x75, 0x5c, 0xa3,
-	0x8e, 0x6b, 0x7b, 0x49, 0xd1, 0x42, 0x7b, 0x68, 0xbf, 0x6a, 0x5d, 0xa3, 0xd9, 0x26, 0xc7, 0x3b,
-	0x8e, 0x37, 0x16, 0x12, 0xb8, 0xbd, 0x3a, 0x54, 0xd9, 0xab, 0xee, 0xd7, 0xbb, 0x8f, 0x8d, 0x7f,
-	0xcf, 0x64, 0xac, 0xb5, 0x32, 0x1e, 0xa6, 0xa8, 0xac, 0x7e, 0xe4, 0x4b, 0x3e, 0xb5, 0xb6, 0x9c,
-	0xf5, 0xd5, 0xf6, 0x11, 0xde, 0xfd, 0xd3, 0x41, 0x7d, 0x1b, 0x57, 0x5d, 0x98, 0x2a, 0x8b, 0x35,
-	0x2b, 0xf9, 0xd4, 0x77, 0xf1, 0x46, 0x44, 0xbd, 0x31, 0xb4, 0x2a, 0xca, 0x76, 0x5a, 0xdc, 0xad,
-	0xdc, 0x41, 0x9d, 0x6f, 0x15, 0x8c, 0x55, 0xef, 0x53, 0x49, 0x25, 0xe8, 0x80, 0x9b, 0x1c, 0xe8,
-	0x19, 0x70, 0x5b, 0x24, 0xb5, 0x68, 0x21, 0x35, 0xc2, 0xfd, 0xd2, 0x23, 0x28, 0x8c, 0x61, 0x29,
-	0x86, 0xfa, 0x16, 0xa9, 0xf9, 0x06, 0x2f, 0x2c, 0xe9, 0x1c, 0xdf, 0x84, 0x49, 0x32, 0x0e, 0x8b,
-	0xc0, 0x5e, 0x36, 0x1c, 0xb2, 0xc1, 0xd0, 0x7e, 0x4f, 0x25, 0xf0, 0x11, 0xe5, 0xae, 0x72, 0x5a,
-	0xef, 0xde, 0x2a, 0xd3, 0xf8, 0x25, 0x15, 0x6e, 0x1f, 0xa6, 0xd6, 0x8d, 0x9c, 0x99, 0xf6, 0x7f,
-	0xc2, 0x06, 0xc3, 0x57, 0x19, 0xb0, 0x3d, 0xc6, 0x3b, 0xbf, 0xd9, 0x2a, 0x46, 0x55, 0x4d, 0xa3,
-	0x7a, 0x56, 0x8c, 0xaa, 0xde, 0x3d, 0x2c, 0x3d, 0x79, 0x01, 0x5e, 0x0c, 0xd8, 0xc6, 0xdb, 0x97,
-	0xb7, 0xf5, 0x3e, 0xde, 0x14, 0x4e, 0x10, 0xe6, 0xf1, 0xf6, 0xca, 0xc7, 0xeb, 0x31, 0x07, 0x4e,
-	0x13, 0xad, 0xb5, 0x44, 0x74, 0x3e, 0x23, 0xbc, 0x75, 0x69, 0x4f, 0x7f, 0x8a, 0x37, 0x38, 0xf5,
-	0x07, 0xa0, 0x06, 0xbb, 0x32, 0xdf, 0x4a, 0xa4, 0x56, 0x4a, 0xd0, 0xfb, 0xb8, 0x96, 0xff, 0x97,
-	0x96, 0x99, 0xdc, 0x2e, 0x83, 0x3b, 0xc9, 0x44, 0xd6, 0x4a, 0xdf, 0xf9, 0xb2, 0xe6, 0x55, 0xf5,
-	0xd1, 0x4f, 0x70, 0x93, 0xf9, 0xd9, 0x5d, 0x18, 0x31, 0x7f, 0xe9, 0xf9, 0x4a, 0xbf, 0x7c, 0x23,
-	0x27, 0x1c, 0x33, 0x3f, 0x21, 0xae, 0x6e, 0xd7, 0x88, 0x4e, 0xfe, 0xe7, 0x2e, 0x35, 0x72, 0xc2,
-	0x31, 0x9d, 0x1c, 0xbd, 0x9d, 0xcd, 0x89, 0x76, 0x3e, 0x27, 0xda, 0xc5, 0x9c, 0xa0, 0x0f, 0x31,
-	0x41, 0x9f, 0x62, 0x82, 0xbe, 0xc6, 0x04, 0xcd, 0x62, 0x82, 0xbe, 0xc7, 0x04, 0xfd, 0x8c, 0x89,
-	0x76, 0x11, 0x13, 0xf4, 0x71, 0x41, 0xb4, 0xd9, 0x82, 0x68, 0xe7, 0x0b, 0xa2, 0xbd, 0x3e, 0x1c,
-	0x04, 0xab, 0x96, 0x2c, 0xf8, 0xfb, 0xc3, 0x73, 0xaf, 0x50, 0xbe, 0xd9, 0x54, 0xef, 0x4f, 0xef,
-	0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2c, 0x23, 0x0d, 0xe4, 0x3b, 0x05, 0x00, 0x00,
+	// 876 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0x9b, 0xa6, 0x6d, 0x5e, 0x52, 0x76, 0x77, 0xb4, 0x2b, 0x99, 0x00, 0x6e, 0x1b, 0x21,
+	0xa8, 0x84, 0x70, 0xb4, 0x6d, 0x0f, 0x08, 0x2e, 0xd0, 0x76, 0xd1, 0x86, 0x6e, 0xa5, 0x5d, 0x6f,
+	0x25, 0x24, 0x2e, 0xd6, 0xd4, 0x7e, 0xeb, 0x0e, 0xb1, 0x3d, 0x66, 0x66, 0x9c, 0x36, 0x37, 0x3e,
+	0xc2, 0x7e, 0x0c, 0xf8, 0x00, 0x7c, 0x05, 0xc4, 0xb1, 0x27, 0xb4, 0x37, 0x68, 0xca, 0x81, 0x63,
+	0x3f, 0x02, 0x9a, 0xb1, 0x9d, 0xa4, 0xa5, 0x88, 0x74, 0x6f, 0x9e, 0xf7, 0xe7, 0xf7, 0xfb, 0xbd,
+	0xf7, 0xc6, 0xf3, 0xa0, 0xa7, 0x30, 0xc9, 0xb8, 0xa0, 0x71, 0x4f, 0xa2, 0x18, 0xa2, 0xe8, 0xd1,
+	0x8c, 0xf5, 0x32, 0x14, 0x92, 0x49, 0x85, 0x69, 0x80, 0xbd, 0xe1, 0xe3, 0xde, 0x0f, 0x39, 0xe6,
+	0x28, 0xdd, 0x4c, 0x70, 0xc5, 0x49, 0xb7, 0x4a, 0x70, 0x8b, 0x04, 0x97, 0x66, 0xcc, 0x9d, 0x49,
+	0x70, 0x87, 0x8f, 0x3b, 0x6b, 0x11, 0xe7, 0x51, 0x8c, 0x3d, 0x93, 0x71, 0x9c, 0xbf, 0xea, 0x29,
+	0x96, 0xa0, 0x54, 0x34, 0xc9, 0x0a, 0x90, 0xce, 0x46, 0x88, 0x19, 0xa6, 0x21, 0xa6, 0x01, 0x43,
+	0xd9, 0x8b, 0x78, 0xc4, 0x8d, 0xdd, 0x7c, 0x95, 0x21, 0x1f, 0x4e, 0x84, 0x69, 0x45, 0x01, 0x4f,
+	0x12, 0x9e, 0x6a, 0x31, 0x09, 0x4a, 0x49, 0x23, 0x2c, 0xa3, 0x3e, 0xbe, 0x4d, 0x3e, 0xa6, 0x79,
+	0x22, 0x75, 0xac, 0xa2, 0x72, 0x50, 0x06, 0x6e, 0xcf, 0x51, 0x67, 0x26, 0x30, 0x64, 0x01, 0x55,
+	0x55, 0xad, 0x1d, 0x77, 0x8e, 0x24, 0xcd, 0x51, 0xc6, 0x77, 0xff, 0xb2, 0x60, 0xf5, 0x85, 0x6e,
+	0xd6, 0x57, 0xc1, 0xe0, 0x19, 0x0e, 0x31, 0x26, 0xef, 0x41, 0x93, 0x06, 0x03, 0x3f, 0xd6, 0x07,
+	0xdb, 0x5a, 0xb7, 0x36, 0xeb, 0xde, 0x0a, 0xad, 0x9c, 0x02, 0x1e, 0x04, 0x71, 0x2e, 0x15, 0x0a,
+	0x7f, 0x1a, 0xb4, 0xb0, 0x5e, 0xdf, 0x6c, 0x6d, 0x7d, 0xed, 0xfe, 0x7f, 0x9b, 0xdd, 0x6b, 0x54,
+	0xee, 0x5e, 0x01, 0x55, 0x9d, 0x9f, 0xa4, 0x4a, 0x8c, 0xbc, 0x7b, 0xc1, 0x75, 0x6b, 0x67, 0x17,
+	0x1e, 0xde, 0x16, 0x48, 0xee, 0x43, 0x7d, 0x80, 0x23, 0x23, 0xb1, 0xe9, 0xe9, 0x4f, 0xf2, 0x10,
+	0x1a, 0x43, 0x1a, 0xe7, 0x68, 0x2f, 0x18, 0xd9, 0xc5, 0xe1, 0xf3, 0x85, 0xcf, 0xac, 0xee, 0xef,
+	0x75, 0x00, 0xc3, 0xfd, 0x52, 0x51, 0x85, 0x04, 0x61, 0x55, 0x20, 0x0d, 0x51, 0xf8, 0x52, 0x9f,
+	0xa5, 0x6d, 0x99, 0x12, 0xbe, 0x9c, 0xbb, 0x04, 0x03, 0xe3, 0x7a, 0x06, 0xc3, 0x7c, 0xcb, 0x42,
+	0x7c, 0x5b, 0xcc, 0x98, 0x88, 0x80, 0x35, 0x3c, 0xd3, 0xe5, 0xb0, 0x21, 0xfa, 0x25, 0xe1, 0x09,
+	0x8b, 0x4e, 0xfc, 0x53, 0xaa, 0x50, 0x24, 0x54, 0x0c, 0x8c, 0xd2, 0xd6, 0xd6, 0x27, 0xf3, 0x10,
+	0x1f, 0x51, 0x39, 0x38, 0xc0, 0x91, 0xf7, 0xfe, 0x04, 0xb3, 0xe0, 0x7f, 0xca, 0xa2, 0x93, 0x6f,
+	0x2b, 0x40, 0x32, 0x84, 0x8d, 0x29, 0x67, 0x88, 0x31, 0x2a, 0xc6, 0xd3, 0x9b, 0xac, 0xf5, 0xbb,
+	0xb3, 0x3a, 0x13, 0xd4, 0xfd, 0x12, 0xf4, 0x1a, 0x6f, 0x27, 0x87, 0x07, 0xff, 0x6a, 0xc7, 0xec,
+	0x88, 0xea, 0xc5, 0x88, 0xbe, 0x99, 0x1d, 0x51, 0x6b, 0x6b, 0x67, 0xee, 0x8e, 0xcf, 0x80, 0xcf,
+	0x0e, 0xd6, 0x87, 0xfb, 0x37, 0xdd, 0xe4, 0x00, 0x96, 0x64, 0xc0, 0xb3, 0xc9, 0x58, 0xb7, 0xe7,
+	0x1f, 0x6b, 0xcc, 0x02, 0x7c, 0xa9, 0x73, 0xbd, 0x12, 0xa2, 0xfb, 0xb3, 0x05, 0xf7, 0x6e, 0xf8,
+	0x48, 0x1f, 0x1a, 0x82, 0xa6, 0x11, 0x9a, 0xc2, 0xee, 0x8c, 0xef, 0xe9, 0x54, 0xaf, 0x40, 0x20,
+	0x07, 0xd0, 0x9c, 0xfc, 0xc3, 0x65, 0x4f, 0x3e, 0x9d, 0x07, 0xee, 0x79, 0x95, 0xe4, 0x4d, 0xf3,
+	0xbb, 0xbf, 0x5c, 0xd3, 0x6a, 0x78, 0xc8, 0x73, 0x58, 0x65, 0x69, 0x75, 0x1f, 0x12, 0x96, 0xda,
+	0xd6, 0xdd, 0x67, 0xdf, 0x9e, 0x20, 0x1c, 0xb2, 0x54, 0x23, 0x4e, 0x6f, 0x58, 0x42, 0xcf, 0xde,
+	0xe6, 0x0e, 0xb7, 0x27, 0x08, 0x87, 0xf4, 0xac, 0xfb, 0x6b, 0x1d, 0x1e, 0x3d, 0x65, 0x52, 0x71,
+	0x31, 0xd2, 0x01, 0xfb, 0xcf, 0x5e, 0x1c, 0x16, 0x4f, 0x26, 0xf9, 0x00, 0xa0, 0x7c, 0x3d, 0x7d,
+	0x16, 0x96, 0xf7, 0xa8, 0x59, 0x5a, 0xfa, 0x21, 0x79, 0x17, 0x56, 0xe4, 0x09, 0x15, 0xa1, 0x76,
+	0x6a, 0x15, 0x0d, 0x6f, 0xd9, 0x9c, 0xfb, 0x21, 0x59, 0x83, 0x96, 0xee, 0x49, 0xc4, 0xc5, 0x48,
+	0x7b, 0xeb, 0xc6, 0x0b, 0x95, 0xa9, 0x1f, 0x92, 0x3d, 0x68, 0xea, 0x87, 0xd0, 0x57, 0xa3, 0x0c,
+	0xed, 0xc5, 0x75, 0x6b, 0xf3, 0x9d, 0xad, 0x8f, 0x6e, 0x2d, 0xc1, 0xbc, 0xcd, 0x95, 0xf8, 0xa3,
+	0x51, 0x86, 0xde, 0x8a, 0x2a, 0xbf, 0xc8, 0x06, 0xb4, 0x53, 0x9a, 0xa0, 0xcc, 0x68, 0x60, 0x14,
+	0x36, 0xcc, 0x63, 0xd4, 0x9a, 0xd8, 0x0a, 0x21, 0xa7, 0x5c, 0x0c, 0x5e, 0xc5, 0xfc, 0x54, 0x47,
+	0x2c, 0x99, 0x08, 0xa8, 0x4c, 0xfd, 0x90, 0x3c, 0x82, 0x25, 0x91, 0xa7, 0xda, 0xb7, 0x6c, 0x7c,
+	0x0d, 0x91, 0xa7, 0xfd, 0x90, 0xec, 0xc0, 0xa2, 0xa6, 0xb1, 0x57, 0x4c, 0x77, 0xd7, 0xa7, 0xd2,
+	0xb4, 0xa6, 0x62, 0xb9, 0x68, 0x51, 0xfb, 0x54, 0xd1, 0xdd, 0x98, 0x1f, 0x7b, 0x26, 0x9a, 0xd8,
+	0xb0, 0x4c, 0x95, 0x0e, 0x55, 0x76, 0xb3, 0x68, 0x48, 0x79, 0xd4, 0xad, 0x8c, 0xa9, 0x54, 0x3e,
+	0x0a, 0xc1, 0x85, 0x0d, 0x86, 0xaa, 0xa9, 0x2d, 0x4f, 0xb4, 0x81, 0xec, 0x41, 0x1b, 0x53, 0xb3,
+	0x36, 0x7d, 0xbd, 0xfa, 0xec, 0x96, 0xa1, 0xed, 0xb8, 0xc5, 0x5e, 0x74, 0xab, 0xbd, 0xe8, 0x1e,
+	0x55, 0x7b, 0x71, 0x77, 0xf1, 0xf5, 0x1f, 0x6b, 0x96, 0xd7, 0x2a, 0xb3, 0xb4, 0x7d, 0xf7, 0xfb,
+	0xf3, 0x0b, 0xa7, 0xf6, 0xe6, 0xc2, 0xa9, 0x5d, 0x5d, 0x38, 0xd6, 0x8f, 0x63, 0xc7, 0xfa, 0x69,
+	0xec, 0x58, 0xbf, 0x8d, 0x1d, 0xeb, 0x7c, 0xec, 0x58, 0x7f, 0x8e, 0x1d, 0xeb, 0xef, 0xb1, 0x53,
+	0xbb, 0x1a, 0x3b, 0xd6, 0xeb, 0x4b, 0xa7, 0x76, 0x7e, 0xe9, 0xd4, 0xde, 0x5c, 0x3a, 0xb5, 0xef,
+	0x76, 0x22, 0x3e, 0xad, 0x8e, 0xf1, 0xff, 0xde, 0x5c, 0x5f, 0xcc, 0x1c, 0x8f, 0x97, 0x8c, 0xa4,
+	0xed, 0x7f, 0x06, 0x00, 0xa6, 0xa9, 0x3c, 0x1c, 0x0f, 0x08, 0x00, 0x00,
 }
 
 func (this *QueueAckLevel) Equal(that interface{}) bool {
@@ -411,6 +575,9 @@ func (this *QueueState) Equal(that interface{}) bool {
 	if !this.ExclusiveReaderHighWatermark.Equal(that1.ExclusiveReaderHighWatermark) {
 		return false
 	}
+	if !this.ExclusiveDeletionHighWatermark.Equal(that1.ExclusiveDeletionHighWatermark) {
+		return false
+	}
 	return true
 }
 func (this *QueueReaderState) Equal(that interface{}) bool {
@@ -496,6 +663,64 @@ func (this *QueueSliceRange) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *HistoryTaskDLQMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HistoryTaskDLQMessage)
+	if !ok {
+		that2, ok := that.(HistoryTaskDLQMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MessageId != that1.MessageId {
+		return false
+	}
+	if this.ShardId != that1.ShardId {
+		return false
+	}
+	if this.CategoryId != that1.CategoryId {
+		return false
+	}
+	if this.TaskType != that1.TaskType {
+		return false
+	}
+	if this.NamespaceId != that1.NamespaceId {
+		return false
+	}
+	if this.WorkflowId != that1.WorkflowId {
+		return false
+	}
+	if this.RunId != that1.RunId {
+		return false
+	}
+	if !this.Task.Equal(that1.Task) {
+		return false
+	}
+	if this.Attempt != that1.Attempt {
+		return false
+	}
+	if this.LastError != that1.LastError {
+		return false
+	}
+	if that1.EnqueueTime == nil {
+		if this.EnqueueTime != nil {
+			return false
+		}
+	} else if !this.EnqueueTime.Equal(*that1.EnqueueTime) {
+		return false
+	}
+	return true
+}
 func (this *QueueAckLevel) GoString() string {
 	if this == nil {
 		return "nil"
@@ -523,7 +748,7 @@ func (this *QueueState) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&persistence.QueueState{")
 	keysForReaderStates := make([]int64, 0, len(this.ReaderStates))
 	for k, _ := range this.ReaderStates {
@@ -541,6 +766,9 @@ func (this *QueueState) GoString() string {
 	if this.ExclusiveReaderHighWatermark != nil {
 		s = append(s, "ExclusiveReaderHighWatermark: "+fmt.Sprintf("%#v", this.ExclusiveReaderHighWatermark)+",\n")
 	}
+	if this.ExclusiveDeletionHighWatermark != nil {
+		s = append(s, "ExclusiveDeletionHighWatermark: "+fmt.Sprintf("%#v", this.ExclusiveDeletionHighWatermark)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -586,6 +814,28 @@ func (this *QueueSliceRange) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *HistoryTaskDLQMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 15)
+	s = append(s, "&persistence.HistoryTaskDLQMessage{")
+	s = append(s, "MessageId: "+fmt.Sprintf("%#v", this.MessageId)+",\n")
+	s = append(s, "ShardId: "+fmt.Sprintf("%#v", this.ShardId)+",\n")
+	s = append(s, "CategoryId: "+fmt.Sprintf("%#v", this.CategoryId)+",\n")
+	s = append(s, "TaskType: "+fmt.Sprintf("%#v", this.TaskType)+",\n")
+	s = append(s, "NamespaceId: "+fmt.Sprintf("%#v", this.NamespaceId)+",\n")
+	s = append(s, "WorkflowId: "+fmt.Sprintf("%#v", this.WorkflowId)+",\n")
+	s = append(s, "RunId: "+fmt.Sprintf("%#v", this.RunId)+",\n")
+	if this.Task != nil {
+		s = append(s, "Task: "+fmt.Sprintf("%#v", this.Task)+",\n")
+	}
+	s = append(s, "Attempt: "+fmt.Sprintf("%#v", this.Attempt)+",\n")
+	s = append(s, "LastError: "+fmt.Sprintf("%#v", this.LastError)+",\n")
+	s = append(s, "EnqueueTime: "+fmt.Sprintf("%#v", this.EnqueueTime)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringQueues(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -659,6 +909,18 @@ func (m *QueueState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExclusiveDeletionHighWatermark != nil {
+		{
+			size, err := m.ExclusiveDeletionHighWatermark.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueues(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.ExclusiveReaderHighWatermark != nil {
 		{
 			size, err := m.ExclusiveReaderHighWatermark.MarshalToSizedBuffer(dAtA[:i])
@@ -829,6 +1091,104 @@ func (m *QueueSliceRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HistoryTaskDLQMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryTaskDLQMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryTaskDLQMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EnqueueTime != nil {
+		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EnqueueTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EnqueueTime):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintQueues(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintQueues(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintQueues(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Task != nil {
+		{
+			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueues(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintQueues(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintQueues(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.NamespaceId) > 0 {
+		i -= len(m.NamespaceId)
+		copy(dAtA[i:], m.NamespaceId)
+		i = encodeVarintQueues(dAtA, i, uint64(len(m.NamespaceId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TaskType != 0 {
+		i = encodeVarintQueues(dAtA, i, uint64(m.TaskType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CategoryId != 0 {
+		i = encodeVarintQueues(dAtA, i, uint64(m.CategoryId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ShardId != 0 {
+		i = encodeVarintQueues(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MessageId != 0 {
+		i = encodeVarintQueues(dAtA, i, uint64(m.MessageId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQueues(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQueues(v)
 	base := offset
@@ -883,6 +1243,10 @@ func (m *QueueState) Size() (n int) {
 		l = m.ExclusiveReaderHighWatermark.Size()
 		n += 1 + l + sovQueues(uint64(l))
 	}
+	if m.ExclusiveDeletionHighWatermark != nil {
+		l = m.ExclusiveDeletionHighWatermark.Size()
+		n += 1 + l + sovQueues(uint64(l))
+	}
 	return n
 }
 
@@ -935,6 +1299,54 @@ func (m *QueueSliceRange) Size() (n int) {
 	return n
 }
 
+func (m *HistoryTaskDLQMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MessageId != 0 {
+		n += 1 + sovQueues(uint64(m.MessageId))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovQueues(uint64(m.ShardId))
+	}
+	if m.CategoryId != 0 {
+		n += 1 + sovQueues(uint64(m.CategoryId))
+	}
+	if m.TaskType != 0 {
+		n += 1 + sovQueues(uint64(m.TaskType))
+	}
+	l = len(m.NamespaceId)
+	if l > 0 {
+		n += 1 + l + sovQueues(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovQueues(uint64(l))
+	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovQueues(uint64(l))
+	}
+	if m.Task != nil {
+		l = m.Task.Size()
+		n += 1 + l + sovQueues(uint64(l))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovQueues(uint64(m.Attempt))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovQueues(uint64(l))
+	}
+	if m.EnqueueTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EnqueueTime)
+		n += 1 + l + sovQueues(uint64(l))
+	}
+	return n
+}
+
 func sovQueues(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -979,6 +1391,7 @@ func (this *QueueState) String() string {
 	s := strings.Join([]string{`&QueueState{`,
 		`ReaderStates:` + mapStringForReaderStates + `,`,
 		`ExclusiveReaderHighWatermark:` + strings.Replace(fmt.Sprintf("%v", this.ExclusiveReaderHighWatermark), "TaskKey", "TaskKey", 1) + `,`,
+		`ExclusiveDeletionHighWatermark:` + strings.Replace(fmt.Sprintf("%v", this.ExclusiveDeletionHighWatermark), "TaskKey", "TaskKey", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1020,6 +1433,26 @@ func (this *QueueSliceRange) String() string {
 	}, "")
 	return s
 }
+func (this *HistoryTaskDLQMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HistoryTaskDLQMessage{`,
+		`MessageId:` + fmt.Sprintf("%v", this.MessageId) + `,`,
+		`ShardId:` + fmt.Sprintf("%v", this.ShardId) + `,`,
+		`CategoryId:` + fmt.Sprintf("%v", this.CategoryId) + `,`,
+		`TaskType:` + fmt.Sprintf("%v", this.TaskType) + `,`,
+		`NamespaceId:` + fmt.Sprintf("%v", this.NamespaceId) + `,`,
+		`WorkflowId:` + fmt.Sprintf("%v", this.WorkflowId) + `,`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`Task:` + strings.Replace(fmt.Sprintf("%v", this.Task), "DataBlob", "v11.DataBlob", 1) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`LastError:` + fmt.Sprintf("%v", this.LastError) + `,`,
+		`EnqueueTime:` + strings.Replace(fmt.Sprintf("%v", this.EnqueueTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringQueues(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1393,6 +1826,42 @@ func (m *QueueState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExclusiveDeletionHighWatermark", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueues
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueues
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueues
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExclusiveDeletionHighWatermark == nil {
+				m.ExclusiveDeletionHighWatermark = &TaskKey{}
+			}
+			if err := m.ExclusiveDeletionHighWatermark.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueues(dAtA[iNdEx:])
@@ -1754,6 +2223,354 @@ func (m *QueueSliceRange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HistoryTaskDLQMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueues
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryTaskDLQMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryTaskDLQMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			m.MessageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueues
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessageId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueues
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CategoryId", wireType)
+			}
+			m.CategoryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueues
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CategoryId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			}
+			m.TaskType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueues
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskType |= v1.TaskType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueues
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueues
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueues
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueues
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueues
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueues
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueues
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueues
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueues
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueues
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueues
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueues
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Task == nil {
+				m.Task = &v11.DataBlob{}
+			}
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueues
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueues
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueues
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueues
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnqueueTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueues
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueues
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueues
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EnqueueTime == nil {
+				m.EnqueueTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EnqueueTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueues(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQueues
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQueues
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQueues(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
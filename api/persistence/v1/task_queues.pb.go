@@ -37,6 +37,7 @@ import (
 	strings "strings"
 
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	v1 "go.temporal.io/server/api/clock/v1"
 )
 
@@ -57,18 +58,22 @@ const (
 	STATE_UNSPECIFIED BuildId_State = 0
 	STATE_ACTIVE      BuildId_State = 1
 	STATE_DELETED     BuildId_State = 2
+	// Still dispatched to for tasks pinned to this build id, but not chosen for new assignments.
+	STATE_DRAINING BuildId_State = 3
 )
 
 var BuildId_State_name = map[int32]string{
 	0: "StateUnspecified",
 	1: "StateActive",
 	2: "StateDeleted",
+	3: "StateDraining",
 }
 
 var BuildId_State_value = map[string]int32{
 	"StateUnspecified": 0,
 	"StateActive":      1,
 	"StateDeleted":     2,
+	"StateDraining":    3,
 }
 
 func (BuildId_State) EnumDescriptor() ([]byte, []int) {
@@ -83,6 +88,12 @@ type BuildId struct {
 	// (-- api-linter: core::0142::time-field-type=disabled
 	//     aip.dev/not-precedent: Using HLC instead of wall clock. --)
 	StateUpdateTimestamp *v1.HybridLogicalClock `protobuf:"bytes,3,opt,name=state_update_timestamp,json=stateUpdateTimestamp,proto3" json:"state_update_timestamp,omitempty"`
+	// Free form metadata attached to the build id by the user, e.g. commit SHA, deploy time or owner.
+	Labels map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// HLC timestamp representing when the labels were last set.
+	// (-- api-linter: core::0142::time-field-type=disabled
+	//     aip.dev/not-precedent: Using HLC instead of wall clock. --)
+	LabelsUpdateTimestamp *v1.HybridLogicalClock `protobuf:"bytes,5,opt,name=labels_update_timestamp,json=labelsUpdateTimestamp,proto3" json:"labels_update_timestamp,omitempty"`
 }
 
 func (m *BuildId) Reset()      { *m = BuildId{} }
@@ -138,6 +149,20 @@ func (m *BuildId) GetStateUpdateTimestamp() *v1.HybridLogicalClock {
 	return nil
 }
 
+func (m *BuildId) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *BuildId) GetLabelsUpdateTimestamp() *v1.HybridLogicalClock {
+	if m != nil {
+		return m.LabelsUpdateTimestamp
+	}
+	return nil
+}
+
 // An internal represenation of temporal.api.taskqueue.v1.CompatibleVersionSet
 type CompatibleVersionSet struct {
 	// Set IDs are used internally by matching.
@@ -207,6 +232,62 @@ func (m *CompatibleVersionSet) GetDefaultUpdateTimestamp() *v1.HybridLogicalCloc
 	return nil
 }
 
+// Records a change of the task queue default build id.
+type VersioningAuditEntry struct {
+	// HLC timestamp of the change.
+	// (-- api-linter: core::0142::time-field-type=disabled
+	//     aip.dev/not-precedent: Using HLC instead of wall clock. --)
+	Timestamp *v1.HybridLogicalClock `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The build id that became the task queue default.
+	DefaultBuildId string `protobuf:"bytes,2,opt,name=default_build_id,json=defaultBuildId,proto3" json:"default_build_id,omitempty"`
+}
+
+func (m *VersioningAuditEntry) Reset()      { *m = VersioningAuditEntry{} }
+func (*VersioningAuditEntry) ProtoMessage() {}
+func (*VersioningAuditEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0cb9a0f256d1327d, []int{2}
+}
+func (m *VersioningAuditEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VersioningAuditEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VersioningAuditEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VersioningAuditEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersioningAuditEntry.Merge(m, src)
+}
+func (m *VersioningAuditEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *VersioningAuditEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersioningAuditEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersioningAuditEntry proto.InternalMessageInfo
+
+func (m *VersioningAuditEntry) GetTimestamp() *v1.HybridLogicalClock {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *VersioningAuditEntry) GetDefaultBuildId() string {
+	if m != nil {
+		return m.DefaultBuildId
+	}
+	return ""
+}
+
 // Holds all the data related to worker versioning for a task queue.
 // Backwards-incompatible changes cannot be made, as this would make existing stored data unreadable.
 type VersioningData struct {
@@ -216,12 +297,20 @@ type VersioningData struct {
 	// (-- api-linter: core::0142::time-field-type=disabled
 	//     aip.dev/not-precedent: Using HLC instead of wall clock. --)
 	DefaultUpdateTimestamp *v1.HybridLogicalClock `protobuf:"bytes,2,opt,name=default_update_timestamp,json=defaultUpdateTimestamp,proto3" json:"default_update_timestamp,omitempty"`
+	// Log of task queue default build id transitions, strictly ordered by timestamp then build id, without duplicates.
+	AuditLog []*VersioningAuditEntry `protobuf:"bytes,3,rep,name=audit_log,json=auditLog,proto3" json:"audit_log,omitempty"`
+	// Set once the audit log was compacted: its timestamp is the compaction boundary and its build id the task queue
+	// default at that time. Entries older than the boundary are dropped from audit_log.
+	AuditLogCheckpoint *VersioningAuditEntry `protobuf:"bytes,4,opt,name=audit_log_checkpoint,json=auditLogCheckpoint,proto3" json:"audit_log_checkpoint,omitempty"`
+	// Version of the schema this data was written with, see MigrateVersioningData in matching. Data written before
+	// schema versions were introduced has version 0 and is upgraded when loaded.
+	SchemaVersion int32 `protobuf:"varint,5,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
 }
 
 func (m *VersioningData) Reset()      { *m = VersioningData{} }
 func (*VersioningData) ProtoMessage() {}
 func (*VersioningData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0cb9a0f256d1327d, []int{2}
+	return fileDescriptor_0cb9a0f256d1327d, []int{3}
 }
 func (m *VersioningData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -264,6 +353,27 @@ func (m *VersioningData) GetDefaultUpdateTimestamp() *v1.HybridLogicalClock {
 	return nil
 }
 
+func (m *VersioningData) GetAuditLog() []*VersioningAuditEntry {
+	if m != nil {
+		return m.AuditLog
+	}
+	return nil
+}
+
+func (m *VersioningData) GetAuditLogCheckpoint() *VersioningAuditEntry {
+	if m != nil {
+		return m.AuditLogCheckpoint
+	}
+	return nil
+}
+
+func (m *VersioningData) GetSchemaVersion() int32 {
+	if m != nil {
+		return m.SchemaVersion
+	}
+	return 0
+}
+
 // Container for all persistent user provided data for a task queue.
 // Task queue as a named concept here is close to how users interpret them, rather than relating to some specific type
 // (workflow vs activity, etc) and thus, as a consequence, any data that applies to a specific type (say, activity rate
@@ -282,7 +392,7 @@ type TaskQueueUserData struct {
 func (m *TaskQueueUserData) Reset()      { *m = TaskQueueUserData{} }
 func (*TaskQueueUserData) ProtoMessage() {}
 func (*TaskQueueUserData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0cb9a0f256d1327d, []int{3}
+	return fileDescriptor_0cb9a0f256d1327d, []int{4}
 }
 func (m *TaskQueueUserData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -334,7 +444,7 @@ type VersionedTaskQueueUserData struct {
 func (m *VersionedTaskQueueUserData) Reset()      { *m = VersionedTaskQueueUserData{} }
 func (*VersionedTaskQueueUserData) ProtoMessage() {}
 func (*VersionedTaskQueueUserData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0cb9a0f256d1327d, []int{4}
+	return fileDescriptor_0cb9a0f256d1327d, []int{5}
 }
 func (m *VersionedTaskQueueUserData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -380,7 +490,9 @@ func (m *VersionedTaskQueueUserData) GetVersion() int64 {
 func init() {
 	proto.RegisterEnum("temporal.server.api.persistence.v1.BuildId_State", BuildId_State_name, BuildId_State_value)
 	proto.RegisterType((*BuildId)(nil), "temporal.server.api.persistence.v1.BuildId")
+	proto.RegisterMapType((map[string]string)(nil), "temporal.server.api.persistence.v1.BuildId.LabelsEntry")
 	proto.RegisterType((*CompatibleVersionSet)(nil), "temporal.server.api.persistence.v1.CompatibleVersionSet")
+	proto.RegisterType((*VersioningAuditEntry)(nil), "temporal.server.api.persistence.v1.VersioningAuditEntry")
 	proto.RegisterType((*VersioningData)(nil), "temporal.server.api.persistence.v1.VersioningData")
 	proto.RegisterType((*TaskQueueUserData)(nil), "temporal.server.api.persistence.v1.TaskQueueUserData")
 	proto.RegisterType((*VersionedTaskQueueUserData)(nil), "temporal.server.api.persistence.v1.VersionedTaskQueueUserData")
@@ -391,44 +503,55 @@ func init() {
 }
 
 var fileDescriptor_0cb9a0f256d1327d = []byte{
-	// 578 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x41, 0x6f, 0xd3, 0x3c,
-	0x1c, 0xc6, 0xe3, 0xf4, 0xdd, 0xf6, 0xce, 0x1d, 0xa5, 0xb5, 0xc6, 0x88, 0x76, 0xb0, 0xaa, 0x9e,
-	0x2a, 0x90, 0x52, 0x5a, 0x86, 0x84, 0xc4, 0x69, 0x6b, 0x33, 0x16, 0x69, 0x42, 0x90, 0xa6, 0x3b,
-	0xb0, 0x43, 0xe4, 0x36, 0x5e, 0xe5, 0x35, 0x6d, 0x42, 0xec, 0x46, 0xe2, 0x06, 0x9f, 0x00, 0x3e,
-	0x06, 0x1f, 0x81, 0x8f, 0xc0, 0xb1, 0xc7, 0x71, 0xa3, 0xa9, 0x90, 0x38, 0xee, 0x23, 0xa0, 0x38,
-	0xe9, 0xd8, 0x58, 0x81, 0x82, 0x76, 0xaa, 0xfd, 0x6f, 0x9e, 0xdf, 0xe3, 0xe7, 0x51, 0x62, 0xb8,
-	0x23, 0xe8, 0x30, 0xf0, 0x43, 0xe2, 0xd5, 0x38, 0x0d, 0x23, 0x1a, 0xd6, 0x48, 0xc0, 0x6a, 0x01,
-	0x0d, 0x39, 0xe3, 0x82, 0x8e, 0x7a, 0xb4, 0x16, 0xd5, 0x6b, 0x82, 0xf0, 0x81, 0xf3, 0x6a, 0x4c,
-	0xc7, 0x94, 0xeb, 0x41, 0xe8, 0x0b, 0x1f, 0x55, 0xe6, 0x2a, 0x3d, 0x55, 0xe9, 0x24, 0x60, 0xfa,
-	0x25, 0x95, 0x1e, 0xd5, 0xb7, 0xef, 0x2d, 0x22, 0xf7, 0x3c, 0xbf, 0x37, 0x48, 0x98, 0x43, 0xca,
-	0x39, 0xe9, 0xd3, 0x94, 0x57, 0x79, 0xa7, 0xc2, 0xb5, 0xbd, 0x31, 0xf3, 0x5c, 0xd3, 0x45, 0x05,
-	0xa8, 0x32, 0x57, 0x03, 0x65, 0x50, 0x5d, 0xb7, 0x54, 0xe6, 0xa2, 0xa7, 0x70, 0x85, 0x0b, 0x22,
-	0xa8, 0xa6, 0x96, 0x41, 0xb5, 0xd0, 0xa8, 0xeb, 0x7f, 0xf6, 0xd6, 0x33, 0x96, 0xde, 0x4e, 0x84,
-	0x56, 0xaa, 0x47, 0x27, 0x70, 0x4b, 0x2e, 0x9c, 0x71, 0xe0, 0x26, 0x3f, 0x82, 0x0d, 0x29, 0x17,
-	0x64, 0x18, 0x68, 0xb9, 0x32, 0xa8, 0xe6, 0x1b, 0x0f, 0x16, 0x92, 0xe5, 0x89, 0x13, 0xe6, 0xc1,
-	0xeb, 0x6e, 0xc8, 0xdc, 0x43, 0xbf, 0xcf, 0x7a, 0xc4, 0x6b, 0x26, 0x53, 0x6b, 0x53, 0xf2, 0x3a,
-	0x12, 0x67, 0xcf, 0x69, 0x95, 0x26, 0x5c, 0x91, 0xbe, 0xe8, 0x0e, 0x2c, 0xb5, 0xed, 0x5d, 0xdb,
-	0x70, 0x3a, 0xcf, 0xda, 0xcf, 0x8d, 0xa6, 0xb9, 0x6f, 0x1a, 0xad, 0xa2, 0x82, 0x8a, 0x70, 0x23,
-	0x1d, 0xef, 0x36, 0x6d, 0xf3, 0xc8, 0x28, 0x02, 0x54, 0x82, 0xb7, 0xd2, 0x49, 0xcb, 0x38, 0x34,
-	0x6c, 0xa3, 0x55, 0x54, 0x2b, 0x5f, 0x01, 0xdc, 0x6c, 0xfa, 0xc3, 0x80, 0x08, 0xd6, 0xf5, 0xe8,
-	0x51, 0x12, 0xcf, 0x1f, 0xb5, 0xa9, 0x40, 0x77, 0xe1, 0x1a, 0xa7, 0xc2, 0x61, 0x2e, 0xd7, 0x40,
-	0x39, 0x57, 0x5d, 0xb7, 0x56, 0x39, 0x15, 0xa6, 0xcb, 0xd1, 0x01, 0x5c, 0xef, 0x26, 0xb1, 0xe5,
-	0x5f, 0x6a, 0x39, 0x57, 0xcd, 0x37, 0xee, 0xff, 0x45, 0x57, 0xd6, 0xff, 0xdd, 0x74, 0xc1, 0xd1,
-	0x29, 0xd4, 0x5c, 0x7a, 0x42, 0xc6, 0x9e, 0xb8, 0xb9, 0xaa, 0xb6, 0x32, 0xe2, 0xcf, 0x65, 0x7d,
-	0x06, 0xb0, 0x90, 0xa5, 0x63, 0xa3, 0x7e, 0x8b, 0x08, 0x82, 0x8e, 0xe1, 0x46, 0x94, 0x4e, 0x1c,
-	0x4e, 0x45, 0x1a, 0x33, 0xdf, 0x78, 0xbc, 0x4c, 0x96, 0x45, 0x8d, 0x59, 0xf9, 0xe8, 0x62, 0xfd,
-	0xfb, 0x6c, 0xea, 0x0d, 0x67, 0xfb, 0x08, 0x60, 0xc9, 0x26, 0x7c, 0xf0, 0x22, 0xf9, 0x74, 0x3a,
-	0x9c, 0x86, 0x32, 0xde, 0x3e, 0x5c, 0x91, 0x30, 0xf9, 0x8a, 0xff, 0x8b, 0x5d, 0x2a, 0x47, 0xc7,
-	0xf0, 0x76, 0x74, 0x51, 0x9c, 0xe3, 0x12, 0x41, 0xb2, 0x00, 0x8d, 0x65, 0x9a, 0xba, 0xda, 0xb9,
-	0x55, 0x88, 0xae, 0xec, 0x2b, 0x6f, 0x01, 0xdc, 0xce, 0x1e, 0xa1, 0xee, 0xf5, 0x0c, 0x26, 0xfc,
-	0x4f, 0x1a, 0xa6, 0x11, 0x1e, 0x2d, 0x63, 0x78, 0x0d, 0x62, 0x49, 0x04, 0xd2, 0xe0, 0x5a, 0xe6,
-	0x2d, 0x8f, 0x9f, 0xb3, 0xe6, 0xdb, 0xbd, 0xd3, 0xc9, 0x14, 0x2b, 0x67, 0x53, 0xac, 0x9c, 0x4f,
-	0x31, 0x78, 0x13, 0x63, 0xf0, 0x21, 0xc6, 0xe0, 0x53, 0x8c, 0xc1, 0x24, 0xc6, 0xe0, 0x4b, 0x8c,
-	0xc1, 0xb7, 0x18, 0x2b, 0xe7, 0x31, 0x06, 0xef, 0x67, 0x58, 0x99, 0xcc, 0xb0, 0x72, 0x36, 0xc3,
-	0xca, 0xcb, 0x9d, 0xbe, 0xff, 0xe3, 0x38, 0xcc, 0xff, 0xf5, 0xb5, 0xf6, 0xe4, 0xd2, 0xb6, 0xbb,
-	0x2a, 0xef, 0xa1, 0x87, 0xdf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x99, 0xb5, 0x16, 0x5c, 0x0f, 0x05,
-	0x00, 0x00,
+	// 768 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xc1, 0x6e, 0xea, 0x46,
+	0x14, 0x86, 0x19, 0x0c, 0xc9, 0xe5, 0x70, 0x2f, 0x25, 0x23, 0xee, 0x8d, 0x95, 0x85, 0x85, 0x2c,
+	0x55, 0x42, 0xad, 0x64, 0x1a, 0x9a, 0xaa, 0x69, 0xbb, 0x22, 0xe0, 0x24, 0x96, 0x10, 0x6d, 0x0d,
+	0xa4, 0x52, 0xb3, 0xb0, 0x06, 0x3c, 0x21, 0x0e, 0x06, 0xbb, 0x9e, 0xc1, 0x52, 0x76, 0xed, 0x1b,
+	0x64, 0xdb, 0x37, 0xe8, 0x23, 0x74, 0xdf, 0x4d, 0x97, 0x59, 0x66, 0xd9, 0x10, 0x55, 0xea, 0x32,
+	0x8f, 0x50, 0xd9, 0x63, 0x20, 0x4d, 0x68, 0x9b, 0xe4, 0x66, 0x95, 0x99, 0x13, 0xce, 0x77, 0xfe,
+	0xf3, 0xff, 0x96, 0x0d, 0x3b, 0x9c, 0x8e, 0x7d, 0x2f, 0x20, 0x6e, 0x95, 0xd1, 0x20, 0xa4, 0x41,
+	0x95, 0xf8, 0x4e, 0xd5, 0xa7, 0x01, 0x73, 0x18, 0xa7, 0x93, 0x01, 0xad, 0x86, 0xdb, 0x55, 0x4e,
+	0xd8, 0xc8, 0xfa, 0x61, 0x4a, 0xa7, 0x94, 0x69, 0x7e, 0xe0, 0x71, 0x0f, 0xab, 0xf3, 0x2e, 0x4d,
+	0x74, 0x69, 0xc4, 0x77, 0xb4, 0x3b, 0x5d, 0x5a, 0xb8, 0xbd, 0xf5, 0xd1, 0x2a, 0xf2, 0xc0, 0xf5,
+	0x06, 0xa3, 0x88, 0x39, 0xa6, 0x8c, 0x91, 0x21, 0x15, 0x3c, 0xf5, 0xe7, 0x0c, 0xac, 0xef, 0x4d,
+	0x1d, 0xd7, 0x36, 0x6c, 0x5c, 0x80, 0xb4, 0x63, 0xcb, 0xa8, 0x8c, 0x2a, 0x39, 0x33, 0xed, 0xd8,
+	0xf8, 0x00, 0xb2, 0x8c, 0x13, 0x4e, 0xe5, 0x74, 0x19, 0x55, 0x0a, 0xb5, 0x6d, 0xed, 0xff, 0x67,
+	0x6b, 0x09, 0x4b, 0xeb, 0x44, 0x8d, 0xa6, 0xe8, 0xc7, 0x27, 0xf0, 0x2e, 0x3e, 0x58, 0x53, 0xdf,
+	0x8e, 0xfe, 0x70, 0x67, 0x4c, 0x19, 0x27, 0x63, 0x5f, 0x96, 0xca, 0xa8, 0x92, 0xaf, 0x7d, 0xb2,
+	0x92, 0x1c, 0x2b, 0x8e, 0x98, 0x87, 0xe7, 0xfd, 0xc0, 0xb1, 0x5b, 0xde, 0xd0, 0x19, 0x10, 0xb7,
+	0x11, 0x55, 0xcd, 0x52, 0xcc, 0xeb, 0xc5, 0xb8, 0xee, 0x9c, 0x86, 0xbf, 0x86, 0x35, 0x97, 0xf4,
+	0xa9, 0xcb, 0xe4, 0x4c, 0x59, 0xaa, 0xe4, 0x6b, 0x9f, 0x3f, 0x45, 0x71, 0x2b, 0xee, 0xd4, 0x27,
+	0x3c, 0x38, 0x37, 0x13, 0x0c, 0x3e, 0x85, 0x4d, 0x71, 0x7a, 0xa8, 0x3c, 0xfb, 0x4c, 0xe5, 0x6f,
+	0x05, 0xf0, 0x9e, 0xf4, 0xad, 0x2f, 0x20, 0x7f, 0x47, 0x00, 0x2e, 0x82, 0x34, 0xa2, 0xe7, 0x49,
+	0x16, 0xd1, 0x11, 0x97, 0x20, 0x1b, 0x12, 0x77, 0x2a, 0xc2, 0xc8, 0x99, 0xe2, 0xf2, 0x65, 0x7a,
+	0x17, 0xa9, 0xdf, 0x41, 0x36, 0x76, 0x1b, 0xbf, 0x85, 0x8d, 0x4e, 0xb7, 0xde, 0xd5, 0xad, 0x5e,
+	0xbb, 0xf3, 0x8d, 0xde, 0x30, 0xf6, 0x0d, 0xbd, 0x59, 0x4c, 0xe1, 0x22, 0xbc, 0x16, 0xe5, 0x7a,
+	0xa3, 0x6b, 0x1c, 0xe9, 0x45, 0x84, 0x37, 0xe0, 0x8d, 0xa8, 0x34, 0xf5, 0x96, 0xde, 0xd5, 0x9b,
+	0xc5, 0x34, 0xc6, 0x50, 0x48, 0x4a, 0x66, 0xdd, 0x68, 0x1b, 0xed, 0x83, 0xa2, 0xa4, 0xfe, 0x89,
+	0xa0, 0xd4, 0xf0, 0xc6, 0x3e, 0xe1, 0x4e, 0xdf, 0xa5, 0x47, 0x91, 0x6d, 0xde, 0xa4, 0x43, 0x39,
+	0xde, 0x84, 0x75, 0x46, 0xb9, 0xe5, 0xd8, 0x4c, 0x46, 0x65, 0xa9, 0x92, 0x33, 0xd7, 0x18, 0xe5,
+	0x86, 0xcd, 0xf0, 0x21, 0xe4, 0xfa, 0x91, 0x9d, 0xf1, 0xbf, 0xd2, 0x71, 0x06, 0x1f, 0x3f, 0x21,
+	0x03, 0xf3, 0x55, 0x5f, 0x1c, 0x18, 0x3e, 0x03, 0xd9, 0xa6, 0x27, 0x64, 0xea, 0xf2, 0x97, 0x7b,
+	0x68, 0xde, 0x25, 0xc4, 0x7b, 0xde, 0xab, 0x17, 0x08, 0x4a, 0xc9, 0x76, 0xce, 0x64, 0x58, 0x9f,
+	0xda, 0x0e, 0x17, 0x29, 0xb4, 0x21, 0xb7, 0x9c, 0x8a, 0x9e, 0x39, 0x75, 0x89, 0xc0, 0x15, 0x28,
+	0xce, 0x97, 0x9a, 0xdb, 0x94, 0xc4, 0x59, 0x48, 0xea, 0x89, 0x11, 0xea, 0x6f, 0x12, 0x14, 0x96,
+	0x92, 0x9a, 0x84, 0x13, 0x7c, 0x0c, 0xaf, 0x43, 0x51, 0xb1, 0x18, 0xe5, 0xc2, 0xf9, 0x7c, 0x6d,
+	0xf7, 0x31, 0xf6, 0xae, 0x0a, 0xd1, 0xcc, 0x87, 0x8b, 0xf3, 0x7f, 0xdb, 0x9d, 0x7e, 0x59, 0xbb,
+	0x71, 0x0f, 0x72, 0x24, 0xf2, 0xd8, 0x72, 0xbd, 0xa1, 0x2c, 0x3d, 0x7e, 0x8b, 0x55, 0x11, 0x99,
+	0xaf, 0x62, 0x54, 0xcb, 0x1b, 0xe2, 0x33, 0x28, 0x2d, 0xb0, 0xd6, 0xe0, 0x94, 0x0e, 0x46, 0xbe,
+	0xe7, 0x4c, 0xb8, 0x9c, 0x29, 0xa3, 0xf7, 0x9a, 0x80, 0xe7, 0x13, 0x1a, 0x0b, 0x26, 0xfe, 0x10,
+	0x0a, 0x6c, 0x70, 0x4a, 0xc7, 0xc4, 0x4a, 0x4c, 0x8c, 0x5f, 0x07, 0x59, 0xf3, 0x8d, 0xa8, 0x26,
+	0x1c, 0xf5, 0x57, 0x04, 0x1b, 0x5d, 0xc2, 0x46, 0xdf, 0x46, 0x6f, 0xf0, 0x1e, 0xa3, 0x41, 0x1c,
+	0xe4, 0x3e, 0x64, 0x63, 0xdb, 0x9e, 0xfd, 0x44, 0x89, 0x76, 0x7c, 0x0c, 0x1f, 0x84, 0x0b, 0xc1,
+	0x96, 0x4d, 0x38, 0x49, 0xa2, 0xaa, 0x3d, 0x6d, 0xd7, 0x48, 0x94, 0x59, 0x08, 0xff, 0x71, 0x57,
+	0x7f, 0x42, 0xb0, 0x95, 0xfc, 0x84, 0xda, 0x0f, 0x77, 0x30, 0x20, 0x13, 0x0f, 0x14, 0x2b, 0x7c,
+	0xf6, 0x98, 0x81, 0x0f, 0x20, 0x66, 0x8c, 0xc0, 0x32, 0xac, 0xcf, 0x4d, 0x8c, 0xe4, 0x4b, 0xe6,
+	0xfc, 0xba, 0x77, 0x76, 0x79, 0xad, 0xa4, 0xae, 0xae, 0x95, 0xd4, 0xed, 0xb5, 0x82, 0x7e, 0x9c,
+	0x29, 0xe8, 0x97, 0x99, 0x82, 0x7e, 0x9f, 0x29, 0xe8, 0x72, 0xa6, 0xa0, 0x3f, 0x66, 0x0a, 0xfa,
+	0x6b, 0xa6, 0xa4, 0x6e, 0x67, 0x0a, 0xba, 0xb8, 0x51, 0x52, 0x97, 0x37, 0x4a, 0xea, 0xea, 0x46,
+	0x49, 0x7d, 0xbf, 0x33, 0xf4, 0x96, 0x72, 0x1c, 0xef, 0xdf, 0xbf, 0xae, 0x5f, 0xdd, 0xb9, 0xf6,
+	0xd7, 0xe2, 0xcf, 0xe1, 0xa7, 0x7f, 0x0f, 0x00, 0x52, 0x65, 0x85, 0x59, 0x96, 0x07, 0x00, 0x00,
 }
 
 func (x BuildId_State) String() string {
@@ -466,6 +589,17 @@ func (this *BuildId) Equal(that interface{}) bool {
 	if !this.StateUpdateTimestamp.Equal(that1.StateUpdateTimestamp) {
 		return false
 	}
+	if len(this.Labels) != len(that1.Labels) {
+		return false
+	}
+	for i := range this.Labels {
+		if this.Labels[i] != that1.Labels[i] {
+			return false
+		}
+	}
+	if !this.LabelsUpdateTimestamp.Equal(that1.LabelsUpdateTimestamp) {
+		return false
+	}
 	return true
 }
 func (this *CompatibleVersionSet) Equal(that interface{}) bool {
@@ -508,6 +642,33 @@ func (this *CompatibleVersionSet) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *VersioningAuditEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VersioningAuditEntry)
+	if !ok {
+		that2, ok := that.(VersioningAuditEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Timestamp.Equal(that1.Timestamp) {
+		return false
+	}
+	if this.DefaultBuildId != that1.DefaultBuildId {
+		return false
+	}
+	return true
+}
 func (this *VersioningData) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -538,6 +699,20 @@ func (this *VersioningData) Equal(that interface{}) bool {
 	if !this.DefaultUpdateTimestamp.Equal(that1.DefaultUpdateTimestamp) {
 		return false
 	}
+	if len(this.AuditLog) != len(that1.AuditLog) {
+		return false
+	}
+	for i := range this.AuditLog {
+		if !this.AuditLog[i].Equal(that1.AuditLog[i]) {
+			return false
+		}
+	}
+	if !this.AuditLogCheckpoint.Equal(that1.AuditLogCheckpoint) {
+		return false
+	}
+	if this.SchemaVersion != that1.SchemaVersion {
+		return false
+	}
 	return true
 }
 func (this *TaskQueueUserData) Equal(that interface{}) bool {
@@ -598,13 +773,29 @@ func (this *BuildId) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 9)
 	s = append(s, "&persistence.BuildId{")
 	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
 	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
 	if this.StateUpdateTimestamp != nil {
 		s = append(s, "StateUpdateTimestamp: "+fmt.Sprintf("%#v", this.StateUpdateTimestamp)+",\n")
 	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k, _ := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%#v: %#v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	if this.Labels != nil {
+		s = append(s, "Labels: "+mapStringForLabels+",\n")
+	}
+	if this.LabelsUpdateTimestamp != nil {
+		s = append(s, "LabelsUpdateTimestamp: "+fmt.Sprintf("%#v", this.LabelsUpdateTimestamp)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -624,11 +815,24 @@ func (this *CompatibleVersionSet) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *VersioningData) GoString() string {
+func (this *VersioningAuditEntry) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 6)
+	s = append(s, "&persistence.VersioningAuditEntry{")
+	if this.Timestamp != nil {
+		s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	}
+	s = append(s, "DefaultBuildId: "+fmt.Sprintf("%#v", this.DefaultBuildId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VersioningData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
 	s = append(s, "&persistence.VersioningData{")
 	if this.VersionSets != nil {
 		s = append(s, "VersionSets: "+fmt.Sprintf("%#v", this.VersionSets)+",\n")
@@ -636,6 +840,13 @@ func (this *VersioningData) GoString() string {
 	if this.DefaultUpdateTimestamp != nil {
 		s = append(s, "DefaultUpdateTimestamp: "+fmt.Sprintf("%#v", this.DefaultUpdateTimestamp)+",\n")
 	}
+	if this.AuditLog != nil {
+		s = append(s, "AuditLog: "+fmt.Sprintf("%#v", this.AuditLog)+",\n")
+	}
+	if this.AuditLogCheckpoint != nil {
+		s = append(s, "AuditLogCheckpoint: "+fmt.Sprintf("%#v", this.AuditLogCheckpoint)+",\n")
+	}
+	s = append(s, "SchemaVersion: "+fmt.Sprintf("%#v", this.SchemaVersion)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -695,6 +906,37 @@ func (m *BuildId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LabelsUpdateTimestamp != nil {
+		{
+			size, err := m.LabelsUpdateTimestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTaskQueues(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintTaskQueues(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTaskQueues(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTaskQueues(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.StateUpdateTimestamp != nil {
 		{
 			size, err := m.StateUpdateTimestamp.MarshalToSizedBuffer(dAtA[:i])
@@ -780,6 +1022,48 @@ func (m *CompatibleVersionSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VersioningAuditEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersioningAuditEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersioningAuditEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DefaultBuildId) > 0 {
+		i -= len(m.DefaultBuildId)
+		copy(dAtA[i:], m.DefaultBuildId)
+		i = encodeVarintTaskQueues(dAtA, i, uint64(len(m.DefaultBuildId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTaskQueues(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *VersioningData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -800,6 +1084,37 @@ func (m *VersioningData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SchemaVersion != 0 {
+		i = encodeVarintTaskQueues(dAtA, i, uint64(m.SchemaVersion))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AuditLogCheckpoint != nil {
+		{
+			size, err := m.AuditLogCheckpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTaskQueues(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AuditLog) > 0 {
+		for iNdEx := len(m.AuditLog) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuditLog[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTaskQueues(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.DefaultUpdateTimestamp != nil {
 		{
 			size, err := m.DefaultUpdateTimestamp.MarshalToSizedBuffer(dAtA[:i])
@@ -944,6 +1259,18 @@ func (m *BuildId) Size() (n int) {
 		l = m.StateUpdateTimestamp.Size()
 		n += 1 + l + sovTaskQueues(uint64(l))
 	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTaskQueues(uint64(len(k))) + 1 + len(v) + sovTaskQueues(uint64(len(v)))
+			n += mapEntrySize + 1 + sovTaskQueues(uint64(mapEntrySize))
+		}
+	}
+	if m.LabelsUpdateTimestamp != nil {
+		l = m.LabelsUpdateTimestamp.Size()
+		n += 1 + l + sovTaskQueues(uint64(l))
+	}
 	return n
 }
 
@@ -972,6 +1299,23 @@ func (m *CompatibleVersionSet) Size() (n int) {
 	return n
 }
 
+func (m *VersioningAuditEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovTaskQueues(uint64(l))
+	}
+	l = len(m.DefaultBuildId)
+	if l > 0 {
+		n += 1 + l + sovTaskQueues(uint64(l))
+	}
+	return n
+}
+
 func (m *VersioningData) Size() (n int) {
 	if m == nil {
 		return 0
@@ -988,6 +1332,19 @@ func (m *VersioningData) Size() (n int) {
 		l = m.DefaultUpdateTimestamp.Size()
 		n += 1 + l + sovTaskQueues(uint64(l))
 	}
+	if len(m.AuditLog) > 0 {
+		for _, e := range m.AuditLog {
+			l = e.Size()
+			n += 1 + l + sovTaskQueues(uint64(l))
+		}
+	}
+	if m.AuditLogCheckpoint != nil {
+		l = m.AuditLogCheckpoint.Size()
+		n += 1 + l + sovTaskQueues(uint64(l))
+	}
+	if m.SchemaVersion != 0 {
+		n += 1 + sovTaskQueues(uint64(m.SchemaVersion))
+	}
 	return n
 }
 
@@ -1034,10 +1391,22 @@ func (this *BuildId) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k, _ := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
 	s := strings.Join([]string{`&BuildId{`,
 		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
 		`State:` + fmt.Sprintf("%v", this.State) + `,`,
 		`StateUpdateTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.StateUpdateTimestamp), "HybridLogicalClock", "v1.HybridLogicalClock", 1) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`LabelsUpdateTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.LabelsUpdateTimestamp), "HybridLogicalClock", "v1.HybridLogicalClock", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1059,6 +1428,17 @@ func (this *CompatibleVersionSet) String() string {
 	}, "")
 	return s
 }
+func (this *VersioningAuditEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VersioningAuditEntry{`,
+		`Timestamp:` + strings.Replace(fmt.Sprintf("%v", this.Timestamp), "HybridLogicalClock", "v1.HybridLogicalClock", 1) + `,`,
+		`DefaultBuildId:` + fmt.Sprintf("%v", this.DefaultBuildId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *VersioningData) String() string {
 	if this == nil {
 		return "nil"
@@ -1068,9 +1448,17 @@ func (this *VersioningData) String() string {
 		repeatedStringForVersionSets += strings.Replace(f.String(), "CompatibleVersionSet", "CompatibleVersionSet", 1) + ","
 	}
 	repeatedStringForVersionSets += "}"
+	repeatedStringForAuditLog := "[]*VersioningAuditEntry{"
+	for _, f := range this.AuditLog {
+		repeatedStringForAuditLog += strings.Replace(f.String(), "VersioningAuditEntry", "VersioningAuditEntry", 1) + ","
+	}
+	repeatedStringForAuditLog += "}"
 	s := strings.Join([]string{`&VersioningData{`,
 		`VersionSets:` + repeatedStringForVersionSets + `,`,
 		`DefaultUpdateTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.DefaultUpdateTimestamp), "HybridLogicalClock", "v1.HybridLogicalClock", 1) + `,`,
+		`AuditLog:` + repeatedStringForAuditLog + `,`,
+		`AuditLogCheckpoint:` + strings.Replace(this.AuditLogCheckpoint.String(), "VersioningAuditEntry", "VersioningAuditEntry", 1) + `,`,
+		`SchemaVersion:` + fmt.Sprintf("%v", this.SchemaVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1221,6 +1609,169 @@ func (m *BuildId) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskQueues
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskQueues
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskQueues
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTaskQueues
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTaskQueues
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTaskQueues
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTaskQueues
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTaskQueues
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthTaskQueues
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthTaskQueues
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTaskQueues(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthTaskQueues
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelsUpdateTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskQueues
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskQueues
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskQueues
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LabelsUpdateTimestamp == nil {
+				m.LabelsUpdateTimestamp = &v1.HybridLogicalClock{}
+			}
+			if err := m.LabelsUpdateTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTaskQueues(dAtA[iNdEx:])
@@ -1400,6 +1951,127 @@ func (m *CompatibleVersionSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VersioningAuditEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskQueues
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersioningAuditEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VersioningAuditEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskQueues
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskQueues
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskQueues
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &v1.HybridLogicalClock{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultBuildId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskQueues
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskQueues
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskQueues
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultBuildId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskQueues(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTaskQueues
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTaskQueues
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *VersioningData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1499,6 +2171,95 @@ func (m *VersioningData) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditLog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskQueues
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskQueues
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskQueues
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuditLog = append(m.AuditLog, &VersioningAuditEntry{})
+			if err := m.AuditLog[len(m.AuditLog)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditLogCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskQueues
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskQueues
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskQueues
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuditLogCheckpoint == nil {
+				m.AuditLogCheckpoint = &VersioningAuditEntry{}
+			}
+			if err := m.AuditLogCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaVersion", wireType)
+			}
+			m.SchemaVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskQueues
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SchemaVersion |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTaskQueues(dAtA[iNdEx:])
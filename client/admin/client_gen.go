@@ -93,6 +93,16 @@ func (c *clientImpl) DescribeHistoryHost(
 	return c.client.DescribeHistoryHost(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeHistoryQueue(
+	ctx context.Context,
+	request *adminservice.DescribeHistoryQueueRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeHistoryQueueResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeHistoryQueue(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeMutableState(
 	ctx context.Context,
 	request *adminservice.DescribeMutableStateRequest,
@@ -213,6 +223,16 @@ func (c *clientImpl) ListHistoryTasks(
 	return c.client.ListHistoryTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListWorkerBuildIdCompatibility(
+	ctx context.Context,
+	request *adminservice.ListWorkerBuildIdCompatibilityRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListWorkerBuildIdCompatibilityResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListWorkerBuildIdCompatibility(ctx, request, opts...)
+}
+
 func (c *clientImpl) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
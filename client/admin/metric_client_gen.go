@@ -119,6 +119,20 @@ func (c *metricClient) DescribeHistoryHost(
 	return c.client.DescribeHistoryHost(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeHistoryQueue(
+	ctx context.Context,
+	request *adminservice.DescribeHistoryQueueRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.DescribeHistoryQueueResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientDescribeHistoryQueueScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeHistoryQueue(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeMutableState(
 	ctx context.Context,
 	request *adminservice.DescribeMutableStateRequest,
@@ -287,6 +301,20 @@ func (c *metricClient) ListHistoryTasks(
 	return c.client.ListHistoryTasks(ctx, request, opts...)
 }
 
+func (c *metricClient) ListWorkerBuildIdCompatibility(
+	ctx context.Context,
+	request *adminservice.ListWorkerBuildIdCompatibilityRequest,
+	opts ...grpc.CallOption,
+) (_ *adminservice.ListWorkerBuildIdCompatibilityResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.AdminClientListWorkerBuildIdCompatibilityScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListWorkerBuildIdCompatibility(ctx, request, opts...)
+}
+
 func (c *metricClient) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -125,6 +125,21 @@ func (c *retryableClient) DescribeHistoryHost(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeHistoryQueue(
+	ctx context.Context,
+	request *adminservice.DescribeHistoryQueueRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeHistoryQueueResponse, error) {
+	var resp *adminservice.DescribeHistoryQueueResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeHistoryQueue(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeMutableState(
 	ctx context.Context,
 	request *adminservice.DescribeMutableStateRequest,
@@ -305,6 +320,21 @@ func (c *retryableClient) ListHistoryTasks(
 	return resp, err
 }
 
+func (c *retryableClient) ListWorkerBuildIdCompatibility(
+	ctx context.Context,
+	request *adminservice.ListWorkerBuildIdCompatibilityRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListWorkerBuildIdCompatibilityResponse, error) {
+	var resp *adminservice.ListWorkerBuildIdCompatibilityResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListWorkerBuildIdCompatibility(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
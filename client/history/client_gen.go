@@ -106,6 +106,30 @@ func (c *clientImpl) DeleteWorkflowVisibilityRecord(
 	return response, nil
 }
 
+func (c *clientImpl) DescribeHistoryQueue(
+	ctx context.Context,
+	request *historyservice.DescribeHistoryQueueRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeHistoryQueueResponse, error) {
+	client, err := c.getClientForShardID(request.GetShardId())
+	if err != nil {
+		return nil, err
+	}
+	var response *historyservice.DescribeHistoryQueueResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.DescribeHistoryQueue(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) DescribeMutableState(
 	ctx context.Context,
 	request *historyservice.DescribeMutableStateRequest,
@@ -619,6 +643,30 @@ func (c *clientImpl) RemoveTask(
 	return response, nil
 }
 
+func (c *clientImpl) RepinWorkflowBuildId(
+	ctx context.Context,
+	request *historyservice.RepinWorkflowBuildIdRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.RepinWorkflowBuildIdResponse, error) {
+	client, err := c.getClientForWorkflowID(request.NamespaceId, request.GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	var response *historyservice.RepinWorkflowBuildIdResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.RepinWorkflowBuildId(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) ReplicateEventsV2(
 	ctx context.Context,
 	request *historyservice.ReplicateEventsV2Request,
@@ -91,6 +91,20 @@ func (c *metricClient) DescribeHistoryHost(
 	return c.client.DescribeHistoryHost(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeHistoryQueue(
+	ctx context.Context,
+	request *historyservice.DescribeHistoryQueueRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.DescribeHistoryQueueResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.HistoryClientDescribeHistoryQueueScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeHistoryQueue(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeMutableState(
 	ctx context.Context,
 	request *historyservice.DescribeMutableStateRequest,
@@ -413,6 +427,20 @@ func (c *metricClient) RemoveTask(
 	return c.client.RemoveTask(ctx, request, opts...)
 }
 
+func (c *metricClient) RepinWorkflowBuildId(
+	ctx context.Context,
+	request *historyservice.RepinWorkflowBuildIdRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.RepinWorkflowBuildIdResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.HistoryClientRepinWorkflowBuildIdScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.RepinWorkflowBuildId(ctx, request, opts...)
+}
+
 func (c *metricClient) ReplicateEventsV2(
 	ctx context.Context,
 	request *historyservice.ReplicateEventsV2Request,
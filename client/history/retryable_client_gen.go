@@ -95,6 +95,21 @@ func (c *retryableClient) DescribeHistoryHost(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeHistoryQueue(
+	ctx context.Context,
+	request *historyservice.DescribeHistoryQueueRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.DescribeHistoryQueueResponse, error) {
+	var resp *historyservice.DescribeHistoryQueueResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeHistoryQueue(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeMutableState(
 	ctx context.Context,
 	request *historyservice.DescribeMutableStateRequest,
@@ -440,6 +455,21 @@ func (c *retryableClient) RemoveTask(
 	return resp, err
 }
 
+func (c *retryableClient) RepinWorkflowBuildId(
+	ctx context.Context,
+	request *historyservice.RepinWorkflowBuildIdRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.RepinWorkflowBuildIdResponse, error) {
+	var resp *historyservice.RepinWorkflowBuildIdResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.RepinWorkflowBuildId(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ReplicateEventsV2(
 	ctx context.Context,
 	request *historyservice.ReplicateEventsV2Request,
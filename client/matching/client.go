@@ -32,6 +32,7 @@ import (
 	"fmt"
 	"time"
 
+	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"google.golang.org/grpc"
@@ -82,6 +83,7 @@ func (c *clientImpl) AddActivityTask(
 		*request.GetTaskQueue(),
 		enumspb.TASK_QUEUE_TYPE_ACTIVITY,
 		request.GetForwardedSource(),
+		request.GetVersionDirective().GetBuildId(),
 	)
 	request.TaskQueue.Name = partition
 	client, err := c.getClientForTaskqueue(
@@ -106,6 +108,7 @@ func (c *clientImpl) AddWorkflowTask(
 		*request.GetTaskQueue(),
 		enumspb.TASK_QUEUE_TYPE_WORKFLOW,
 		request.GetForwardedSource(),
+		request.GetVersionDirective().GetBuildId(),
 	)
 	request.TaskQueue.Name = partition
 	client, err := c.getClientForTaskqueue(
@@ -130,6 +133,7 @@ func (c *clientImpl) PollActivityTaskQueue(
 		*request.PollRequest.GetTaskQueue(),
 		enumspb.TASK_QUEUE_TYPE_ACTIVITY,
 		request.GetForwardedSource(),
+		pollerBuildId(request.GetPollRequest().GetWorkerVersionCapabilities()),
 	)
 	request.PollRequest.TaskQueue.Name = partition
 	client, err := c.getClientForTaskqueue(
@@ -154,6 +158,7 @@ func (c *clientImpl) PollWorkflowTaskQueue(
 		*request.PollRequest.GetTaskQueue(),
 		enumspb.TASK_QUEUE_TYPE_WORKFLOW,
 		request.GetForwardedSource(),
+		pollerBuildId(request.GetPollRequest().GetWorkerVersionCapabilities()),
 	)
 	request.PollRequest.TaskQueue.Name = partition
 	client, err := c.getClientForTaskqueue(
@@ -175,6 +180,7 @@ func (c *clientImpl) QueryWorkflow(ctx context.Context, request *matchingservice
 		*request.GetTaskQueue(),
 		enumspb.TASK_QUEUE_TYPE_WORKFLOW,
 		request.GetForwardedSource(),
+		request.GetVersionDirective().GetBuildId(),
 	)
 	request.TaskQueue.Name = partition
 	client, err := c.getClientForTaskqueue(
@@ -190,6 +196,29 @@ func (c *clientImpl) QueryWorkflow(ctx context.Context, request *matchingservice
 	return client.QueryWorkflow(ctx, request, opts...)
 }
 
+func (c *clientImpl) UpdateWorkerBuildIdCompatibility(
+	ctx context.Context,
+	request *matchingservice.UpdateWorkerBuildIdCompatibilityRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.UpdateWorkerBuildIdCompatibilityResponse, error) {
+	taskQueueName := request.GetTaskQueue()
+	if taskQueueName == "" {
+		// set by callers that predate the task_queue field
+		taskQueueName = request.GetRequest().GetTaskQueue()
+	}
+	client, err := c.getClientForTaskqueue(
+		request.GetNamespaceId(),
+		&taskqueuepb.TaskQueue{Name: taskQueueName},
+		enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateWorkerBuildIdCompatibility(ctx, request, opts...)
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	return context.WithTimeout(parent, c.timeout)
 }
@@ -210,3 +239,11 @@ func (c *clientImpl) getClientForTaskqueue(
 	}
 	return client.(matchingservice.MatchingServiceClient), nil
 }
+
+// pollerBuildId returns the build id a poller is pinned to, or empty if the poller is not versioned.
+func pollerBuildId(caps *commonpb.WorkerVersionCapabilities) string {
+	if !caps.GetUseVersioning() {
+		return ""
+	}
+	return caps.GetBuildId()
+}
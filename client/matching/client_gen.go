@@ -52,6 +52,21 @@ func (c *clientImpl) ApplyTaskQueueUserDataReplicationEvent(
 	return client.ApplyTaskQueueUserDataReplicationEvent(ctx, request, opts...)
 }
 
+func (c *clientImpl) ApplyVersioningTemplate(
+	ctx context.Context,
+	request *matchingservice.ApplyVersioningTemplateRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.ApplyVersioningTemplateResponse, error) {
+
+	client, err := c.getClientForTaskqueue(request.GetNamespaceId(), &taskqueuepb.TaskQueue{Name: request.GetTaskQueue()}, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ApplyVersioningTemplate(ctx, request, opts...)
+}
+
 func (c *clientImpl) CancelOutstandingPoll(
 	ctx context.Context,
 	request *matchingservice.CancelOutstandingPollRequest,
@@ -67,6 +82,21 @@ func (c *clientImpl) CancelOutstandingPoll(
 	return client.CancelOutstandingPoll(ctx, request, opts...)
 }
 
+func (c *clientImpl) CleanupUnreachableBuildIds(
+	ctx context.Context,
+	request *matchingservice.CleanupUnreachableBuildIdsRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.CleanupUnreachableBuildIdsResponse, error) {
+
+	client, err := c.getClientForTaskqueue(request.GetNamespaceId(), &taskqueuepb.TaskQueue{Name: request.GetTaskQueue()}, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.CleanupUnreachableBuildIds(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeTaskQueue(
 	ctx context.Context,
 	request *matchingservice.DescribeTaskQueueRequest,
@@ -82,6 +112,36 @@ func (c *clientImpl) DescribeTaskQueue(
 	return client.DescribeTaskQueue(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeVersioning(
+	ctx context.Context,
+	request *matchingservice.DescribeVersioningRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.DescribeVersioningResponse, error) {
+
+	client, err := c.getClientForTaskqueue(request.GetNamespaceId(), &taskqueuepb.TaskQueue{Name: request.GetTaskQueue()}, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeVersioning(ctx, request, opts...)
+}
+
+func (c *clientImpl) EnableWorkerVersioning(
+	ctx context.Context,
+	request *matchingservice.EnableWorkerVersioningRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.EnableWorkerVersioningResponse, error) {
+
+	client, err := c.getClientForTaskqueue(request.GetNamespaceId(), &taskqueuepb.TaskQueue{Name: request.GetTaskQueue()}, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.EnableWorkerVersioning(ctx, request, opts...)
+}
+
 func (c *clientImpl) ForceUnloadTaskQueue(
 	ctx context.Context,
 	request *matchingservice.ForceUnloadTaskQueueRequest,
@@ -97,6 +157,21 @@ func (c *clientImpl) ForceUnloadTaskQueue(
 	return client.ForceUnloadTaskQueue(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetBuildIdReachability(
+	ctx context.Context,
+	request *matchingservice.GetBuildIdReachabilityRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.GetBuildIdReachabilityResponse, error) {
+
+	client, err := c.getClientForTaskqueue(request.GetNamespaceId(), &taskqueuepb.TaskQueue{Name: request.GetTaskQueue()}, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetBuildIdReachability(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetBuildIdTaskQueueMapping(
 	ctx context.Context,
 	request *matchingservice.GetBuildIdTaskQueueMappingRequest,
@@ -112,6 +187,51 @@ func (c *clientImpl) GetBuildIdTaskQueueMapping(
 	return client.GetBuildIdTaskQueueMapping(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetClosedWorkflowBuildId(
+	ctx context.Context,
+	request *matchingservice.GetClosedWorkflowBuildIdRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.GetClosedWorkflowBuildIdResponse, error) {
+
+	client, err := c.getClientForTaskqueue(request.GetNamespaceId(), &taskqueuepb.TaskQueue{Name: request.GetTaskQueue()}, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetClosedWorkflowBuildId(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetDefaultBuildIdTimeline(
+	ctx context.Context,
+	request *matchingservice.GetDefaultBuildIdTimelineRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.GetDefaultBuildIdTimelineResponse, error) {
+
+	client, err := c.getClientForTaskqueue(request.GetNamespaceId(), &taskqueuepb.TaskQueue{Name: request.GetTaskQueue()}, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetDefaultBuildIdTimeline(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetTaskDispatchDecision(
+	ctx context.Context,
+	request *matchingservice.GetTaskDispatchDecisionRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.GetTaskDispatchDecisionResponse, error) {
+
+	client, err := c.getClientForTaskqueue(request.GetNamespaceId(), &taskqueuepb.TaskQueue{Name: request.GetTaskQueue()}, request.GetTaskQueueType())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetTaskDispatchDecision(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.GetTaskQueueUserDataRequest,
@@ -127,6 +247,21 @@ func (c *clientImpl) GetTaskQueueUserData(
 	return client.GetTaskQueueUserData(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetUserDataPropagationStatus(
+	ctx context.Context,
+	request *matchingservice.GetUserDataPropagationStatusRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.GetUserDataPropagationStatusResponse, error) {
+
+	client, err := c.getClientForTaskqueue(request.GetNamespaceId(), &taskqueuepb.TaskQueue{Name: fmt.Sprintf("not-applicable-%s", rand.Int())}, enumspb.TASK_QUEUE_TYPE_UNSPECIFIED)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.GetUserDataPropagationStatus(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetWorkerBuildIdCompatibility(
 	ctx context.Context,
 	request *matchingservice.GetWorkerBuildIdCompatibilityRequest,
@@ -157,6 +292,36 @@ func (c *clientImpl) ListTaskQueuePartitions(
 	return client.ListTaskQueuePartitions(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListWorkerBuildIdCompatibility(
+	ctx context.Context,
+	request *matchingservice.ListWorkerBuildIdCompatibilityRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.ListWorkerBuildIdCompatibilityResponse, error) {
+
+	client, err := c.getClientForTaskqueue(request.GetNamespaceId(), &taskqueuepb.TaskQueue{Name: fmt.Sprintf("not-applicable-%s", rand.Int())}, enumspb.TASK_QUEUE_TYPE_UNSPECIFIED)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListWorkerBuildIdCompatibility(ctx, request, opts...)
+}
+
+func (c *clientImpl) ReassignBuildId(
+	ctx context.Context,
+	request *matchingservice.ReassignBuildIdRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.ReassignBuildIdResponse, error) {
+
+	client, err := c.getClientForTaskqueue(request.GetNamespaceId(), &taskqueuepb.TaskQueue{Name: request.GetTaskQueue()}, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ReassignBuildId(ctx, request, opts...)
+}
+
 func (c *clientImpl) ReplicateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.ReplicateTaskQueueUserDataRequest,
@@ -202,17 +367,17 @@ func (c *clientImpl) UpdateTaskQueueUserData(
 	return client.UpdateTaskQueueUserData(ctx, request, opts...)
 }
 
-func (c *clientImpl) UpdateWorkerBuildIdCompatibility(
+func (c *clientImpl) ValidateDefaultBuildIdSwitch(
 	ctx context.Context,
-	request *matchingservice.UpdateWorkerBuildIdCompatibilityRequest,
+	request *matchingservice.ValidateDefaultBuildIdSwitchRequest,
 	opts ...grpc.CallOption,
-) (*matchingservice.UpdateWorkerBuildIdCompatibilityResponse, error) {
+) (*matchingservice.ValidateDefaultBuildIdSwitchResponse, error) {
 
-	client, err := c.getClientForTaskqueue(request.GetNamespaceId(), &taskqueuepb.TaskQueue{Name: request.GetRequest().GetTaskQueue()}, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	client, err := c.getClientForTaskqueue(request.GetNamespaceId(), &taskqueuepb.TaskQueue{Name: request.GetTaskQueue()}, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
 	if err != nil {
 		return nil, err
 	}
 	ctx, cancel := c.createContext(ctx)
 	defer cancel()
-	return client.UpdateWorkerBuildIdCompatibility(ctx, request, opts...)
+	return client.ValidateDefaultBuildIdSwitch(ctx, request, opts...)
 }
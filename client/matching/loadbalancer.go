@@ -46,31 +46,36 @@ type (
 		// original task queue (with no partition info). When forwardedFrom
 		// is non-empty, this call is forwardedFrom from a child partition
 		// to a parent partition in which case, no load balancing should be
-		// performed
+		// performed. buildId is the build id the task is pinned to, if any.
 		PickWritePartition(
 			namespaceID namespace.ID,
 			taskQueue taskqueuepb.TaskQueue,
 			taskQueueType enumspb.TaskQueueType,
 			forwardedFrom string,
+			buildId string,
 		) string
 
 		// PickReadPartition returns the task queue partition to send a poller to.
 		// Input is name of the original task queue as specified by caller. When
 		// forwardedFrom is non-empty, no load balancing should be done.
+		// buildId is the build id of a versioned poller, if any.
 		PickReadPartition(
 			namespaceID namespace.ID,
 			taskQueue taskqueuepb.TaskQueue,
 			taskQueueType enumspb.TaskQueueType,
 			forwardedFrom string,
+			buildId string,
 		) string
 	}
 
 	defaultLoadBalancer struct {
-		namespaceIDToName   func(id namespace.ID) (namespace.Name, error)
-		nReadPartitions     dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
-		nWritePartitions    dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
-		forceReadPartition  dynamicconfig.IntPropertyFn
-		forceWritePartition dynamicconfig.IntPropertyFn
+		namespaceIDToName          func(id namespace.ID) (namespace.Name, error)
+		nReadPartitions            dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		nWritePartitions           dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		nReadPartitionsPerBuildId  dynamicconfig.MapPropertyFnWithNamespaceFilter
+		nWritePartitionsPerBuildId dynamicconfig.MapPropertyFnWithNamespaceFilter
+		forceReadPartition         dynamicconfig.IntPropertyFn
+		forceWritePartition        dynamicconfig.IntPropertyFn
 	}
 )
 
@@ -81,11 +86,13 @@ func NewLoadBalancer(
 	dc *dynamicconfig.Collection,
 ) LoadBalancer {
 	lb := &defaultLoadBalancer{
-		namespaceIDToName:   namespaceIDToName,
-		nReadPartitions:     dc.GetTaskQueuePartitionsProperty(dynamicconfig.MatchingNumTaskqueueReadPartitions),
-		nWritePartitions:    dc.GetTaskQueuePartitionsProperty(dynamicconfig.MatchingNumTaskqueueWritePartitions),
-		forceReadPartition:  dc.GetIntProperty(dynamicconfig.TestMatchingLBForceReadPartition, -1),
-		forceWritePartition: dc.GetIntProperty(dynamicconfig.TestMatchingLBForceReadPartition, -1),
+		namespaceIDToName:          namespaceIDToName,
+		nReadPartitions:            dc.GetTaskQueuePartitionsProperty(dynamicconfig.MatchingNumTaskqueueReadPartitions),
+		nWritePartitions:           dc.GetTaskQueuePartitionsProperty(dynamicconfig.MatchingNumTaskqueueWritePartitions),
+		nReadPartitionsPerBuildId:  dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.MatchingNumTaskqueueReadPartitionsPerBuildId, map[string]any{}),
+		nWritePartitionsPerBuildId: dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.MatchingNumTaskqueueWritePartitionsPerBuildId, map[string]any{}),
+		forceReadPartition:         dc.GetIntProperty(dynamicconfig.TestMatchingLBForceReadPartition, -1),
+		forceWritePartition:        dc.GetIntProperty(dynamicconfig.TestMatchingLBForceReadPartition, -1),
 	}
 	return lb
 }
@@ -95,8 +102,9 @@ func (lb *defaultLoadBalancer) PickWritePartition(
 	taskQueue taskqueuepb.TaskQueue,
 	taskQueueType enumspb.TaskQueueType,
 	forwardedFrom string,
+	buildId string,
 ) string {
-	return lb.pickPartition(namespaceID, taskQueue, taskQueueType, forwardedFrom, lb.nWritePartitions, lb.forceWritePartition)
+	return lb.pickPartition(namespaceID, taskQueue, taskQueueType, forwardedFrom, buildId, lb.nWritePartitions, lb.nWritePartitionsPerBuildId, lb.forceWritePartition)
 }
 
 func (lb *defaultLoadBalancer) PickReadPartition(
@@ -104,8 +112,9 @@ func (lb *defaultLoadBalancer) PickReadPartition(
 	taskQueue taskqueuepb.TaskQueue,
 	taskQueueType enumspb.TaskQueueType,
 	forwardedFrom string,
+	buildId string,
 ) string {
-	return lb.pickPartition(namespaceID, taskQueue, taskQueueType, forwardedFrom, lb.nReadPartitions, lb.forceReadPartition)
+	return lb.pickPartition(namespaceID, taskQueue, taskQueueType, forwardedFrom, buildId, lb.nReadPartitions, lb.nReadPartitionsPerBuildId, lb.forceReadPartition)
 }
 
 func (lb *defaultLoadBalancer) pickPartition(
@@ -113,7 +122,9 @@ func (lb *defaultLoadBalancer) pickPartition(
 	taskQueue taskqueuepb.TaskQueue,
 	taskQueueType enumspb.TaskQueueType,
 	forwardedFrom string,
+	buildId string,
 	nPartitions dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters,
+	nPartitionsPerBuildId dynamicconfig.MapPropertyFnWithNamespaceFilter,
 	force dynamicconfig.IntPropertyFn,
 ) string {
 	if forwardedFrom != "" || taskQueue.GetKind() == enumspb.TASK_QUEUE_KIND_STICKY {
@@ -136,6 +147,31 @@ func (lb *defaultLoadBalancer) pickPartition(
 		return taskQueue.GetName()
 	}
 
-	n := util.Max(1, nPartitions(nsName.String(), tqName.BaseNameString(), taskQueueType))
+	n, ok := BuildIdPartitions(nPartitionsPerBuildId(nsName.String()), buildId)
+	if !ok {
+		n = nPartitions(nsName.String(), tqName.BaseNameString(), taskQueueType)
+	}
+	n = util.Max(1, n)
 	return tqName.WithPartition(rand.Intn(n)).FullName()
 }
+
+// BuildIdPartitions returns the partition count configured for buildId in the value of
+// MatchingNumTaskqueueReadPartitionsPerBuildId or MatchingNumTaskqueueWritePartitionsPerBuildId, if any.
+// Entries that are not positive numbers are ignored.
+func BuildIdPartitions(value map[string]any, buildId string) (int, bool) {
+	if buildId == "" {
+		return 0, false
+	}
+	var n int
+	switch v := value[buildId].(type) {
+	case float64:
+		n = int(v)
+	case int:
+		n = v
+	case int32:
+		n = int(v)
+	case int64:
+		n = int(v)
+	}
+	return n, n > 0
+}
@@ -49,6 +49,20 @@ func (c *metricClient) ApplyTaskQueueUserDataReplicationEvent(
 	return c.client.ApplyTaskQueueUserDataReplicationEvent(ctx, request, opts...)
 }
 
+func (c *metricClient) ApplyVersioningTemplate(
+	ctx context.Context,
+	request *matchingservice.ApplyVersioningTemplateRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.ApplyVersioningTemplateResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.MatchingClientApplyVersioningTemplateScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ApplyVersioningTemplate(ctx, request, opts...)
+}
+
 func (c *metricClient) CancelOutstandingPoll(
 	ctx context.Context,
 	request *matchingservice.CancelOutstandingPollRequest,
@@ -63,6 +77,20 @@ func (c *metricClient) CancelOutstandingPoll(
 	return c.client.CancelOutstandingPoll(ctx, request, opts...)
 }
 
+func (c *metricClient) CleanupUnreachableBuildIds(
+	ctx context.Context,
+	request *matchingservice.CleanupUnreachableBuildIdsRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.CleanupUnreachableBuildIdsResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.MatchingClientCleanupUnreachableBuildIdsScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.CleanupUnreachableBuildIds(ctx, request, opts...)
+}
+
 func (c *metricClient) DescribeTaskQueue(
 	ctx context.Context,
 	request *matchingservice.DescribeTaskQueueRequest,
@@ -77,6 +105,34 @@ func (c *metricClient) DescribeTaskQueue(
 	return c.client.DescribeTaskQueue(ctx, request, opts...)
 }
 
+func (c *metricClient) DescribeVersioning(
+	ctx context.Context,
+	request *matchingservice.DescribeVersioningRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.DescribeVersioningResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.MatchingClientDescribeVersioningScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.DescribeVersioning(ctx, request, opts...)
+}
+
+func (c *metricClient) EnableWorkerVersioning(
+	ctx context.Context,
+	request *matchingservice.EnableWorkerVersioningRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.EnableWorkerVersioningResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.MatchingClientEnableWorkerVersioningScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.EnableWorkerVersioning(ctx, request, opts...)
+}
+
 func (c *metricClient) ForceUnloadTaskQueue(
 	ctx context.Context,
 	request *matchingservice.ForceUnloadTaskQueueRequest,
@@ -91,6 +147,20 @@ func (c *metricClient) ForceUnloadTaskQueue(
 	return c.client.ForceUnloadTaskQueue(ctx, request, opts...)
 }
 
+func (c *metricClient) GetBuildIdReachability(
+	ctx context.Context,
+	request *matchingservice.GetBuildIdReachabilityRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.GetBuildIdReachabilityResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.MatchingClientGetBuildIdReachabilityScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetBuildIdReachability(ctx, request, opts...)
+}
+
 func (c *metricClient) GetBuildIdTaskQueueMapping(
 	ctx context.Context,
 	request *matchingservice.GetBuildIdTaskQueueMappingRequest,
@@ -105,6 +175,48 @@ func (c *metricClient) GetBuildIdTaskQueueMapping(
 	return c.client.GetBuildIdTaskQueueMapping(ctx, request, opts...)
 }
 
+func (c *metricClient) GetClosedWorkflowBuildId(
+	ctx context.Context,
+	request *matchingservice.GetClosedWorkflowBuildIdRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.GetClosedWorkflowBuildIdResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.MatchingClientGetClosedWorkflowBuildIdScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetClosedWorkflowBuildId(ctx, request, opts...)
+}
+
+func (c *metricClient) GetDefaultBuildIdTimeline(
+	ctx context.Context,
+	request *matchingservice.GetDefaultBuildIdTimelineRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.GetDefaultBuildIdTimelineResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.MatchingClientGetDefaultBuildIdTimelineScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetDefaultBuildIdTimeline(ctx, request, opts...)
+}
+
+func (c *metricClient) GetTaskDispatchDecision(
+	ctx context.Context,
+	request *matchingservice.GetTaskDispatchDecisionRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.GetTaskDispatchDecisionResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.MatchingClientGetTaskDispatchDecisionScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetTaskDispatchDecision(ctx, request, opts...)
+}
+
 func (c *metricClient) GetTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.GetTaskQueueUserDataRequest,
@@ -119,6 +231,20 @@ func (c *metricClient) GetTaskQueueUserData(
 	return c.client.GetTaskQueueUserData(ctx, request, opts...)
 }
 
+func (c *metricClient) GetUserDataPropagationStatus(
+	ctx context.Context,
+	request *matchingservice.GetUserDataPropagationStatusRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.GetUserDataPropagationStatusResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.MatchingClientGetUserDataPropagationStatusScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.GetUserDataPropagationStatus(ctx, request, opts...)
+}
+
 func (c *metricClient) GetWorkerBuildIdCompatibility(
 	ctx context.Context,
 	request *matchingservice.GetWorkerBuildIdCompatibilityRequest,
@@ -147,6 +273,34 @@ func (c *metricClient) ListTaskQueuePartitions(
 	return c.client.ListTaskQueuePartitions(ctx, request, opts...)
 }
 
+func (c *metricClient) ListWorkerBuildIdCompatibility(
+	ctx context.Context,
+	request *matchingservice.ListWorkerBuildIdCompatibilityRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.ListWorkerBuildIdCompatibilityResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.MatchingClientListWorkerBuildIdCompatibilityScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ListWorkerBuildIdCompatibility(ctx, request, opts...)
+}
+
+func (c *metricClient) ReassignBuildId(
+	ctx context.Context,
+	request *matchingservice.ReassignBuildIdRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.ReassignBuildIdResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.MatchingClientReassignBuildIdScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ReassignBuildId(ctx, request, opts...)
+}
+
 func (c *metricClient) ReplicateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.ReplicateTaskQueueUserDataRequest,
@@ -202,3 +356,17 @@ func (c *metricClient) UpdateWorkerBuildIdCompatibility(
 
 	return c.client.UpdateWorkerBuildIdCompatibility(ctx, request, opts...)
 }
+
+func (c *metricClient) ValidateDefaultBuildIdSwitch(
+	ctx context.Context,
+	request *matchingservice.ValidateDefaultBuildIdSwitchRequest,
+	opts ...grpc.CallOption,
+) (_ *matchingservice.ValidateDefaultBuildIdSwitchResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, metrics.MatchingClientValidateDefaultBuildIdSwitchScope)
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ValidateDefaultBuildIdSwitch(ctx, request, opts...)
+}
@@ -80,6 +80,21 @@ func (c *retryableClient) ApplyTaskQueueUserDataReplicationEvent(
 	return resp, err
 }
 
+func (c *retryableClient) ApplyVersioningTemplate(
+	ctx context.Context,
+	request *matchingservice.ApplyVersioningTemplateRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.ApplyVersioningTemplateResponse, error) {
+	var resp *matchingservice.ApplyVersioningTemplateResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ApplyVersioningTemplate(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) CancelOutstandingPoll(
 	ctx context.Context,
 	request *matchingservice.CancelOutstandingPollRequest,
@@ -95,6 +110,21 @@ func (c *retryableClient) CancelOutstandingPoll(
 	return resp, err
 }
 
+func (c *retryableClient) CleanupUnreachableBuildIds(
+	ctx context.Context,
+	request *matchingservice.CleanupUnreachableBuildIdsRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.CleanupUnreachableBuildIdsResponse, error) {
+	var resp *matchingservice.CleanupUnreachableBuildIdsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.CleanupUnreachableBuildIds(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeTaskQueue(
 	ctx context.Context,
 	request *matchingservice.DescribeTaskQueueRequest,
@@ -110,6 +140,36 @@ func (c *retryableClient) DescribeTaskQueue(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeVersioning(
+	ctx context.Context,
+	request *matchingservice.DescribeVersioningRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.DescribeVersioningResponse, error) {
+	var resp *matchingservice.DescribeVersioningResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeVersioning(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) EnableWorkerVersioning(
+	ctx context.Context,
+	request *matchingservice.EnableWorkerVersioningRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.EnableWorkerVersioningResponse, error) {
+	var resp *matchingservice.EnableWorkerVersioningResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.EnableWorkerVersioning(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ForceUnloadTaskQueue(
 	ctx context.Context,
 	request *matchingservice.ForceUnloadTaskQueueRequest,
@@ -125,6 +185,21 @@ func (c *retryableClient) ForceUnloadTaskQueue(
 	return resp, err
 }
 
+func (c *retryableClient) GetBuildIdReachability(
+	ctx context.Context,
+	request *matchingservice.GetBuildIdReachabilityRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.GetBuildIdReachabilityResponse, error) {
+	var resp *matchingservice.GetBuildIdReachabilityResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetBuildIdReachability(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetBuildIdTaskQueueMapping(
 	ctx context.Context,
 	request *matchingservice.GetBuildIdTaskQueueMappingRequest,
@@ -140,6 +215,51 @@ func (c *retryableClient) GetBuildIdTaskQueueMapping(
 	return resp, err
 }
 
+func (c *retryableClient) GetClosedWorkflowBuildId(
+	ctx context.Context,
+	request *matchingservice.GetClosedWorkflowBuildIdRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.GetClosedWorkflowBuildIdResponse, error) {
+	var resp *matchingservice.GetClosedWorkflowBuildIdResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetClosedWorkflowBuildId(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetDefaultBuildIdTimeline(
+	ctx context.Context,
+	request *matchingservice.GetDefaultBuildIdTimelineRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.GetDefaultBuildIdTimelineResponse, error) {
+	var resp *matchingservice.GetDefaultBuildIdTimelineResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetDefaultBuildIdTimeline(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) GetTaskDispatchDecision(
+	ctx context.Context,
+	request *matchingservice.GetTaskDispatchDecisionRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.GetTaskDispatchDecisionResponse, error) {
+	var resp *matchingservice.GetTaskDispatchDecisionResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetTaskDispatchDecision(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.GetTaskQueueUserDataRequest,
@@ -155,6 +275,21 @@ func (c *retryableClient) GetTaskQueueUserData(
 	return resp, err
 }
 
+func (c *retryableClient) GetUserDataPropagationStatus(
+	ctx context.Context,
+	request *matchingservice.GetUserDataPropagationStatusRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.GetUserDataPropagationStatusResponse, error) {
+	var resp *matchingservice.GetUserDataPropagationStatusResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetUserDataPropagationStatus(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetWorkerBuildIdCompatibility(
 	ctx context.Context,
 	request *matchingservice.GetWorkerBuildIdCompatibilityRequest,
@@ -230,6 +365,36 @@ func (c *retryableClient) QueryWorkflow(
 	return resp, err
 }
 
+func (c *retryableClient) ListWorkerBuildIdCompatibility(
+	ctx context.Context,
+	request *matchingservice.ListWorkerBuildIdCompatibilityRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.ListWorkerBuildIdCompatibilityResponse, error) {
+	var resp *matchingservice.ListWorkerBuildIdCompatibilityResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListWorkerBuildIdCompatibility(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ReassignBuildId(
+	ctx context.Context,
+	request *matchingservice.ReassignBuildIdRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.ReassignBuildIdResponse, error) {
+	var resp *matchingservice.ReassignBuildIdResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ReassignBuildId(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ReplicateTaskQueueUserData(
 	ctx context.Context,
 	request *matchingservice.ReplicateTaskQueueUserDataRequest,
@@ -289,3 +454,18 @@ func (c *retryableClient) UpdateWorkerBuildIdCompatibility(
 	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) ValidateDefaultBuildIdSwitch(
+	ctx context.Context,
+	request *matchingservice.ValidateDefaultBuildIdSwitchRequest,
+	opts ...grpc.CallOption,
+) (*matchingservice.ValidateDefaultBuildIdSwitchResponse, error) {
+	var resp *matchingservice.ValidateDefaultBuildIdSwitchResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ValidateDefaultBuildIdSwitch(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
@@ -102,6 +102,8 @@ var (
 		"client.matching.PollActivityTaskQueue": true,
 		"client.matching.PollWorkflowTaskQueue": true,
 		"client.matching.QueryWorkflow":         true,
+		// this falls back to a deprecated field for routing.
+		"client.matching.UpdateWorkerBuildIdCompatibility": true,
 		// these do forwarding stats. too complicated.
 		"metricsClient.matching.AddActivityTask":       true,
 		"metricsClient.matching.AddWorkflowTask":       true,
@@ -185,7 +187,9 @@ func makeGetMatchingClient(reqType reflect.Type) string {
 
 	var tqtPath string
 	switch t.Name() {
-	case "GetBuildIdTaskQueueMappingRequest":
+	case "GetBuildIdTaskQueueMappingRequest",
+		"GetUserDataPropagationStatusRequest",
+		"ListWorkerBuildIdCompatibilityRequest":
 		// Pick a random node for this request, it's not associated with a specific task queue.
 		tqPath = "&taskqueuepb.TaskQueue{Name: fmt.Sprintf(\"not-applicable-%s\", rand.Int())}"
 		tqtPath = "enumspb.TASK_QUEUE_TYPE_UNSPECIFIED"
@@ -197,10 +201,18 @@ func makeGetMatchingClient(reqType reflect.Type) string {
 		tqtPath = "enumspb.TASK_QUEUE_TYPE_UNSPECIFIED"
 		return fmt.Sprintf("client, err := c.getClientForTaskqueue(%s, %s, %s)", nsIDPath, tqPath, tqtPath)
 	case "GetWorkerBuildIdCompatibilityRequest",
-		"UpdateWorkerBuildIdCompatibilityRequest",
 		"RespondQueryTaskCompletedRequest",
 		"ListTaskQueuePartitionsRequest",
-		"ApplyTaskQueueUserDataReplicationEventRequest":
+		"ApplyTaskQueueUserDataReplicationEventRequest",
+		"DescribeVersioningRequest",
+		"CleanupUnreachableBuildIdsRequest",
+		"GetDefaultBuildIdTimelineRequest",
+		"ValidateDefaultBuildIdSwitchRequest",
+		"GetClosedWorkflowBuildIdRequest",
+		"ApplyVersioningTemplateRequest",
+		"ReassignBuildIdRequest",
+		"EnableWorkerVersioningRequest",
+		"GetBuildIdReachabilityRequest":
 		tqtPath = "enumspb.TASK_QUEUE_TYPE_WORKFLOW"
 	default:
 		tqtPath = pathToField(t, "TaskQueueType", "request", 2)
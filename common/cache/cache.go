@@ -26,6 +26,8 @@ package cache
 
 import (
 	"time"
+
+	"go.temporal.io/server/common/clock"
 )
 
 // A Cache is a generalized interface to a cache.  See cache.LRU for a specific
@@ -66,6 +68,10 @@ type Options struct {
 
 	// Pin prevents in-use objects from getting evicted.
 	Pin bool
+
+	// TimeSource is used to determine the create time and expiry of cache entries,
+	// defaults to the wall clock
+	TimeSource clock.TimeSource
 }
 
 // SimpleOptions provides options that can be used to configure SimpleCache
@@ -29,6 +29,8 @@ import (
 	"errors"
 	"sync"
 	"time"
+
+	"go.temporal.io/server/common/clock"
 )
 
 var (
@@ -39,12 +41,13 @@ var (
 // lru is a concurrent fixed size cache that evicts elements in lru order
 type (
 	lru struct {
-		mut      sync.Mutex
-		byAccess *list.List
-		byKey    map[interface{}]*list.Element
-		maxSize  int
-		ttl      time.Duration
-		pin      bool
+		mut        sync.Mutex
+		byAccess   *list.List
+		byKey      map[interface{}]*list.Element
+		maxSize    int
+		ttl        time.Duration
+		pin        bool
+		timeSource clock.TimeSource
 	}
 
 	iteratorImpl struct {
@@ -109,7 +112,7 @@ func (c *lru) Iterator() Iterator {
 	c.mut.Lock()
 	iterator := &iteratorImpl{
 		lru:        c,
-		createTime: time.Now().UTC(),
+		createTime: c.timeSource.Now().UTC(),
 		nextItem:   c.byAccess.Front(),
 	}
 	iterator.prepareNext()
@@ -134,12 +137,18 @@ func New(maxSize int, opts *Options) Cache {
 		opts = &Options{}
 	}
 
+	timeSource := opts.TimeSource
+	if timeSource == nil {
+		timeSource = clock.NewRealTimeSource()
+	}
+
 	return &lru{
-		byAccess: list.New(),
-		byKey:    make(map[interface{}]*list.Element, opts.InitialCapacity),
-		ttl:      opts.TTL,
-		maxSize:  maxSize,
-		pin:      opts.Pin,
+		byAccess:   list.New(),
+		byKey:      make(map[interface{}]*list.Element, opts.InitialCapacity),
+		ttl:        opts.TTL,
+		maxSize:    maxSize,
+		pin:        opts.Pin,
+		timeSource: timeSource,
 	}
 }
 
@@ -172,7 +181,7 @@ func (c *lru) Get(key interface{}) interface{} {
 
 	entry := element.Value.(*entryImpl)
 
-	if c.isEntryExpired(entry, time.Now().UTC()) {
+	if c.isEntryExpired(entry, c.timeSource.Now().UTC()) {
 		// Entry has expired
 		c.deleteInternal(element)
 		return nil
@@ -259,7 +268,7 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 	elt := c.byKey[key]
 	if elt != nil {
 		entry := elt.Value.(*entryImpl)
-		if c.isEntryExpired(entry, time.Now().UTC()) {
+		if c.isEntryExpired(entry, c.timeSource.Now().UTC()) {
 			// Entry has expired
 			c.deleteInternal(elt)
 		} else {
@@ -267,7 +276,7 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 			if allowUpdate {
 				entry.value = value
 				if c.ttl != 0 {
-					entry.createTime = time.Now().UTC()
+					entry.createTime = c.timeSource.Now().UTC()
 				}
 			}
 
@@ -289,7 +298,7 @@ func (c *lru) putInternal(key interface{}, value interface{}, allowUpdate bool)
 	}
 
 	if c.ttl != 0 {
-		entry.createTime = time.Now().UTC()
+		entry.createTime = c.timeSource.Now().UTC()
 	}
 
 	if len(c.byKey) >= c.maxSize {
@@ -30,6 +30,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"go.temporal.io/server/common/clock"
 )
 
 type keyType struct {
@@ -165,6 +167,24 @@ func TestTTL(t *testing.T) {
 	assert.Nil(t, cache.Get("A"))
 }
 
+func TestTTLWithTimeSource(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	cache := New(5, &Options{
+		TTL:        time.Minute,
+		TimeSource: timeSource,
+	})
+
+	cache.Put("A", t)
+	it := cache.Iterator()
+	assert.Equal(t, timeSource.Now().UTC(), it.Next().CreateTime())
+	it.Close()
+
+	timeSource.Update(timeSource.Now().Add(time.Minute / 2))
+	assert.Equal(t, t, cache.Get("A"))
+	timeSource.Update(timeSource.Now().Add(time.Minute))
+	assert.Nil(t, cache.Get("A"))
+}
+
 func TestTTLWithPin(t *testing.T) {
 	cache := New(5, &Options{
 		TTL: time.Millisecond * 50,
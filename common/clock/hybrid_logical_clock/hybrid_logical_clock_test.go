@@ -25,6 +25,10 @@
 package hybrid_logical_clock
 
 import (
+	"bytes"
+	"math"
+	"math/rand"
+	"sort"
 	"testing"
 	"time"
 
@@ -46,6 +50,55 @@ func Test_Next_ReturnsGreaterClock(t *testing.T) {
 	assert.Equal(t, Compare(t1, t2), 1)
 }
 
+func Test_NextWithBackwardJumpDetection_ReturnsJump(t *testing.T) {
+	now := time.Now().UTC()
+	timesource := commonclock.NewEventTimeSource()
+	timesource.Update(now)
+	t0 := ZeroAt(now, 1)
+
+	// Physical time moving forward is no jump
+	timesource.Update(now.Add(time.Second))
+	t1, jump := NextWithBackwardJumpDetection(t0, timesource)
+	assert.True(t, Greater(t1, t0))
+	assert.Zero(t, jump)
+
+	// Backward jump is returned but the clock stays monotonic
+	timesource.Update(now.Add(-time.Hour))
+	t2, jump := NextWithBackwardJumpDetection(t1, timesource)
+	assert.True(t, Greater(t2, t1))
+	assert.Equal(t, t1.WallClock, t2.WallClock)
+	assert.Equal(t, time.Hour+time.Second, jump)
+}
+
+func Test_ZeroAt_GreaterThanZero(t *testing.T) {
+	now := time.Now().UTC()
+	t0 := ZeroAt(now, 1)
+	assert.Equal(t, now.UnixMilli(), t0.WallClock)
+	assert.True(t, Greater(t0, Zero(1)))
+	assert.True(t, Greater(t0, Zero(math.MaxInt64)))
+}
+
+func Test_ZeroAt_NextIsMonotonic(t *testing.T) {
+	now := time.Now().UTC()
+	timesource := commonclock.NewEventTimeSource()
+	timesource.Update(now)
+
+	prev := ZeroAt(now, 1)
+	for i := 0; i < 3; i++ {
+		next := Next(prev, timesource)
+		assert.True(t, Greater(next, prev))
+		prev = next
+	}
+	// Time moving backwards does not move the clock backwards
+	timesource.Update(now.Add(-time.Second))
+	next := Next(prev, timesource)
+	assert.True(t, Greater(next, prev))
+	prev = next
+	timesource.Update(now.Add(time.Millisecond))
+	next = Next(prev, timesource)
+	assert.True(t, Greater(next, prev))
+}
+
 func Test_Compare(t *testing.T) {
 	var t0 Clock
 	var t1 Clock
@@ -73,6 +126,26 @@ func Test_Compare(t *testing.T) {
 	assert.True(t, Less(t0, t1))
 }
 
+func Test_CompareVerbose(t *testing.T) {
+	base := Clock{WallClock: 1, Version: 1, ClusterId: 1}
+
+	result, decidedBy := CompareVerbose(base, base)
+	assert.Equal(t, 0, result)
+	assert.Equal(t, "", decidedBy)
+
+	result, decidedBy = CompareVerbose(base, Clock{WallClock: 1, Version: 1, ClusterId: 2})
+	assert.Equal(t, 1, result)
+	assert.Equal(t, DecidedByClusterID, decidedBy)
+
+	result, decidedBy = CompareVerbose(Clock{WallClock: 1, Version: 2, ClusterId: 1}, Clock{WallClock: 1, Version: 1, ClusterId: 2})
+	assert.Equal(t, -1, result)
+	assert.Equal(t, DecidedByVersion, decidedBy)
+
+	result, decidedBy = CompareVerbose(base, Clock{WallClock: 2, Version: 0, ClusterId: 0})
+	assert.Equal(t, 1, result)
+	assert.Equal(t, DecidedByWallClock, decidedBy)
+}
+
 func Test_Max_ReturnsMaximum(t *testing.T) {
 	t0 := Zero(1)
 	t1 := Zero(2)
@@ -83,3 +156,156 @@ func Test_Max_ReturnsMaximum(t *testing.T) {
 	max = Max(t1, t0)
 	assert.Equal(t, max, t1)
 }
+
+func Test_InRange(t *testing.T) {
+	low := Clock{WallClock: 1, Version: 1, ClusterId: 1}
+	high := Clock{WallClock: 2, Version: 0, ClusterId: 1}
+
+	// Bounds are inclusive
+	assert.True(t, InRange(low, low, high))
+	assert.True(t, InRange(high, low, high))
+	assert.True(t, InRange(Clock{WallClock: 1, Version: 5, ClusterId: 1}, low, high))
+	assert.True(t, InRange(low, low, low))
+
+	assert.False(t, InRange(Clock{WallClock: 1, Version: 1, ClusterId: 0}, low, high))
+	assert.False(t, InRange(Clock{WallClock: 2, Version: 0, ClusterId: 2}, low, high))
+
+	// Inverted bounds are an empty range
+	assert.False(t, InRange(low, high, low))
+	assert.False(t, InRange(high, high, low))
+}
+
+type clocked struct {
+	clock *Clock
+}
+
+func (c clocked) GetClock() *Clock {
+	return c.clock
+}
+
+func Test_FilterRange(t *testing.T) {
+	mk := func(wallClock int64) clocked {
+		return clocked{clock: &Clock{WallClock: wallClock, ClusterId: 1}}
+	}
+	items := []clocked{mk(3), mk(0), mk(1), {}, mk(2), mk(4)}
+	low := *mk(1).clock
+	high := *mk(3).clock
+
+	// Items exactly on the bounds are included, order is preserved and items without a clock are skipped
+	assert.Equal(t, []clocked{mk(3), mk(1), mk(2)}, FilterRange(items, low, high))
+	assert.Equal(t, []clocked{mk(1)}, FilterRange(items, low, low))
+	assert.Empty(t, FilterRange(items, high, low))
+	assert.Empty(t, FilterRange([]clocked(nil), low, high))
+}
+
+func Test_EncodeBytes_RoundTrips(t *testing.T) {
+	for _, clock := range []Clock{
+		Zero(1),
+		{WallClock: 1680000000000, Version: 3, ClusterId: 2},
+		{WallClock: -1, Version: -1, ClusterId: -1},
+		{WallClock: math.MaxInt64, Version: math.MaxInt32, ClusterId: math.MaxInt64},
+		{WallClock: math.MinInt64, Version: math.MinInt32, ClusterId: math.MinInt64},
+	} {
+		encoded := EncodeBytes(clock)
+		assert.Len(t, encoded, EncodedSize)
+		decoded, err := DecodeBytes(encoded)
+		assert.NoError(t, err)
+		assert.Equal(t, clock, decoded)
+	}
+
+	_, err := DecodeBytes(make([]byte, EncodedSize-1))
+	assert.Error(t, err)
+}
+
+func Test_EncodeBytes_PreservesOrder(t *testing.T) {
+	clocks := []Clock{
+		{WallClock: 2, Version: 0, ClusterId: 1},
+		{WallClock: 1, Version: 1, ClusterId: 2},
+		{WallClock: 1, Version: 1, ClusterId: 1},
+		{WallClock: 1, Version: 2, ClusterId: 1},
+		{WallClock: 0, Version: 5, ClusterId: 3},
+		{WallClock: -1, Version: 0, ClusterId: 1},
+		{WallClock: 1, Version: -1, ClusterId: 1},
+		{WallClock: 1, Version: 1, ClusterId: -1},
+		{WallClock: 1680000000000, Version: 0, ClusterId: 1},
+	}
+
+	byCompare := make([]Clock, len(clocks))
+	copy(byCompare, clocks)
+	sort.Slice(byCompare, func(i, j int) bool { return Compare(byCompare[i], byCompare[j]) > 0 })
+
+	encoded := make([][]byte, len(clocks))
+	for i, clock := range clocks {
+		encoded[i] = EncodeBytes(clock)
+	}
+	sort.Slice(encoded, func(i, j int) bool { return bytes.Compare(encoded[i], encoded[j]) < 0 })
+
+	for i, b := range encoded {
+		decoded, err := DecodeBytes(b)
+		assert.NoError(t, err)
+		assert.Equal(t, byCompare[i], decoded)
+	}
+
+	for _, a := range clocks {
+		for _, b := range clocks {
+			assert.Equal(t, Compare(a, b), -bytes.Compare(EncodeBytes(a), EncodeBytes(b)))
+		}
+	}
+}
+
+func Test_ClusterTag_OnlyClusterID(t *testing.T) {
+	timesource := commonclock.NewEventTimeSource()
+	timesource.Update(time.Unix(1234, 0).UTC())
+	t0 := ZeroAt(timesource.Now(), 42)
+	t1 := Next(t0, timesource)
+	timesource.Update(time.Unix(5678, 0).UTC())
+	t2 := Next(t1, timesource)
+
+	assert.Equal(t, "42", ClusterTag(t0))
+	assert.Equal(t, ClusterTag(t0), ClusterTag(t1))
+	assert.Equal(t, ClusterTag(t0), ClusterTag(t2))
+	assert.NotEqual(t, ClusterTag(t0), ClusterTag(Zero(43)))
+}
+
+func Test_ShuffleSeed_Stable(t *testing.T) {
+	clock := Clock{WallClock: 1680000000000, Version: 3, ClusterId: 2}
+	assert.Equal(t, ShuffleSeed(clock), ShuffleSeed(Clock{WallClock: 1680000000000, Version: 3, ClusterId: 2}))
+
+	shuffle := func(seed int64) []int {
+		return rand.New(rand.NewSource(seed)).Perm(16)
+	}
+	assert.Equal(t, shuffle(ShuffleSeed(clock)), shuffle(ShuffleSeed(clock)))
+
+	// every field contributes to the seed
+	assert.NotEqual(t, ShuffleSeed(clock), ShuffleSeed(Clock{WallClock: 1680000000001, Version: 3, ClusterId: 2}))
+	assert.NotEqual(t, ShuffleSeed(clock), ShuffleSeed(Clock{WallClock: 1680000000000, Version: 4, ClusterId: 2}))
+	assert.NotEqual(t, ShuffleSeed(clock), ShuffleSeed(Clock{WallClock: 1680000000000, Version: 3, ClusterId: 3}))
+}
+
+func Test_ShuffleSeed_WellDistributed(t *testing.T) {
+	const (
+		clocks     = 4096
+		partitions = 8
+	)
+	timesource := commonclock.NewEventTimeSource()
+	timesource.Update(time.Unix(1234, 0).UTC())
+	clock := ZeroAt(timesource.Now(), 1)
+
+	seeds := make(map[int64]struct{}, clocks)
+	counts := make([]int, partitions)
+	for i := 0; i < clocks; i++ {
+		// consecutive clocks mostly differ in the version only
+		clock = Next(clock, timesource)
+		if i%64 == 0 {
+			timesource.Update(timesource.Now().Add(time.Millisecond))
+		}
+		seed := ShuffleSeed(clock)
+		seeds[seed] = struct{}{}
+		counts[rand.New(rand.NewSource(seed)).Intn(partitions)]++
+	}
+
+	assert.Len(t, seeds, clocks)
+	for partition, count := range counts {
+		assert.InDelta(t, clocks/partitions, count, clocks/partitions/4, "partition %d", partition)
+	}
+}
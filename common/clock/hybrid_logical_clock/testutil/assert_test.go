@@ -0,0 +1,81 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package testutil
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	commonclock "go.temporal.io/server/common/clock"
+	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
+)
+
+type recordingT struct {
+	errors []string
+}
+
+func (r *recordingT) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func Test_AssertMonotonic_Increasing(t *testing.T) {
+	timeSource := commonclock.NewEventTimeSource()
+	timeSource.Update(time.Unix(0, 0).UTC())
+	clocks := []hlc.Clock{hlc.Zero(1)}
+	for i := 0; i < 5; i++ {
+		if i%2 == 1 {
+			timeSource.Update(timeSource.Now().Add(time.Millisecond))
+		}
+		clocks = append(clocks, hlc.Next(clocks[len(clocks)-1], timeSource))
+	}
+
+	rt := &recordingT{}
+	assert.True(t, AssertMonotonic(rt, clocks))
+	assert.Empty(t, rt.errors)
+}
+
+func Test_AssertMonotonic_Regression(t *testing.T) {
+	clocks := []hlc.Clock{
+		{WallClock: 1, Version: 0, ClusterId: 1},
+		{WallClock: 1, Version: 1, ClusterId: 1},
+		{WallClock: 1, Version: 1, ClusterId: 1},
+		{WallClock: 2, Version: 0, ClusterId: 1},
+	}
+
+	rt := &recordingT{}
+	assert.False(t, AssertMonotonic(rt, clocks))
+	assert.Len(t, rt.errors, 1)
+	assert.Contains(t, rt.errors[0], "index 2")
+
+	rt = &recordingT{}
+	assert.False(t, AssertMonotonic(rt, []hlc.Clock{
+		{WallClock: 2, Version: 0, ClusterId: 1},
+		{WallClock: 1, Version: 5, ClusterId: 1},
+	}))
+	assert.Len(t, rt.errors, 1)
+}
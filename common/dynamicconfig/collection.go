@@ -85,6 +85,7 @@ type (
 	MapPropertyFnWithNamespaceFilter           func(namespace string) map[string]any
 	StringPropertyFn                           func() string
 	StringPropertyFnWithNamespaceFilter        func(namespace string) string
+	StringPropertyFnWithTaskQueueInfoFilters   func(namespace string, taskQueue string, taskType enumspb.TaskQueueType) string
 )
 
 const (
@@ -347,6 +348,19 @@ func (c *Collection) GetStringPropertyFnWithNamespaceFilter(key Key, defaultValu
 	}
 }
 
+// GetStringPropertyFilteredByTaskQueueInfo gets property with taskQueueInfo as filters and asserts that it's a string
+func (c *Collection) GetStringPropertyFilteredByTaskQueueInfo(key Key, defaultValue any) StringPropertyFnWithTaskQueueInfoFilters {
+	return func(namespace string, taskQueue string, taskType enumspb.TaskQueueType) string {
+		return matchAndConvert(
+			c,
+			key,
+			defaultValue,
+			taskQueuePrecedence(namespace, taskQueue, taskType),
+			convertString,
+		)
+	}
+}
+
 // GetMapPropertyFnWithNamespaceFilter gets property and asserts that it's a map
 func (c *Collection) GetMapPropertyFnWithNamespaceFilter(key Key, defaultValue any) MapPropertyFnWithNamespaceFilter {
 	return func(namespace string) map[string]interface{} {
@@ -53,6 +53,7 @@ const (
 	testGetDurationPropertyStructuredDefaults         = "testGetDurationPropertyStructuredDefaults"
 	testGetBoolPropertyFilteredByNamespaceIDKey       = "testGetBoolPropertyFilteredByNamespaceIDKey"
 	testGetBoolPropertyFilteredByTaskQueueInfoKey     = "testGetBoolPropertyFilteredByTaskQueueInfoKey"
+	testGetStringPropertyFilteredByTaskQueueInfoKey   = "testGetStringPropertyFilteredByTaskQueueInfoKey"
 )
 
 // Note: fileBasedClientSuite also heavily tests Collection, since some tests are easier with data
@@ -97,6 +98,15 @@ func (s *collectionSuite) TestGetStringPropertyFnWithNamespaceFilter() {
 	s.Equal("efg", value(namespace))
 }
 
+func (s *collectionSuite) TestGetStringPropertyFilteredByTaskQueueInfo() {
+	namespace := "testNamespace"
+	taskQueue := "testTaskQueue"
+	value := s.cln.GetStringPropertyFilteredByTaskQueueInfo(testGetStringPropertyFilteredByTaskQueueInfoKey, "abc")
+	s.Equal("abc", value(namespace, taskQueue, 0))
+	s.client[testGetStringPropertyFilteredByTaskQueueInfoKey] = "efg"
+	s.Equal("efg", value(namespace, taskQueue, 0))
+}
+
 func (s *collectionSuite) TestGetIntPropertyFilteredByTaskQueueInfo() {
 	namespace := "testNamespace"
 	taskQueue := "testTaskQueue"
@@ -101,6 +101,10 @@ const (
 	EnableStickyQuery = "system.enableStickyQuery"
 	// EnableActivityEagerExecution indicates if activity eager execution is enabled per namespace
 	EnableActivityEagerExecution = "system.enableActivityEagerExecution"
+	// DisableActivityEagerExecution disables activity eager execution per namespace and task queue even if enabled by
+	// EnableActivityEagerExecution. Eagerly executed activities bypass build id routing, so this is useful for
+	// versioned task queues.
+	DisableActivityEagerExecution = "system.disableActivityEagerExecution"
 	// EnableEagerWorkflowStart toggles "eager workflow start" - returning the first workflow task inline in the
 	// response to a StartWorkflowExecution request and skipping the trip through matching.
 	EnableEagerWorkflowStart = "system.enableEagerWorkflowStart"
@@ -112,8 +116,60 @@ const (
 	PersistenceHealthSignalWindowSize = "system.persistenceHealthSignalWindowSize"
 	// PersistenceHealthSignalBufferSize is the maximum number of persistence signals to buffer in memory per signal key
 	PersistenceHealthSignalBufferSize = "system.persistenceHealthSignalBufferSize"
+	// PersistenceHealthDegradedLatencyThreshold is the average latency above which a persistence store type is
+	// reported as degraded
+	PersistenceHealthDegradedLatencyThreshold = "system.persistenceHealthDegradedLatencyThreshold"
+	// PersistenceHealthDegradedErrorRatioThreshold is the ratio of unhealthy errors above which a persistence store
+	// type is reported as degraded
+	PersistenceHealthDegradedErrorRatioThreshold = "system.persistenceHealthDegradedErrorRatioThreshold"
+	// PersistenceHealthLatencyThreshold is the average latency above which a persistence store type is reported as
+	// unhealthy
+	PersistenceHealthLatencyThreshold = "system.persistenceHealthLatencyThreshold"
+	// PersistenceHealthErrorRatioThreshold is the ratio of unhealthy errors above which a persistence store type is
+	// reported as unhealthy
+	PersistenceHealthErrorRatioThreshold = "system.persistenceHealthErrorRatioThreshold"
+	// PersistenceHealthTransitionInterval is the interval at which persistence health transitions are evaluated and
+	// delivered to the registered callbacks
+	PersistenceHealthTransitionInterval = "system.persistenceHealthTransitionInterval"
 	// ShardRPSWarnLimit is the per-shard RPS limit for warning
 	ShardRPSWarnLimit = "system.shardRPSWarnLimit"
+	// PersistenceShedLatencyThreshold is the average persistence latency above which low priority (background and
+	// preemptable) persistence requests are shed. Shedding is disabled if the value is less or equal to 0
+	PersistenceShedLatencyThreshold = "system.persistenceShedLatencyThreshold"
+	// PersistenceVisibilityMaxQPS is the max QPS of the persistence requests made to process visibility tasks. They
+	// are limited separately from, and don't count against, the other persistence requests of the host. Zero shares
+	// the host's persistence QPS between them.
+	PersistenceVisibilityMaxQPS = "system.persistenceVisibilityMaxQPS"
+	// PersistenceNamespacePriorityFloor is the lowest persistence request priority (highest value) assigned to requests
+	// made on behalf of a namespace, regardless of caller type. Lower values mean higher priority.
+	PersistenceNamespacePriorityFloor = "system.persistenceNamespacePriorityFloor"
+	// PersistenceSlowStartDuration is the warm-up duration after process start over which the persistence rate
+	// limiter ramps up from a fraction of the max QPS to the max QPS. Slow start is disabled if the value is less or
+	// equal to 0
+	PersistenceSlowStartDuration = "system.persistenceSlowStartDuration"
+	// PersistenceAdaptivePageSizeEnabled enables adapting the page size of background persistence scans (concrete
+	// executions, task queues and task queue user data) to persistence latency
+	PersistenceAdaptivePageSizeEnabled = "system.persistenceAdaptivePageSizeEnabled"
+	// PersistenceAdaptivePageSizeMin is the lower bound of the adaptive page size
+	PersistenceAdaptivePageSizeMin = "system.persistenceAdaptivePageSizeMin"
+	// PersistenceAdaptivePageSizeMax is the upper bound of the adaptive page size
+	PersistenceAdaptivePageSizeMax = "system.persistenceAdaptivePageSizeMax"
+	// PersistenceAdaptivePageSizeLatencyThreshold is the persistence latency below which the adaptive page size grows
+	// and above which it shrinks
+	PersistenceAdaptivePageSizeLatencyThreshold = "system.persistenceAdaptivePageSizeLatencyThreshold"
+	// PersistenceNewImplementationOperations maps persistence store operations (the store method names, e.g.
+	// ReadHistoryBranch) to whether they are served by the new persistence implementation, if one is provided
+	PersistenceNewImplementationOperations = "system.persistenceNewImplementationOperations"
+	// PersistenceUserDataCompressionThreshold is the size in bytes above which serialized task queue user data is
+	// compressed before being persisted. Zero, the default, disables compression. Compression is only set up if the
+	// threshold is positive on startup, later changes of the threshold apply to new writes. Compressed user data is
+	// always readable by this version, but not by older ones: before downgrading, set the threshold to zero and update
+	// the user data of every task queue that had it compressed (e.g. through UpdateWorkerBuildIdCompatibility), which
+	// rewrites it uncompressed.
+	PersistenceUserDataCompressionThreshold = "system.persistenceUserDataCompressionThreshold"
+	// PersistenceSerializerMetricsEnabled enables the latency and size metrics of persistence serialization, per
+	// record type. It is read on startup only.
+	PersistenceSerializerMetricsEnabled = "system.persistenceSerializerMetricsEnabled"
 
 	// Whether the deadlock detector should dump goroutines
 	DeadlockDumpGoroutines = "system.deadlock.DumpGoroutines"
@@ -187,6 +243,13 @@ const (
 	// task queue. Update requests which would cause the versioning data to exceed this number will fail with a
 	// FailedPrecondition error.
 	VersionBuildIdLimitPerQueue = "limit.versionBuildIdLimitPerQueue"
+	// VersionCompatibleBuildIdLimitPerSet is the max number of build IDs allowed in a single compatible set in the
+	// versioning data for a task queue. AddNewCompatibleBuildId requests which would cause a set to exceed this number
+	// will fail with a FailedPrecondition error. Zero (the default) means no per set limit.
+	VersionCompatibleBuildIdLimitPerSet = "limit.versionCompatibleBuildIdLimitPerSet"
+	// VersionBuildIdLabelsSizeLimit is the max total size in bytes of the label keys and values attached to a single
+	// build ID. Requests setting larger labels will fail with an InvalidArgument error. Zero means no limit.
+	VersionBuildIdLabelsSizeLimit = "limit.versionBuildIdLabelsSize"
 	// ReachabilityTaskQueueScanLimit limits the number of task queues to scan when responding to a
 	// GetWorkerTaskReachability query.
 	ReachabilityTaskQueueScanLimit = "limit.reachabilityTaskQueueScan"
@@ -195,6 +258,10 @@ const (
 	ReachabilityQueryBuildIdLimit = "limit.reachabilityQueryBuildIds"
 	// TaskQueuesPerBuildIdLimit limits the number of task queue names that can be mapped to a single build id.
 	TaskQueuesPerBuildIdLimit = "limit.taskQueuesPerBuildId"
+	// TaskQueueUserDataSizeLimit is the max size in bytes of the user data of a task queue. Update requests which would
+	// cause the user data to exceed this size will fail with a FailedPrecondition error. Replication events are not
+	// limited.
+	TaskQueueUserDataSizeLimit = "limit.taskQueueUserDataSize"
 
 	// keys for frontend
 
@@ -224,6 +291,13 @@ const (
 	// FrontendMaxNamespaceVisibilityBurstPerInstance is namespace burst limit for visibility APIs.
 	// This config is EXPERIMENTAL and may be changed or removed in a later release.
 	FrontendMaxNamespaceVisibilityBurstPerInstance = "frontend.namespaceBurst.visibility"
+	// FrontendMaxNamespaceVersioningRPSPerInstance is namespace rate limit per second for the APIs that update the
+	// worker versioning data of task queues, e.g. UpdateWorkerBuildIdCompatibility. These APIs are not subject to
+	// "frontend.namespaceRPS" but default to the same limit when this is not set.
+	FrontendMaxNamespaceVersioningRPSPerInstance = "frontend.namespaceRPS.workerVersioningWrite"
+	// FrontendMaxNamespaceVersioningBurstPerInstance is namespace burst limit for the APIs that update the worker
+	// versioning data of task queues. Defaults to "frontend.namespaceBurst" when not set.
+	FrontendMaxNamespaceVersioningBurstPerInstance = "frontend.namespaceBurst.workerVersioningWrite"
 	// FrontendGlobalNamespaceRPS is workflow namespace rate limit per second for the whole cluster.
 	// The limit is evenly distributed among available frontend service instances.
 	// If this is set, it overwrites per instance limit "frontend.namespaceRPS".
@@ -361,6 +435,9 @@ const (
 	MatchingUpdateAckInterval = "matching.updateAckInterval"
 	// MatchingMaxTaskQueueIdleTime is the time after which an idle task queue will be unloaded
 	MatchingMaxTaskQueueIdleTime = "matching.maxTaskQueueIdleTime"
+	// MatchingPollerHistoryTTL is how long a poller is still reported by DescribeTaskQueue after it was last seen
+	// polling. Changes take effect when the task queue is (re)loaded.
+	MatchingPollerHistoryTTL = "matching.pollerHistoryTTL"
 	// MatchingOutstandingTaskAppendsThreshold is the threshold for outstanding task appends
 	MatchingOutstandingTaskAppendsThreshold = "matching.outstandingTaskAppendsThreshold"
 	// MatchingMaxTaskBatchSize is max batch size for task writer
@@ -373,6 +450,13 @@ const (
 	MatchingNumTaskqueueWritePartitions = "matching.numTaskqueueWritePartitions"
 	// MatchingNumTaskqueueReadPartitions is the number of read partitions for a task queue
 	MatchingNumTaskqueueReadPartitions = "matching.numTaskqueueReadPartitions"
+	// MatchingNumTaskqueueWritePartitionsPerBuildId is a map from build id to the number of write partitions used for
+	// tasks pinned to that build id, overriding MatchingNumTaskqueueWritePartitions. Tasks that don't name a build id,
+	// e.g. the first workflow task of a workflow, always use MatchingNumTaskqueueWritePartitions.
+	MatchingNumTaskqueueWritePartitionsPerBuildId = "matching.numTaskqueueWritePartitionsPerBuildId"
+	// MatchingNumTaskqueueReadPartitionsPerBuildId is a map from build id to the number of read partitions polled by
+	// versioned workers of that build id, overriding MatchingNumTaskqueueReadPartitions
+	MatchingNumTaskqueueReadPartitionsPerBuildId = "matching.numTaskqueueReadPartitionsPerBuildId"
 	// MatchingForwarderMaxOutstandingPolls is the max number of inflight polls from the forwarder
 	MatchingForwarderMaxOutstandingPolls = "matching.forwarderMaxOutstandingPolls"
 	// MatchingForwarderMaxOutstandingTasks is the max number of inflight addTask/queryTask from the forwarder
@@ -385,6 +469,71 @@ const (
 	MatchingShutdownDrainDuration = "matching.shutdownDrainDuration"
 	// MatchingGetUserDataLongPollTimeout is the max length of long polls for GetUserData calls between partitions.
 	MatchingGetUserDataLongPollTimeout = "matching.getUserDataLongPollTimeout"
+	// MatchingRetiredBuildIdTaskTTL is how long a backlogged task may wait for a build id that was drained or deleted
+	// before it is expired (or re-routed, see MatchingRerouteExpiredRetiredBuildIdTasks). Disabled if 0.
+	MatchingRetiredBuildIdTaskTTL = "matching.retiredBuildIdTaskTTL"
+	// MatchingRerouteExpiredRetiredBuildIdTasks controls whether tasks that exceeded MatchingRetiredBuildIdTaskTTL
+	// are re-routed to the default version set instead of being dropped
+	MatchingRerouteExpiredRetiredBuildIdTasks = "matching.rerouteExpiredRetiredBuildIdTasks"
+	// MatchingUserDataConsistencyCheckInterval is how often non-root partitions compare their user data against the
+	// root partition. Disabled if 0. Changes take effect when the task queue is (re)loaded.
+	MatchingUserDataConsistencyCheckInterval = "matching.userDataConsistencyCheckInterval"
+	// MatchingUserDataDivergenceGracePeriod is how long a partition's user data may disagree with the root partition
+	// before it is reported as divergent
+	MatchingUserDataDivergenceGracePeriod = "matching.userDataDivergenceGracePeriod"
+	// MatchingRepairDivergentUserData controls whether partitions with divergent user data replace it with the root
+	// partition's copy
+	MatchingRepairDivergentUserData = "matching.repairDivergentUserData"
+	// MatchingUserDataMinPropagationInterval is how long a partition waits after its user data changed before
+	// answering the long polls of its child partitions, so that a burst of versioning updates propagates as one wave
+	MatchingUserDataMinPropagationInterval = "matching.userDataMinPropagationInterval"
+	// MatchingPauseUserDataPropagation stops non-root partitions from fetching task queue user data (versioning
+	// updates) from their parent, e.g. during a maintenance window. Updates are still accepted at the root partition
+	// and propagate once this is turned off again.
+	MatchingPauseUserDataPropagation = "matching.pauseUserDataPropagation"
+	// MatchingBuildIdDispatchWeights is a map from build id to a relative dispatch weight. Build ids with a positive
+	// weight may poll alongside the default of their compatible set, and tasks of the set are split between them in
+	// proportion to their weights. Build ids without a weight keep the default behavior.
+	MatchingBuildIdDispatchWeights = "matching.buildIdDispatchWeights"
+	// MatchingStickyScheduleToStartTimeoutPerBuildId is a map from build id to a schedule-to-start timeout for the
+	// workflow tasks of its sticky queues, e.g. to quickly push tasks back to the normal queue while the build id is
+	// being drained. Matching waits at most that long for a sticky poller of the build id to pick a task up before
+	// bouncing it back to the normal queue, instead of spooling it until the workflow's sticky timeout.
+	MatchingStickyScheduleToStartTimeoutPerBuildId = "matching.stickyScheduleToStartTimeoutPerBuildId"
+	// MatchingEvictOutdatedStickyPollers controls whether sticky queues cancel the outstanding polls of build ids that
+	// stopped being the default of their compatible set as soon as they learn about the new default, so those workers
+	// move their workflows to the newer build id right away instead of after the sticky schedule-to-start timeout.
+	MatchingEvictOutdatedStickyPollers = "matching.evictOutdatedStickyPollers"
+	// MatchingBuildIdDispatchRatePerPoller limits the rate at which tasks of a versioned queue are dispatched to the
+	// pollers of each build id to this many tasks per second for every poller of that build id that polled within the
+	// last long poll interval, so build ids with few pollers are not handed tasks faster than they can process them.
+	// Disabled if 0.
+	MatchingBuildIdDispatchRatePerPoller = "matching.buildIdDispatchRatePerPoller"
+	// MatchingActivityDefaultBuildId is a build id whose compatible set receives the activity tasks of a task queue
+	// that request the task queue default, instead of the default set. Ignored if empty or unknown to the task queue.
+	MatchingActivityDefaultBuildId = "matching.activityDefaultBuildId"
+	// MatchingActivityVersioningIntentWins decides how activity tasks that request a build id compatible with their
+	// workflow's (VersioningIntentCompatible) are dispatched when MatchingActivityDefaultBuildId is set: if true the
+	// intent wins and they stay on their workflow's compatible set, otherwise they go to the activity default.
+	MatchingActivityVersioningIntentWins = "matching.activityVersioningIntentWins"
+	// MatchingVersioningTemplates is a map from template name to the compatible version sets that
+	// ApplyVersioningTemplate creates on a task queue. Each set is a list of build ids, and both the sets and the build
+	// ids within a set are ordered oldest to newest, so the last build id of the last set becomes the queue default.
+	MatchingVersioningTemplates = "matching.versioningTemplates"
+	// MatchingHybridLogicalClockBackwardJumpThreshold is how far the physical time may be behind the wall clock of a
+	// task queue's user data before generating its next clock warns about a backward clock jump. Disabled if 0.
+	MatchingHybridLogicalClockBackwardJumpThreshold = "matching.hybridLogicalClockBackwardJumpThreshold"
+	// MatchingVersioningAuditLogRetention is how long, by the wall clock of their HLC timestamps, entries of the
+	// versioning audit log of a task queue are kept. Older entries are compacted into a checkpoint of the task queue
+	// default when the versioning data is next updated. Disabled if 0.
+	MatchingVersioningAuditLogRetention = "matching.versioningAuditLogRetention"
+	// MatchingBuildIdScavengerInterval is how often the root partition of a task queue looks for compatible sets that
+	// have not been the task queue default for longer than MatchingBuildIdScavengerRetention and deletes their build
+	// ids if no workflows can reach them anymore. Disabled if 0. Changes take effect when the task queue is (re)loaded.
+	MatchingBuildIdScavengerInterval = "matching.buildIdScavengerInterval"
+	// MatchingBuildIdScavengerRetention is how long a compatible set must not have been the task queue default before
+	// the build id scavenger may delete its build ids, see MatchingBuildIdScavengerInterval
+	MatchingBuildIdScavengerRetention = "matching.buildIdScavengerRetention"
 
 	// for matching testing only:
 
@@ -394,6 +543,8 @@ const (
 	TestMatchingLBForceReadPartition = "test.matching.lbForceReadPartition"
 	// TestMatchingLBForceWritePartition forces adds to go to a specific partition
 	TestMatchingLBForceWritePartition = "test.matching.lbForceWritePartition"
+	// TestMatchingDisableUserDataPropagation stops non-root partitions from applying user data fetched from their parent
+	TestMatchingDisableUserDataPropagation = "test.matching.disableUserDataPropagation"
 
 	// keys for history
 
@@ -459,6 +610,42 @@ const (
 	QueuePendingTaskMaxCount = "history.queuePendingTasksMaxCount"
 	// QueueMaxReaderCount is the max number of readers in one multi-cursor queue
 	QueueMaxReaderCount = "history.queueMaxReaderCount"
+	// QueueErrorLogSampleRates maps the class of a task processing error (workflow_busy or resource_exhausted) to N,
+	// such that only one in N errors of that class is logged. Other errors are always logged.
+	QueueErrorLogSampleRates = "history.queueErrorLogSampleRates"
+	// QueueExecutableSnapshotEnabled enables logging a snapshot of the loaded executables of each queue on every
+	// checkpoint, to help analyzing what was in flight when a shard crashed
+	QueueExecutableSnapshotEnabled = "history.queueExecutableSnapshotEnabled"
+	// QueueCircuitBreakerFailureThreshold is the number of consecutive failed attempts of the tasks of a task type
+	// after which a shard stops executing tasks of that type for QueueCircuitBreakerOpenDuration, then lets one task
+	// through at a time to probe whether the failures are over. Disabled if 0.
+	QueueCircuitBreakerFailureThreshold = "history.queueCircuitBreakerFailureThreshold"
+	// QueueCircuitBreakerOpenDuration is how long the tasks of a task type are held back after its circuit breaker
+	// opened or a probe failed, see QueueCircuitBreakerFailureThreshold
+	QueueCircuitBreakerOpenDuration = "history.queueCircuitBreakerOpenDuration"
+	// QueueDLQMaxAttempts is the number of failed attempts after which a task is moved from its queue to the history
+	// task DLQ, so that it stops blocking the queue. The task can then be inspected, merged back or purged with the
+	// admin DLQ APIs. Disabled if 0.
+	QueueDLQMaxAttempts = "history.queueDLQMaxAttempts"
+	// QueueExecutableAttemptTimeout is the deadline of each attempt to execute a task. The context of an attempt
+	// running past it is cancelled, so that an executor stuck e.g. on a hanging persistence call releases its worker
+	// and the task is rescheduled. Disabled if 0.
+	QueueExecutableAttemptTimeout = "history.queueExecutableAttemptTimeout"
+	// QueueStandbyTaskMinClockEnabled defers the tasks of a namespace which is not active in this cluster until the
+	// shard has received the replicated updates of its active cluster up to the visibility time of the task, instead
+	// of executing them only for the standby verification to retry them
+	QueueStandbyTaskMinClockEnabled = "history.queueStandbyTaskMinClockEnabled"
+	// QueueSequentialExecutionEnabled makes the tasks of the same workflow in a queue execute one at a time, in the
+	// order of their task keys, while tasks of different workflows still execute concurrently
+	QueueSequentialExecutionEnabled = "history.queueSequentialExecutionEnabled"
+	// QueueRangeCompleteInterval is the minimum interval between two deletions of the tasks acked by all readers of a
+	// queue. Acks from checkpoints in between are coalesced into a single range deletion, reducing the number of
+	// persistence calls. Tasks are deleted on every checkpoint if 0.
+	QueueRangeCompleteInterval = "history.queueRangeCompleteInterval"
+	// QueueLowPriorityAdmissionDelay is how long low priority tasks are delayed before being submitted to the task
+	// scheduler when a queue has QueuePendingTaskMaxCount pending tasks. The delay is proportional to the number of
+	// pending tasks, so it only kicks in under load. 0 disables the delay.
+	QueueLowPriorityAdmissionDelay = "history.queueLowPriorityAdmissionDelay"
 	// ContinueAsNewMinInterval is the minimal interval between continue_as_new executions.
 	// This is needed to prevent tight loop continue_as_new spin. Default is 1s.
 	ContinueAsNewMinInterval = "history.continueAsNewMinInterval"
@@ -476,6 +663,30 @@ const (
 	// TaskSchedulerNamespaceMaxQPS is the max qps task schedulers on a host can schedule tasks for a certain namespace
 	// If value less or equal to 0, will fall back to HistoryPersistenceNamespaceMaxQPS
 	TaskSchedulerNamespaceMaxQPS = "history.taskSchedulerNamespaceMaxQPS"
+	// TaskSchedulerNamespacePriorityMultiplier scales the weight of the priority of the task type of the history
+	// tasks of a namespace in the active round robin weights of their queue, e.g.
+	// TransferProcessorSchedulerActiveRoundRobinWeights. The tasks are then processed at the priority whose weight is
+	// the closest to the result, e.g. a multiplier of 10 promotes low priority tasks to high priority with the default
+	// weights. 1 keeps the task type priority.
+	TaskSchedulerNamespacePriorityMultiplier = "history.taskSchedulerNamespacePriorityMultiplier"
+	// TaskSchedulerNamespacePriorityOverride is a map from history task category (e.g. "transfer" or "timer") to the
+	// priority ("high" or "low") the tasks of that category are processed at for a namespace, e.g. to demote a noisy
+	// namespace's tasks. It takes precedence over TaskSchedulerNamespacePriorityMultiplier.
+	TaskSchedulerNamespacePriorityOverride = "history.taskSchedulerNamespacePriorityOverride"
+	// TaskSchedulerAdaptiveWorkerCountEnabled makes the host level task schedulers reduce their number of workers
+	// while persistence is unhealthy, i.e. while the average latency or the ratio of unhealthy errors of persistence
+	// requests breaches TaskSchedulerAdaptiveLatencyThreshold or TaskSchedulerAdaptiveErrorRatioThreshold, and scale
+	// back up once it recovers
+	TaskSchedulerAdaptiveWorkerCountEnabled = "history.taskSchedulerAdaptiveWorkerCountEnabled"
+	// TaskSchedulerAdaptiveLatencyThreshold is the average persistence latency above which the task schedulers reduce
+	// their number of workers, see TaskSchedulerAdaptiveWorkerCountEnabled. Disabled if 0.
+	TaskSchedulerAdaptiveLatencyThreshold = "history.taskSchedulerAdaptiveLatencyThreshold"
+	// TaskSchedulerAdaptiveErrorRatioThreshold is the ratio of unhealthy persistence errors above which the task
+	// schedulers reduce their number of workers, see TaskSchedulerAdaptiveWorkerCountEnabled. Disabled if 0.
+	TaskSchedulerAdaptiveErrorRatioThreshold = "history.taskSchedulerAdaptiveErrorRatioThreshold"
+	// TaskSchedulerAdaptiveMinWorkerRatio is the fraction of the configured number of workers the task schedulers
+	// keep while persistence is unhealthy, see TaskSchedulerAdaptiveWorkerCountEnabled
+	TaskSchedulerAdaptiveMinWorkerRatio = "history.taskSchedulerAdaptiveMinWorkerRatio"
 
 	// TimerTaskBatchSize is batch size for timer processor to process tasks
 	TimerTaskBatchSize = "history.timerTaskBatchSize"
@@ -503,6 +714,9 @@ const (
 	TimerProcessorMaxPollIntervalJitterCoefficient = "history.timerProcessorMaxPollIntervalJitterCoefficient"
 	// TimerProcessorPollBackoffInterval is the poll backoff interval if task redispatcher's size exceeds limit for timer processor
 	TimerProcessorPollBackoffInterval = "history.timerProcessorPollBackoffInterval"
+	// TimerProcessorReschedulerFlushInterval is the granularity at which due timer task reschedules are re-submitted,
+	// 0 means each task is re-submitted at its exact reschedule time
+	TimerProcessorReschedulerFlushInterval = "history.timerProcessorReschedulerFlushInterval"
 	// TimerProcessorMaxTimeShift is the max shift timer processor can have
 	TimerProcessorMaxTimeShift = "history.timerProcessorMaxTimeShift"
 	// TimerProcessorHistoryArchivalSizeLimit is the max history size for inline archival
@@ -543,6 +757,9 @@ const (
 	TransferProcessorCompleteTransferInterval = "history.transferProcessorCompleteTransferInterval"
 	// TransferProcessorPollBackoffInterval is the poll backoff interval if task redispatcher's size exceeds limit for transferQueueProcessor
 	TransferProcessorPollBackoffInterval = "history.transferProcessorPollBackoffInterval"
+	// TransferProcessorReschedulerFlushInterval is the granularity at which due transfer task reschedules are re-submitted,
+	// 0 means each task is re-submitted at its exact reschedule time
+	TransferProcessorReschedulerFlushInterval = "history.transferProcessorReschedulerFlushInterval"
 	// TransferProcessorVisibilityArchivalTimeLimit is the upper time limit for archiving visibility records
 	TransferProcessorVisibilityArchivalTimeLimit = "history.transferProcessorVisibilityArchivalTimeLimit"
 	// TransferProcessorEnsureCloseBeforeDelete means we ensure the execution is closed before we delete it
@@ -572,6 +789,9 @@ const (
 	VisibilityProcessorCompleteTaskInterval = "history.visibilityProcessorCompleteTaskInterval"
 	// VisibilityProcessorPollBackoffInterval is the poll backoff interval if task redispatcher's size exceeds limit for visibilityQueueProcessor
 	VisibilityProcessorPollBackoffInterval = "history.visibilityProcessorPollBackoffInterval"
+	// VisibilityProcessorReschedulerFlushInterval is the granularity at which due visibility task reschedules are re-submitted,
+	// 0 means each task is re-submitted at its exact reschedule time
+	VisibilityProcessorReschedulerFlushInterval = "history.visibilityProcessorReschedulerFlushInterval"
 	// VisibilityProcessorVisibilityArchivalTimeLimit is the upper time limit for archiving visibility records
 	VisibilityProcessorVisibilityArchivalTimeLimit = "history.visibilityProcessorVisibilityArchivalTimeLimit"
 	// VisibilityProcessorEnsureCloseBeforeDelete means we ensure the visibility of an execution is closed before we delete its visibility records
@@ -601,6 +821,9 @@ const (
 	// ArchivalProcessorPollBackoffInterval is the poll backoff interval if task redispatcher's size exceeds limit for
 	// archivalQueueProcessor
 	ArchivalProcessorPollBackoffInterval = "history.archivalProcessorPollBackoffInterval"
+	// ArchivalProcessorReschedulerFlushInterval is the granularity at which due archival task reschedules are re-submitted,
+	// 0 means each task is re-submitted at its exact reschedule time
+	ArchivalProcessorReschedulerFlushInterval = "history.archivalProcessorReschedulerFlushInterval"
 	// ArchivalProcessorArchiveDelay is the delay before archivalQueueProcessor starts to process archival tasks
 	ArchivalProcessorArchiveDelay = "history.archivalProcessorArchiveDelay"
 	// ArchivalBackendMaxRPS is the maximum rate of requests per second to the archival backend
@@ -585,6 +585,16 @@ func TaskVersion(taskVersion int64) ZapTag {
 	return NewInt64("queue-task-version", taskVersion)
 }
 
+// TaskPriority returns tag for task priority
+func TaskPriority(priority string) ZapTag {
+	return NewStringTag("queue-task-priority", priority)
+}
+
+// TaskCategory returns tag for task category
+func TaskCategory(category string) ZapTag {
+	return NewStringTag("queue-task-category", category)
+}
+
 func TaskType(taskType enumsspb.TaskType) ZapTag {
 	return NewStringTag("queue-task-type", taskType.String())
 }
@@ -616,6 +626,11 @@ func Attempt(attempt int32) ZapTag {
 	return NewInt32("attempt", attempt)
 }
 
+// LifetimeAttempt returns tag for the attempt of a task that is never reset
+func LifetimeAttempt(attempt int32) ZapTag {
+	return NewInt32("lifetime-attempt", attempt)
+}
+
 func WorkflowTaskType(wtType string) ZapTag {
 	return NewStringTag("wt-type", wtType)
 }
@@ -45,9 +45,12 @@ const (
 	TaskCategoryTagName        = "task_category"
 	TaskTypeTagName            = "task_type"
 	TaskPriorityTagName        = "task_priority"
+	TaskStateTagName           = "task_state"
+	TaskNextStateTagName       = "task_next_state"
 	QueueReaderIDTagName       = "queue_reader_id"
 	QueueActionTagName         = "queue_action"
 	QueueTypeTagName           = "queue_type"
+	RecordTypeTagName          = "record_type"
 	visibilityTypeTagName      = "visibility_type"
 	ErrorTypeTagName           = "error_type"
 	httpStatusTagName          = "http_status"
@@ -100,10 +103,14 @@ const (
 	AdminClientGetShardScope = "AdminClientGetShard"
 	// AdminClientListHistoryTasksScope tracks RPC calls to admin service
 	AdminClientListHistoryTasksScope = "AdminClientListHistoryTasks"
+	// AdminClientListWorkerBuildIdCompatibilityScope tracks RPC calls to admin service
+	AdminClientListWorkerBuildIdCompatibilityScope = "AdminClientListWorkerBuildIdCompatibility"
 	// AdminClientRemoveTaskScope tracks RPC calls to admin service
 	AdminClientRemoveTaskScope = "AdminClientRemoveTask"
 	// AdminClientDescribeHistoryHostScope tracks RPC calls to admin service
 	AdminClientDescribeHistoryHostScope = "AdminClientDescribeHistoryHost"
+	// AdminClientDescribeHistoryQueueScope tracks RPC calls to admin service
+	AdminClientDescribeHistoryQueueScope = "AdminClientDescribeHistoryQueue"
 	// AdminClientRebuildMutableStateScope tracks RPC calls to admin service
 	AdminClientRebuildMutableStateScope = "AdminClientRebuildMutableState"
 	// AdminClientDescribeMutableStateScope tracks RPC calls to admin service
@@ -145,6 +152,8 @@ const (
 
 	// AdminDescribeHistoryHostScope is the metric scope for admin.AdminDescribeHistoryHost
 	AdminDescribeHistoryHostScope = "AdminDescribeHistoryHost"
+	// AdminDescribeHistoryQueueScope is the metric scope for admin.AdminDescribeHistoryQueue
+	AdminDescribeHistoryQueueScope = "AdminDescribeHistoryQueue"
 	// AdminAddSearchAttributesScope is the metric scope for admin.AdminAddSearchAttributes
 	AdminAddSearchAttributesScope = "AdminAddSearchAttributes"
 	// AdminRemoveSearchAttributesScope is the metric scope for admin.AdminRemoveSearchAttributes
@@ -179,6 +188,8 @@ const (
 	AdminGetShardScope = "AdminGetShard"
 	// AdminListHistoryTasksScope is the metric scope for admin.ListHistoryTasks
 	AdminListHistoryTasksScope = "AdminListHistoryTasks"
+	// AdminListWorkerBuildIdCompatibilityScope is the metric scope for admin.ListWorkerBuildIdCompatibility
+	AdminListWorkerBuildIdCompatibilityScope = "AdminListWorkerBuildIdCompatibility"
 	// AdminGetDLQMessagesScope is the metric scope for admin.AdminGetDLQMessages
 	AdminGetDLQMessagesScope = "AdminGetDLQMessages"
 	// AdminPurgeDLQMessagesScope is the metric scope for admin.AdminPurgeDLQMessages
@@ -393,6 +404,8 @@ const (
 	HistoryClientRecordChildExecutionCompletedScope = "HistoryClientRecordChildExecutionCompleted"
 	// HistoryClientVerifyChildExecutionCompletionRecordedScope tracks RPC calls to history service
 	HistoryClientVerifyChildExecutionCompletionRecordedScope = "HistoryClientVerifyChildExecutionCompletionRecorded"
+	// HistoryClientRepinWorkflowBuildIdScope tracks RPC calls to history service
+	HistoryClientRepinWorkflowBuildIdScope = "HistoryClientRepinWorkflowBuildId"
 	// HistoryClientReplicateEventsV2Scope tracks RPC calls to history service
 	HistoryClientReplicateEventsV2Scope = "HistoryClientReplicateEventsV2"
 	// HistoryClientReplicateWorkflowStateScope tracks RPC calls to history service
@@ -439,6 +452,8 @@ const (
 	HistoryClientVerifyFirstWorkflowTaskScheduledScope = "HistoryClientVerifyFirstWorkflowTaskScheduled"
 	// HistoryClientDescribeHistoryHostScope tracks RPC calls to history service
 	HistoryClientDescribeHistoryHostScope = "HistoryClientDescribeHistoryHost"
+	// HistoryClientDescribeHistoryQueueScope tracks RPC calls to history service
+	HistoryClientDescribeHistoryQueueScope = "HistoryClientDescribeHistoryQueue"
 	// HistoryClientGetReplicationMessagesScope tracks RPC calls to history service
 	HistoryClientGetReplicationMessagesScope = "HistoryClientGetReplicationMessages"
 	// HistoryClientStreamWorkflowReplicationMessagesScope tracks RPC calls to history service
@@ -461,16 +476,40 @@ const (
 	MatchingClientRespondQueryTaskCompletedScope = "MatchingClientRespondQueryTaskCompleted"
 	// MatchingClientCancelOutstandingPollScope tracks RPC calls to matching service
 	MatchingClientCancelOutstandingPollScope = "MatchingClientCancelOutstandingPoll"
+	// MatchingClientCleanupUnreachableBuildIdsScope tracks RPC calls to matching service
+	MatchingClientCleanupUnreachableBuildIdsScope = "MatchingClientCleanupUnreachableBuildIds"
 	// MatchingClientDescribeTaskQueueScope tracks RPC calls to matching service
 	MatchingClientDescribeTaskQueueScope = "MatchingClientDescribeTaskQueue"
+	// MatchingClientDescribeVersioningScope tracks RPC calls to matching service
+	MatchingClientDescribeVersioningScope = "MatchingClientDescribeVersioning"
+	// MatchingClientGetDefaultBuildIdTimelineScope tracks RPC calls to matching service
+	MatchingClientGetDefaultBuildIdTimelineScope = "MatchingClientGetDefaultBuildIdTimeline"
+	// MatchingClientGetBuildIdReachabilityScope tracks RPC calls to matching service
+	MatchingClientGetBuildIdReachabilityScope = "MatchingClientGetBuildIdReachability"
 	// MatchingClientGetBuildIdTaskQueueMappingScope tracks RPC calls to matching service
 	MatchingClientGetBuildIdTaskQueueMappingScope = "MatchingClientGetBuildIdTaskQueueMapping"
 	// MatchingClientListTaskQueuePartitionsScope tracks RPC calls to matching service
 	MatchingClientListTaskQueuePartitionsScope = "MatchingClientListTaskQueuePartitions"
+	// MatchingClientListWorkerBuildIdCompatibilityScope tracks RPC calls to matching service
+	MatchingClientListWorkerBuildIdCompatibilityScope = "MatchingClientListWorkerBuildIdCompatibility"
 	// MatchingClientUpdateWorkerBuildIdCompatibilityScope tracks RPC calls to matching service
 	MatchingClientUpdateWorkerBuildIdCompatibilityScope = "MatchingClientUpdateWorkerBuildIdCompatibility"
+	// MatchingClientValidateDefaultBuildIdSwitchScope tracks RPC calls to matching service
+	MatchingClientValidateDefaultBuildIdSwitchScope = "MatchingClientValidateDefaultBuildIdSwitch"
+	// MatchingClientGetClosedWorkflowBuildIdScope tracks RPC calls to matching service
+	MatchingClientGetClosedWorkflowBuildIdScope = "MatchingClientGetClosedWorkflowBuildId"
+	// MatchingClientGetUserDataPropagationStatusScope tracks RPC calls to matching service
+	MatchingClientGetUserDataPropagationStatusScope = "MatchingClientGetUserDataPropagationStatus"
+	// MatchingClientApplyVersioningTemplateScope tracks RPC calls to matching service
+	MatchingClientApplyVersioningTemplateScope = "MatchingClientApplyVersioningTemplate"
+	// MatchingClientReassignBuildIdScope tracks RPC calls to matching service
+	MatchingClientReassignBuildIdScope = "MatchingClientReassignBuildId"
 	// MatchingClientGetWorkerBuildIdCompatibilityScope tracks RPC calls to matching service
 	MatchingClientGetWorkerBuildIdCompatibilityScope = "MatchingClientGetWorkerBuildIdCompatibility"
+	// MatchingClientEnableWorkerVersioningScope tracks RPC calls to matching service
+	MatchingClientEnableWorkerVersioningScope = "MatchingClientEnableWorkerVersioning"
+	// MatchingClientGetTaskDispatchDecisionScope tracks RPC calls to matching service
+	MatchingClientGetTaskDispatchDecisionScope = "MatchingClientGetTaskDispatchDecision"
 	// MatchingClientGetTaskQueueUserDataScope tracks RPC calls to matching service
 	MatchingClientGetTaskQueueUserDataScope = "MatchingClientGetTaskQueueUserData"
 	// MatchingClientApplyTaskQueueUserDataReplicationEventScope tracks RPC calls to matching service
@@ -644,6 +683,8 @@ const (
 	PersistenceGetTaskQueueUserDataScope = "GetTaskQueueUserData"
 	// PersistenceUpdateTaskQueueUserDataScope is the metric scope for persistence.TaskManager.UpdateTaskQueueUserData API
 	PersistenceUpdateTaskQueueUserDataScope = "UpdateTaskQueueUserData"
+	// PersistenceCompareAndSwapTaskQueueUserDataScope is the metric scope for persistence.TaskManager.CompareAndSwapTaskQueueUserData API
+	PersistenceCompareAndSwapTaskQueueUserDataScope = "CompareAndSwapTaskQueueUserData"
 	// PersistenceListTaskQueueUserDataEntriesScope is the metric scope for persistence.TaskManager.ListTaskQueueUserDataEntries API
 	PersistenceListTaskQueueUserDataEntriesScope = "ListTaskQueueUserDataEntries"
 	// PersistenceGetTaskQueuesByBuildIdScope is the metric scope for persistence.TaskManager.GetTaskQueuesByBuildId API
@@ -1331,14 +1372,29 @@ var (
 	SyncShardFromRemoteCounter                        = NewCounterDef("syncshard_remote_count")
 	SyncShardFromRemoteFailure                        = NewCounterDef("syncshard_remote_failed")
 	TaskRequests                                      = NewCounterDef("task_requests")
-	TaskLoadLatency                                   = NewTimerDef("task_latency_load")       // latency from task generation to task loading (persistence scheduleToStart)
-	TaskScheduleLatency                               = NewTimerDef("task_latency_schedule")   // latency from task submission to in-memory queue to processing (in-memory scheduleToStart)
-	TaskProcessingLatency                             = NewTimerDef("task_latency_processing") // latency for processing task one time
-	TaskLatency                                       = NewTimerDef("task_latency")            // task in-memory latency across multiple attempts
-	TaskQueueLatency                                  = NewTimerDef("task_latency_queue")      // task e2e latency
+	TaskCompleted                                     = NewCounterDef("task_completed")                      // tagged with the tags returned by the task executor
+	TaskLoadLatency                                   = NewTimerDef("task_latency_load")                     // latency from task generation to task loading (persistence scheduleToStart)
+	TaskScheduleLatency                               = NewTimerDef("task_latency_schedule")                 // latency from task submission to in-memory queue to processing (in-memory scheduleToStart)
+	TaskProcessingLatency                             = NewTimerDef("task_latency_processing")               // latency for processing task one time
+	TaskProcessingUserLatency                         = NewTimerDef("task_latency_processing_user")          // part of the processing latency attributed to user, e.g. waiting for a busy workflow lock
+	TaskProcessingNoUserLatency                       = NewTimerDef("task_latency_processing_nouserlatency") // processing latency excluding the user latency, i.e. time spent in the system
+	TaskLatency                                       = NewTimerDef("task_latency")                          // task in-memory latency across multiple attempts
+	TaskQueueLatency                                  = NewTimerDef("task_latency_queue")                    // task e2e latency
 	TaskAttempt                                       = NewDimensionlessHistogramDef("task_attempt")
+	TaskLifetimeAttempt                               = NewDimensionlessHistogramDef("task_lifetime_attempt") // attempts across active/standby transitions
+	TaskStateDuration                                 = NewTimerDef("task_state_duration")                    // time a task spent in a state before transitioning out of it
 	TaskFailures                                      = NewCounterDef("task_errors")
 	TaskDiscarded                                     = NewCounterDef("task_errors_discarded")
+	TaskYielded                                       = NewCounterDef("task_yielded")
+	TaskSplit                                         = NewCounterDef("task_split")
+	TaskClockNotReached                               = NewCounterDef("task_clock_not_reached")
+	TaskPrecedingTaskNotCompleted                     = NewCounterDef("task_preceding_task_not_completed")
+	TaskCircuitBreakerOpen                            = NewCounterDef("task_circuit_breaker_open")
+	TaskCircuitBreakerTripped                         = NewCounterDef("task_circuit_breaker_tripped")
+	TaskCircuitBreakerReset                           = NewCounterDef("task_circuit_breaker_reset")
+	TaskDLQEnqueued                                   = NewCounterDef("task_dlq_enqueued")
+	TaskDLQEnqueueFailed                              = NewCounterDef("task_dlq_enqueue_failed")
+	TaskAttemptTimeout                                = NewCounterDef("task_attempt_timeout")
 	TaskSkipped                                       = NewCounterDef("task_skipped")
 	TaskVersionMisMatch                               = NewCounterDef("task_errors_version_mismatch")
 	TasksDependencyTaskNotCompleted                   = NewCounterDef("task_dependency_task_not_completed")
@@ -1355,9 +1411,12 @@ var (
 	TaskReschedulerPendingTasks                       = NewDimensionlessHistogramDef("task_rescheduler_pending_tasks")
 	PendingTasksCounter                               = NewDimensionlessHistogramDef("pending_tasks")
 	TaskSchedulerThrottled                            = NewCounterDef("task_scheduler_throttled")
+	TaskSchedulerWorkerCount                          = NewGaugeDef("task_scheduler_worker_count")
 	QueueScheduleLatency                              = NewTimerDef("queue_latency_schedule") // latency for scheduling 100 tasks in one task channel
 	QueueReaderCountHistogram                         = NewDimensionlessHistogramDef("queue_reader_count")
 	QueueSliceCountHistogram                          = NewDimensionlessHistogramDef("queue_slice_count")
+	QueueReaderBacklogCount                           = NewDimensionlessHistogramDef("queue_reader_backlog_count")
+	QueueReaderBacklogAge                             = NewTimerDef("queue_reader_backlog_age")
 	QueueActionCounter                                = NewCounterDef("queue_actions")
 	QueueActionFailures                               = NewCounterDef("queue_action_errors")
 	ActivityE2ELatency                                = NewTimerDef("activity_end_to_end_latency")
@@ -1518,6 +1577,8 @@ var (
 	PollSuccessPerTaskQueueCounter            = NewCounterDef("poll_success")
 	PollTimeoutPerTaskQueueCounter            = NewCounterDef("poll_timeouts")
 	PollSuccessWithSyncPerTaskQueueCounter    = NewCounterDef("poll_success_sync")
+	PollMatchedPerBuildIdCounter              = NewCounterDef("poll_matched_per_build_id")
+	PollTimedOutPerBuildIdCounter             = NewCounterDef("poll_timed_out_per_build_id")
 	LeaseRequestPerTaskQueueCounter           = NewCounterDef("lease_requests")
 	LeaseFailurePerTaskQueueCounter           = NewCounterDef("lease_failures")
 	ConditionFailedErrorPerTaskQueueCounter   = NewCounterDef("condition_failed_errors")
@@ -1546,6 +1607,21 @@ var (
 	TaskWriteLatencyPerTaskQueue              = NewTimerDef("task_write_latency")
 	TaskLagPerTaskQueueGauge                  = NewGaugeDef("task_lag_per_tl")
 	NoRecentPollerTasksPerTaskQueueCounter    = NewCounterDef("no_poller_tasks")
+	CompatibleBuildIdDispatchCounter          = NewCounterDef("compatible_build_id_dispatch")
+	VersionedPollerDispatchCounter            = NewCounterDef("versioned_poller_dispatch")
+	UnversionedPollerDispatchCounter          = NewCounterDef("unversioned_poller_dispatch")
+	SyncMatchPerBuildIdCounter                = NewCounterDef("sync_match_per_build_id")
+	VersionSetRedirectCounter                 = NewCounterDef("version_set_redirect")
+	StickyTaskBouncedCounter                  = NewCounterDef("sticky_task_bounced")
+	StickyPollerEvictedCounter                = NewCounterDef("sticky_poller_evicted")
+	VersioningPartitionDivergence             = NewCounterDef("versioning_partition_divergence")
+	TaskQueueUserDataSize                     = NewBytesHistogramDef("task_queue_user_data_size")
+	TaskQueueUserDataLongPolls                = NewCounterDef("task_queue_user_data_long_polls")
+	TaskQueueUserDataPropagated               = NewCounterDef("task_queue_user_data_propagated")
+	TaskQueueVersioningDataRepaired           = NewCounterDef("task_queue_versioning_data_repaired")
+	TaskQueueVersioningDataMigrated           = NewCounterDef("task_queue_versioning_data_migrated")
+	VersioningBuildIdsScavenged               = NewCounterDef("versioning_build_ids_scavenged")
+	HybridLogicalClockBackwardJump            = NewCounterDef("hybrid_logical_clock_backward_jump")
 
 	// Worker
 	ExecutorTasksDoneCount                                    = NewCounterDef("executor_done")
@@ -1668,6 +1744,11 @@ var (
 	PersistenceErrorWithType                            = NewCounterDef("persistence_error_with_type")
 	PersistenceLatency                                  = NewTimerDef("persistence_latency")
 	PersistenceShardRPS                                 = NewDimensionlessHistogramDef("persistence_shard_rps")
+	PersistenceHealthStatus                             = NewGaugeDef("persistence_health_status")
+	PersistenceSerializationLatency                     = NewTimerDef("persistence_serialization_latency")
+	PersistenceSerializedSize                           = NewBytesHistogramDef("persistence_serialized_size")
+	PersistenceDeserializationLatency                   = NewTimerDef("persistence_deserialization_latency")
+	PersistenceDeserializedSize                         = NewBytesHistogramDef("persistence_deserialized_size")
 	PersistenceErrShardExistsCounter                    = NewCounterDef("persistence_errors_shard_exists")
 	PersistenceErrShardOwnershipLostCounter             = NewCounterDef("persistence_errors_shard_ownership_lost")
 	PersistenceErrConditionFailedCounter                = NewCounterDef("persistence_errors_condition_failed")
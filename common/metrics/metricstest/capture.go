@@ -0,0 +1,166 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metricstest
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/exp/maps"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	// CaptureHandler is a metrics.Handler that records every value emitted through it while at
+	// least one Capture is active. It is meant for tests that assert on emitted metrics.
+	CaptureHandler struct {
+		tags   map[string]string
+		shared *captureState
+	}
+
+	captureState struct {
+		lock     sync.RWMutex
+		captures map[*Capture]struct{}
+	}
+
+	// Capture is a set of recordings made between StartCapture and StopCapture.
+	Capture struct {
+		lock       sync.RWMutex
+		recordings map[string][]*CapturedRecording
+	}
+
+	// CapturedRecording is a single recorded value along with its merged tags.
+	CapturedRecording struct {
+		Value any
+		Tags  map[string]string
+		Unit  metrics.MetricUnit
+	}
+)
+
+var _ metrics.Handler = (*CaptureHandler)(nil)
+
+// NewCaptureHandler creates a new CaptureHandler with no active captures.
+func NewCaptureHandler() *CaptureHandler {
+	return &CaptureHandler{
+		tags: map[string]string{},
+		shared: &captureState{
+			captures: map[*Capture]struct{}{},
+		},
+	}
+}
+
+// StartCapture starts recording everything emitted through this handler (and any handler
+// derived from it via WithTags) into the returned Capture.
+func (c *CaptureHandler) StartCapture() *Capture {
+	capture := &Capture{recordings: map[string][]*CapturedRecording{}}
+	c.shared.lock.Lock()
+	defer c.shared.lock.Unlock()
+	c.shared.captures[capture] = struct{}{}
+	return capture
+}
+
+// StopCapture stops recording into the given Capture.
+func (c *CaptureHandler) StopCapture(capture *Capture) {
+	c.shared.lock.Lock()
+	defer c.shared.lock.Unlock()
+	delete(c.shared.captures, capture)
+}
+
+// WithTags returns a handler that merges the given tags into every recording.
+func (c *CaptureHandler) WithTags(tags ...metrics.Tag) metrics.Handler {
+	return &CaptureHandler{
+		tags:   c.mergeTags(tags),
+		shared: c.shared,
+	}
+}
+
+func (c *CaptureHandler) Counter(name string) metrics.CounterIface {
+	return metrics.CounterFunc(func(v int64, tags ...metrics.Tag) {
+		c.record(name, v, "", tags)
+	})
+}
+
+func (c *CaptureHandler) Gauge(name string) metrics.GaugeIface {
+	return metrics.GaugeFunc(func(v float64, tags ...metrics.Tag) {
+		c.record(name, v, "", tags)
+	})
+}
+
+func (c *CaptureHandler) Timer(name string) metrics.TimerIface {
+	return metrics.TimerFunc(func(v time.Duration, tags ...metrics.Tag) {
+		c.record(name, v, "", tags)
+	})
+}
+
+func (c *CaptureHandler) Histogram(name string, unit metrics.MetricUnit) metrics.HistogramIface {
+	return metrics.HistogramFunc(func(v int64, tags ...metrics.Tag) {
+		c.record(name, v, unit, tags)
+	})
+}
+
+func (*CaptureHandler) Stop(log.Logger) {}
+
+func (c *CaptureHandler) mergeTags(tags []metrics.Tag) map[string]string {
+	merged := maps.Clone(c.tags)
+	for _, tag := range tags {
+		merged[tag.Key()] = tag.Value()
+	}
+	return merged
+}
+
+func (c *CaptureHandler) record(name string, value any, unit metrics.MetricUnit, tags []metrics.Tag) {
+	c.shared.lock.RLock()
+	defer c.shared.lock.RUnlock()
+	if len(c.shared.captures) == 0 {
+		return
+	}
+	recording := &CapturedRecording{
+		Value: value,
+		Tags:  c.mergeTags(tags),
+		Unit:  unit,
+	}
+	for capture := range c.shared.captures {
+		capture.add(name, recording)
+	}
+}
+
+func (c *Capture) add(name string, recording *CapturedRecording) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.recordings[name] = append(c.recordings[name], recording)
+}
+
+// Snapshot returns a copy of all recordings so far, keyed by metric name.
+func (c *Capture) Snapshot() map[string][]*CapturedRecording {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	snapshot := make(map[string][]*CapturedRecording, len(c.recordings))
+	for name, recordings := range c.recordings {
+		snapshot[name] = append([]*CapturedRecording(nil), recordings...)
+	}
+	return snapshot
+}
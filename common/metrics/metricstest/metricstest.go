@@ -243,6 +243,16 @@ func (s Snapshot) Histogram(name string, tags ...metrics.Tag) ([]HistogramBucket
 	return sample.buckets, nil
 }
 
+// Value returns the cumulative count of samples up to the upper bound of the bucket
+func (b HistogramBucket) Value() float64 {
+	return b.value
+}
+
+// UpperBound returns the inclusive upper bound of the bucket
+func (b HistogramBucket) UpperBound() float64 {
+	return b.upperBound
+}
+
 func (s Snapshot) String() string {
 	var b strings.Builder
 	for n, s := range s.samples {
@@ -49,6 +49,9 @@ const (
 	fromCluster    = "from_cluster"
 	toCluster      = "to_cluster"
 	taskQueue      = "taskqueue"
+	buildId        = "build_id"
+	versionSet     = "version_set"
+	fromVersionSet = "from_version_set"
 	workflowType   = "workflowType"
 	activityType   = "activityType"
 	commandType    = "commandType"
@@ -61,6 +64,7 @@ const (
 
 	namespaceAllValue = "all"
 	unknownValue      = "_unknown_"
+	unversionedValue  = "__unversioned__"
 	totalMetricSuffix = "_total"
 	tagExcludedValue  = "_tag_excluded_"
 
@@ -164,6 +168,30 @@ func TaskQueueTypeTag(tqType enumspb.TaskQueueType) Tag {
 	return &tagImpl{key: TaskTypeTagName, value: tqType.String()}
 }
 
+// BuildIdTag returns a new worker build id tag.
+func BuildIdTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return &tagImpl{key: buildId, value: value}
+}
+
+// VersionSetTag returns a new task queue version set tag.
+func VersionSetTag(value string) Tag {
+	if len(value) == 0 {
+		value = unversionedValue
+	}
+	return &tagImpl{key: versionSet, value: value}
+}
+
+// FromVersionSetTag returns a new tag for the version set a task was redirected from.
+func FromVersionSetTag(value string) Tag {
+	if len(value) == 0 {
+		value = unversionedValue
+	}
+	return &tagImpl{key: fromVersionSet, value: value}
+}
+
 // WorkflowTypeTag returns a new workflow type tag.
 func WorkflowTypeTag(value string) Tag {
 	if len(value) == 0 {
@@ -225,6 +253,20 @@ func TaskPriorityTag(value string) Tag {
 	return &tagImpl{key: TaskPriorityTagName, value: value}
 }
 
+func TaskStateTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return &tagImpl{key: TaskStateTagName, value: value}
+}
+
+func TaskNextStateTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return &tagImpl{key: TaskNextStateTagName, value: value}
+}
+
 func QueueReaderIDTag(readerID int64) Tag {
 	return &tagImpl{key: QueueReaderIDTagName, value: strconv.Itoa(int(readerID))}
 }
@@ -243,6 +285,14 @@ func QueueTypeTag(value string) Tag {
 	return &tagImpl{key: QueueTypeTagName, value: value}
 }
 
+// RecordTypeTag returns a tag for the type of a persisted record, e.g. ShardInfo
+func RecordTypeTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return &tagImpl{key: RecordTypeTagName, value: value}
+}
+
 func VisibilityTypeTag(value string) Tag {
 	if value == "" {
 		value = unknownValue
@@ -0,0 +1,111 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+type (
+	// AdaptivePageSizeConfig configures the adaptive page sizing of persistence list operations, see
+	// AdaptivePageSizer.
+	AdaptivePageSizeConfig struct {
+		Enabled          dynamicconfig.BoolPropertyFn
+		MinPageSize      dynamicconfig.IntPropertyFn
+		MaxPageSize      dynamicconfig.IntPropertyFn
+		LatencyThreshold dynamicconfig.DurationPropertyFn
+	}
+
+	// AdaptivePageSizer picks the page size of a list operation. Starting from the page size of the first request,
+	// it doubles the page size after each read that is faster than the latency threshold, and halves it while the
+	// average persistence latency reported by the health signal aggregator is above the threshold. The page size is
+	// kept within the configured bounds.
+	AdaptivePageSizer struct {
+		config        *AdaptivePageSizeConfig
+		healthSignals HealthSignalAggregator
+
+		sync.Mutex
+		pageSize int
+	}
+)
+
+func NewAdaptivePageSizer(
+	config *AdaptivePageSizeConfig,
+	healthSignals HealthSignalAggregator,
+) *AdaptivePageSizer {
+	return &AdaptivePageSizer{
+		config:        config,
+		healthSignals: healthSignals,
+	}
+}
+
+// PageSize returns the page size to use in place of requested, which is returned as is if adaptive page sizing is
+// disabled.
+func (s *AdaptivePageSizer) PageSize(requested int) int {
+	if !s.config.Enabled() {
+		return requested
+	}
+	s.Lock()
+	defer s.Unlock()
+	if s.pageSize == 0 {
+		s.pageSize = requested
+	}
+	s.pageSize = s.boundLocked(s.pageSize)
+	return s.pageSize
+}
+
+// Record adjusts the page size after a list request that took latency and failed with err, if not nil.
+func (s *AdaptivePageSizer) Record(latency time.Duration, err error) {
+	if !s.config.Enabled() {
+		return
+	}
+	threshold := s.config.LatencyThreshold()
+	if threshold <= 0 {
+		return
+	}
+	s.Lock()
+	defer s.Unlock()
+	if s.pageSize == 0 {
+		return
+	}
+	if s.healthSignals.AverageLatency() > float64(threshold.Milliseconds()) || IsUnhealthyError(err) {
+		s.pageSize = s.boundLocked(s.pageSize / 2)
+	} else if err == nil && latency < threshold {
+		s.pageSize = s.boundLocked(s.pageSize * 2)
+	}
+}
+
+func (s *AdaptivePageSizer) boundLocked(pageSize int) int {
+	if maxPageSize := s.config.MaxPageSize(); pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	if minPageSize := s.config.MinPageSize(); pageSize < minPageSize {
+		pageSize = minPageSize
+	}
+	return pageSize
+}
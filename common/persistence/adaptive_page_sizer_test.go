@@ -0,0 +1,103 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+)
+
+func TestAdaptivePageSizeClient_GrowsWhenFastShrinksWhenSlow(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockExecutionManager := NewMockExecutionManager(controller)
+	healthSignals := NewHealthSignalAggregatorImpl(
+		time.Minute,
+		100,
+		metrics.NoopMetricsHandler,
+		dynamicconfig.GetIntPropertyFn(50),
+		log.NewNoopLogger(),
+	)
+	client := NewExecutionPersistenceAdaptivePageSizeClient(
+		mockExecutionManager,
+		&AdaptivePageSizeConfig{
+			Enabled:          dynamicconfig.GetBoolPropertyFn(true),
+			MinPageSize:      dynamicconfig.GetIntPropertyFn(10),
+			MaxPageSize:      dynamicconfig.GetIntPropertyFn(500),
+			LatencyThreshold: dynamicconfig.GetDurationPropertyFn(time.Second),
+		},
+		healthSignals,
+	)
+
+	var pageSizes []int
+	mockExecutionManager.EXPECT().ListConcreteExecutions(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error) {
+			pageSizes = append(pageSizes, request.PageSize)
+			return &ListConcreteExecutionsResponse{}, nil
+		},
+	).AnyTimes()
+
+	request := &ListConcreteExecutionsRequest{PageSize: 100}
+	for i := 0; i < 4; i++ {
+		_, err := client.ListConcreteExecutions(context.Background(), request)
+		require.NoError(t, err)
+	}
+	require.Equal(t, []int{100, 200, 400, 500}, pageSizes)
+	require.Equal(t, 100, request.PageSize)
+
+	// persistence reports slow responses
+	for i := 0; i < 10; i++ {
+		healthSignals.Record(CallerSegmentMissing, 5*time.Second, nil)
+	}
+	pageSizes = nil
+	for i := 0; i < 4; i++ {
+		_, err := client.ListConcreteExecutions(context.Background(), request)
+		require.NoError(t, err)
+	}
+	require.Equal(t, []int{500, 250, 125, 62}, pageSizes)
+}
+
+func TestAdaptivePageSizer_Disabled(t *testing.T) {
+	sizer := NewAdaptivePageSizer(
+		&AdaptivePageSizeConfig{
+			Enabled:          dynamicconfig.GetBoolPropertyFn(false),
+			MinPageSize:      dynamicconfig.GetIntPropertyFn(10),
+			MaxPageSize:      dynamicconfig.GetIntPropertyFn(500),
+			LatencyThreshold: dynamicconfig.GetDurationPropertyFn(time.Second),
+		},
+		NoopHealthSignalAggregator,
+	)
+
+	require.Equal(t, 1000, sizer.PageSize(1000))
+	sizer.Record(time.Millisecond, nil)
+	require.Equal(t, 1000, sizer.PageSize(1000))
+}
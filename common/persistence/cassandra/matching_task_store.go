@@ -556,12 +556,12 @@ func (d *MatchingTaskStore) UpdateTaskQueueUserData(
 			request.NamespaceID,
 			request.TaskQueue,
 			request.UserData.Data,
-			request.UserData.EncodingType.String(),
+			p.EncodingTypeString(request.UserData.EncodingType),
 		)
 	} else {
 		batch.Query(templateUpdateTaskQueueUserDataQuery,
 			request.UserData.Data,
-			request.UserData.EncodingType.String(),
+			p.EncodingTypeString(request.UserData.EncodingType),
 			request.Version+1,
 			request.NamespaceID,
 			request.TaskQueue,
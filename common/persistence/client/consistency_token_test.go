@@ -0,0 +1,167 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type (
+	// eventuallyConsistentTaskStore only exposes user data writes to reads once they were replicated, unless the read
+	// carries the consistency token of the write.
+	eventuallyConsistentTaskStore struct {
+		p.TaskStore
+		supportsTokens bool
+
+		lock       sync.Mutex
+		writes     []*p.InternalUpdateTaskQueueUserDataRequest
+		replicated int
+	}
+)
+
+func (s *eventuallyConsistentTaskStore) UpdateTaskQueueUserData(
+	ctx context.Context,
+	request *p.InternalUpdateTaskQueueUserDataRequest,
+) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.writes = append(s.writes, request)
+	if s.supportsTokens {
+		token := make([]byte, 8)
+		binary.BigEndian.PutUint64(token, uint64(len(s.writes)))
+		p.RecordConsistencyToken(ctx, token)
+	}
+	return nil
+}
+
+func (s *eventuallyConsistentTaskStore) GetTaskQueueUserData(
+	ctx context.Context,
+	_ *p.GetTaskQueueUserDataRequest,
+) (*p.InternalGetTaskQueueUserDataResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	visible := s.replicated
+	if token, ok := p.GetConsistencyToken(ctx); ok && s.supportsTokens {
+		if written := int(binary.BigEndian.Uint64(token)); written > visible {
+			visible = written
+		}
+	}
+	if visible == 0 {
+		return nil, serviceerror.NewNotFound("task queue user data not found")
+	}
+	write := s.writes[visible-1]
+	return &p.InternalGetTaskQueueUserDataResponse{Version: write.Version, UserData: write.UserData}, nil
+}
+
+func (s *eventuallyConsistentTaskStore) replicate() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.replicated = len(s.writes)
+}
+
+func TestConsistencyToken_TokenedReadSeesPrecedingWrite(t *testing.T) {
+	for _, supportsTokens := range []bool{true, false} {
+		store := &eventuallyConsistentTaskStore{supportsTokens: supportsTokens}
+		factory := NewFactory(
+			&countingDataStoreFactory{taskStore: &countingTaskStore{TaskStore: store}},
+			&config.Persistence{},
+			nil,
+			serialization.NewSerializer(),
+			"test-cluster",
+			nil,
+			log.NewNoopLogger(),
+			nil,
+			nil,
+			nil,
+		)
+		taskManager, err := factory.NewTaskManager()
+		require.NoError(t, err)
+
+		update := func(version int64) p.ConsistencyToken {
+			ctx, recorder := p.WithConsistencyTokenRecorder(context.Background())
+			err := taskManager.UpdateTaskQueueUserData(ctx, &p.UpdateTaskQueueUserDataRequest{
+				NamespaceID: "ns",
+				TaskQueue:   "tq",
+				UserData: &persistencespb.VersionedTaskQueueUserData{
+					Version: version,
+					Data:    &persistencespb.TaskQueueUserData{},
+				},
+			})
+			require.NoError(t, err)
+			return recorder.Token()
+		}
+		get := func(token p.ConsistencyToken) (int64, error) {
+			res, err := taskManager.GetTaskQueueUserData(p.WithConsistencyToken(context.Background(), token), &p.GetTaskQueueUserDataRequest{
+				NamespaceID: "ns",
+				TaskQueue:   "tq",
+			})
+			if err != nil {
+				return 0, err
+			}
+			return res.UserData.GetVersion(), nil
+		}
+
+		token := update(1)
+		if !supportsTokens {
+			// Stores without token support never return one, and reads proceed as untokened reads
+			require.Nil(t, token)
+			_, err := get(token)
+			var notFound *serviceerror.NotFound
+			require.ErrorAs(t, err, &notFound)
+			continue
+		}
+		require.NotNil(t, token)
+
+		// An untokened read may not see the write yet
+		_, err = get(nil)
+		var notFound *serviceerror.NotFound
+		require.ErrorAs(t, err, &notFound)
+		// A tokened read does
+		version, err := get(token)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), version)
+
+		store.replicate()
+		token = update(2)
+		version, err = get(nil)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), version)
+		version, err = get(token)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), version)
+	}
+}
@@ -56,8 +56,17 @@ type (
 		NewExecutionManager() (p.ExecutionManager, error)
 		// NewNamespaceReplicationQueue returns a new queue for namespace replication
 		NewNamespaceReplicationQueue() (p.NamespaceReplicationQueue, error)
+		// NewHistoryTaskDLQ returns a new dead letter queue for history tasks
+		NewHistoryTaskDLQ() (p.HistoryTaskDLQ, error)
 		// NewClusterMetadataManager returns a new manager for cluster specific metadata
 		NewClusterMetadataManager() (p.ClusterMetadataManager, error)
+		// Health returns the persistence health of each store type, e.g. for a readiness probe
+		Health() Health
+		// RegisterHealthTransitionCallback registers a callback invoked whenever the health of a store type or the
+		// overall health changes. Transitions are evaluated periodically, and only if a HealthConfig was provided.
+		RegisterHealthTransitionCallback(key any, cb HealthTransitionCallbackFn)
+		// UnregisterHealthTransitionCallback removes the callback registered with the given key
+		UnregisterHealthTransitionCallback(key any)
 	}
 
 	factoryImpl struct {
@@ -69,6 +78,9 @@ type (
 		clusterName      string
 		ratelimiter      quotas.RequestRateLimiter
 		healthSignals    p.HealthSignalAggregator
+		adaptivePageSize *p.AdaptivePageSizeConfig
+		healthConfig     *HealthConfig
+		transitions      *healthTransitions
 	}
 )
 
@@ -78,7 +90,9 @@ type (
 // also contains config for individual datastores themselves.
 //
 // The objects returned by this factory enforce ratelimit and maxconns according to
-// given configuration. In addition, all objects will emit metrics automatically
+// given configuration. In addition, all objects will emit metrics automatically.
+// Callers doing write-then-read can pass the persistence.ConsistencyToken recorded for a
+// write to the read, which stores that support tokens honor and others ignore.
 func NewFactory(
 	dataStoreFactory DataStoreFactory,
 	cfg *config.Persistence,
@@ -88,6 +102,8 @@ func NewFactory(
 	metricsHandler metrics.Handler,
 	logger log.Logger,
 	healthSignals p.HealthSignalAggregator,
+	adaptivePageSize *p.AdaptivePageSizeConfig,
+	healthConfig *HealthConfig,
 ) Factory {
 	factory := &factoryImpl{
 		dataStoreFactory: dataStoreFactory,
@@ -98,6 +114,8 @@ func NewFactory(
 		clusterName:      clusterName,
 		ratelimiter:      ratelimiter,
 		healthSignals:    healthSignals,
+		adaptivePageSize: adaptivePageSize,
+		healthConfig:     healthConfig,
 	}
 	factory.initDependencies()
 	return factory
@@ -115,7 +133,10 @@ func (f *factoryImpl) NewTaskManager() (p.TaskManager, error) {
 		result = p.NewTaskPersistenceRateLimitedClient(result, f.ratelimiter, f.logger)
 	}
 	if f.metricsHandler != nil && f.healthSignals != nil {
-		result = p.NewTaskPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.logger)
+		result = p.NewTaskPersistenceMetricsClient(result, f.metricsHandler, f.storeHealthSignals(StoreTypeTask), f.logger)
+	}
+	if f.adaptivePageSize != nil {
+		result = p.NewTaskPersistenceAdaptivePageSizeClient(result, f.adaptivePageSize, f.healthSignals)
 	}
 	return result, nil
 }
@@ -132,7 +153,7 @@ func (f *factoryImpl) NewShardManager() (p.ShardManager, error) {
 		result = p.NewShardPersistenceRateLimitedClient(result, f.ratelimiter, f.logger)
 	}
 	if f.metricsHandler != nil && f.healthSignals != nil {
-		result = p.NewShardPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.logger)
+		result = p.NewShardPersistenceMetricsClient(result, f.metricsHandler, f.storeHealthSignals(StoreTypeShard), f.logger)
 	}
 	result = p.NewShardPersistenceRetryableClient(result, retryPolicy, IsPersistenceTransientError)
 	return result, nil
@@ -150,7 +171,7 @@ func (f *factoryImpl) NewMetadataManager() (p.MetadataManager, error) {
 		result = p.NewMetadataPersistenceRateLimitedClient(result, f.ratelimiter, f.logger)
 	}
 	if f.metricsHandler != nil && f.healthSignals != nil {
-		result = p.NewMetadataPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.logger)
+		result = p.NewMetadataPersistenceMetricsClient(result, f.metricsHandler, f.storeHealthSignals(StoreTypeMetadata), f.logger)
 	}
 	result = p.NewMetadataPersistenceRetryableClient(result, retryPolicy, IsPersistenceTransientError)
 	return result, nil
@@ -168,7 +189,7 @@ func (f *factoryImpl) NewClusterMetadataManager() (p.ClusterMetadataManager, err
 		result = p.NewClusterMetadataPersistenceRateLimitedClient(result, f.ratelimiter, f.logger)
 	}
 	if f.metricsHandler != nil && f.healthSignals != nil {
-		result = p.NewClusterMetadataPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.logger)
+		result = p.NewClusterMetadataPersistenceMetricsClient(result, f.metricsHandler, f.storeHealthSignals(StoreTypeClusterMetadata), f.logger)
 	}
 	result = p.NewClusterMetadataPersistenceRetryableClient(result, retryPolicy, IsPersistenceTransientError)
 	return result, nil
@@ -186,7 +207,10 @@ func (f *factoryImpl) NewExecutionManager() (p.ExecutionManager, error) {
 		result = p.NewExecutionPersistenceRateLimitedClient(result, f.ratelimiter, f.logger)
 	}
 	if f.metricsHandler != nil && f.healthSignals != nil {
-		result = p.NewExecutionPersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.logger)
+		result = p.NewExecutionPersistenceMetricsClient(result, f.metricsHandler, f.storeHealthSignals(StoreTypeExecution), f.logger)
+	}
+	if f.adaptivePageSize != nil {
+		result = p.NewExecutionPersistenceAdaptivePageSizeClient(result, f.adaptivePageSize, f.healthSignals)
 	}
 	result = p.NewExecutionPersistenceRetryableClient(result, retryPolicy, IsPersistenceTransientError)
 	return result, nil
@@ -202,15 +226,35 @@ func (f *factoryImpl) NewNamespaceReplicationQueue() (p.NamespaceReplicationQueu
 		result = p.NewQueuePersistenceRateLimitedClient(result, f.ratelimiter, f.logger)
 	}
 	if f.metricsHandler != nil && f.healthSignals != nil {
-		result = p.NewQueuePersistenceMetricsClient(result, f.metricsHandler, f.healthSignals, f.logger)
+		result = p.NewQueuePersistenceMetricsClient(result, f.metricsHandler, f.storeHealthSignals(StoreTypeQueue), f.logger)
 	}
 	result = p.NewQueuePersistenceRetryableClient(result, retryPolicy, IsPersistenceTransientError)
 	return p.NewNamespaceReplicationQueue(result, f.serializer, f.clusterName, f.metricsHandler, f.logger)
 }
 
+func (f *factoryImpl) NewHistoryTaskDLQ() (p.HistoryTaskDLQ, error) {
+	return p.NewHistoryTaskDLQ(func(queueType p.QueueType) (p.Queue, error) {
+		result, err := f.dataStoreFactory.NewQueue(queueType)
+		if err != nil {
+			return nil, err
+		}
+
+		if f.ratelimiter != nil {
+			result = p.NewQueuePersistenceRateLimitedClient(result, f.ratelimiter, f.logger)
+		}
+		if f.metricsHandler != nil && f.healthSignals != nil {
+			result = p.NewQueuePersistenceMetricsClient(result, f.metricsHandler, f.storeHealthSignals(StoreTypeQueue), f.logger)
+		}
+		return p.NewQueuePersistenceRetryableClient(result, retryPolicy, IsPersistenceTransientError), nil
+	}), nil
+}
+
 // Close closes this factory
 func (f *factoryImpl) Close() {
 	f.dataStoreFactory.Close()
+	if f.transitions != nil {
+		f.transitions.Stop()
+	}
 	if f.healthSignals != nil {
 		f.healthSignals.Stop()
 	}
@@ -237,4 +281,68 @@ func (f *factoryImpl) initDependencies() {
 		f.healthSignals = p.NoopHealthSignalAggregator
 	}
 	f.healthSignals.Start()
+
+	if f.healthConfig != nil {
+		f.transitions = newHealthTransitions(f.healthConfig.TransitionInterval, f.Health, f.logger)
+		f.transitions.Start()
+	}
+}
+
+// storeHealthSignals returns the aggregator recording the signals of the given store type, which also feeds the
+// shared aggregator.
+func (f *factoryImpl) storeHealthSignals(storeType string) p.HealthSignalAggregator {
+	return f.healthSignals.ForStore(storeType)
+}
+
+// Health returns the persistence health of each store type, derived from the signals the HealthSignalAggregator
+// tracks for it. The overall status is healthy if all store types are healthy, unhealthy if all of them are unhealthy,
+// and degraded otherwise. Health is always reported as healthy if no HealthConfig was provided or if health signal
+// collection is disabled.
+func (f *factoryImpl) Health() Health {
+	health := Health{
+		Status: HealthStatusHealthy,
+		Stores: make(map[string]HealthStatus, len(storeTypes)),
+	}
+	if f.healthConfig == nil || f.healthSignals == nil {
+		for _, storeType := range storeTypes {
+			health.Stores[storeType] = HealthStatusHealthy
+		}
+		return health
+	}
+
+	healthy, unhealthy := 0, 0
+	for _, storeType := range storeTypes {
+		status := storeHealthStatus(f.healthSignals.ForStore(storeType), f.healthConfig)
+		switch status {
+		case HealthStatusHealthy:
+			healthy++
+		case HealthStatusUnhealthy:
+			unhealthy++
+		}
+		health.Stores[storeType] = status
+	}
+	switch {
+	case healthy == len(storeTypes):
+	case unhealthy == len(storeTypes):
+		health.Status = HealthStatusUnhealthy
+	default:
+		health.Status = HealthStatusDegraded
+	}
+	return health
+}
+
+// RegisterHealthTransitionCallback registers a callback invoked on every health transition. The first transition
+// reported is relative to the health last evaluated before the callback was registered. Registering a callback is a
+// no-op if no HealthConfig was provided.
+func (f *factoryImpl) RegisterHealthTransitionCallback(key any, cb HealthTransitionCallbackFn) {
+	if f.transitions != nil {
+		f.transitions.register(key, cb)
+	}
+}
+
+// UnregisterHealthTransitionCallback removes the callback registered with the given key.
+func (f *factoryImpl) UnregisterHealthTransitionCallback(key any) {
+	if f.transitions != nil {
+		f.transitions.unregister(key)
+	}
 }
@@ -25,10 +25,13 @@
 package client
 
 import (
+	"context"
 	"time"
 
 	"go.uber.org/fx"
+	"google.golang.org/grpc/health"
 
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
@@ -41,26 +44,44 @@ import (
 )
 
 type (
-	PersistenceMaxQps                  dynamicconfig.IntPropertyFn
-	PersistenceNamespaceMaxQps         dynamicconfig.IntPropertyFnWithNamespaceFilter
-	PersistencePerShardNamespaceMaxQPS dynamicconfig.IntPropertyFnWithNamespaceFilter
-	EnablePriorityRateLimiting         dynamicconfig.BoolPropertyFn
-	ClusterName                        string
+	PersistenceMaxQps                       dynamicconfig.IntPropertyFn
+	PersistenceVisibilityMaxQps             dynamicconfig.IntPropertyFn
+	PersistenceNamespaceMaxQps              dynamicconfig.IntPropertyFnWithNamespaceFilter
+	PersistencePerShardNamespaceMaxQPS      dynamicconfig.IntPropertyFnWithNamespaceFilter
+	EnablePriorityRateLimiting              dynamicconfig.BoolPropertyFn
+	PersistenceShedLatencyThreshold         dynamicconfig.DurationPropertyFn
+	PersistenceNamespacePriorityFloor       dynamicconfig.IntPropertyFnWithNamespaceFilter
+	PersistenceSlowStartDuration            dynamicconfig.DurationPropertyFn
+	PersistenceNewImplementationOperations  dynamicconfig.MapPropertyFn
+	NewImplementationDataStoreFactory       DataStoreFactory
+	PersistenceUserDataCompressionThreshold dynamicconfig.IntPropertyFn
+	PersistenceSerializerMetricsEnabled     dynamicconfig.BoolPropertyFn
+	ClusterName                             string
 
 	NewFactoryParams struct {
 		fx.In
 
-		DataStoreFactory                   DataStoreFactory
-		Cfg                                *config.Persistence
-		PersistenceMaxQPS                  PersistenceMaxQps
-		PersistenceNamespaceMaxQPS         PersistenceNamespaceMaxQps
-		PersistencePerShardNamespaceMaxQPS PersistencePerShardNamespaceMaxQPS
-		EnablePriorityRateLimiting         EnablePriorityRateLimiting
-		ClusterName                        ClusterName
-		ServiceName                        primitives.ServiceName
-		MetricsHandler                     metrics.Handler
-		Logger                             log.Logger
-		HealthSignals                      persistence.HealthSignalAggregator
+		DataStoreFactory                        DataStoreFactory
+		Cfg                                     *config.Persistence
+		PersistenceMaxQPS                       PersistenceMaxQps
+		PersistenceVisibilityMaxQPS             PersistenceVisibilityMaxQps
+		PersistenceNamespaceMaxQPS              PersistenceNamespaceMaxQps
+		PersistencePerShardNamespaceMaxQPS      PersistencePerShardNamespaceMaxQPS
+		EnablePriorityRateLimiting              EnablePriorityRateLimiting
+		PersistenceShedLatencyThreshold         PersistenceShedLatencyThreshold
+		PersistenceNamespacePriorityFloor       PersistenceNamespacePriorityFloor
+		PersistenceSlowStartDuration            PersistenceSlowStartDuration
+		PersistenceNewImplementationOperations  PersistenceNewImplementationOperations
+		NewImplementationDataStoreFactory       NewImplementationDataStoreFactory `optional:"true"`
+		PersistenceUserDataCompressionThreshold PersistenceUserDataCompressionThreshold
+		PersistenceSerializerMetricsEnabled     PersistenceSerializerMetricsEnabled
+		ClusterName                             ClusterName
+		ServiceName                             primitives.ServiceName
+		MetricsHandler                          metrics.Handler
+		Logger                                  log.Logger
+		HealthSignals                           persistence.HealthSignalAggregator
+		AdaptivePageSizeConfig                  *persistence.AdaptivePageSizeConfig
+		HealthConfig                            *HealthConfig
 	}
 
 	FactoryProviderFn func(NewFactoryParams) Factory
@@ -71,6 +92,17 @@ var Module = fx.Options(
 	fx.Provide(ClusterNameProvider),
 	fx.Provide(DataStoreFactoryProvider),
 	fx.Provide(HealthSignalAggregatorProvider),
+	fx.Provide(PersistenceVisibilityMaxQpsProvider),
+	fx.Provide(PersistenceShedLatencyThresholdProvider),
+	fx.Provide(PersistenceNamespacePriorityFloorProvider),
+	fx.Provide(PersistenceSlowStartDurationProvider),
+	fx.Provide(PersistenceNewImplementationOperationsProvider),
+	fx.Provide(PersistenceUserDataCompressionThresholdProvider),
+	fx.Provide(PersistenceSerializerMetricsEnabledProvider),
+	fx.Provide(AdaptivePageSizeConfigProvider),
+	fx.Provide(HealthConfigProvider),
+	fx.Invoke(HealthTransitionReporterLifetimeHooks),
+	fx.Invoke(HealthCheckLifetimeHooks),
 )
 
 func ClusterNameProvider(config *cluster.Config) ClusterName {
@@ -82,27 +114,76 @@ func FactoryProvider(
 ) Factory {
 	var requestRatelimiter quotas.RequestRateLimiter
 	if params.PersistenceMaxQPS != nil && params.PersistenceMaxQPS() > 0 {
+		requestPriorityFn := NewRequestPriorityFn(params.PersistenceNamespacePriorityFloor)
 		if params.EnablePriorityRateLimiting != nil && params.EnablePriorityRateLimiting() {
 			requestRatelimiter = NewPriorityRateLimiter(
 				params.PersistenceNamespaceMaxQPS,
 				params.PersistenceMaxQPS,
 				params.PersistencePerShardNamespaceMaxQPS,
-				RequestPriorityFn,
+				requestPriorityFn,
 			)
 		} else {
 			requestRatelimiter = NewNoopPriorityRateLimiter(params.PersistenceMaxQPS)
 		}
+		if params.PersistenceSlowStartDuration != nil {
+			requestRatelimiter = NewSlowStartRequestRateLimiter(
+				requestRatelimiter,
+				params.PersistenceMaxQPS,
+				params.PersistenceSlowStartDuration,
+				clock.NewRealTimeSource(),
+			)
+		}
+		if params.PersistenceVisibilityMaxQPS != nil {
+			requestRatelimiter = NewVisibilityRequestRateLimiter(
+				requestRatelimiter,
+				params.PersistenceVisibilityMaxQPS,
+				requestPriorityFn,
+			)
+		}
+		if params.HealthSignals != nil {
+			requestRatelimiter = NewHealthRequestRateLimiter(
+				requestRatelimiter,
+				params.HealthSignals,
+				params.PersistenceShedLatencyThreshold,
+				requestPriorityFn,
+			)
+		}
+	}
+
+	dataStoreFactory := params.DataStoreFactory
+	if params.NewImplementationDataStoreFactory != nil && params.PersistenceNewImplementationOperations != nil {
+		dataStoreFactory = NewRoutingDataStoreFactory(
+			dataStoreFactory,
+			params.NewImplementationDataStoreFactory,
+			dynamicconfig.MapPropertyFn(params.PersistenceNewImplementationOperations),
+		)
+	}
+	// user data compression is opt-in: the wrapper is only installed if a threshold is configured on startup
+	if params.PersistenceUserDataCompressionThreshold != nil && params.PersistenceUserDataCompressionThreshold() > 0 {
+		dataStoreFactory = NewUserDataCompressionDataStoreFactory(
+			dataStoreFactory,
+			dynamicconfig.IntPropertyFn(params.PersistenceUserDataCompressionThreshold),
+		)
+	}
+
+	serializer := serialization.NewSerializer()
+	// serializer metrics are opt-in: the wrapper is only installed if they are enabled on startup
+	if params.PersistenceSerializerMetricsEnabled != nil && params.PersistenceSerializerMetricsEnabled() &&
+		params.MetricsHandler != nil {
+		serializer = serialization.NewSerializerWithMetrics(serializer, params.MetricsHandler)
 	}
 
 	return NewFactory(
-		params.DataStoreFactory,
+		dataStoreFactory,
 		params.Cfg,
 		requestRatelimiter,
-		serialization.NewSerializer(),
+		serializer,
 		string(params.ClusterName),
 		params.MetricsHandler,
 		params.Logger,
 		params.HealthSignals,
+		params.AdaptivePageSizeConfig,
+		params.HealthConfig,
 	)
 }
 
@@ -123,3 +204,117 @@ func HealthSignalAggregatorProvider(
 
 	return persistence.NoopHealthSignalAggregator
 }
+
+func PersistenceVisibilityMaxQpsProvider(
+	dynamicCollection *dynamicconfig.Collection,
+) PersistenceVisibilityMaxQps {
+	return PersistenceVisibilityMaxQps(dynamicCollection.GetIntProperty(dynamicconfig.PersistenceVisibilityMaxQPS, 0))
+}
+
+func PersistenceShedLatencyThresholdProvider(
+	dynamicCollection *dynamicconfig.Collection,
+) PersistenceShedLatencyThreshold {
+	return PersistenceShedLatencyThreshold(dynamicCollection.GetDurationProperty(dynamicconfig.PersistenceShedLatencyThreshold, 0))
+}
+
+func PersistenceNamespacePriorityFloorProvider(
+	dynamicCollection *dynamicconfig.Collection,
+) PersistenceNamespacePriorityFloor {
+	return PersistenceNamespacePriorityFloor(dynamicCollection.GetIntPropertyFilteredByNamespace(
+		dynamicconfig.PersistenceNamespacePriorityFloor,
+		RequestPrioritiesOrdered[len(RequestPrioritiesOrdered)-1],
+	))
+}
+
+func PersistenceSlowStartDurationProvider(
+	dynamicCollection *dynamicconfig.Collection,
+) PersistenceSlowStartDuration {
+	return PersistenceSlowStartDuration(dynamicCollection.GetDurationProperty(dynamicconfig.PersistenceSlowStartDuration, 0))
+}
+
+func PersistenceNewImplementationOperationsProvider(
+	dynamicCollection *dynamicconfig.Collection,
+) PersistenceNewImplementationOperations {
+	return PersistenceNewImplementationOperations(dynamicCollection.GetMapProperty(dynamicconfig.PersistenceNewImplementationOperations, map[string]any{}))
+}
+
+func PersistenceUserDataCompressionThresholdProvider(
+	dynamicCollection *dynamicconfig.Collection,
+) PersistenceUserDataCompressionThreshold {
+	return PersistenceUserDataCompressionThreshold(dynamicCollection.GetIntProperty(dynamicconfig.PersistenceUserDataCompressionThreshold, 0))
+}
+
+func PersistenceSerializerMetricsEnabledProvider(
+	dynamicCollection *dynamicconfig.Collection,
+) PersistenceSerializerMetricsEnabled {
+	return PersistenceSerializerMetricsEnabled(dynamicCollection.GetBoolProperty(dynamicconfig.PersistenceSerializerMetricsEnabled, false))
+}
+
+func AdaptivePageSizeConfigProvider(
+	dynamicCollection *dynamicconfig.Collection,
+) *persistence.AdaptivePageSizeConfig {
+	return &persistence.AdaptivePageSizeConfig{
+		Enabled:          dynamicCollection.GetBoolProperty(dynamicconfig.PersistenceAdaptivePageSizeEnabled, false),
+		MinPageSize:      dynamicCollection.GetIntProperty(dynamicconfig.PersistenceAdaptivePageSizeMin, 10),
+		MaxPageSize:      dynamicCollection.GetIntProperty(dynamicconfig.PersistenceAdaptivePageSizeMax, 1000),
+		LatencyThreshold: dynamicCollection.GetDurationProperty(dynamicconfig.PersistenceAdaptivePageSizeLatencyThreshold, 200*time.Millisecond),
+	}
+}
+
+func HealthConfigProvider(
+	dynamicCollection *dynamicconfig.Collection,
+) *HealthConfig {
+	return &HealthConfig{
+		DegradedLatencyThreshold:    dynamicCollection.GetDurationProperty(dynamicconfig.PersistenceHealthDegradedLatencyThreshold, 500*time.Millisecond),
+		DegradedErrorRatioThreshold: dynamicCollection.GetFloat64Property(dynamicconfig.PersistenceHealthDegradedErrorRatioThreshold, 0.05),
+		LatencyThreshold:            dynamicCollection.GetDurationProperty(dynamicconfig.PersistenceHealthLatencyThreshold, time.Second),
+		ErrorRatioThreshold:         dynamicCollection.GetFloat64Property(dynamicconfig.PersistenceHealthErrorRatioThreshold, 0.1),
+		TransitionInterval:          dynamicCollection.GetDurationProperty(dynamicconfig.PersistenceHealthTransitionInterval, time.Second),
+	}
+}
+
+// HealthTransitionReporterLifetimeHooks subscribes a reporter to the persistence health transitions of the factory for
+// the lifetime of the service.
+func HealthTransitionReporterLifetimeHooks(
+	lc fx.Lifecycle,
+	factory Factory,
+	metricsHandler metrics.Handler,
+	logger log.Logger,
+) {
+	lc.Append(
+		fx.Hook{
+			OnStart: func(context.Context) error {
+				factory.RegisterHealthTransitionCallback(
+					healthTransitionReporterKey,
+					newHealthTransitionReporter(metricsHandler, logger),
+				)
+				return nil
+			},
+			OnStop: func(context.Context) error {
+				factory.UnregisterHealthTransitionCallback(healthTransitionReporterKey)
+				return nil
+			},
+		},
+	)
+}
+
+// HealthCheckLifetimeHooks reports the persistence health on the gRPC health server of the service, see
+// HealthCheckServiceName, for the lifetime of the service.
+func HealthCheckLifetimeHooks(
+	lc fx.Lifecycle,
+	factory Factory,
+	healthServer *health.Server,
+) {
+	lc.Append(
+		fx.Hook{
+			OnStart: func(context.Context) error {
+				registerHealthCheck(factory, healthServer)
+				return nil
+			},
+			OnStop: func(context.Context) error {
+				factory.UnregisterHealthTransitionCallback(healthCheckKey)
+				return nil
+			},
+		},
+	)
+}
@@ -0,0 +1,332 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	p "go.temporal.io/server/common/persistence"
+)
+
+const (
+	HealthStatusHealthy HealthStatus = iota
+	// HealthStatusDegraded means, for a store type, that its signals exceed the degraded thresholds but not the
+	// unhealthy ones. Overall, it means that some store types are not healthy, but not all of them are unhealthy.
+	HealthStatusDegraded
+	HealthStatusUnhealthy
+)
+
+const (
+	StoreTypeShard           = "shard"
+	StoreTypeExecution       = "execution"
+	StoreTypeTask            = "task"
+	StoreTypeMetadata        = "metadata"
+	StoreTypeClusterMetadata = "cluster_metadata"
+	StoreTypeQueue           = "queue"
+)
+
+// HealthCheckServiceName is the service name the persistence health is reported under by the gRPC health server of a
+// service, for a readiness probe to check. It is not serving while persistence is unhealthy.
+const HealthCheckServiceName = "temporal.server.persistence"
+
+// healthCheckKey is the key the health check registers its transition callback with.
+const healthCheckKey = "persistence-health-check"
+
+// healthTransitionReporterKey is the key the health transition reporter registers its callback with.
+const healthTransitionReporterKey = "persistence-health-transition-reporter"
+
+// healthTransitionBufferSize is the number of transitions buffered for a callback which is still busy with earlier
+// ones, further transitions are dropped.
+const healthTransitionBufferSize = 64
+
+var storeTypes = []string{
+	StoreTypeShard,
+	StoreTypeExecution,
+	StoreTypeTask,
+	StoreTypeMetadata,
+	StoreTypeClusterMetadata,
+	StoreTypeQueue,
+}
+
+type (
+	HealthStatus int
+
+	// HealthConfig configures the persistence health reported by Factory.Health. A store type is degraded or
+	// unhealthy if the average latency or the ratio of unhealthy errors of its requests, as tracked by the
+	// HealthSignalAggregator, exceeds the corresponding threshold. Health transitions are evaluated every
+	// TransitionInterval.
+	HealthConfig struct {
+		DegradedLatencyThreshold    dynamicconfig.DurationPropertyFn
+		DegradedErrorRatioThreshold dynamicconfig.FloatPropertyFn
+		LatencyThreshold            dynamicconfig.DurationPropertyFn
+		ErrorRatioThreshold         dynamicconfig.FloatPropertyFn
+		TransitionInterval          dynamicconfig.DurationPropertyFn
+	}
+
+	// Health is the overall persistence health along with the health of each store type.
+	Health struct {
+		Status HealthStatus
+		Stores map[string]HealthStatus
+	}
+
+	// HealthTransition is a change of the health status of a store type, or of the overall persistence health if
+	// StoreType is empty.
+	HealthTransition struct {
+		StoreType string
+		From      HealthStatus
+		To        HealthStatus
+	}
+
+	// HealthTransitionCallbackFn can be registered to be called on every health transition, e.g. to drive a circuit
+	// breaker or an alert. Each callback is invoked on its own goroutine, in the order the transitions happened.
+	HealthTransitionCallbackFn func(transition HealthTransition)
+
+	// healthTransitions periodically evaluates the health and delivers its transitions to the registered callbacks.
+	healthTransitions struct {
+		status     int32
+		shutdownCh chan struct{}
+		interval   dynamicconfig.DurationPropertyFn
+		current    func() Health
+		logger     log.Logger
+
+		sync.Mutex
+		last        Health
+		subscribers map[any]*healthSubscriber
+	}
+
+	// healthSubscriber delivers the transitions to a callback from its own goroutine, so that a slow callback neither
+	// blocks the evaluation nor the other callbacks.
+	healthSubscriber struct {
+		cb       HealthTransitionCallbackFn
+		events   chan HealthTransition
+		closedCh chan struct{}
+	}
+)
+
+func (s HealthStatus) String() string {
+	switch s {
+	case HealthStatusHealthy:
+		return "healthy"
+	case HealthStatusDegraded:
+		return "degraded"
+	case HealthStatusUnhealthy:
+		return "unhealthy"
+	default:
+		return "unknown"
+	}
+}
+
+// storeHealthStatus derives the health status of a store type from its signals.
+func storeHealthStatus(signals p.HealthSignalAggregator, config *HealthConfig) HealthStatus {
+	latency, errorRatio := signals.AverageLatency(), signals.ErrorRatio()
+	switch {
+	case latency > float64(config.LatencyThreshold().Milliseconds()) || errorRatio > config.ErrorRatioThreshold():
+		return HealthStatusUnhealthy
+	case latency > float64(config.DegradedLatencyThreshold().Milliseconds()) ||
+		errorRatio > config.DegradedErrorRatioThreshold():
+		return HealthStatusDegraded
+	default:
+		return HealthStatusHealthy
+	}
+}
+
+func newHealthTransitions(
+	interval dynamicconfig.DurationPropertyFn,
+	current func() Health,
+	logger log.Logger,
+) *healthTransitions {
+	return &healthTransitions{
+		status:      common.DaemonStatusInitialized,
+		shutdownCh:  make(chan struct{}),
+		interval:    interval,
+		current:     current,
+		logger:      logger,
+		last:        current(),
+		subscribers: make(map[any]*healthSubscriber),
+	}
+}
+
+func (h *healthTransitions) Start() {
+	if !atomic.CompareAndSwapInt32(&h.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	go h.evaluateLoop()
+}
+
+func (h *healthTransitions) Stop() {
+	if !atomic.CompareAndSwapInt32(&h.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(h.shutdownCh)
+
+	h.Lock()
+	defer h.Unlock()
+	for key, subscriber := range h.subscribers {
+		close(subscriber.closedCh)
+		delete(h.subscribers, key)
+	}
+}
+
+func (h *healthTransitions) register(key any, cb HealthTransitionCallbackFn) {
+	h.Lock()
+	defer h.Unlock()
+
+	if subscriber, ok := h.subscribers[key]; ok {
+		close(subscriber.closedCh)
+	}
+	subscriber := &healthSubscriber{
+		cb:       cb,
+		events:   make(chan HealthTransition, healthTransitionBufferSize),
+		closedCh: make(chan struct{}),
+	}
+	h.subscribers[key] = subscriber
+	go subscriber.deliverLoop()
+}
+
+func (h *healthTransitions) unregister(key any) {
+	h.Lock()
+	defer h.Unlock()
+
+	if subscriber, ok := h.subscribers[key]; ok {
+		close(subscriber.closedCh)
+		delete(h.subscribers, key)
+	}
+}
+
+func (h *healthTransitions) evaluateLoop() {
+	timer := time.NewTimer(h.interval())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-h.shutdownCh:
+			return
+		case <-timer.C:
+			h.evaluate()
+			timer.Reset(h.interval())
+		}
+	}
+}
+
+// evaluate compares the current health with the last evaluated one and hands the transition of every store type,
+// followed by the overall transition if any, to the callbacks.
+func (h *healthTransitions) evaluate() {
+	health := h.current()
+
+	h.Lock()
+	defer h.Unlock()
+
+	var transitions []HealthTransition
+	for _, storeType := range storeTypes {
+		if from, to := h.last.Stores[storeType], health.Stores[storeType]; from != to {
+			transitions = append(transitions, HealthTransition{StoreType: storeType, From: from, To: to})
+		}
+	}
+	if h.last.Status != health.Status {
+		transitions = append(transitions, HealthTransition{From: h.last.Status, To: health.Status})
+	}
+	h.last = health
+
+	for key, subscriber := range h.subscribers {
+		for _, transition := range transitions {
+			select {
+			case subscriber.events <- transition:
+			default:
+				h.logger.Warn("Dropping persistence health transition, callback is too slow",
+					tag.NewAnyTag("callback-key", key),
+					tag.NewStringTag("store-type", transition.StoreType),
+					tag.NewStringTag("health-status", transition.To.String()),
+				)
+			}
+		}
+	}
+}
+
+func (s *healthSubscriber) deliverLoop() {
+	for {
+		select {
+		case <-s.closedCh:
+			return
+		case transition := <-s.events:
+			s.cb(transition)
+		}
+	}
+}
+
+// newHealthTransitionReporter returns a callback which logs the health transitions and reports the health status of
+// each store type, and of persistence overall with the store type "all", as a gauge to alert on.
+func newHealthTransitionReporter(metricsHandler metrics.Handler, logger log.Logger) HealthTransitionCallbackFn {
+	return func(transition HealthTransition) {
+		storeType := transition.StoreType
+		if storeType == "" {
+			storeType = "all"
+		}
+		metricsHandler.Gauge(metrics.PersistenceHealthStatus.GetMetricName()).Record(
+			float64(transition.To),
+			metrics.StringTag("store_type", storeType),
+		)
+
+		tags := []tag.Tag{
+			tag.NewStringTag("store-type", storeType),
+			tag.NewStringTag("from", transition.From.String()),
+			tag.NewStringTag("to", transition.To.String()),
+		}
+		if transition.To > transition.From {
+			logger.Warn("Persistence health deteriorated", tags...)
+		} else {
+			logger.Info("Persistence health recovered", tags...)
+		}
+	}
+}
+
+// registerHealthCheck reports the current persistence health on the health server under HealthCheckServiceName and
+// keeps it up to date with the transitions of the overall health.
+func registerHealthCheck(factory Factory, healthServer *health.Server) {
+	healthServer.SetServingStatus(HealthCheckServiceName, healthCheckServingStatus(factory.Health().Status))
+	factory.RegisterHealthTransitionCallback(healthCheckKey, func(transition HealthTransition) {
+		if transition.StoreType == "" {
+			healthServer.SetServingStatus(HealthCheckServiceName, healthCheckServingStatus(transition.To))
+		}
+	})
+}
+
+// healthCheckServingStatus maps the overall persistence health to a serving status, a degraded persistence can still
+// serve requests.
+func healthCheckServingStatus(status HealthStatus) healthpb.HealthCheckResponse_ServingStatus {
+	if status == HealthStatusUnhealthy {
+		return healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	return healthpb.HealthCheckResponse_SERVING
+}
@@ -0,0 +1,108 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"time"
+
+	"go.temporal.io/server/common/headers"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/quotas"
+)
+
+type (
+	// HealthRequestRateLimiterImpl sheds low priority requests while the average persistence latency
+	// reported by the health signal aggregator is above a threshold, and otherwise defers to the wrapped rate limiter
+	HealthRequestRateLimiterImpl struct {
+		rateLimiter       quotas.RequestRateLimiter
+		healthSignals     p.HealthSignalAggregator
+		latencyThreshold  PersistenceShedLatencyThreshold
+		requestPriorityFn quotas.RequestPriorityFn
+	}
+)
+
+var _ quotas.RequestRateLimiter = (*HealthRequestRateLimiterImpl)(nil)
+
+var (
+	// ShedRequestPriority is the priority from which (inclusive) requests are shed when persistence latency is high,
+	// by default only background and preemptable requests without a priority override are shed
+	ShedRequestPriority = CallerTypeDefaultPriority[headers.CallerTypeBackground]
+)
+
+func NewHealthRequestRateLimiter(
+	rateLimiter quotas.RequestRateLimiter,
+	healthSignals p.HealthSignalAggregator,
+	latencyThreshold PersistenceShedLatencyThreshold,
+	requestPriorityFn quotas.RequestPriorityFn,
+) *HealthRequestRateLimiterImpl {
+	return &HealthRequestRateLimiterImpl{
+		rateLimiter:       rateLimiter,
+		healthSignals:     healthSignals,
+		latencyThreshold:  latencyThreshold,
+		requestPriorityFn: requestPriorityFn,
+	}
+}
+
+func (r *HealthRequestRateLimiterImpl) Allow(
+	now time.Time,
+	request quotas.Request,
+) bool {
+	if r.shouldShed(request) {
+		return false
+	}
+	return r.rateLimiter.Allow(now, request)
+}
+
+func (r *HealthRequestRateLimiterImpl) Reserve(
+	now time.Time,
+	request quotas.Request,
+) quotas.Reservation {
+	if r.shouldShed(request) {
+		return quotas.NewMultiReservation(false, nil)
+	}
+	return r.rateLimiter.Reserve(now, request)
+}
+
+func (r *HealthRequestRateLimiterImpl) Wait(
+	ctx context.Context,
+	request quotas.Request,
+) error {
+	if r.shouldShed(request) {
+		return p.ErrPersistenceLimitExceeded
+	}
+	return r.rateLimiter.Wait(ctx, request)
+}
+
+func (r *HealthRequestRateLimiterImpl) shouldShed(request quotas.Request) bool {
+	if r.latencyThreshold == nil {
+		return false
+	}
+	threshold := r.latencyThreshold()
+	if threshold <= 0 || r.requestPriorityFn(request) < ShedRequestPriority {
+		return false
+	}
+	return r.healthSignals.AverageLatency() > float64(threshold.Milliseconds())
+}
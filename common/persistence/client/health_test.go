@@ -0,0 +1,237 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type (
+	timeoutDataStoreFactory struct {
+		DataStoreFactory
+	}
+
+	timeoutTaskStore struct {
+		p.TaskStore
+	}
+)
+
+func (f *timeoutDataStoreFactory) NewTaskStore() (p.TaskStore, error) {
+	return &timeoutTaskStore{}, nil
+}
+
+func (s *timeoutTaskStore) GetName() string {
+	return "timeout"
+}
+
+func (s *timeoutTaskStore) GetTaskQueue(
+	_ context.Context,
+	_ *p.InternalGetTaskQueueRequest,
+) (*p.InternalGetTaskQueueResponse, error) {
+	return nil, &p.TimeoutError{Msg: "timeout"}
+}
+
+func TestHealth_UnhealthyStoreDegradesOverallHealth(t *testing.T) {
+	healthSignals := newTestHealthSignals()
+	factory := NewFactory(
+		&timeoutDataStoreFactory{},
+		&config.Persistence{},
+		nil,
+		serialization.NewSerializer(),
+		"test-cluster",
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
+		healthSignals,
+		nil,
+		newTestHealthConfig(time.Second),
+	).(*factoryImpl)
+	defer healthSignals.Stop()
+	defer factory.transitions.Stop()
+
+	health := factory.Health()
+	require.Equal(t, HealthStatusHealthy, health.Status)
+
+	taskManager, err := factory.NewTaskManager()
+	require.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		_, err = taskManager.GetTaskQueue(context.Background(), &p.GetTaskQueueRequest{})
+		require.Error(t, err)
+	}
+
+	health = factory.Health()
+	require.Equal(t, HealthStatusDegraded, health.Status)
+	for _, storeType := range storeTypes {
+		if storeType == StoreTypeTask {
+			require.Equal(t, HealthStatusUnhealthy, health.Stores[storeType])
+		} else {
+			require.Equal(t, HealthStatusHealthy, health.Stores[storeType], storeType)
+		}
+	}
+	// the shared aggregator keeps receiving the signals of every store type
+	require.Equal(t, float64(1), healthSignals.ErrorRatio())
+}
+
+func TestHealth_TransitionCallbacks(t *testing.T) {
+	healthSignals := newTestHealthSignals()
+	factory := NewFactory(
+		&timeoutDataStoreFactory{},
+		&config.Persistence{},
+		nil,
+		serialization.NewSerializer(),
+		"test-cluster",
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
+		healthSignals,
+		nil,
+		newTestHealthConfig(200*time.Millisecond),
+	).(*factoryImpl)
+	defer healthSignals.Stop()
+	defer factory.transitions.Stop()
+
+	transitions := make(chan HealthTransition, 100)
+	factory.RegisterHealthTransitionCallback(t.Name(), func(transition HealthTransition) {
+		transitions <- transition
+	})
+	unregistered := make(chan HealthTransition, 100)
+	factory.RegisterHealthTransitionCallback("unregistered", func(transition HealthTransition) {
+		unregistered <- transition
+	})
+	factory.UnregisterHealthTransitionCallback("unregistered")
+	// a callback that never returns must not hold back the evaluation nor the other callbacks
+	unblock := make(chan struct{})
+	defer close(unblock)
+	factory.RegisterHealthTransitionCallback("blocked", func(HealthTransition) {
+		<-unblock
+	})
+
+	// ramp the latency of every store type up, then back down
+	for latency := time.Duration(0); latency <= 600*time.Millisecond; latency += 10 * time.Millisecond {
+		for _, storeType := range storeTypes {
+			healthSignals.ForStore(storeType).Record(0, latency, nil)
+		}
+		factory.transitions.evaluate()
+	}
+	for i := 0; i < 200; i++ {
+		for _, storeType := range storeTypes {
+			healthSignals.ForStore(storeType).Record(0, 0, nil)
+		}
+		factory.transitions.evaluate()
+	}
+
+	var expected []HealthTransition
+	for _, step := range []struct{ from, to HealthStatus }{
+		{HealthStatusHealthy, HealthStatusDegraded},
+		{HealthStatusDegraded, HealthStatusUnhealthy},
+		{HealthStatusUnhealthy, HealthStatusDegraded},
+		{HealthStatusDegraded, HealthStatusHealthy},
+	} {
+		for _, storeType := range storeTypes {
+			expected = append(expected, HealthTransition{StoreType: storeType, From: step.from, To: step.to})
+		}
+		expected = append(expected, HealthTransition{From: step.from, To: step.to})
+	}
+	var received []HealthTransition
+	for range expected {
+		select {
+		case transition := <-transitions:
+			received = append(received, transition)
+		case <-time.After(time.Second):
+			require.FailNow(t, "missing health transitions", "received %v", received)
+		}
+	}
+	require.Equal(t, expected, received)
+	require.Never(t, func() bool { return len(transitions) > 0 }, 100*time.Millisecond, 10*time.Millisecond)
+	require.Empty(t, unregistered)
+	require.Equal(t, HealthStatusHealthy, factory.Health().Status)
+}
+
+func TestHealth_HealthCheck(t *testing.T) {
+	healthSignals := newTestHealthSignals()
+	factory := NewFactory(
+		&timeoutDataStoreFactory{},
+		&config.Persistence{},
+		nil,
+		serialization.NewSerializer(),
+		"test-cluster",
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
+		healthSignals,
+		nil,
+		newTestHealthConfig(time.Second),
+	).(*factoryImpl)
+	defer healthSignals.Stop()
+	defer factory.transitions.Stop()
+
+	healthServer := health.NewServer()
+	registerHealthCheck(factory, healthServer)
+	servingStatus := func() healthpb.HealthCheckResponse_ServingStatus {
+		response, err := healthServer.Check(context.Background(), &healthpb.HealthCheckRequest{Service: HealthCheckServiceName})
+		require.NoError(t, err)
+		return response.Status
+	}
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus())
+
+	for _, storeType := range storeTypes {
+		healthSignals.ForStore(storeType).Record(0, 0, &p.TimeoutError{Msg: "timeout"})
+	}
+	factory.transitions.evaluate()
+	require.Eventually(t, func() bool {
+		return servingStatus() == healthpb.HealthCheckResponse_NOT_SERVING
+	}, time.Second, 10*time.Millisecond)
+}
+
+func newTestHealthSignals() *p.HealthSignalAggregatorImpl {
+	return p.NewHealthSignalAggregatorImpl(
+		time.Minute,
+		1000,
+		metrics.NoopMetricsHandler,
+		dynamicconfig.GetIntPropertyFn(50),
+		log.NewNoopLogger(),
+	)
+}
+
+func newTestHealthConfig(latencyThreshold time.Duration) *HealthConfig {
+	return &HealthConfig{
+		DegradedLatencyThreshold:    dynamicconfig.GetDurationPropertyFn(latencyThreshold / 2),
+		DegradedErrorRatioThreshold: dynamicconfig.GetFloatPropertyFn(0.5),
+		LatencyThreshold:            dynamicconfig.GetDurationPropertyFn(latencyThreshold),
+		ErrorRatioThreshold:         dynamicconfig.GetFloatPropertyFn(0.5),
+		// transitions are evaluated explicitly by the tests
+		TransitionInterval: dynamicconfig.GetDurationPropertyFn(time.Hour),
+	}
+}
@@ -204,6 +204,26 @@ func RequestPriorityFn(req quotas.Request) int {
 	}
 }
 
+// NewRequestPriorityFn returns a RequestPriorityFn which never assigns a request made on behalf of a namespace a lower
+// priority than the floor configured for that namespace.
+func NewRequestPriorityFn(
+	namespacePriorityFloor PersistenceNamespacePriorityFloor,
+) quotas.RequestPriorityFn {
+	if namespacePriorityFloor == nil {
+		return RequestPriorityFn
+	}
+	return func(req quotas.Request) int {
+		priority := RequestPriorityFn(req)
+		if !hasCaller(req) {
+			return priority
+		}
+		if floor := namespacePriorityFloor(req.Caller); floor >= RequestPrioritiesOrdered[0] && floor < priority {
+			return floor
+		}
+		return priority
+	}
+}
+
 func hasCaller(req quotas.Request) bool {
 	return req.Caller != "" && req.Caller != headers.CallerNameSystem
 }
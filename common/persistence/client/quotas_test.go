@@ -25,13 +25,21 @@
 package client
 
 import (
+	"context"
 	"reflect"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/service/history/tasks"
 	"golang.org/x/exp/slices"
 
 	"go.temporal.io/api/workflowservice/v1"
@@ -103,6 +111,26 @@ func (s *quotasSuite) TestCallOriginDefined() {
 	}
 }
 
+func (s *quotasSuite) TestRequestPriorityFn_NamespacePriorityFloor() {
+	var namespacePriorityFloor = func(namespace string) int {
+		if namespace == "critical-namespace" {
+			return CallerTypeDefaultPriority[headers.CallerTypeAPI]
+		}
+		return RequestPrioritiesOrdered[len(RequestPrioritiesOrdered)-1]
+	}
+	requestPriorityFn := NewRequestPriorityFn(namespacePriorityFloor)
+
+	newRequest := func(namespace string) quotas.Request {
+		return quotas.NewRequest("GetWorkflowExecution", 1, namespace, headers.CallerTypeBackground, 0, "")
+	}
+	s.Equal(CallerTypeDefaultPriority[headers.CallerTypeAPI], requestPriorityFn(newRequest("critical-namespace")))
+	s.Equal(CallerTypeDefaultPriority[headers.CallerTypeBackground], requestPriorityFn(newRequest("ordinary-namespace")))
+
+	// the floor never lowers the priority of requests which already have a higher one
+	overridden := quotas.NewRequest("GetOrCreateShard", 1, "critical-namespace", headers.CallerTypeBackground, 0, "")
+	s.Equal(BackgroundTypeAPIPriorityOverride["GetOrCreateShard"], requestPriorityFn(overridden))
+}
+
 func (s *quotasSuite) TestPriorityNamespaceRateLimiter_DoesLimit() {
 	var namespaceMaxRPS = func(namespace string) int { return 1 }
 	var hostMaxRPS = func() int { return 1 }
@@ -156,3 +184,123 @@ func (s *quotasSuite) TestPerShardNamespaceRateLimiter_DoesLimit() {
 
 	s.True(wasLimited)
 }
+
+func (s *quotasSuite) TestHealthRequestRateLimiter_ShedsLowPriorityOnHighLatency() {
+	healthSignals := p.NewHealthSignalAggregatorImpl(
+		time.Minute,
+		10,
+		metrics.NoopMetricsHandler,
+		dynamicconfig.GetIntPropertyFn(50),
+		log.NewNoopLogger(),
+	)
+	limiter := NewHealthRequestRateLimiter(
+		quotas.NoopRequestRateLimiter,
+		healthSignals,
+		PersistenceShedLatencyThreshold(dynamicconfig.GetDurationPropertyFn(100*time.Millisecond)),
+		RequestPriorityFn,
+	)
+
+	newRequest := func(api string, callerType string) quotas.Request {
+		return quotas.NewRequest(api, 1, "test-namespace", callerType, -1, "")
+	}
+	apiRequest := newRequest("GetWorkflowExecution", headers.CallerTypeAPI)
+	backgroundRequest := newRequest("GetWorkflowExecution", headers.CallerTypeBackground)
+	preemptableRequest := newRequest("GetWorkflowExecution", headers.CallerTypePreemptable)
+	shardRequest := newRequest("UpdateShard", headers.CallerTypeBackground)
+
+	now := time.Now()
+	for _, request := range []quotas.Request{apiRequest, backgroundRequest, preemptableRequest, shardRequest} {
+		s.True(limiter.Allow(now, request))
+	}
+
+	healthSignals.Record(p.CallerSegmentMissing, time.Second, nil)
+
+	s.True(limiter.Allow(now, apiRequest))
+	s.True(limiter.Allow(now, shardRequest))
+	s.False(limiter.Allow(now, backgroundRequest))
+	s.False(limiter.Allow(now, preemptableRequest))
+	s.False(limiter.Reserve(now, backgroundRequest).OK())
+	s.ErrorIs(limiter.Wait(context.Background(), preemptableRequest), p.ErrPersistenceLimitExceeded)
+	s.NoError(limiter.Wait(context.Background(), apiRequest))
+}
+
+func (s *quotasSuite) TestVisibilityRequestRateLimiter_IsolatesVisibilityPool() {
+	coreMaxQPS := 10
+	visibilityMaxQPS := 5
+	limiter := NewVisibilityRequestRateLimiter(
+		NewNoopPriorityRateLimiter(func() int { return coreMaxQPS }),
+		func() int { return visibilityMaxQPS },
+		RequestPriorityFn,
+	)
+
+	newRequest := func(api string) quotas.Request {
+		return quotas.NewRequest(api, 1, "test-namespace", headers.CallerTypeBackground, 1, "")
+	}
+	visibilityRequest := newRequest(p.ConstructHistoryTaskAPI("GetHistoryTasks", tasks.CategoryVisibility))
+	coreRequest := newRequest(p.ConstructHistoryTaskAPI("GetHistoryTasks", tasks.CategoryTransfer))
+
+	// saturate the visibility pool
+	now := time.Now()
+	allowed := 0
+	for i := 0; i < 10*visibilityMaxQPS; i++ {
+		if limiter.Allow(now, visibilityRequest) {
+			allowed++
+		}
+	}
+	s.Equal(visibilityMaxQPS, allowed)
+
+	// core requests still get their whole pool, and no more
+	allowed = 0
+	for i := 0; i < 10*coreMaxQPS; i++ {
+		if limiter.Allow(now, coreRequest) {
+			allowed++
+		}
+	}
+	s.Equal(coreMaxQPS, allowed)
+	s.False(limiter.Allow(now, visibilityRequest))
+
+	// without a visibility pool, visibility requests share the core pool
+	visibilityMaxQPS = 0
+	s.False(limiter.Allow(now, visibilityRequest))
+	s.True(limiter.Allow(now.Add(time.Second), visibilityRequest))
+}
+
+func (s *quotasSuite) TestSlowStartRequestRateLimiter_RampsUpToMaxQPS() {
+	maxQPS := 100
+	warmup := time.Minute
+	timeSource := clock.NewEventTimeSource()
+	timeSource.Update(time.Now())
+	limiter := NewSlowStartRequestRateLimiter(
+		quotas.NoopRequestRateLimiter,
+		func() int { return maxQPS },
+		PersistenceSlowStartDuration(dynamicconfig.GetDurationPropertyFn(warmup)),
+		timeSource,
+	)
+
+	s.InDelta(float64(maxQPS)*SlowStartInitialQPSRatio, limiter.Rate(), 0.001)
+	request := quotas.NewRequest("GetWorkflowExecution", 1, "test-namespace", headers.CallerTypeAPI, -1, "")
+	now := timeSource.Now()
+	allowed := 0
+	for i := 0; i < maxQPS; i++ {
+		if limiter.Allow(now, request) {
+			allowed++
+		}
+	}
+	s.Less(allowed, maxQPS)
+
+	previous := limiter.Rate()
+	for elapsed := 10 * time.Second; elapsed < warmup; elapsed += 10 * time.Second {
+		timeSource.Update(now.Add(elapsed))
+		rate := limiter.Rate()
+		s.Greater(rate, previous)
+		s.Less(rate, float64(maxQPS))
+		previous = rate
+	}
+
+	timeSource.Update(now.Add(warmup))
+	s.Equal(float64(maxQPS), limiter.Rate())
+	// once warmed up the wrapped rate limiter is used as is
+	for i := 0; i < 2*maxQPS; i++ {
+		s.True(limiter.Allow(timeSource.Now(), request))
+	}
+}
@@ -0,0 +1,808 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+
+	commonpb "go.temporal.io/api/common/v1"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	// RoutingDataStoreFactory routes every store operation to either a legacy or a new DataStoreFactory, so that a new
+	// persistence implementation can be rolled out one operation at a time. An operation is named after the store
+	// method, e.g. ReadHistoryBranch, and is served by the new implementation when it maps to true in newOperations.
+	// The implementation is selected on every call, so flipping an operation takes effect at runtime.
+	RoutingDataStoreFactory struct {
+		legacy  DataStoreFactory
+		newImpl DataStoreFactory
+		router  operationRouter
+	}
+
+	operationRouter struct {
+		newOperations dynamicconfig.MapPropertyFn
+	}
+
+	routingShardStore struct {
+		legacy  persistence.ShardStore
+		newImpl persistence.ShardStore
+		router  operationRouter
+	}
+
+	routingTaskStore struct {
+		legacy  persistence.TaskStore
+		newImpl persistence.TaskStore
+		router  operationRouter
+	}
+
+	routingMetadataStore struct {
+		legacy  persistence.MetadataStore
+		newImpl persistence.MetadataStore
+		router  operationRouter
+	}
+
+	routingClusterMetadataStore struct {
+		legacy  persistence.ClusterMetadataStore
+		newImpl persistence.ClusterMetadataStore
+		router  operationRouter
+	}
+
+	routingExecutionStore struct {
+		legacy  persistence.ExecutionStore
+		newImpl persistence.ExecutionStore
+		router  operationRouter
+	}
+
+	routingQueue struct {
+		legacy  persistence.Queue
+		newImpl persistence.Queue
+		router  operationRouter
+	}
+)
+
+var _ DataStoreFactory = (*RoutingDataStoreFactory)(nil)
+var _ persistence.ShardStore = (*routingShardStore)(nil)
+var _ persistence.TaskStore = (*routingTaskStore)(nil)
+var _ persistence.MetadataStore = (*routingMetadataStore)(nil)
+var _ persistence.ClusterMetadataStore = (*routingClusterMetadataStore)(nil)
+var _ persistence.ExecutionStore = (*routingExecutionStore)(nil)
+var _ persistence.Queue = (*routingQueue)(nil)
+
+func NewRoutingDataStoreFactory(
+	legacy DataStoreFactory,
+	newImpl DataStoreFactory,
+	newOperations dynamicconfig.MapPropertyFn,
+) *RoutingDataStoreFactory {
+	return &RoutingDataStoreFactory{
+		legacy:  legacy,
+		newImpl: newImpl,
+		router:  operationRouter{newOperations: newOperations},
+	}
+}
+
+// useNew returns whether the given operation is served by the new implementation.
+func (r operationRouter) useNew(operation string) bool {
+	useNew, _ := r.newOperations()[operation].(bool)
+	return useNew
+}
+
+func (f *RoutingDataStoreFactory) Close() {
+	f.legacy.Close()
+	f.newImpl.Close()
+}
+
+func (f *RoutingDataStoreFactory) NewTaskStore() (persistence.TaskStore, error) {
+	legacy, err := f.legacy.NewTaskStore()
+	if err != nil {
+		return nil, err
+	}
+	store, err := f.newImpl.NewTaskStore()
+	if err != nil {
+		legacy.Close()
+		return nil, err
+	}
+	return &routingTaskStore{legacy: legacy, newImpl: store, router: f.router}, nil
+}
+
+func (f *RoutingDataStoreFactory) NewShardStore() (persistence.ShardStore, error) {
+	legacy, err := f.legacy.NewShardStore()
+	if err != nil {
+		return nil, err
+	}
+	store, err := f.newImpl.NewShardStore()
+	if err != nil {
+		legacy.Close()
+		return nil, err
+	}
+	return &routingShardStore{legacy: legacy, newImpl: store, router: f.router}, nil
+}
+
+func (f *RoutingDataStoreFactory) NewMetadataStore() (persistence.MetadataStore, error) {
+	legacy, err := f.legacy.NewMetadataStore()
+	if err != nil {
+		return nil, err
+	}
+	store, err := f.newImpl.NewMetadataStore()
+	if err != nil {
+		legacy.Close()
+		return nil, err
+	}
+	return &routingMetadataStore{legacy: legacy, newImpl: store, router: f.router}, nil
+}
+
+func (f *RoutingDataStoreFactory) NewExecutionStore() (persistence.ExecutionStore, error) {
+	legacy, err := f.legacy.NewExecutionStore()
+	if err != nil {
+		return nil, err
+	}
+	store, err := f.newImpl.NewExecutionStore()
+	if err != nil {
+		legacy.Close()
+		return nil, err
+	}
+	return &routingExecutionStore{legacy: legacy, newImpl: store, router: f.router}, nil
+}
+
+func (f *RoutingDataStoreFactory) NewQueue(queueType persistence.QueueType) (persistence.Queue, error) {
+	legacy, err := f.legacy.NewQueue(queueType)
+	if err != nil {
+		return nil, err
+	}
+	queue, err := f.newImpl.NewQueue(queueType)
+	if err != nil {
+		legacy.Close()
+		return nil, err
+	}
+	return &routingQueue{legacy: legacy, newImpl: queue, router: f.router}, nil
+}
+
+func (f *RoutingDataStoreFactory) NewClusterMetadataStore() (persistence.ClusterMetadataStore, error) {
+	legacy, err := f.legacy.NewClusterMetadataStore()
+	if err != nil {
+		return nil, err
+	}
+	store, err := f.newImpl.NewClusterMetadataStore()
+	if err != nil {
+		legacy.Close()
+		return nil, err
+	}
+	return &routingClusterMetadataStore{legacy: legacy, newImpl: store, router: f.router}, nil
+}
+
+func (s *routingShardStore) route(operation string) persistence.ShardStore {
+	if s.router.useNew(operation) {
+		return s.newImpl
+	}
+	return s.legacy
+}
+
+func (s *routingShardStore) Close() {
+	s.legacy.Close()
+	s.newImpl.Close()
+}
+
+func (s *routingShardStore) GetName() string {
+	return s.legacy.GetName()
+}
+
+func (s *routingShardStore) GetClusterName() string {
+	return s.legacy.GetClusterName()
+}
+
+func (s *routingTaskStore) route(operation string) persistence.TaskStore {
+	if s.router.useNew(operation) {
+		return s.newImpl
+	}
+	return s.legacy
+}
+
+func (s *routingTaskStore) Close() {
+	s.legacy.Close()
+	s.newImpl.Close()
+}
+
+func (s *routingTaskStore) GetName() string {
+	return s.legacy.GetName()
+}
+
+func (s *routingMetadataStore) route(operation string) persistence.MetadataStore {
+	if s.router.useNew(operation) {
+		return s.newImpl
+	}
+	return s.legacy
+}
+
+func (s *routingMetadataStore) Close() {
+	s.legacy.Close()
+	s.newImpl.Close()
+}
+
+func (s *routingMetadataStore) GetName() string {
+	return s.legacy.GetName()
+}
+
+func (s *routingClusterMetadataStore) route(operation string) persistence.ClusterMetadataStore {
+	if s.router.useNew(operation) {
+		return s.newImpl
+	}
+	return s.legacy
+}
+
+func (s *routingClusterMetadataStore) Close() {
+	s.legacy.Close()
+	s.newImpl.Close()
+}
+
+func (s *routingClusterMetadataStore) GetName() string {
+	return s.legacy.GetName()
+}
+
+func (s *routingExecutionStore) route(operation string) persistence.ExecutionStore {
+	if s.router.useNew(operation) {
+		return s.newImpl
+	}
+	return s.legacy
+}
+
+func (s *routingExecutionStore) Close() {
+	s.legacy.Close()
+	s.newImpl.Close()
+}
+
+func (s *routingExecutionStore) GetName() string {
+	return s.legacy.GetName()
+}
+
+func (s *routingQueue) route(operation string) persistence.Queue {
+	if s.router.useNew(operation) {
+		return s.newImpl
+	}
+	return s.legacy
+}
+
+func (s *routingQueue) Close() {
+	s.legacy.Close()
+	s.newImpl.Close()
+}
+
+func (s *routingExecutionStore) GetHistoryBranchUtil() persistence.HistoryBranchUtil {
+	return s.legacy.GetHistoryBranchUtil()
+}
+
+func (s *routingShardStore) GetOrCreateShard(
+	ctx context.Context,
+	request *persistence.InternalGetOrCreateShardRequest,
+) (*persistence.InternalGetOrCreateShardResponse, error) {
+	return s.route("GetOrCreateShard").GetOrCreateShard(ctx, request)
+}
+
+func (s *routingShardStore) UpdateShard(
+	ctx context.Context,
+	request *persistence.InternalUpdateShardRequest,
+) error {
+	return s.route("UpdateShard").UpdateShard(ctx, request)
+}
+
+func (s *routingShardStore) AssertShardOwnership(
+	ctx context.Context,
+	request *persistence.AssertShardOwnershipRequest,
+) error {
+	return s.route("AssertShardOwnership").AssertShardOwnership(ctx, request)
+}
+
+func (s *routingTaskStore) CreateTaskQueue(
+	ctx context.Context,
+	request *persistence.InternalCreateTaskQueueRequest,
+) error {
+	return s.route("CreateTaskQueue").CreateTaskQueue(ctx, request)
+}
+
+func (s *routingTaskStore) GetTaskQueue(
+	ctx context.Context,
+	request *persistence.InternalGetTaskQueueRequest,
+) (*persistence.InternalGetTaskQueueResponse, error) {
+	return s.route("GetTaskQueue").GetTaskQueue(ctx, request)
+}
+
+func (s *routingTaskStore) UpdateTaskQueue(
+	ctx context.Context,
+	request *persistence.InternalUpdateTaskQueueRequest,
+) (*persistence.UpdateTaskQueueResponse, error) {
+	return s.route("UpdateTaskQueue").UpdateTaskQueue(ctx, request)
+}
+
+func (s *routingTaskStore) ListTaskQueue(
+	ctx context.Context,
+	request *persistence.ListTaskQueueRequest,
+) (*persistence.InternalListTaskQueueResponse, error) {
+	return s.route("ListTaskQueue").ListTaskQueue(ctx, request)
+}
+
+func (s *routingTaskStore) DeleteTaskQueue(
+	ctx context.Context,
+	request *persistence.DeleteTaskQueueRequest,
+) error {
+	return s.route("DeleteTaskQueue").DeleteTaskQueue(ctx, request)
+}
+
+func (s *routingTaskStore) CreateTasks(
+	ctx context.Context,
+	request *persistence.InternalCreateTasksRequest,
+) (*persistence.CreateTasksResponse, error) {
+	return s.route("CreateTasks").CreateTasks(ctx, request)
+}
+
+func (s *routingTaskStore) GetTasks(
+	ctx context.Context,
+	request *persistence.GetTasksRequest,
+) (*persistence.InternalGetTasksResponse, error) {
+	return s.route("GetTasks").GetTasks(ctx, request)
+}
+
+func (s *routingTaskStore) CompleteTask(
+	ctx context.Context,
+	request *persistence.CompleteTaskRequest,
+) error {
+	return s.route("CompleteTask").CompleteTask(ctx, request)
+}
+
+func (s *routingTaskStore) CompleteTasksLessThan(
+	ctx context.Context,
+	request *persistence.CompleteTasksLessThanRequest,
+) (int, error) {
+	return s.route("CompleteTasksLessThan").CompleteTasksLessThan(ctx, request)
+}
+
+func (s *routingTaskStore) GetTaskQueueUserData(
+	ctx context.Context,
+	request *persistence.GetTaskQueueUserDataRequest,
+) (*persistence.InternalGetTaskQueueUserDataResponse, error) {
+	return s.route("GetTaskQueueUserData").GetTaskQueueUserData(ctx, request)
+}
+
+func (s *routingTaskStore) UpdateTaskQueueUserData(
+	ctx context.Context,
+	request *persistence.InternalUpdateTaskQueueUserDataRequest,
+) error {
+	return s.route("UpdateTaskQueueUserData").UpdateTaskQueueUserData(ctx, request)
+}
+
+func (s *routingTaskStore) ListTaskQueueUserDataEntries(
+	ctx context.Context,
+	request *persistence.ListTaskQueueUserDataEntriesRequest,
+) (*persistence.InternalListTaskQueueUserDataEntriesResponse, error) {
+	return s.route("ListTaskQueueUserDataEntries").ListTaskQueueUserDataEntries(ctx, request)
+}
+
+func (s *routingTaskStore) GetTaskQueuesByBuildId(
+	ctx context.Context,
+	request *persistence.GetTaskQueuesByBuildIdRequest,
+) ([]string, error) {
+	return s.route("GetTaskQueuesByBuildId").GetTaskQueuesByBuildId(ctx, request)
+}
+
+func (s *routingTaskStore) CountTaskQueuesByBuildId(
+	ctx context.Context,
+	request *persistence.CountTaskQueuesByBuildIdRequest,
+) (int, error) {
+	return s.route("CountTaskQueuesByBuildId").CountTaskQueuesByBuildId(ctx, request)
+}
+
+func (s *routingMetadataStore) CreateNamespace(
+	ctx context.Context,
+	request *persistence.InternalCreateNamespaceRequest,
+) (*persistence.CreateNamespaceResponse, error) {
+	return s.route("CreateNamespace").CreateNamespace(ctx, request)
+}
+
+func (s *routingMetadataStore) GetNamespace(
+	ctx context.Context,
+	request *persistence.GetNamespaceRequest,
+) (*persistence.InternalGetNamespaceResponse, error) {
+	return s.route("GetNamespace").GetNamespace(ctx, request)
+}
+
+func (s *routingMetadataStore) UpdateNamespace(
+	ctx context.Context,
+	request *persistence.InternalUpdateNamespaceRequest,
+) error {
+	return s.route("UpdateNamespace").UpdateNamespace(ctx, request)
+}
+
+func (s *routingMetadataStore) RenameNamespace(
+	ctx context.Context,
+	request *persistence.InternalRenameNamespaceRequest,
+) error {
+	return s.route("RenameNamespace").RenameNamespace(ctx, request)
+}
+
+func (s *routingMetadataStore) DeleteNamespace(
+	ctx context.Context,
+	request *persistence.DeleteNamespaceRequest,
+) error {
+	return s.route("DeleteNamespace").DeleteNamespace(ctx, request)
+}
+
+func (s *routingMetadataStore) DeleteNamespaceByName(
+	ctx context.Context,
+	request *persistence.DeleteNamespaceByNameRequest,
+) error {
+	return s.route("DeleteNamespaceByName").DeleteNamespaceByName(ctx, request)
+}
+
+func (s *routingMetadataStore) ListNamespaces(
+	ctx context.Context,
+	request *persistence.InternalListNamespacesRequest,
+) (*persistence.InternalListNamespacesResponse, error) {
+	return s.route("ListNamespaces").ListNamespaces(ctx, request)
+}
+
+func (s *routingMetadataStore) GetMetadata(
+	ctx context.Context,
+) (*persistence.GetMetadataResponse, error) {
+	return s.route("GetMetadata").GetMetadata(ctx)
+}
+
+func (s *routingClusterMetadataStore) ListClusterMetadata(
+	ctx context.Context,
+	request *persistence.InternalListClusterMetadataRequest,
+) (*persistence.InternalListClusterMetadataResponse, error) {
+	return s.route("ListClusterMetadata").ListClusterMetadata(ctx, request)
+}
+
+func (s *routingClusterMetadataStore) GetClusterMetadata(
+	ctx context.Context,
+	request *persistence.InternalGetClusterMetadataRequest,
+) (*persistence.InternalGetClusterMetadataResponse, error) {
+	return s.route("GetClusterMetadata").GetClusterMetadata(ctx, request)
+}
+
+func (s *routingClusterMetadataStore) SaveClusterMetadata(
+	ctx context.Context,
+	request *persistence.InternalSaveClusterMetadataRequest,
+) (bool, error) {
+	return s.route("SaveClusterMetadata").SaveClusterMetadata(ctx, request)
+}
+
+func (s *routingClusterMetadataStore) DeleteClusterMetadata(
+	ctx context.Context,
+	request *persistence.InternalDeleteClusterMetadataRequest,
+) error {
+	return s.route("DeleteClusterMetadata").DeleteClusterMetadata(ctx, request)
+}
+
+func (s *routingClusterMetadataStore) GetClusterMembers(
+	ctx context.Context,
+	request *persistence.GetClusterMembersRequest,
+) (*persistence.GetClusterMembersResponse, error) {
+	return s.route("GetClusterMembers").GetClusterMembers(ctx, request)
+}
+
+func (s *routingClusterMetadataStore) UpsertClusterMembership(
+	ctx context.Context,
+	request *persistence.UpsertClusterMembershipRequest,
+) error {
+	return s.route("UpsertClusterMembership").UpsertClusterMembership(ctx, request)
+}
+
+func (s *routingClusterMetadataStore) PruneClusterMembership(
+	ctx context.Context,
+	request *persistence.PruneClusterMembershipRequest,
+) error {
+	return s.route("PruneClusterMembership").PruneClusterMembership(ctx, request)
+}
+
+func (s *routingExecutionStore) CreateWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalCreateWorkflowExecutionRequest,
+) (*persistence.InternalCreateWorkflowExecutionResponse, error) {
+	return s.route("CreateWorkflowExecution").CreateWorkflowExecution(ctx, request)
+}
+
+func (s *routingExecutionStore) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalUpdateWorkflowExecutionRequest,
+) error {
+	return s.route("UpdateWorkflowExecution").UpdateWorkflowExecution(ctx, request)
+}
+
+func (s *routingExecutionStore) ConflictResolveWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalConflictResolveWorkflowExecutionRequest,
+) error {
+	return s.route("ConflictResolveWorkflowExecution").ConflictResolveWorkflowExecution(ctx, request)
+}
+
+func (s *routingExecutionStore) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *persistence.DeleteWorkflowExecutionRequest,
+) error {
+	return s.route("DeleteWorkflowExecution").DeleteWorkflowExecution(ctx, request)
+}
+
+func (s *routingExecutionStore) DeleteCurrentWorkflowExecution(
+	ctx context.Context,
+	request *persistence.DeleteCurrentWorkflowExecutionRequest,
+) error {
+	return s.route("DeleteCurrentWorkflowExecution").DeleteCurrentWorkflowExecution(ctx, request)
+}
+
+func (s *routingExecutionStore) GetCurrentExecution(
+	ctx context.Context,
+	request *persistence.GetCurrentExecutionRequest,
+) (*persistence.InternalGetCurrentExecutionResponse, error) {
+	return s.route("GetCurrentExecution").GetCurrentExecution(ctx, request)
+}
+
+func (s *routingExecutionStore) GetWorkflowExecution(
+	ctx context.Context,
+	request *persistence.GetWorkflowExecutionRequest,
+) (*persistence.InternalGetWorkflowExecutionResponse, error) {
+	return s.route("GetWorkflowExecution").GetWorkflowExecution(ctx, request)
+}
+
+func (s *routingExecutionStore) SetWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalSetWorkflowExecutionRequest,
+) error {
+	return s.route("SetWorkflowExecution").SetWorkflowExecution(ctx, request)
+}
+
+func (s *routingExecutionStore) ListConcreteExecutions(
+	ctx context.Context,
+	request *persistence.ListConcreteExecutionsRequest,
+) (*persistence.InternalListConcreteExecutionsResponse, error) {
+	return s.route("ListConcreteExecutions").ListConcreteExecutions(ctx, request)
+}
+
+func (s *routingExecutionStore) RegisterHistoryTaskReader(
+	ctx context.Context,
+	request *persistence.RegisterHistoryTaskReaderRequest,
+) error {
+	return s.route("RegisterHistoryTaskReader").RegisterHistoryTaskReader(ctx, request)
+}
+
+func (s *routingExecutionStore) UnregisterHistoryTaskReader(
+	ctx context.Context,
+	request *persistence.UnregisterHistoryTaskReaderRequest,
+) {
+	s.route("UnregisterHistoryTaskReader").UnregisterHistoryTaskReader(ctx, request)
+}
+
+func (s *routingExecutionStore) UpdateHistoryTaskReaderProgress(
+	ctx context.Context,
+	request *persistence.UpdateHistoryTaskReaderProgressRequest,
+) {
+	s.route("UpdateHistoryTaskReaderProgress").UpdateHistoryTaskReaderProgress(ctx, request)
+}
+
+func (s *routingExecutionStore) AddHistoryTasks(
+	ctx context.Context,
+	request *persistence.InternalAddHistoryTasksRequest,
+) error {
+	return s.route("AddHistoryTasks").AddHistoryTasks(ctx, request)
+}
+
+func (s *routingExecutionStore) GetHistoryTasks(
+	ctx context.Context,
+	request *persistence.GetHistoryTasksRequest,
+) (*persistence.InternalGetHistoryTasksResponse, error) {
+	return s.route("GetHistoryTasks").GetHistoryTasks(ctx, request)
+}
+
+func (s *routingExecutionStore) CompleteHistoryTask(
+	ctx context.Context,
+	request *persistence.CompleteHistoryTaskRequest,
+) error {
+	return s.route("CompleteHistoryTask").CompleteHistoryTask(ctx, request)
+}
+
+func (s *routingExecutionStore) RangeCompleteHistoryTasks(
+	ctx context.Context,
+	request *persistence.RangeCompleteHistoryTasksRequest,
+) error {
+	return s.route("RangeCompleteHistoryTasks").RangeCompleteHistoryTasks(ctx, request)
+}
+
+func (s *routingExecutionStore) PutReplicationTaskToDLQ(
+	ctx context.Context,
+	request *persistence.PutReplicationTaskToDLQRequest,
+) error {
+	return s.route("PutReplicationTaskToDLQ").PutReplicationTaskToDLQ(ctx, request)
+}
+
+func (s *routingExecutionStore) GetReplicationTasksFromDLQ(
+	ctx context.Context,
+	request *persistence.GetReplicationTasksFromDLQRequest,
+) (*persistence.InternalGetReplicationTasksFromDLQResponse, error) {
+	return s.route("GetReplicationTasksFromDLQ").GetReplicationTasksFromDLQ(ctx, request)
+}
+
+func (s *routingExecutionStore) DeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *persistence.DeleteReplicationTaskFromDLQRequest,
+) error {
+	return s.route("DeleteReplicationTaskFromDLQ").DeleteReplicationTaskFromDLQ(ctx, request)
+}
+
+func (s *routingExecutionStore) RangeDeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *persistence.RangeDeleteReplicationTaskFromDLQRequest,
+) error {
+	return s.route("RangeDeleteReplicationTaskFromDLQ").RangeDeleteReplicationTaskFromDLQ(ctx, request)
+}
+
+func (s *routingExecutionStore) IsReplicationDLQEmpty(
+	ctx context.Context,
+	request *persistence.GetReplicationTasksFromDLQRequest,
+) (bool, error) {
+	return s.route("IsReplicationDLQEmpty").IsReplicationDLQEmpty(ctx, request)
+}
+
+func (s *routingExecutionStore) InsertHistoryTree(
+	ctx context.Context,
+	request *persistence.InternalInsertHistoryTreeRequest,
+) error {
+	return s.route("InsertHistoryTree").InsertHistoryTree(ctx, request)
+}
+
+func (s *routingExecutionStore) AppendHistoryNodes(
+	ctx context.Context,
+	request *persistence.InternalAppendHistoryNodesRequest,
+) error {
+	return s.route("AppendHistoryNodes").AppendHistoryNodes(ctx, request)
+}
+
+func (s *routingExecutionStore) DeleteHistoryNodes(
+	ctx context.Context,
+	request *persistence.InternalDeleteHistoryNodesRequest,
+) error {
+	return s.route("DeleteHistoryNodes").DeleteHistoryNodes(ctx, request)
+}
+
+func (s *routingExecutionStore) ReadHistoryBranch(
+	ctx context.Context,
+	request *persistence.InternalReadHistoryBranchRequest,
+) (*persistence.InternalReadHistoryBranchResponse, error) {
+	return s.route("ReadHistoryBranch").ReadHistoryBranch(ctx, request)
+}
+
+func (s *routingExecutionStore) ForkHistoryBranch(
+	ctx context.Context,
+	request *persistence.InternalForkHistoryBranchRequest,
+) error {
+	return s.route("ForkHistoryBranch").ForkHistoryBranch(ctx, request)
+}
+
+func (s *routingExecutionStore) DeleteHistoryBranch(
+	ctx context.Context,
+	request *persistence.InternalDeleteHistoryBranchRequest,
+) error {
+	return s.route("DeleteHistoryBranch").DeleteHistoryBranch(ctx, request)
+}
+
+func (s *routingExecutionStore) GetHistoryTree(
+	ctx context.Context,
+	request *persistence.GetHistoryTreeRequest,
+) (*persistence.InternalGetHistoryTreeResponse, error) {
+	return s.route("GetHistoryTree").GetHistoryTree(ctx, request)
+}
+
+func (s *routingExecutionStore) GetAllHistoryTreeBranches(
+	ctx context.Context,
+	request *persistence.GetAllHistoryTreeBranchesRequest,
+) (*persistence.InternalGetAllHistoryTreeBranchesResponse, error) {
+	return s.route("GetAllHistoryTreeBranches").GetAllHistoryTreeBranches(ctx, request)
+}
+
+func (s *routingQueue) Init(
+	ctx context.Context,
+	blob *commonpb.DataBlob,
+) error {
+	return s.route("Init").Init(ctx, blob)
+}
+
+func (s *routingQueue) EnqueueMessage(
+	ctx context.Context,
+	blob commonpb.DataBlob,
+) error {
+	return s.route("EnqueueMessage").EnqueueMessage(ctx, blob)
+}
+
+func (s *routingQueue) ReadMessages(
+	ctx context.Context,
+	lastMessageID int64,
+	maxCount int,
+) ([]*persistence.QueueMessage, error) {
+	return s.route("ReadMessages").ReadMessages(ctx, lastMessageID, maxCount)
+}
+
+func (s *routingQueue) DeleteMessagesBefore(
+	ctx context.Context,
+	messageID int64,
+) error {
+	return s.route("DeleteMessagesBefore").DeleteMessagesBefore(ctx, messageID)
+}
+
+func (s *routingQueue) UpdateAckLevel(
+	ctx context.Context,
+	metadata *persistence.InternalQueueMetadata,
+) error {
+	return s.route("UpdateAckLevel").UpdateAckLevel(ctx, metadata)
+}
+
+func (s *routingQueue) GetAckLevels(
+	ctx context.Context,
+) (*persistence.InternalQueueMetadata, error) {
+	return s.route("GetAckLevels").GetAckLevels(ctx)
+}
+
+func (s *routingQueue) EnqueueMessageToDLQ(
+	ctx context.Context,
+	blob commonpb.DataBlob,
+) (int64, error) {
+	return s.route("EnqueueMessageToDLQ").EnqueueMessageToDLQ(ctx, blob)
+}
+
+func (s *routingQueue) ReadMessagesFromDLQ(
+	ctx context.Context,
+	firstMessageID int64,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]*persistence.QueueMessage, []byte, error) {
+	return s.route("ReadMessagesFromDLQ").ReadMessagesFromDLQ(ctx, firstMessageID, lastMessageID, pageSize, pageToken)
+}
+
+func (s *routingQueue) DeleteMessageFromDLQ(
+	ctx context.Context,
+	messageID int64,
+) error {
+	return s.route("DeleteMessageFromDLQ").DeleteMessageFromDLQ(ctx, messageID)
+}
+
+func (s *routingQueue) RangeDeleteMessagesFromDLQ(
+	ctx context.Context,
+	firstMessageID int64,
+	lastMessageID int64,
+) error {
+	return s.route("RangeDeleteMessagesFromDLQ").RangeDeleteMessagesFromDLQ(ctx, firstMessageID, lastMessageID)
+}
+
+func (s *routingQueue) UpdateDLQAckLevel(
+	ctx context.Context,
+	metadata *persistence.InternalQueueMetadata,
+) error {
+	return s.route("UpdateDLQAckLevel").UpdateDLQAckLevel(ctx, metadata)
+}
+
+func (s *routingQueue) GetDLQAckLevels(
+	ctx context.Context,
+) (*persistence.InternalQueueMetadata, error) {
+	return s.route("GetDLQAckLevels").GetDLQAckLevels(ctx)
+}
@@ -0,0 +1,124 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type (
+	countingDataStoreFactory struct {
+		DataStoreFactory
+		taskStore *countingTaskStore
+	}
+
+	countingTaskStore struct {
+		p.TaskStore
+		getTaskQueueCalls atomic.Int32
+		closed            bool
+	}
+)
+
+func (f *countingDataStoreFactory) NewTaskStore() (p.TaskStore, error) {
+	return f.taskStore, nil
+}
+
+func (s *countingTaskStore) GetTaskQueue(
+	_ context.Context,
+	_ *p.InternalGetTaskQueueRequest,
+) (*p.InternalGetTaskQueueResponse, error) {
+	s.getTaskQueueCalls.Add(1)
+	return nil, serviceerror.NewNotFound("task queue not found")
+}
+
+func (s *countingTaskStore) Close() {
+	s.closed = true
+}
+
+func TestRoutingDataStoreFactory_FlipOperationAtRuntime(t *testing.T) {
+	legacyStore := &countingTaskStore{}
+	newStore := &countingTaskStore{}
+	var newOperations atomic.Value
+	newOperations.Store(map[string]any{})
+
+	factory := NewFactory(
+		NewRoutingDataStoreFactory(
+			&countingDataStoreFactory{taskStore: legacyStore},
+			&countingDataStoreFactory{taskStore: newStore},
+			func() map[string]any { return newOperations.Load().(map[string]any) },
+		),
+		&config.Persistence{},
+		nil,
+		serialization.NewSerializer(),
+		"test-cluster",
+		nil,
+		log.NewNoopLogger(),
+		nil,
+		nil,
+		nil,
+	)
+	taskManager, err := factory.NewTaskManager()
+	require.NoError(t, err)
+	getTaskQueue := func() {
+		_, err := taskManager.GetTaskQueue(context.Background(), &p.GetTaskQueueRequest{})
+		var notFound *serviceerror.NotFound
+		require.ErrorAs(t, err, &notFound)
+	}
+
+	getTaskQueue()
+	require.Equal(t, int32(1), legacyStore.getTaskQueueCalls.Load())
+	require.Equal(t, int32(0), newStore.getTaskQueueCalls.Load())
+
+	// Other operations don't affect the routing of GetTaskQueue
+	newOperations.Store(map[string]any{"UpdateTaskQueue": true})
+	getTaskQueue()
+	require.Equal(t, int32(2), legacyStore.getTaskQueueCalls.Load())
+	require.Equal(t, int32(0), newStore.getTaskQueueCalls.Load())
+
+	newOperations.Store(map[string]any{"GetTaskQueue": true})
+	getTaskQueue()
+	getTaskQueue()
+	require.Equal(t, int32(2), legacyStore.getTaskQueueCalls.Load())
+	require.Equal(t, int32(2), newStore.getTaskQueueCalls.Load())
+
+	newOperations.Store(map[string]any{"GetTaskQueue": false})
+	getTaskQueue()
+	require.Equal(t, int32(3), legacyStore.getTaskQueueCalls.Load())
+	require.Equal(t, int32(2), newStore.getTaskQueueCalls.Load())
+
+	taskManager.Close()
+	require.True(t, legacyStore.closed)
+	require.True(t, newStore.closed)
+}
@@ -0,0 +1,145 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"time"
+
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/quotas"
+)
+
+type (
+	// SlowStartRequestRateLimiterImpl ramps the effective QPS of the wrapped rate limiter from a low floor up to the
+	// configured max over a warm-up duration after construction, so that a cold start does not let a thundering herd
+	// through before persistence health signals are available
+	SlowStartRequestRateLimiterImpl struct {
+		rateLimiter    quotas.RequestRateLimiter
+		maxQPS         PersistenceMaxQps
+		warmupDuration PersistenceSlowStartDuration
+		timeSource     clock.TimeSource
+		startTime      time.Time
+		warmupLimiter  *quotas.RateLimiterImpl
+	}
+)
+
+var _ quotas.RequestRateLimiter = (*SlowStartRequestRateLimiterImpl)(nil)
+
+var (
+	// SlowStartInitialQPSRatio is the ratio of the configured max QPS that is allowed right after construction
+	SlowStartInitialQPSRatio = 0.1
+)
+
+func NewSlowStartRequestRateLimiter(
+	rateLimiter quotas.RequestRateLimiter,
+	maxQPS PersistenceMaxQps,
+	warmupDuration PersistenceSlowStartDuration,
+	timeSource clock.TimeSource,
+) *SlowStartRequestRateLimiterImpl {
+	r := &SlowStartRequestRateLimiterImpl{
+		rateLimiter:    rateLimiter,
+		maxQPS:         maxQPS,
+		warmupDuration: warmupDuration,
+		timeSource:     timeSource,
+		startTime:      timeSource.Now(),
+	}
+	rate := r.Rate()
+	r.warmupLimiter = quotas.NewRateLimiter(rate, slowStartBurst(rate))
+	return r
+}
+
+// Rate returns the effective QPS at the current time, which grows linearly from SlowStartInitialQPSRatio of the
+// max QPS to the max QPS over the warm-up duration
+func (r *SlowStartRequestRateLimiterImpl) Rate() float64 {
+	maxQPS := float64(r.maxQPS())
+	warmup := r.warmupDuration()
+	elapsed := r.timeSource.Now().Sub(r.startTime)
+	if warmup <= 0 || elapsed >= warmup {
+		return maxQPS
+	}
+	floor := maxQPS * SlowStartInitialQPSRatio
+	return floor + (maxQPS-floor)*float64(elapsed)/float64(warmup)
+}
+
+func (r *SlowStartRequestRateLimiterImpl) Allow(
+	now time.Time,
+	request quotas.Request,
+) bool {
+	if r.warmedUp() {
+		return r.rateLimiter.Allow(now, request)
+	}
+	return r.warmupLimiter.AllowN(now, request.Token) && r.rateLimiter.Allow(now, request)
+}
+
+func (r *SlowStartRequestRateLimiterImpl) Reserve(
+	now time.Time,
+	request quotas.Request,
+) quotas.Reservation {
+	if r.warmedUp() {
+		return r.rateLimiter.Reserve(now, request)
+	}
+	warmupReservation := r.warmupLimiter.ReserveN(now, request.Token)
+	if !warmupReservation.OK() {
+		return quotas.NewMultiReservation(false, nil)
+	}
+	reservation := r.rateLimiter.Reserve(now, request)
+	if !reservation.OK() {
+		warmupReservation.CancelAt(now)
+		return quotas.NewMultiReservation(false, nil)
+	}
+	return quotas.NewMultiReservation(true, []quotas.Reservation{warmupReservation, reservation})
+}
+
+func (r *SlowStartRequestRateLimiterImpl) Wait(
+	ctx context.Context,
+	request quotas.Request,
+) error {
+	if r.warmedUp() {
+		return r.rateLimiter.Wait(ctx, request)
+	}
+	if err := r.warmupLimiter.WaitN(ctx, request.Token); err != nil {
+		return err
+	}
+	return r.rateLimiter.Wait(ctx, request)
+}
+
+// warmedUp refreshes the warm-up rate limiter and returns whether the warm-up is over
+func (r *SlowStartRequestRateLimiterImpl) warmedUp() bool {
+	warmup := r.warmupDuration()
+	if warmup <= 0 || r.timeSource.Now().Sub(r.startTime) >= warmup {
+		return true
+	}
+	rate := r.Rate()
+	r.warmupLimiter.SetRateBurst(rate, slowStartBurst(rate))
+	return false
+}
+
+func slowStartBurst(rate float64) int {
+	if rate < 1 {
+		return 1
+	}
+	return int(rate)
+}
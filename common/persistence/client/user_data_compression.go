@@ -0,0 +1,88 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type (
+	// UserDataCompressionDataStoreFactory wraps a DataStoreFactory so that task queue user data blobs larger than the
+	// threshold are gzip compressed before being written to the task store. Compressed blobs are persisted with the
+	// serialization.EncodingTypeProto3Gzip encoding and decompressed by the serializer when read back.
+	UserDataCompressionDataStoreFactory struct {
+		DataStoreFactory
+		threshold dynamicconfig.IntPropertyFn
+	}
+
+	userDataCompressionTaskStore struct {
+		persistence.TaskStore
+		threshold dynamicconfig.IntPropertyFn
+	}
+)
+
+var _ DataStoreFactory = (*UserDataCompressionDataStoreFactory)(nil)
+
+// NewUserDataCompressionDataStoreFactory returns a DataStoreFactory compressing the task queue user data blobs larger
+// than threshold bytes. A non-positive threshold disables compression of new writes, already compressed blobs stay
+// readable since decompression doesn't depend on this wrapper.
+func NewUserDataCompressionDataStoreFactory(
+	baseFactory DataStoreFactory,
+	threshold dynamicconfig.IntPropertyFn,
+) *UserDataCompressionDataStoreFactory {
+	return &UserDataCompressionDataStoreFactory{
+		DataStoreFactory: baseFactory,
+		threshold:        threshold,
+	}
+}
+
+func (f *UserDataCompressionDataStoreFactory) NewTaskStore() (persistence.TaskStore, error) {
+	store, err := f.DataStoreFactory.NewTaskStore()
+	if err != nil {
+		return nil, err
+	}
+	return &userDataCompressionTaskStore{TaskStore: store, threshold: f.threshold}, nil
+}
+
+func (s *userDataCompressionTaskStore) UpdateTaskQueueUserData(
+	ctx context.Context,
+	request *persistence.InternalUpdateTaskQueueUserDataRequest,
+) error {
+	threshold := s.threshold()
+	if threshold <= 0 || len(request.UserData.GetData()) <= threshold {
+		return s.TaskStore.UpdateTaskQueueUserData(ctx, request)
+	}
+	userData, err := serialization.Proto3GzipEncodeBlob(request.UserData)
+	if err != nil {
+		return err
+	}
+	compressed := *request
+	compressed.UserData = userData
+	return s.TaskStore.UpdateTaskQueueUserData(ctx, &compressed)
+}
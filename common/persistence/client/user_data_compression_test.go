@@ -0,0 +1,199 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	commonclock "go.temporal.io/server/common/clock"
+	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type (
+	memoryUserDataStoreFactory struct {
+		DataStoreFactory
+		taskStore *memoryUserDataStore
+	}
+
+	memoryUserDataStore struct {
+		p.TaskStore
+		userData map[string]*p.InternalGetTaskQueueUserDataResponse
+	}
+)
+
+func (f *memoryUserDataStoreFactory) NewTaskStore() (p.TaskStore, error) {
+	return f.taskStore, nil
+}
+
+func (s *memoryUserDataStore) GetTaskQueueUserData(
+	_ context.Context,
+	request *p.GetTaskQueueUserDataRequest,
+) (*p.InternalGetTaskQueueUserDataResponse, error) {
+	userData, ok := s.userData[request.TaskQueue]
+	if !ok {
+		return nil, serviceerror.NewNotFound("user data not found")
+	}
+	return userData, nil
+}
+
+func (s *memoryUserDataStore) UpdateTaskQueueUserData(
+	_ context.Context,
+	request *p.InternalUpdateTaskQueueUserDataRequest,
+) error {
+	var version int64
+	if stored, ok := s.userData[request.TaskQueue]; ok {
+		version = stored.Version
+	}
+	if version != request.Version {
+		return &p.ConditionFailedError{Msg: "version mismatch"}
+	}
+	s.userData[request.TaskQueue] = &p.InternalGetTaskQueueUserDataResponse{
+		Version:  request.Version + 1,
+		UserData: request.UserData,
+	}
+	return nil
+}
+
+func (s *memoryUserDataStore) Close() {}
+
+func largeVersioningData(clock *hlc.Clock, sets int) *persistencespb.VersioningData {
+	data := &persistencespb.VersioningData{DefaultUpdateTimestamp: clock}
+	for i := 0; i < sets; i++ {
+		buildId := fmt.Sprintf("build-id-%d", i)
+		data.VersionSets = append(data.VersionSets, &persistencespb.CompatibleVersionSet{
+			SetIds: []string{fmt.Sprintf("set-id-%d", i)},
+			BuildIds: []*persistencespb.BuildId{{
+				Id:                    buildId,
+				State:                 persistencespb.STATE_ACTIVE,
+				StateUpdateTimestamp:  clock,
+				Labels:                map[string]string{"commit": fmt.Sprintf("%040d", i), "owner": "team"},
+				LabelsUpdateTimestamp: clock,
+			}},
+			DefaultUpdateTimestamp: clock,
+		})
+		data.AuditLog = append(data.AuditLog, &persistencespb.VersioningAuditEntry{
+			Timestamp:      clock,
+			DefaultBuildId: buildId,
+		})
+	}
+	return data
+}
+
+func TestUserDataCompressionDataStoreFactory_RoundTrip(t *testing.T) {
+	store := &memoryUserDataStore{userData: make(map[string]*p.InternalGetTaskQueueUserDataResponse)}
+	threshold := 1024
+	factory := NewFactory(
+		NewUserDataCompressionDataStoreFactory(
+			&memoryUserDataStoreFactory{taskStore: store},
+			func() int { return threshold },
+		),
+		&config.Persistence{},
+		nil,
+		serialization.NewSerializer(),
+		"test-cluster",
+		nil,
+		log.NewNoopLogger(),
+		nil,
+		nil,
+		nil,
+	)
+	taskManager, err := factory.NewTaskManager()
+	require.NoError(t, err)
+	defer taskManager.Close()
+	ctx := context.Background()
+	timeSource := commonclock.NewEventTimeSource().Update(time.Now())
+
+	clock := hlc.Next(hlc.Zero(1), timeSource)
+	data := &persistencespb.TaskQueueUserData{Clock: &clock, VersioningData: largeVersioningData(&clock, 500)}
+	_, err = taskManager.CompareAndSwapTaskQueueUserData(ctx, &p.CompareAndSwapTaskQueueUserDataRequest{
+		NamespaceID: "namespace",
+		TaskQueue:   "large",
+		UserData:    data,
+	})
+	require.NoError(t, err)
+
+	serialized, err := data.Marshal()
+	require.NoError(t, err)
+	stored := store.userData["large"].UserData
+	require.Equal(t, serialization.EncodingTypeProto3Gzip, stored.EncodingType)
+	require.Less(t, len(stored.Data), len(serialized))
+	// the encoding is persisted under its own name, and a reader unaware of it fails instead of misreading the blob
+	require.Equal(t, stored, p.NewDataBlob(stored.Data, p.EncodingTypeString(stored.EncodingType)))
+	require.Error(t, serialization.ProtoDecodeBlob(stored, &persistencespb.TaskQueueUserData{}))
+
+	loaded, err := taskManager.GetTaskQueueUserData(ctx, &p.GetTaskQueueUserDataRequest{NamespaceID: "namespace", TaskQueue: "large"})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), loaded.UserData.Version)
+	require.True(t, proto.Equal(data, loaded.UserData.Data))
+
+	// the clock survives compression, so a stale write still conflicts while an update based on the loaded data applies
+	_, err = taskManager.CompareAndSwapTaskQueueUserData(ctx, &p.CompareAndSwapTaskQueueUserDataRequest{
+		NamespaceID: "namespace",
+		TaskQueue:   "large",
+		UserData:    data,
+	})
+	require.ErrorAs(t, err, new(*p.ConditionFailedError))
+
+	nextClock := hlc.Next(*loaded.UserData.Data.Clock, timeSource)
+	merged := proto.Clone(loaded.UserData.Data).(*persistencespb.TaskQueueUserData)
+	merged.Clock = &nextClock
+	merged.VersioningData.VersionSets = append(merged.VersioningData.VersionSets, largeVersioningData(&nextClock, 1).VersionSets...)
+	merged.VersioningData.DefaultUpdateTimestamp = &nextClock
+	_, err = taskManager.CompareAndSwapTaskQueueUserData(ctx, &p.CompareAndSwapTaskQueueUserDataRequest{
+		NamespaceID:   "namespace",
+		TaskQueue:     "large",
+		ExpectedClock: loaded.UserData.Data.Clock,
+		UserData:      merged,
+	})
+	require.NoError(t, err)
+
+	// compressed user data stays readable once compression is disabled, and new writes are left uncompressed
+	threshold = 0
+	loaded, err = taskManager.GetTaskQueueUserData(ctx, &p.GetTaskQueueUserDataRequest{NamespaceID: "namespace", TaskQueue: "large"})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), loaded.UserData.Version)
+	require.True(t, proto.Equal(merged, loaded.UserData.Data))
+
+	err = taskManager.UpdateTaskQueueUserData(ctx, &p.UpdateTaskQueueUserDataRequest{
+		NamespaceID: "namespace",
+		TaskQueue:   "large",
+		UserData:    loaded.UserData,
+	})
+	require.NoError(t, err)
+	require.Equal(t, enumspb.ENCODING_TYPE_PROTO3, store.userData["large"].UserData.EncodingType)
+}
@@ -0,0 +1,100 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package client
+
+import (
+	"context"
+	"time"
+
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/quotas"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+type (
+	// VisibilityRequestRateLimiterImpl isolates the QPS of visibility requests, e.g. the loading and completion of
+	// visibility tasks, from the QPS of core persistence requests: while the visibility max QPS is positive, visibility
+	// requests are limited by a pool of their own and don't consume the tokens of the wrapped core rate limiter, so a
+	// visibility request storm can't starve core operations and vice versa.
+	VisibilityRequestRateLimiterImpl struct {
+		rateLimiter           quotas.RequestRateLimiter
+		visibilityMaxQPS      PersistenceVisibilityMaxQps
+		visibilityRateLimiter quotas.RequestRateLimiter
+	}
+)
+
+var _ quotas.RequestRateLimiter = (*VisibilityRequestRateLimiterImpl)(nil)
+
+var (
+	// VisibilityAPIs are the persistence request APIs that are limited by the visibility QPS pool
+	VisibilityAPIs = map[string]struct{}{
+		p.ConstructHistoryTaskAPI("GetHistoryTasks", tasks.CategoryVisibility):           {},
+		p.ConstructHistoryTaskAPI("CompleteHistoryTask", tasks.CategoryVisibility):       {},
+		p.ConstructHistoryTaskAPI("RangeCompleteHistoryTasks", tasks.CategoryVisibility): {},
+	}
+)
+
+func NewVisibilityRequestRateLimiter(
+	rateLimiter quotas.RequestRateLimiter,
+	visibilityMaxQPS PersistenceVisibilityMaxQps,
+	requestPriorityFn quotas.RequestPriorityFn,
+) *VisibilityRequestRateLimiterImpl {
+	return &VisibilityRequestRateLimiterImpl{
+		rateLimiter:      rateLimiter,
+		visibilityMaxQPS: visibilityMaxQPS,
+		visibilityRateLimiter: newPriorityRateLimiter(
+			func() float64 { return float64(visibilityMaxQPS()) },
+			requestPriorityFn,
+		),
+	}
+}
+
+func (r *VisibilityRequestRateLimiterImpl) Allow(
+	now time.Time,
+	request quotas.Request,
+) bool {
+	return r.rateLimiterFor(request).Allow(now, request)
+}
+
+func (r *VisibilityRequestRateLimiterImpl) Reserve(
+	now time.Time,
+	request quotas.Request,
+) quotas.Reservation {
+	return r.rateLimiterFor(request).Reserve(now, request)
+}
+
+func (r *VisibilityRequestRateLimiterImpl) Wait(
+	ctx context.Context,
+	request quotas.Request,
+) error {
+	return r.rateLimiterFor(request).Wait(ctx, request)
+}
+
+func (r *VisibilityRequestRateLimiterImpl) rateLimiterFor(request quotas.Request) quotas.RequestRateLimiter {
+	if _, ok := VisibilityAPIs[request.API]; !ok || r.visibilityMaxQPS() <= 0 {
+		return r.rateLimiter
+	}
+	return r.visibilityRateLimiter
+}
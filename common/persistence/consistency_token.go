@@ -0,0 +1,93 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"sync"
+)
+
+type (
+	consistencyTokenKey         struct{}
+	consistencyTokenRecorderKey struct{}
+
+	// ConsistencyToken is an opaque token returned by eventually consistent datastores for a write. Passing it to a
+	// subsequent read with WithConsistencyToken guarantees the read observes that write.
+	ConsistencyToken []byte
+
+	// ConsistencyTokenRecorder collects the token of the writes made with the context returned by
+	// WithConsistencyTokenRecorder. Stores that don't support tokens never record one.
+	ConsistencyTokenRecorder struct {
+		lock  sync.Mutex
+		token ConsistencyToken
+	}
+)
+
+var (
+	consistencyTokenCtxKey         = consistencyTokenKey{}
+	consistencyTokenRecorderCtxKey = consistencyTokenRecorderKey{}
+)
+
+// WithConsistencyTokenRecorder returns a copy of ctx whose writes record their consistency token in the returned
+// recorder.
+func WithConsistencyTokenRecorder(ctx context.Context) (context.Context, *ConsistencyTokenRecorder) {
+	recorder := &ConsistencyTokenRecorder{}
+	return context.WithValue(ctx, consistencyTokenRecorderCtxKey, recorder), recorder
+}
+
+// Token returns the token of the last recorded write, or nil if no write recorded a token.
+func (r *ConsistencyTokenRecorder) Token() ConsistencyToken {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.token
+}
+
+// RecordConsistencyToken is called by stores that support consistency tokens after a successful write. It is a no-op
+// if the caller did not ask for tokens.
+func RecordConsistencyToken(ctx context.Context, token ConsistencyToken) {
+	recorder, ok := ctx.Value(consistencyTokenRecorderCtxKey).(*ConsistencyTokenRecorder)
+	if !ok {
+		return
+	}
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	recorder.token = token
+}
+
+// WithConsistencyToken returns a copy of ctx whose reads must observe the write the token was recorded for. An empty
+// token, e.g. from a store that doesn't support tokens, returns ctx unchanged.
+func WithConsistencyToken(ctx context.Context, token ConsistencyToken) context.Context {
+	if len(token) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, consistencyTokenCtxKey, token)
+}
+
+// GetConsistencyToken returns the consistency token attached to ctx, if any. Stores that support tokens use it to
+// make reads observe the corresponding write, others ignore it.
+func GetConsistencyToken(ctx context.Context) (ConsistencyToken, bool) {
+	token, ok := ctx.Value(consistencyTokenCtxKey).(ConsistencyToken)
+	return token, ok
+}
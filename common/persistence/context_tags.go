@@ -0,0 +1,61 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+
+	"go.temporal.io/server/common/headers"
+)
+
+type (
+	// ContextTags describes the upstream caller of a persistence request, so that DataStoreFactory stores can use
+	// them to annotate queries and logs, see GetContextTags.
+	ContextTags struct {
+		// Namespace is the name of the namespace the request is made on behalf of, empty for system requests.
+		Namespace string
+		// CallerType is one of the headers.CallerType* values.
+		CallerType string
+		// CallOrigin is the name of the API that originated the request.
+		CallOrigin string
+	}
+)
+
+// GetContextTags derives the tags of a persistence request from the caller info attached to ctx by
+// headers.SetCallerInfo. It returns false if ctx carries no caller info.
+func GetContextTags(ctx context.Context) (ContextTags, bool) {
+	callerInfo := headers.GetCallerInfo(ctx)
+	if callerInfo == (headers.CallerInfo{}) {
+		return ContextTags{}, false
+	}
+	tags := ContextTags{
+		CallerType: callerInfo.CallerType,
+		CallOrigin: callerInfo.CallOrigin,
+	}
+	if callerInfo.CallerName != headers.CallerNameSystem {
+		tags.Namespace = callerInfo.CallerName
+	}
+	return tags, true
+}
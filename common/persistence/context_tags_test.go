@@ -0,0 +1,55 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go.temporal.io/server/common/headers"
+)
+
+func TestGetContextTags(t *testing.T) {
+	ctx := headers.SetCallerInfo(context.Background(), headers.NewCallerInfo("test-namespace", headers.CallerTypeAPI, "PollWorkflowTaskQueue"))
+	tags, ok := GetContextTags(ctx)
+	require.True(t, ok)
+	require.Equal(t, ContextTags{
+		Namespace:  "test-namespace",
+		CallerType: headers.CallerTypeAPI,
+		CallOrigin: "PollWorkflowTaskQueue",
+	}, tags)
+}
+
+func TestGetContextTags_SystemCaller(t *testing.T) {
+	tags, ok := GetContextTags(headers.SetCallerInfo(context.Background(), headers.SystemBackgroundCallerInfo))
+	require.True(t, ok)
+	require.Empty(t, tags.Namespace)
+	require.Equal(t, headers.CallerTypeBackground, tags.CallerType)
+
+	_, ok = GetContextTags(context.Background())
+	require.False(t, ok)
+}
@@ -38,6 +38,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 
+	clockspb "go.temporal.io/server/api/clock/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/service/history/tasks"
@@ -57,6 +58,10 @@ const (
 	NamespaceReplicationQueueType QueueType = iota + 1
 )
 
+// historyTaskDLQQueueTypeBase is added to the shard ID to get the queue type of the history task DLQ of a shard, see
+// HistoryTaskDLQQueueType. It leaves room for the queue types above.
+const historyTaskDLQQueueTypeBase QueueType = 1 << 20
+
 // Create Workflow Execution Mode
 const (
 	// CreateWorkflowModeBrandNew fail if current record exists
@@ -561,6 +566,23 @@ type (
 		BuildIdsRemoved []string
 	}
 
+	// CompareAndSwapTaskQueueUserDataRequest is the input type for the CompareAndSwapTaskQueueUserData API
+	CompareAndSwapTaskQueueUserDataRequest struct {
+		NamespaceID string
+		TaskQueue   string
+		// ExpectedClock is the clock the stored user data must have for the swap to apply. Nil expects that no user
+		// data (or user data without a clock) is stored.
+		ExpectedClock   *clockspb.HybridLogicalClock
+		UserData        *persistencespb.TaskQueueUserData
+		BuildIdsAdded   []string
+		BuildIdsRemoved []string
+	}
+
+	// CompareAndSwapTaskQueueUserDataResponse is the output type for the CompareAndSwapTaskQueueUserData API
+	CompareAndSwapTaskQueueUserDataResponse struct {
+		UserData *persistencespb.VersionedTaskQueueUserData
+	}
+
 	ListTaskQueueUserDataEntriesRequest struct {
 		NamespaceID   string
 		PageSize      int
@@ -1173,6 +1195,10 @@ type (
 		// The caller should +1 increment the cached version number if this call succeeds.
 		// Fails with ConditionFailedError if the user data was updated concurrently.
 		UpdateTaskQueueUserData(ctx context.Context, request *UpdateTaskQueueUserDataRequest) error
+		// CompareAndSwapTaskQueueUserData replaces the user data for a given task queue only if the clock of the stored
+		// user data equals the expected clock, and returns the stored data with its new version.
+		// Fails with ConditionFailedError if the stored clock differs or the user data was updated concurrently.
+		CompareAndSwapTaskQueueUserData(ctx context.Context, request *CompareAndSwapTaskQueueUserDataRequest) (*CompareAndSwapTaskQueueUserDataResponse, error)
 		ListTaskQueueUserDataEntries(ctx context.Context, request *ListTaskQueueUserDataEntriesRequest) (*ListTaskQueueUserDataEntriesResponse, error)
 		GetTaskQueuesByBuildId(ctx context.Context, request *GetTaskQueuesByBuildIdRequest) ([]string, error)
 		CountTaskQueuesByBuildId(ctx context.Context, request *CountTaskQueuesByBuildIdRequest) (int, error)
@@ -724,6 +724,21 @@ func (mr *MockTaskManagerMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockTaskManager)(nil).Close))
 }
 
+// CompareAndSwapTaskQueueUserData mocks base method.
+func (m *MockTaskManager) CompareAndSwapTaskQueueUserData(ctx context.Context, request *CompareAndSwapTaskQueueUserDataRequest) (*CompareAndSwapTaskQueueUserDataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompareAndSwapTaskQueueUserData", ctx, request)
+	ret0, _ := ret[0].(*CompareAndSwapTaskQueueUserDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompareAndSwapTaskQueueUserData indicates an expected call of CompareAndSwapTaskQueueUserData.
+func (mr *MockTaskManagerMockRecorder) CompareAndSwapTaskQueueUserData(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareAndSwapTaskQueueUserData", reflect.TypeOf((*MockTaskManager)(nil).CompareAndSwapTaskQueueUserData), ctx, request)
+}
+
 // CompleteTask mocks base method.
 func (m *MockTaskManager) CompleteTask(ctx context.Context, request *CompleteTaskRequest) error {
 	m.ctrl.T.Helper()
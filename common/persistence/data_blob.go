@@ -27,6 +27,8 @@ package persistence
 import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/server/common/persistence/serialization"
 )
 
 // NewDataBlob returns a new DataBlob
@@ -38,8 +40,12 @@ func NewDataBlob(data []byte, encodingTypeStr string) *commonpb.DataBlob {
 
 	encodingType, ok := enumspb.EncodingType_value[encodingTypeStr]
 	if !ok {
-		// encodingTypeStr not valid, an error will be returned on deserialization
-		encodingType = int32(enumspb.ENCODING_TYPE_UNSPECIFIED)
+		if encodingTypeStr == serialization.EncodingTypeProto3GzipName {
+			encodingType = int32(serialization.EncodingTypeProto3Gzip)
+		} else {
+			// encodingTypeStr not valid, an error will be returned on deserialization
+			encodingType = int32(enumspb.ENCODING_TYPE_UNSPECIFIED)
+		}
 	}
 
 	return &commonpb.DataBlob{
@@ -47,3 +53,11 @@ func NewDataBlob(data []byte, encodingTypeStr string) *commonpb.DataBlob {
 		EncodingType: enumspb.EncodingType(encodingType),
 	}
 }
+
+// EncodingTypeString returns the name an encoding type is persisted under, the inverse of the mapping in NewDataBlob
+func EncodingTypeString(encodingType enumspb.EncodingType) string {
+	if encodingType == serialization.EncodingTypeProto3Gzip {
+		return serialization.EncodingTypeProto3GzipName
+	}
+	return encodingType.String()
+}
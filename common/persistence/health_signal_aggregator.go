@@ -47,6 +47,9 @@ type (
 		Record(callerSegment int32, latency time.Duration, err error)
 		AverageLatency() float64
 		ErrorRatio() float64
+		// ForStore returns the aggregator of the signals of a single store type. Signals recorded through it are
+		// recorded by this aggregator as well, while its AverageLatency and ErrorRatio only cover that store type.
+		ForStore(storeType string) HealthSignalAggregator
 	}
 
 	HealthSignalAggregatorImpl struct {
@@ -56,15 +59,28 @@ type (
 		requestsPerShard map[int32]int64
 		requestsLock     sync.Mutex
 
+		windowSize     time.Duration
+		maxBufferSize  int
 		latencyAverage aggregate.MovingWindowAverage
 		errorRatio     aggregate.MovingWindowAverage
 
+		stores     map[string]*storeSignalAggregator
+		storesLock sync.Mutex
+
 		metricsHandler       metrics.Handler
 		emitMetricsTimer     *time.Ticker
 		perShardRPSWarnLimit dynamicconfig.IntPropertyFn
 
 		logger log.Logger
 	}
+
+	// storeSignalAggregator tracks the signals of a single store type on behalf of a HealthSignalAggregatorImpl.
+	storeSignalAggregator struct {
+		parent *HealthSignalAggregatorImpl
+
+		latencyAverage aggregate.MovingWindowAverage
+		errorRatio     aggregate.MovingWindowAverage
+	}
 )
 
 func NewHealthSignalAggregatorImpl(
@@ -78,8 +94,11 @@ func NewHealthSignalAggregatorImpl(
 		status:               common.DaemonStatusInitialized,
 		shutdownCh:           make(chan struct{}),
 		requestsPerShard:     make(map[int32]int64),
+		windowSize:           windowSize,
+		maxBufferSize:        maxBufferSize,
 		latencyAverage:       aggregate.NewMovingWindowAvgImpl(windowSize, maxBufferSize),
 		errorRatio:           aggregate.NewMovingWindowAvgImpl(windowSize, maxBufferSize),
+		stores:               make(map[string]*storeSignalAggregator),
 		metricsHandler:       metricsHandler,
 		emitMetricsTimer:     time.NewTicker(emitMetricsInterval),
 		perShardRPSWarnLimit: perShardRPSWarnLimit,
@@ -103,14 +122,13 @@ func (s *HealthSignalAggregatorImpl) Stop() {
 }
 
 func (s *HealthSignalAggregatorImpl) Record(callerSegment int32, latency time.Duration, err error) {
-	// TODO: uncomment when adding dynamic rate limiter
-	//s.latencyAverage.Record(latency.Milliseconds())
-	//
-	//if isUnhealthyError(err) {
-	//	s.errorRatio.Record(1)
-	//} else {
-	//	s.errorRatio.Record(0)
-	//}
+	s.latencyAverage.Record(latency.Milliseconds())
+
+	if IsUnhealthyError(err) {
+		s.errorRatio.Record(1)
+	} else {
+		s.errorRatio.Record(0)
+	}
 
 	if callerSegment != CallerSegmentMissing {
 		s.incrementShardRequestCount(callerSegment)
@@ -125,6 +143,22 @@ func (s *HealthSignalAggregatorImpl) ErrorRatio() float64 {
 	return s.errorRatio.Average()
 }
 
+func (s *HealthSignalAggregatorImpl) ForStore(storeType string) HealthSignalAggregator {
+	s.storesLock.Lock()
+	defer s.storesLock.Unlock()
+
+	store, ok := s.stores[storeType]
+	if !ok {
+		store = &storeSignalAggregator{
+			parent:         s,
+			latencyAverage: aggregate.NewMovingWindowAvgImpl(s.windowSize, s.maxBufferSize),
+			errorRatio:     aggregate.NewMovingWindowAvgImpl(s.windowSize, s.maxBufferSize),
+		}
+		s.stores[storeType] = store
+	}
+	return store
+}
+
 func (s *HealthSignalAggregatorImpl) incrementShardRequestCount(shardID int32) {
 	s.requestsLock.Lock()
 	defer s.requestsLock.Unlock()
@@ -153,18 +187,47 @@ func (s *HealthSignalAggregatorImpl) emitMetricsLoop() {
 	}
 }
 
-// TODO: uncomment when adding dynamic rate limiter
-//func isUnhealthyError(err error) bool {
-//	if err == nil {
-//		return false
-//	}
-//	switch err.(type) {
-//	case *ShardOwnershipLostError,
-//		*AppendHistoryTimeoutError,
-//		*TimeoutError:
-//		return true
-//
-//	default:
-//		return false
-//	}
-//}
+// Start and Stop are no-ops, the lifecycle is owned by the parent aggregator.
+func (s *storeSignalAggregator) Start() {}
+
+func (s *storeSignalAggregator) Stop() {}
+
+func (s *storeSignalAggregator) Record(callerSegment int32, latency time.Duration, err error) {
+	s.parent.Record(callerSegment, latency, err)
+
+	s.latencyAverage.Record(latency.Milliseconds())
+	if IsUnhealthyError(err) {
+		s.errorRatio.Record(1)
+	} else {
+		s.errorRatio.Record(0)
+	}
+}
+
+func (s *storeSignalAggregator) AverageLatency() float64 {
+	return s.latencyAverage.Average()
+}
+
+func (s *storeSignalAggregator) ErrorRatio() float64 {
+	return s.errorRatio.Average()
+}
+
+func (s *storeSignalAggregator) ForStore(storeType string) HealthSignalAggregator {
+	return s.parent.ForStore(storeType)
+}
+
+// IsUnhealthyError returns whether err indicates that persistence is unhealthy, as opposed to an error caused by the
+// request itself.
+func IsUnhealthyError(err error) bool {
+	if err == nil {
+		return false
+	}
+	switch err.(type) {
+	case *ShardOwnershipLostError,
+		*AppendHistoryTimeoutError,
+		*TimeoutError:
+		return true
+
+	default:
+		return false
+	}
+}
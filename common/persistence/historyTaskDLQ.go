@@ -0,0 +1,178 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -copyright_file ../../LICENSE -package $GOPACKAGE -source $GOFILE -destination historyTaskDLQ_mock.go
+
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type (
+	// HistoryTaskDLQ is used to persist history tasks which repeatedly failed to be processed,
+	// so that they stop blocking the queue they were loaded from. Each shard has its own DLQ.
+	HistoryTaskDLQ interface {
+		EnqueueTask(ctx context.Context, message *persistencespb.HistoryTaskDLQMessage) (int64, error)
+		ReadTasks(
+			ctx context.Context,
+			shardID int32,
+			firstMessageID int64,
+			lastMessageID int64,
+			pageSize int,
+			pageToken []byte,
+		) ([]*persistencespb.HistoryTaskDLQMessage, []byte, error)
+		DeleteTask(ctx context.Context, shardID int32, messageID int64) error
+		RangeDeleteTasks(ctx context.Context, shardID int32, firstMessageID int64, lastMessageID int64) error
+	}
+
+	// QueueProvider returns the queue of the given queue type
+	QueueProvider func(queueType QueueType) (Queue, error)
+
+	historyTaskDLQImpl struct {
+		queueProvider QueueProvider
+
+		sync.Mutex
+		queues map[int32]Queue
+	}
+)
+
+var _ HistoryTaskDLQ = (*historyTaskDLQImpl)(nil)
+
+// NewHistoryTaskDLQ creates a new HistoryTaskDLQ instance backed by the DLQ side of one queue per shard, see
+// HistoryTaskDLQQueueType. Only the messages of the DLQ side are used, so the queue metadata is not initialized.
+func NewHistoryTaskDLQ(
+	queueProvider QueueProvider,
+) HistoryTaskDLQ {
+	return &historyTaskDLQImpl{
+		queueProvider: queueProvider,
+		queues:        make(map[int32]Queue),
+	}
+}
+
+// HistoryTaskDLQQueueType returns the queue type of the history task DLQ of the given shard
+func HistoryTaskDLQQueueType(shardID int32) QueueType {
+	return historyTaskDLQQueueTypeBase + QueueType(shardID)
+}
+
+func (q *historyTaskDLQImpl) EnqueueTask(
+	ctx context.Context,
+	message *persistencespb.HistoryTaskDLQMessage,
+) (int64, error) {
+	queue, err := q.getQueue(message.GetShardId())
+	if err != nil {
+		return EmptyQueueMessageID, err
+	}
+
+	blob, err := serialization.HistoryTaskDLQMessageToBlob(message)
+	if err != nil {
+		return EmptyQueueMessageID, fmt.Errorf("failed to encode history task dlq message: %v", err)
+	}
+	return queue.EnqueueMessageToDLQ(ctx, blob)
+}
+
+func (q *historyTaskDLQImpl) ReadTasks(
+	ctx context.Context,
+	shardID int32,
+	firstMessageID int64,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]*persistencespb.HistoryTaskDLQMessage, []byte, error) {
+	queue, err := q.getQueue(shardID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	messages, token, err := queue.ReadMessagesFromDLQ(ctx, firstMessageID, lastMessageID, pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dlqMessages := make([]*persistencespb.HistoryTaskDLQMessage, 0, len(messages))
+	for _, message := range messages {
+		dlqMessage, err := serialization.HistoryTaskDLQMessageFromBlob(message.Data, message.Encoding)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to decode history task dlq message: %v", err)
+		}
+
+		dlqMessage.MessageId = message.ID
+		dlqMessages = append(dlqMessages, dlqMessage)
+	}
+
+	return dlqMessages, token, nil
+}
+
+func (q *historyTaskDLQImpl) DeleteTask(
+	ctx context.Context,
+	shardID int32,
+	messageID int64,
+) error {
+	queue, err := q.getQueue(shardID)
+	if err != nil {
+		return err
+	}
+
+	return queue.DeleteMessageFromDLQ(ctx, messageID)
+}
+
+func (q *historyTaskDLQImpl) RangeDeleteTasks(
+	ctx context.Context,
+	shardID int32,
+	firstMessageID int64,
+	lastMessageID int64,
+) error {
+	queue, err := q.getQueue(shardID)
+	if err != nil {
+		return err
+	}
+
+	return queue.RangeDeleteMessagesFromDLQ(ctx, firstMessageID, lastMessageID)
+}
+
+func (q *historyTaskDLQImpl) getQueue(
+	shardID int32,
+) (Queue, error) {
+	if shardID <= 0 {
+		return nil, fmt.Errorf("invalid shard ID %v of history task dlq", shardID)
+	}
+
+	q.Lock()
+	defer q.Unlock()
+
+	if queue, ok := q.queues[shardID]; ok {
+		return queue, nil
+	}
+	queue, err := q.queueProvider(HistoryTaskDLQQueueType(shardID))
+	if err != nil {
+		return nil, err
+	}
+	q.queues[shardID] = queue
+	return queue, nil
+}
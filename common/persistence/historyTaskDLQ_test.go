@@ -0,0 +1,144 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+)
+
+// memoryDLQueue implements the DLQ side of a Queue in memory
+type memoryDLQueue struct {
+	Queue
+	messages []*QueueMessage
+}
+
+func (q *memoryDLQueue) EnqueueMessageToDLQ(_ context.Context, blob commonpb.DataBlob) (int64, error) {
+	id := int64(len(q.messages) + 1)
+	if len(q.messages) > 0 {
+		id = q.messages[len(q.messages)-1].ID + 1
+	}
+	q.messages = append(q.messages, &QueueMessage{ID: id, Data: blob.Data, Encoding: blob.EncodingType.String()})
+	return id, nil
+}
+
+func (q *memoryDLQueue) ReadMessagesFromDLQ(
+	_ context.Context,
+	firstMessageID int64,
+	lastMessageID int64,
+	_ int,
+	_ []byte,
+) ([]*QueueMessage, []byte, error) {
+	var messages []*QueueMessage
+	for _, message := range q.messages {
+		if message.ID > firstMessageID && message.ID <= lastMessageID {
+			messages = append(messages, message)
+		}
+	}
+	return messages, nil, nil
+}
+
+func (q *memoryDLQueue) DeleteMessageFromDLQ(ctx context.Context, messageID int64) error {
+	return q.RangeDeleteMessagesFromDLQ(ctx, messageID-1, messageID)
+}
+
+func (q *memoryDLQueue) RangeDeleteMessagesFromDLQ(_ context.Context, firstMessageID int64, lastMessageID int64) error {
+	messages := q.messages[:0]
+	for _, message := range q.messages {
+		if message.ID <= firstMessageID || message.ID > lastMessageID {
+			messages = append(messages, message)
+		}
+	}
+	q.messages = messages
+	return nil
+}
+
+func TestHistoryTaskDLQ_PartitionedByShard(t *testing.T) {
+	ctx := context.Background()
+	queues := make(map[QueueType]*memoryDLQueue)
+	dlq := NewHistoryTaskDLQ(func(queueType QueueType) (Queue, error) {
+		require.NotContains(t, queues, queueType, "queue of a shard must be created once")
+		queues[queueType] = &memoryDLQueue{}
+		return queues[queueType], nil
+	})
+
+	enqueue := func(shardID int32, workflowID string) int64 {
+		id, err := dlq.EnqueueTask(ctx, &persistencespb.HistoryTaskDLQMessage{
+			ShardId:    shardID,
+			TaskType:   enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK,
+			WorkflowId: workflowID,
+			Task:       &commonpb.DataBlob{Data: []byte("task")},
+		})
+		require.NoError(t, err)
+		return id
+	}
+	read := func(shardID int32) []string {
+		messages, _, err := dlq.ReadTasks(ctx, shardID, EmptyQueueMessageID, 100, 10, nil)
+		require.NoError(t, err)
+		var workflowIDs []string
+		for _, message := range messages {
+			require.Equal(t, shardID, message.GetShardId())
+			workflowIDs = append(workflowIDs, message.GetWorkflowId())
+		}
+		return workflowIDs
+	}
+
+	require.Equal(t, int64(1), enqueue(1, "wf-1"))
+	require.Equal(t, int64(2), enqueue(1, "wf-2"))
+	require.Equal(t, int64(3), enqueue(1, "wf-3"))
+	// message IDs are per shard
+	require.Equal(t, int64(1), enqueue(2, "wf-4"))
+	require.Len(t, queues, 2)
+	require.Contains(t, queues, HistoryTaskDLQQueueType(1))
+	require.Contains(t, queues, HistoryTaskDLQQueueType(2))
+	require.NotEqual(t, NamespaceReplicationQueueType, HistoryTaskDLQQueueType(1))
+
+	require.Equal(t, []string{"wf-1", "wf-2", "wf-3"}, read(1))
+	require.Equal(t, []string{"wf-4"}, read(2))
+	require.Empty(t, read(3))
+
+	messages, _, err := dlq.ReadTasks(ctx, 1, EmptyQueueMessageID, 100, 10, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), messages[1].GetMessageId())
+
+	require.NoError(t, dlq.DeleteTask(ctx, 1, 2))
+	require.Equal(t, []string{"wf-1", "wf-3"}, read(1))
+
+	// deleting the messages of a shard leaves the other shards alone
+	require.NoError(t, dlq.RangeDeleteTasks(ctx, 1, EmptyQueueMessageID, 3))
+	require.Empty(t, read(1))
+	require.Equal(t, []string{"wf-4"}, read(2))
+
+	_, err = dlq.EnqueueTask(ctx, &persistencespb.HistoryTaskDLQMessage{})
+	require.Error(t, err)
+	_, _, err = dlq.ReadTasks(ctx, 0, EmptyQueueMessageID, 100, 10, nil)
+	require.Error(t, err)
+}
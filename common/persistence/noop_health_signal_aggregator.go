@@ -49,3 +49,7 @@ func (a *noopSignalAggregator) AverageLatency() float64 {
 func (*noopSignalAggregator) ErrorRatio() float64 {
 	return 0
 }
+
+func (a *noopSignalAggregator) ForStore(_ string) HealthSignalAggregator {
+	return a
+}
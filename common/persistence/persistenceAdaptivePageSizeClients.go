@@ -0,0 +1,438 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// executionAdaptivePageSizeClient and taskAdaptivePageSizeClient adapt the page size of background scans
+	// (concrete executions, task queues and task queue user data) to persistence latency, see AdaptivePageSizer.
+	// User facing list operations keep the page size requested by the caller.
+	executionAdaptivePageSizeClient struct {
+		persistence            ExecutionManager
+		listConcreteExecutions *AdaptivePageSizer
+	}
+
+	taskAdaptivePageSizeClient struct {
+		persistence                  TaskManager
+		listTaskQueue                *AdaptivePageSizer
+		listTaskQueueUserDataEntries *AdaptivePageSizer
+	}
+)
+
+var _ ExecutionManager = (*executionAdaptivePageSizeClient)(nil)
+var _ TaskManager = (*taskAdaptivePageSizeClient)(nil)
+
+// NewExecutionPersistenceAdaptivePageSizeClient creates a client to manage executions
+func NewExecutionPersistenceAdaptivePageSizeClient(
+	persistence ExecutionManager,
+	config *AdaptivePageSizeConfig,
+	healthSignals HealthSignalAggregator,
+) ExecutionManager {
+	return &executionAdaptivePageSizeClient{
+		persistence:            persistence,
+		listConcreteExecutions: NewAdaptivePageSizer(config, healthSignals),
+	}
+}
+
+// NewTaskPersistenceAdaptivePageSizeClient creates a client to manage tasks
+func NewTaskPersistenceAdaptivePageSizeClient(
+	persistence TaskManager,
+	config *AdaptivePageSizeConfig,
+	healthSignals HealthSignalAggregator,
+) TaskManager {
+	return &taskAdaptivePageSizeClient{
+		persistence:                  persistence,
+		listTaskQueue:                NewAdaptivePageSizer(config, healthSignals),
+		listTaskQueueUserDataEntries: NewAdaptivePageSizer(config, healthSignals),
+	}
+}
+
+func (p *executionAdaptivePageSizeClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *executionAdaptivePageSizeClient) GetHistoryBranchUtil() HistoryBranchUtil {
+	return p.persistence.GetHistoryBranchUtil()
+}
+
+func (p *executionAdaptivePageSizeClient) CreateWorkflowExecution(
+	ctx context.Context,
+	request *CreateWorkflowExecutionRequest,
+) (*CreateWorkflowExecutionResponse, error) {
+	return p.persistence.CreateWorkflowExecution(ctx, request)
+}
+
+func (p *executionAdaptivePageSizeClient) GetWorkflowExecution(
+	ctx context.Context,
+	request *GetWorkflowExecutionRequest,
+) (*GetWorkflowExecutionResponse, error) {
+	return p.persistence.GetWorkflowExecution(ctx, request)
+}
+
+func (p *executionAdaptivePageSizeClient) SetWorkflowExecution(
+	ctx context.Context,
+	request *SetWorkflowExecutionRequest,
+) (*SetWorkflowExecutionResponse, error) {
+	return p.persistence.SetWorkflowExecution(ctx, request)
+}
+
+func (p *executionAdaptivePageSizeClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *UpdateWorkflowExecutionRequest,
+) (*UpdateWorkflowExecutionResponse, error) {
+	return p.persistence.UpdateWorkflowExecution(ctx, request)
+}
+
+func (p *executionAdaptivePageSizeClient) ConflictResolveWorkflowExecution(
+	ctx context.Context,
+	request *ConflictResolveWorkflowExecutionRequest,
+) (*ConflictResolveWorkflowExecutionResponse, error) {
+	return p.persistence.ConflictResolveWorkflowExecution(ctx, request)
+}
+
+func (p *executionAdaptivePageSizeClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *DeleteWorkflowExecutionRequest,
+) error {
+	return p.persistence.DeleteWorkflowExecution(ctx, request)
+}
+
+func (p *executionAdaptivePageSizeClient) DeleteCurrentWorkflowExecution(
+	ctx context.Context,
+	request *DeleteCurrentWorkflowExecutionRequest,
+) error {
+	return p.persistence.DeleteCurrentWorkflowExecution(ctx, request)
+}
+
+func (p *executionAdaptivePageSizeClient) GetCurrentExecution(
+	ctx context.Context,
+	request *GetCurrentExecutionRequest,
+) (*GetCurrentExecutionResponse, error) {
+	return p.persistence.GetCurrentExecution(ctx, request)
+}
+
+func (p *executionAdaptivePageSizeClient) ListConcreteExecutions(
+	ctx context.Context,
+	request *ListConcreteExecutionsRequest,
+) (*ListConcreteExecutionsResponse, error) {
+	adjusted := *request
+	adjusted.PageSize = p.listConcreteExecutions.PageSize(request.PageSize)
+	startTime := time.Now().UTC()
+	response, err := p.persistence.ListConcreteExecutions(ctx, &adjusted)
+	p.listConcreteExecutions.Record(time.Since(startTime), err)
+	return response, err
+}
+
+func (p *executionAdaptivePageSizeClient) RegisterHistoryTaskReader(
+	ctx context.Context,
+	request *RegisterHistoryTaskReaderRequest,
+) error {
+	return p.persistence.RegisterHistoryTaskReader(ctx, request)
+}
+
+func (p *executionAdaptivePageSizeClient) UnregisterHistoryTaskReader(
+	ctx context.Context,
+	request *UnregisterHistoryTaskReaderRequest,
+) {
+	p.persistence.UnregisterHistoryTaskReader(ctx, request)
+}
+
+func (p *executionAdaptivePageSizeClient) UpdateHistoryTaskReaderProgress(
+	ctx context.Context,
+	request *UpdateHistoryTaskReaderProgressRequest,
+) {
+	p.persistence.UpdateHistoryTaskReaderProgress(ctx, request)
+}
+
+func (p *executionAdaptivePageSizeClient) AddHistoryTasks(
+	ctx context.Context,
+	request *AddHistoryTasksRequest,
+) error {
+	return p.persistence.AddHistoryTasks(ctx, request)
+}
+
+func (p *executionAdaptivePageSizeClient) GetHistoryTasks(
+	ctx context.Context,
+	request *GetHistoryTasksRequest,
+) (*GetHistoryTasksResponse, error) {
+	return p.persistence.GetHistoryTasks(ctx, request)
+}
+
+func (p *executionAdaptivePageSizeClient) CompleteHistoryTask(
+	ctx context.Context,
+	request *CompleteHistoryTaskRequest,
+) error {
+	return p.persistence.CompleteHistoryTask(ctx, request)
+}
+
+func (p *executionAdaptivePageSizeClient) RangeCompleteHistoryTasks(
+	ctx context.Context,
+	request *RangeCompleteHistoryTasksRequest,
+) error {
+	return p.persistence.RangeCompleteHistoryTasks(ctx, request)
+}
+
+func (p *executionAdaptivePageSizeClient) PutReplicationTaskToDLQ(
+	ctx context.Context,
+	request *PutReplicationTaskToDLQRequest,
+) error {
+	return p.persistence.PutReplicationTaskToDLQ(ctx, request)
+}
+
+func (p *executionAdaptivePageSizeClient) GetReplicationTasksFromDLQ(
+	ctx context.Context,
+	request *GetReplicationTasksFromDLQRequest,
+) (*GetHistoryTasksResponse, error) {
+	return p.persistence.GetReplicationTasksFromDLQ(ctx, request)
+}
+
+func (p *executionAdaptivePageSizeClient) DeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *DeleteReplicationTaskFromDLQRequest,
+) error {
+	return p.persistence.DeleteReplicationTaskFromDLQ(ctx, request)
+}
+
+func (p *executionAdaptivePageSizeClient) RangeDeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *RangeDeleteReplicationTaskFromDLQRequest,
+) error {
+	return p.persistence.RangeDeleteReplicationTaskFromDLQ(ctx, request)
+}
+
+func (p *executionAdaptivePageSizeClient) IsReplicationDLQEmpty(
+	ctx context.Context,
+	request *GetReplicationTasksFromDLQRequest,
+) (bool, error) {
+	return p.persistence.IsReplicationDLQEmpty(ctx, request)
+}
+
+func (p *executionAdaptivePageSizeClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *executionAdaptivePageSizeClient) AppendHistoryNodes(
+	ctx context.Context,
+	request *AppendHistoryNodesRequest,
+) (*AppendHistoryNodesResponse, error) {
+	return p.persistence.AppendHistoryNodes(ctx, request)
+}
+
+func (p *executionAdaptivePageSizeClient) AppendRawHistoryNodes(
+	ctx context.Context,
+	request *AppendRawHistoryNodesRequest,
+) (*AppendHistoryNodesResponse, error) {
+	return p.persistence.AppendRawHistoryNodes(ctx, request)
+}
+
+func (p *executionAdaptivePageSizeClient) ReadHistoryBranch(
+	ctx context.Context,
+	request *ReadHistoryBranchRequest,
+) (*ReadHistoryBranchResponse, error) {
+	return p.persistence.ReadHistoryBranch(ctx, request)
+}
+
+func (p *executionAdaptivePageSizeClient) ReadHistoryBranchReverse(
+	ctx context.Context,
+	request *ReadHistoryBranchReverseRequest,
+) (*ReadHistoryBranchReverseResponse, error) {
+	return p.persistence.ReadHistoryBranchReverse(ctx, request)
+}
+
+func (p *executionAdaptivePageSizeClient) ReadHistoryBranchByBatch(
+	ctx context.Context,
+	request *ReadHistoryBranchRequest,
+) (*ReadHistoryBranchByBatchResponse, error) {
+	return p.persistence.ReadHistoryBranchByBatch(ctx, request)
+}
+
+func (p *executionAdaptivePageSizeClient) ReadRawHistoryBranch(
+	ctx context.Context,
+	request *ReadHistoryBranchRequest,
+) (*ReadRawHistoryBranchResponse, error) {
+	return p.persistence.ReadRawHistoryBranch(ctx, request)
+}
+
+func (p *executionAdaptivePageSizeClient) ForkHistoryBranch(
+	ctx context.Context,
+	request *ForkHistoryBranchRequest,
+) (*ForkHistoryBranchResponse, error) {
+	return p.persistence.ForkHistoryBranch(ctx, request)
+}
+
+func (p *executionAdaptivePageSizeClient) DeleteHistoryBranch(
+	ctx context.Context,
+	request *DeleteHistoryBranchRequest,
+) error {
+	return p.persistence.DeleteHistoryBranch(ctx, request)
+}
+
+func (p *executionAdaptivePageSizeClient) TrimHistoryBranch(
+	ctx context.Context,
+	request *TrimHistoryBranchRequest,
+) (*TrimHistoryBranchResponse, error) {
+	return p.persistence.TrimHistoryBranch(ctx, request)
+}
+
+func (p *executionAdaptivePageSizeClient) GetHistoryTree(
+	ctx context.Context,
+	request *GetHistoryTreeRequest,
+) (*GetHistoryTreeResponse, error) {
+	return p.persistence.GetHistoryTree(ctx, request)
+}
+
+func (p *executionAdaptivePageSizeClient) GetAllHistoryTreeBranches(
+	ctx context.Context,
+	request *GetAllHistoryTreeBranchesRequest,
+) (*GetAllHistoryTreeBranchesResponse, error) {
+	return p.persistence.GetAllHistoryTreeBranches(ctx, request)
+}
+
+func (p *taskAdaptivePageSizeClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *taskAdaptivePageSizeClient) CreateTasks(
+	ctx context.Context,
+	request *CreateTasksRequest,
+) (*CreateTasksResponse, error) {
+	return p.persistence.CreateTasks(ctx, request)
+}
+
+func (p *taskAdaptivePageSizeClient) GetTasks(
+	ctx context.Context,
+	request *GetTasksRequest,
+) (*GetTasksResponse, error) {
+	return p.persistence.GetTasks(ctx, request)
+}
+
+func (p *taskAdaptivePageSizeClient) CompleteTask(
+	ctx context.Context,
+	request *CompleteTaskRequest,
+) error {
+	return p.persistence.CompleteTask(ctx, request)
+}
+
+func (p *taskAdaptivePageSizeClient) CompleteTasksLessThan(
+	ctx context.Context,
+	request *CompleteTasksLessThanRequest,
+) (int, error) {
+	return p.persistence.CompleteTasksLessThan(ctx, request)
+}
+
+func (p *taskAdaptivePageSizeClient) CreateTaskQueue(
+	ctx context.Context,
+	request *CreateTaskQueueRequest,
+) (*CreateTaskQueueResponse, error) {
+	return p.persistence.CreateTaskQueue(ctx, request)
+}
+
+func (p *taskAdaptivePageSizeClient) UpdateTaskQueue(
+	ctx context.Context,
+	request *UpdateTaskQueueRequest,
+) (*UpdateTaskQueueResponse, error) {
+	return p.persistence.UpdateTaskQueue(ctx, request)
+}
+
+func (p *taskAdaptivePageSizeClient) GetTaskQueue(
+	ctx context.Context,
+	request *GetTaskQueueRequest,
+) (*GetTaskQueueResponse, error) {
+	return p.persistence.GetTaskQueue(ctx, request)
+}
+
+func (p *taskAdaptivePageSizeClient) ListTaskQueue(
+	ctx context.Context,
+	request *ListTaskQueueRequest,
+) (*ListTaskQueueResponse, error) {
+	adjusted := *request
+	adjusted.PageSize = p.listTaskQueue.PageSize(request.PageSize)
+	startTime := time.Now().UTC()
+	response, err := p.persistence.ListTaskQueue(ctx, &adjusted)
+	p.listTaskQueue.Record(time.Since(startTime), err)
+	return response, err
+}
+
+func (p *taskAdaptivePageSizeClient) DeleteTaskQueue(
+	ctx context.Context,
+	request *DeleteTaskQueueRequest,
+) error {
+	return p.persistence.DeleteTaskQueue(ctx, request)
+}
+
+func (p *taskAdaptivePageSizeClient) GetTaskQueueUserData(
+	ctx context.Context,
+	request *GetTaskQueueUserDataRequest,
+) (*GetTaskQueueUserDataResponse, error) {
+	return p.persistence.GetTaskQueueUserData(ctx, request)
+}
+
+func (p *taskAdaptivePageSizeClient) UpdateTaskQueueUserData(
+	ctx context.Context,
+	request *UpdateTaskQueueUserDataRequest,
+) error {
+	return p.persistence.UpdateTaskQueueUserData(ctx, request)
+}
+
+func (p *taskAdaptivePageSizeClient) CompareAndSwapTaskQueueUserData(
+	ctx context.Context,
+	request *CompareAndSwapTaskQueueUserDataRequest,
+) (*CompareAndSwapTaskQueueUserDataResponse, error) {
+	return p.persistence.CompareAndSwapTaskQueueUserData(ctx, request)
+}
+
+func (p *taskAdaptivePageSizeClient) ListTaskQueueUserDataEntries(
+	ctx context.Context,
+	request *ListTaskQueueUserDataEntriesRequest,
+) (*ListTaskQueueUserDataEntriesResponse, error) {
+	adjusted := *request
+	adjusted.PageSize = p.listTaskQueueUserDataEntries.PageSize(request.PageSize)
+	startTime := time.Now().UTC()
+	response, err := p.persistence.ListTaskQueueUserDataEntries(ctx, &adjusted)
+	p.listTaskQueueUserDataEntries.Record(time.Since(startTime), err)
+	return response, err
+}
+
+func (p *taskAdaptivePageSizeClient) GetTaskQueuesByBuildId(
+	ctx context.Context,
+	request *GetTaskQueuesByBuildIdRequest,
+) ([]string, error) {
+	return p.persistence.GetTaskQueuesByBuildId(ctx, request)
+}
+
+func (p *taskAdaptivePageSizeClient) CountTaskQueuesByBuildId(
+	ctx context.Context,
+	request *CountTaskQueuesByBuildIdRequest,
+) (int, error) {
+	return p.persistence.CountTaskQueuesByBuildId(ctx, request)
+}
+
+func (p *taskAdaptivePageSizeClient) Close() {
+	p.persistence.Close()
+}
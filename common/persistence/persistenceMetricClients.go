@@ -174,6 +174,7 @@ func (p *shardPersistenceClient) GetOrCreateShard(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceGetOrCreateShardScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceGetOrCreateShardScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.GetOrCreateShard(ctx, request)
 }
@@ -188,6 +189,7 @@ func (p *shardPersistenceClient) UpdateShard(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardInfo.GetShardId(), latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceUpdateShardScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceUpdateShardScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.UpdateShard(ctx, request)
 }
@@ -202,6 +204,7 @@ func (p *shardPersistenceClient) AssertShardOwnership(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceAssertShardOwnershipScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceAssertShardOwnershipScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.AssertShardOwnership(ctx, request)
 }
@@ -228,6 +231,7 @@ func (p *executionPersistenceClient) CreateWorkflowExecution(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceCreateWorkflowExecutionScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceCreateWorkflowExecutionScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.CreateWorkflowExecution(ctx, request)
 }
@@ -242,6 +246,7 @@ func (p *executionPersistenceClient) GetWorkflowExecution(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceGetWorkflowExecutionScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceGetWorkflowExecutionScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.GetWorkflowExecution(ctx, request)
 }
@@ -256,6 +261,7 @@ func (p *executionPersistenceClient) SetWorkflowExecution(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceSetWorkflowExecutionScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceSetWorkflowExecutionScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.SetWorkflowExecution(ctx, request)
 }
@@ -270,6 +276,7 @@ func (p *executionPersistenceClient) UpdateWorkflowExecution(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceUpdateWorkflowExecutionScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceUpdateWorkflowExecutionScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.UpdateWorkflowExecution(ctx, request)
 }
@@ -284,6 +291,7 @@ func (p *executionPersistenceClient) ConflictResolveWorkflowExecution(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceConflictResolveWorkflowExecutionScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceConflictResolveWorkflowExecutionScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.ConflictResolveWorkflowExecution(ctx, request)
 }
@@ -298,6 +306,7 @@ func (p *executionPersistenceClient) DeleteWorkflowExecution(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceDeleteWorkflowExecutionScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceDeleteWorkflowExecutionScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.DeleteWorkflowExecution(ctx, request)
 }
@@ -312,6 +321,7 @@ func (p *executionPersistenceClient) DeleteCurrentWorkflowExecution(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceDeleteCurrentWorkflowExecutionScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceDeleteCurrentWorkflowExecutionScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.DeleteCurrentWorkflowExecution(ctx, request)
 }
@@ -326,6 +336,7 @@ func (p *executionPersistenceClient) GetCurrentExecution(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceGetCurrentExecutionScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceGetCurrentExecutionScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.GetCurrentExecution(ctx, request)
 }
@@ -340,6 +351,7 @@ func (p *executionPersistenceClient) ListConcreteExecutions(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceListConcreteExecutionsScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceListConcreteExecutionsScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.ListConcreteExecutions(ctx, request)
 }
@@ -381,6 +393,7 @@ func (p *executionPersistenceClient) AddHistoryTasks(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceAddTasksScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceAddTasksScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.AddHistoryTasks(ctx, request)
 }
@@ -411,6 +424,7 @@ func (p *executionPersistenceClient) GetHistoryTasks(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
 		p.recordRequestMetrics(operation, caller, latency, retErr)
+		retErr = annotateError(operation, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.GetHistoryTasks(ctx, request)
 }
@@ -441,6 +455,7 @@ func (p *executionPersistenceClient) CompleteHistoryTask(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
 		p.recordRequestMetrics(operation, caller, latency, retErr)
+		retErr = annotateError(operation, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.CompleteHistoryTask(ctx, request)
 }
@@ -471,6 +486,7 @@ func (p *executionPersistenceClient) RangeCompleteHistoryTasks(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
 		p.recordRequestMetrics(operation, caller, latency, retErr)
+		retErr = annotateError(operation, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.RangeCompleteHistoryTasks(ctx, request)
 }
@@ -485,6 +501,7 @@ func (p *executionPersistenceClient) PutReplicationTaskToDLQ(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistencePutReplicationTaskToDLQScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistencePutReplicationTaskToDLQScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.PutReplicationTaskToDLQ(ctx, request)
 }
@@ -499,6 +516,7 @@ func (p *executionPersistenceClient) GetReplicationTasksFromDLQ(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceGetReplicationTasksFromDLQScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceGetReplicationTasksFromDLQScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.GetReplicationTasksFromDLQ(ctx, request)
 }
@@ -513,6 +531,7 @@ func (p *executionPersistenceClient) DeleteReplicationTaskFromDLQ(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceDeleteReplicationTaskFromDLQScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceDeleteReplicationTaskFromDLQScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.DeleteReplicationTaskFromDLQ(ctx, request)
 }
@@ -527,6 +546,7 @@ func (p *executionPersistenceClient) RangeDeleteReplicationTaskFromDLQ(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceRangeDeleteReplicationTaskFromDLQScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceRangeDeleteReplicationTaskFromDLQScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.RangeDeleteReplicationTaskFromDLQ(ctx, request)
 }
@@ -541,6 +561,7 @@ func (p *executionPersistenceClient) IsReplicationDLQEmpty(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(request.ShardID, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceGetReplicationTasksFromDLQScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceGetReplicationTasksFromDLQScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.IsReplicationDLQEmpty(ctx, request)
 }
@@ -563,6 +584,7 @@ func (p *taskPersistenceClient) CreateTasks(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceCreateTasksScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceCreateTasksScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.CreateTasks(ctx, request)
 }
@@ -577,6 +599,7 @@ func (p *taskPersistenceClient) GetTasks(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceGetTasksScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceGetTasksScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.GetTasks(ctx, request)
 }
@@ -591,6 +614,7 @@ func (p *taskPersistenceClient) CompleteTask(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceCompleteTaskScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceCompleteTaskScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.CompleteTask(ctx, request)
 }
@@ -605,6 +629,7 @@ func (p *taskPersistenceClient) CompleteTasksLessThan(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceCompleteTasksLessThanScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceCompleteTasksLessThanScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.CompleteTasksLessThan(ctx, request)
 }
@@ -619,6 +644,7 @@ func (p *taskPersistenceClient) CreateTaskQueue(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceCreateTaskQueueScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceCreateTaskQueueScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.CreateTaskQueue(ctx, request)
 }
@@ -633,6 +659,7 @@ func (p *taskPersistenceClient) UpdateTaskQueue(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceUpdateTaskQueueScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceUpdateTaskQueueScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.UpdateTaskQueue(ctx, request)
 }
@@ -647,6 +674,7 @@ func (p *taskPersistenceClient) GetTaskQueue(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceGetTaskQueueScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceGetTaskQueueScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.GetTaskQueue(ctx, request)
 }
@@ -661,6 +689,7 @@ func (p *taskPersistenceClient) ListTaskQueue(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceListTaskQueueScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceListTaskQueueScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.ListTaskQueue(ctx, request)
 }
@@ -675,6 +704,7 @@ func (p *taskPersistenceClient) DeleteTaskQueue(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceDeleteTaskQueueScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceDeleteTaskQueueScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.DeleteTaskQueue(ctx, request)
 }
@@ -689,6 +719,7 @@ func (p *taskPersistenceClient) GetTaskQueueUserData(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceGetTaskQueueUserDataScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceGetTaskQueueUserDataScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.GetTaskQueueUserData(ctx, request)
 }
@@ -703,10 +734,26 @@ func (p *taskPersistenceClient) UpdateTaskQueueUserData(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceUpdateTaskQueueUserDataScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceUpdateTaskQueueUserDataScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.UpdateTaskQueueUserData(ctx, request)
 }
 
+func (p *taskPersistenceClient) CompareAndSwapTaskQueueUserData(
+	ctx context.Context,
+	request *CompareAndSwapTaskQueueUserDataRequest,
+) (_ *CompareAndSwapTaskQueueUserDataResponse, retErr error) {
+	caller := headers.GetCallerInfo(ctx).CallerName
+	startTime := time.Now().UTC()
+	defer func() {
+		latency := time.Since(startTime)
+		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
+		p.recordRequestMetrics(metrics.PersistenceCompareAndSwapTaskQueueUserDataScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceCompareAndSwapTaskQueueUserDataScope, p.persistence.GetName(), retErr)
+	}()
+	return p.persistence.CompareAndSwapTaskQueueUserData(ctx, request)
+}
+
 func (p *taskPersistenceClient) ListTaskQueueUserDataEntries(
 	ctx context.Context,
 	request *ListTaskQueueUserDataEntriesRequest,
@@ -717,6 +764,7 @@ func (p *taskPersistenceClient) ListTaskQueueUserDataEntries(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceListTaskQueueUserDataEntriesScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceListTaskQueueUserDataEntriesScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.ListTaskQueueUserDataEntries(ctx, request)
 }
@@ -728,6 +776,7 @@ func (p *taskPersistenceClient) GetTaskQueuesByBuildId(ctx context.Context, requ
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceGetTaskQueuesByBuildIdScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceGetTaskQueuesByBuildIdScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.GetTaskQueuesByBuildId(ctx, request)
 }
@@ -739,6 +788,7 @@ func (p *taskPersistenceClient) CountTaskQueuesByBuildId(ctx context.Context, re
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceCountTaskQueuesByBuildIdScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceCountTaskQueuesByBuildIdScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.CountTaskQueuesByBuildId(ctx, request)
 }
@@ -761,6 +811,7 @@ func (p *metadataPersistenceClient) CreateNamespace(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceCreateNamespaceScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceCreateNamespaceScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.CreateNamespace(ctx, request)
 }
@@ -775,6 +826,7 @@ func (p *metadataPersistenceClient) GetNamespace(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceGetNamespaceScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceGetNamespaceScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.GetNamespace(ctx, request)
 }
@@ -789,6 +841,7 @@ func (p *metadataPersistenceClient) UpdateNamespace(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceUpdateNamespaceScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceUpdateNamespaceScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.UpdateNamespace(ctx, request)
 }
@@ -803,6 +856,7 @@ func (p *metadataPersistenceClient) RenameNamespace(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceRenameNamespaceScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceRenameNamespaceScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.RenameNamespace(ctx, request)
 }
@@ -817,6 +871,7 @@ func (p *metadataPersistenceClient) DeleteNamespace(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceDeleteNamespaceScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceDeleteNamespaceScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.DeleteNamespace(ctx, request)
 }
@@ -831,6 +886,7 @@ func (p *metadataPersistenceClient) DeleteNamespaceByName(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceDeleteNamespaceByNameScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceDeleteNamespaceByNameScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.DeleteNamespaceByName(ctx, request)
 }
@@ -845,6 +901,7 @@ func (p *metadataPersistenceClient) ListNamespaces(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceListNamespacesScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceListNamespacesScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.ListNamespaces(ctx, request)
 }
@@ -858,6 +915,7 @@ func (p *metadataPersistenceClient) GetMetadata(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceGetMetadataScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceGetMetadataScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.GetMetadata(ctx)
 }
@@ -877,6 +935,7 @@ func (p *executionPersistenceClient) AppendHistoryNodes(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceAppendHistoryNodesScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceAppendHistoryNodesScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.AppendHistoryNodes(ctx, request)
 }
@@ -892,6 +951,7 @@ func (p *executionPersistenceClient) AppendRawHistoryNodes(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceAppendRawHistoryNodesScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceAppendRawHistoryNodesScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.AppendRawHistoryNodes(ctx, request)
 }
@@ -907,6 +967,7 @@ func (p *executionPersistenceClient) ReadHistoryBranch(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceReadHistoryBranchScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceReadHistoryBranchScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.ReadHistoryBranch(ctx, request)
 }
@@ -921,6 +982,7 @@ func (p *executionPersistenceClient) ReadHistoryBranchReverse(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceReadHistoryBranchReverseScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceReadHistoryBranchReverseScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.ReadHistoryBranchReverse(ctx, request)
 }
@@ -936,6 +998,7 @@ func (p *executionPersistenceClient) ReadHistoryBranchByBatch(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceReadHistoryBranchScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceReadHistoryBranchScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.ReadHistoryBranchByBatch(ctx, request)
 }
@@ -951,6 +1014,7 @@ func (p *executionPersistenceClient) ReadRawHistoryBranch(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceReadRawHistoryBranchScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceReadRawHistoryBranchScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.ReadRawHistoryBranch(ctx, request)
 }
@@ -966,6 +1030,7 @@ func (p *executionPersistenceClient) ForkHistoryBranch(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceForkHistoryBranchScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceForkHistoryBranchScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.ForkHistoryBranch(ctx, request)
 }
@@ -981,6 +1046,7 @@ func (p *executionPersistenceClient) DeleteHistoryBranch(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceDeleteHistoryBranchScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceDeleteHistoryBranchScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.DeleteHistoryBranch(ctx, request)
 }
@@ -996,6 +1062,7 @@ func (p *executionPersistenceClient) TrimHistoryBranch(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceTrimHistoryBranchScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceTrimHistoryBranchScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.TrimHistoryBranch(ctx, request)
 }
@@ -1010,6 +1077,7 @@ func (p *executionPersistenceClient) GetAllHistoryTreeBranches(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceGetAllHistoryTreeBranchesScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceGetAllHistoryTreeBranchesScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.GetAllHistoryTreeBranches(ctx, request)
 }
@@ -1025,6 +1093,7 @@ func (p *executionPersistenceClient) GetHistoryTree(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceGetHistoryTreeScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceGetHistoryTreeScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.GetHistoryTree(ctx, request)
 }
@@ -1211,6 +1280,7 @@ func (p *clusterMetadataPersistenceClient) ListClusterMetadata(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceListClusterMetadataScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceListClusterMetadataScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.ListClusterMetadata(ctx, request)
 }
@@ -1224,6 +1294,7 @@ func (p *clusterMetadataPersistenceClient) GetCurrentClusterMetadata(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceGetCurrentClusterMetadataScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceGetCurrentClusterMetadataScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.GetCurrentClusterMetadata(ctx)
 }
@@ -1238,6 +1309,7 @@ func (p *clusterMetadataPersistenceClient) GetClusterMetadata(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceGetClusterMetadataScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceGetClusterMetadataScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.GetClusterMetadata(ctx, request)
 }
@@ -1252,6 +1324,7 @@ func (p *clusterMetadataPersistenceClient) SaveClusterMetadata(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceSaveClusterMetadataScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceSaveClusterMetadataScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.SaveClusterMetadata(ctx, request)
 }
@@ -1266,6 +1339,7 @@ func (p *clusterMetadataPersistenceClient) DeleteClusterMetadata(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceDeleteClusterMetadataScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceDeleteClusterMetadataScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.DeleteClusterMetadata(ctx, request)
 }
@@ -1284,6 +1358,7 @@ func (p *clusterMetadataPersistenceClient) GetClusterMembers(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceGetClusterMembersScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceGetClusterMembersScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.GetClusterMembers(ctx, request)
 }
@@ -1298,6 +1373,7 @@ func (p *clusterMetadataPersistenceClient) UpsertClusterMembership(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceUpsertClusterMembershipScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceUpsertClusterMembershipScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.UpsertClusterMembership(ctx, request)
 }
@@ -1312,6 +1388,7 @@ func (p *clusterMetadataPersistenceClient) PruneClusterMembership(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistencePruneClusterMembershipScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistencePruneClusterMembershipScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.PruneClusterMembership(ctx, request)
 }
@@ -1326,6 +1403,7 @@ func (p *metadataPersistenceClient) InitializeSystemNamespaces(
 		latency := time.Since(startTime)
 		p.healthSignals.Record(CallerSegmentMissing, latency, retErr)
 		p.recordRequestMetrics(metrics.PersistenceInitializeSystemNamespaceScope, caller, latency, retErr)
+		retErr = annotateError(metrics.PersistenceInitializeSystemNamespaceScope, p.persistence.GetName(), retErr)
 	}()
 	return p.persistence.InitializeSystemNamespaces(ctx, currentClusterName)
 }
@@ -1362,3 +1440,32 @@ func updateErrorMetric(handler metrics.Handler, logger log.Logger, operation str
 		}
 	}
 }
+
+// annotateError adds the operation name and store type to the message of err. The concrete
+// error type is preserved, so type switches and errors.As checks on the result still work.
+func annotateError(operation string, store string, err error) error {
+	if err == nil {
+		return nil
+	}
+	prefix := fmt.Sprintf("operation %v on %v: ", operation, store)
+	switch err := err.(type) {
+	case *serviceerror.NotFound:
+		return &serviceerror.NotFound{
+			Message:        prefix + err.Message,
+			CurrentCluster: err.CurrentCluster,
+			ActiveCluster:  err.ActiveCluster,
+		}
+	case *serviceerror.InvalidArgument:
+		return serviceerror.NewInvalidArgument(prefix + err.Message)
+	case *serviceerror.Internal:
+		return serviceerror.NewInternal(prefix + err.Message)
+	case *serviceerror.Unavailable:
+		return serviceerror.NewUnavailable(prefix + err.Message)
+	case *serviceerror.ResourceExhausted:
+		return serviceerror.NewResourceExhausted(err.Cause, prefix+err.Message)
+	case *TimeoutError:
+		return &TimeoutError{Msg: prefix + err.Msg}
+	default:
+		return err
+	}
+}
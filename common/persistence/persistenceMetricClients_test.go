@@ -0,0 +1,109 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	persistenceMetricClientsSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller         *gomock.Controller
+		mockExecutionStore *MockExecutionManager
+		executionClient    ExecutionManager
+	}
+)
+
+func TestPersistenceMetricClientsSuite(t *testing.T) {
+	s := new(persistenceMetricClientsSuite)
+	suite.Run(t, s)
+}
+
+func (s *persistenceMetricClientsSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.mockExecutionStore = NewMockExecutionManager(s.controller)
+	s.executionClient = NewExecutionPersistenceMetricsClient(
+		s.mockExecutionStore,
+		metrics.NoopMetricsHandler,
+		NoopHealthSignalAggregator,
+		log.NewNoopLogger(),
+	)
+}
+
+func (s *persistenceMetricClientsSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *persistenceMetricClientsSuite) TestErrorAnnotation_NotFound() {
+	s.mockExecutionStore.EXPECT().GetName().Return("sqlite").AnyTimes()
+	s.mockExecutionStore.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(nil, serviceerror.NewNotFound("workflow not found"))
+
+	_, err := s.executionClient.GetWorkflowExecution(context.Background(), &GetWorkflowExecutionRequest{})
+
+	var notFound *serviceerror.NotFound
+	s.True(errors.As(err, &notFound))
+	s.IsType(&serviceerror.NotFound{}, err)
+	s.Contains(err.Error(), metrics.PersistenceGetWorkflowExecutionScope)
+	s.Contains(err.Error(), "sqlite")
+	s.Contains(err.Error(), "workflow not found")
+}
+
+func (s *persistenceMetricClientsSuite) TestErrorAnnotation_ResourceExhausted() {
+	s.mockExecutionStore.EXPECT().GetName().Return("sqlite").AnyTimes()
+	s.mockExecutionStore.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(nil, ErrPersistenceLimitExceeded)
+
+	_, err := s.executionClient.GetWorkflowExecution(context.Background(), &GetWorkflowExecutionRequest{})
+
+	var resourceExhausted *serviceerror.ResourceExhausted
+	s.True(errors.As(err, &resourceExhausted))
+	s.Equal(ErrPersistenceLimitExceeded.(*serviceerror.ResourceExhausted).Cause, resourceExhausted.Cause)
+	s.Contains(err.Error(), metrics.PersistenceGetWorkflowExecutionScope)
+	s.Contains(err.Error(), ErrPersistenceLimitExceeded.Error())
+}
+
+func (s *persistenceMetricClientsSuite) TestErrorAnnotation_NoError() {
+	s.mockExecutionStore.EXPECT().GetName().Return("sqlite").AnyTimes()
+	s.mockExecutionStore.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(&GetWorkflowExecutionResponse{}, nil)
+
+	_, err := s.executionClient.GetWorkflowExecution(context.Background(), &GetWorkflowExecutionRequest{})
+	s.NoError(err)
+}
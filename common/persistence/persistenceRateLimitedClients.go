@@ -564,6 +564,16 @@ func (p taskRateLimitedPersistenceClient) UpdateTaskQueueUserData(
 	return p.persistence.UpdateTaskQueueUserData(ctx, request)
 }
 
+func (p taskRateLimitedPersistenceClient) CompareAndSwapTaskQueueUserData(
+	ctx context.Context,
+	request *CompareAndSwapTaskQueueUserDataRequest,
+) (*CompareAndSwapTaskQueueUserDataResponse, error) {
+	if ok := allow(ctx, "CompareAndSwapTaskQueueUserData", CallerSegmentMissing, p.rateLimiter); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	return p.persistence.CompareAndSwapTaskQueueUserData(ctx, request)
+}
+
 func (p taskRateLimitedPersistenceClient) ListTaskQueueUserDataEntries(
 	ctx context.Context,
 	request *ListTaskQueueUserDataEntriesRequest,
@@ -803,6 +803,21 @@ func (p *taskRetryablePersistenceClient) UpdateTaskQueueUserData(
 	return err
 }
 
+func (p *taskRetryablePersistenceClient) CompareAndSwapTaskQueueUserData(
+	ctx context.Context,
+	request *CompareAndSwapTaskQueueUserDataRequest,
+) (*CompareAndSwapTaskQueueUserDataResponse, error) {
+	var response *CompareAndSwapTaskQueueUserDataResponse
+	op := func(ctx context.Context) error {
+		var err error
+		response, err = p.persistence.CompareAndSwapTaskQueueUserData(ctx, request)
+		return err
+	}
+
+	err := backoff.ThrottleRetryContext(ctx, op, p.policy, p.isRetryable)
+	return response, err
+}
+
 func (p *taskRetryablePersistenceClient) ListTaskQueueUserDataEntries(
 	ctx context.Context,
 	request *ListTaskQueueUserDataEntriesRequest,
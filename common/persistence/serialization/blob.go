@@ -120,6 +120,15 @@ func QueueStateFromBlob(blob []byte, encoding string) (*persistencespb.QueueStat
 	return result, proto3Decode(blob, encoding, result)
 }
 
+func HistoryTaskDLQMessageToBlob(message *persistencespb.HistoryTaskDLQMessage) (commonpb.DataBlob, error) {
+	return proto3Encode(message)
+}
+
+func HistoryTaskDLQMessageFromBlob(blob []byte, encoding string) (*persistencespb.HistoryTaskDLQMessage, error) {
+	result := &persistencespb.HistoryTaskDLQMessage{}
+	return result, proto3Decode(blob, encoding, result)
+}
+
 func encode(
 	object proto.Message,
 	encoding enumspb.EncodingType,
@@ -0,0 +1,78 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serialization
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+)
+
+const (
+	// EncodingTypeProto3Gzip is the server internal encoding of gzip compressed proto3 blobs. It lies outside of the
+	// public EncodingType enum and is persisted as EncodingTypeProto3GzipName, so that servers not aware of it reject
+	// such blobs with an unknown encoding type error instead of misreading them.
+	EncodingTypeProto3Gzip enumspb.EncodingType = 1000
+	// EncodingTypeProto3GzipName is the persisted name of EncodingTypeProto3Gzip.
+	EncodingTypeProto3GzipName = "Proto3Gzip"
+)
+
+// Proto3GzipEncodeBlob compresses a proto3 encoded blob into an EncodingTypeProto3Gzip one.
+func Proto3GzipEncodeBlob(blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	if blob.GetEncodingType() != enumspb.ENCODING_TYPE_PROTO3 {
+		return nil, NewUnknownEncodingTypeError(blob.GetEncodingType().String(), enumspb.ENCODING_TYPE_PROTO3)
+	}
+
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(blob.GetData()); err != nil {
+		return nil, NewSerializationError(EncodingTypeProto3Gzip, err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, NewSerializationError(EncodingTypeProto3Gzip, err)
+	}
+	return &commonpb.DataBlob{EncodingType: EncodingTypeProto3Gzip, Data: buf.Bytes()}, nil
+}
+
+// proto3GzipDecodeBlob decompresses an EncodingTypeProto3Gzip blob back into a proto3 one. Blobs of any other encoding
+// are returned as is.
+func proto3GzipDecodeBlob(blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	if blob.GetEncodingType() != EncodingTypeProto3Gzip {
+		return blob, nil
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(blob.GetData()))
+	if err != nil {
+		return nil, NewDeserializationError(EncodingTypeProto3Gzip, err)
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, NewDeserializationError(EncodingTypeProto3Gzip, err)
+	}
+	return &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: data}, nil
+}
@@ -481,6 +481,11 @@ func (t *serializerImpl) TaskQueueUserDataToBlob(data *persistencespb.TaskQueueU
 }
 
 func (t *serializerImpl) TaskQueueUserDataFromBlob(data *commonpb.DataBlob) (*persistencespb.TaskQueueUserData, error) {
+	// user data may have been compressed on write, see client.UserDataCompressionDataStoreFactory
+	data, err := proto3GzipDecodeBlob(data)
+	if err != nil {
+		return nil, err
+	}
 	result := &persistencespb.TaskQueueUserData{}
 	return result, ProtoDecodeBlob(data, result)
 }
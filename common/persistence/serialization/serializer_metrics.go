@@ -0,0 +1,479 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serialization
+
+import (
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	replicationspb "go.temporal.io/server/api/replication/v1"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+const (
+	recordTypeEvents                 = "Events"
+	recordTypeEvent                  = "Event"
+	recordTypeClusterMetadata        = "ClusterMetadata"
+	recordTypeShardInfo              = "ShardInfo"
+	recordTypeNamespaceDetail        = "NamespaceDetail"
+	recordTypeHistoryTreeInfo        = "HistoryTreeInfo"
+	recordTypeHistoryBranch          = "HistoryBranch"
+	recordTypeWorkflowExecutionInfo  = "WorkflowExecutionInfo"
+	recordTypeWorkflowExecutionState = "WorkflowExecutionState"
+	recordTypeActivityInfo           = "ActivityInfo"
+	recordTypeChildExecutionInfo     = "ChildExecutionInfo"
+	recordTypeSignalInfo             = "SignalInfo"
+	recordTypeRequestCancelInfo      = "RequestCancelInfo"
+	recordTypeTimerInfo              = "TimerInfo"
+	recordTypeTaskInfo               = "TaskInfo"
+	recordTypeTaskQueueInfo          = "TaskQueueInfo"
+	recordTypeTaskQueueUserData      = "TaskQueueUserData"
+	recordTypeChecksum               = "Checksum"
+	recordTypeQueueMetadata          = "QueueMetadata"
+	recordTypeReplicationTask        = "ReplicationTask"
+	recordTypeTask                   = "Task"
+)
+
+type (
+	serializerWithMetrics struct {
+		Serializer
+		metricsHandler metrics.Handler
+	}
+)
+
+// NewSerializerWithMetrics returns a Serializer that emits the latency and the encoded size of every successful
+// serialization and deserialization, tagged by the type of the record.
+func NewSerializerWithMetrics(
+	serializer Serializer,
+	metricsHandler metrics.Handler,
+) Serializer {
+	return &serializerWithMetrics{
+		Serializer:     serializer,
+		metricsHandler: metricsHandler,
+	}
+}
+
+func (s *serializerWithMetrics) SerializeEvents(batch []*historypb.HistoryEvent, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
+	startTime := time.Now().UTC()
+	blob, err := s.Serializer.SerializeEvents(batch, encodingType)
+	if err == nil {
+		s.recordSerialization(recordTypeEvents, startTime, blob)
+	}
+	return blob, err
+}
+
+func (s *serializerWithMetrics) DeserializeEvents(data *commonpb.DataBlob) ([]*historypb.HistoryEvent, error) {
+	startTime := time.Now().UTC()
+	result, err := s.Serializer.DeserializeEvents(data)
+	if err == nil {
+		s.recordDeserialization(recordTypeEvents, startTime, data)
+	}
+	return result, err
+}
+
+func (s *serializerWithMetrics) SerializeEvent(event *historypb.HistoryEvent, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
+	startTime := time.Now().UTC()
+	blob, err := s.Serializer.SerializeEvent(event, encodingType)
+	if err == nil {
+		s.recordSerialization(recordTypeEvent, startTime, blob)
+	}
+	return blob, err
+}
+
+func (s *serializerWithMetrics) DeserializeEvent(data *commonpb.DataBlob) (*historypb.HistoryEvent, error) {
+	startTime := time.Now().UTC()
+	result, err := s.Serializer.DeserializeEvent(data)
+	if err == nil {
+		s.recordDeserialization(recordTypeEvent, startTime, data)
+	}
+	return result, err
+}
+
+func (s *serializerWithMetrics) SerializeClusterMetadata(icm *persistencespb.ClusterMetadata, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
+	startTime := time.Now().UTC()
+	blob, err := s.Serializer.SerializeClusterMetadata(icm, encodingType)
+	if err == nil {
+		s.recordSerialization(recordTypeClusterMetadata, startTime, blob)
+	}
+	return blob, err
+}
+
+func (s *serializerWithMetrics) DeserializeClusterMetadata(data *commonpb.DataBlob) (*persistencespb.ClusterMetadata, error) {
+	startTime := time.Now().UTC()
+	result, err := s.Serializer.DeserializeClusterMetadata(data)
+	if err == nil {
+		s.recordDeserialization(recordTypeClusterMetadata, startTime, data)
+	}
+	return result, err
+}
+
+func (s *serializerWithMetrics) ShardInfoToBlob(info *persistencespb.ShardInfo, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
+	startTime := time.Now().UTC()
+	blob, err := s.Serializer.ShardInfoToBlob(info, encodingType)
+	if err == nil {
+		s.recordSerialization(recordTypeShardInfo, startTime, blob)
+	}
+	return blob, err
+}
+
+func (s *serializerWithMetrics) ShardInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.ShardInfo, error) {
+	startTime := time.Now().UTC()
+	result, err := s.Serializer.ShardInfoFromBlob(data)
+	if err == nil {
+		s.recordDeserialization(recordTypeShardInfo, startTime, data)
+	}
+	return result, err
+}
+
+func (s *serializerWithMetrics) NamespaceDetailToBlob(info *persistencespb.NamespaceDetail, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
+	startTime := time.Now().UTC()
+	blob, err := s.Serializer.NamespaceDetailToBlob(info, encodingType)
+	if err == nil {
+		s.recordSerialization(recordTypeNamespaceDetail, startTime, blob)
+	}
+	return blob, err
+}
+
+func (s *serializerWithMetrics) NamespaceDetailFromBlob(data *commonpb.DataBlob) (*persistencespb.NamespaceDetail, error) {
+	startTime := time.Now().UTC()
+	result, err := s.Serializer.NamespaceDetailFromBlob(data)
+	if err == nil {
+		s.recordDeserialization(recordTypeNamespaceDetail, startTime, data)
+	}
+	return result, err
+}
+
+func (s *serializerWithMetrics) HistoryTreeInfoToBlob(info *persistencespb.HistoryTreeInfo, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
+	startTime := time.Now().UTC()
+	blob, err := s.Serializer.HistoryTreeInfoToBlob(info, encodingType)
+	if err == nil {
+		s.recordSerialization(recordTypeHistoryTreeInfo, startTime, blob)
+	}
+	return blob, err
+}
+
+func (s *serializerWithMetrics) HistoryTreeInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.HistoryTreeInfo, error) {
+	startTime := time.Now().UTC()
+	result, err := s.Serializer.HistoryTreeInfoFromBlob(data)
+	if err == nil {
+		s.recordDeserialization(recordTypeHistoryTreeInfo, startTime, data)
+	}
+	return result, err
+}
+
+func (s *serializerWithMetrics) HistoryBranchToBlob(info *persistencespb.HistoryBranch, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
+	startTime := time.Now().UTC()
+	blob, err := s.Serializer.HistoryBranchToBlob(info, encodingType)
+	if err == nil {
+		s.recordSerialization(recordTypeHistoryBranch, startTime, blob)
+	}
+	return blob, err
+}
+
+func (s *serializerWithMetrics) HistoryBranchFromBlob(data *commonpb.DataBlob) (*persistencespb.HistoryBranch, error) {
+	startTime := time.Now().UTC()
+	result, err := s.Serializer.HistoryBranchFromBlob(data)
+	if err == nil {
+		s.recordDeserialization(recordTypeHistoryBranch, startTime, data)
+	}
+	return result, err
+}
+
+func (s *serializerWithMetrics) WorkflowExecutionInfoToBlob(info *persistencespb.WorkflowExecutionInfo, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
+	startTime := time.Now().UTC()
+	blob, err := s.Serializer.WorkflowExecutionInfoToBlob(info, encodingType)
+	if err == nil {
+		s.recordSerialization(recordTypeWorkflowExecutionInfo, startTime, blob)
+	}
+	return blob, err
+}
+
+func (s *serializerWithMetrics) WorkflowExecutionInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.WorkflowExecutionInfo, error) {
+	startTime := time.Now().UTC()
+	result, err := s.Serializer.WorkflowExecutionInfoFromBlob(data)
+	if err == nil {
+		s.recordDeserialization(recordTypeWorkflowExecutionInfo, startTime, data)
+	}
+	return result, err
+}
+
+func (s *serializerWithMetrics) WorkflowExecutionStateToBlob(info *persistencespb.WorkflowExecutionState, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
+	startTime := time.Now().UTC()
+	blob, err := s.Serializer.WorkflowExecutionStateToBlob(info, encodingType)
+	if err == nil {
+		s.recordSerialization(recordTypeWorkflowExecutionState, startTime, blob)
+	}
+	return blob, err
+}
+
+func (s *serializerWithMetrics) WorkflowExecutionStateFromBlob(data *commonpb.DataBlob) (*persistencespb.WorkflowExecutionState, error) {
+	startTime := time.Now().UTC()
+	result, err := s.Serializer.WorkflowExecutionStateFromBlob(data)
+	if err == nil {
+		s.recordDeserialization(recordTypeWorkflowExecutionState, startTime, data)
+	}
+	return result, err
+}
+
+func (s *serializerWithMetrics) ActivityInfoToBlob(info *persistencespb.ActivityInfo, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
+	startTime := time.Now().UTC()
+	blob, err := s.Serializer.ActivityInfoToBlob(info, encodingType)
+	if err == nil {
+		s.recordSerialization(recordTypeActivityInfo, startTime, blob)
+	}
+	return blob, err
+}
+
+func (s *serializerWithMetrics) ActivityInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.ActivityInfo, error) {
+	startTime := time.Now().UTC()
+	result, err := s.Serializer.ActivityInfoFromBlob(data)
+	if err == nil {
+		s.recordDeserialization(recordTypeActivityInfo, startTime, data)
+	}
+	return result, err
+}
+
+func (s *serializerWithMetrics) ChildExecutionInfoToBlob(info *persistencespb.ChildExecutionInfo, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
+	startTime := time.Now().UTC()
+	blob, err := s.Serializer.ChildExecutionInfoToBlob(info, encodingType)
+	if err == nil {
+		s.recordSerialization(recordTypeChildExecutionInfo, startTime, blob)
+	}
+	return blob, err
+}
+
+func (s *serializerWithMetrics) ChildExecutionInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.ChildExecutionInfo, error) {
+	startTime := time.Now().UTC()
+	result, err := s.Serializer.ChildExecutionInfoFromBlob(data)
+	if err == nil {
+		s.recordDeserialization(recordTypeChildExecutionInfo, startTime, data)
+	}
+	return result, err
+}
+
+func (s *serializerWithMetrics) SignalInfoToBlob(info *persistencespb.SignalInfo, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
+	startTime := time.Now().UTC()
+	blob, err := s.Serializer.SignalInfoToBlob(info, encodingType)
+	if err == nil {
+		s.recordSerialization(recordTypeSignalInfo, startTime, blob)
+	}
+	return blob, err
+}
+
+func (s *serializerWithMetrics) SignalInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.SignalInfo, error) {
+	startTime := time.Now().UTC()
+	result, err := s.Serializer.SignalInfoFromBlob(data)
+	if err == nil {
+		s.recordDeserialization(recordTypeSignalInfo, startTime, data)
+	}
+	return result, err
+}
+
+func (s *serializerWithMetrics) RequestCancelInfoToBlob(info *persistencespb.RequestCancelInfo, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
+	startTime := time.Now().UTC()
+	blob, err := s.Serializer.RequestCancelInfoToBlob(info, encodingType)
+	if err == nil {
+		s.recordSerialization(recordTypeRequestCancelInfo, startTime, blob)
+	}
+	return blob, err
+}
+
+func (s *serializerWithMetrics) RequestCancelInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.RequestCancelInfo, error) {
+	startTime := time.Now().UTC()
+	result, err := s.Serializer.RequestCancelInfoFromBlob(data)
+	if err == nil {
+		s.recordDeserialization(recordTypeRequestCancelInfo, startTime, data)
+	}
+	return result, err
+}
+
+func (s *serializerWithMetrics) TimerInfoToBlob(info *persistencespb.TimerInfo, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
+	startTime := time.Now().UTC()
+	blob, err := s.Serializer.TimerInfoToBlob(info, encodingType)
+	if err == nil {
+		s.recordSerialization(recordTypeTimerInfo, startTime, blob)
+	}
+	return blob, err
+}
+
+func (s *serializerWithMetrics) TimerInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.TimerInfo, error) {
+	startTime := time.Now().UTC()
+	result, err := s.Serializer.TimerInfoFromBlob(data)
+	if err == nil {
+		s.recordDeserialization(recordTypeTimerInfo, startTime, data)
+	}
+	return result, err
+}
+
+func (s *serializerWithMetrics) TaskInfoToBlob(info *persistencespb.AllocatedTaskInfo, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
+	startTime := time.Now().UTC()
+	blob, err := s.Serializer.TaskInfoToBlob(info, encodingType)
+	if err == nil {
+		s.recordSerialization(recordTypeTaskInfo, startTime, blob)
+	}
+	return blob, err
+}
+
+func (s *serializerWithMetrics) TaskInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.AllocatedTaskInfo, error) {
+	startTime := time.Now().UTC()
+	result, err := s.Serializer.TaskInfoFromBlob(data)
+	if err == nil {
+		s.recordDeserialization(recordTypeTaskInfo, startTime, data)
+	}
+	return result, err
+}
+
+func (s *serializerWithMetrics) TaskQueueInfoToBlob(info *persistencespb.TaskQueueInfo, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
+	startTime := time.Now().UTC()
+	blob, err := s.Serializer.TaskQueueInfoToBlob(info, encodingType)
+	if err == nil {
+		s.recordSerialization(recordTypeTaskQueueInfo, startTime, blob)
+	}
+	return blob, err
+}
+
+func (s *serializerWithMetrics) TaskQueueInfoFromBlob(data *commonpb.DataBlob) (*persistencespb.TaskQueueInfo, error) {
+	startTime := time.Now().UTC()
+	result, err := s.Serializer.TaskQueueInfoFromBlob(data)
+	if err == nil {
+		s.recordDeserialization(recordTypeTaskQueueInfo, startTime, data)
+	}
+	return result, err
+}
+
+func (s *serializerWithMetrics) TaskQueueUserDataToBlob(info *persistencespb.TaskQueueUserData, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
+	startTime := time.Now().UTC()
+	blob, err := s.Serializer.TaskQueueUserDataToBlob(info, encodingType)
+	if err == nil {
+		s.recordSerialization(recordTypeTaskQueueUserData, startTime, blob)
+	}
+	return blob, err
+}
+
+func (s *serializerWithMetrics) TaskQueueUserDataFromBlob(data *commonpb.DataBlob) (*persistencespb.TaskQueueUserData, error) {
+	startTime := time.Now().UTC()
+	result, err := s.Serializer.TaskQueueUserDataFromBlob(data)
+	if err == nil {
+		s.recordDeserialization(recordTypeTaskQueueUserData, startTime, data)
+	}
+	return result, err
+}
+
+func (s *serializerWithMetrics) ChecksumToBlob(checksum *persistencespb.Checksum, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
+	startTime := time.Now().UTC()
+	blob, err := s.Serializer.ChecksumToBlob(checksum, encodingType)
+	if err == nil {
+		s.recordSerialization(recordTypeChecksum, startTime, blob)
+	}
+	return blob, err
+}
+
+func (s *serializerWithMetrics) ChecksumFromBlob(data *commonpb.DataBlob) (*persistencespb.Checksum, error) {
+	startTime := time.Now().UTC()
+	result, err := s.Serializer.ChecksumFromBlob(data)
+	if err == nil {
+		s.recordDeserialization(recordTypeChecksum, startTime, data)
+	}
+	return result, err
+}
+
+func (s *serializerWithMetrics) QueueMetadataToBlob(metadata *persistencespb.QueueMetadata, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
+	startTime := time.Now().UTC()
+	blob, err := s.Serializer.QueueMetadataToBlob(metadata, encodingType)
+	if err == nil {
+		s.recordSerialization(recordTypeQueueMetadata, startTime, blob)
+	}
+	return blob, err
+}
+
+func (s *serializerWithMetrics) QueueMetadataFromBlob(data *commonpb.DataBlob) (*persistencespb.QueueMetadata, error) {
+	startTime := time.Now().UTC()
+	result, err := s.Serializer.QueueMetadataFromBlob(data)
+	if err == nil {
+		s.recordDeserialization(recordTypeQueueMetadata, startTime, data)
+	}
+	return result, err
+}
+
+func (s *serializerWithMetrics) ReplicationTaskToBlob(replicationTask *replicationspb.ReplicationTask, encodingType enumspb.EncodingType) (*commonpb.DataBlob, error) {
+	startTime := time.Now().UTC()
+	blob, err := s.Serializer.ReplicationTaskToBlob(replicationTask, encodingType)
+	if err == nil {
+		s.recordSerialization(recordTypeReplicationTask, startTime, blob)
+	}
+	return blob, err
+}
+
+func (s *serializerWithMetrics) ReplicationTaskFromBlob(data *commonpb.DataBlob) (*replicationspb.ReplicationTask, error) {
+	startTime := time.Now().UTC()
+	result, err := s.Serializer.ReplicationTaskFromBlob(data)
+	if err == nil {
+		s.recordDeserialization(recordTypeReplicationTask, startTime, data)
+	}
+	return result, err
+}
+
+func (s *serializerWithMetrics) SerializeTask(task tasks.Task) (commonpb.DataBlob, error) {
+	startTime := time.Now().UTC()
+	blob, err := s.Serializer.SerializeTask(task)
+	if err == nil {
+		s.recordSerialization(recordTypeTask, startTime, &blob)
+	}
+	return blob, err
+}
+
+func (s *serializerWithMetrics) DeserializeTask(category tasks.Category, blob commonpb.DataBlob) (tasks.Task, error) {
+	startTime := time.Now().UTC()
+	result, err := s.Serializer.DeserializeTask(category, blob)
+	if err == nil {
+		s.recordDeserialization(recordTypeTask, startTime, &blob)
+	}
+	return result, err
+}
+
+func (s *serializerWithMetrics) recordSerialization(
+	recordType string,
+	startTime time.Time,
+	blob *commonpb.DataBlob,
+) {
+	handler := s.metricsHandler.WithTags(metrics.RecordTypeTag(recordType))
+	handler.Timer(metrics.PersistenceSerializationLatency.GetMetricName()).Record(time.Since(startTime))
+	handler.Histogram(metrics.PersistenceSerializedSize.GetMetricName(), metrics.PersistenceSerializedSize.GetMetricUnit()).Record(int64(len(blob.GetData())))
+}
+
+func (s *serializerWithMetrics) recordDeserialization(
+	recordType string,
+	startTime time.Time,
+	blob *commonpb.DataBlob,
+) {
+	handler := s.metricsHandler.WithTags(metrics.RecordTypeTag(recordType))
+	handler.Timer(metrics.PersistenceDeserializationLatency.GetMetricName()).Record(time.Since(startTime))
+	handler.Histogram(metrics.PersistenceDeserializedSize.GetMetricName(), metrics.PersistenceDeserializedSize.GetMetricUnit()).Record(int64(len(blob.GetData())))
+}
@@ -0,0 +1,91 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package serialization
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
+)
+
+func TestSerializerWithMetrics(t *testing.T) {
+	handler, err := metricstest.NewHandler(log.NewTestLogger(), metrics.ClientConfig{
+		PerUnitHistogramBoundaries: map[string][]float64{
+			metrics.Bytes: {64, 1024, 16384},
+		},
+	})
+	require.NoError(t, err)
+	serializer := NewSerializerWithMetrics(NewSerializer(), handler)
+
+	for _, size := range []int{10, 500, 5000, 50000} {
+		info := &persistencespb.ShardInfo{Owner: strings.Repeat("a", size)}
+		blob, err := serializer.ShardInfoToBlob(info, enumspb.ENCODING_TYPE_PROTO3)
+		require.NoError(t, err)
+		result, err := serializer.ShardInfoFromBlob(blob)
+		require.NoError(t, err)
+		require.Equal(t, info.Owner, result.Owner)
+	}
+	// Failures are not recorded
+	_, err = serializer.ShardInfoToBlob(&persistencespb.ShardInfo{}, enumspb.ENCODING_TYPE_JSON)
+	require.Error(t, err)
+
+	snapshot, err := handler.Snapshot()
+	require.NoError(t, err)
+	tags := []metrics.Tag{
+		metrics.StringTag("otel_scope_name", "temporal"),
+		metrics.StringTag("otel_scope_version", ""),
+		metrics.RecordTypeTag(recordTypeShardInfo),
+	}
+	// One record in each bucket
+	expectedBuckets := map[float64]float64{64: 1, 1024: 2, 16384: 3, math.Inf(1): 4}
+	for _, name := range []string{
+		metrics.PersistenceSerializedSize.GetMetricName(),
+		metrics.PersistenceDeserializedSize.GetMetricName(),
+	} {
+		buckets, err := snapshot.Histogram(name+"_bytes", tags...)
+		require.NoError(t, err)
+		actualBuckets := make(map[float64]float64, len(buckets))
+		for _, bucket := range buckets {
+			actualBuckets[bucket.UpperBound()] = bucket.Value()
+		}
+		require.Equal(t, expectedBuckets, actualBuckets, name)
+	}
+	for _, name := range []string{
+		metrics.PersistenceSerializationLatency.GetMetricName(),
+		metrics.PersistenceDeserializationLatency.GetMetricName(),
+	} {
+		buckets, err := snapshot.Histogram(name+"_milliseconds", tags...)
+		require.NoError(t, err)
+		require.Equal(t, float64(4), buckets[len(buckets)-1].Value(), name)
+	}
+}
@@ -508,7 +508,7 @@ func (m *sqlTaskManager) UpdateTaskQueueUserData(ctx context.Context, request *p
 			NamespaceID:   namespaceID,
 			TaskQueueName: request.TaskQueue,
 			Data:          request.UserData.Data,
-			DataEncoding:  request.UserData.EncodingType.String(),
+			DataEncoding:  persistence.EncodingTypeString(request.UserData.EncodingType),
 			Version:       request.Version,
 		})
 		if m.Db.IsDupEntryError(err) {
@@ -32,6 +32,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives/timestamp"
 )
@@ -279,6 +280,51 @@ func (m *taskManagerImpl) UpdateTaskQueueUserData(ctx context.Context, request *
 	return m.taskStore.UpdateTaskQueueUserData(ctx, internalRequest)
 }
 
+// CompareAndSwapTaskQueueUserData implements TaskManager
+func (m *taskManagerImpl) CompareAndSwapTaskQueueUserData(ctx context.Context, request *CompareAndSwapTaskQueueUserDataRequest) (*CompareAndSwapTaskQueueUserDataResponse, error) {
+	var version int64
+	var storedClock *hlc.Clock
+	stored, err := m.GetTaskQueueUserData(ctx, &GetTaskQueueUserDataRequest{
+		NamespaceID: request.NamespaceID,
+		TaskQueue:   request.TaskQueue,
+	})
+	if err == nil {
+		version = stored.UserData.GetVersion()
+		storedClock = stored.UserData.GetData().GetClock()
+	} else if _, ok := err.(*serviceerror.NotFound); !ok {
+		return nil, err
+	}
+	if !clocksEqual(storedClock, request.ExpectedClock) {
+		return nil, &ConditionFailedError{
+			Msg: fmt.Sprintf("Failed to update task queue user data. name: %v, expected clock: %v, stored clock: %v",
+				request.TaskQueue, request.ExpectedClock, storedClock),
+		}
+	}
+	// The store only applies the write if the version is still the one read above, which makes the whole operation
+	// atomic with respect to concurrent writers.
+	userData := &persistencespb.VersionedTaskQueueUserData{Version: version, Data: request.UserData}
+	err = m.UpdateTaskQueueUserData(ctx, &UpdateTaskQueueUserDataRequest{
+		NamespaceID:     request.NamespaceID,
+		TaskQueue:       request.TaskQueue,
+		UserData:        userData,
+		BuildIdsAdded:   request.BuildIdsAdded,
+		BuildIdsRemoved: request.BuildIdsRemoved,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &CompareAndSwapTaskQueueUserDataResponse{
+		UserData: &persistencespb.VersionedTaskQueueUserData{Version: version + 1, Data: request.UserData},
+	}, nil
+}
+
+func clocksEqual(a, b *hlc.Clock) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return hlc.Equal(*a, *b)
+}
+
 func (m *taskManagerImpl) ListTaskQueueUserDataEntries(ctx context.Context, request *ListTaskQueueUserDataEntriesRequest) (*ListTaskQueueUserDataEntriesResponse, error) {
 	response, err := m.taskStore.ListTaskQueueUserDataEntries(ctx, request)
 	if err != nil {
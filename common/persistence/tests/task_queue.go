@@ -37,6 +37,8 @@ import (
 	"go.temporal.io/api/serviceerror"
 
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	commonclock "go.temporal.io/server/common/clock"
+	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
 	"go.temporal.io/server/common/debug"
 	"go.temporal.io/server/common/log"
 	p "go.temporal.io/server/common/persistence"
@@ -255,6 +257,97 @@ func (s *TaskQueueSuite) TesList() {
 	// TODO there exists a SQL impl, but no cassandra impl ...
 }
 
+func (s *TaskQueueSuite) TestCompareAndSwapUserData() {
+	clock := hlc.Zero(1)
+	userData := &persistencespb.TaskQueueUserData{Clock: &clock}
+	resp, err := s.taskManager.CompareAndSwapTaskQueueUserData(s.ctx, &p.CompareAndSwapTaskQueueUserDataRequest{
+		NamespaceID: s.namespaceID,
+		TaskQueue:   s.taskQueueName,
+		UserData:    userData,
+	})
+	s.NoError(err)
+	s.Equal(&persistencespb.VersionedTaskQueueUserData{Version: 1, Data: userData}, resp.UserData)
+
+	nextClock := hlc.Next(clock, commonclock.NewRealTimeSource())
+	nextUserData := &persistencespb.TaskQueueUserData{Clock: &nextClock}
+	resp, err = s.taskManager.CompareAndSwapTaskQueueUserData(s.ctx, &p.CompareAndSwapTaskQueueUserDataRequest{
+		NamespaceID:   s.namespaceID,
+		TaskQueue:     s.taskQueueName,
+		ExpectedClock: &clock,
+		UserData:      nextUserData,
+	})
+	s.NoError(err)
+	s.Equal(&persistencespb.VersionedTaskQueueUserData{Version: 2, Data: nextUserData}, resp.UserData)
+	s.assertUserDataEqualWithDB(resp.UserData)
+}
+
+func (s *TaskQueueSuite) TestCompareAndSwapUserData_Conflict() {
+	clock := hlc.Zero(1)
+	userData := &persistencespb.TaskQueueUserData{Clock: &clock}
+	resp, err := s.taskManager.CompareAndSwapTaskQueueUserData(s.ctx, &p.CompareAndSwapTaskQueueUserDataRequest{
+		NamespaceID: s.namespaceID,
+		TaskQueue:   s.taskQueueName,
+		UserData:    userData,
+	})
+	s.NoError(err)
+
+	staleClock := hlc.Zero(2)
+	nextClock := hlc.Next(clock, commonclock.NewRealTimeSource())
+	_, err = s.taskManager.CompareAndSwapTaskQueueUserData(s.ctx, &p.CompareAndSwapTaskQueueUserDataRequest{
+		NamespaceID:   s.namespaceID,
+		TaskQueue:     s.taskQueueName,
+		ExpectedClock: &staleClock,
+		UserData:      &persistencespb.TaskQueueUserData{Clock: &nextClock},
+	})
+	s.IsType(&p.ConditionFailedError{}, err)
+	s.assertUserDataEqualWithDB(resp.UserData)
+
+	// Creating user data that already exists conflicts as well
+	_, err = s.taskManager.CompareAndSwapTaskQueueUserData(s.ctx, &p.CompareAndSwapTaskQueueUserDataRequest{
+		NamespaceID: s.namespaceID,
+		TaskQueue:   s.taskQueueName,
+		UserData:    &persistencespb.TaskQueueUserData{Clock: &nextClock},
+	})
+	s.IsType(&p.ConditionFailedError{}, err)
+	s.assertUserDataEqualWithDB(resp.UserData)
+}
+
+func (s *TaskQueueSuite) TestCompareAndSwapUserData_BuildIdMapping() {
+	otherTaskQueueName := uuid.New().String()
+	clock := hlc.Zero(1)
+	_, err := s.taskManager.CompareAndSwapTaskQueueUserData(s.ctx, &p.CompareAndSwapTaskQueueUserDataRequest{
+		NamespaceID:   s.namespaceID,
+		TaskQueue:     s.taskQueueName,
+		UserData:      &persistencespb.TaskQueueUserData{Clock: &clock},
+		BuildIdsAdded: []string{"b1", "b2", "b3"},
+	})
+	s.NoError(err)
+	_, err = s.taskManager.CompareAndSwapTaskQueueUserData(s.ctx, &p.CompareAndSwapTaskQueueUserDataRequest{
+		NamespaceID:   s.namespaceID,
+		TaskQueue:     otherTaskQueueName,
+		UserData:      &persistencespb.TaskQueueUserData{Clock: &clock},
+		BuildIdsAdded: []string{"b1"},
+	})
+	s.NoError(err)
+	s.assertTaskQueuesByBuildId("b1", s.taskQueueName, otherTaskQueueName)
+	s.assertTaskQueuesByBuildId("b2", s.taskQueueName)
+
+	nextClock := hlc.Next(clock, commonclock.NewRealTimeSource())
+	_, err = s.taskManager.CompareAndSwapTaskQueueUserData(s.ctx, &p.CompareAndSwapTaskQueueUserDataRequest{
+		NamespaceID:     s.namespaceID,
+		TaskQueue:       s.taskQueueName,
+		ExpectedClock:   &clock,
+		UserData:        &persistencespb.TaskQueueUserData{Clock: &nextClock},
+		BuildIdsRemoved: []string{"b1", "b2"},
+	})
+	s.NoError(err)
+
+	// Only the mappings of the updated task queue are removed
+	s.assertTaskQueuesByBuildId("b1", otherTaskQueueName)
+	s.assertTaskQueuesByBuildId("b2")
+	s.assertTaskQueuesByBuildId("b3", s.taskQueueName)
+}
+
 func (s *TaskQueueSuite) createTaskQueue(
 	rangeID int64,
 	taskQueueKind enumspb.TaskQueueKind,
@@ -315,3 +408,26 @@ func (s *TaskQueueSuite) assertEqualWithDB(
 	s.Equal(rangeID, resp.RangeID)
 	s.Equal(taskQueueInfo, resp.TaskQueueInfo)
 }
+
+func (s *TaskQueueSuite) assertUserDataEqualWithDB(
+	userData *persistencespb.VersionedTaskQueueUserData,
+) {
+	resp, err := s.taskManager.GetTaskQueueUserData(s.ctx, &p.GetTaskQueueUserDataRequest{
+		NamespaceID: s.namespaceID,
+		TaskQueue:   s.taskQueueName,
+	})
+	s.NoError(err)
+	s.Equal(userData, resp.UserData)
+}
+
+func (s *TaskQueueSuite) assertTaskQueuesByBuildId(
+	buildId string,
+	taskQueues ...string,
+) {
+	resp, err := s.taskManager.GetTaskQueuesByBuildId(s.ctx, &p.GetTaskQueuesByBuildIdRequest{
+		NamespaceID: s.namespaceID,
+		BuildID:     buildId,
+	})
+	s.NoError(err)
+	s.ElementsMatch(taskQueues, resp)
+}
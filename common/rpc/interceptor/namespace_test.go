@@ -62,6 +62,7 @@ var (
 	historyAPIExcluded = map[string]struct{}{
 		"CloseShard":                {},
 		"GetShard":                  {},
+		"DescribeHistoryQueue":      {},
 		"GetDLQMessages":            {},
 		"GetDLQReplicationMessages": {},
 		"GetReplicationMessages":    {},
@@ -0,0 +1,70 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package singleflight provides a typed wrapper around golang.org/x/sync/singleflight, to coalesce concurrent
+// identical calls into a single execution whose result is shared by all callers.
+package singleflight
+
+import (
+	"context"
+
+	"golang.org/x/sync/singleflight"
+)
+
+type (
+	// Group coalesces concurrent calls with the same key. The zero value is ready to use.
+	Group[T any] struct {
+		group singleflight.Group
+	}
+)
+
+// Do executes fn, unless a call with the same key is already in flight, in which case it waits for that call and
+// returns its result. shared is true if the result was given to more than one caller.
+func (g *Group[T]) Do(key string, fn func() (T, error)) (value T, shared bool, err error) {
+	v, err, shared := g.group.Do(key, func() (interface{}, error) {
+		return fn()
+	})
+	value, _ = v.(T)
+	return value, shared, err
+}
+
+// DoContext is like Do but stops waiting when ctx is done and returns ctx.Err(). The shared call keeps running for
+// the other callers, so fn should not depend on the context of any single caller.
+func (g *Group[T]) DoContext(ctx context.Context, key string, fn func() (T, error)) (value T, shared bool, err error) {
+	ch := g.group.DoChan(key, func() (interface{}, error) {
+		return fn()
+	})
+	select {
+	case res := <-ch:
+		value, _ = res.Val.(T)
+		return value, res.Shared, res.Err
+	case <-ctx.Done():
+		return value, false, ctx.Err()
+	}
+}
+
+// Forget makes the next call with the given key execute fn again, even if a call is still in flight.
+func (g *Group[T]) Forget(key string) {
+	g.group.Forget(key)
+}
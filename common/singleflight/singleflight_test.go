@@ -0,0 +1,94 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package singleflight
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroup_Do_CoalescesConcurrentCalls(t *testing.T) {
+	t.Parallel()
+	var g Group[int]
+	var calls atomic.Int32
+	release := make(chan struct{})
+	fn := func() (int, error) {
+		calls.Add(1)
+		<-release
+		return 42, nil
+	}
+
+	const callers = 10
+	var started, done sync.WaitGroup
+	started.Add(callers)
+	done.Add(callers)
+	for i := 0; i < callers; i++ {
+		go func() {
+			defer done.Done()
+			started.Done()
+			v, _, err := g.Do("key", fn)
+			assert.NoError(t, err)
+			assert.Equal(t, 42, v)
+		}()
+	}
+	started.Wait()
+	// give all callers a chance to join the in-flight call
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	done.Wait()
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestGroup_Do_ReturnsError(t *testing.T) {
+	t.Parallel()
+	var g Group[string]
+	myErr := errors.New("boom")
+	v, shared, err := g.Do("key", func() (string, error) { return "", myErr })
+	assert.ErrorIs(t, err, myErr)
+	assert.False(t, shared)
+	assert.Equal(t, "", v)
+}
+
+func TestGroup_DoContext_StopsWaitingOnContextDone(t *testing.T) {
+	t.Parallel()
+	var g Group[int]
+	release := make(chan struct{})
+	defer close(release)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, _, err := g.DoContext(ctx, "key", func() (int, error) {
+		<-release
+		return 1, nil
+	})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
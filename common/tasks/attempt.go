@@ -0,0 +1,59 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tasks
+
+import (
+	"context"
+)
+
+type (
+	attemptInfoKey struct{}
+
+	// AttemptInfo describes the processing attempt of a task a context was created for, so that executors and the
+	// layers below them can annotate their logs and traces consistently.
+	AttemptInfo struct {
+		// Attempt is the current attempt number, starting at 1.
+		Attempt int
+		// LifetimeAttempt is the attempt number across the lifetime of the task, it's not reset when the attempt is.
+		LifetimeAttempt int
+		// TaskID is the id of the task being processed.
+		TaskID int64
+	}
+)
+
+var (
+	attemptInfoCtxKey = attemptInfoKey{}
+)
+
+// WithAttemptInfo returns a copy of ctx carrying the given attempt info.
+func WithAttemptInfo(ctx context.Context, info AttemptInfo) context.Context {
+	return context.WithValue(ctx, attemptInfoCtxKey, info)
+}
+
+// GetAttemptInfo returns the attempt info attached to ctx, if any.
+func GetAttemptInfo(ctx context.Context) (AttemptInfo, bool) {
+	info, ok := ctx.Value(attemptInfoCtxKey).(AttemptInfo)
+	return info, ok
+}
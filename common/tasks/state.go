@@ -24,6 +24,8 @@
 
 package tasks
 
+import "strconv"
+
 // State represents the current state of a task
 type State int
 
@@ -39,3 +41,21 @@ const (
 	// TaskStateNacked is the state for a task if it can not be processed
 	TaskStateNacked
 )
+
+var (
+	StateName = map[State]string{
+		TaskStatePending:   "pending",
+		TaskStateAborted:   "aborted",
+		TaskStateCancelled: "cancelled",
+		TaskStateAcked:     "acked",
+		TaskStateNacked:    "nacked",
+	}
+)
+
+func (s State) String() string {
+	name, ok := StateName[s]
+	if ok {
+		return name
+	}
+	return strconv.Itoa(int(s))
+}
@@ -57,6 +57,7 @@ import (
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
@@ -111,12 +112,14 @@ type (
 		visibilityMgr               manager.VisibilityManager
 		persistenceExecutionManager persistence.ExecutionManager
 		namespaceReplicationQueue   persistence.NamespaceReplicationQueue
+		historyTaskDLQ              persistence.HistoryTaskDLQ
 		taskManager                 persistence.TaskManager
 		clusterMetadataManager      persistence.ClusterMetadataManager
 		persistenceMetadataManager  persistence.MetadataManager
 		clientFactory               serverClient.Factory
 		clientBean                  serverClient.Bean
 		historyClient               historyservice.HistoryServiceClient
+		matchingClient              matchingservice.MatchingServiceClient
 		sdkClientFactory            sdk.ClientFactory
 		membershipMonitor           membership.Monitor
 		metricsHandler              metrics.Handler
@@ -142,6 +145,7 @@ type (
 		ClientFactory                       serverClient.Factory
 		ClientBean                          serverClient.Bean
 		HistoryClient                       historyservice.HistoryServiceClient
+		MatchingClient                      matchingservice.MatchingServiceClient
 		sdkClientFactory                    sdk.ClientFactory
 		MembershipMonitor                   membership.Monitor
 		ArchiverProvider                    provider.ArchiverProvider
@@ -154,6 +158,7 @@ type (
 		HealthServer                        *health.Server
 		EventSerializer                     serialization.Serializer
 		TimeSource                          clock.TimeSource
+		HistoryTaskDLQ                      persistence.HistoryTaskDLQ
 	}
 )
 
@@ -188,12 +193,14 @@ func NewAdminHandler(
 		ESClient:                    args.EsClient,
 		persistenceExecutionManager: args.PersistenceExecutionManager,
 		namespaceReplicationQueue:   args.NamespaceReplicationQueue,
+		historyTaskDLQ:              args.HistoryTaskDLQ,
 		taskManager:                 args.TaskManager,
 		clusterMetadataManager:      args.ClusterMetadataManager,
 		persistenceMetadataManager:  args.PersistenceMetadataManager,
 		clientFactory:               args.ClientFactory,
 		clientBean:                  args.ClientBean,
 		historyClient:               args.HistoryClient,
+		matchingClient:              args.MatchingClient,
 		sdkClientFactory:            args.sdkClientFactory,
 		membershipMonitor:           args.MembershipMonitor,
 		metricsHandler:              args.MetricsHandler,
@@ -719,6 +726,30 @@ func (adh *AdminHandler) RemoveTask(ctx context.Context, request *adminservice.R
 	return &adminservice.RemoveTaskResponse{}, err
 }
 
+// DescribeHistoryQueue returns the reader watermarks, estimated backlog and oldest task age of the history task
+// queues of a shard
+func (adh *AdminHandler) DescribeHistoryQueue(ctx context.Context, request *adminservice.DescribeHistoryQueueRequest) (_ *adminservice.DescribeHistoryQueueResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetShardId() <= 0 {
+		return nil, errShardIDNotSet
+	}
+
+	resp, err := adh.historyClient.DescribeHistoryQueue(ctx, &historyservice.DescribeHistoryQueueRequest{
+		ShardId:  request.GetShardId(),
+		Category: request.GetCategory(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DescribeHistoryQueueResponse{
+		Queues: resp.GetQueues(),
+	}, nil
+}
+
 // GetShard returns information about the internal states of a shard
 func (adh *AdminHandler) GetShard(ctx context.Context, request *adminservice.GetShardRequest) (_ *adminservice.GetShardResponse, retError error) {
 	defer log.CapturePanic(adh.logger, &retError)
@@ -1437,6 +1468,28 @@ func (adh *AdminHandler) GetDLQMessages(
 			ReplicationTasks: tasks,
 			NextPageToken:    token,
 		}, nil
+	case enumsspb.DEAD_LETTER_QUEUE_TYPE_HISTORY_TASK:
+		// each shard has its own history task DLQ
+		if request.GetShardId() <= 0 {
+			return nil, errShardIDNotSet
+		}
+		tasks, token, err := adh.historyTaskDLQ.ReadTasks(
+			ctx,
+			request.GetShardId(),
+			persistence.EmptyQueueMessageID,
+			request.GetInclusiveEndMessageId(),
+			int(request.GetMaximumPageSize()),
+			request.GetNextPageToken(),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return &adminservice.GetDLQMessagesResponse{
+			Type:          request.GetType(),
+			HistoryTasks:  tasks,
+			NextPageToken: token,
+		}, nil
 	default:
 		return nil, errDLQTypeIsNotSupported
 	}
@@ -1477,6 +1530,21 @@ func (adh *AdminHandler) PurgeDLQMessages(
 		}
 
 		return &adminservice.PurgeDLQMessagesResponse{}, err
+	case enumsspb.DEAD_LETTER_QUEUE_TYPE_HISTORY_TASK:
+		if request.GetShardId() <= 0 {
+			return nil, errShardIDNotSet
+		}
+		err := adh.historyTaskDLQ.RangeDeleteTasks(
+			ctx,
+			request.GetShardId(),
+			persistence.EmptyQueueMessageID,
+			request.GetInclusiveEndMessageId(),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return &adminservice.PurgeDLQMessagesResponse{}, nil
 	default:
 		return nil, errDLQTypeIsNotSupported
 	}
@@ -1527,6 +1595,25 @@ func (adh *AdminHandler) MergeDLQMessages(
 		return &adminservice.MergeDLQMessagesResponse{
 			NextPageToken: token,
 		}, nil
+	case enumsspb.DEAD_LETTER_QUEUE_TYPE_HISTORY_TASK:
+		// tasks are re-enqueued by the history host owning their shard
+		if request.GetShardId() <= 0 {
+			return nil, errShardIDNotSet
+		}
+		resp, err := adh.historyClient.MergeDLQMessages(ctx, &historyservice.MergeDLQMessagesRequest{
+			Type:                  request.GetType(),
+			ShardId:               request.GetShardId(),
+			InclusiveEndMessageId: request.GetInclusiveEndMessageId(),
+			MaximumPageSize:       request.GetMaximumPageSize(),
+			NextPageToken:         request.GetNextPageToken(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return &adminservice.MergeDLQMessagesResponse{
+			NextPageToken: resp.GetNextPageToken(),
+		}, nil
 	default:
 		return nil, errDLQTypeIsNotSupported
 	}
@@ -1632,6 +1719,50 @@ func (adh *AdminHandler) GetTaskQueueTasks(
 	}, nil
 }
 
+// ListWorkerBuildIdCompatibility lists the compatible version sets of every task queue of a namespace which has
+// versioning data, so that all the live build ids of a namespace can be audited without a call per task queue.
+func (adh *AdminHandler) ListWorkerBuildIdCompatibility(
+	ctx context.Context,
+	request *adminservice.ListWorkerBuildIdCompatibilityRequest,
+) (_ *adminservice.ListWorkerBuildIdCompatibilityResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	if !adh.config.EnableWorkerVersioningData(request.GetNamespace()) {
+		return nil, errWorkerVersioningNotAllowed
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := adh.matchingClient.ListWorkerBuildIdCompatibility(ctx, &matchingservice.ListWorkerBuildIdCompatibilityRequest{
+		NamespaceId:   namespaceID.String(),
+		PageSize:      request.GetPageSize(),
+		NextPageToken: request.GetNextPageToken(),
+		MaxSets:       request.GetMaxSets(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	taskQueues := make([]*adminservice.ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility, 0, len(resp.GetTaskQueues()))
+	for _, taskQueue := range resp.GetTaskQueues() {
+		taskQueues = append(taskQueues, &adminservice.ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility{
+			TaskQueue:        taskQueue.GetTaskQueue(),
+			MajorVersionSets: taskQueue.GetMajorVersionSets(),
+		})
+	}
+	return &adminservice.ListWorkerBuildIdCompatibilityResponse{
+		TaskQueues:    taskQueues,
+		NextPageToken: resp.GetNextPageToken(),
+	}, nil
+}
+
 func (adh *AdminHandler) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
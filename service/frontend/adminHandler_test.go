@@ -89,6 +89,7 @@ type (
 		mockAdminClient            *adminservicemock.MockAdminServiceClient
 		mockMetadata               *cluster.MockMetadata
 		mockProducer               *persistence.MockNamespaceReplicationQueue
+		mockHistoryTaskDLQ         *persistence.MockHistoryTaskDLQ
 
 		namespace      namespace.Name
 		namespaceID    namespace.ID
@@ -130,6 +131,7 @@ func (s *adminHandlerSuite) SetupTest() {
 	s.mockMetadata = s.mockResource.ClusterMetadata
 	s.mockVisibilityMgr = s.mockResource.VisibilityManager
 	s.mockProducer = persistence.NewMockNamespaceReplicationQueue(s.controller)
+	s.mockHistoryTaskDLQ = persistence.NewMockHistoryTaskDLQ(s.controller)
 
 	persistenceConfig := &config.Persistence{
 		NumHistoryShards: 1,
@@ -153,6 +155,7 @@ func (s *adminHandlerSuite) SetupTest() {
 		s.mockResource.GetClientFactory(),
 		s.mockResource.GetClientBean(),
 		s.mockResource.GetHistoryClient(),
+		s.mockResource.GetMatchingClient(),
 		s.mockResource.GetSDKClientFactory(),
 		s.mockResource.GetMembershipMonitor(),
 		s.mockResource.GetArchiverProvider(),
@@ -165,6 +168,7 @@ func (s *adminHandlerSuite) SetupTest() {
 		health.NewServer(),
 		serialization.NewSerializer(),
 		clock.NewRealTimeSource(),
+		s.mockHistoryTaskDLQ,
 	}
 	s.mockMetadata.EXPECT().GetCurrentClusterName().Return(uuid.New()).AnyTimes()
 	s.handler = NewAdminHandler(args)
@@ -1444,3 +1448,80 @@ func (s *adminHandlerSuite) TestDeleteWorkflowExecution_CassandraVisibilityBacke
 	_, err = s.handler.DeleteWorkflowExecution(context.Background(), request)
 	s.NoError(err)
 }
+
+func (s *adminHandlerSuite) TestGetDLQMessages_HistoryTask() {
+	ctx := context.Background()
+	_, err := s.handler.GetDLQMessages(ctx, &adminservice.GetDLQMessagesRequest{
+		Type: enums.DEAD_LETTER_QUEUE_TYPE_HISTORY_TASK,
+	})
+	s.ErrorIs(err, errShardIDNotSet)
+
+	tasks := []*persistencespb.HistoryTaskDLQMessage{{MessageId: 1, ShardId: 3}, {MessageId: 2, ShardId: 3}}
+	s.mockHistoryTaskDLQ.EXPECT().ReadTasks(
+		gomock.Any(),
+		int32(3),
+		persistence.EmptyQueueMessageID,
+		int64(10),
+		common.ReadDLQMessagesPageSize,
+		[]byte("token"),
+	).Return(tasks, []byte("next-token"), nil)
+	resp, err := s.handler.GetDLQMessages(ctx, &adminservice.GetDLQMessagesRequest{
+		Type:                  enums.DEAD_LETTER_QUEUE_TYPE_HISTORY_TASK,
+		ShardId:               3,
+		InclusiveEndMessageId: 10,
+		NextPageToken:         []byte("token"),
+	})
+	s.NoError(err)
+	s.Equal(tasks, resp.GetHistoryTasks())
+	s.Equal([]byte("next-token"), resp.GetNextPageToken())
+}
+
+func (s *adminHandlerSuite) TestPurgeDLQMessages_HistoryTask() {
+	ctx := context.Background()
+	_, err := s.handler.PurgeDLQMessages(ctx, &adminservice.PurgeDLQMessagesRequest{
+		Type: enums.DEAD_LETTER_QUEUE_TYPE_HISTORY_TASK,
+	})
+	s.ErrorIs(err, errShardIDNotSet)
+
+	s.mockHistoryTaskDLQ.EXPECT().RangeDeleteTasks(gomock.Any(), int32(3), persistence.EmptyQueueMessageID, int64(10)).Return(nil)
+	_, err = s.handler.PurgeDLQMessages(ctx, &adminservice.PurgeDLQMessagesRequest{
+		Type:                  enums.DEAD_LETTER_QUEUE_TYPE_HISTORY_TASK,
+		ShardId:               3,
+		InclusiveEndMessageId: 10,
+	})
+	s.NoError(err)
+
+	s.mockHistoryTaskDLQ.EXPECT().RangeDeleteTasks(gomock.Any(), int32(3), persistence.EmptyQueueMessageID, common.EndMessageID).
+		Return(serviceerror.NewUnavailable("some error"))
+	_, err = s.handler.PurgeDLQMessages(ctx, &adminservice.PurgeDLQMessagesRequest{
+		Type:    enums.DEAD_LETTER_QUEUE_TYPE_HISTORY_TASK,
+		ShardId: 3,
+	})
+	s.Error(err)
+}
+
+func (s *adminHandlerSuite) TestMergeDLQMessages_HistoryTask() {
+	ctx := context.Background()
+	_, err := s.handler.MergeDLQMessages(ctx, &adminservice.MergeDLQMessagesRequest{
+		Type: enums.DEAD_LETTER_QUEUE_TYPE_HISTORY_TASK,
+	})
+	s.ErrorIs(err, errShardIDNotSet)
+
+	// tasks are merged by the history host owning the shard
+	s.mockHistoryClient.EXPECT().MergeDLQMessages(gomock.Any(), &historyservice.MergeDLQMessagesRequest{
+		Type:                  enums.DEAD_LETTER_QUEUE_TYPE_HISTORY_TASK,
+		ShardId:               3,
+		InclusiveEndMessageId: 10,
+		MaximumPageSize:       100,
+		NextPageToken:         []byte("token"),
+	}).Return(&historyservice.MergeDLQMessagesResponse{NextPageToken: []byte("next-token")}, nil)
+	resp, err := s.handler.MergeDLQMessages(ctx, &adminservice.MergeDLQMessagesRequest{
+		Type:                  enums.DEAD_LETTER_QUEUE_TYPE_HISTORY_TASK,
+		ShardId:               3,
+		InclusiveEndMessageId: 10,
+		MaximumPageSize:       100,
+		NextPageToken:         []byte("token"),
+	})
+	s.NoError(err)
+	s.Equal([]byte("next-token"), resp.GetNextPageToken())
+}
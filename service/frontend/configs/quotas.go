@@ -72,7 +72,6 @@ var (
 		"PollActivityTaskQueue":              2,
 		"GetWorkflowExecutionHistoryReverse": 2,
 		"GetWorkerBuildIdCompatibility":      2,
-		"UpdateWorkerBuildIdCompatibility":   2,
 		"GetWorkerTaskReachability":          2,
 		"DeleteWorkflowExecution":            2,
 
@@ -95,6 +94,15 @@ var (
 
 	VisibilityAPIPrioritiesOrdered = []int{0}
 
+	// WorkerVersioningWriteAPIToPriority lists the APIs that update the versioning data of a task queue. Every update
+	// is replicated to all partitions of the task queue, so they have their own rate limit rather than sharing the
+	// execution API one.
+	WorkerVersioningWriteAPIToPriority = map[string]int{
+		"UpdateWorkerBuildIdCompatibility": 0,
+	}
+
+	WorkerVersioningWriteAPIPrioritiesOrdered = []int{0}
+
 	OtherAPIToPriority = map[string]int{
 		"GetClusterInfo":      0,
 		"GetSystemInfo":       0,
@@ -157,12 +165,14 @@ func (c *NamespaceRateBurstImpl) Burst() int {
 func NewRequestToRateLimiter(
 	executionRateBurstFn quotas.RateBurst,
 	visibilityRateBurstFn quotas.RateBurst,
+	workerVersioningWriteRateBurstFn quotas.RateBurst,
 	otherRateBurstFn quotas.RateBurst,
 ) quotas.RequestRateLimiter {
 	mapping := make(map[string]quotas.RequestRateLimiter)
 
 	executionRateLimiter := NewExecutionPriorityRateLimiter(executionRateBurstFn)
 	visibilityRateLimiter := NewVisibilityPriorityRateLimiter(visibilityRateBurstFn)
+	workerVersioningWriteRateLimiter := NewWorkerVersioningWritePriorityRateLimiter(workerVersioningWriteRateBurstFn)
 	otherRateLimiter := NewOtherAPIPriorityRateLimiter(otherRateBurstFn)
 
 	for api := range ExecutionAPIToPriority {
@@ -171,6 +181,9 @@ func NewRequestToRateLimiter(
 	for api := range VisibilityAPIToPriority {
 		mapping[api] = visibilityRateLimiter
 	}
+	for api := range WorkerVersioningWriteAPIToPriority {
+		mapping[api] = workerVersioningWriteRateLimiter
+	}
 	for api := range OtherAPIToPriority {
 		mapping[api] = otherRateLimiter
 	}
@@ -208,6 +221,21 @@ func NewVisibilityPriorityRateLimiter(
 	}, rateLimiters)
 }
 
+func NewWorkerVersioningWritePriorityRateLimiter(
+	rateBurstFn quotas.RateBurst,
+) quotas.RequestRateLimiter {
+	rateLimiters := make(map[int]quotas.RequestRateLimiter)
+	for priority := range WorkerVersioningWriteAPIPrioritiesOrdered {
+		rateLimiters[priority] = quotas.NewRequestRateLimiterAdapter(quotas.NewDynamicRateLimiter(rateBurstFn, time.Minute))
+	}
+	return quotas.NewPriorityRateLimiter(func(req quotas.Request) int {
+		if priority, ok := WorkerVersioningWriteAPIToPriority[req.API]; ok {
+			return priority
+		}
+		return WorkerVersioningWriteAPIPrioritiesOrdered[len(WorkerVersioningWriteAPIPrioritiesOrdered)-1]
+	}, rateLimiters)
+}
+
 func NewOtherAPIPriorityRateLimiter(
 	rateBurstFn quotas.RateBurst,
 ) quotas.RequestRateLimiter {
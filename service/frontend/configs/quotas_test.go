@@ -73,6 +73,13 @@ func (s *quotasSuite) TestVisibilityAPIToPriorityMapping() {
 	}
 }
 
+func (s *quotasSuite) TestWorkerVersioningWriteAPIToPriorityMapping() {
+	for _, priority := range WorkerVersioningWriteAPIToPriority {
+		index := slices.Index(WorkerVersioningWriteAPIPrioritiesOrdered, priority)
+		s.NotEqual(-1, index)
+	}
+}
+
 func (s *quotasSuite) TestOtherAPIToPriorityMapping() {
 	for _, priority := range OtherAPIToPriority {
 		index := slices.Index(OtherAPIPrioritiesOrdered, priority)
@@ -92,6 +99,12 @@ func (s *quotasSuite) TestVisibilityAPIPrioritiesOrdered() {
 	}
 }
 
+func (s *quotasSuite) TestWorkerVersioningWriteAPIPrioritiesOrdered() {
+	for idx := range WorkerVersioningWriteAPIPrioritiesOrdered[1:] {
+		s.True(WorkerVersioningWriteAPIPrioritiesOrdered[idx] < WorkerVersioningWriteAPIPrioritiesOrdered[idx+1])
+	}
+}
+
 func (s *quotasSuite) TestOtherAPIPrioritiesOrdered() {
 	for idx := range OtherAPIPrioritiesOrdered[1:] {
 		s.True(OtherAPIPrioritiesOrdered[idx] < OtherAPIPrioritiesOrdered[idx+1])
@@ -120,17 +133,16 @@ func (s *quotasSuite) TestExecutionAPIs() {
 		"RespondActivityTaskCompletedById": {},
 		"RespondWorkflowTaskCompleted":     {},
 
-		"ResetWorkflowExecution":           {},
-		"DescribeWorkflowExecution":        {},
-		"RespondWorkflowTaskFailed":        {},
-		"QueryWorkflow":                    {},
-		"RespondQueryTaskCompleted":        {},
-		"PollWorkflowTaskQueue":            {},
-		"PollActivityTaskQueue":            {},
-		"GetWorkerBuildIdCompatibility":    {},
-		"UpdateWorkerBuildIdCompatibility": {},
-		"GetWorkerTaskReachability":        {},
-		"DeleteWorkflowExecution":          {},
+		"ResetWorkflowExecution":        {},
+		"DescribeWorkflowExecution":     {},
+		"RespondWorkflowTaskFailed":     {},
+		"QueryWorkflow":                 {},
+		"RespondQueryTaskCompleted":     {},
+		"PollWorkflowTaskQueue":         {},
+		"PollActivityTaskQueue":         {},
+		"GetWorkerBuildIdCompatibility": {},
+		"GetWorkerTaskReachability":     {},
+		"DeleteWorkflowExecution":       {},
 
 		"ResetStickyTaskQueue":    {},
 		"DescribeTaskQueue":       {},
@@ -172,6 +184,23 @@ func (s *quotasSuite) TestVisibilityAPIs() {
 	s.Equal(apiToPriority, VisibilityAPIToPriority)
 }
 
+func (s *quotasSuite) TestWorkerVersioningWriteAPIs() {
+	apis := map[string]struct{}{
+		"UpdateWorkerBuildIdCompatibility": {},
+	}
+
+	var service workflowservice.WorkflowServiceServer
+	t := reflect.TypeOf(&service).Elem()
+	apiToPriority := make(map[string]int, t.NumMethod())
+	for i := 0; i < t.NumMethod(); i++ {
+		apiName := t.Method(i).Name
+		if _, ok := apis[apiName]; ok {
+			apiToPriority[apiName] = WorkerVersioningWriteAPIToPriority[apiName]
+		}
+	}
+	s.Equal(apiToPriority, WorkerVersioningWriteAPIToPriority)
+}
+
 func (s *quotasSuite) TestOtherAPIs() {
 	apis := map[string]struct{}{
 		"GetClusterInfo":      {},
@@ -225,6 +254,9 @@ func (s *quotasSuite) TestAllAPIs() {
 	for api := range VisibilityAPIToPriority {
 		actualAPIs[api] = struct{}{}
 	}
+	for api := range WorkerVersioningWriteAPIToPriority {
+		actualAPIs[api] = struct{}{}
+	}
 	for api := range OtherAPIToPriority {
 		actualAPIs[api] = struct{}{}
 	}
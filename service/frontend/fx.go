@@ -293,6 +293,7 @@ func RateLimitInterceptorProvider(
 			quotas.NewDefaultIncomingRateLimiter(rateFn),
 			quotas.NewDefaultIncomingRateLimiter(rateFn),
 			quotas.NewDefaultIncomingRateLimiter(rateFn),
+			quotas.NewDefaultIncomingRateLimiter(rateFn),
 		),
 		map[string]int{},
 	)
@@ -334,11 +335,25 @@ func NamespaceRateLimitInterceptorProvider(
 			namespace,
 		)
 	}
+	// worker versioning write APIs default to the namespace limits unless configured separately
+	workerVersioningWriteRateFn := func(namespace string) float64 {
+		if rps := serviceConfig.MaxNamespaceVersioningRPSPerInstance(namespace); rps > 0 {
+			return float64(rps)
+		}
+		return rateFn(namespace)
+	}
+	workerVersioningWriteBurstFn := func(namespace string) int {
+		if burst := serviceConfig.MaxNamespaceVersioningBurstPerInstance(namespace); burst > 0 {
+			return burst
+		}
+		return serviceConfig.MaxNamespaceBurstPerInstance(namespace)
+	}
 	namespaceRateLimiter := quotas.NewNamespaceRequestRateLimiter(
 		func(req quotas.Request) quotas.RequestRateLimiter {
 			return configs.NewRequestToRateLimiter(
 				configs.NewNamespaceRateBurst(req.Caller, rateFn, serviceConfig.MaxNamespaceBurstPerInstance),
 				configs.NewNamespaceRateBurst(req.Caller, visibilityRateFn, serviceConfig.MaxNamespaceVisibilityBurstPerInstance),
+				configs.NewNamespaceRateBurst(req.Caller, workerVersioningWriteRateFn, workerVersioningWriteBurstFn),
 				configs.NewNamespaceRateBurst(req.Caller, rateFn, serviceConfig.MaxNamespaceBurstPerInstance),
 			)
 		},
@@ -453,6 +468,7 @@ func AdminHandlerProvider(
 	clientFactory client.Factory,
 	clientBean client.Bean,
 	historyClient historyservice.HistoryServiceClient,
+	matchingClient resource.MatchingClient,
 	sdkClientFactory sdk.ClientFactory,
 	membershipMonitor membership.Monitor,
 	archiverProvider provider.ArchiverProvider,
@@ -465,6 +481,7 @@ func AdminHandlerProvider(
 	healthServer *health.Server,
 	eventSerializer serialization.Serializer,
 	timeSource clock.TimeSource,
+	historyTaskDLQ persistence.HistoryTaskDLQ,
 ) *AdminHandler {
 	args := NewAdminHandlerArgs{
 		persistenceConfig,
@@ -481,6 +498,7 @@ func AdminHandlerProvider(
 		clientFactory,
 		clientBean,
 		historyClient,
+		matchingClient,
 		sdkClientFactory,
 		membershipMonitor,
 		archiverProvider,
@@ -493,6 +511,7 @@ func AdminHandlerProvider(
 		healthServer,
 		eventSerializer,
 		timeSource,
+		historyTaskDLQ,
 	}
 	return NewAdminHandler(args)
 }
@@ -0,0 +1,106 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package repinworkflow
+
+import (
+	"context"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/consts"
+	"go.temporal.io/server/service/history/shard"
+)
+
+var errWorkflowTaskInFlight = serviceerror.NewFailedPrecondition("workflow has a workflow task in flight")
+
+func Invoke(
+	ctx context.Context,
+	request *historyservice.RepinWorkflowBuildIdRequest,
+	shard shard.Context,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) (*historyservice.RepinWorkflowBuildIdResponse, error) {
+	_, err := api.GetActiveNamespace(shard, namespace.ID(request.GetNamespaceId()))
+	if err != nil {
+		return nil, err
+	}
+	if request.GetBuildId() == "" || request.GetTargetBuildId() == "" {
+		return nil, serviceerror.NewInvalidArgument("build id and target build id are required")
+	}
+
+	response := &historyservice.RepinWorkflowBuildIdResponse{}
+	err = api.GetAndUpdateWorkflowWithNew(
+		ctx,
+		nil,
+		api.BypassMutableStateConsistencyPredicate,
+		definition.NewWorkflowKey(
+			request.NamespaceId,
+			request.Execution.GetWorkflowId(),
+			request.Execution.GetRunId(),
+		),
+		func(workflowContext api.WorkflowContext) (*api.UpdateWorkflowAction, error) {
+			mutableState := workflowContext.GetMutableState()
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, consts.ErrWorkflowCompleted
+			}
+			if common.StampIfUsingVersioning(mutableState.GetWorkerVersionStamp()).GetBuildId() != request.GetBuildId() {
+				return &api.UpdateWorkflowAction{
+					Noop:               true,
+					CreateWorkflowTask: false,
+				}, nil
+			}
+			// The task of a pending workflow task was dispatched to the old build id already, and completing it would
+			// pin the workflow back to it.
+			if mutableState.HasPendingWorkflowTask() {
+				return nil, errWorkflowTaskInFlight
+			}
+
+			// The stamp is what the workflow task transfer task dispatches by, and the sticky queue belongs to a
+			// worker of the old build id.
+			mutableState.GetExecutionInfo().WorkerVersionStamp = &commonpb.WorkerVersionStamp{
+				BuildId:       request.GetTargetBuildId(),
+				UseVersioning: true,
+			}
+			mutableState.ClearStickyTaskQueue()
+			response.Repinned = true
+			return &api.UpdateWorkflowAction{
+				Noop:               false,
+				CreateWorkflowTask: true,
+			}, nil
+		},
+		nil,
+		shard,
+		workflowConsistencyChecker,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
@@ -28,6 +28,7 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/api/historyservice/v1"
@@ -139,6 +140,13 @@ func Invoke(
 	baseCurrentBranchToken := baseCurrentVersionHistory.GetBranchToken()
 	baseNextEventID := baseMutableState.GetNextEventID()
 
+	var resetWorkerVersionStamp *commonpb.WorkerVersionStamp
+	if buildId := resetRequest.GetTargetBuildId(); buildId != "" {
+		resetWorkerVersionStamp = &commonpb.WorkerVersionStamp{BuildId: buildId, UseVersioning: true}
+	} else if resetRequest.GetPreserveBuildId() {
+		resetWorkerVersionStamp = common.StampIfUsingVersioning(baseMutableState.GetWorkerVersionStamp())
+	}
+
 	if err := ndc.NewWorkflowResetter(
 		shard,
 		workflowConsistencyChecker.GetWorkflowCache(),
@@ -165,6 +173,7 @@ func Invoke(
 		request.GetReason(),
 		nil,
 		request.GetResetReapplyType(),
+		resetWorkerVersionStamp,
 	); err != nil {
 		return nil, err
 	}
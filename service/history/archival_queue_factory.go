@@ -34,6 +34,7 @@ import (
 	ctasks "go.temporal.io/server/common/tasks"
 	"go.temporal.io/server/service/history/archival"
 	"go.temporal.io/server/service/history/configs"
+	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/queues"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tasks"
@@ -92,9 +93,14 @@ func NewArchivalQueueFactory(
 
 // newScheduler creates a new task scheduler for tasks on the archival queue.
 func newScheduler(params ArchivalQueueFactoryParams) queues.Scheduler {
+	metricsHandler := params.MetricsHandler.WithTags(metrics.OperationTag(metrics.OperationArchivalQueueProcessorScope))
 	return queues.NewPriorityScheduler(
 		queues.PrioritySchedulerOptions{
-			WorkerCount:                 params.Config.ArchivalProcessorSchedulerWorkerCount,
+			WorkerCount: NewHostSchedulerWorkerCount(
+				params.QueueFactoryBaseParams,
+				params.Config.ArchivalProcessorSchedulerWorkerCount,
+				metricsHandler,
+			),
 			EnableRateLimiter:           params.Config.TaskSchedulerEnableRateLimiter,
 			EnableRateLimiterShadowMode: params.Config.TaskSchedulerEnableRateLimiterShadowMode,
 			DispatchThrottleDuration:    params.Config.TaskSchedulerThrottleDuration,
@@ -103,7 +109,7 @@ func newScheduler(params ArchivalQueueFactoryParams) queues.Scheduler {
 		params.SchedulerRateLimiter,
 		params.TimeSource,
 		params.Logger,
-		params.MetricsHandler.WithTags(metrics.OperationTag(metrics.OperationArchivalQueueProcessorScope)),
+		metricsHandler,
 	)
 }
 
@@ -111,8 +117,14 @@ func newScheduler(params ArchivalQueueFactoryParams) queues.Scheduler {
 // like the task scheduler, task priority assigner, and rate limiters.
 func newQueueFactoryBase(params ArchivalQueueFactoryParams, hostScheduler queues.Scheduler) QueueFactoryBase {
 	return QueueFactoryBase{
-		HostScheduler:        hostScheduler,
-		HostPriorityAssigner: queues.NewPriorityAssigner(),
+		HostScheduler: hostScheduler,
+		HostPriorityAssigner: queues.NewPriorityAssigner(
+			params.NamespaceRegistry,
+			params.Config.TaskSchedulerNamespacePriorityMultiplier,
+			params.Config.TaskSchedulerNamespacePriorityOverride,
+			dynamicconfig.GetMapPropertyFnWithNamespaceFilter(ArchivalTaskPriorities),
+			params.Logger,
+		),
 		HostReaderRateLimiter: queues.NewReaderPriorityRateLimiter(
 			NewHostRateLimiterRateFn(
 				params.Config.ArchivalProcessorMaxPollHostRPS,
@@ -155,6 +167,7 @@ func (f *archivalQueueFactory) newScheduledQueue(shard shard.Context, executor q
 		shard.GetTimeSource(),
 		logger,
 		metricsHandler,
+		f.Config.ArchivalProcessorReschedulerFlushInterval,
 	)
 
 	return queues.NewScheduledQueue(
@@ -166,9 +179,10 @@ func (f *archivalQueueFactory) newScheduledQueue(shard shard.Context, executor q
 		executor,
 		&queues.Options{
 			ReaderOptions: queues.ReaderOptions{
-				BatchSize:            f.Config.ArchivalTaskBatchSize,
-				MaxPendingTasksCount: f.Config.QueuePendingTaskMaxCount,
-				PollBackoffInterval:  f.Config.ArchivalProcessorPollBackoffInterval,
+				BatchSize:                 f.Config.ArchivalTaskBatchSize,
+				MaxPendingTasksCount:      f.Config.QueuePendingTaskMaxCount,
+				PollBackoffInterval:       f.Config.ArchivalProcessorPollBackoffInterval,
+				LowPriorityAdmissionDelay: f.Config.QueueLowPriorityAdmissionDelay,
 			},
 			MonitorOptions: queues.MonitorOptions{
 				PendingTasksCriticalCount:   f.Config.QueuePendingTaskCriticalCount,
@@ -181,6 +195,17 @@ func (f *archivalQueueFactory) newScheduledQueue(shard shard.Context, executor q
 			CheckpointInterval:                  f.Config.ArchivalProcessorUpdateAckInterval,
 			CheckpointIntervalJitterCoefficient: f.Config.ArchivalProcessorUpdateAckIntervalJitterCoefficient,
 			MaxReaderCount:                      f.Config.QueueMaxReaderCount,
+			ErrorLogSampleRates:                 f.Config.QueueErrorLogSampleRates,
+			ExecutableSnapshotSink:              queues.NewLogExecutableSnapshotSink(logger),
+			ExecutableSnapshotEnabled:           f.Config.QueueExecutableSnapshotEnabled,
+			Tracer:                              f.TracerProvider.Tracer(consts.LibraryName),
+			CircuitBreakerFailureThreshold:      f.Config.QueueCircuitBreakerThreshold,
+			CircuitBreakerOpenDuration:          f.Config.QueueCircuitBreakerOpenDuration,
+			HistoryTaskDLQ:                      f.HistoryTaskDLQ,
+			DLQMaxAttempts:                      f.Config.QueueDLQMaxAttempts,
+			AttemptTimeout:                      f.Config.QueueExecutableAttemptTimeout,
+			SequentialExecutionEnabled:          f.Config.QueueSequentialExecutionEnabled,
+			RangeCompleteInterval:               f.Config.QueueRangeCompleteInterval,
 		},
 		f.HostReaderRateLimiter,
 		logger,
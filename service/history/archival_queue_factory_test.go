@@ -30,6 +30,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
 
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/log"
@@ -76,6 +77,7 @@ func TestArchivalQueueFactory(t *testing.T) {
 			TimeSource:     namespace.NewMockClock(ctrl),
 			MetricsHandler: metricsHandler,
 			Logger:         log.NewNoopLogger(),
+			TracerProvider: trace.NewNoopTracerProvider(),
 		},
 	})
 	queue := queueFactory.CreateQueue(mockShard, nil)
@@ -519,6 +519,8 @@ func TestArchivalQueueTaskExecutor(t *testing.T) {
 				namespaceRegistry,
 				mockMetadata,
 				nil,
+				nil,
+				nil,
 				metrics.NoopMetricsHandler,
 			)
 			err := executable.Execute()
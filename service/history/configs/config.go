@@ -90,12 +90,28 @@ type Config struct {
 	QueueCriticalSlicesCount         dynamicconfig.IntPropertyFn
 	QueuePendingTaskMaxCount         dynamicconfig.IntPropertyFn
 	QueueMaxReaderCount              dynamicconfig.IntPropertyFn
+	QueueErrorLogSampleRates         dynamicconfig.MapPropertyFn
+	QueueExecutableSnapshotEnabled   dynamicconfig.BoolPropertyFn
+	QueueCircuitBreakerThreshold     dynamicconfig.IntPropertyFn
+	QueueCircuitBreakerOpenDuration  dynamicconfig.DurationPropertyFn
+	QueueDLQMaxAttempts              dynamicconfig.IntPropertyFn
+	QueueExecutableAttemptTimeout    dynamicconfig.DurationPropertyFn
+	QueueStandbyTaskMinClockEnabled  dynamicconfig.BoolPropertyFn
+	QueueSequentialExecutionEnabled  dynamicconfig.BoolPropertyFn
+	QueueRangeCompleteInterval       dynamicconfig.DurationPropertyFn
+	QueueLowPriorityAdmissionDelay   dynamicconfig.DurationPropertyFn
 
 	TaskSchedulerEnableRateLimiter           dynamicconfig.BoolPropertyFn
 	TaskSchedulerEnableRateLimiterShadowMode dynamicconfig.BoolPropertyFn
 	TaskSchedulerThrottleDuration            dynamicconfig.DurationPropertyFn
 	TaskSchedulerMaxQPS                      dynamicconfig.IntPropertyFn
 	TaskSchedulerNamespaceMaxQPS             dynamicconfig.IntPropertyFnWithNamespaceFilter
+	TaskSchedulerNamespacePriorityMultiplier dynamicconfig.FloatPropertyFnWithNamespaceFilter
+	TaskSchedulerNamespacePriorityOverride   dynamicconfig.MapPropertyFnWithNamespaceFilter
+	TaskSchedulerAdaptiveWorkerCountEnabled  dynamicconfig.BoolPropertyFn
+	TaskSchedulerAdaptiveLatencyThreshold    dynamicconfig.DurationPropertyFn
+	TaskSchedulerAdaptiveErrorRatioThreshold dynamicconfig.FloatPropertyFn
+	TaskSchedulerAdaptiveMinWorkerRatio      dynamicconfig.FloatPropertyFn
 
 	// TimerQueueProcessor settings
 	TimerTaskHighPriorityRPS                         dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -112,6 +128,7 @@ type Config struct {
 	TimerProcessorMaxPollInterval                    dynamicconfig.DurationPropertyFn
 	TimerProcessorMaxPollIntervalJitterCoefficient   dynamicconfig.FloatPropertyFn
 	TimerProcessorPollBackoffInterval                dynamicconfig.DurationPropertyFn
+	TimerProcessorReschedulerFlushInterval           dynamicconfig.DurationPropertyFn
 	TimerProcessorMaxTimeShift                       dynamicconfig.DurationPropertyFn
 	TimerProcessorHistoryArchivalSizeLimit           dynamicconfig.IntPropertyFn
 	TimerProcessorArchivalTimeLimit                  dynamicconfig.DurationPropertyFn
@@ -134,6 +151,7 @@ type Config struct {
 	TransferProcessorUpdateAckIntervalJitterCoefficient dynamicconfig.FloatPropertyFn
 	TransferProcessorCompleteTransferInterval           dynamicconfig.DurationPropertyFn
 	TransferProcessorPollBackoffInterval                dynamicconfig.DurationPropertyFn
+	TransferProcessorReschedulerFlushInterval           dynamicconfig.DurationPropertyFn
 	TransferProcessorVisibilityArchivalTimeLimit        dynamicconfig.DurationPropertyFn
 	TransferProcessorEnsureCloseBeforeDelete            dynamicconfig.BoolPropertyFn
 
@@ -273,6 +291,7 @@ type Config struct {
 	VisibilityProcessorUpdateAckIntervalJitterCoefficient dynamicconfig.FloatPropertyFn
 	VisibilityProcessorCompleteTaskInterval               dynamicconfig.DurationPropertyFn
 	VisibilityProcessorPollBackoffInterval                dynamicconfig.DurationPropertyFn
+	VisibilityProcessorReschedulerFlushInterval           dynamicconfig.DurationPropertyFn
 	VisibilityProcessorVisibilityArchivalTimeLimit        dynamicconfig.DurationPropertyFn
 	VisibilityProcessorEnsureCloseBeforeDelete            dynamicconfig.BoolPropertyFn
 	VisibilityProcessorEnableCloseWorkflowCleanup         dynamicconfig.BoolPropertyFnWithNamespaceFilter
@@ -289,6 +308,7 @@ type Config struct {
 
 	EnableCrossNamespaceCommands  dynamicconfig.BoolPropertyFn
 	EnableActivityEagerExecution  dynamicconfig.BoolPropertyFnWithNamespaceFilter
+	DisableActivityEagerExecution dynamicconfig.BoolPropertyFnWithTaskQueueInfoFilters
 	EnableEagerWorkflowStart      dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	NamespaceCacheRefreshInterval dynamicconfig.DurationPropertyFn
 
@@ -297,6 +317,7 @@ type Config struct {
 	ArchivalProcessorMaxPollHostRPS                     dynamicconfig.IntPropertyFn
 	ArchivalTaskBatchSize                               dynamicconfig.IntPropertyFn
 	ArchivalProcessorPollBackoffInterval                dynamicconfig.DurationPropertyFn
+	ArchivalProcessorReschedulerFlushInterval           dynamicconfig.DurationPropertyFn
 	ArchivalProcessorMaxPollRPS                         dynamicconfig.IntPropertyFn
 	ArchivalProcessorMaxPollInterval                    dynamicconfig.DurationPropertyFn
 	ArchivalProcessorMaxPollIntervalJitterCoefficient   dynamicconfig.FloatPropertyFn
@@ -364,12 +385,28 @@ func NewConfig(
 		QueueCriticalSlicesCount:         dc.GetIntProperty(dynamicconfig.QueueCriticalSlicesCount, 50),
 		QueuePendingTaskMaxCount:         dc.GetIntProperty(dynamicconfig.QueuePendingTaskMaxCount, 10000),
 		QueueMaxReaderCount:              dc.GetIntProperty(dynamicconfig.QueueMaxReaderCount, 2),
+		QueueErrorLogSampleRates:         dc.GetMapProperty(dynamicconfig.QueueErrorLogSampleRates, map[string]any{"workflow_busy": 100, "resource_exhausted": 100}),
+		QueueExecutableSnapshotEnabled:   dc.GetBoolProperty(dynamicconfig.QueueExecutableSnapshotEnabled, false),
+		QueueCircuitBreakerThreshold:     dc.GetIntProperty(dynamicconfig.QueueCircuitBreakerFailureThreshold, 0),
+		QueueCircuitBreakerOpenDuration:  dc.GetDurationProperty(dynamicconfig.QueueCircuitBreakerOpenDuration, 10*time.Second),
+		QueueDLQMaxAttempts:              dc.GetIntProperty(dynamicconfig.QueueDLQMaxAttempts, 0),
+		QueueExecutableAttemptTimeout:    dc.GetDurationProperty(dynamicconfig.QueueExecutableAttemptTimeout, 0),
+		QueueStandbyTaskMinClockEnabled:  dc.GetBoolProperty(dynamicconfig.QueueStandbyTaskMinClockEnabled, false),
+		QueueSequentialExecutionEnabled:  dc.GetBoolProperty(dynamicconfig.QueueSequentialExecutionEnabled, false),
+		QueueRangeCompleteInterval:       dc.GetDurationProperty(dynamicconfig.QueueRangeCompleteInterval, 0),
+		QueueLowPriorityAdmissionDelay:   dc.GetDurationProperty(dynamicconfig.QueueLowPriorityAdmissionDelay, 0),
 
 		TaskSchedulerEnableRateLimiter:           dc.GetBoolProperty(dynamicconfig.TaskSchedulerEnableRateLimiter, false),
 		TaskSchedulerEnableRateLimiterShadowMode: dc.GetBoolProperty(dynamicconfig.TaskSchedulerEnableRateLimiterShadowMode, true),
 		TaskSchedulerThrottleDuration:            dc.GetDurationProperty(dynamicconfig.TaskSchedulerThrottleDuration, time.Second),
 		TaskSchedulerMaxQPS:                      dc.GetIntProperty(dynamicconfig.TaskSchedulerMaxQPS, 0),
 		TaskSchedulerNamespaceMaxQPS:             dc.GetIntPropertyFilteredByNamespace(dynamicconfig.TaskSchedulerNamespaceMaxQPS, 0),
+		TaskSchedulerNamespacePriorityMultiplier: dc.GetFloatPropertyFilteredByNamespace(dynamicconfig.TaskSchedulerNamespacePriorityMultiplier, 1.0),
+		TaskSchedulerNamespacePriorityOverride:   dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.TaskSchedulerNamespacePriorityOverride, map[string]any{}),
+		TaskSchedulerAdaptiveWorkerCountEnabled:  dc.GetBoolProperty(dynamicconfig.TaskSchedulerAdaptiveWorkerCountEnabled, false),
+		TaskSchedulerAdaptiveLatencyThreshold:    dc.GetDurationProperty(dynamicconfig.TaskSchedulerAdaptiveLatencyThreshold, 500*time.Millisecond),
+		TaskSchedulerAdaptiveErrorRatioThreshold: dc.GetFloat64Property(dynamicconfig.TaskSchedulerAdaptiveErrorRatioThreshold, 0.1),
+		TaskSchedulerAdaptiveMinWorkerRatio:      dc.GetFloat64Property(dynamicconfig.TaskSchedulerAdaptiveMinWorkerRatio, 0.25),
 
 		TimerTaskBatchSize:                               dc.GetIntProperty(dynamicconfig.TimerTaskBatchSize, 100),
 		TimerProcessorSchedulerWorkerCount:               dc.GetIntProperty(dynamicconfig.TimerProcessorSchedulerWorkerCount, 512),
@@ -384,6 +421,7 @@ func NewConfig(
 		TimerProcessorMaxPollInterval:                    dc.GetDurationProperty(dynamicconfig.TimerProcessorMaxPollInterval, 5*time.Minute),
 		TimerProcessorMaxPollIntervalJitterCoefficient:   dc.GetFloat64Property(dynamicconfig.TimerProcessorMaxPollIntervalJitterCoefficient, 0.15),
 		TimerProcessorPollBackoffInterval:                dc.GetDurationProperty(dynamicconfig.TimerProcessorPollBackoffInterval, 5*time.Second),
+		TimerProcessorReschedulerFlushInterval:           dc.GetDurationProperty(dynamicconfig.TimerProcessorReschedulerFlushInterval, 0),
 		TimerProcessorMaxTimeShift:                       dc.GetDurationProperty(dynamicconfig.TimerProcessorMaxTimeShift, 1*time.Second),
 		TimerProcessorHistoryArchivalSizeLimit:           dc.GetIntProperty(dynamicconfig.TimerProcessorHistoryArchivalSizeLimit, 500*1024),
 		TimerProcessorArchivalTimeLimit:                  dc.GetDurationProperty(dynamicconfig.TimerProcessorArchivalTimeLimit, 1*time.Second),
@@ -404,6 +442,7 @@ func NewConfig(
 		TransferProcessorUpdateAckIntervalJitterCoefficient: dc.GetFloat64Property(dynamicconfig.TransferProcessorUpdateAckIntervalJitterCoefficient, 0.15),
 		TransferProcessorCompleteTransferInterval:           dc.GetDurationProperty(dynamicconfig.TransferProcessorCompleteTransferInterval, 60*time.Second),
 		TransferProcessorPollBackoffInterval:                dc.GetDurationProperty(dynamicconfig.TransferProcessorPollBackoffInterval, 5*time.Second),
+		TransferProcessorReschedulerFlushInterval:           dc.GetDurationProperty(dynamicconfig.TransferProcessorReschedulerFlushInterval, 0),
 		TransferProcessorVisibilityArchivalTimeLimit:        dc.GetDurationProperty(dynamicconfig.TransferProcessorVisibilityArchivalTimeLimit, 200*time.Millisecond),
 		TransferProcessorEnsureCloseBeforeDelete:            dc.GetBoolProperty(dynamicconfig.TransferProcessorEnsureCloseBeforeDelete, true),
 
@@ -511,6 +550,7 @@ func NewConfig(
 		VisibilityProcessorUpdateAckIntervalJitterCoefficient: dc.GetFloat64Property(dynamicconfig.VisibilityProcessorUpdateAckIntervalJitterCoefficient, 0.15),
 		VisibilityProcessorCompleteTaskInterval:               dc.GetDurationProperty(dynamicconfig.VisibilityProcessorCompleteTaskInterval, 60*time.Second),
 		VisibilityProcessorPollBackoffInterval:                dc.GetDurationProperty(dynamicconfig.VisibilityProcessorPollBackoffInterval, 5*time.Second),
+		VisibilityProcessorReschedulerFlushInterval:           dc.GetDurationProperty(dynamicconfig.VisibilityProcessorReschedulerFlushInterval, 0),
 		VisibilityProcessorVisibilityArchivalTimeLimit:        dc.GetDurationProperty(dynamicconfig.VisibilityProcessorVisibilityArchivalTimeLimit, 200*time.Millisecond),
 		VisibilityProcessorEnsureCloseBeforeDelete:            dc.GetBoolProperty(dynamicconfig.VisibilityProcessorEnsureCloseBeforeDelete, false),
 		VisibilityProcessorEnableCloseWorkflowCleanup:         dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.VisibilityProcessorEnableCloseWorkflowCleanup, false),
@@ -531,6 +571,7 @@ func NewConfig(
 
 		EnableCrossNamespaceCommands:  dc.GetBoolProperty(dynamicconfig.EnableCrossNamespaceCommands, true),
 		EnableActivityEagerExecution:  dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableActivityEagerExecution, false),
+		DisableActivityEagerExecution: dc.GetBoolPropertyFilteredByTaskQueueInfo(dynamicconfig.DisableActivityEagerExecution, false),
 		EnableEagerWorkflowStart:      dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableEagerWorkflowStart, false),
 		NamespaceCacheRefreshInterval: dc.GetDurationProperty(dynamicconfig.NamespaceCacheRefreshInterval, 10*time.Second),
 
@@ -545,9 +586,10 @@ func NewConfig(
 		ArchivalProcessorUpdateAckInterval: dc.GetDurationProperty(dynamicconfig.ArchivalProcessorUpdateAckInterval, 30*time.Second),
 		ArchivalProcessorUpdateAckIntervalJitterCoefficient: dc.GetFloat64Property(dynamicconfig.
 			ArchivalProcessorUpdateAckIntervalJitterCoefficient, 0.15),
-		ArchivalProcessorPollBackoffInterval: dc.GetDurationProperty(dynamicconfig.ArchivalProcessorPollBackoffInterval, 5*time.Second),
-		ArchivalProcessorArchiveDelay:        dc.GetDurationProperty(dynamicconfig.ArchivalProcessorArchiveDelay, 5*time.Minute),
-		ArchivalBackendMaxRPS:                dc.GetFloat64Property(dynamicconfig.ArchivalBackendMaxRPS, 10000.0),
+		ArchivalProcessorPollBackoffInterval:      dc.GetDurationProperty(dynamicconfig.ArchivalProcessorPollBackoffInterval, 5*time.Second),
+		ArchivalProcessorReschedulerFlushInterval: dc.GetDurationProperty(dynamicconfig.ArchivalProcessorReschedulerFlushInterval, 0),
+		ArchivalProcessorArchiveDelay:             dc.GetDurationProperty(dynamicconfig.ArchivalProcessorArchiveDelay, 5*time.Minute),
+		ArchivalBackendMaxRPS:                     dc.GetFloat64Property(dynamicconfig.ArchivalBackendMaxRPS, 10000.0),
 
 		// workflow update related
 		WorkflowExecutionMaxInFlightUpdates: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.WorkflowExecutionMaxInFlightUpdates, 10),
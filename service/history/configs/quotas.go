@@ -34,6 +34,7 @@ var (
 		"GetShard":                               0,
 		"DeleteWorkflowExecution":                0,
 		"DescribeHistoryHost":                    0,
+		"DescribeHistoryQueue":                   0,
 		"DescribeMutableState":                   0,
 		"DescribeWorkflowExecution":              0,
 		"GetDLQMessages":                         0,
@@ -56,6 +57,7 @@ var (
 		"RemoveTask":                             0,
 		"ReplicateEventsV2":                      0,
 		"ReplicateWorkflowState":                 0,
+		"RepinWorkflowBuildId":                   0,
 		"RequestCancelWorkflowExecution":         0,
 		"ResetStickyTaskQueue":                   0,
 		"ResetWorkflowExecution":                 0,
@@ -49,6 +49,20 @@ var (
 	ErrTaskRetry = errors.New("passive task should retry due to condition in mutable state is not met")
 	// ErrDependencyTaskNotCompleted is the error returned when a task this task depends on is not completed yet
 	ErrDependencyTaskNotCompleted = errors.New("a task which this task depends on has not been completed yet")
+	// ErrTaskYield is the error returned by an executor which made partial progress and wants to release its worker so other tasks can run
+	ErrTaskYield = errors.New("task yielded after partial progress")
+	// ErrTaskSplit is the error returned by an executor which split its task into child tasks, the task completes once all of them complete
+	ErrTaskSplit = errors.New("task split into child tasks")
+	// ErrClockNotReached is the error returned when a task is executed before the shard reached the clock the task depends on
+	ErrClockNotReached = errors.New("shard has not reached the clock this task depends on")
+	// ErrPrecedingTaskNotCompleted is the error returned when a task is executed before the tasks of the same workflow scheduled before it are completed
+	ErrPrecedingTaskNotCompleted = errors.New("a task of the same workflow scheduled before this task has not been completed yet")
+	// ErrCircuitBreakerOpen is the error returned when a task is executed while the circuit breaker of its task type is open
+	ErrCircuitBreakerOpen = errors.New("circuit breaker of this task type is open")
+	// ErrTaskAttemptTimeout is the error returned when a task attempt fails after running past its deadline
+	ErrTaskAttemptTimeout = errors.New("task attempt exceeded its deadline")
+	// ErrTaskMoveToDLQ is the error returned when a task failed its max number of attempts and is to be moved to the DLQ
+	ErrTaskMoveToDLQ = errors.New("task reached its max attempts and is moved to the DLQ")
 	// ErrDuplicate is exported temporarily for integration test
 	ErrDuplicate = errors.New("duplicate task, completing it")
 	// ErrLocateCurrentWorkflowExecution is the error returned when current workflow execution can't be located
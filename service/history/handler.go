@@ -602,6 +602,31 @@ func (h *Handler) RemoveTask(ctx context.Context, request *historyservice.Remove
 	return &historyservice.RemoveTaskResponse{}, err
 }
 
+// DescribeHistoryQueue returns the progress and backlog of the readers of the history task queues of a shard
+func (h *Handler) DescribeHistoryQueue(ctx context.Context, request *historyservice.DescribeHistoryQueueRequest) (_ *historyservice.DescribeHistoryQueueResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	shardContext, err := h.controller.GetShardByID(request.GetShardId())
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err := engine.DescribeHistoryQueue(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	return resp, nil
+}
+
 // CloseShard closes a shard hosted by this instance
 func (h *Handler) CloseShard(_ context.Context, request *historyservice.CloseShardRequest) (_ *historyservice.CloseShardResponse, retError error) {
 	defer log.CapturePanic(h.logger, &retError)
@@ -1271,6 +1296,38 @@ func (h *Handler) ResetStickyTaskQueue(ctx context.Context, request *historyserv
 	return resp, nil
 }
 
+// RepinWorkflowBuildId pins a workflow to another build id and schedules its next workflow task there
+func (h *Handler) RepinWorkflowBuildId(ctx context.Context, request *historyservice.RepinWorkflowBuildIdRequest) (_ *historyservice.RepinWorkflowBuildIdResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	h.startWG.Wait()
+
+	if h.isStopped() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := namespace.ID(request.GetNamespaceId())
+	if namespaceID == "" {
+		return nil, h.convertError(errNamespaceNotSet)
+	}
+
+	workflowID := request.Execution.GetWorkflowId()
+	shardContext, err := h.controller.GetShardByNamespaceWorkflow(namespaceID, workflowID)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+	engine, err := shardContext.GetEngine(ctx)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	resp, err := engine.RepinWorkflowBuildId(ctx, request)
+	if err != nil {
+		return nil, h.convertError(err)
+	}
+
+	return resp, nil
+}
+
 // ReplicateEventsV2 is called by processor to replicate history events for passive namespaces
 func (h *Handler) ReplicateEventsV2(ctx context.Context, request *historyservice.ReplicateEventsV2Request) (_ *historyservice.ReplicateEventsV2Response, retError error) {
 	defer log.CapturePanic(h.logger, &retError)
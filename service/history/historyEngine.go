@@ -26,13 +26,18 @@ package history
 
 import (
 	"context"
+	"fmt"
 	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/otel/trace"
 	commonpb "go.temporal.io/api/common/v1"
 	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"golang.org/x/exp/slices"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
@@ -65,6 +70,7 @@ import (
 	"go.temporal.io/server/service/history/api/recordchildworkflowcompleted"
 	"go.temporal.io/server/service/history/api/refreshworkflow"
 	"go.temporal.io/server/service/history/api/removesignalmutablestate"
+	"go.temporal.io/server/service/history/api/repinworkflow"
 	replicationapi "go.temporal.io/server/service/history/api/replication"
 	"go.temporal.io/server/service/history/api/replicationadmin"
 	"go.temporal.io/server/service/history/api/requestcancelworkflow"
@@ -123,6 +129,7 @@ type (
 		matchingClient             matchingservice.MatchingServiceClient
 		rawMatchingClient          matchingservice.MatchingServiceClient
 		replicationDLQHandler      replication.DLQHandler
+		historyTaskDLQ             persistence.HistoryTaskDLQ
 		persistenceVisibilityMgr   manager.VisibilityManager
 		searchAttributesValidator  *searchattribute.Validator
 		workflowDeleteManager      deletemanager.DeleteManager
@@ -150,6 +157,7 @@ func NewEngineWithShardContext(
 	workflowConsistencyChecker api.WorkflowConsistencyChecker,
 	tracerProvider trace.TracerProvider,
 	persistenceVisibilityMgr manager.VisibilityManager,
+	historyTaskDLQ persistence.HistoryTaskDLQ,
 ) shard.Engine {
 	currentClusterName := shard.GetClusterMetadata().GetCurrentClusterName()
 
@@ -186,6 +194,7 @@ func NewEngineWithShardContext(
 		eventSerializer:            eventSerializer,
 		workflowConsistencyChecker: workflowConsistencyChecker,
 		tracer:                     tracerProvider.Tracer(consts.LibraryName),
+		historyTaskDLQ:             historyTaskDLQ,
 	}
 
 	historyEngImpl.queueProcessors = make(map[tasks.Category]queues.Queue)
@@ -642,6 +651,14 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 	return resetworkflow.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker)
 }
 
+// RepinWorkflowBuildId pins a workflow to another build id and schedules its next workflow task there
+func (e *historyEngineImpl) RepinWorkflowBuildId(
+	ctx context.Context,
+	req *historyservice.RepinWorkflowBuildIdRequest,
+) (*historyservice.RepinWorkflowBuildIdResponse, error) {
+	return repinworkflow.Invoke(ctx, req, e.shard, e.workflowConsistencyChecker)
+}
+
 func (e *historyEngineImpl) NotifyNewHistoryEvent(
 	notification *events.Notification,
 ) {
@@ -740,9 +757,55 @@ func (e *historyEngineImpl) MergeDLQMessages(
 	ctx context.Context,
 	request *historyservice.MergeDLQMessagesRequest,
 ) (*historyservice.MergeDLQMessagesResponse, error) {
+	if request.GetType() == enumsspb.DEAD_LETTER_QUEUE_TYPE_HISTORY_TASK {
+		token, err := queues.MergeDLQ(
+			ctx,
+			e.shard,
+			e.historyTaskDLQ,
+			request.GetInclusiveEndMessageId(),
+			int(request.GetMaximumPageSize()),
+			request.GetNextPageToken(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return &historyservice.MergeDLQMessagesResponse{
+			NextPageToken: token,
+		}, nil
+	}
 	return replicationadmin.MergeDLQ(ctx, request, e.shard, e.replicationDLQHandler)
 }
 
+func (e *historyEngineImpl) DescribeHistoryQueue(
+	_ context.Context,
+	request *historyservice.DescribeHistoryQueueRequest,
+) (*historyservice.DescribeHistoryQueueResponse, error) {
+	if categoryID := request.GetCategory(); categoryID != enumsspb.TASK_CATEGORY_UNSPECIFIED {
+		category, ok := tasks.GetCategoryByID(int32(categoryID))
+		if !ok {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Invalid task category ID: %v", categoryID))
+		}
+		queue, ok := e.queueProcessors[category]
+		if !ok {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Task category %v has no history queue", category.Name()))
+		}
+		return &historyservice.DescribeHistoryQueueResponse{
+			Queues: []*historyspb.HistoryQueueInfo{queue.Describe()},
+		}, nil
+	}
+
+	queueInfos := make([]*historyspb.HistoryQueueInfo, 0, len(e.queueProcessors))
+	for _, queue := range e.queueProcessors {
+		queueInfos = append(queueInfos, queue.Describe())
+	}
+	slices.SortFunc(queueInfos, func(a, b *historyspb.HistoryQueueInfo) bool {
+		return a.GetCategory() < b.GetCategory()
+	})
+	return &historyservice.DescribeHistoryQueueResponse{
+		Queues: queueInfos,
+	}, nil
+}
+
 func (e *historyEngineImpl) RebuildMutableState(
 	ctx context.Context,
 	namespaceUUID namespace.ID,
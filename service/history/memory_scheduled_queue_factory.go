@@ -79,8 +79,14 @@ func NewMemoryScheduledQueueFactory(
 	)
 
 	return &memoryScheduledQueueFactory{
-		scheduler:         hostScheduler,
-		priorityAssigner:  queues.NewPriorityAssigner(),
+		scheduler: hostScheduler,
+		priorityAssigner: queues.NewPriorityAssigner(
+			params.NamespaceRegistry,
+			params.Config.TaskSchedulerNamespacePriorityMultiplier,
+			params.Config.TaskSchedulerNamespacePriorityOverride,
+			params.Config.TimerProcessorSchedulerActiveRoundRobinWeights,
+			logger,
+		),
 		namespaceRegistry: params.NamespaceRegistry,
 		clusterMetadata:   params.ClusterMetadata,
 		timeSource:        params.TimeSource,
@@ -353,6 +353,7 @@ func (r *transactionMgrImpl) backfillWorkflowEventsReapply(
 			EventsReapplicationResetWorkflowReason,
 			totalEvents,
 			enumspb.RESET_REAPPLY_TYPE_SIGNAL,
+			nil,
 		)
 		switch err.(type) {
 		case *serviceerror.InvalidArgument:
@@ -240,6 +240,7 @@ func (s *transactionMgrSuite) TestBackfillWorkflow_CurrentWorkflow_Active_Closed
 		EventsReapplicationResetWorkflowReason,
 		workflowEvents.Events,
 		enumspb.RESET_REAPPLY_TYPE_SIGNAL,
+		nil,
 	).Return(nil)
 
 	s.mockExecutionMgr.EXPECT().GetCurrentExecution(gomock.Any(), &persistence.GetCurrentExecutionRequest{
@@ -319,6 +320,7 @@ func (s *transactionMgrSuite) TestBackfillWorkflow_CurrentWorkflow_Closed_ResetF
 		EventsReapplicationResetWorkflowReason,
 		workflowEvents.Events,
 		enumspb.RESET_REAPPLY_TYPE_SIGNAL,
+		nil,
 	).Return(serviceerror.NewInvalidArgument("reset fail"))
 
 	s.mockExecutionMgr.EXPECT().GetCurrentExecution(gomock.Any(), &persistence.GetCurrentExecutionRequest{
@@ -72,6 +72,7 @@ type (
 			resetReason string,
 			additionalReapplyEvents []*historypb.HistoryEvent,
 			resetReapplyType enumspb.ResetReapplyType,
+			resetWorkerVersionStamp *commonpb.WorkerVersionStamp,
 		) error
 	}
 
@@ -125,6 +126,7 @@ func (r *workflowResetterImpl) ResetWorkflow(
 	resetReason string,
 	additionalReapplyEvents []*historypb.HistoryEvent,
 	resetReapplyType enumspb.ResetReapplyType,
+	resetWorkerVersionStamp *commonpb.WorkerVersionStamp,
 ) (retError error) {
 
 	namespaceEntry, err := r.namespaceRegistry.GetNamespaceByID(namespaceID)
@@ -221,6 +223,11 @@ func (r *workflowResetterImpl) ResetWorkflow(
 		return err
 	}
 
+	if resetWorkerVersionStamp != nil {
+		// the first workflow task of the reset run is dispatched according to this stamp
+		resetWorkflow.GetMutableState().GetExecutionInfo().WorkerVersionStamp = resetWorkerVersionStamp
+	}
+
 	if err := workflow.ScheduleWorkflowTask(resetWorkflow.GetMutableState()); err != nil {
 		return err
 	}
@@ -27,6 +27,7 @@ package history
 import (
 	"context"
 
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/fx"
 
 	"go.temporal.io/server/common"
@@ -37,6 +38,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/queues"
@@ -71,6 +73,9 @@ type (
 		MetricsHandler       metrics.Handler
 		Logger               log.SnTaggedLogger
 		SchedulerRateLimiter queues.SchedulerRateLimiter
+		TracerProvider       trace.TracerProvider
+		HistoryTaskDLQ       persistence.HistoryTaskDLQ
+		AdaptiveWorkerRatio  *queues.AdaptiveWorkerRatio
 	}
 
 	QueueFactoryBase struct {
@@ -89,6 +94,7 @@ type (
 
 var QueueModule = fx.Options(
 	fx.Provide(QueueSchedulerRateLimiterProvider),
+	fx.Provide(AdaptiveWorkerRatioProvider),
 	fx.Provide(
 		fx.Annotated{
 			Group:  QueueFactoryFxGroup,
@@ -109,6 +115,7 @@ var QueueModule = fx.Options(
 		getOptionalQueueFactories,
 	),
 	fx.Invoke(QueueFactoryLifetimeHooks),
+	fx.Invoke(AdaptiveWorkerRatioLifetimeHooks),
 )
 
 // additionalQueueFactories is a container for a list of queue factories that are only added to the group if
@@ -196,6 +203,55 @@ func (f *QueueFactoryBase) Stop() {
 	}
 }
 
+func AdaptiveWorkerRatioProvider(
+	config *configs.Config,
+	healthSignals persistence.HealthSignalAggregator,
+	logger log.SnTaggedLogger,
+) *queues.AdaptiveWorkerRatio {
+	return queues.NewAdaptiveWorkerRatio(
+		healthSignals,
+		queues.AdaptiveWorkerCountOptions{
+			Enabled:             config.TaskSchedulerAdaptiveWorkerCountEnabled,
+			LatencyThreshold:    config.TaskSchedulerAdaptiveLatencyThreshold,
+			ErrorRatioThreshold: config.TaskSchedulerAdaptiveErrorRatioThreshold,
+			MinWorkerRatio:      config.TaskSchedulerAdaptiveMinWorkerRatio,
+		},
+		logger,
+	)
+}
+
+func AdaptiveWorkerRatioLifetimeHooks(
+	lc fx.Lifecycle,
+	ratio *queues.AdaptiveWorkerRatio,
+) {
+	lc.Append(
+		fx.Hook{
+			OnStart: func(context.Context) error {
+				ratio.Start()
+				return nil
+			},
+			OnStop: func(context.Context) error {
+				ratio.Stop()
+				return nil
+			},
+		},
+	)
+}
+
+// NewHostSchedulerWorkerCount returns the worker count for a host level task scheduler, which is scaled down
+// by the host AdaptiveWorkerRatio when persistence is unhealthy if adaptive worker count is enabled. The ratio is
+// updated on its own timer, the scheduler picks up the scaled count whenever it re-evaluates its worker count.
+func NewHostSchedulerWorkerCount(
+	params QueueFactoryBaseParams,
+	workerCount dynamicconfig.IntPropertyFn,
+	metricsHandler metrics.Handler,
+) dynamicconfig.IntPropertyFn {
+	if params.AdaptiveWorkerRatio == nil {
+		return workerCount
+	}
+	return params.AdaptiveWorkerRatio.WorkerCount(workerCount, metricsHandler)
+}
+
 func NewQueueHostRateLimiter(
 	hostRPS dynamicconfig.IntPropertyFn,
 	persistenceMaxRPS dynamicconfig.IntPropertyFn,
@@ -30,6 +30,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/fx"
 
 	"go.temporal.io/server/api/historyservice/v1"
@@ -41,6 +42,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/sdk"
@@ -180,6 +182,9 @@ type compileTimeDependencies struct {
 	manager.VisibilityManager
 	archival.Archiver
 	workflow.RelocatableAttributesFetcher
+	trace.TracerProvider
+	persistence.HistoryTaskDLQ
+	persistence.HealthSignalAggregator
 }
 
 // getArchivalMetadata returns a mock ArchivalMetadata that contains the static archival config specified in the given
@@ -0,0 +1,208 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queues
+
+import (
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+)
+
+const (
+	// adaptiveWorkerRatioUpdateInterval is how often persistence health is evaluated to update the fraction of
+	// workers kept
+	adaptiveWorkerRatioUpdateInterval = 5 * time.Second
+	// adaptiveWorkerRatioDecreaseFactor is how much the fraction of workers kept shrinks on each update while
+	// persistence is unhealthy
+	adaptiveWorkerRatioDecreaseFactor = 0.5
+	// adaptiveWorkerRatioIncreaseStep is how much the fraction of workers kept grows on each update once persistence
+	// recovered
+	adaptiveWorkerRatioIncreaseStep = 0.25
+)
+
+type (
+	AdaptiveWorkerCountOptions struct {
+		Enabled             dynamicconfig.BoolPropertyFn
+		LatencyThreshold    dynamicconfig.DurationPropertyFn
+		ErrorRatioThreshold dynamicconfig.FloatPropertyFn
+		MinWorkerRatio      dynamicconfig.FloatPropertyFn
+	}
+
+	// AdaptiveWorkerRatio tracks the fraction of the workers of the host level task schedulers to keep given
+	// persistence health. Every adaptiveWorkerRatioUpdateInterval, the fraction halves if the average latency or the
+	// ratio of unhealthy errors reported by the health signals breaches its threshold, down to MinWorkerRatio, and
+	// otherwise grows back by a quarter. The schedulers pick it up when they next resize their worker pool, see
+	// WorkerCount.
+	AdaptiveWorkerRatio struct {
+		status     int32
+		shutdownCh chan struct{}
+		shutdownWG sync.WaitGroup
+
+		healthSignals persistence.HealthSignalAggregator
+		options       AdaptiveWorkerCountOptions
+		logger        log.Logger
+
+		sync.Mutex
+		ratio           float64
+		warnedNoop      bool
+		lastLoggedRatio float64
+	}
+)
+
+// NewAdaptiveWorkerRatio creates an AdaptiveWorkerRatio following the given health signals. A nil or noop aggregator
+// reports no signals, the ratio then stays at 1 and a warning is logged if adaptive worker count is enabled.
+func NewAdaptiveWorkerRatio(
+	healthSignals persistence.HealthSignalAggregator,
+	options AdaptiveWorkerCountOptions,
+	logger log.Logger,
+) *AdaptiveWorkerRatio {
+	return &AdaptiveWorkerRatio{
+		status:          common.DaemonStatusInitialized,
+		shutdownCh:      make(chan struct{}),
+		healthSignals:   healthSignals,
+		options:         options,
+		logger:          logger,
+		ratio:           1,
+		lastLoggedRatio: 1,
+	}
+}
+
+func (a *AdaptiveWorkerRatio) Start() {
+	if !atomic.CompareAndSwapInt32(&a.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	a.shutdownWG.Add(1)
+	go a.updateLoop()
+}
+
+func (a *AdaptiveWorkerRatio) Stop() {
+	if !atomic.CompareAndSwapInt32(&a.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(a.shutdownCh)
+
+	if success := common.AwaitWaitGroup(&a.shutdownWG, time.Minute); !success {
+		a.logger.Warn("Adaptive worker ratio timed out on shutdown.", tag.LifeCycleStopTimedout)
+	}
+}
+
+// WorkerCount scales workerCount by the current ratio while adaptive worker count is enabled. It has no side
+// effects, so it can be evaluated as often as needed.
+func (a *AdaptiveWorkerRatio) WorkerCount(
+	workerCount dynamicconfig.IntPropertyFn,
+	metricsHandler metrics.Handler,
+) dynamicconfig.IntPropertyFn {
+	return func() int {
+		count := workerCount()
+		if !a.options.Enabled() {
+			return count
+		}
+
+		count = int(math.Ceil(float64(count) * a.Ratio()))
+		if count < 1 {
+			count = 1
+		}
+		metricsHandler.Gauge(metrics.TaskSchedulerWorkerCount.GetMetricName()).Record(float64(count))
+		return count
+	}
+}
+
+// Ratio returns the fraction of workers to keep.
+func (a *AdaptiveWorkerRatio) Ratio() float64 {
+	a.Lock()
+	defer a.Unlock()
+
+	return a.ratio
+}
+
+func (a *AdaptiveWorkerRatio) updateLoop() {
+	defer a.shutdownWG.Done()
+
+	ticker := time.NewTicker(adaptiveWorkerRatioUpdateInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-a.shutdownCh:
+			return
+		case <-ticker.C:
+			a.update()
+		}
+	}
+}
+
+func (a *AdaptiveWorkerRatio) update() {
+	a.Lock()
+	defer a.Unlock()
+
+	if !a.options.Enabled() {
+		a.ratio = 1
+		a.lastLoggedRatio = 1
+		return
+	}
+	if a.healthSignals == nil || a.healthSignals == persistence.NoopHealthSignalAggregator {
+		if !a.warnedNoop {
+			a.logger.Warn("Adaptive task scheduler worker count is enabled but persistence health signal collection is disabled, worker count is not adapted")
+			a.warnedNoop = true
+		}
+		return
+	}
+
+	if a.unhealthy() {
+		a.ratio = math.Max(a.ratio*adaptiveWorkerRatioDecreaseFactor, a.options.MinWorkerRatio())
+	} else {
+		a.ratio = math.Min(a.ratio+adaptiveWorkerRatioIncreaseStep, 1)
+	}
+	if a.ratio != a.lastLoggedRatio {
+		a.logger.Info("Adapt task scheduler worker ratio to persistence health",
+			tag.NewAnyTag("worker-ratio", a.ratio),
+			tag.NewAnyTag("persistence-latency-ms", a.healthSignals.AverageLatency()),
+			tag.NewAnyTag("persistence-error-ratio", a.healthSignals.ErrorRatio()),
+		)
+		a.lastLoggedRatio = a.ratio
+	}
+}
+
+func (a *AdaptiveWorkerRatio) unhealthy() bool {
+	if threshold := a.options.LatencyThreshold(); threshold > 0 &&
+		a.healthSignals.AverageLatency() > float64(threshold.Milliseconds()) {
+		return true
+	}
+	if threshold := a.options.ErrorRatioThreshold(); threshold > 0 &&
+		a.healthSignals.ErrorRatio() > threshold {
+		return true
+	}
+	return false
+}
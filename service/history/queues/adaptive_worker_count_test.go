@@ -0,0 +1,175 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queues
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+)
+
+type (
+	adaptiveWorkerCountSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		healthSignals *testHealthSignalAggregator
+		enabled       bool
+	}
+
+	testHealthSignalAggregator struct {
+		persistence.HealthSignalAggregator
+
+		averageLatency float64
+		errorRatio     float64
+	}
+)
+
+func TestAdaptiveWorkerCountSuite(t *testing.T) {
+	s := new(adaptiveWorkerCountSuite)
+	suite.Run(t, s)
+}
+
+func (s *adaptiveWorkerCountSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.healthSignals = &testHealthSignalAggregator{}
+	s.enabled = true
+}
+
+func (s *adaptiveWorkerCountSuite) TestWorkerCount_Disabled() {
+	s.enabled = false
+	ratio := s.newAdaptiveWorkerRatio(s.healthSignals)
+	workerCount := ratio.WorkerCount(dynamicconfig.GetIntPropertyFn(100), metrics.NoopMetricsHandler)
+
+	s.healthSignals.averageLatency = 1000
+	ratio.update()
+	s.Equal(100, workerCount())
+	ratio.update()
+	s.Equal(100, workerCount())
+}
+
+func (s *adaptiveWorkerCountSuite) TestWorkerCount_Healthy() {
+	ratio := s.newAdaptiveWorkerRatio(s.healthSignals)
+	workerCount := ratio.WorkerCount(dynamicconfig.GetIntPropertyFn(100), metrics.NoopMetricsHandler)
+
+	s.healthSignals.averageLatency = 100
+	s.healthSignals.errorRatio = 0.05
+	ratio.update()
+	s.Equal(100, workerCount())
+}
+
+func (s *adaptiveWorkerCountSuite) TestWorkerCount_NoSideEffects() {
+	ratio := s.newAdaptiveWorkerRatio(s.healthSignals)
+	workerCount := ratio.WorkerCount(dynamicconfig.GetIntPropertyFn(100), metrics.NoopMetricsHandler)
+
+	s.healthSignals.averageLatency = 1000
+	for i := 0; i < 10; i++ {
+		s.Equal(100, workerCount())
+	}
+
+	ratio.update()
+	for i := 0; i < 10; i++ {
+		s.Equal(50, workerCount())
+	}
+}
+
+func (s *adaptiveWorkerCountSuite) TestWorkerCount_ScaleDownAndRecover() {
+	ratio := s.newAdaptiveWorkerRatio(s.healthSignals)
+	workerCount := ratio.WorkerCount(dynamicconfig.GetIntPropertyFn(100), metrics.NoopMetricsHandler)
+
+	s.healthSignals.averageLatency = 1000
+	for _, expected := range []int{50, 25, 20, 20} { // capped by min worker ratio
+		ratio.update()
+		s.Equal(expected, workerCount())
+	}
+
+	s.healthSignals.averageLatency = 100
+	for _, expected := range []int{45, 70, 95, 100, 100} {
+		ratio.update()
+		s.Equal(expected, workerCount())
+	}
+}
+
+func (s *adaptiveWorkerCountSuite) TestWorkerCount_ErrorRatio() {
+	ratio := s.newAdaptiveWorkerRatio(s.healthSignals)
+	workerCount := ratio.WorkerCount(dynamicconfig.GetIntPropertyFn(100), metrics.NoopMetricsHandler)
+
+	s.healthSignals.errorRatio = 0.5
+	ratio.update()
+	s.Equal(50, workerCount())
+
+	s.healthSignals.errorRatio = 0
+	ratio.update()
+	s.Equal(75, workerCount())
+}
+
+func (s *adaptiveWorkerCountSuite) TestWorkerCount_NoopHealthSignals() {
+	ratio := s.newAdaptiveWorkerRatio(persistence.NoopHealthSignalAggregator)
+	workerCount := ratio.WorkerCount(dynamicconfig.GetIntPropertyFn(100), metrics.NoopMetricsHandler)
+
+	ratio.update()
+	ratio.update()
+	s.Equal(1.0, ratio.Ratio())
+	s.Equal(100, workerCount())
+	s.True(ratio.warnedNoop)
+}
+
+func (s *adaptiveWorkerCountSuite) TestStartStop() {
+	ratio := s.newAdaptiveWorkerRatio(s.healthSignals)
+
+	ratio.Start()
+	ratio.Stop()
+}
+
+func (s *adaptiveWorkerCountSuite) newAdaptiveWorkerRatio(
+	healthSignals persistence.HealthSignalAggregator,
+) *AdaptiveWorkerRatio {
+	return NewAdaptiveWorkerRatio(
+		healthSignals,
+		AdaptiveWorkerCountOptions{
+			Enabled:             func() bool { return s.enabled },
+			LatencyThreshold:    dynamicconfig.GetDurationPropertyFn(500 * time.Millisecond),
+			ErrorRatioThreshold: dynamicconfig.GetFloatPropertyFn(0.1),
+			MinWorkerRatio:      dynamicconfig.GetFloatPropertyFn(0.2),
+		},
+		log.NewTestLogger(),
+	)
+}
+
+func (a *testHealthSignalAggregator) AverageLatency() float64 {
+	return a.averageLatency
+}
+
+func (a *testHealthSignalAggregator) ErrorRatio() float64 {
+	return a.errorRatio
+}
@@ -0,0 +1,155 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queues
+
+import (
+	"sync"
+	"time"
+
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+)
+
+type (
+	// CircuitBreaker stops executing the tasks of a task type while that task type keeps failing, e.g. because the
+	// matching partitions or the remote cluster its executor talks to are down, instead of retrying all of them hot.
+	CircuitBreaker interface {
+		// Allow returns whether a task of the given type may execute now. While the breaker of the task type is
+		// open it returns false and how long the breaker stays open. Once that time passed, the breaker is
+		// half-open and lets one task through per open duration to probe whether the failures are over.
+		Allow(taskType enumsspb.TaskType) (bool, time.Duration)
+		// RecordSuccess closes the breaker of the task type.
+		RecordSuccess(taskType enumsspb.TaskType)
+		// RecordFailure counts a failed attempt of a task of the task type. The breaker opens once the configured
+		// number of consecutive attempts failed, or right away if it was half-open.
+		RecordFailure(taskType enumsspb.TaskType)
+	}
+
+	circuitBreakerImpl struct {
+		failureThreshold dynamicconfig.IntPropertyFn
+		openDuration     dynamicconfig.DurationPropertyFn
+		timeSource       clock.TimeSource
+		logger           log.Logger
+		metricsHandler   metrics.Handler
+
+		sync.Mutex
+		states map[enumsspb.TaskType]*circuitState
+	}
+
+	circuitState struct {
+		failures  int
+		open      bool
+		nextProbe time.Time // while open, when the next task is let through
+	}
+)
+
+// NewCircuitBreaker creates a CircuitBreaker which opens the breaker of a task type after failureThreshold
+// consecutive failed attempts and keeps it open for openDuration between probes. It's disabled if failureThreshold
+// is not positive.
+func NewCircuitBreaker(
+	failureThreshold dynamicconfig.IntPropertyFn,
+	openDuration dynamicconfig.DurationPropertyFn,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+) CircuitBreaker {
+	return &circuitBreakerImpl{
+		failureThreshold: failureThreshold,
+		openDuration:     openDuration,
+		timeSource:       timeSource,
+		logger:           logger,
+		metricsHandler:   metricsHandler,
+		states:           make(map[enumsspb.TaskType]*circuitState),
+	}
+}
+
+func (b *circuitBreakerImpl) Allow(taskType enumsspb.TaskType) (bool, time.Duration) {
+	if b.failureThreshold() <= 0 {
+		return true, 0
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	state, ok := b.states[taskType]
+	if !ok || !state.open {
+		return true, 0
+	}
+	now := b.timeSource.Now()
+	if now.Before(state.nextProbe) {
+		return false, state.nextProbe.Sub(now)
+	}
+	// half-open, let this task probe and hold back the others for another open duration in case it fails
+	// (or never reports back)
+	state.nextProbe = now.Add(b.openDuration())
+	return true, 0
+}
+
+func (b *circuitBreakerImpl) RecordSuccess(taskType enumsspb.TaskType) {
+	b.Lock()
+	defer b.Unlock()
+
+	state, ok := b.states[taskType]
+	if !ok {
+		return
+	}
+	delete(b.states, taskType)
+	if state.open {
+		b.metricsHandler.Counter(metrics.TaskCircuitBreakerReset.GetMetricName()).Record(1, metrics.TaskTypeTag(taskType.String()))
+		b.logger.Info("Task circuit breaker closed", tag.TaskType(taskType))
+	}
+}
+
+func (b *circuitBreakerImpl) RecordFailure(taskType enumsspb.TaskType) {
+	threshold := b.failureThreshold()
+	if threshold <= 0 {
+		return
+	}
+
+	b.Lock()
+	defer b.Unlock()
+
+	state, ok := b.states[taskType]
+	if !ok {
+		state = &circuitState{}
+		b.states[taskType] = state
+	}
+	state.failures++
+	if state.open {
+		// a probe failed, stay open
+		state.nextProbe = b.timeSource.Now().Add(b.openDuration())
+		return
+	}
+	if state.failures >= threshold {
+		state.open = true
+		state.nextProbe = b.timeSource.Now().Add(b.openDuration())
+		b.metricsHandler.Counter(metrics.TaskCircuitBreakerTripped.GetMetricName()).Record(1, metrics.TaskTypeTag(taskType.String()))
+		b.logger.Warn("Task circuit breaker opened", tag.TaskType(taskType), tag.Counter(state.failures))
+	}
+}
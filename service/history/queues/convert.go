@@ -30,6 +30,7 @@ import (
 	"golang.org/x/exp/maps"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/predicates"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -50,9 +51,15 @@ func ToPersistenceQueueState(
 		}
 	}
 
+	var exclusiveDeletionHighWatermark *persistencespb.TaskKey
+	if queueState.exclusiveDeletionHighWatermark != nil {
+		exclusiveDeletionHighWatermark = ToPersistenceTaskKey(*queueState.exclusiveDeletionHighWatermark)
+	}
+
 	return &persistencespb.QueueState{
-		ReaderStates:                 readerStates,
-		ExclusiveReaderHighWatermark: ToPersistenceTaskKey(queueState.exclusiveReaderHighWatermark),
+		ReaderStates:                   readerStates,
+		ExclusiveReaderHighWatermark:   ToPersistenceTaskKey(queueState.exclusiveReaderHighWatermark),
+		ExclusiveDeletionHighWatermark: exclusiveDeletionHighWatermark,
 	}
 }
 
@@ -68,9 +75,16 @@ func FromPersistenceQueueState(
 		readerScopes[id] = scopes
 	}
 
+	var exclusiveDeletionHighWatermark *tasks.Key
+	if state.ExclusiveDeletionHighWatermark != nil {
+		key := FromPersistenceTaskKey(state.ExclusiveDeletionHighWatermark)
+		exclusiveDeletionHighWatermark = &key
+	}
+
 	return &queueState{
-		readerScopes:                 readerScopes,
-		exclusiveReaderHighWatermark: FromPersistenceTaskKey(state.ExclusiveReaderHighWatermark),
+		readerScopes:                   readerScopes,
+		exclusiveReaderHighWatermark:   FromPersistenceTaskKey(state.ExclusiveReaderHighWatermark),
+		exclusiveDeletionHighWatermark: exclusiveDeletionHighWatermark,
 	}
 }
 
@@ -119,6 +133,15 @@ func ToPersistenceTaskKey(
 	}
 }
 
+func toHistoryTaskKey(
+	key tasks.Key,
+) *historyspb.TaskKey {
+	return &historyspb.TaskKey{
+		TaskId:   key.TaskID,
+		FireTime: timestamp.TimePtr(key.FireTime),
+	}
+}
+
 func FromPersistenceTaskKey(
 	key *persistencespb.TaskKey,
 ) tasks.Key {
@@ -259,4 +259,10 @@ func (s *convertSuite) TestConvertQueueState() {
 	s.Equal(queueState, FromPersistenceQueueState(
 		ToPersistenceQueueState(queueState),
 	))
+
+	exclusiveDeletionHighWatermark := tasks.NewKey(time.Unix(0, rand.Int63()).UTC(), 0)
+	queueState.exclusiveDeletionHighWatermark = &exclusiveDeletionHighWatermark
+	s.Equal(queueState, FromPersistenceQueueState(
+		ToPersistenceQueueState(queueState),
+	))
 }
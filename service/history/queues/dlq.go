@@ -0,0 +1,270 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queues
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/primitives/timestamp"
+	hshard "go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+type (
+	// DLQ persists the tasks of executables which keep failing, so that they can be acked and stop blocking the
+	// queue they were loaded from. Tasks in the DLQ are re-enqueued with MergeDLQ.
+	DLQ interface {
+		common.Daemon
+
+		// Enqueue hands the task of the given executable over to be persisted along with the error of its last
+		// attempt, and returns false if the DLQ is stopped or has too many tasks pending already. Tasks are persisted
+		// in the background, so that the scheduler workers don't wait on the DLQ. The executable is acked once its
+		// task is persisted, and rescheduled if that fails.
+		Enqueue(executable Executable, lastErr error) bool
+	}
+
+	dlqImpl struct {
+		status     int32
+		shutdownCh chan struct{}
+		shutdownWG sync.WaitGroup
+
+		shardID        int32
+		historyTaskDLQ persistence.HistoryTaskDLQ
+		serializer     serialization.Serializer
+		timeSource     clock.TimeSource
+		logger         log.Logger
+		metricsHandler metrics.Handler
+
+		requestCh chan dlqRequest
+	}
+
+	dlqRequest struct {
+		executable Executable
+		lastErr    error
+	}
+)
+
+const (
+	// dlqMaxPendingRequests is the max number of tasks waiting to be persisted in the DLQ of a queue
+	dlqMaxPendingRequests = 100
+	// dlqEnqueueTimeout is the timeout for persisting a task in the DLQ
+	dlqEnqueueTimeout = 5 * time.Second
+)
+
+var _ DLQ = (*dlqImpl)(nil)
+
+func NewDLQ(
+	shardID int32,
+	historyTaskDLQ persistence.HistoryTaskDLQ,
+	serializer serialization.Serializer,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+	metricsHandler metrics.Handler,
+) DLQ {
+	return &dlqImpl{
+		status:         common.DaemonStatusInitialized,
+		shutdownCh:     make(chan struct{}),
+		shardID:        shardID,
+		historyTaskDLQ: historyTaskDLQ,
+		serializer:     serializer,
+		timeSource:     timeSource,
+		logger:         logger,
+		metricsHandler: metricsHandler,
+		requestCh:      make(chan dlqRequest, dlqMaxPendingRequests),
+	}
+}
+
+func (d *dlqImpl) Start() {
+	if !atomic.CompareAndSwapInt32(&d.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+
+	d.shutdownWG.Add(1)
+	go d.enqueueLoop()
+}
+
+func (d *dlqImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(&d.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+
+	close(d.shutdownCh)
+
+	if success := common.AwaitWaitGroup(&d.shutdownWG, time.Minute); !success {
+		d.logger.Warn("Task DLQ timed out on shutdown.", tag.LifeCycleStopTimedout)
+	}
+}
+
+func (d *dlqImpl) Enqueue(
+	executable Executable,
+	lastErr error,
+) bool {
+	if atomic.LoadInt32(&d.status) != common.DaemonStatusStarted {
+		return false
+	}
+
+	select {
+	case d.requestCh <- dlqRequest{executable: executable, lastErr: lastErr}:
+		return true
+	default:
+		return false
+	}
+}
+
+func (d *dlqImpl) enqueueLoop() {
+	defer d.shutdownWG.Done()
+
+	for {
+		select {
+		case <-d.shutdownCh:
+			// the pending executables are aborted along with the queue, and loaded again by the next owner of the shard
+			return
+		case request := <-d.requestCh:
+			d.persist(request.executable, request.lastErr)
+		}
+	}
+}
+
+func (d *dlqImpl) persist(
+	executable Executable,
+	lastErr error,
+) {
+	taskTypeTag := metrics.TaskTypeTag(executable.GetType().String())
+	ctx, cancel := context.WithTimeout(
+		headers.SetCallerInfo(context.Background(), headers.SystemBackgroundCallerInfo),
+		dlqEnqueueTimeout,
+	)
+	defer cancel()
+
+	if err := d.enqueueTask(ctx, executable, lastErr); err != nil {
+		d.metricsHandler.Counter(metrics.TaskDLQEnqueueFailed.GetMetricName()).Record(1, taskTypeTag)
+		d.logger.Error("Fail to move task to DLQ", tag.TaskType(executable.GetType()), tag.Error(err))
+		executable.Reschedule()
+		return
+	}
+
+	d.metricsHandler.Counter(metrics.TaskDLQEnqueued.GetMetricName()).Record(1, taskTypeTag)
+	d.logger.Warn("Task moved to DLQ after reaching max attempts",
+		tag.TaskType(executable.GetType()),
+		tag.LifetimeAttempt(int32(executable.LifetimeAttempt())),
+		tag.Error(lastErr),
+	)
+	executable.Ack()
+}
+
+func (d *dlqImpl) enqueueTask(
+	ctx context.Context,
+	executable Executable,
+	lastErr error,
+) error {
+	task := executable.GetTask()
+	category := task.GetCategory()
+	blob, err := d.serializer.SerializeTask(task)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.historyTaskDLQ.EnqueueTask(ctx, &persistencespb.HistoryTaskDLQMessage{
+		ShardId:     d.shardID,
+		CategoryId:  category.ID(),
+		TaskType:    task.GetType(),
+		NamespaceId: task.GetNamespaceID(),
+		WorkflowId:  task.GetWorkflowID(),
+		RunId:       task.GetRunID(),
+		Task:        &blob,
+		Attempt:     int32(executable.LifetimeAttempt()),
+		LastError:   lastErr.Error(),
+		EnqueueTime: timestamp.TimePtr(d.timeSource.Now()),
+	})
+	return err
+}
+
+// MergeDLQ re-enqueues the tasks found in the DLQ of the given shard up to and including lastMessageID, then deletes
+// them from the DLQ. It returns the token of the next page to merge, or nil if the end of the DLQ was reached.
+func MergeDLQ(
+	ctx context.Context,
+	shard hshard.Context,
+	historyTaskDLQ persistence.HistoryTaskDLQ,
+	lastMessageID int64,
+	pageSize int,
+	pageToken []byte,
+) ([]byte, error) {
+	messages, token, err := historyTaskDLQ.ReadTasks(
+		ctx,
+		shard.GetShardID(),
+		persistence.EmptyQueueMessageID,
+		lastMessageID,
+		pageSize,
+		pageToken,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, message := range messages {
+		category, ok := tasks.GetCategoryByID(message.GetCategoryId())
+		if !ok {
+			return nil, serviceerror.NewInternal(fmt.Sprintf("unknown task category %v of DLQ message %v", message.GetCategoryId(), message.GetMessageId()))
+		}
+		task, err := shard.GetPayloadSerializer().DeserializeTask(category, *message.GetTask())
+		if err != nil {
+			return nil, err
+		}
+
+		if err := shard.AddTasks(ctx, &persistence.AddHistoryTasksRequest{
+			ShardID: shard.GetShardID(),
+			// RangeID is set by shard
+			NamespaceID: message.GetNamespaceId(),
+			WorkflowID:  message.GetWorkflowId(),
+			RunID:       message.GetRunId(),
+			Tasks: map[tasks.Category][]tasks.Task{
+				category: {task},
+			},
+		}); err != nil {
+			return nil, err
+		}
+
+		if err := historyTaskDLQ.DeleteTask(ctx, shard.GetShardID(), message.GetMessageId()); err != nil {
+			return nil, err
+		}
+	}
+
+	return token, nil
+}
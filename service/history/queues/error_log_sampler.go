@@ -0,0 +1,113 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queues
+
+import (
+	"errors"
+	"sync"
+
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+const (
+	// ErrorLogClassWorkflowBusy is the class of errors caused by the workflow being busy, e.g. its lock is held by
+	// other tasks or requests.
+	ErrorLogClassWorkflowBusy = "workflow_busy"
+	// ErrorLogClassResourceExhausted is the class of errors caused by the system being overloaded.
+	ErrorLogClassResourceExhausted = "resource_exhausted"
+)
+
+type (
+	// ErrorLogSampler decides whether an error returned by task processing is logged.
+	ErrorLogSampler interface {
+		ShouldLog(err error) bool
+	}
+
+	errorLogSamplerImpl struct {
+		sampleRates dynamicconfig.MapPropertyFn
+
+		sync.Mutex
+		counts map[string]int
+	}
+)
+
+// NewErrorLogSampler creates an ErrorLogSampler which logs only one in every N errors of a class, where N is the sample
+// rate configured for the class in sampleRates. Errors of classes without a sample rate, or with a sample rate of at
+// most 1, and errors which don't belong to any class, are always logged.
+func NewErrorLogSampler(
+	sampleRates dynamicconfig.MapPropertyFn,
+) ErrorLogSampler {
+	return &errorLogSamplerImpl{
+		sampleRates: sampleRates,
+		counts:      make(map[string]int),
+	}
+}
+
+func (s *errorLogSamplerImpl) ShouldLog(err error) bool {
+	class := errorLogClass(err)
+	if class == "" {
+		return true
+	}
+	rate := errorLogSampleRate(s.sampleRates()[class])
+	if rate <= 1 {
+		return true
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	count := s.counts[class]
+	s.counts[class] = (count + 1) % rate
+	return count == 0
+}
+
+func errorLogClass(err error) string {
+	var resourceExhaustedErr *serviceerror.ResourceExhausted
+	if errors.As(err, &resourceExhaustedErr) {
+		if resourceExhaustedErr.Cause == enums.RESOURCE_EXHAUSTED_CAUSE_BUSY_WORKFLOW {
+			return ErrorLogClassWorkflowBusy
+		}
+		return ErrorLogClassResourceExhausted
+	}
+	return ""
+}
+
+func errorLogSampleRate(value interface{}) int {
+	switch value := value.(type) {
+	case float64:
+		return int(value)
+	case int:
+		return value
+	case int32:
+		return int(value)
+	case int64:
+		return int(value)
+	default:
+		return 0
+	}
+}
@@ -34,18 +34,24 @@ import (
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/clock"
+	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
 	"go.temporal.io/server/common/cluster"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	ctasks "go.temporal.io/server/common/tasks"
 	"go.temporal.io/server/common/util"
@@ -60,12 +66,69 @@ type (
 		tasks.Task
 
 		Attempt() int
+		// LifetimeAttempt is like Attempt but is never reset, e.g. on active/standby transitions, so it can be used to
+		// correlate all the retries of a task.
+		LifetimeAttempt() int
 		GetTask() tasks.Task
 		GetPriority() ctasks.Priority
 		GetScheduledTime() time.Time
 		SetScheduledTime(time.Time)
+
+		// Yield records the cursor to continue from and returns consts.ErrTaskYield.
+		// Executors processing large batches return that error to release the worker,
+		// the executable is then resubmitted and can resume from Cursor() on the next attempt.
+		Yield(cursor interface{}) error
+		// Cursor returns the cursor recorded by the last Yield, or nil if the executable never yielded.
+		Cursor() interface{}
+		// Split creates a child executable for each of the given tasks and returns consts.ErrTaskSplit.
+		// Executors fanning out to many targets return that error instead of processing all of them in one attempt,
+		// the children are then scheduled independently and the executable is acked once all of them are acked.
+		// Splitting into no tasks returns nil, i.e. the executable completes right away.
+		Split(children []tasks.Task) error
+		// SetMinClock makes Execute defer the executable, by returning consts.ErrClockNotReached without invoking the
+		// executor, until the given watermark reaches minClock. A nil minClock removes the requirement.
+		SetMinClock(minClock *hlc.Clock, watermark ClockWatermark)
+		// SetSequencer adds the executable to the given sequencer, Execute then defers it, by returning
+		// consts.ErrPrecedingTaskNotCompleted without invoking the executor, until all executables of the same
+		// workflow scheduled before it in that sequencer are completed. It must be called before the first submission.
+		SetSequencer(sequencer *ExecutableSequencer)
+		// SetShardReloading makes Nack drop the executable instead of adding it to the rescheduler while the shard is
+		// reloading, since the reload loads the task again anyway. A nil reloading removes the check.
+		SetShardReloading(reloading ShardReloading)
+		// SetTracer makes Execute record a span per attempt with the given tracer, ended by HandleErr once the outcome
+		// of the attempt is known. Spans of the children of a split executable are parented to the attempt that split
+		// it. A nil tracer disables tracing.
+		SetTracer(tracer trace.Tracer)
+		// SetCircuitBreaker makes Execute defer the executable, by returning consts.ErrCircuitBreakerOpen without
+		// invoking the executor, while the breaker of its task type is open, and reports the outcome of each attempt
+		// to the breaker. A nil breaker removes the check.
+		SetCircuitBreaker(breaker CircuitBreaker)
+		// SetDLQ makes Nack hand the executable over to the given DLQ, which acks it once persisted, after it failed
+		// maxAttempts times. Errors which are expected to resolve by themselves, e.g. throttling, timeouts or an
+		// unavailable dependency, don't count. A nil dlq or a non-positive maxAttempts disables it.
+		SetDLQ(dlq DLQ, maxAttempts dynamicconfig.IntPropertyFn)
+		// SetAttemptTimeout makes Execute cancel the context passed to the executor once an attempt runs for longer
+		// than the given timeout. An attempt failing past its deadline returns consts.ErrTaskAttemptTimeout and the
+		// executable is rescheduled with a backoff. A nil timeout or a non-positive value disables the deadline.
+		SetAttemptTimeout(timeout dynamicconfig.DurationPropertyFn)
+		// ReportProgress records that the running attempt is still making progress. Executors of long running tasks
+		// call it from Execute so that the executable is not considered stuck, see StuckExecutableDetector.
+		ReportProgress()
+		// LastProgressTime returns when the running attempt started or last reported progress, or the zero time if
+		// the executable is not executing.
+		LastProgressTime() time.Time
 	}
 
+	// ClockWatermark returns the hybrid logical clock up to which the shard has applied its updates.
+	ClockWatermark func() *hlc.Clock
+
+	// ShardReloading returns true if the shard owning the executable is reloading its tasks.
+	ShardReloading func() bool
+
+	// ReplicationLagSignal returns how far this cluster is behind in replicating from the
+	// active cluster of the given namespace.
+	ReplicationLagSignal func(namespaceID namespace.ID) time.Duration
+
 	Executor interface {
 		// TODO: remove isActive return value after deprecating
 		// active/standby queue processing logic
@@ -91,34 +154,85 @@ const (
 	// resourceExhaustedResubmitMaxAttempts is the same as resubmitMaxAttempts but only applies to resource
 	// exhausted error
 	resourceExhaustedResubmitMaxAttempts = 1
+	// replicationLagRescheduleMaxInterval caps the reschedule backoff derived from replication lag
+	replicationLagRescheduleMaxInterval = 10 * time.Minute
 	// taskCriticalLogMetricAttempts, if exceeded, task attempts metrics and critical processing error log will be emitted
 	// while task is retrying
 	taskCriticalLogMetricAttempts = 30
 )
 
+const (
+	attemptSpanName = "queues.ExecuteAttempt"
+
+	attemptAttributeCategory        = attribute.Key("temporal.task.category")
+	attemptAttributeType            = attribute.Key("temporal.task.type")
+	attemptAttributeTaskID          = attribute.Key("temporal.task.id")
+	attemptAttributeAttempt         = attribute.Key("temporal.task.attempt")
+	attemptAttributeLifetimeAttempt = attribute.Key("temporal.task.lifetime_attempt")
+	attemptAttributePriority        = attribute.Key("temporal.task.priority")
+	attemptAttributeOutcome         = attribute.Key("temporal.task.outcome")
+
+	// attemptOutcomeSuccess means the executor completed the task.
+	attemptOutcomeSuccess = "success"
+	// attemptOutcomeDropped means the attempt failed with a benign error, e.g. a version mismatch, and the task is
+	// dropped as if it completed.
+	attemptOutcomeDropped = "dropped"
+	// attemptOutcomeDeferred means the task made progress or waits for a condition, e.g. it yielded or was split,
+	// and is resubmitted without counting as a failed attempt.
+	attemptOutcomeDeferred = "deferred"
+	// attemptOutcomeFailed means the attempt failed and the task is retried.
+	attemptOutcomeFailed = "failed"
+)
+
 type (
 	executableImpl struct {
 		tasks.Task
 
 		sync.Mutex
-		state          ctasks.State
-		priority       ctasks.Priority // priority for the current attempt
-		lowestPriority ctasks.Priority // priority for emitting metrics across multiple attempts
-		attempt        int
-
-		executor          Executor
-		scheduler         Scheduler
-		rescheduler       Rescheduler
-		priorityAssigner  PriorityAssigner
-		timeSource        clock.TimeSource
-		namespaceRegistry namespace.Registry
-		clusterMetadata   cluster.Metadata
-		logger            log.Logger
-		metricsHandler    metrics.Handler
+		state           ctasks.State
+		priority        ctasks.Priority // priority for the current attempt
+		lowestPriority  ctasks.Priority // priority for emitting metrics across multiple attempts
+		attempt         int
+		lifetimeAttempt int
+		cursor          interface{}
+		parent          *executableImpl   // set if this executable was created by Split
+		children        []*executableImpl // created by the last Split
+		pendingChildren int               // children not acked yet
+		minClock        *hlc.Clock
+		clockWatermark  ClockWatermark
+		sequencer       *ExecutableSequencer
+		shardReloading  ShardReloading
+		executing       bool
+		lastProgress    time.Time
+		tracer          trace.Tracer
+		attemptSpan     trace.Span        // span of the running attempt, until HandleErr ends it
+		lastSpanContext trace.SpanContext // span context of the previous attempt, linked from the next one
+		parentSpan      trace.SpanContext // span context of the attempt that split the parent executable
+		circuitBreaker  CircuitBreaker
+		circuitOpenFor  time.Duration // how long the circuit breaker stays open, when the last attempt was rejected
+		dlq             DLQ
+		dlqMaxAttempts  dynamicconfig.IntPropertyFn
+		dlqFailures     int   // failed attempts counting towards dlqMaxAttempts
+		dlqLastErr      error // error of the attempt which reached dlqMaxAttempts
+		attemptTimeout  dynamicconfig.DurationPropertyFn
+
+		executor             Executor
+		scheduler            Scheduler
+		rescheduler          Rescheduler
+		priorityAssigner     PriorityAssigner
+		timeSource           clock.TimeSource
+		namespaceRegistry    namespace.Registry
+		clusterMetadata      cluster.Metadata
+		replicationLagSignal ReplicationLagSignal
+		errorLogSampler      ErrorLogSampler
+		baseLogger           log.Logger // without the tags of this task, for creating child executables
+		logger               log.Logger
+		metricsHandler       metrics.Handler
 
 		readerID                     int64
 		loadTime                     time.Time
 		scheduledTime                time.Time
+		stateEnterTime               time.Time // when the task entered its current state, or was last nacked
 		scheduleLatency              time.Duration
 		attemptNoUserLatency         time.Duration
 		inMemoryNoUserLatency        time.Duration
@@ -138,22 +252,29 @@ func NewExecutable(
 	timeSource clock.TimeSource,
 	namespaceRegistry namespace.Registry,
 	clusterMetadata cluster.Metadata,
+	replicationLagSignal ReplicationLagSignal,
+	errorLogSampler ErrorLogSampler,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
 ) Executable {
 	executable := &executableImpl{
-		Task:              task,
-		state:             ctasks.TaskStatePending,
-		attempt:           1,
-		executor:          executor,
-		scheduler:         scheduler,
-		rescheduler:       rescheduler,
-		priorityAssigner:  priorityAssigner,
-		timeSource:        timeSource,
-		namespaceRegistry: namespaceRegistry,
-		clusterMetadata:   clusterMetadata,
-		readerID:          readerID,
-		loadTime:          util.MaxTime(timeSource.Now(), task.GetKey().FireTime),
+		Task:                 task,
+		state:                ctasks.TaskStatePending,
+		attempt:              1,
+		lifetimeAttempt:      1,
+		executor:             executor,
+		scheduler:            scheduler,
+		rescheduler:          rescheduler,
+		priorityAssigner:     priorityAssigner,
+		timeSource:           timeSource,
+		namespaceRegistry:    namespaceRegistry,
+		clusterMetadata:      clusterMetadata,
+		replicationLagSignal: replicationLagSignal,
+		errorLogSampler:      errorLogSampler,
+		readerID:             readerID,
+		loadTime:             util.MaxTime(timeSource.Now(), task.GetKey().FireTime),
+		stateEnterTime:       timeSource.Now(),
+		baseLogger:           logger,
 		logger: log.NewLazyLogger(
 			logger,
 			func() []tag.Tag {
@@ -177,6 +298,24 @@ func (e *executableImpl) Execute() (retErr error) {
 		e.Unlock()
 		return nil
 	}
+	if !e.minClockReachedLocked() {
+		e.Unlock()
+		return consts.ErrClockNotReached
+	}
+	if e.sequencer != nil && !e.sequencer.isNext(e) {
+		e.Unlock()
+		return consts.ErrPrecedingTaskNotCompleted
+	}
+	circuitBreaker := e.circuitBreaker
+	if circuitBreaker != nil {
+		if allowed, openFor := circuitBreaker.Allow(e.GetType()); !allowed {
+			e.circuitOpenFor = openFor
+			e.Unlock()
+			return consts.ErrCircuitBreakerOpen
+		}
+	}
+	e.executing = true
+	e.lastProgress = startTime
 
 	ns, _ := e.namespaceRegistry.GetNamespaceName(namespace.ID(e.GetNamespaceID()))
 	var callerInfo headers.CallerInfo
@@ -191,9 +330,29 @@ func (e *executableImpl) Execute() (retErr error) {
 		metrics.AddMetricsContext(context.Background()),
 		callerInfo,
 	)
+	ctx = ctasks.WithAttemptInfo(ctx, ctasks.AttemptInfo{
+		Attempt:         e.attempt,
+		LifetimeAttempt: e.lifetimeAttempt,
+		TaskID:          e.GetTaskID(),
+	})
+	ctx = e.startAttemptSpanLocked(ctx)
+	var timeout time.Duration
+	if e.attemptTimeout != nil {
+		timeout = e.attemptTimeout()
+	}
 	e.Unlock()
 
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
 	defer func() {
+		e.Lock()
+		e.executing = false
+		e.Unlock()
+
 		if panicObj := recover(); panicObj != nil {
 			err, ok := panicObj.(error)
 			if !ok {
@@ -217,15 +376,33 @@ func (e *executableImpl) Execute() (retErr error) {
 		e.attemptNoUserLatency = attemptLatency - attemptUserLatency
 		// emit total attempt latency so that we know how much time a task will occpy a worker goroutine
 		e.taggedMetricsHandler.Timer(metrics.TaskProcessingLatency.GetMetricName()).Record(attemptLatency)
+		// split it so that slowness in our code can be told apart from user attributable waits
+		e.taggedMetricsHandler.Timer(metrics.TaskProcessingUserLatency.GetMetricName()).Record(attemptUserLatency)
+		e.taggedMetricsHandler.Timer(metrics.TaskProcessingNoUserLatency.GetMetricName()).Record(e.attemptNoUserLatency)
 
 		priorityTaggedProvider := e.taggedMetricsHandler.WithTags(metrics.TaskPriorityTag(e.priority.String()))
 		priorityTaggedProvider.Counter(metrics.TaskRequests.GetMetricName()).Record(1)
 		priorityTaggedProvider.Timer(metrics.TaskScheduleLatency.GetMetricName()).Record(e.scheduleLatency)
+
+		if retErr == nil {
+			// HandleErr is only invoked on errors
+			e.endAttemptSpan(nil, attemptOutcomeSuccess)
+			if circuitBreaker != nil {
+				circuitBreaker.RecordSuccess(e.GetType())
+			}
+		}
 	}()
 
 	metricsTags, isActive, err := e.executor.Execute(ctx, e)
 	e.taggedMetricsHandler = e.metricsHandler.WithTags(metricsTags...)
 
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		// the error is likely caused by the deadline of the attempt, but log the original one in case it isn't
+		e.taggedMetricsHandler.Counter(metrics.TaskAttemptTimeout.GetMetricName()).Record(1)
+		e.logger.Warn("Task attempt exceeded its deadline", tag.Timeout(timeout.String()), tag.Error(err))
+		err = consts.ErrTaskAttemptTimeout
+	}
+
 	if isActive != e.lastActiveness {
 		// namespace did a failover,
 		// reset task attempt since the execution logic used will change
@@ -243,14 +420,38 @@ func (e *executableImpl) HandleErr(err error) (retErr error) {
 			e.inMemoryNoUserLatency += e.scheduleLatency + e.attemptNoUserLatency
 		}
 
-		if retErr != nil {
+		deferred := errors.Is(retErr, consts.ErrTaskYield) ||
+			errors.Is(retErr, consts.ErrTaskSplit) ||
+			errors.Is(retErr, consts.ErrClockNotReached) ||
+			errors.Is(retErr, consts.ErrPrecedingTaskNotCompleted) ||
+			errors.Is(retErr, consts.ErrCircuitBreakerOpen)
+		switch {
+		case err == nil:
+			e.endAttemptSpan(nil, attemptOutcomeSuccess)
+		case retErr == nil:
+			e.endAttemptSpan(err, attemptOutcomeDropped)
+		case deferred:
+			e.endAttemptSpan(err, attemptOutcomeDeferred)
+		default:
+			e.endAttemptSpan(err, attemptOutcomeFailed)
+		}
+
+		if retErr != nil && !deferred {
 			e.Lock()
 			defer e.Unlock()
 
 			e.attempt++
+			e.lifetimeAttempt++
 			if e.attempt > taskCriticalLogMetricAttempts {
 				e.taggedMetricsHandler.Histogram(metrics.TaskAttempt.GetMetricName(), metrics.TaskAttempt.GetMetricUnit()).Record(int64(e.attempt))
-				e.logger.Error("Critical error processing task, retrying.", tag.Attempt(int32(e.attempt)), tag.Error(err), tag.OperationCritical)
+				if e.shouldLogError(err) {
+					e.logger.Error("Critical error processing task, retrying.",
+						tag.Attempt(int32(e.attempt)),
+						tag.LifetimeAttempt(int32(e.lifetimeAttempt)),
+						tag.Error(err),
+						tag.OperationCritical,
+					)
+				}
 			}
 		}
 	}()
@@ -271,12 +472,48 @@ func (e *executableImpl) HandleErr(err error) (retErr error) {
 	}
 	e.systemResourceExhaustedCount = 0
 
-	if _, isNotFound := err.(*serviceerror.NotFound); isNotFound {
+	if errors.Is(err, consts.ErrTaskYield) {
+		// task made progress, resubmit it without counting as a failed attempt
+		e.taggedMetricsHandler.Counter(metrics.TaskYielded.GetMetricName()).Record(1)
+		return err
+	}
+
+	if errors.Is(err, consts.ErrTaskSplit) {
+		// the children are submitted when the task is nacked with this error
+		e.taggedMetricsHandler.Counter(metrics.TaskSplit.GetMetricName()).Record(1)
+		return err
+	}
+
+	if errors.Is(err, consts.ErrClockNotReached) {
+		// task was deferred without being executed, don't count it as a failed attempt
+		e.taggedMetricsHandler.Counter(metrics.TaskClockNotReached.GetMetricName()).Record(1)
+		return err
+	}
+
+	if errors.Is(err, consts.ErrPrecedingTaskNotCompleted) {
+		// same as above, the task waits for its turn in the sequencer
+		e.taggedMetricsHandler.Counter(metrics.TaskPrecedingTaskNotCompleted.GetMetricName()).Record(1)
+		return err
+	}
+
+	if errors.Is(err, consts.ErrCircuitBreakerOpen) {
+		// the task was held back without being executed, don't count it as a failed attempt
+		e.taggedMetricsHandler.Counter(metrics.TaskCircuitBreakerOpen.GetMetricName()).Record(1)
+		return err
+	}
+
+	// The errors below are benign and the task is dropped, but err may wrap additional context about
+	// what was not found, so log the full error chain to help debugging.
+	var notFoundErr *serviceerror.NotFound
+	if errors.As(err, &notFoundErr) {
+		e.logger.Debug("Drop task due to entity not found", tag.Error(err))
 		return nil
 	}
 
 	// This means that namespace is deleted, and it is safe to drop the task (=ignore the error).
-	if _, isNotFound := err.(*serviceerror.NamespaceNotFound); isNotFound {
+	var namespaceNotFoundErr *serviceerror.NamespaceNotFound
+	if errors.As(err, &namespaceNotFoundErr) {
+		e.logger.Debug("Drop task due to namespace not found", tag.Error(err))
 		return nil
 	}
 
@@ -295,13 +532,15 @@ func (e *executableImpl) HandleErr(err error) (retErr error) {
 		return err
 	}
 
-	if err == consts.ErrTaskDiscarded {
+	if errors.Is(err, consts.ErrTaskDiscarded) {
 		e.taggedMetricsHandler.Counter(metrics.TaskDiscarded.GetMetricName()).Record(1)
+		e.logger.Debug("Drop task due to task discarded", tag.Error(err))
 		return nil
 	}
 
-	if err == consts.ErrTaskVersionMismatch {
+	if errors.Is(err, consts.ErrTaskVersionMismatch) {
 		e.taggedMetricsHandler.Counter(metrics.TaskVersionMisMatch.GetMetricName()).Record(1)
+		e.logger.Debug("Drop task due to task version mismatch", tag.Error(err))
 		return nil
 	}
 
@@ -329,11 +568,81 @@ func (e *executableImpl) HandleErr(err error) (retErr error) {
 	}
 
 	e.taggedMetricsHandler.Counter(metrics.TaskFailures.GetMetricName()).Record(1)
+	if isDestinationError(err) {
+		e.recordCircuitBreakerFailure()
+	}
 
-	e.logger.Error("Fail to process task", tag.Error(err), tag.LifeCycleProcessingFailed)
+	if e.countDLQFailure(err) {
+		// Nack hands the executable over to the DLQ
+		return consts.ErrTaskMoveToDLQ
+	}
+
+	if e.shouldLogError(err) {
+		e.logger.Error("Fail to process task", tag.Error(err), tag.LifeCycleProcessingFailed)
+	}
 	return err
 }
 
+// recordCircuitBreakerFailure reports a failed attempt to the circuit breaker, if any.
+func (e *executableImpl) recordCircuitBreakerFailure() {
+	e.Lock()
+	circuitBreaker := e.circuitBreaker
+	e.Unlock()
+
+	if circuitBreaker != nil {
+		circuitBreaker.RecordFailure(e.GetType())
+	}
+}
+
+// isDestinationError returns whether err may come from the destination the task is processed against, as opposed to
+// e.g. the shard losing its ownership or shutting down, which must not open the circuit breaker of the task type.
+func isDestinationError(err error) bool {
+	var ownershipLostErr *persistence.ShardOwnershipLostError
+	return !shard.IsShardOwnershipLostError(err) &&
+		!errors.As(err, &ownershipLostErr) &&
+		!common.IsContextCanceledErr(err)
+}
+
+// countDLQFailure counts a failed attempt towards moving the executable to its DLQ, unless err is expected to resolve
+// by itself, and returns true once the executable reached the max number of failed attempts.
+func (e *executableImpl) countDLQFailure(err error) bool {
+	e.Lock()
+	defer e.Unlock()
+
+	if e.dlq == nil || e.dlqMaxAttempts == nil || isTransientTaskError(err) {
+		return false
+	}
+	e.dlqFailures++
+	if limit := e.dlqMaxAttempts(); limit <= 0 || e.dlqFailures < limit {
+		return false
+	}
+	e.dlqLastErr = err
+	return true
+}
+
+// isTransientTaskError returns whether err is expected to resolve by itself when the task is retried.
+func isTransientTaskError(err error) bool {
+	if shard.IsShardOwnershipLostError(err) ||
+		errors.Is(err, consts.ErrTaskAttemptTimeout) ||
+		common.IsContextDeadlineExceededErr(err) ||
+		common.IsContextCanceledErr(err) {
+		return true
+	}
+
+	var unavailableErr *serviceerror.Unavailable
+	var resourceExhaustedErr *serviceerror.ResourceExhausted
+	var timeoutErr *persistence.TimeoutError
+	return errors.As(err, &unavailableErr) ||
+		errors.As(err, &resourceExhaustedErr) ||
+		errors.As(err, &timeoutErr)
+}
+
+// shouldLogError samples the logs of repetitive errors, see ErrorLogSampler. All errors are logged if no sampler is
+// configured.
+func (e *executableImpl) shouldLogError(err error) bool {
+	return e.errorLogSampler == nil || e.errorLogSampler.ShouldLog(err)
+}
+
 func (e *executableImpl) IsRetryableError(err error) bool {
 	// this determines if the executable should be retried when hold the worker goroutine
 	//
@@ -350,49 +659,129 @@ func (e *executableImpl) RetryPolicy() backoff.RetryPolicy {
 }
 
 func (e *executableImpl) Abort() {
+	if e.transitionTo(ctasks.TaskStateAborted) {
+		e.leaveSequencer()
+		// a split task and its children can only complete together
+		for _, related := range e.relatedExecutables() {
+			related.Abort()
+		}
+	}
+}
+
+func (e *executableImpl) Cancel() {
+	if e.transitionTo(ctasks.TaskStateCancelled) {
+		e.leaveSequencer()
+		for _, related := range e.relatedExecutables() {
+			related.Cancel()
+		}
+	}
+}
+
+// transitionTo moves a pending executable to the given state and returns whether it did.
+func (e *executableImpl) transitionTo(state ctasks.State) bool {
 	e.Lock()
 	defer e.Unlock()
 
-	if e.state == ctasks.TaskStatePending {
-		e.state = ctasks.TaskStateAborted
+	if e.state != ctasks.TaskStatePending {
+		return false
 	}
+	e.recordStateTransitionLocked(state)
+	e.state = state
+	return true
 }
 
-func (e *executableImpl) Cancel() {
+// relatedExecutables returns the parent and children of a split task.
+func (e *executableImpl) relatedExecutables() []*executableImpl {
 	e.Lock()
 	defer e.Unlock()
 
-	if e.state == ctasks.TaskStatePending {
-		e.state = ctasks.TaskStateCancelled
+	related := append([]*executableImpl(nil), e.children...)
+	if e.parent != nil {
+		related = append(related, e.parent)
 	}
+	return related
 }
 
 func (e *executableImpl) Ack() {
+	if !e.ack() {
+		return
+	}
+	e.leaveSequencer()
+	if e.parent != nil {
+		e.parent.childAcked()
+	}
+}
+
+func (e *executableImpl) ack() bool {
 	e.Lock()
 	defer e.Unlock()
 
 	if e.state != ctasks.TaskStatePending {
-		return
+		return false
 	}
 
+	e.recordStateTransitionLocked(ctasks.TaskStateAcked)
 	e.state = ctasks.TaskStateAcked
 
+	// taggedMetricsHandler carries the tags returned by the executor on the last attempt,
+	// so task types can attach their own dimensions to this counter.
+	e.taggedMetricsHandler.Counter(metrics.TaskCompleted.GetMetricName()).Record(1)
 	e.taggedMetricsHandler.Timer(metrics.TaskLoadLatency.GetMetricName()).Record(
 		e.loadTime.Sub(e.GetVisibilityTime()),
 		metrics.QueueReaderIDTag(e.readerID),
 	)
 	e.taggedMetricsHandler.Histogram(metrics.TaskAttempt.GetMetricName(), metrics.TaskAttempt.GetMetricUnit()).Record(int64(e.attempt))
+	e.taggedMetricsHandler.Histogram(metrics.TaskLifetimeAttempt.GetMetricName(), metrics.TaskLifetimeAttempt.GetMetricUnit()).Record(int64(e.lifetimeAttempt))
 
 	priorityTaggedProvider := e.taggedMetricsHandler.WithTags(metrics.TaskPriorityTag(e.lowestPriority.String()))
 	priorityTaggedProvider.Timer(metrics.TaskLatency.GetMetricName()).Record(e.inMemoryNoUserLatency)
 
 	readerIDTaggedProvider := priorityTaggedProvider.WithTags(metrics.QueueReaderIDTag(e.readerID))
 	readerIDTaggedProvider.Timer(metrics.TaskQueueLatency.GetMetricName()).Record(time.Since(e.GetVisibilityTime()))
+	return true
+}
+
+func (e *executableImpl) childAcked() {
+	e.Lock()
+	e.pendingChildren--
+	done := e.pendingChildren == 0
+	e.Unlock()
+
+	if done {
+		e.Ack()
+	}
 }
 
 func (e *executableImpl) Nack(err error) {
-	state := e.State()
-	if state != ctasks.TaskStatePending {
+	e.Lock()
+	if e.state != ctasks.TaskStatePending {
+		e.Unlock()
+		return
+	}
+	// the task stays pending, but record the time spent on this attempt
+	e.recordStateTransitionLocked(ctasks.TaskStateNacked)
+	children := e.children
+	dlq := e.dlq
+	dlqLastErr := e.dlqLastErr
+	e.Unlock()
+
+	if errors.Is(err, consts.ErrTaskMoveToDLQ) {
+		// the task stays pending until the DLQ persisted it and acks it, or reschedules it if that fails
+		if dlq.Enqueue(e, dlqLastErr) {
+			return
+		}
+		// the DLQ is busy, retry the task as usual in the meantime
+		err = dlqLastErr
+	}
+
+	if errors.Is(err, consts.ErrTaskSplit) {
+		// the task stays pending until all of its children are acked, see childAcked
+		for _, child := range children {
+			child.SetScheduledTime(e.timeSource.Now())
+			if !e.scheduler.TrySubmit(child) {
+				e.rescheduler.Add(child, e.timeSource.Now())
+			}
+		}
 		return
 	}
 
@@ -407,6 +796,13 @@ func (e *executableImpl) Nack(err error) {
 		submitted = e.scheduler.TrySubmit(e)
 	}
 
+	if !submitted && e.isShardReloading() {
+		// the task will be loaded again by the shard reload, rescheduling it would only
+		// result in a duplicate submission
+		e.logger.Debug("Dropped nacked task for shard reload")
+		return
+	}
+
 	if !submitted {
 		backoffDuration := e.backoffDuration(err, e.Attempt())
 		e.rescheduler.Add(e, e.timeSource.Now().Add(backoffDuration))
@@ -427,6 +823,18 @@ func (e *executableImpl) Reschedule() {
 	e.rescheduler.Add(e, e.timeSource.Now().Add(e.backoffDuration(nil, e.Attempt())))
 }
 
+// recordStateTransitionLocked emits the time spent in the current state and restarts the state timer.
+// e.Lock() must be held before calling.
+func (e *executableImpl) recordStateTransitionLocked(next ctasks.State) {
+	now := e.timeSource.Now()
+	e.taggedMetricsHandler.Timer(metrics.TaskStateDuration.GetMetricName()).Record(
+		now.Sub(e.stateEnterTime),
+		metrics.TaskStateTag(e.state.String()),
+		metrics.TaskNextStateTag(next.String()),
+	)
+	e.stateEnterTime = now
+}
+
 func (e *executableImpl) State() ctasks.State {
 	e.Lock()
 	defer e.Unlock()
@@ -448,6 +856,226 @@ func (e *executableImpl) Attempt() int {
 	return e.attempt
 }
 
+func (e *executableImpl) LifetimeAttempt() int {
+	e.Lock()
+	defer e.Unlock()
+
+	return e.lifetimeAttempt
+}
+
+func (e *executableImpl) Yield(cursor interface{}) error {
+	e.Lock()
+	defer e.Unlock()
+
+	e.cursor = cursor
+	return consts.ErrTaskYield
+}
+
+func (e *executableImpl) Cursor() interface{} {
+	e.Lock()
+	defer e.Unlock()
+
+	return e.cursor
+}
+
+func (e *executableImpl) Split(children []tasks.Task) error {
+	if len(children) == 0 {
+		return nil
+	}
+
+	e.Lock()
+	tracer := e.tracer
+	parentSpan := e.lastSpanContext
+	circuitBreaker := e.circuitBreaker
+	dlq := e.dlq
+	dlqMaxAttempts := e.dlqMaxAttempts
+	attemptTimeout := e.attemptTimeout
+	e.Unlock()
+
+	executables := make([]*executableImpl, 0, len(children))
+	for _, task := range children {
+		child := NewExecutable(
+			e.readerID,
+			task,
+			e.executor,
+			e.scheduler,
+			e.rescheduler,
+			e.priorityAssigner,
+			e.timeSource,
+			e.namespaceRegistry,
+			e.clusterMetadata,
+			e.replicationLagSignal,
+			e.errorLogSampler,
+			e.baseLogger,
+			e.metricsHandler,
+		).(*executableImpl)
+		child.parent = e
+		child.tracer = tracer
+		child.parentSpan = parentSpan
+		child.circuitBreaker = circuitBreaker
+		child.dlq = dlq
+		child.dlqMaxAttempts = dlqMaxAttempts
+		child.attemptTimeout = attemptTimeout
+		executables = append(executables, child)
+	}
+
+	e.Lock()
+	defer e.Unlock()
+
+	e.children = executables
+	e.pendingChildren = len(executables)
+	return consts.ErrTaskSplit
+}
+
+func (e *executableImpl) SetMinClock(minClock *hlc.Clock, watermark ClockWatermark) {
+	e.Lock()
+	defer e.Unlock()
+
+	e.minClock = minClock
+	e.clockWatermark = watermark
+}
+
+func (e *executableImpl) SetSequencer(sequencer *ExecutableSequencer) {
+	e.Lock()
+	e.sequencer = sequencer
+	e.Unlock()
+
+	sequencer.add(e)
+}
+
+func (e *executableImpl) SetShardReloading(reloading ShardReloading) {
+	e.Lock()
+	defer e.Unlock()
+
+	e.shardReloading = reloading
+}
+
+func (e *executableImpl) SetTracer(tracer trace.Tracer) {
+	e.Lock()
+	defer e.Unlock()
+
+	e.tracer = tracer
+}
+
+func (e *executableImpl) SetCircuitBreaker(breaker CircuitBreaker) {
+	e.Lock()
+	defer e.Unlock()
+
+	e.circuitBreaker = breaker
+}
+
+func (e *executableImpl) SetDLQ(dlq DLQ, maxAttempts dynamicconfig.IntPropertyFn) {
+	e.Lock()
+	defer e.Unlock()
+
+	e.dlq = dlq
+	e.dlqMaxAttempts = maxAttempts
+}
+
+func (e *executableImpl) SetAttemptTimeout(timeout dynamicconfig.DurationPropertyFn) {
+	e.Lock()
+	defer e.Unlock()
+
+	e.attemptTimeout = timeout
+}
+
+// startAttemptSpanLocked starts the span of a new attempt if a tracer is set, and returns ctx with that span.
+// e.Lock() must be held before calling.
+func (e *executableImpl) startAttemptSpanLocked(ctx context.Context) context.Context {
+	if e.tracer == nil {
+		return ctx
+	}
+	if e.parentSpan.IsValid() {
+		ctx = trace.ContextWithSpanContext(ctx, e.parentSpan)
+	}
+	category := e.GetCategory()
+	opts := []trace.SpanStartOption{
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(
+			attemptAttributeCategory.String(category.Name()),
+			attemptAttributeType.String(e.GetType().String()),
+			attemptAttributeTaskID.Int64(e.GetTaskID()),
+			attemptAttributeAttempt.Int(e.attempt),
+			attemptAttributeLifetimeAttempt.Int(e.lifetimeAttempt),
+			attemptAttributePriority.String(e.priority.String()),
+		),
+	}
+	if e.lastSpanContext.IsValid() {
+		// retries are linked rather than nested, so that each attempt keeps the parent of the task
+		opts = append(opts, trace.WithLinks(trace.Link{SpanContext: e.lastSpanContext}))
+	}
+	ctx, e.attemptSpan = e.tracer.Start(ctx, attemptSpanName, opts...)
+	e.lastSpanContext = e.attemptSpan.SpanContext()
+	return ctx
+}
+
+// endAttemptSpan annotates the span of the running attempt with its outcome and ends it. It's a noop if there is no
+// such span, e.g. tracing is disabled or the span was already ended.
+func (e *executableImpl) endAttemptSpan(err error, outcome string) {
+	e.Lock()
+	span := e.attemptSpan
+	e.attemptSpan = nil
+	e.Unlock()
+
+	if span == nil {
+		return
+	}
+	span.SetAttributes(attemptAttributeOutcome.String(outcome))
+	if err != nil {
+		span.RecordError(err)
+	}
+	if outcome == attemptOutcomeFailed {
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func (e *executableImpl) ReportProgress() {
+	e.Lock()
+	defer e.Unlock()
+
+	if e.executing {
+		e.lastProgress = e.timeSource.Now()
+	}
+}
+
+func (e *executableImpl) LastProgressTime() time.Time {
+	e.Lock()
+	defer e.Unlock()
+
+	if !e.executing {
+		return time.Time{}
+	}
+	return e.lastProgress
+}
+
+// leaveSequencer lets the next executable of the same workflow execute once this one is completed.
+func (e *executableImpl) leaveSequencer() {
+	e.Lock()
+	sequencer := e.sequencer
+	e.Unlock()
+
+	if sequencer != nil {
+		sequencer.remove(e)
+	}
+}
+
+func (e *executableImpl) minClockReachedLocked() bool {
+	if e.minClock == nil {
+		return true
+	}
+	watermark := e.clockWatermark()
+	return watermark != nil && !hlc.Less(*watermark, *e.minClock)
+}
+
+func (e *executableImpl) isShardReloading() bool {
+	e.Lock()
+	reloading := e.shardReloading
+	e.Unlock()
+
+	return reloading != nil && reloading()
+}
+
 func (e *executableImpl) GetTask() tasks.Task {
 	return e.Task
 }
@@ -484,6 +1112,10 @@ func (e *executableImpl) shouldResubmitOnNack(attempt int, err error) bool {
 
 	return err != consts.ErrTaskRetry &&
 		err != consts.ErrDependencyTaskNotCompleted &&
+		err != consts.ErrClockNotReached &&
+		err != consts.ErrPrecedingTaskNotCompleted &&
+		err != consts.ErrCircuitBreakerOpen &&
+		err != consts.ErrTaskAttemptTimeout &&
 		err != consts.ErrNamespaceHandover
 }
 
@@ -494,18 +1126,35 @@ func (e *executableImpl) backoffDuration(
 	// elapsedTime, the first parameter in ComputeNextDelay is not relevant here
 	// since reschedule policy has no expiration interval.
 
-	if err == consts.ErrTaskRetry ||
-		err == consts.ErrNamespaceHandover ||
+	if err == consts.ErrTaskRetry {
+		// standby task is waiting for events from the active cluster, retrying before
+		// replication catches up won't help, so wait for at least the observed lag.
+		return util.Max(
+			taskNotReadyReschedulePolicy.ComputeNextDelay(0, attempt),
+			e.replicationLag(),
+		)
+	}
+
+	if err == consts.ErrNamespaceHandover ||
 		common.IsInternalError(err) {
 		// using a different reschedule policy to slow down retry
 		// as immediate retry typically won't resolve the issue.
 		return taskNotReadyReschedulePolicy.ComputeNextDelay(0, attempt)
 	}
 
-	if err == consts.ErrDependencyTaskNotCompleted {
+	if err == consts.ErrDependencyTaskNotCompleted ||
+		err == consts.ErrClockNotReached ||
+		err == consts.ErrPrecedingTaskNotCompleted {
 		return dependencyTaskNotCompletedReschedulePolicy.ComputeNextDelay(0, attempt)
 	}
 
+	if err == consts.ErrCircuitBreakerOpen {
+		// retrying before the breaker lets the next probe through would only be rejected again
+		e.Lock()
+		defer e.Unlock()
+		return e.circuitOpenFor
+	}
+
 	backoffDuration := reschedulePolicy.ComputeNextDelay(0, attempt)
 	if !errors.Is(err, consts.ErrResourceExhaustedBusyWorkflow) && common.IsResourceExhausted(err) {
 		// try a different reschedule policy to slow down retry
@@ -519,6 +1168,17 @@ func (e *executableImpl) backoffDuration(
 	return backoffDuration
 }
 
+func (e *executableImpl) replicationLag() time.Duration {
+	if e.replicationLagSignal == nil {
+		return 0
+	}
+	lag := e.replicationLagSignal(namespace.ID(e.GetNamespaceID()))
+	if lag < 0 {
+		return 0
+	}
+	return util.Min(lag, replicationLagRescheduleMaxInterval)
+}
+
 func (e *executableImpl) updatePriority() {
 	// do NOT invoke Assign while holding the lock
 	newPriority := e.priorityAssigner.Assign(e)
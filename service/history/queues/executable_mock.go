@@ -34,8 +34,11 @@ import (
 	time "time"
 
 	gomock "github.com/golang/mock/gomock"
+	trace "go.opentelemetry.io/otel/trace"
+	v10 "go.temporal.io/server/api/clock/v1"
 	v1 "go.temporal.io/server/api/enums/v1"
 	backoff "go.temporal.io/server/common/backoff"
+	dynamicconfig "go.temporal.io/server/common/dynamicconfig"
 	metrics "go.temporal.io/server/common/metrics"
 	tasks "go.temporal.io/server/common/tasks"
 	tasks0 "go.temporal.io/server/service/history/tasks"
@@ -114,6 +117,20 @@ func (mr *MockExecutableMockRecorder) Cancel() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockExecutable)(nil).Cancel))
 }
 
+// Cursor mocks base method.
+func (m *MockExecutable) Cursor() interface{} {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cursor")
+	ret0, _ := ret[0].(interface{})
+	return ret0
+}
+
+// Cursor indicates an expected call of Cursor.
+func (mr *MockExecutableMockRecorder) Cursor() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cursor", reflect.TypeOf((*MockExecutable)(nil).Cursor))
+}
+
 // Execute mocks base method.
 func (m *MockExecutable) Execute() error {
 	m.ctrl.T.Helper()
@@ -324,6 +341,34 @@ func (mr *MockExecutableMockRecorder) IsRetryableError(err interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRetryableError", reflect.TypeOf((*MockExecutable)(nil).IsRetryableError), err)
 }
 
+// LastProgressTime mocks base method.
+func (m *MockExecutable) LastProgressTime() time.Time {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastProgressTime")
+	ret0, _ := ret[0].(time.Time)
+	return ret0
+}
+
+// LastProgressTime indicates an expected call of LastProgressTime.
+func (mr *MockExecutableMockRecorder) LastProgressTime() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastProgressTime", reflect.TypeOf((*MockExecutable)(nil).LastProgressTime))
+}
+
+// LifetimeAttempt mocks base method.
+func (m *MockExecutable) LifetimeAttempt() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LifetimeAttempt")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// LifetimeAttempt indicates an expected call of LifetimeAttempt.
+func (mr *MockExecutableMockRecorder) LifetimeAttempt() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LifetimeAttempt", reflect.TypeOf((*MockExecutable)(nil).LifetimeAttempt))
+}
+
 // Nack mocks base method.
 func (m *MockExecutable) Nack(err error) {
 	m.ctrl.T.Helper()
@@ -336,6 +381,18 @@ func (mr *MockExecutableMockRecorder) Nack(err interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Nack", reflect.TypeOf((*MockExecutable)(nil).Nack), err)
 }
 
+// ReportProgress mocks base method.
+func (m *MockExecutable) ReportProgress() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReportProgress")
+}
+
+// ReportProgress indicates an expected call of ReportProgress.
+func (mr *MockExecutableMockRecorder) ReportProgress() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportProgress", reflect.TypeOf((*MockExecutable)(nil).ReportProgress))
+}
+
 // Reschedule mocks base method.
 func (m *MockExecutable) Reschedule() {
 	m.ctrl.T.Helper()
@@ -362,6 +419,78 @@ func (mr *MockExecutableMockRecorder) RetryPolicy() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetryPolicy", reflect.TypeOf((*MockExecutable)(nil).RetryPolicy))
 }
 
+// SetAttemptTimeout mocks base method.
+func (m *MockExecutable) SetAttemptTimeout(timeout dynamicconfig.DurationPropertyFn) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetAttemptTimeout", timeout)
+}
+
+// SetAttemptTimeout indicates an expected call of SetAttemptTimeout.
+func (mr *MockExecutableMockRecorder) SetAttemptTimeout(timeout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAttemptTimeout", reflect.TypeOf((*MockExecutable)(nil).SetAttemptTimeout), timeout)
+}
+
+// SetCircuitBreaker mocks base method.
+func (m *MockExecutable) SetCircuitBreaker(breaker CircuitBreaker) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetCircuitBreaker", breaker)
+}
+
+// SetCircuitBreaker indicates an expected call of SetCircuitBreaker.
+func (mr *MockExecutableMockRecorder) SetCircuitBreaker(breaker interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCircuitBreaker", reflect.TypeOf((*MockExecutable)(nil).SetCircuitBreaker), breaker)
+}
+
+// SetDLQ mocks base method.
+func (m *MockExecutable) SetDLQ(dlq DLQ, maxAttempts dynamicconfig.IntPropertyFn) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetDLQ", dlq, maxAttempts)
+}
+
+// SetDLQ indicates an expected call of SetDLQ.
+func (mr *MockExecutableMockRecorder) SetDLQ(dlq, maxAttempts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDLQ", reflect.TypeOf((*MockExecutable)(nil).SetDLQ), dlq, maxAttempts)
+}
+
+// SetMinClock mocks base method.
+func (m *MockExecutable) SetMinClock(minClock *v10.HybridLogicalClock, watermark ClockWatermark) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetMinClock", minClock, watermark)
+}
+
+// SetMinClock indicates an expected call of SetMinClock.
+func (mr *MockExecutableMockRecorder) SetMinClock(minClock, watermark interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMinClock", reflect.TypeOf((*MockExecutable)(nil).SetMinClock), minClock, watermark)
+}
+
+// SetShardReloading mocks base method.
+func (m *MockExecutable) SetShardReloading(reloading ShardReloading) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetShardReloading", reloading)
+}
+
+// SetShardReloading indicates an expected call of SetShardReloading.
+func (mr *MockExecutableMockRecorder) SetShardReloading(reloading interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetShardReloading", reflect.TypeOf((*MockExecutable)(nil).SetShardReloading), reloading)
+}
+
+// SetTracer mocks base method.
+func (m *MockExecutable) SetTracer(tracer trace.Tracer) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTracer", tracer)
+}
+
+// SetTracer indicates an expected call of SetTracer.
+func (mr *MockExecutableMockRecorder) SetTracer(tracer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTracer", reflect.TypeOf((*MockExecutable)(nil).SetTracer), tracer)
+}
+
 // SetScheduledTime mocks base method.
 func (m *MockExecutable) SetScheduledTime(arg0 time.Time) {
 	m.ctrl.T.Helper()
@@ -374,6 +503,18 @@ func (mr *MockExecutableMockRecorder) SetScheduledTime(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetScheduledTime", reflect.TypeOf((*MockExecutable)(nil).SetScheduledTime), arg0)
 }
 
+// SetSequencer mocks base method.
+func (m *MockExecutable) SetSequencer(sequencer *ExecutableSequencer) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetSequencer", sequencer)
+}
+
+// SetSequencer indicates an expected call of SetSequencer.
+func (mr *MockExecutableMockRecorder) SetSequencer(sequencer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSequencer", reflect.TypeOf((*MockExecutable)(nil).SetSequencer), sequencer)
+}
+
 // SetTaskID mocks base method.
 func (m *MockExecutable) SetTaskID(id int64) {
 	m.ctrl.T.Helper()
@@ -398,6 +539,20 @@ func (mr *MockExecutableMockRecorder) SetVisibilityTime(timestamp interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVisibilityTime", reflect.TypeOf((*MockExecutable)(nil).SetVisibilityTime), timestamp)
 }
 
+// Split mocks base method.
+func (m *MockExecutable) Split(children []tasks0.Task) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Split", children)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Split indicates an expected call of Split.
+func (mr *MockExecutableMockRecorder) Split(children interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Split", reflect.TypeOf((*MockExecutable)(nil).Split), children)
+}
+
 // State mocks base method.
 func (m *MockExecutable) State() tasks.State {
 	m.ctrl.T.Helper()
@@ -412,6 +567,20 @@ func (mr *MockExecutableMockRecorder) State() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "State", reflect.TypeOf((*MockExecutable)(nil).State))
 }
 
+// Yield mocks base method.
+func (m *MockExecutable) Yield(cursor interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Yield", cursor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Yield indicates an expected call of Yield.
+func (mr *MockExecutableMockRecorder) Yield(cursor interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Yield", reflect.TypeOf((*MockExecutable)(nil).Yield), cursor)
+}
+
 // MockExecutor is a mock of Executor interface.
 type MockExecutor struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,95 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queues
+
+import (
+	"sync"
+
+	"go.temporal.io/server/common/definition"
+)
+
+type (
+	// ExecutableSequencer makes executables of the same workflow execute one at a time, in the order of their task
+	// keys, i.e. the time they are scheduled for. Executables of different workflows are not affected and can still
+	// execute concurrently. See Executable.SetSequencer.
+	ExecutableSequencer struct {
+		sync.Mutex
+		pending map[definition.WorkflowKey][]*executableImpl // ordered by task key
+	}
+)
+
+func NewExecutableSequencer() *ExecutableSequencer {
+	return &ExecutableSequencer{
+		pending: make(map[definition.WorkflowKey][]*executableImpl),
+	}
+}
+
+func (s *ExecutableSequencer) add(e *executableImpl) {
+	s.Lock()
+	defer s.Unlock()
+
+	key := executableWorkflowKey(e)
+	pending := s.pending[key]
+	idx := len(pending)
+	for idx > 0 && pending[idx-1].GetKey().CompareTo(e.GetKey()) > 0 {
+		idx--
+	}
+	pending = append(pending, nil)
+	copy(pending[idx+1:], pending[idx:])
+	pending[idx] = e
+	s.pending[key] = pending
+}
+
+// isNext returns whether all the executables of the same workflow scheduled before e have completed.
+func (s *ExecutableSequencer) isNext(e *executableImpl) bool {
+	s.Lock()
+	defer s.Unlock()
+
+	pending := s.pending[executableWorkflowKey(e)]
+	return len(pending) == 0 || pending[0] == e
+}
+
+func (s *ExecutableSequencer) remove(e *executableImpl) {
+	s.Lock()
+	defer s.Unlock()
+
+	key := executableWorkflowKey(e)
+	pending := s.pending[key]
+	for idx, executable := range pending {
+		if executable == e {
+			pending = append(pending[:idx], pending[idx+1:]...)
+			break
+		}
+	}
+	if len(pending) == 0 {
+		delete(s.pending, key)
+		return
+	}
+	s.pending[key] = pending
+}
+
+func executableWorkflowKey(e *executableImpl) definition.WorkflowKey {
+	return definition.NewWorkflowKey(e.GetNamespaceID(), e.GetWorkflowID(), e.GetRunID())
+}
@@ -0,0 +1,93 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queues
+
+import (
+	"time"
+
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	ctasks "go.temporal.io/server/common/tasks"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+type (
+	// ExecutableSnapshot is the state of a loaded executable at the time a snapshot of its queue was taken.
+	ExecutableSnapshot struct {
+		WorkflowKey   definition.WorkflowKey
+		Category      tasks.Category
+		TaskKey       tasks.Key
+		Attempt       int
+		State         ctasks.State
+		Priority      ctasks.Priority
+		ScheduledTime time.Time
+	}
+
+	// ExecutableSnapshotSink receives the executables loaded by a queue, which are snapshotted every time the
+	// queue checkpoints, so that what was in flight can be analyzed after a shard crashes.
+	ExecutableSnapshotSink interface {
+		Write(category tasks.Category, snapshots []ExecutableSnapshot)
+	}
+
+	logExecutableSnapshotSink struct {
+		logger log.Logger
+	}
+)
+
+// NewLogExecutableSnapshotSink creates an ExecutableSnapshotSink which logs each snapshot.
+func NewLogExecutableSnapshotSink(
+	logger log.Logger,
+) ExecutableSnapshotSink {
+	return &logExecutableSnapshotSink{
+		logger: logger,
+	}
+}
+
+func (s *logExecutableSnapshotSink) Write(category tasks.Category, snapshots []ExecutableSnapshot) {
+	s.logger.Info("Loaded executables snapshot",
+		tag.TaskCategory(category.Name()),
+		tag.Counter(len(snapshots)),
+		tag.Value(snapshots),
+	)
+}
+
+func newExecutableSnapshot(executable Executable) ExecutableSnapshot {
+	return ExecutableSnapshot{
+		WorkflowKey:   definition.NewWorkflowKey(executable.GetNamespaceID(), executable.GetWorkflowID(), executable.GetRunID()),
+		Category:      executable.GetCategory(),
+		TaskKey:       executable.GetKey(),
+		Attempt:       executable.Attempt(),
+		State:         executable.State(),
+		Priority:      executable.GetPriority(),
+		ScheduledTime: executable.GetScheduledTime(),
+	}
+}
+
+// isExecutableInFlight returns whether the executable has not reached a terminal state yet.
+func isExecutableInFlight(executable Executable) bool {
+	state := executable.State()
+	return state == ctasks.TaskStatePending || state == ctasks.TaskStateNacked
+}
@@ -27,23 +27,35 @@ package queues
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 
 	"go.temporal.io/server/common/clock"
+	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	ctasks "go.temporal.io/server/common/tasks"
 	"go.temporal.io/server/service/history/consts"
@@ -63,7 +75,9 @@ type (
 		mockNamespaceRegistry *namespace.MockRegistry
 		mockClusterMetadata   *cluster.MockMetadata
 
-		timeSource *clock.EventTimeSource
+		timeSource      *clock.EventTimeSource
+		metricsHandler  metrics.Handler
+		errorLogSampler ErrorLogSampler
 	}
 )
 
@@ -87,6 +101,8 @@ func (s *executableSuite) SetupTest() {
 	s.mockClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
 
 	s.timeSource = clock.NewEventTimeSource()
+	s.metricsHandler = metrics.NoopMetricsHandler
+	s.errorLogSampler = nil
 }
 
 func (s *executableSuite) TearDownSuite() {
@@ -103,6 +119,27 @@ func (s *executableSuite) TestExecute_TaskExecuted() {
 	s.NoError(executable.Execute())
 }
 
+func (s *executableSuite) TestAck_CompletionCounterTaggedByExecutor() {
+	captureHandler := metricstest.NewCaptureHandler()
+	capture := captureHandler.StartCapture()
+	defer captureHandler.StopCapture(capture)
+	s.metricsHandler = captureHandler
+	executable := s.newTestExecutable()
+
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), executable).Return(
+		[]metrics.Tag{metrics.StringTag("custom_dimension", "custom_value")},
+		true,
+		nil,
+	)
+	s.NoError(executable.Execute())
+	executable.Ack()
+
+	recordings := capture.Snapshot()[metrics.TaskCompleted.GetMetricName()]
+	s.Len(recordings, 1)
+	s.Equal(int64(1), recordings[0].Value)
+	s.Equal("custom_value", recordings[0].Tags["custom_dimension"])
+}
+
 func (s *executableSuite) TestExecute_InMemoryNoUserLatency() {
 	executable := s.newTestExecutable()
 
@@ -158,6 +195,40 @@ func (s *executableSuite) TestExecute_InMemoryNoUserLatency() {
 	s.Equal(scheduleLatency+attemptLatency-userLatency, executable.(*executableImpl).inMemoryNoUserLatency)
 }
 
+func (s *executableSuite) TestExecute_UserAndSystemLatencyMetrics() {
+	captureHandler := metricstest.NewCaptureHandler()
+	capture := captureHandler.StartCapture()
+	defer captureHandler.StopCapture(capture)
+	s.metricsHandler = captureHandler
+	executable := s.newTestExecutable()
+
+	userLatency := 300 * time.Millisecond
+	attemptLatency := time.Second
+
+	s.timeSource.Update(time.Now())
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), executable).Do(func(ctx context.Context, taskInfo interface{}) {
+		metrics.ContextCounterAdd(
+			ctx,
+			metrics.HistoryWorkflowExecutionCacheLatency.GetMetricName(),
+			int64(userLatency),
+		)
+		s.timeSource.Update(s.timeSource.Now().Add(attemptLatency))
+	}).Return([]metrics.Tag{metrics.TaskTypeTag("TransferActiveTaskActivity")}, true, nil)
+	s.NoError(executable.Execute())
+
+	snapshot := capture.Snapshot()
+	for metricName, expected := range map[string]time.Duration{
+		metrics.TaskProcessingLatency.GetMetricName():       attemptLatency,
+		metrics.TaskProcessingUserLatency.GetMetricName():   userLatency,
+		metrics.TaskProcessingNoUserLatency.GetMetricName(): attemptLatency - userLatency,
+	} {
+		recordings := snapshot[metricName]
+		s.Len(recordings, 1, metricName)
+		s.Equal(expected, recordings[0].Value, metricName)
+		s.Equal("TransferActiveTaskActivity", recordings[0].Tags[metrics.TaskTypeTagName], metricName)
+	}
+}
+
 func (s *executableSuite) TestExecute_CapturePanic() {
 	executable := s.newTestExecutable()
 
@@ -191,6 +262,125 @@ func (s *executableSuite) TestExecute_CallerInfo() {
 	s.NoError(executable.Execute())
 }
 
+func (s *executableSuite) TestExecute_AttemptInfo() {
+	executable := s.newTestExecutable()
+
+	expectAttemptInfo := func() {
+		s.mockExecutor.EXPECT().Execute(gomock.Any(), executable).DoAndReturn(
+			func(ctx context.Context, _ Executable) ([]metrics.Tag, bool, error) {
+				info, ok := ctasks.GetAttemptInfo(ctx)
+				s.True(ok)
+				s.Equal(ctasks.AttemptInfo{
+					Attempt:         executable.Attempt(),
+					LifetimeAttempt: executable.LifetimeAttempt(),
+					TaskID:          executable.GetTaskID(),
+				}, info)
+				return nil, true, errors.New("some random error")
+			},
+		)
+	}
+
+	expectAttemptInfo()
+	err := executable.Execute()
+	s.Error(err)
+	s.Error(executable.HandleErr(err))
+	s.Equal(2, executable.Attempt())
+
+	// the next attempt carries the incremented attempt number
+	expectAttemptInfo()
+	s.Error(executable.Execute())
+}
+
+func (s *executableSuite) TestExecute_AttemptSpans() {
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+	spanAttributes := func(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+		attributes := make(map[attribute.Key]attribute.Value)
+		for _, kv := range span.Attributes() {
+			attributes[kv.Key] = kv.Value
+		}
+		return attributes
+	}
+
+	executable := s.newTestExecutable()
+	executable.SetTracer(tracer)
+	category := executable.GetCategory()
+
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), executable).Return(nil, true, errors.New("some random error"))
+	err := executable.Execute()
+	s.Error(executable.HandleErr(err))
+
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), executable).DoAndReturn(
+		func(ctx context.Context, _ Executable) ([]metrics.Tag, bool, error) {
+			// the executor runs within the span of the attempt
+			s.True(trace.SpanFromContext(ctx).SpanContext().IsValid())
+			return nil, true, consts.ErrTaskVersionMismatch
+		},
+	)
+	err = executable.Execute()
+	s.NoError(executable.HandleErr(err))
+
+	spans := recorder.Ended()
+	s.Len(spans, 2)
+
+	failed := spans[0]
+	s.Equal(attemptSpanName, failed.Name())
+	s.Equal(codes.Error, failed.Status().Code)
+	attributes := spanAttributes(failed)
+	s.Equal(category.Name(), attributes[attemptAttributeCategory].AsString())
+	s.Equal(executable.GetType().String(), attributes[attemptAttributeType].AsString())
+	s.Equal(executable.GetTaskID(), attributes[attemptAttributeTaskID].AsInt64())
+	s.Equal(int64(1), attributes[attemptAttributeAttempt].AsInt64())
+	s.Equal(int64(1), attributes[attemptAttributeLifetimeAttempt].AsInt64())
+	s.Equal(executable.GetPriority().String(), attributes[attemptAttributePriority].AsString())
+	s.Equal(attemptOutcomeFailed, attributes[attemptAttributeOutcome].AsString())
+
+	// the benign version mismatch drops the task without marking the span as failed
+	dropped := spans[1]
+	s.Equal(codes.Unset, dropped.Status().Code)
+	attributes = spanAttributes(dropped)
+	s.Equal(int64(2), attributes[attemptAttributeAttempt].AsInt64())
+	s.Equal(attemptOutcomeDropped, attributes[attemptAttributeOutcome].AsString())
+	s.Len(dropped.Events(), 1)
+	s.Contains(dropped.Events()[0].Attributes, attribute.String("exception.message", consts.ErrTaskVersionMismatch.Error()))
+	// retries are linked to the previous attempt
+	s.Len(dropped.Links(), 1)
+	s.Equal(failed.SpanContext(), dropped.Links()[0].SpanContext)
+}
+
+func (s *executableSuite) TestExecute_AttemptSpans_Split() {
+	recorder := tracetest.NewSpanRecorder()
+	tracer := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)).Tracer("test")
+
+	parent := s.newTestExecutable()
+	parent.SetTracer(tracer)
+	var child Executable
+	s.mockScheduler.EXPECT().TrySubmit(gomock.Any()).DoAndReturn(func(e Executable) bool {
+		child = e
+		return true
+	})
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, e Executable) ([]metrics.Tag, bool, error) {
+			if e == parent {
+				return nil, true, e.Split([]tasks.Task{parent.GetTask()})
+			}
+			return nil, true, nil
+		},
+	).Times(2)
+
+	err := parent.HandleErr(parent.Execute())
+	s.ErrorIs(err, consts.ErrTaskSplit)
+	parent.Nack(err)
+	s.NoError(child.Execute())
+
+	spans := recorder.Ended()
+	s.Len(spans, 2)
+	s.Contains(spans[0].Attributes(), attemptAttributeOutcome.String(attemptOutcomeDeferred))
+	s.Contains(spans[1].Attributes(), attemptAttributeOutcome.String(attemptOutcomeSuccess))
+	// the child attempt is parented to the attempt that split the parent
+	s.Equal(spans[0].SpanContext(), spans[1].Parent())
+}
+
 func (s *executableSuite) TestExecuteHandleErr_ResetAttempt() {
 	executable := s.newTestExecutable()
 	s.mockExecutor.EXPECT().Execute(gomock.Any(), executable).Return(nil, true, errors.New("some random error"))
@@ -205,6 +395,27 @@ func (s *executableSuite) TestExecuteHandleErr_ResetAttempt() {
 	s.Equal(1, executable.Attempt())
 }
 
+func (s *executableSuite) TestExecuteHandleErr_LifetimeAttemptNotReset() {
+	executable := s.newTestExecutable()
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), executable).Return(nil, true, errors.New("some random error"))
+	err := executable.Execute()
+	s.Error(err)
+	s.Error(executable.HandleErr(err))
+	s.Equal(2, executable.Attempt())
+	s.Equal(2, executable.LifetimeAttempt())
+
+	// isActive changed to false, resets attempt but not the lifetime attempt
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), executable).Return(nil, false, errors.New("some random error"))
+	err = executable.Execute()
+	s.Error(err)
+	s.Equal(1, executable.Attempt())
+	s.Equal(2, executable.LifetimeAttempt())
+
+	s.Error(executable.HandleErr(err))
+	s.Equal(2, executable.Attempt())
+	s.Equal(3, executable.LifetimeAttempt())
+}
+
 func (s *executableSuite) TestExecuteHandleErr_Corrupted() {
 	executable := s.newTestExecutable()
 
@@ -224,12 +435,84 @@ func (s *executableSuite) TestHandleErr_EntityNotExists() {
 	s.NoError(executable.HandleErr(serviceerror.NewNotFound("")))
 }
 
+func (s *executableSuite) TestHandleErr_EntityNotExists_Wrapped() {
+	logger := log.NewMockLogger(s.controller)
+	executable := s.newTestExecutableWithLogger(nil, logger)
+
+	var loggedErr string
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any()).Do(func(msg string, tags ...tag.Tag) {
+		for _, t := range tags {
+			if t.Key() == "error" {
+				loggedErr = fmt.Sprint(t.Value())
+			}
+		}
+	})
+
+	err := fmt.Errorf("failed to load mutable state: %w", serviceerror.NewNotFound("workflow execution not found"))
+	s.NoError(executable.HandleErr(err))
+	s.Contains(loggedErr, "failed to load mutable state")
+	s.Contains(loggedErr, "workflow execution not found")
+}
+
+func (s *executableSuite) TestHandleErr_ErrorLogSampling() {
+	s.errorLogSampler = NewErrorLogSampler(dynamicconfig.GetMapPropertyFn(map[string]interface{}{
+		ErrorLogClassWorkflowBusy: 10,
+	}))
+	logger := log.NewMockLogger(s.controller)
+	loggedErrors := make(map[string]int)
+	logger.EXPECT().Error(gomock.Any(), gomock.Any()).Do(func(msg string, tags ...tag.Tag) {
+		for _, t := range tags {
+			if t.Key() == "error" {
+				loggedErrors[fmt.Sprint(t.Value())]++
+			}
+		}
+	}).AnyTimes()
+	executable := s.newTestExecutableWithLogger(nil, logger)
+
+	busyErrors := 1000
+	for i := 0; i < busyErrors; i++ {
+		s.Equal(consts.ErrResourceExhaustedBusyWorkflow, executable.HandleErr(consts.ErrResourceExhaustedBusyWorkflow))
+	}
+	// Critical errors are only logged once the attempt, starting at 1, exceeds taskCriticalLogMetricAttempts, and then
+	// only one in 10 is logged
+	criticalBusyErrors := busyErrors + 1 - taskCriticalLogMetricAttempts
+	s.Equal((criticalBusyErrors+9)/10, loggedErrors[consts.ErrResourceExhaustedBusyWorkflow.Error()])
+
+	// A distinct error is always logged, both as a failure and as a critical error
+	rareErr := errors.New("some rare error")
+	s.Equal(rareErr, executable.HandleErr(rareErr))
+	s.Equal(2, loggedErrors[rareErr.Error()])
+}
+
 func (s *executableSuite) TestHandleErr_ErrTaskRetry() {
 	executable := s.newTestExecutable()
 
 	s.Equal(consts.ErrTaskRetry, executable.HandleErr(consts.ErrTaskRetry))
 }
 
+func (s *executableSuite) TestTaskNack_ErrTaskRetry_ReplicationLag() {
+	rescheduleDelay := func(lag time.Duration) time.Duration {
+		executable := s.newTestExecutableWithReplicationLagSignal(func(_ namespace.ID) time.Duration {
+			return lag
+		})
+		s.Equal(consts.ErrTaskRetry, executable.HandleErr(consts.ErrTaskRetry))
+
+		var rescheduleTime time.Time
+		s.mockRescheduler.EXPECT().Add(executable, gomock.Any()).Do(func(_ Executable, t time.Time) {
+			rescheduleTime = t
+		})
+		executable.Nack(consts.ErrTaskRetry)
+		return rescheduleTime.Sub(s.timeSource.Now())
+	}
+
+	noLagDelay := rescheduleDelay(0)
+	s.Less(noLagDelay, time.Minute)
+
+	s.Equal(2*time.Minute, rescheduleDelay(2*time.Minute))
+	s.Equal(4*time.Minute, rescheduleDelay(4*time.Minute))
+	s.Equal(replicationLagRescheduleMaxInterval, rescheduleDelay(time.Hour))
+}
+
 func (s *executableSuite) TestHandleErr_ErrDeleteOpenExecution() {
 	executable := s.newTestExecutable()
 
@@ -260,6 +543,420 @@ func (s *executableSuite) TestHandleErr_RandomErr() {
 	s.Error(executable.HandleErr(errors.New("random error")))
 }
 
+func (s *executableSuite) TestHandleErr_ErrTaskYield() {
+	executable := s.newTestExecutable()
+
+	s.Equal(consts.ErrTaskYield, executable.HandleErr(executable.Yield(1)))
+	s.Equal(1, executable.Cursor())
+	s.Equal(1, executable.Attempt())
+}
+
+func (s *executableSuite) TestExecute_CooperativeYield() {
+	yielding := s.newTestExecutable()
+	others := []Executable{s.newTestExecutable(), s.newTestExecutable()}
+
+	// a single worker running submitted executables in FIFO order
+	pending := append([]Executable{yielding}, others...)
+	s.mockScheduler.EXPECT().TrySubmit(gomock.Any()).DoAndReturn(func(e Executable) bool {
+		pending = append(pending, e)
+		return true
+	}).Times(2)
+
+	var executed []interface{}
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, e Executable) ([]metrics.Tag, bool, error) {
+			if e != yielding {
+				executed = append(executed, e)
+				return nil, true, nil
+			}
+			cursor, _ := e.Cursor().(int)
+			executed = append(executed, cursor)
+			if cursor < 2 {
+				return nil, true, e.Yield(cursor + 1)
+			}
+			return nil, true, nil
+		},
+	).Times(5)
+
+	for len(pending) != 0 {
+		e := pending[0]
+		pending = pending[1:]
+		if err := e.HandleErr(e.Execute()); err != nil {
+			e.Nack(err)
+			continue
+		}
+		e.Ack()
+	}
+
+	s.Equal([]interface{}{0, others[0], others[1], 1, 2}, executed)
+	s.Equal(ctasks.TaskStateAcked, yielding.State())
+	s.Equal(1, yielding.Attempt())
+}
+
+func (s *executableSuite) TestExecute_SplitIntoChildren() {
+	parent := s.newTestExecutable()
+	childTasks := make([]tasks.Task, 3)
+	for i := range childTasks {
+		childTasks[i] = tasks.NewFakeTask(
+			definition.NewWorkflowKey(tests.NamespaceID.String(), fmt.Sprintf("%s-%d", tests.WorkflowID, i), tests.RunID),
+			tasks.CategoryTransfer,
+			s.timeSource.Now(),
+		)
+	}
+
+	var children []Executable
+	s.mockScheduler.EXPECT().TrySubmit(gomock.Any()).DoAndReturn(func(e Executable) bool {
+		children = append(children, e)
+		return true
+	}).Times(3)
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, e Executable) ([]metrics.Tag, bool, error) {
+			if e == parent {
+				return nil, true, e.Split(childTasks)
+			}
+			return nil, true, nil
+		},
+	).Times(4)
+
+	err := parent.HandleErr(parent.Execute())
+	s.ErrorIs(err, consts.ErrTaskSplit)
+	parent.Nack(err)
+	s.Equal(1, parent.Attempt())
+	s.Len(children, 3)
+	for i, child := range children {
+		s.Equal(childTasks[i], child.GetTask())
+	}
+
+	for i, child := range children {
+		s.Equal(ctasks.TaskStatePending, parent.State())
+		s.NoError(child.HandleErr(child.Execute()))
+		child.Ack()
+		s.Equal(ctasks.TaskStateAcked, child.State(), i)
+	}
+	s.Equal(ctasks.TaskStateAcked, parent.State())
+}
+
+func (s *executableSuite) TestSplit_CancelPropagates() {
+	parent := s.newTestExecutable()
+	s.ErrorIs(parent.Split([]tasks.Task{parent.GetTask(), parent.GetTask()}), consts.ErrTaskSplit)
+	var children []Executable
+	s.mockScheduler.EXPECT().TrySubmit(gomock.Any()).DoAndReturn(func(e Executable) bool {
+		children = append(children, e)
+		return true
+	}).Times(2)
+	parent.Nack(consts.ErrTaskSplit)
+
+	children[0].Ack()
+	children[1].Cancel()
+	s.Equal(ctasks.TaskStateCancelled, parent.State())
+
+	s.NoError(s.newTestExecutable().Split(nil))
+}
+
+func (s *executableSuite) TestExecute_DefersUntilMinClockReached() {
+	executable := s.newTestExecutable()
+	watermarkSource := clock.NewEventTimeSource().Update(time.Unix(100, 0))
+	watermark := hlc.Next(hlc.Zero(1), watermarkSource)
+	minClock := hlc.Next(watermark, watermarkSource.Update(time.Unix(200, 0)))
+	executable.SetMinClock(&minClock, func() *hlc.Clock {
+		return &watermark
+	})
+
+	// the executor is not invoked and the task is rescheduled without counting as a failed attempt
+	s.mockRescheduler.EXPECT().Add(executable, gomock.Any()).Times(2)
+	for i := 0; i < 2; i++ {
+		err := executable.HandleErr(executable.Execute())
+		s.ErrorIs(err, consts.ErrClockNotReached)
+		executable.Nack(err)
+		s.Equal(1, executable.Attempt())
+		s.Equal(ctasks.TaskStatePending, executable.State())
+	}
+
+	watermark = hlc.Next(watermark, watermarkSource)
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), executable).Return(nil, true, nil).Times(1)
+	s.NoError(executable.HandleErr(executable.Execute()))
+	executable.Ack()
+	s.Equal(ctasks.TaskStateAcked, executable.State())
+}
+
+func (s *executableSuite) TestExecute_CircuitBreaker() {
+	captureHandler := metricstest.NewCaptureHandler()
+	capture := captureHandler.StartCapture()
+	defer captureHandler.StopCapture(capture)
+	s.metricsHandler = captureHandler
+
+	openDuration := 10 * time.Second
+	circuitBreaker := NewCircuitBreaker(
+		func() int { return 2 },
+		func() time.Duration { return openDuration },
+		s.timeSource,
+		log.NewTestLogger(),
+		captureHandler,
+	)
+	newExecutable := func() Executable {
+		executable := s.newTestExecutable()
+		executable.SetCircuitBreaker(circuitBreaker)
+		return executable
+	}
+	failing := newExecutable()
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), failing).Return(nil, true, errors.New("some random error")).Times(2)
+	s.Error(failing.HandleErr(failing.Execute()))
+	s.Error(failing.HandleErr(failing.Execute()))
+	s.Len(capture.Snapshot()[metrics.TaskCircuitBreakerTripped.GetMetricName()], 1)
+
+	// the executor is not invoked while the breaker is open and the task is rescheduled for when it half-opens,
+	// without counting as a failed attempt
+	held := newExecutable()
+	s.timeSource.Update(s.timeSource.Now().Add(time.Second))
+	err := held.HandleErr(held.Execute())
+	s.ErrorIs(err, consts.ErrCircuitBreakerOpen)
+	s.mockRescheduler.EXPECT().Add(held, s.timeSource.Now().Add(openDuration-time.Second)).Times(1)
+	held.Nack(err)
+	s.Equal(1, held.Attempt())
+
+	// once half-open, one task probes while the others are still held back
+	s.timeSource.Update(s.timeSource.Now().Add(openDuration))
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), held).Return(nil, true, nil).Times(1)
+	s.NoError(held.HandleErr(held.Execute()))
+	s.Len(capture.Snapshot()[metrics.TaskCircuitBreakerReset.GetMetricName()], 1)
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), failing).Return(nil, true, nil).Times(1)
+	s.NoError(failing.HandleErr(failing.Execute()))
+
+	// a failed probe keeps the breaker open
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), failing).Return(nil, true, errors.New("some random error")).Times(3)
+	s.Error(failing.HandleErr(failing.Execute()))
+	s.Error(failing.HandleErr(failing.Execute()))
+	s.timeSource.Update(s.timeSource.Now().Add(openDuration))
+	s.Error(failing.HandleErr(failing.Execute()))
+	s.ErrorIs(newExecutable().Execute(), consts.ErrCircuitBreakerOpen)
+	s.Len(capture.Snapshot()[metrics.TaskCircuitBreakerTripped.GetMetricName()], 2)
+}
+
+func (s *executableSuite) TestExecute_CircuitBreaker_IgnoresNonDestinationErrors() {
+	captureHandler := metricstest.NewCaptureHandler()
+	capture := captureHandler.StartCapture()
+	defer captureHandler.StopCapture(capture)
+	s.metricsHandler = captureHandler
+
+	circuitBreaker := NewCircuitBreaker(
+		func() int { return 2 },
+		func() time.Duration { return 10 * time.Second },
+		s.timeSource,
+		log.NewTestLogger(),
+		captureHandler,
+	)
+	executable := s.newTestExecutable()
+	executable.SetCircuitBreaker(circuitBreaker)
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), executable).Return(nil, true, &persistence.ShardOwnershipLostError{ShardID: 1}).Times(1)
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), executable).Return(nil, true, fmt.Errorf("wrapped: %w", &persistence.ShardOwnershipLostError{ShardID: 1})).Times(1)
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), executable).Return(nil, true, context.Canceled).Times(1)
+	s.Error(executable.HandleErr(executable.Execute()))
+	s.Error(executable.HandleErr(executable.Execute()))
+	s.Error(executable.HandleErr(executable.Execute()))
+
+	s.Empty(capture.Snapshot()[metrics.TaskCircuitBreakerTripped.GetMetricName()])
+	allowed, _ := circuitBreaker.Allow(executable.GetType())
+	s.True(allowed)
+}
+
+func (s *executableSuite) TestHandleErr_MoveToDLQ() {
+	dlq := &testDLQ{accept: true}
+	executable := s.newTestExecutable()
+	executable.SetDLQ(dlq, func() int { return 2 })
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), executable).Return(nil, true, errors.New("some random error")).Times(1)
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), executable).Return(nil, true, serviceerror.NewUnavailable("some unavailable error")).Times(1)
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), executable).Return(nil, true, context.DeadlineExceeded).Times(1)
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), executable).Return(nil, true, errors.New("some other error")).Times(1)
+
+	err := executable.HandleErr(executable.Execute())
+	s.Error(err)
+	s.NotErrorIs(err, consts.ErrTaskMoveToDLQ)
+
+	// errors expected to resolve by themselves don't count towards the max attempts
+	err = executable.HandleErr(executable.Execute())
+	s.NotErrorIs(err, consts.ErrTaskMoveToDLQ)
+	err = executable.HandleErr(executable.Execute())
+	s.NotErrorIs(err, consts.ErrTaskMoveToDLQ)
+
+	err = executable.HandleErr(executable.Execute())
+	s.ErrorIs(err, consts.ErrTaskMoveToDLQ)
+
+	// Nack hands the executable over to the DLQ, which acks it once persisted
+	executable.Nack(err)
+	s.Equal([]Executable{executable}, dlq.enqueued)
+	s.EqualError(dlq.lastErr, "some other error")
+	s.Equal(ctasks.TaskStatePending, executable.State())
+
+	// disabled with a non-positive max attempts
+	disabled := s.newTestExecutable()
+	disabled.SetDLQ(dlq, func() int { return 0 })
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), disabled).Return(nil, true, errors.New("some random error")).Times(1)
+	s.NotErrorIs(disabled.HandleErr(disabled.Execute()), consts.ErrTaskMoveToDLQ)
+}
+
+func (s *executableSuite) TestTaskNack_DLQBusy() {
+	dlq := &testDLQ{}
+	executable := s.newTestExecutable()
+	executable.SetDLQ(dlq, func() int { return 1 })
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), executable).Return(nil, true, errors.New("some random error")).Times(1)
+
+	err := executable.HandleErr(executable.Execute())
+	s.ErrorIs(err, consts.ErrTaskMoveToDLQ)
+
+	// the executable is retried as usual if the DLQ doesn't take it
+	s.mockScheduler.EXPECT().TrySubmit(executable).Return(false)
+	s.mockRescheduler.EXPECT().Add(executable, gomock.Any()).Times(1)
+	executable.Nack(err)
+	s.Empty(dlq.enqueued)
+}
+
+func (s *executableSuite) TestExecute_AttemptTimeout() {
+	captureHandler := metricstest.NewCaptureHandler()
+	capture := captureHandler.StartCapture()
+	defer captureHandler.StopCapture(capture)
+	s.metricsHandler = captureHandler
+
+	executable := s.newTestExecutable()
+	executable.SetAttemptTimeout(func() time.Duration { return 10 * time.Millisecond })
+
+	// the context of a stuck attempt is cancelled and the executable is rescheduled with a backoff
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), executable).DoAndReturn(
+		func(ctx context.Context, _ Executable) ([]metrics.Tag, bool, error) {
+			<-ctx.Done()
+			return nil, true, ctx.Err()
+		},
+	).Times(1)
+	err := executable.HandleErr(executable.Execute())
+	s.ErrorIs(err, consts.ErrTaskAttemptTimeout)
+	s.Len(capture.Snapshot()[metrics.TaskAttemptTimeout.GetMetricName()], 1)
+	s.mockRescheduler.EXPECT().Add(executable, gomock.Any()).Times(1)
+	executable.Nack(err)
+	s.Equal(2, executable.Attempt())
+
+	// errors of attempts completing within their deadline are left untouched
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), executable).DoAndReturn(
+		func(ctx context.Context, _ Executable) ([]metrics.Tag, bool, error) {
+			_, ok := ctx.Deadline()
+			s.True(ok)
+			return nil, true, consts.ErrTaskRetry
+		},
+	).Times(1)
+	s.ErrorIs(executable.Execute(), consts.ErrTaskRetry)
+	s.Len(capture.Snapshot()[metrics.TaskAttemptTimeout.GetMetricName()], 1)
+}
+
+func (s *executableSuite) TestExecute_SequencesExecutablesOfSameWorkflow() {
+	sequencer := NewExecutableSequencer()
+	workflowKey := definition.NewWorkflowKey(tests.NamespaceID.String(), tests.WorkflowID, tests.RunID)
+	otherWorkflowKey := definition.NewWorkflowKey(tests.NamespaceID.String(), "other-workflow-id", tests.RunID)
+
+	now := s.timeSource.Now()
+	var executables []Executable
+	// added out of order, they should still execute in the order they are scheduled for
+	for _, delay := range []time.Duration{3 * time.Second, time.Second, 2 * time.Second} {
+		executables = append(executables, s.newTestExecutableWithTask(
+			tasks.NewFakeTask(workflowKey, tasks.CategoryTimer, now.Add(delay)),
+			nil,
+			log.NewTestLogger(),
+		))
+	}
+	otherExecutable := s.newTestExecutableWithTask(
+		tasks.NewFakeTask(otherWorkflowKey, tasks.CategoryTimer, now.Add(3*time.Second)),
+		nil,
+		log.NewTestLogger(),
+	)
+	for _, executable := range append(executables, otherExecutable) {
+		executable.SetSequencer(sequencer)
+	}
+
+	var lock sync.Mutex
+	var executed []Executable
+	running := 0
+	otherStarted := make(chan struct{})
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, e Executable) ([]metrics.Tag, bool, error) {
+			if e == otherExecutable {
+				close(otherStarted)
+				return nil, true, nil
+			}
+
+			lock.Lock()
+			running++
+			s.Equal(1, running)
+			executed = append(executed, e)
+			first := len(executed) == 1
+			lock.Unlock()
+
+			if first {
+				// the other workflow is not blocked by this one
+				<-otherStarted
+			}
+
+			lock.Lock()
+			running--
+			lock.Unlock()
+			return nil, true, nil
+		},
+	).Times(4)
+
+	var wg sync.WaitGroup
+	for _, executable := range append(executables, otherExecutable) {
+		wg.Add(1)
+		go func(executable Executable) {
+			defer wg.Done()
+			for {
+				err := executable.HandleErr(executable.Execute())
+				if errors.Is(err, consts.ErrPrecedingTaskNotCompleted) {
+					s.Equal(1, executable.Attempt())
+					time.Sleep(time.Millisecond)
+					continue
+				}
+				s.NoError(err)
+				executable.Ack()
+				return
+			}
+		}(executable)
+	}
+	wg.Wait()
+
+	s.Equal([]Executable{executables[1], executables[2], executables[0]}, executed)
+}
+
+func (s *executableSuite) TestStuckExecutableDetector_ReportProgress() {
+	detector := NewStuckExecutableDetector(dynamicconfig.GetDurationPropertyFn(time.Minute), s.timeSource)
+
+	progressing := s.newTestExecutable()
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), progressing).DoAndReturn(
+		func(_ context.Context, e Executable) ([]metrics.Tag, bool, error) {
+			for i := 0; i < 5; i++ {
+				s.timeSource.Update(s.timeSource.Now().Add(40 * time.Second))
+				e.ReportProgress()
+				s.False(detector.IsStuck(e))
+			}
+			return nil, true, nil
+		},
+	).Times(1)
+	s.NoError(progressing.Execute())
+
+	stuck := s.newTestExecutable()
+	s.mockExecutor.EXPECT().Execute(gomock.Any(), stuck).DoAndReturn(
+		func(_ context.Context, e Executable) ([]metrics.Tag, bool, error) {
+			s.timeSource.Update(s.timeSource.Now().Add(40 * time.Second))
+			s.False(detector.IsStuck(e))
+			s.timeSource.Update(s.timeSource.Now().Add(40 * time.Second))
+			s.True(detector.IsStuck(e))
+			return nil, true, nil
+		},
+	).Times(1)
+	s.NoError(stuck.Execute())
+
+	// executables are only stuck while executing
+	s.timeSource.Update(s.timeSource.Now().Add(time.Hour))
+	s.True(stuck.LastProgressTime().IsZero())
+	s.False(detector.IsStuck(stuck))
+	s.False(detector.IsStuck(s.newTestExecutable()))
+}
+
 func (s *executableSuite) TestTaskAck() {
 	executable := s.newTestExecutable()
 
@@ -293,6 +990,24 @@ func (s *executableSuite) TestTaskNack_Reschedule() {
 	})
 }
 
+func (s *executableSuite) TestTaskNack_ShardReloading() {
+	executable := s.newTestExecutable()
+	reloading := true
+	executable.SetShardReloading(func() bool {
+		return reloading
+	})
+
+	// the task is left for the shard reload
+	s.mockRescheduler.EXPECT().Add(gomock.Any(), gomock.Any()).Times(0)
+	executable.Nack(consts.ErrTaskRetry)
+	s.Equal(ctasks.TaskStatePending, executable.State())
+
+	reloading = false
+	s.mockRescheduler.EXPECT().Add(executable, gomock.AssignableToTypeOf(time.Now())).Times(1)
+	executable.Nack(consts.ErrTaskRetry)
+	s.Equal(ctasks.TaskStatePending, executable.State())
+}
+
 func (s *executableSuite) TestTaskAbort() {
 	executable := s.newTestExecutable()
 
@@ -311,6 +1026,56 @@ func (s *executableSuite) TestTaskAbort() {
 	s.False(executable.IsRetryableError(errors.New("some random error")))
 }
 
+func (s *executableSuite) TestTaskStateDuration() {
+	captureHandler := metricstest.NewCaptureHandler()
+	capture := captureHandler.StartCapture()
+	defer captureHandler.StopCapture(capture)
+	s.metricsHandler = captureHandler
+
+	advance := func(d time.Duration) {
+		s.timeSource.Update(s.timeSource.Now().Add(d))
+	}
+	transitions := func() map[string]time.Duration {
+		durations := make(map[string]time.Duration)
+		for _, recording := range capture.Snapshot()[metrics.TaskStateDuration.GetMetricName()] {
+			transition := recording.Tags[metrics.TaskStateTagName] + "->" + recording.Tags[metrics.TaskNextStateTagName]
+			durations[transition] += recording.Value.(time.Duration)
+		}
+		return durations
+	}
+
+	// nack keeps the task pending and restarts the timer, ack ends it
+	executable := s.newTestExecutable()
+	s.mockScheduler.EXPECT().TrySubmit(executable).Return(true)
+	advance(time.Second)
+	executable.Nack(errors.New("some random error"))
+	advance(2 * time.Second)
+	executable.Ack()
+	advance(time.Minute)
+	executable.Ack() // no-op
+	s.Equal(map[string]time.Duration{
+		"pending->nacked": time.Second,
+		"pending->acked":  2 * time.Second,
+	}, transitions())
+
+	executable = s.newTestExecutable()
+	advance(3 * time.Second)
+	executable.Abort()
+	executable.Cancel() // no-op
+
+	executable = s.newTestExecutable()
+	advance(4 * time.Second)
+	executable.Cancel()
+	executable.Nack(errors.New("some random error")) // no-op
+
+	s.Equal(map[string]time.Duration{
+		"pending->nacked":    time.Second,
+		"pending->acked":     2 * time.Second,
+		"pending->aborted":   3 * time.Second,
+		"pending->cancelled": 4 * time.Second,
+	}, transitions())
+}
+
 func (s *executableSuite) TestTaskCancellation() {
 	executable := s.newTestExecutable()
 
@@ -330,8 +1095,20 @@ func (s *executableSuite) TestTaskCancellation() {
 }
 
 func (s *executableSuite) newTestExecutable() Executable {
-	return NewExecutable(
-		DefaultReaderId,
+	return s.newTestExecutableWithReplicationLagSignal(nil)
+}
+
+func (s *executableSuite) newTestExecutableWithReplicationLagSignal(
+	replicationLagSignal ReplicationLagSignal,
+) Executable {
+	return s.newTestExecutableWithLogger(replicationLagSignal, log.NewTestLogger())
+}
+
+func (s *executableSuite) newTestExecutableWithLogger(
+	replicationLagSignal ReplicationLagSignal,
+	logger log.Logger,
+) Executable {
+	return s.newTestExecutableWithTask(
 		tasks.NewFakeTask(
 			definition.NewWorkflowKey(
 				tests.NamespaceID.String(),
@@ -341,6 +1118,19 @@ func (s *executableSuite) newTestExecutable() Executable {
 			tasks.CategoryTransfer,
 			s.timeSource.Now(),
 		),
+		replicationLagSignal,
+		logger,
+	)
+}
+
+func (s *executableSuite) newTestExecutableWithTask(
+	task tasks.Task,
+	replicationLagSignal ReplicationLagSignal,
+	logger log.Logger,
+) Executable {
+	return NewExecutable(
+		DefaultReaderId,
+		task,
 		s.mockExecutor,
 		s.mockScheduler,
 		s.mockRescheduler,
@@ -348,7 +1138,28 @@ func (s *executableSuite) newTestExecutable() Executable {
 		s.timeSource,
 		s.mockNamespaceRegistry,
 		s.mockClusterMetadata,
-		log.NewTestLogger(),
-		metrics.NoopMetricsHandler,
+		replicationLagSignal,
+		s.errorLogSampler,
+		logger,
+		s.metricsHandler,
 	)
 }
+
+type testDLQ struct {
+	accept   bool
+	enqueued []Executable
+	lastErr  error
+}
+
+func (d *testDLQ) Start() {}
+
+func (d *testDLQ) Stop() {}
+
+func (d *testDLQ) Enqueue(executable Executable, lastErr error) bool {
+	if !d.accept {
+		return false
+	}
+	d.enqueued = append(d.enqueued, executable)
+	d.lastErr = lastErr
+	return true
+}
@@ -184,6 +184,8 @@ func (s *memoryScheduledQueueSuite) newSpeculativeWorkflowTaskTimeoutTestExecuta
 			nil,
 			nil,
 			nil,
+			nil,
+			nil,
 		),
 		wttt,
 	)
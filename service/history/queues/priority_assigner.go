@@ -25,8 +25,16 @@
 package queues
 
 import (
+	"math"
+	"strings"
+
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/tasks"
+	"go.temporal.io/server/service/history/configs"
+	historytasks "go.temporal.io/server/service/history/tasks"
 )
 
 type (
@@ -35,18 +43,56 @@ type (
 		Assign(Executable) tasks.Priority
 	}
 
-	priorityAssignerImpl struct{}
+	priorityAssignerImpl struct {
+		namespaceRegistry           namespace.Registry
+		namespacePriorityMultiplier dynamicconfig.FloatPropertyFnWithNamespaceFilter
+		namespacePriorityOverride   dynamicconfig.MapPropertyFnWithNamespaceFilter
+		activeNamespaceWeights      dynamicconfig.MapPropertyFnWithNamespaceFilter
+		logger                      log.Logger
+	}
 
 	// noopPriorityAssigner always assign high priority to tasks
 	// it should only be used in tests
 	noopPriorityAssigner struct{}
 )
 
-func NewPriorityAssigner() PriorityAssigner {
-	return &priorityAssignerImpl{}
+func NewPriorityAssigner(
+	namespaceRegistry namespace.Registry,
+	namespacePriorityMultiplier dynamicconfig.FloatPropertyFnWithNamespaceFilter,
+	namespacePriorityOverride dynamicconfig.MapPropertyFnWithNamespaceFilter,
+	activeNamespaceWeights dynamicconfig.MapPropertyFnWithNamespaceFilter,
+	logger log.Logger,
+) PriorityAssigner {
+	return &priorityAssignerImpl{
+		namespaceRegistry:           namespaceRegistry,
+		namespacePriorityMultiplier: namespacePriorityMultiplier,
+		namespacePriorityOverride:   namespacePriorityOverride,
+		activeNamespaceWeights:      activeNamespaceWeights,
+		logger:                      logger,
+	}
 }
 
+// Assign derives the priority from the task type, scaled by the priority multiplier of the namespace of the task,
+// unless the namespace overrides the priority of the task category. Workflows carry no scheduling priority of their
+// own, so there is nothing for a child workflow to inherit from its parent: StartChildExecution tasks are assigned the
+// same priority as every other task of the parent workflow.
 func (a *priorityAssignerImpl) Assign(executable Executable) tasks.Priority {
+	// tasks of a namespace that can't be resolved keep the priority of their task type
+	nsName, err := a.namespaceRegistry.GetNamespaceName(namespace.ID(executable.GetNamespaceID()))
+	if err != nil {
+		return a.taskTypePriority(executable)
+	}
+
+	if overrides := a.namespacePriorityOverride(nsName.String()); len(overrides) > 0 {
+		if priority, ok := priorityOverride(overrides, executable.GetCategory()); ok {
+			return priority
+		}
+	}
+	weights := configs.ConvertDynamicConfigValueToWeights(a.activeNamespaceWeights(nsName.String()), a.logger)
+	return scalePriority(a.taskTypePriority(executable), a.namespacePriorityMultiplier(nsName.String()), weights)
+}
+
+func (a *priorityAssignerImpl) taskTypePriority(executable Executable) tasks.Priority {
 	taskType := executable.GetType()
 	switch taskType {
 	case enumsspb.TASK_TYPE_DELETE_HISTORY_EVENT,
@@ -67,6 +113,43 @@ func (a *priorityAssignerImpl) Assign(executable Executable) tasks.Priority {
 	return tasks.PriorityHigh
 }
 
+// scalePriority multiplies the weight of the given priority in the round robin weights of the scheduler the task is
+// submitted to by multiplier and returns the priority whose weight is the closest to the result on a logarithmic
+// scale. With the default weights, see configs.DefaultActiveTaskPriorityWeight, a low priority is promoted only by a
+// multiplier above about 3.2 and a high priority is demoted only by a multiplier below about 0.32. Priorities without a
+// positive weight and non-positive multipliers keep the priority.
+func scalePriority(priority tasks.Priority, multiplier float64, weights map[tasks.Priority]int) tasks.Priority {
+	weight, ok := weights[priority]
+	if !ok || weight <= 0 || multiplier <= 0 || multiplier == 1 {
+		return priority
+	}
+
+	scaledWeight := float64(weight) * multiplier
+	scaled := priority
+	minDistance := math.Abs(math.Log(multiplier))
+	for candidate, candidateWeight := range weights {
+		if candidateWeight <= 0 {
+			continue
+		}
+		if distance := math.Abs(math.Log(scaledWeight / float64(candidateWeight))); distance < minDistance {
+			scaled = candidate
+			minDistance = distance
+		}
+	}
+	return scaled
+}
+
+// priorityOverride looks up the priority configured for a task category in a map from category name to priority
+// name, e.g. {"timer": "low"}. Unknown priority names are ignored.
+func priorityOverride(overrides map[string]any, category historytasks.Category) (tasks.Priority, bool) {
+	name, ok := overrides[category.Name()].(string)
+	if !ok {
+		return 0, false
+	}
+	priority, ok := tasks.PriorityValue[strings.ToLower(name)]
+	return priority, ok
+}
+
 func NewNoopPriorityAssigner() PriorityAssigner {
 	return &noopPriorityAssigner{}
 }
@@ -32,7 +32,12 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/tasks"
+	"go.temporal.io/server/service/history/configs"
+	historytasks "go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/history/tests"
 )
 
 type (
@@ -40,9 +45,13 @@ type (
 		*require.Assertions
 		suite.Suite
 
-		controller *gomock.Controller
+		controller            *gomock.Controller
+		mockNamespaceRegistry *namespace.MockRegistry
 
-		priorityAssigner *priorityAssignerImpl
+		namespacePriorityMultiplier map[string]float64
+		namespacePriorityOverride   map[string]map[string]any
+		namespaceWeights            map[string]map[tasks.Priority]int
+		priorityAssigner            *priorityAssignerImpl
 	}
 )
 
@@ -56,7 +65,35 @@ func (s *priorityAssignerSuite) SetupTest() {
 
 	s.controller = gomock.NewController(s.T())
 
-	s.priorityAssigner = NewPriorityAssigner().(*priorityAssignerImpl)
+	s.mockNamespaceRegistry = namespace.NewMockRegistry(s.controller)
+	s.mockNamespaceRegistry.EXPECT().GetNamespaceName(gomock.Any()).DoAndReturn(
+		func(id namespace.ID) (namespace.Name, error) {
+			return namespace.Name(id.String() + "-name"), nil
+		},
+	).AnyTimes()
+
+	s.namespacePriorityMultiplier = make(map[string]float64)
+	s.namespacePriorityOverride = make(map[string]map[string]any)
+	s.namespaceWeights = make(map[string]map[tasks.Priority]int)
+	s.priorityAssigner = NewPriorityAssigner(
+		s.mockNamespaceRegistry,
+		func(namespace string) float64 {
+			if multiplier, ok := s.namespacePriorityMultiplier[namespace]; ok {
+				return multiplier
+			}
+			return 1
+		},
+		func(namespace string) map[string]any {
+			return s.namespacePriorityOverride[namespace]
+		},
+		func(namespace string) map[string]any {
+			if weights, ok := s.namespaceWeights[namespace]; ok {
+				return configs.ConvertWeightsToDynamicConfigValue(weights)
+			}
+			return configs.ConvertWeightsToDynamicConfigValue(configs.DefaultActiveTaskPriorityWeight)
+		},
+		log.NewNoopLogger(),
+	).(*priorityAssignerImpl)
 }
 
 func (s *priorityAssignerSuite) TearDownTest() {
@@ -64,21 +101,21 @@ func (s *priorityAssignerSuite) TearDownTest() {
 }
 
 func (s *priorityAssignerSuite) TestAssign_SelectedTaskTypes() {
-	mockExecutable := NewMockExecutable(s.controller)
+	mockExecutable := s.newMockExecutable()
 	mockExecutable.EXPECT().GetType().Return(enumsspb.TASK_TYPE_DELETE_HISTORY_EVENT).Times(1)
 
 	s.Equal(tasks.PriorityLow, s.priorityAssigner.Assign(mockExecutable))
 }
 
 func (s *priorityAssignerSuite) TestAssign_UnknownTaskTypes() {
-	mockExecutable := NewMockExecutable(s.controller)
+	mockExecutable := s.newMockExecutable()
 	mockExecutable.EXPECT().GetType().Return(enumsspb.TaskType(1234)).Times(1)
 
 	s.Equal(tasks.PriorityLow, s.priorityAssigner.Assign(mockExecutable))
 }
 
 func (s *priorityAssignerSuite) TestAssign_HighPriorityTaskTypes() {
-	mockExecutable := NewMockExecutable(s.controller)
+	mockExecutable := s.newMockExecutable()
 	mockExecutable.EXPECT().GetType().Return(enumsspb.TASK_TYPE_ACTIVITY_RETRY_TIMER).Times(1)
 
 	s.Equal(tasks.PriorityHigh, s.priorityAssigner.Assign(mockExecutable))
@@ -92,9 +129,100 @@ func (s *priorityAssignerSuite) TestAssign_LowPriorityTaskTypes() {
 		enumsspb.TASK_TYPE_ARCHIVAL_ARCHIVE_EXECUTION,
 		enumsspb.TASK_TYPE_UNSPECIFIED,
 	} {
-		mockExecutable := NewMockExecutable(s.controller)
+		mockExecutable := s.newMockExecutable()
 		mockExecutable.EXPECT().GetType().Return(taskType).Times(1)
 
 		s.Equal(tasks.PriorityLow, s.priorityAssigner.Assign(mockExecutable))
 	}
 }
+
+func (s *priorityAssignerSuite) TestAssign_NamespacePriorityMultiplier() {
+	nsName := tests.NamespaceID.String() + "-name"
+	newMockExecutable := func(taskType enumsspb.TaskType) *MockExecutable {
+		mockExecutable := s.newMockExecutable()
+		mockExecutable.EXPECT().GetType().Return(taskType).AnyTimes()
+		return mockExecutable
+	}
+	highPriorityTask := newMockExecutable(enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK)
+	lowPriorityTask := newMockExecutable(enumsspb.TASK_TYPE_DELETE_HISTORY_EVENT)
+
+	// namespaces without a multiplier keep the priority of the task type
+	s.Equal(tasks.PriorityHigh, s.priorityAssigner.Assign(highPriorityTask))
+	s.Equal(tasks.PriorityLow, s.priorityAssigner.Assign(lowPriorityTask))
+
+	// small multipliers don't bridge the weights of high and low priority
+	s.namespacePriorityMultiplier[nsName] = 2
+	s.Equal(tasks.PriorityHigh, s.priorityAssigner.Assign(highPriorityTask))
+	s.Equal(tasks.PriorityLow, s.priorityAssigner.Assign(lowPriorityTask))
+	s.namespacePriorityMultiplier[nsName] = 0.5
+	s.Equal(tasks.PriorityHigh, s.priorityAssigner.Assign(highPriorityTask))
+	s.Equal(tasks.PriorityLow, s.priorityAssigner.Assign(lowPriorityTask))
+
+	s.namespacePriorityMultiplier[nsName] = 10
+	s.Equal(tasks.PriorityHigh, s.priorityAssigner.Assign(highPriorityTask))
+	s.Equal(tasks.PriorityHigh, s.priorityAssigner.Assign(lowPriorityTask))
+	s.namespacePriorityMultiplier[nsName] = 0.1
+	s.Equal(tasks.PriorityLow, s.priorityAssigner.Assign(highPriorityTask))
+	s.Equal(tasks.PriorityLow, s.priorityAssigner.Assign(lowPriorityTask))
+
+	// non-positive multipliers are ignored
+	s.namespacePriorityMultiplier[nsName] = 0
+	s.Equal(tasks.PriorityHigh, s.priorityAssigner.Assign(highPriorityTask))
+}
+
+func (s *priorityAssignerSuite) TestAssign_NamespacePriorityMultiplier_ConfiguredWeights() {
+	nsName := tests.NamespaceID.String() + "-name"
+	lowPriorityTask := s.newMockExecutable()
+	lowPriorityTask.EXPECT().GetType().Return(enumsspb.TASK_TYPE_DELETE_HISTORY_EVENT).AnyTimes()
+
+	// the multiplier is applied to the weights the scheduler is configured with
+	s.namespaceWeights[nsName] = map[tasks.Priority]int{
+		tasks.PriorityHigh: 2,
+		tasks.PriorityLow:  1,
+	}
+	s.namespacePriorityMultiplier[nsName] = 2
+	s.Equal(tasks.PriorityHigh, s.priorityAssigner.Assign(lowPriorityTask))
+
+	s.namespaceWeights[nsName] = map[tasks.Priority]int{
+		tasks.PriorityHigh: 1000,
+		tasks.PriorityLow:  1,
+	}
+	s.namespacePriorityMultiplier[nsName] = 10
+	s.Equal(tasks.PriorityLow, s.priorityAssigner.Assign(lowPriorityTask))
+
+	// priorities without a weight are never assigned
+	s.namespaceWeights[nsName] = map[tasks.Priority]int{
+		tasks.PriorityLow: 1,
+	}
+	s.Equal(tasks.PriorityLow, s.priorityAssigner.Assign(lowPriorityTask))
+}
+
+func (s *priorityAssignerSuite) TestAssign_NamespacePriorityOverride() {
+	nsName := tests.NamespaceID.String() + "-name"
+	s.namespacePriorityOverride[nsName] = map[string]any{
+		historytasks.CategoryNameTransfer:   "low",
+		historytasks.CategoryNameTimer:      "High",
+		historytasks.CategoryNameVisibility: "urgent",
+	}
+	// overrides take precedence over the multiplier
+	s.namespacePriorityMultiplier[nsName] = 10
+
+	newMockExecutable := func(category historytasks.Category) *MockExecutable {
+		mockExecutable := s.newMockExecutable()
+		mockExecutable.EXPECT().GetCategory().Return(category).AnyTimes()
+		mockExecutable.EXPECT().GetType().Return(enumsspb.TASK_TYPE_UNSPECIFIED).AnyTimes()
+		return mockExecutable
+	}
+
+	s.Equal(tasks.PriorityLow, s.priorityAssigner.Assign(newMockExecutable(historytasks.CategoryTransfer)))
+	s.Equal(tasks.PriorityHigh, s.priorityAssigner.Assign(newMockExecutable(historytasks.CategoryTimer)))
+	// unknown priorities and categories without an override fall back to the multiplier
+	s.Equal(tasks.PriorityHigh, s.priorityAssigner.Assign(newMockExecutable(historytasks.CategoryVisibility)))
+	s.Equal(tasks.PriorityHigh, s.priorityAssigner.Assign(newMockExecutable(historytasks.CategoryArchival)))
+}
+
+func (s *priorityAssignerSuite) newMockExecutable() *MockExecutable {
+	mockExecutable := NewMockExecutable(s.controller)
+	mockExecutable.EXPECT().GetNamespaceID().Return(tests.NamespaceID.String()).AnyTimes()
+	return mockExecutable
+}
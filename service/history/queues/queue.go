@@ -25,6 +25,7 @@
 package queues
 
 import (
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/service/history/tasks"
 )
@@ -37,5 +38,6 @@ type (
 		Category() tasks.Category
 		NotifyNewTasks(tasks []tasks.Task)
 		FailoverNamespace(namespaceID string)
+		Describe() *historyspb.HistoryQueueInfo
 	}
 )
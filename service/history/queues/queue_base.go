@@ -30,19 +30,25 @@ import (
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/exp/slices"
 
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/clock"
+	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
 	"go.temporal.io/server/common/debug"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/predicates"
+	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/quotas"
 	hshard "go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tasks"
@@ -75,6 +81,9 @@ type (
 	queueState struct {
 		readerScopes                 map[int64][]Scope
 		exclusiveReaderHighWatermark tasks.Key
+		// exclusiveDeletionHighWatermark is only set when tasks acked by all readers
+		// are not yet range completed, see Options.RangeCompleteInterval
+		exclusiveDeletionHighWatermark *tasks.Key
 	}
 
 	queueBase struct {
@@ -88,6 +97,7 @@ type (
 		options        *Options
 		scheduler      Scheduler
 		rescheduler    Rescheduler
+		dlq            DLQ // nil if the executables of the queue are never moved to a DLQ
 		timeSource     clock.TimeSource
 		monitor        *monitorImpl
 		mitigator      *mitigatorImpl
@@ -98,6 +108,7 @@ type (
 		executableInitializer ExecutableInitializer
 
 		exclusiveDeletionHighWatermark tasks.Key
+		lastRangeCompleteTime          time.Time
 		nonReadableScope               Scope
 		readerRateLimiter              quotas.RequestRateLimiter
 		readerGroup                    *ReaderGroup
@@ -119,9 +130,86 @@ type (
 		CheckpointInterval                  dynamicconfig.DurationPropertyFn
 		CheckpointIntervalJitterCoefficient dynamicconfig.FloatPropertyFn
 		MaxReaderCount                      dynamicconfig.IntPropertyFn
+		// ErrorLogSampleRates is used to sample the logs of repetitive task processing errors,
+		// see NewErrorLogSampler. Optional, all errors are logged if not set.
+		ErrorLogSampleRates dynamicconfig.MapPropertyFn
+		// ExecutableSnapshotSink receives a snapshot of the loaded executables on every checkpoint while
+		// ExecutableSnapshotEnabled returns true. Optional, no snapshot is taken if either is not set.
+		ExecutableSnapshotSink    ExecutableSnapshotSink
+		ExecutableSnapshotEnabled dynamicconfig.BoolPropertyFn
+		// Tracer records a span per attempt of each executable, see Executable.SetTracer. Optional, attempts are not
+		// traced if not set.
+		Tracer trace.Tracer
+		// CircuitBreakerFailureThreshold and CircuitBreakerOpenDuration configure the circuit breaker of the
+		// executables of the queue, see NewCircuitBreaker. Optional, there is no circuit breaker if either is not set.
+		CircuitBreakerFailureThreshold dynamicconfig.IntPropertyFn
+		CircuitBreakerOpenDuration     dynamicconfig.DurationPropertyFn
+		// HistoryTaskDLQ and DLQMaxAttempts configure where and after how many failed attempts the executables of the
+		// queue are moved to a DLQ, see Executable.SetDLQ. Optional, executables are retried forever if either is not set.
+		HistoryTaskDLQ persistence.HistoryTaskDLQ
+		DLQMaxAttempts dynamicconfig.IntPropertyFn
+		// AttemptTimeout is the deadline of each attempt to execute an executable of the queue, see
+		// Executable.SetAttemptTimeout. Optional, attempts have no deadline if not set.
+		AttemptTimeout dynamicconfig.DurationPropertyFn
+		// StandbyTaskMinClockEnabled makes each executable of the queue wait until the shard has applied the updates of
+		// the active cluster of its namespace up to the visibility time of its task, see Executable.SetMinClock and
+		// newClockWatermark. Optional, executables don't wait if not set.
+		StandbyTaskMinClockEnabled dynamicconfig.BoolPropertyFn
+		// SequentialExecutionEnabled makes the executables of the same workflow created while it returns true execute
+		// one at a time, see Executable.SetSequencer. Optional, executables execute concurrently if not set.
+		SequentialExecutionEnabled dynamicconfig.BoolPropertyFn
+		// RangeCompleteInterval is the minimum interval between two deletions of the tasks acked by all readers of the
+		// queue, so that acks from several checkpoints are coalesced into a single RangeCompleteHistoryTasks call.
+		// Optional, acked tasks are deleted on every checkpoint if not set.
+		RangeCompleteInterval dynamicconfig.DurationPropertyFn
 	}
 )
 
+// newReplicationLagSignal estimates replication lag for a namespace as the difference between
+// the local time and the last known time of the namespace's active cluster.
+func newReplicationLagSignal(
+	shard hshard.Context,
+) ReplicationLagSignal {
+	return func(namespaceID namespace.ID) time.Duration {
+		namespaceEntry, err := shard.GetNamespaceRegistry().GetNamespaceByID(namespaceID)
+		if err != nil {
+			return 0
+		}
+		activeCluster := namespaceEntry.ActiveClusterName()
+		if activeCluster == shard.GetClusterMetadata().GetCurrentClusterName() {
+			return 0
+		}
+		return shard.GetTimeSource().Now().Sub(shard.GetCurrentTime(activeCluster))
+	}
+}
+
+// newShardReloading reports the shard as reloading once it is no longer valid, e.g. after losing its ownership. The
+// queue is then stopped and its pending tasks are loaded again when the shard is reacquired.
+func newShardReloading(
+	shard hshard.Context,
+) ShardReloading {
+	return func() bool {
+		return !shard.IsValid()
+	}
+}
+
+// newClockWatermark returns the clock up to which the shard has applied the updates of the active cluster of the
+// given namespace, i.e. the last known time of that cluster, or the local time if the namespace is active here.
+func newClockWatermark(
+	shard hshard.Context,
+	namespaceID namespace.ID,
+) ClockWatermark {
+	return func() *hlc.Clock {
+		clusterMetadata := shard.GetClusterMetadata()
+		activeCluster := clusterMetadata.GetCurrentClusterName()
+		if namespaceEntry, err := shard.GetNamespaceRegistry().GetNamespaceByID(namespaceID); err == nil {
+			activeCluster = namespaceEntry.ActiveClusterName()
+		}
+		watermark := hlc.ZeroAt(shard.GetCurrentTime(activeCluster), clusterMetadata.GetClusterID())
+		return &watermark
+	}
+}
+
 func newQueueBase(
 	shard hshard.Context,
 	category tasks.Category,
@@ -138,11 +226,13 @@ func newQueueBase(
 ) *queueBase {
 	var readerScopes map[int64][]Scope
 	var exclusiveReaderHighWatermark tasks.Key
+	var lastExclusiveDeletionHighWatermark *tasks.Key
 	if persistenceState, ok := shard.GetQueueState(category); ok {
 		queueState := FromPersistenceQueueState(persistenceState)
 
 		readerScopes = queueState.readerScopes
 		exclusiveReaderHighWatermark = queueState.exclusiveReaderHighWatermark
+		lastExclusiveDeletionHighWatermark = queueState.exclusiveDeletionHighWatermark
 	} else {
 		ackLevel := tasks.NewKey(tasks.DefaultFireTime, 0)
 		if category.Type() == tasks.CategoryTypeImmediate {
@@ -154,8 +244,36 @@ func newQueueBase(
 	}
 
 	timeSource := shard.GetTimeSource()
+	replicationLagSignal := newReplicationLagSignal(shard)
+	shardReloading := newShardReloading(shard)
+	sequencer := NewExecutableSequencer()
+	var errorLogSampler ErrorLogSampler
+	if options.ErrorLogSampleRates != nil {
+		errorLogSampler = NewErrorLogSampler(options.ErrorLogSampleRates)
+	}
+	var circuitBreaker CircuitBreaker
+	if options.CircuitBreakerFailureThreshold != nil && options.CircuitBreakerOpenDuration != nil {
+		circuitBreaker = NewCircuitBreaker(
+			options.CircuitBreakerFailureThreshold,
+			options.CircuitBreakerOpenDuration,
+			timeSource,
+			logger,
+			metricsHandler,
+		)
+	}
+	var dlq DLQ
+	if options.HistoryTaskDLQ != nil && options.DLQMaxAttempts != nil {
+		dlq = NewDLQ(
+			shard.GetShardID(),
+			options.HistoryTaskDLQ,
+			shard.GetPayloadSerializer(),
+			timeSource,
+			logger,
+			metricsHandler,
+		)
+	}
 	executableInitializer := func(readerID int64, t tasks.Task) Executable {
-		return NewExecutable(
+		executable := NewExecutable(
 			readerID,
 			t,
 			executor,
@@ -165,9 +283,32 @@ func newQueueBase(
 			timeSource,
 			shard.GetNamespaceRegistry(),
 			shard.GetClusterMetadata(),
+			replicationLagSignal,
+			errorLogSampler,
 			logger,
 			metricsHandler,
 		)
+		executable.SetShardReloading(shardReloading)
+		if options.StandbyTaskMinClockEnabled != nil && options.StandbyTaskMinClockEnabled() {
+			minClock := hlc.ZeroAt(t.GetVisibilityTime(), shard.GetClusterMetadata().GetClusterID())
+			executable.SetMinClock(&minClock, newClockWatermark(shard, namespace.ID(t.GetNamespaceID())))
+		}
+		if options.SequentialExecutionEnabled != nil && options.SequentialExecutionEnabled() {
+			executable.SetSequencer(sequencer)
+		}
+		if options.Tracer != nil {
+			executable.SetTracer(options.Tracer)
+		}
+		if circuitBreaker != nil {
+			executable.SetCircuitBreaker(circuitBreaker)
+		}
+		if dlq != nil {
+			executable.SetDLQ(dlq, options.DLQMaxAttempts)
+		}
+		if options.AttemptTimeout != nil {
+			executable.SetAttemptTimeout(options.AttemptTimeout)
+		}
+		return executable
 	}
 
 	monitor := newMonitor(category.Type(), timeSource, &options.MonitorOptions)
@@ -236,6 +377,11 @@ func newQueueBase(
 		readerGroup.Stop()
 		readerGroup = NewReaderGroup(shard.GetShardID(), shard.GetOwner(), category, readerInitializer, shard.GetExecutionManager())
 	}
+	if lastExclusiveDeletionHighWatermark != nil {
+		// tasks acked before the last checkpoint may not be deleted yet,
+		// resume deletion from where it was left off
+		exclusiveDeletionHighWatermark = tasks.MinKey(exclusiveDeletionHighWatermark, *lastExclusiveDeletionHighWatermark)
+	}
 
 	mitigator := newMitigator(readerGroup, monitor, logger, metricsHandler, options.MaxReaderCount)
 
@@ -249,6 +395,7 @@ func newQueueBase(
 		options:        options,
 		scheduler:      scheduler,
 		rescheduler:    rescheduler,
+		dlq:            dlq,
 		timeSource:     shard.GetTimeSource(),
 		monitor:        monitor,
 		mitigator:      mitigator,
@@ -278,6 +425,9 @@ func newQueueBase(
 
 func (p *queueBase) Start() {
 	p.rescheduler.Start()
+	if p.dlq != nil {
+		p.dlq.Start()
+	}
 	p.readerGroup.Start()
 
 	p.checkpointTimer = time.NewTimer(backoff.Jitter(
@@ -289,6 +439,9 @@ func (p *queueBase) Start() {
 func (p *queueBase) Stop() {
 	p.monitor.Close()
 	p.readerGroup.Stop()
+	if p.dlq != nil {
+		p.dlq.Stop()
+	}
 	p.rescheduler.Stop()
 	p.checkpointTimer.Stop()
 }
@@ -297,6 +450,33 @@ func (p *queueBase) Category() tasks.Category {
 	return p.category
 }
 
+// Describe returns the watermarks and backlog of the readers of the queue. The exclusive reader high watermark is
+// the one persisted by the last checkpoint.
+func (p *queueBase) Describe() *historyspb.HistoryQueueInfo {
+	exclusiveReaderHighWatermark := tasks.MinimumKey
+	if persistenceState, ok := p.shard.GetQueueState(p.category); ok && persistenceState.ExclusiveReaderHighWatermark != nil {
+		exclusiveReaderHighWatermark = FromPersistenceTaskKey(persistenceState.ExclusiveReaderHighWatermark)
+	}
+
+	now := p.timeSource.Now()
+	backlogs := newReaderBacklogs(p.readerGroup.Readers(), exclusiveReaderHighWatermark)
+	readers := make([]*historyspb.HistoryQueueReaderInfo, 0, len(backlogs))
+	for _, backlog := range backlogs {
+		readers = append(readers, &historyspb.HistoryQueueReaderInfo{
+			ReaderId:                   backlog.readerID,
+			InclusiveMinPendingTaskKey: toHistoryTaskKey(backlog.inclusiveMinPendingTaskKey),
+			BacklogCount:               backlog.count,
+			OldestTaskAge:              timestamp.DurationPtr(backlog.oldestTaskAge(now)),
+		})
+	}
+
+	return &historyspb.HistoryQueueInfo{
+		Category:                     enumsspb.TaskCategory(p.category.ID()),
+		ExclusiveReaderHighWatermark: toHistoryTaskKey(exclusiveReaderHighWatermark),
+		Readers:                      readers,
+	}
+}
+
 func (p *queueBase) FailoverNamespace(
 	namespaceID string,
 ) {
@@ -348,6 +528,7 @@ func (p *queueBase) checkpoint() {
 	p.readerGroup.ForEach(func(_ int64, r Reader) {
 		r.ShrinkSlices()
 	})
+	p.snapshotExecutables()
 
 	// Run slicePredicateAction to move slices with non-universal predicate to non-default reader
 	// so that upon shard reload, task loading for those slices won't block other slices in the default reader.
@@ -379,6 +560,7 @@ func (p *queueBase) checkpoint() {
 		Record(int64(p.monitor.GetTotalSliceCount()))
 	p.metricsHandler.Histogram(metrics.PendingTasksCounter.GetMetricName(), metrics.PendingTasksCounter.GetMetricUnit()).
 		Record(int64(p.monitor.GetTotalPendingTaskCount()))
+	p.recordReaderBacklogs()
 
 	p.updateReaderProgress(readerScopes)
 
@@ -388,8 +570,12 @@ func (p *queueBase) checkpoint() {
 	//
 	// Emit metric before the deletion watermark comparsion so we have the emit even if there's no task
 	// for the queue
+	//
+	// If range completion is deferred to coalesce it with later checkpoints,
+	// the deletion watermark is persisted along with the queue state instead,
+	// so that tasks acked so far are still deleted after a shard reload.
 	p.metricsHandler.Counter(metrics.TaskBatchCompleteCounter.GetMetricName()).Record(1)
-	if newExclusiveDeletionHighWatermark.CompareTo(p.exclusiveDeletionHighWatermark) > 0 {
+	if newExclusiveDeletionHighWatermark.CompareTo(p.exclusiveDeletionHighWatermark) > 0 && p.shouldRangeCompleteTasks() {
 		err := p.rangeCompleteTasks(p.exclusiveDeletionHighWatermark, newExclusiveDeletionHighWatermark)
 		if err != nil {
 			p.resetCheckpointTimer(err)
@@ -397,12 +583,54 @@ func (p *queueBase) checkpoint() {
 		}
 
 		p.exclusiveDeletionHighWatermark = newExclusiveDeletionHighWatermark
+		p.lastRangeCompleteTime = p.timeSource.Now()
+	}
+
+	var exclusiveDeletionHighWatermark *tasks.Key
+	if newExclusiveDeletionHighWatermark.CompareTo(p.exclusiveDeletionHighWatermark) > 0 {
+		lastExclusiveDeletionHighWatermark := p.exclusiveDeletionHighWatermark
+		exclusiveDeletionHighWatermark = &lastExclusiveDeletionHighWatermark
 	}
 
-	err := p.updateQueueState(readerScopes)
+	err := p.updateQueueState(readerScopes, exclusiveDeletionHighWatermark)
 	p.resetCheckpointTimer(err)
 }
 
+func (p *queueBase) shouldRangeCompleteTasks() bool {
+	if p.options.RangeCompleteInterval == nil {
+		return true
+	}
+	return !p.timeSource.Now().Before(p.lastRangeCompleteTime.Add(p.options.RangeCompleteInterval()))
+}
+
+func (p *queueBase) recordReaderBacklogs() {
+	now := p.timeSource.Now()
+	for _, backlog := range newReaderBacklogs(p.readerGroup.Readers(), p.nonReadableScope.Range.InclusiveMin) {
+		metricsHandler := p.metricsHandler.WithTags(metrics.QueueReaderIDTag(backlog.readerID))
+		metricsHandler.Histogram(metrics.QueueReaderBacklogCount.GetMetricName(), metrics.QueueReaderBacklogCount.GetMetricUnit()).
+			Record(backlog.count)
+		metricsHandler.Timer(metrics.QueueReaderBacklogAge.GetMetricName()).Record(backlog.oldestTaskAge(now))
+	}
+}
+
+func (p *queueBase) snapshotExecutables() {
+	if p.options.ExecutableSnapshotSink == nil || p.options.ExecutableSnapshotEnabled == nil || !p.options.ExecutableSnapshotEnabled() {
+		return
+	}
+
+	var snapshots []ExecutableSnapshot
+	p.readerGroup.ForEach(func(_ int64, r Reader) {
+		r.WalkSlices(func(s Slice) {
+			s.WalkExecutables(func(executable Executable) {
+				if isExecutableInFlight(executable) {
+					snapshots = append(snapshots, newExecutableSnapshot(executable))
+				}
+			})
+		})
+	})
+	p.options.ExecutableSnapshotSink.Write(p.category, snapshots)
+}
+
 func (p *queueBase) updateReaderProgress(
 	readerScopes map[int64][]Scope,
 ) {
@@ -475,6 +703,7 @@ func (p *queueBase) rangeCompleteTasks(
 
 func (p *queueBase) updateQueueState(
 	readerScopes map[int64][]Scope,
+	exclusiveDeletionHighWatermark *tasks.Key,
 ) error {
 	p.metricsHandler.Counter(metrics.AckLevelUpdateCounter.GetMetricName()).Record(1)
 	for readerID, scopes := range readerScopes {
@@ -484,8 +713,9 @@ func (p *queueBase) updateQueueState(
 	}
 
 	err := p.shard.SetQueueState(p.category, ToPersistenceQueueState(&queueState{
-		readerScopes:                 readerScopes,
-		exclusiveReaderHighWatermark: p.nonReadableScope.Range.InclusiveMin,
+		readerScopes:                   readerScopes,
+		exclusiveReaderHighWatermark:   p.nonReadableScope.Range.InclusiveMin,
+		exclusiveDeletionHighWatermark: exclusiveDeletionHighWatermark,
 	}))
 	if err != nil {
 		p.metricsHandler.Counter(metrics.AckLevelUpdateFailedCounter.GetMetricName()).Record(1)
@@ -36,9 +36,12 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/collection"
+	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
@@ -47,6 +50,7 @@ import (
 	"go.temporal.io/server/common/predicates"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/quotas"
+	ctasks "go.temporal.io/server/common/tasks"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tasks"
@@ -72,9 +76,10 @@ type (
 
 var testQueueOptions = &Options{
 	ReaderOptions: ReaderOptions{
-		BatchSize:            dynamicconfig.GetIntPropertyFn(10),
-		MaxPendingTasksCount: dynamicconfig.GetIntPropertyFn(100),
-		PollBackoffInterval:  dynamicconfig.GetDurationPropertyFn(200 * time.Millisecond),
+		BatchSize:                 dynamicconfig.GetIntPropertyFn(10),
+		MaxPendingTasksCount:      dynamicconfig.GetIntPropertyFn(100),
+		PollBackoffInterval:       dynamicconfig.GetDurationPropertyFn(200 * time.Millisecond),
+		LowPriorityAdmissionDelay: dynamicconfig.GetDurationPropertyFn(0),
 	},
 	MonitorOptions: MonitorOptions{
 		PendingTasksCriticalCount:   dynamicconfig.GetIntPropertyFn(1000),
@@ -344,6 +349,7 @@ func (s *queueBaseSuite) TestStartStop() {
 			key := NewRandomKeyInRange(paginationRange)
 			mockTask.EXPECT().GetKey().Return(key).AnyTimes()
 			mockTask.EXPECT().GetNamespaceID().Return(uuid.New()).AnyTimes()
+			mockTask.EXPECT().GetCategory().Return(tasks.CategoryTimer).AnyTimes()
 			return []tasks.Task{mockTask}, nil, nil
 		}
 	}
@@ -591,6 +597,93 @@ func (s *queueBaseSuite) TestCheckPoint_NoPendingTasks() {
 	s.True(exclusiveReaderHighWatermark.CompareTo(base.exclusiveDeletionHighWatermark) == 0)
 }
 
+func (s *queueBaseSuite) TestCheckPoint_DeferRangeComplete() {
+	exclusiveReaderHighWatermark := NewRandomKey()
+	initialQueueState := &queueState{
+		readerScopes:                 map[int64][]Scope{},
+		exclusiveReaderHighWatermark: exclusiveReaderHighWatermark,
+	}
+	persistenceState := ToPersistenceQueueState(initialQueueState)
+
+	mockShard := shard.NewTestContext(
+		s.controller,
+		&persistencespb.ShardInfo{
+			ShardId: 0,
+			RangeId: 10,
+			QueueStates: map[int32]*persistencespb.QueueState{
+				tasks.CategoryIDTimer: persistenceState,
+			},
+		},
+		s.config,
+	)
+	mockShard.Resource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	mockShard.Resource.ClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
+
+	options := *s.options
+	options.RangeCompleteInterval = dynamicconfig.GetDurationPropertyFn(time.Minute)
+	newQueueBase := func() *queueBase {
+		base := newQueueBase(
+			mockShard,
+			tasks.CategoryTimer,
+			nil,
+			s.mockScheduler,
+			s.mockRescheduler,
+			NewNoopPriorityAssigner(),
+			nil,
+			&options,
+			s.rateLimiter,
+			NoopReaderCompletionFn,
+			s.logger,
+			s.metricsHandler,
+		)
+		base.checkpointTimer = time.NewTimer(options.CheckpointInterval())
+		return base
+	}
+
+	base := newQueueBase()
+
+	// tasks were range completed recently and there's pending deletion
+	currentLowWatermark := tasks.MinimumKey
+	base.exclusiveDeletionHighWatermark = currentLowWatermark
+	base.lastRangeCompleteTime = base.timeSource.Now()
+
+	expectedQueueState := &queueState{
+		readerScopes:                   map[int64][]Scope{},
+		exclusiveReaderHighWatermark:   exclusiveReaderHighWatermark,
+		exclusiveDeletionHighWatermark: &currentLowWatermark,
+	}
+	mockShard.Resource.ShardMgr.EXPECT().UpdateShard(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.UpdateShardRequest) error {
+			s.QueueStateEqual(ToPersistenceQueueState(expectedQueueState), request.ShardInfo.QueueStates[tasks.CategoryIDTimer])
+			return nil
+		},
+	).Times(1)
+
+	base.checkpoint()
+
+	s.True(currentLowWatermark.CompareTo(base.exclusiveDeletionHighWatermark) == 0)
+
+	// upon shard reload, deletion resumes from the persisted deletion watermark
+	// instead of skipping tasks acked but not yet deleted
+	base = newQueueBase()
+	s.True(currentLowWatermark.CompareTo(base.exclusiveDeletionHighWatermark) == 0)
+
+	mockShard.Resource.ExecutionMgr.EXPECT().RangeCompleteHistoryTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *persistence.RangeCompleteHistoryTasksRequest) error {
+			s.True(request.InclusiveMinTaskKey.FireTime.Equal(currentLowWatermark.FireTime))
+			s.True(request.ExclusiveMaxTaskKey.FireTime.Equal(exclusiveReaderHighWatermark.FireTime))
+			return nil
+		},
+	).Times(1)
+
+	base.checkpoint()
+
+	s.True(exclusiveReaderHighWatermark.CompareTo(base.exclusiveDeletionHighWatermark) == 0)
+	queueState, ok := mockShard.GetQueueState(tasks.CategoryTimer)
+	s.True(ok)
+	s.QueueStateEqual(persistenceState, queueState)
+}
+
 func (s *queueBaseSuite) TestCheckPoint_MoveSlices() {
 	exclusiveReaderHighWatermark := tasks.MaximumKey
 	scopes := NewRandomScopes(3)
@@ -733,6 +826,286 @@ func (s *queueBaseSuite) TestUpdateReaderProgress() {
 	}, readerProgress)
 }
 
+func (s *queueBaseSuite) TestSnapshotExecutables() {
+	mockShard := shard.NewTestContext(
+		s.controller,
+		&persistencespb.ShardInfo{
+			ShardId: 0,
+			RangeId: 10,
+		},
+		s.config,
+	)
+	mockShard.Resource.ExecutionMgr.EXPECT().RegisterHistoryTaskReader(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	sink := &testExecutableSnapshotSink{}
+	options := *s.options
+	options.ExecutableSnapshotSink = sink
+	options.ExecutableSnapshotEnabled = dynamicconfig.GetBoolPropertyFn(true)
+
+	base := newQueueBase(
+		mockShard,
+		tasks.CategoryTransfer,
+		nil,
+		s.mockScheduler,
+		s.mockRescheduler,
+		NewNoopPriorityAssigner(),
+		nil,
+		&options,
+		s.rateLimiter,
+		NoopReaderCompletionFn,
+		s.logger,
+		s.metricsHandler,
+	)
+
+	scheduledTime := time.Now().UTC()
+	pending := s.newMockExecutable(1, ctasks.TaskStatePending, scheduledTime)
+	nacked := s.newMockExecutable(2, ctasks.TaskStateNacked, scheduledTime)
+	acked := s.newMockExecutable(3, ctasks.TaskStateAcked, scheduledTime)
+	cancelled := s.newMockExecutable(4, ctasks.TaskStateCancelled, scheduledTime)
+
+	slice := NewSlice(nil, nil, base.monitor, NewScope(NewRange(tasks.MinimumKey, tasks.MaximumKey), predicates.Universal[tasks.Task]()))
+	for _, executable := range []Executable{pending, nacked, acked, cancelled} {
+		slice.executableTracker.add(executable)
+	}
+	_, err := base.readerGroup.NewReader(DefaultReaderId, slice)
+	s.NoError(err)
+
+	base.snapshotExecutables()
+
+	s.Len(sink.snapshots, 1)
+	s.ElementsMatch([]ExecutableSnapshot{
+		newExecutableSnapshot(pending),
+		newExecutableSnapshot(nacked),
+	}, sink.snapshots[0])
+	s.Equal(ExecutableSnapshot{
+		WorkflowKey:   definition.NewWorkflowKey(tests.NamespaceID.String(), tests.WorkflowID, tests.RunID),
+		Category:      tasks.CategoryTransfer,
+		TaskKey:       tasks.NewImmediateKey(1),
+		Attempt:       3,
+		State:         ctasks.TaskStatePending,
+		Priority:      ctasks.PriorityLow,
+		ScheduledTime: scheduledTime,
+	}, newExecutableSnapshot(pending))
+
+	// No snapshot is taken while disabled
+	options.ExecutableSnapshotEnabled = dynamicconfig.GetBoolPropertyFn(false)
+	base.snapshotExecutables()
+	s.Len(sink.snapshots, 1)
+}
+
+func (s *queueBaseSuite) TestDescribe() {
+	exclusiveReaderHighWatermark := tasks.NewImmediateKey(100)
+	queueState := &queueState{
+		readerScopes:                 map[int64][]Scope{},
+		exclusiveReaderHighWatermark: exclusiveReaderHighWatermark,
+	}
+
+	now := time.Now().UTC()
+	mockShard := shard.NewTestContextWithTimeSource(
+		s.controller,
+		&persistencespb.ShardInfo{
+			ShardId: 0,
+			RangeId: 10,
+			QueueStates: map[int32]*persistencespb.QueueState{
+				tasks.CategoryIDTransfer: ToPersistenceQueueState(queueState),
+			},
+		},
+		s.config,
+		clock.NewEventTimeSource().Update(now),
+	)
+	mockShard.Resource.ExecutionMgr.EXPECT().RegisterHistoryTaskReader(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	base := newQueueBase(
+		mockShard,
+		tasks.CategoryTransfer,
+		nil,
+		s.mockScheduler,
+		s.mockRescheduler,
+		NewNoopPriorityAssigner(),
+		nil,
+		s.options,
+		s.rateLimiter,
+		NoopReaderCompletionFn,
+		s.logger,
+		s.metricsHandler,
+	)
+
+	pending := s.newMockExecutable(10, ctasks.TaskStatePending, now)
+	pending.EXPECT().GetVisibilityTime().Return(now.Add(-time.Minute)).AnyTimes()
+	nacked := s.newMockExecutable(20, ctasks.TaskStateNacked, now)
+	nacked.EXPECT().GetVisibilityTime().Return(now.Add(-2 * time.Minute)).AnyTimes()
+	acked := s.newMockExecutable(5, ctasks.TaskStateAcked, now)
+	acked.EXPECT().GetVisibilityTime().Return(now.Add(-time.Hour)).AnyTimes()
+
+	slice := NewSlice(nil, nil, base.monitor, NewScope(NewRange(tasks.NewImmediateKey(5), tasks.NewImmediateKey(50)), predicates.Universal[tasks.Task]()))
+	for _, executable := range []Executable{pending, nacked, acked} {
+		slice.executableTracker.add(executable)
+	}
+	_, err := base.readerGroup.NewReader(DefaultReaderId, slice)
+	s.NoError(err)
+	_, err = base.readerGroup.NewReader(
+		DefaultReaderId+1,
+		NewSlice(nil, nil, base.monitor, NewScope(NewRange(tasks.NewImmediateKey(60), tasks.NewImmediateKey(70)), predicates.Universal[tasks.Task]())),
+	)
+	s.NoError(err)
+
+	s.Equal(&historyspb.HistoryQueueInfo{
+		Category:                     enumsspb.TASK_CATEGORY_TRANSFER,
+		ExclusiveReaderHighWatermark: toHistoryTaskKey(exclusiveReaderHighWatermark),
+		Readers: []*historyspb.HistoryQueueReaderInfo{
+			{
+				ReaderId:                   DefaultReaderId,
+				InclusiveMinPendingTaskKey: toHistoryTaskKey(tasks.NewImmediateKey(5)),
+				BacklogCount:               2,
+				OldestTaskAge:              timestamp.DurationPtr(2 * time.Minute),
+			},
+			{
+				ReaderId:                   DefaultReaderId + 1,
+				InclusiveMinPendingTaskKey: toHistoryTaskKey(tasks.NewImmediateKey(5)),
+				BacklogCount:               0,
+				OldestTaskAge:              timestamp.DurationPtr(0),
+			},
+		},
+	}, base.Describe())
+}
+
+func (s *queueBaseSuite) TestExecutableInitializer_ShardReloading() {
+	mockShard := shard.NewTestContext(
+		s.controller,
+		&persistencespb.ShardInfo{
+			ShardId: 0,
+			RangeId: 10,
+		},
+		s.config,
+	)
+
+	base := newQueueBase(
+		mockShard,
+		tasks.CategoryTransfer,
+		nil,
+		s.mockScheduler,
+		s.mockRescheduler,
+		NewNoopPriorityAssigner(),
+		nil,
+		s.options,
+		s.rateLimiter,
+		NoopReaderCompletionFn,
+		s.logger,
+		s.metricsHandler,
+	)
+
+	executable := base.executableInitializer(DefaultReaderId, &tasks.ActivityTask{
+		WorkflowKey: definition.NewWorkflowKey(tests.NamespaceID.String(), tests.WorkflowID, tests.RunID),
+		TaskID:      1,
+	}).(*executableImpl)
+	s.False(executable.isShardReloading())
+
+	mockShard.StopForTest()
+	s.True(executable.isShardReloading())
+}
+
+func (s *queueBaseSuite) TestExecutableInitializer_StandbyTaskMinClock() {
+	now := time.Now().UTC()
+	mockShard := shard.NewTestContextWithTimeSource(
+		s.controller,
+		&persistencespb.ShardInfo{
+			ShardId: 0,
+			RangeId: 10,
+		},
+		s.config,
+		clock.NewEventTimeSource().Update(now),
+	)
+	mockShard.Resource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	mockShard.Resource.ClusterMetadata.EXPECT().GetClusterID().Return(cluster.TestCurrentClusterInitialFailoverVersion).AnyTimes()
+	mockShard.Resource.NamespaceCache.EXPECT().GetNamespaceByID(tests.StandbyNamespaceID).Return(tests.GlobalStandbyNamespaceEntry, nil).AnyTimes()
+
+	options := *s.options
+	options.StandbyTaskMinClockEnabled = dynamicconfig.GetBoolPropertyFn(true)
+	base := newQueueBase(
+		mockShard,
+		tasks.CategoryTransfer,
+		nil,
+		s.mockScheduler,
+		s.mockRescheduler,
+		NewNoopPriorityAssigner(),
+		nil,
+		&options,
+		s.rateLimiter,
+		NoopReaderCompletionFn,
+		s.logger,
+		s.metricsHandler,
+	)
+
+	executable := base.executableInitializer(DefaultReaderId, &tasks.ActivityTask{
+		WorkflowKey:         definition.NewWorkflowKey(tests.StandbyNamespaceID.String(), tests.WorkflowID, tests.RunID),
+		VisibilityTimestamp: now,
+		TaskID:              1,
+	}).(*executableImpl)
+	s.False(executable.minClockReachedLocked())
+
+	mockShard.SetCurrentTime(cluster.TestAlternativeClusterName, now.Add(-time.Second))
+	s.False(executable.minClockReachedLocked())
+
+	mockShard.SetCurrentTime(cluster.TestAlternativeClusterName, now)
+	s.True(executable.minClockReachedLocked())
+}
+
+func (s *queueBaseSuite) TestExecutableInitializer_SequentialExecution() {
+	mockShard := shard.NewTestContext(
+		s.controller,
+		&persistencespb.ShardInfo{
+			ShardId: 0,
+			RangeId: 10,
+		},
+		s.config,
+	)
+
+	options := *s.options
+	options.SequentialExecutionEnabled = dynamicconfig.GetBoolPropertyFn(true)
+	base := newQueueBase(
+		mockShard,
+		tasks.CategoryTransfer,
+		nil,
+		s.mockScheduler,
+		s.mockRescheduler,
+		NewNoopPriorityAssigner(),
+		nil,
+		&options,
+		s.rateLimiter,
+		NoopReaderCompletionFn,
+		s.logger,
+		s.metricsHandler,
+	)
+
+	workflowKey := definition.NewWorkflowKey(tests.NamespaceID.String(), tests.WorkflowID, tests.RunID)
+	first := base.executableInitializer(DefaultReaderId, &tasks.ActivityTask{WorkflowKey: workflowKey, TaskID: 1}).(*executableImpl)
+	second := base.executableInitializer(DefaultReaderId, &tasks.ActivityTask{WorkflowKey: workflowKey, TaskID: 2}).(*executableImpl)
+	s.Same(first.sequencer, second.sequencer)
+	s.True(first.sequencer.isNext(first))
+	s.False(second.sequencer.isNext(second))
+
+	first.Ack()
+	s.True(second.sequencer.isNext(second))
+}
+
+func (s *queueBaseSuite) newMockExecutable(
+	taskID int64,
+	state ctasks.State,
+	scheduledTime time.Time,
+) *MockExecutable {
+	executable := NewMockExecutable(s.controller)
+	executable.EXPECT().GetKey().Return(tasks.NewImmediateKey(taskID)).AnyTimes()
+	executable.EXPECT().GetNamespaceID().Return(tests.NamespaceID.String()).AnyTimes()
+	executable.EXPECT().GetWorkflowID().Return(tests.WorkflowID).AnyTimes()
+	executable.EXPECT().GetRunID().Return(tests.RunID).AnyTimes()
+	executable.EXPECT().GetCategory().Return(tasks.CategoryTransfer).AnyTimes()
+	executable.EXPECT().Attempt().Return(3).AnyTimes()
+	executable.EXPECT().State().Return(state).AnyTimes()
+	executable.EXPECT().GetPriority().Return(ctasks.PriorityLow).AnyTimes()
+	executable.EXPECT().GetScheduledTime().Return(scheduledTime).AnyTimes()
+	return executable
+}
+
 func (s *queueBaseSuite) QueueStateEqual(
 	this *persistencespb.QueueState,
 	that *persistencespb.QueueState,
@@ -750,3 +1123,11 @@ func (s *queueBaseSuite) QueueStateEqual(
 
 	s.Equal(this, that)
 }
+
+type testExecutableSnapshotSink struct {
+	snapshots [][]ExecutableSnapshot
+}
+
+func (s *testExecutableSnapshotSink) Write(_ tasks.Category, snapshots []ExecutableSnapshot) {
+	s.snapshots = append(s.snapshots, snapshots)
+}
@@ -32,6 +32,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	history "go.temporal.io/server/api/history/v1"
 	tasks "go.temporal.io/server/service/history/tasks"
 )
 
@@ -72,6 +73,20 @@ func (mr *MockQueueMockRecorder) Category() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Category", reflect.TypeOf((*MockQueue)(nil).Category))
 }
 
+// Describe mocks base method.
+func (m *MockQueue) Describe() *history.HistoryQueueInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Describe")
+	ret0, _ := ret[0].(*history.HistoryQueueInfo)
+	return ret0
+}
+
+// Describe indicates an expected call of Describe.
+func (mr *MockQueueMockRecorder) Describe() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Describe", reflect.TypeOf((*MockQueue)(nil).Describe))
+}
+
 // FailoverNamespace mocks base method.
 func (m *MockQueue) FailoverNamespace(namespaceID string) {
 	m.ctrl.T.Helper()
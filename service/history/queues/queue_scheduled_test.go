@@ -104,6 +104,7 @@ func (s *scheduledQueueSuite) SetupTest() {
 		s.mockShard.GetTimeSource(),
 		log.NewTestLogger(),
 		metrics.NoopMetricsHandler,
+		dynamicconfig.GetDurationPropertyFn(0),
 	)
 
 	s.scheduledQueue = NewScheduledQueue(
@@ -41,12 +41,18 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/quotas"
+	ctasks "go.temporal.io/server/common/tasks"
 )
 
 var (
 	throttleRetryDelay = 3 * time.Second
 )
 
+const (
+	// submitTraceLogRPS caps the number of submission trace events emitted per reader
+	submitTraceLogRPS = 1
+)
+
 type (
 	Reader interface {
 		common.Daemon
@@ -69,6 +75,10 @@ type (
 		BatchSize            dynamicconfig.IntPropertyFn
 		MaxPendingTasksCount dynamicconfig.IntPropertyFn
 		PollBackoffInterval  dynamicconfig.DurationPropertyFn
+		// LowPriorityAdmissionDelay is how long low priority executables are held back before being submitted
+		// when the queue has MaxPendingTasksCount pending tasks. The delay scales linearly with the number of
+		// pending tasks, so that high priority executables get the scheduler first while the queue is busy.
+		LowPriorityAdmissionDelay dynamicconfig.DurationPropertyFn
 	}
 
 	SliceIterator func(s Slice)
@@ -91,6 +101,7 @@ type (
 		monitor        Monitor
 		completionFn   ReaderCompletionFn
 		logger         log.Logger
+		traceLogger    log.Logger
 		metricsHandler metrics.Handler
 
 		status     int32
@@ -134,6 +145,7 @@ func NewReader(
 	}
 	monitor.SetSliceCount(readerID, len(slices))
 
+	logger = log.With(logger, tag.QueueReaderID(readerID))
 	rateLimitContext, rateLimitContextCancel := context.WithCancel(context.Background())
 	return &ReaderImpl{
 		readerID:       readerID,
@@ -144,7 +156,8 @@ func NewReader(
 		ratelimiter:    ratelimiter,
 		monitor:        monitor,
 		completionFn:   completionFn,
-		logger:         log.With(logger, tag.QueueReaderID(readerID)),
+		logger:         logger,
+		traceLogger:    log.NewThrottledLogger(logger, func() float64 { return submitTraceLogRPS }),
 		metricsHandler: metricsHandler,
 
 		status:     common.DaemonStatusInitialized,
@@ -535,11 +548,42 @@ func (r *ReaderImpl) submit(
 	}
 
 	executable.SetScheduledTime(now)
+	if delay := r.admissionDelay(executable); delay > 0 {
+		r.rescheduler.Add(executable, now.Add(delay))
+		return
+	}
+
+	category := executable.GetCategory()
+	r.traceLogger.Debug("Submitting task to scheduler",
+		tag.TaskPriority(executable.GetPriority().String()),
+		tag.TaskCategory(category.Name()),
+		tag.WorkflowNamespaceID(executable.GetNamespaceID()),
+	)
 	if !r.scheduler.TrySubmit(executable) {
 		executable.Reschedule()
 	}
 }
 
+// admissionDelay returns how long the executable should wait before being submitted to the scheduler, proportional
+// to the number of pending tasks in the queue. Only low priority executables are delayed.
+func (r *ReaderImpl) admissionDelay(
+	executable Executable,
+) time.Duration {
+	if executable.GetPriority() != ctasks.PriorityLow {
+		return 0
+	}
+	maxDelay := r.options.LowPriorityAdmissionDelay()
+	maxPendingTasks := r.options.MaxPendingTasksCount()
+	if maxDelay <= 0 || maxPendingTasks <= 0 {
+		return 0
+	}
+	pendingTasks := r.monitor.GetTotalPendingTaskCount()
+	if pendingTasks >= maxPendingTasks {
+		return maxDelay
+	}
+	return time.Duration(int64(maxDelay) * int64(pendingTasks) / int64(maxPendingTasks))
+}
+
 func (r *ReaderImpl) verifyPendingTaskSize() bool {
 	return r.monitor.GetTotalPendingTaskCount() < r.options.MaxPendingTasksCount()
 }
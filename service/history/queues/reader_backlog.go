@@ -0,0 +1,98 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queues
+
+import (
+	"time"
+
+	"golang.org/x/exp/slices"
+
+	ctasks "go.temporal.io/server/common/tasks"
+	"go.temporal.io/server/service/history/tasks"
+)
+
+type (
+	// readerBacklog is the backlog of a queue reader, i.e. the tasks it loaded but which are not yet acked.
+	readerBacklog struct {
+		readerID                   int64
+		inclusiveMinPendingTaskKey tasks.Key
+		count                      int64
+		// oldestTaskTime is the visibility time of the oldest task in the backlog,
+		// zero if the backlog is empty.
+		oldestTaskTime time.Time
+	}
+)
+
+// newReaderBacklogs computes the backlog of all readers of a queue. Similar to queueBase.updateReaderProgress,
+// the progress of a reader is capped by the progress of the readers with lower IDs, since slices only move from
+// lower to higher reader IDs.
+func newReaderBacklogs(
+	readers map[int64]Reader,
+	exclusiveReaderHighWatermark tasks.Key,
+) []readerBacklog {
+	readerIDs := make([]int64, 0, len(readers))
+	for readerID := range readers {
+		readerIDs = append(readerIDs, readerID)
+	}
+	slices.Sort(readerIDs)
+
+	backlogs := make([]readerBacklog, 0, len(readerIDs))
+	progress := tasks.MaximumKey
+	for _, readerID := range readerIDs {
+		reader := readers[readerID]
+
+		minKey := exclusiveReaderHighWatermark
+		if scopes := reader.Scopes(); len(scopes) != 0 {
+			minKey = scopes[0].Range.InclusiveMin
+		}
+		progress = tasks.MinKey(progress, minKey)
+
+		backlog := readerBacklog{
+			readerID:                   readerID,
+			inclusiveMinPendingTaskKey: progress,
+		}
+		reader.WalkSlices(func(s Slice) {
+			s.WalkExecutables(func(executable Executable) {
+				if executable.State() == ctasks.TaskStateAcked {
+					return
+				}
+				backlog.count++
+				visibilityTime := executable.GetVisibilityTime()
+				if backlog.oldestTaskTime.IsZero() || visibilityTime.Before(backlog.oldestTaskTime) {
+					backlog.oldestTaskTime = visibilityTime
+				}
+			})
+		})
+		backlogs = append(backlogs, backlog)
+	}
+	return backlogs
+}
+
+func (b readerBacklog) oldestTaskAge(now time.Time) time.Duration {
+	if b.oldestTaskTime.IsZero() || now.Before(b.oldestTaskTime) {
+		return 0
+	}
+	return now.Sub(b.oldestTaskTime)
+}
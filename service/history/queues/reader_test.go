@@ -39,9 +39,11 @@ import (
 	"go.temporal.io/server/common/collection"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/predicates"
+	ctasks "go.temporal.io/server/common/tasks"
 	"go.temporal.io/server/service/history/tasks"
 )
 
@@ -77,7 +79,7 @@ func (s *readerSuite) SetupTest() {
 	s.metricsHandler = metrics.NoopMetricsHandler
 
 	s.executableInitializer = func(readerID int64, t tasks.Task) Executable {
-		return NewExecutable(readerID, t, nil, nil, nil, NewNoopPriorityAssigner(), clock.NewRealTimeSource(), nil, nil, nil, metrics.NoopMetricsHandler)
+		return NewExecutable(readerID, t, nil, nil, nil, NewNoopPriorityAssigner(), clock.NewRealTimeSource(), nil, nil, nil, nil, nil, metrics.NoopMetricsHandler)
 	}
 	s.monitor = newMonitor(tasks.CategoryTypeScheduled, clock.NewRealTimeSource(), &MonitorOptions{
 		PendingTasksCriticalCount:   dynamicconfig.GetIntPropertyFn(1000),
@@ -100,6 +102,7 @@ func (s *readerSuite) TestStartLoadStop() {
 			mockTask := tasks.NewMockTask(s.controller)
 			mockTask.EXPECT().GetKey().Return(NewRandomKeyInRange(r)).AnyTimes()
 			mockTask.EXPECT().GetNamespaceID().Return(uuid.New()).AnyTimes()
+			mockTask.EXPECT().GetCategory().Return(tasks.CategoryTimer).AnyTimes()
 			return []tasks.Task{mockTask}, nil, nil
 		}
 	}
@@ -279,6 +282,7 @@ func (s *readerSuite) TestPause() {
 			mockTask := tasks.NewMockTask(s.controller)
 			mockTask.EXPECT().GetKey().Return(NewRandomKeyInRange(scopes[0].Range)).AnyTimes()
 			mockTask.EXPECT().GetNamespaceID().Return(uuid.New()).AnyTimes()
+			mockTask.EXPECT().GetCategory().Return(tasks.CategoryTimer).AnyTimes()
 			return []tasks.Task{mockTask}, nil, nil
 		}
 	}
@@ -348,6 +352,7 @@ func (s *readerSuite) TestLoadAndSubmitTasks_MoreTasks() {
 				mockTask := tasks.NewMockTask(s.controller)
 				mockTask.EXPECT().GetKey().Return(NewRandomKeyInRange(scopes[0].Range)).AnyTimes()
 				mockTask.EXPECT().GetNamespaceID().Return(uuid.New()).AnyTimes()
+				mockTask.EXPECT().GetCategory().Return(tasks.CategoryTimer).AnyTimes()
 				result = append(result, mockTask)
 			}
 
@@ -383,6 +388,7 @@ func (s *readerSuite) TestLoadAndSubmitTasks_NoMoreTasks_HasNextSlice() {
 			mockTask := tasks.NewMockTask(s.controller)
 			mockTask.EXPECT().GetKey().Return(NewRandomKeyInRange(scopes[0].Range)).AnyTimes()
 			mockTask.EXPECT().GetNamespaceID().Return(uuid.New()).AnyTimes()
+			mockTask.EXPECT().GetCategory().Return(tasks.CategoryTimer).AnyTimes()
 			return []tasks.Task{mockTask}, nil, nil
 		}
 	}
@@ -415,6 +421,7 @@ func (s *readerSuite) TestLoadAndSubmitTasks_NoMoreTasks_NoNextSlice() {
 			mockTask := tasks.NewMockTask(s.controller)
 			mockTask.EXPECT().GetKey().Return(NewRandomKeyInRange(scopes[0].Range)).AnyTimes()
 			mockTask.EXPECT().GetNamespaceID().Return(uuid.New()).AnyTimes()
+			mockTask.EXPECT().GetCategory().Return(tasks.CategoryTimer).AnyTimes()
 			return []tasks.Task{mockTask}, nil, nil
 		}
 	}
@@ -450,6 +457,9 @@ func (s *readerSuite) TestSubmitTask() {
 	reader := s.newTestReader(scopes, nil, NoopReaderCompletionFn)
 
 	mockExecutable := NewMockExecutable(s.controller)
+	mockExecutable.EXPECT().GetPriority().Return(ctasks.PriorityHigh).AnyTimes()
+	mockExecutable.EXPECT().GetCategory().Return(tasks.CategoryTransfer).AnyTimes()
+	mockExecutable.EXPECT().GetNamespaceID().Return(uuid.New()).AnyTimes()
 
 	pastFireTime := reader.timeSource.Now().Add(-time.Minute)
 	mockExecutable.EXPECT().GetKey().Return(tasks.NewKey(pastFireTime, rand.Int63())).Times(1)
@@ -469,6 +479,86 @@ func (s *readerSuite) TestSubmitTask() {
 	reader.submit(mockExecutable)
 }
 
+func (s *readerSuite) TestSubmitTask_LowPriorityAdmissionDelay() {
+	r := NewRandomRange()
+	scopes := []Scope{NewScope(r, predicates.Universal[tasks.Task]())}
+	reader := s.newTestReader(scopes, nil, NoopReaderCompletionFn)
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	reader.timeSource = timeSource
+	maxDelay := 10 * time.Second
+	reader.options.LowPriorityAdmissionDelay = dynamicconfig.GetDurationPropertyFn(maxDelay)
+
+	newExecutable := func(priority ctasks.Priority) *MockExecutable {
+		mockExecutable := NewMockExecutable(s.controller)
+		mockExecutable.EXPECT().GetPriority().Return(priority).AnyTimes()
+		mockExecutable.EXPECT().GetCategory().Return(tasks.CategoryTransfer).AnyTimes()
+		mockExecutable.EXPECT().GetNamespaceID().Return(uuid.New()).AnyTimes()
+		mockExecutable.EXPECT().GetKey().Return(tasks.NewKey(timeSource.Now().Add(-time.Minute), rand.Int63())).AnyTimes()
+		mockExecutable.EXPECT().SetScheduledTime(timeSource.Now()).Times(1)
+		return mockExecutable
+	}
+
+	// Idle queue, nothing is delayed
+	lowPriorityExecutable := newExecutable(ctasks.PriorityLow)
+	s.mockScheduler.EXPECT().TrySubmit(lowPriorityExecutable).Return(true).Times(1)
+	reader.submit(lowPriorityExecutable)
+
+	// Simulate a queue at half of its capacity
+	slice := reader.slices.Front().Value.(Slice)
+	s.monitor.SetSlicePendingTaskCount(slice, reader.options.MaxPendingTasksCount()/2)
+
+	highPriorityExecutable := newExecutable(ctasks.PriorityHigh)
+	s.mockScheduler.EXPECT().TrySubmit(highPriorityExecutable).Return(true).Times(1)
+	reader.submit(highPriorityExecutable)
+
+	lowPriorityExecutable = newExecutable(ctasks.PriorityLow)
+	s.mockRescheduler.EXPECT().Add(lowPriorityExecutable, timeSource.Now().Add(maxDelay/2)).Times(1)
+	reader.submit(lowPriorityExecutable)
+
+	// Saturated queue, the delay is capped
+	s.monitor.SetSlicePendingTaskCount(slice, reader.options.MaxPendingTasksCount()*2)
+
+	highPriorityExecutable = newExecutable(ctasks.PriorityHigh)
+	s.mockScheduler.EXPECT().TrySubmit(highPriorityExecutable).Return(true).Times(1)
+	reader.submit(highPriorityExecutable)
+
+	lowPriorityExecutable = newExecutable(ctasks.PriorityLow)
+	s.mockRescheduler.EXPECT().Add(lowPriorityExecutable, timeSource.Now().Add(maxDelay)).Times(1)
+	reader.submit(lowPriorityExecutable)
+}
+
+func (s *readerSuite) TestSubmitTask_TraceEvent() {
+	mockLogger := log.NewMockLogger(s.controller)
+	s.logger = mockLogger
+
+	r := NewRandomRange()
+	scopes := []Scope{NewScope(r, predicates.Universal[tasks.Task]())}
+	reader := s.newTestReader(scopes, nil, NoopReaderCompletionFn)
+
+	namespaceID := uuid.New()
+	mockExecutable := NewMockExecutable(s.controller)
+	mockExecutable.EXPECT().GetKey().Return(tasks.NewKey(reader.timeSource.Now().Add(-time.Minute), rand.Int63())).Times(1)
+	mockExecutable.EXPECT().SetScheduledTime(gomock.Any()).Times(1)
+	mockExecutable.EXPECT().GetPriority().Return(ctasks.PriorityLow).AnyTimes()
+	mockExecutable.EXPECT().GetCategory().Return(tasks.CategoryTimer).AnyTimes()
+	mockExecutable.EXPECT().GetNamespaceID().Return(namespaceID).AnyTimes()
+	s.mockScheduler.EXPECT().TrySubmit(mockExecutable).Return(true).Times(1)
+
+	loggedTags := make(map[string]interface{})
+	mockLogger.EXPECT().Debug(gomock.Any(), gomock.Any()).Do(func(msg string, tags ...tag.Tag) {
+		for _, t := range tags {
+			loggedTags[t.Key()] = t.Value()
+		}
+	}).Times(1)
+
+	reader.submit(mockExecutable)
+
+	s.Equal(DefaultReaderId, loggedTags[tag.QueueReaderID(0).Key()])
+	s.Equal(ctasks.PriorityLow.String(), loggedTags[tag.TaskPriority("").Key()])
+	s.Equal(tasks.CategoryTimer.Name(), loggedTags[tag.TaskCategory("").Key()])
+	s.Equal(namespaceID, loggedTags[tag.WorkflowNamespaceID("").Key()])
+}
+
 func (s *readerSuite) validateSlicesOrdered(
 	reader Reader,
 ) {
@@ -497,9 +587,10 @@ func (s *readerSuite) newTestReader(
 		DefaultReaderId,
 		slices,
 		&ReaderOptions{
-			BatchSize:            dynamicconfig.GetIntPropertyFn(10),
-			MaxPendingTasksCount: dynamicconfig.GetIntPropertyFn(100),
-			PollBackoffInterval:  dynamicconfig.GetDurationPropertyFn(200 * time.Millisecond),
+			BatchSize:                 dynamicconfig.GetIntPropertyFn(10),
+			MaxPendingTasksCount:      dynamicconfig.GetIntPropertyFn(100),
+			PollBackoffInterval:       dynamicconfig.GetDurationPropertyFn(200 * time.Millisecond),
+			LowPriorityAdmissionDelay: dynamicconfig.GetDurationPropertyFn(0),
 		},
 		s.mockScheduler,
 		s.mockRescheduler,
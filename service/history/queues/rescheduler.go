@@ -35,6 +35,7 @@ import (
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/collection"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
@@ -77,6 +78,7 @@ type (
 		timeSource     clock.TimeSource
 		logger         log.Logger
 		metricsHandler metrics.Handler
+		flushInterval  dynamicconfig.DurationPropertyFn
 
 		status     int32
 		shutdownCh chan struct{}
@@ -91,17 +93,23 @@ type (
 	}
 )
 
+// NewRescheduler creates a Rescheduler. Due reschedules are re-submitted at
+// the granularity given by flushInterval: reschedule times are rounded up to
+// the next multiple of the interval so that nearby reschedules are flushed
+// together. A non-positive interval re-submits each task at its exact time.
 func NewRescheduler(
 	scheduler Scheduler,
 	timeSource clock.TimeSource,
 	logger log.Logger,
 	metricsHandler metrics.Handler,
+	flushInterval dynamicconfig.DurationPropertyFn,
 ) *reschedulerImpl {
 	return &reschedulerImpl{
 		scheduler:      scheduler,
 		timeSource:     timeSource,
 		logger:         logger,
 		metricsHandler: metricsHandler,
+		flushInterval:  flushInterval,
 
 		status:     common.DaemonStatusInitialized,
 		shutdownCh: make(chan struct{}),
@@ -150,7 +158,7 @@ func (r *reschedulerImpl) Add(
 		rescheduleTime: rescheduleTime,
 	})
 	r.numExecutables++
-	r.timerGate.Update(rescheduleTime)
+	r.timerGate.Update(r.flushTime(rescheduleTime))
 	r.Unlock()
 
 	if r.isStopped() {
@@ -234,7 +242,7 @@ func (r *reschedulerImpl) reschedule() {
 			rescheduled := pq.Peek()
 
 			if rescheduleTime := rescheduled.rescheduleTime; now.Before(rescheduleTime) {
-				r.timerGate.Update(rescheduleTime)
+				r.timerGate.Update(r.flushTime(rescheduleTime))
 				break
 			}
 
@@ -258,6 +266,22 @@ func (r *reschedulerImpl) reschedule() {
 	}
 }
 
+// flushTime rounds rescheduleTime up to the next multiple of the flush interval.
+func (r *reschedulerImpl) flushTime(
+	rescheduleTime time.Time,
+) time.Time {
+	interval := r.flushInterval()
+	if interval <= 0 {
+		return rescheduleTime
+	}
+
+	flushTime := rescheduleTime.Truncate(interval)
+	if flushTime.Before(rescheduleTime) {
+		flushTime = flushTime.Add(interval)
+	}
+	return flushTime
+}
+
 func (r *reschedulerImpl) cleanupPQ() {
 	r.Lock()
 	defer r.Unlock()
@@ -35,6 +35,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	ctasks "go.temporal.io/server/common/tasks"
@@ -75,6 +76,7 @@ func (s *rescheudulerSuite) SetupTest() {
 		s.timeSource,
 		log.NewTestLogger(),
 		metrics.NoopMetricsHandler,
+		dynamicconfig.GetDurationPropertyFn(0),
 	)
 }
 
@@ -89,6 +91,7 @@ func (s *rescheudulerSuite) TestStartStop() {
 		timeSource,
 		log.NewTestLogger(),
 		metrics.NoopMetricsHandler,
+		dynamicconfig.GetDurationPropertyFn(0),
 	)
 
 	rescheduler.Start()
@@ -125,6 +128,7 @@ func (s *rescheudulerSuite) TestDrain() {
 		timeSource,
 		log.NewTestLogger(),
 		metrics.NoopMetricsHandler,
+		dynamicconfig.GetDurationPropertyFn(0),
 	)
 
 	rescheduler.Start()
@@ -221,6 +225,54 @@ func (s *rescheudulerSuite) TestReschedule_DropCancelled() {
 	s.Equal(0, s.rescheduler.Len())
 }
 
+func (s *rescheudulerSuite) TestReschedule_FlushInterval() {
+	now := time.Unix(0, 0).Add(time.Hour)
+	s.timeSource.Update(now)
+
+	timerRescheduler := NewRescheduler(
+		s.mockScheduler,
+		s.timeSource,
+		log.NewTestLogger(),
+		metrics.NoopMetricsHandler,
+		dynamicconfig.GetDurationPropertyFn(10*time.Millisecond),
+	)
+	transferRescheduler := NewRescheduler(
+		s.mockScheduler,
+		s.timeSource,
+		log.NewTestLogger(),
+		metrics.NoopMetricsHandler,
+		dynamicconfig.GetDurationPropertyFn(time.Second),
+	)
+	defer timerRescheduler.timerGate.Close()
+	defer transferRescheduler.timerGate.Close()
+
+	rescheduleTime := now.Add(1234 * time.Millisecond)
+	for _, rescheduler := range []*reschedulerImpl{timerRescheduler, transferRescheduler} {
+		mockTask := NewMockExecutable(s.controller)
+		mockTask.EXPECT().SetScheduledTime(gomock.Any()).Times(1)
+		mockTask.EXPECT().State().Return(ctasks.TaskStatePending).Times(1)
+		rescheduler.Add(mockTask, rescheduleTime)
+	}
+
+	// timer reschedules are flushed at the next 10ms boundary
+	s.True(timerRescheduler.timerGate.FireAfter(now.Add(1239 * time.Millisecond)))
+	s.False(timerRescheduler.timerGate.FireAfter(now.Add(1240 * time.Millisecond)))
+
+	// transfer reschedules are flushed at the next 1s boundary
+	s.True(transferRescheduler.timerGate.FireAfter(now.Add(1999 * time.Millisecond)))
+	s.False(transferRescheduler.timerGate.FireAfter(now.Add(2 * time.Second)))
+
+	s.mockScheduler.EXPECT().TrySubmit(gomock.Any()).Return(true).Times(2)
+
+	s.timeSource.Update(now.Add(1240 * time.Millisecond))
+	timerRescheduler.reschedule()
+	s.Equal(0, timerRescheduler.Len())
+
+	s.timeSource.Update(now.Add(2 * time.Second))
+	transferRescheduler.reschedule()
+	s.Equal(0, transferRescheduler.Len())
+}
+
 func (s *rescheudulerSuite) TestImmdiateReschedule() {
 	now := time.Now()
 	s.timeSource.Update(now)
@@ -53,9 +53,12 @@ type (
 		SelectTasks(readerID int64, batchSize int) ([]Executable, error)
 		MoreTasks() bool
 		TaskStats() TaskStats
+		WalkExecutables(ExecutableIterator)
 		Clear()
 	}
 
+	ExecutableIterator func(Executable)
+
 	TaskStats struct {
 		PendingPerNamespace map[namespace.ID]int
 	}
@@ -419,6 +422,14 @@ func (s *SliceImpl) TaskStats() TaskStats {
 	}
 }
 
+func (s *SliceImpl) WalkExecutables(iterator ExecutableIterator) {
+	s.stateSanityCheck()
+
+	for _, executable := range s.executableTracker.pendingExecutables {
+		iterator(executable)
+	}
+}
+
 func (s *SliceImpl) Clear() {
 	s.stateSanityCheck()
 
@@ -25,6 +25,8 @@
 package queues
 
 import (
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/log"
@@ -104,6 +106,8 @@ func (q SpeculativeWorkflowTaskTimeoutQueue) NotifyNewTasks(ts []tasks.Task) {
 				q.timeSource,
 				q.namespaceRegistry,
 				q.clusterMetadata,
+				nil,
+				nil,
 				q.logger,
 				q.metricsHandler,
 			), wttt)
@@ -114,3 +118,10 @@ func (q SpeculativeWorkflowTaskTimeoutQueue) NotifyNewTasks(ts []tasks.Task) {
 
 func (q SpeculativeWorkflowTaskTimeoutQueue) FailoverNamespace(_ string) {
 }
+
+func (q SpeculativeWorkflowTaskTimeoutQueue) Describe() *historyspb.HistoryQueueInfo {
+	// speculative workflow task timeouts are not persisted and have no reader
+	return &historyspb.HistoryQueueInfo{
+		Category: enumsspb.TaskCategory(tasks.CategoryIDMemoryTimer),
+	}
+}
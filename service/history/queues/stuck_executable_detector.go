@@ -0,0 +1,69 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package queues
+
+import (
+	"go.temporal.io/server/common/clock"
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+type (
+	// StuckExecutableDetector decides whether an executable is stuck, i.e. whether its running attempt has not made
+	// progress for too long. See Executable.ReportProgress.
+	StuckExecutableDetector interface {
+		IsStuck(executable Executable) bool
+	}
+
+	stuckExecutableDetectorImpl struct {
+		threshold  dynamicconfig.DurationPropertyFn
+		timeSource clock.TimeSource
+	}
+)
+
+// NewStuckExecutableDetector creates a StuckExecutableDetector which flags executables that are executing and did not
+// start their attempt or report progress within the given threshold. Nothing is flagged if the threshold is not
+// positive.
+func NewStuckExecutableDetector(
+	threshold dynamicconfig.DurationPropertyFn,
+	timeSource clock.TimeSource,
+) StuckExecutableDetector {
+	return &stuckExecutableDetectorImpl{
+		threshold:  threshold,
+		timeSource: timeSource,
+	}
+}
+
+func (d *stuckExecutableDetectorImpl) IsStuck(executable Executable) bool {
+	threshold := d.threshold()
+	if threshold <= 0 {
+		return false
+	}
+	lastProgressTime := executable.LastProgressTime()
+	if lastProgressTime.IsZero() {
+		// not executing
+		return false
+	}
+	return d.timeSource.Now().Sub(lastProgressTime) > threshold
+}
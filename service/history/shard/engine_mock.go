@@ -109,6 +109,21 @@ func (mr *MockEngineMockRecorder) DeleteWorkflowExecution(ctx, deleteRequest int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).DeleteWorkflowExecution), ctx, deleteRequest)
 }
 
+// DescribeHistoryQueue mocks base method.
+func (m *MockEngine) DescribeHistoryQueue(ctx context.Context, request *historyservice.DescribeHistoryQueueRequest) (*historyservice.DescribeHistoryQueueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeHistoryQueue", ctx, request)
+	ret0, _ := ret[0].(*historyservice.DescribeHistoryQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeHistoryQueue indicates an expected call of DescribeHistoryQueue.
+func (mr *MockEngineMockRecorder) DescribeHistoryQueue(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeHistoryQueue", reflect.TypeOf((*MockEngine)(nil).DescribeHistoryQueue), ctx, request)
+}
+
 // DescribeMutableState mocks base method.
 func (m *MockEngine) DescribeMutableState(ctx context.Context, request *historyservice.DescribeMutableStateRequest) (*historyservice.DescribeMutableStateResponse, error) {
 	m.ctrl.T.Helper()
@@ -460,6 +475,21 @@ func (mr *MockEngineMockRecorder) RemoveSignalMutableState(ctx, request interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSignalMutableState", reflect.TypeOf((*MockEngine)(nil).RemoveSignalMutableState), ctx, request)
 }
 
+// RepinWorkflowBuildId mocks base method.
+func (m *MockEngine) RepinWorkflowBuildId(ctx context.Context, request *historyservice.RepinWorkflowBuildIdRequest) (*historyservice.RepinWorkflowBuildIdResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RepinWorkflowBuildId", ctx, request)
+	ret0, _ := ret[0].(*historyservice.RepinWorkflowBuildIdResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RepinWorkflowBuildId indicates an expected call of RepinWorkflowBuildId.
+func (mr *MockEngineMockRecorder) RepinWorkflowBuildId(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepinWorkflowBuildId", reflect.TypeOf((*MockEngine)(nil).RepinWorkflowBuildId), ctx, request)
+}
+
 // ReplicateEventsV2 mocks base method.
 func (m *MockEngine) ReplicateEventsV2(ctx context.Context, request *historyservice.ReplicateEventsV2Request) error {
 	m.ctrl.T.Helper()
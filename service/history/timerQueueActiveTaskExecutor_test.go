@@ -1486,6 +1486,8 @@ func (s *timerQueueActiveTaskExecutorSuite) newTaskExecutable(
 		s.mockNamespaceCache,
 		s.mockClusterMetadata,
 		nil,
+		nil,
+		nil,
 		metrics.NoopMetricsHandler,
 	)
 }
@@ -37,6 +37,7 @@ import (
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/xdc"
+	"go.temporal.io/server/service/history/consts"
 	deletemanager "go.temporal.io/server/service/history/deletemanager"
 	"go.temporal.io/server/service/history/queues"
 	"go.temporal.io/server/service/history/shard"
@@ -76,7 +77,11 @@ func NewTimerQueueFactory(
 			HostScheduler: queues.NewNamespacePriorityScheduler(
 				params.ClusterMetadata.GetCurrentClusterName(),
 				queues.NamespacePrioritySchedulerOptions{
-					WorkerCount:                 params.Config.TimerProcessorSchedulerWorkerCount,
+					WorkerCount: NewHostSchedulerWorkerCount(
+						params.QueueFactoryBaseParams,
+						params.Config.TimerProcessorSchedulerWorkerCount,
+						params.MetricsHandler.WithTags(metrics.OperationTag(metrics.OperationTimerQueueProcessorScope)),
+					),
 					ActiveNamespaceWeights:      params.Config.TimerProcessorSchedulerActiveRoundRobinWeights,
 					StandbyNamespaceWeights:     params.Config.TimerProcessorSchedulerStandbyRoundRobinWeights,
 					EnableRateLimiter:           params.Config.TaskSchedulerEnableRateLimiter,
@@ -89,7 +94,13 @@ func NewTimerQueueFactory(
 				params.MetricsHandler.WithTags(metrics.OperationTag(metrics.OperationTimerQueueProcessorScope)),
 				params.Logger,
 			),
-			HostPriorityAssigner: queues.NewPriorityAssigner(),
+			HostPriorityAssigner: queues.NewPriorityAssigner(
+				params.NamespaceRegistry,
+				params.Config.TaskSchedulerNamespacePriorityMultiplier,
+				params.Config.TaskSchedulerNamespacePriorityOverride,
+				params.Config.TimerProcessorSchedulerActiveRoundRobinWeights,
+				params.Logger,
+			),
 			HostReaderRateLimiter: queues.NewReaderPriorityRateLimiter(
 				NewHostRateLimiterRateFn(
 					params.Config.TimerProcessorMaxPollHostRPS,
@@ -124,6 +135,7 @@ func (f *timerQueueFactory) CreateQueue(
 		shard.GetTimeSource(),
 		logger,
 		metricsHandler,
+		f.Config.TimerProcessorReschedulerFlushInterval,
 	)
 
 	activeExecutor := newTimerQueueActiveTaskExecutor(
@@ -182,9 +194,10 @@ func (f *timerQueueFactory) CreateQueue(
 		executor,
 		&queues.Options{
 			ReaderOptions: queues.ReaderOptions{
-				BatchSize:            f.Config.TimerTaskBatchSize,
-				MaxPendingTasksCount: f.Config.QueuePendingTaskMaxCount,
-				PollBackoffInterval:  f.Config.TimerProcessorPollBackoffInterval,
+				BatchSize:                 f.Config.TimerTaskBatchSize,
+				MaxPendingTasksCount:      f.Config.QueuePendingTaskMaxCount,
+				PollBackoffInterval:       f.Config.TimerProcessorPollBackoffInterval,
+				LowPriorityAdmissionDelay: f.Config.QueueLowPriorityAdmissionDelay,
 			},
 			MonitorOptions: queues.MonitorOptions{
 				PendingTasksCriticalCount:   f.Config.QueuePendingTaskCriticalCount,
@@ -197,6 +210,18 @@ func (f *timerQueueFactory) CreateQueue(
 			CheckpointInterval:                  f.Config.TimerProcessorUpdateAckInterval,
 			CheckpointIntervalJitterCoefficient: f.Config.TimerProcessorUpdateAckIntervalJitterCoefficient,
 			MaxReaderCount:                      f.Config.QueueMaxReaderCount,
+			ErrorLogSampleRates:                 f.Config.QueueErrorLogSampleRates,
+			ExecutableSnapshotSink:              queues.NewLogExecutableSnapshotSink(logger),
+			ExecutableSnapshotEnabled:           f.Config.QueueExecutableSnapshotEnabled,
+			Tracer:                              f.TracerProvider.Tracer(consts.LibraryName),
+			CircuitBreakerFailureThreshold:      f.Config.QueueCircuitBreakerThreshold,
+			CircuitBreakerOpenDuration:          f.Config.QueueCircuitBreakerOpenDuration,
+			HistoryTaskDLQ:                      f.HistoryTaskDLQ,
+			DLQMaxAttempts:                      f.Config.QueueDLQMaxAttempts,
+			AttemptTimeout:                      f.Config.QueueExecutableAttemptTimeout,
+			StandbyTaskMinClockEnabled:          f.Config.QueueStandbyTaskMinClockEnabled,
+			SequentialExecutionEnabled:          f.Config.QueueSequentialExecutionEnabled,
+			RangeCompleteInterval:               f.Config.QueueRangeCompleteInterval,
 		},
 		f.HostReaderRateLimiter,
 		logger,
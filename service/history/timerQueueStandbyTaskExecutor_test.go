@@ -1504,6 +1504,8 @@ func (s *timerQueueStandbyTaskExecutorSuite) newTaskExecutable(
 		s.mockNamespaceCache,
 		s.mockClusterMetadata,
 		nil,
+		nil,
+		nil,
 		metrics.NoopMetricsHandler,
 	)
 }
@@ -1418,6 +1418,7 @@ func (t *transferQueueActiveTaskExecutor) resetWorkflow(
 		reason,
 		nil,
 		enumspb.RESET_REAPPLY_TYPE_SIGNAL,
+		nil,
 	)
 
 	switch err.(type) {
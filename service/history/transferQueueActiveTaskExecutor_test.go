@@ -2803,6 +2803,8 @@ func (s *transferQueueActiveTaskExecutorSuite) newTaskExecutable(
 		s.mockNamespaceCache,
 		s.mockClusterMetadata,
 		nil,
+		nil,
+		nil,
 		metrics.NoopMetricsHandler,
 	)
 }
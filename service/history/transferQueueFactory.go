@@ -38,6 +38,7 @@ import (
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/xdc"
+	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/queues"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tasks"
@@ -78,7 +79,11 @@ func NewTransferQueueFactory(
 			HostScheduler: queues.NewNamespacePriorityScheduler(
 				params.ClusterMetadata.GetCurrentClusterName(),
 				queues.NamespacePrioritySchedulerOptions{
-					WorkerCount:                 params.Config.TransferProcessorSchedulerWorkerCount,
+					WorkerCount: NewHostSchedulerWorkerCount(
+						params.QueueFactoryBaseParams,
+						params.Config.TransferProcessorSchedulerWorkerCount,
+						params.MetricsHandler.WithTags(metrics.OperationTag(metrics.OperationTransferQueueProcessorScope)),
+					),
 					ActiveNamespaceWeights:      params.Config.TransferProcessorSchedulerActiveRoundRobinWeights,
 					StandbyNamespaceWeights:     params.Config.TransferProcessorSchedulerStandbyRoundRobinWeights,
 					EnableRateLimiter:           params.Config.TaskSchedulerEnableRateLimiter,
@@ -91,7 +96,13 @@ func NewTransferQueueFactory(
 				params.MetricsHandler.WithTags(metrics.OperationTag(metrics.OperationTransferQueueProcessorScope)),
 				params.Logger,
 			),
-			HostPriorityAssigner: queues.NewPriorityAssigner(),
+			HostPriorityAssigner: queues.NewPriorityAssigner(
+				params.NamespaceRegistry,
+				params.Config.TaskSchedulerNamespacePriorityMultiplier,
+				params.Config.TaskSchedulerNamespacePriorityOverride,
+				params.Config.TransferProcessorSchedulerActiveRoundRobinWeights,
+				params.Logger,
+			),
 			HostReaderRateLimiter: queues.NewReaderPriorityRateLimiter(
 				NewHostRateLimiterRateFn(
 					params.Config.TransferProcessorMaxPollHostRPS,
@@ -116,6 +127,7 @@ func (f *transferQueueFactory) CreateQueue(
 		shard.GetTimeSource(),
 		logger,
 		metricsHandler,
+		f.Config.TransferProcessorReschedulerFlushInterval,
 	)
 
 	currentClusterName := f.ClusterMetadata.GetCurrentClusterName()
@@ -173,9 +185,10 @@ func (f *transferQueueFactory) CreateQueue(
 		executor,
 		&queues.Options{
 			ReaderOptions: queues.ReaderOptions{
-				BatchSize:            f.Config.TransferTaskBatchSize,
-				MaxPendingTasksCount: f.Config.QueuePendingTaskMaxCount,
-				PollBackoffInterval:  f.Config.TransferProcessorPollBackoffInterval,
+				BatchSize:                 f.Config.TransferTaskBatchSize,
+				MaxPendingTasksCount:      f.Config.QueuePendingTaskMaxCount,
+				PollBackoffInterval:       f.Config.TransferProcessorPollBackoffInterval,
+				LowPriorityAdmissionDelay: f.Config.QueueLowPriorityAdmissionDelay,
 			},
 			MonitorOptions: queues.MonitorOptions{
 				PendingTasksCriticalCount:   f.Config.QueuePendingTaskCriticalCount,
@@ -188,6 +201,18 @@ func (f *transferQueueFactory) CreateQueue(
 			CheckpointInterval:                  f.Config.TransferProcessorUpdateAckInterval,
 			CheckpointIntervalJitterCoefficient: f.Config.TransferProcessorUpdateAckIntervalJitterCoefficient,
 			MaxReaderCount:                      f.Config.QueueMaxReaderCount,
+			ErrorLogSampleRates:                 f.Config.QueueErrorLogSampleRates,
+			ExecutableSnapshotSink:              queues.NewLogExecutableSnapshotSink(logger),
+			ExecutableSnapshotEnabled:           f.Config.QueueExecutableSnapshotEnabled,
+			Tracer:                              f.TracerProvider.Tracer(consts.LibraryName),
+			CircuitBreakerFailureThreshold:      f.Config.QueueCircuitBreakerThreshold,
+			CircuitBreakerOpenDuration:          f.Config.QueueCircuitBreakerOpenDuration,
+			HistoryTaskDLQ:                      f.HistoryTaskDLQ,
+			DLQMaxAttempts:                      f.Config.QueueDLQMaxAttempts,
+			AttemptTimeout:                      f.Config.QueueExecutableAttemptTimeout,
+			StandbyTaskMinClockEnabled:          f.Config.QueueStandbyTaskMinClockEnabled,
+			SequentialExecutionEnabled:          f.Config.QueueSequentialExecutionEnabled,
+			RangeCompleteInterval:               f.Config.QueueRangeCompleteInterval,
 		},
 		f.HostReaderRateLimiter,
 		logger,
@@ -1268,6 +1268,8 @@ func (s *transferQueueStandbyTaskExecutorSuite) newTaskExecutable(
 		s.mockNamespaceCache,
 		s.mockClusterMetadata,
 		nil,
+		nil,
+		nil,
 		metrics.NoopMetricsHandler,
 	)
 }
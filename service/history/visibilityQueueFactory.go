@@ -31,6 +31,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/service/history/consts"
 	"go.temporal.io/server/service/history/queues"
 	"go.temporal.io/server/service/history/shard"
 	"go.temporal.io/server/service/history/tasks"
@@ -65,7 +66,11 @@ func NewVisibilityQueueFactory(
 			HostScheduler: queues.NewNamespacePriorityScheduler(
 				params.ClusterMetadata.GetCurrentClusterName(),
 				queues.NamespacePrioritySchedulerOptions{
-					WorkerCount:                 params.Config.VisibilityProcessorSchedulerWorkerCount,
+					WorkerCount: NewHostSchedulerWorkerCount(
+						params.QueueFactoryBaseParams,
+						params.Config.VisibilityProcessorSchedulerWorkerCount,
+						params.MetricsHandler.WithTags(metrics.OperationTag(metrics.OperationVisibilityQueueProcessorScope)),
+					),
 					ActiveNamespaceWeights:      params.Config.VisibilityProcessorSchedulerActiveRoundRobinWeights,
 					StandbyNamespaceWeights:     params.Config.VisibilityProcessorSchedulerStandbyRoundRobinWeights,
 					EnableRateLimiter:           params.Config.TaskSchedulerEnableRateLimiter,
@@ -78,7 +83,13 @@ func NewVisibilityQueueFactory(
 				params.MetricsHandler.WithTags(metrics.OperationTag(metrics.OperationVisibilityQueueProcessorScope)),
 				params.Logger,
 			),
-			HostPriorityAssigner: queues.NewPriorityAssigner(),
+			HostPriorityAssigner: queues.NewPriorityAssigner(
+				params.NamespaceRegistry,
+				params.Config.TaskSchedulerNamespacePriorityMultiplier,
+				params.Config.TaskSchedulerNamespacePriorityOverride,
+				params.Config.VisibilityProcessorSchedulerActiveRoundRobinWeights,
+				params.Logger,
+			),
 			HostReaderRateLimiter: queues.NewReaderPriorityRateLimiter(
 				NewHostRateLimiterRateFn(
 					params.Config.VisibilityProcessorMaxPollHostRPS,
@@ -103,6 +114,7 @@ func (f *visibilityQueueFactory) CreateQueue(
 		shard.GetTimeSource(),
 		logger,
 		metricsHandler,
+		f.Config.VisibilityProcessorReschedulerFlushInterval,
 	)
 
 	executor := newVisibilityQueueTaskExecutor(
@@ -124,9 +136,10 @@ func (f *visibilityQueueFactory) CreateQueue(
 		executor,
 		&queues.Options{
 			ReaderOptions: queues.ReaderOptions{
-				BatchSize:            f.Config.VisibilityTaskBatchSize,
-				MaxPendingTasksCount: f.Config.QueuePendingTaskMaxCount,
-				PollBackoffInterval:  f.Config.VisibilityProcessorPollBackoffInterval,
+				BatchSize:                 f.Config.VisibilityTaskBatchSize,
+				MaxPendingTasksCount:      f.Config.QueuePendingTaskMaxCount,
+				PollBackoffInterval:       f.Config.VisibilityProcessorPollBackoffInterval,
+				LowPriorityAdmissionDelay: f.Config.QueueLowPriorityAdmissionDelay,
 			},
 			MonitorOptions: queues.MonitorOptions{
 				PendingTasksCriticalCount:   f.Config.QueuePendingTaskCriticalCount,
@@ -139,6 +152,17 @@ func (f *visibilityQueueFactory) CreateQueue(
 			CheckpointInterval:                  f.Config.VisibilityProcessorUpdateAckInterval,
 			CheckpointIntervalJitterCoefficient: f.Config.VisibilityProcessorUpdateAckIntervalJitterCoefficient,
 			MaxReaderCount:                      f.Config.QueueMaxReaderCount,
+			ErrorLogSampleRates:                 f.Config.QueueErrorLogSampleRates,
+			ExecutableSnapshotSink:              queues.NewLogExecutableSnapshotSink(logger),
+			ExecutableSnapshotEnabled:           f.Config.QueueExecutableSnapshotEnabled,
+			Tracer:                              f.TracerProvider.Tracer(consts.LibraryName),
+			CircuitBreakerFailureThreshold:      f.Config.QueueCircuitBreakerThreshold,
+			CircuitBreakerOpenDuration:          f.Config.QueueCircuitBreakerOpenDuration,
+			HistoryTaskDLQ:                      f.HistoryTaskDLQ,
+			DLQMaxAttempts:                      f.Config.QueueDLQMaxAttempts,
+			AttemptTimeout:                      f.Config.QueueExecutableAttemptTimeout,
+			SequentialExecutionEnabled:          f.Config.QueueSequentialExecutionEnabled,
+			RangeCompleteInterval:               f.Config.QueueRangeCompleteInterval,
 		},
 		f.HostReaderRateLimiter,
 		logger,
@@ -617,6 +617,8 @@ func (s *visibilityQueueTaskExecutorSuite) newTaskExecutable(
 		s.mockShard.GetNamespaceRegistry(),
 		s.mockShard.GetClusterMetadata(),
 		nil,
+		nil,
+		nil,
 		metrics.NoopMetricsHandler,
 	)
 }
@@ -372,7 +372,8 @@ func (handler *workflowTaskHandlerImpl) handleCommandScheduleActivity(
 
 	eagerStartActivity := false
 	namespace := handler.mutableState.GetNamespaceEntry().Name().String()
-	if attr.RequestEagerExecution && handler.config.EnableActivityEagerExecution(namespace) {
+	if attr.RequestEagerExecution && handler.config.EnableActivityEagerExecution(namespace) &&
+		!handler.config.DisableActivityEagerExecution(namespace, attr.GetTaskQueue().GetName(), enumspb.TASK_QUEUE_TYPE_ACTIVITY) {
 		eagerStartActivity = true
 	}
 
@@ -0,0 +1,100 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.temporal.io/server/common/quotas"
+)
+
+const (
+	// buildIdDispatchRateRefreshInterval is how often the rate of a build id is recomputed from its poller count.
+	buildIdDispatchRateRefreshInterval = time.Second
+)
+
+type (
+	// buildIdDispatchRateLimiter limits the rate at which the tasks of a versioned queue are dispatched to the pollers
+	// of each build id, in proportion to the number of pollers the build id has, so that a build id that lost most of
+	// its pollers is not handed tasks faster than it can process them. Tasks that are not dispatched stay in the
+	// backlog.
+	buildIdDispatchRateLimiter struct {
+		ratePerPoller func() float64
+		pollerCount   func(buildId string) int
+
+		lock     sync.Mutex
+		limiters map[string]*quotas.DynamicRateLimiterImpl
+	}
+)
+
+func newBuildIdDispatchRateLimiter(
+	ratePerPoller func() float64,
+	pollerCount func(buildId string) int,
+) *buildIdDispatchRateLimiter {
+	return &buildIdDispatchRateLimiter{
+		ratePerPoller: ratePerPoller,
+		pollerCount:   pollerCount,
+		limiters:      make(map[string]*quotas.DynamicRateLimiterImpl),
+	}
+}
+
+// wait blocks until a task may be dispatched to a poller of the given build id. Unversioned pollers, and all pollers
+// while the rate per poller is not positive, are not limited. Returns ErrNoTasks when ctx is done first.
+func (l *buildIdDispatchRateLimiter) wait(ctx context.Context, buildId string) error {
+	if buildId == "" || l.ratePerPoller() <= 0 {
+		return nil
+	}
+	if err := l.limiter(buildId).Wait(ctx); err != nil {
+		// Wait fails right away if no token is expected before the deadline, hold the poller until then instead of
+		// letting it come back in a busy loop
+		<-ctx.Done()
+		return ErrNoTasks
+	}
+	return nil
+}
+
+func (l *buildIdDispatchRateLimiter) limiter(buildId string) *quotas.DynamicRateLimiterImpl {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	limiter, ok := l.limiters[buildId]
+	if !ok {
+		limiter = quotas.NewDynamicRateLimiter(
+			quotas.NewDefaultOutgoingRateBurst(func() float64 {
+				// the poller asking for a token counts even if it has no identity to be tracked by
+				pollers := l.pollerCount(buildId)
+				if pollers < 1 {
+					pollers = 1
+				}
+				return l.ratePerPoller() * float64(pollers)
+			}),
+			buildIdDispatchRateRefreshInterval,
+		)
+		l.limiters[buildId] = limiter
+	}
+	return limiter
+}
@@ -0,0 +1,156 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// dispatchShareDecay controls how quickly past dispatches are forgotten when computing the share of tasks each
+	// build id received. With 0.9, roughly the last ten dispatches dominate.
+	dispatchShareDecay = 0.9
+	// maxDispatchBalanceWait bounds how long an over-served poller waits before re-checking its share, in case the
+	// pollers it yielded to went away without taking a task.
+	maxDispatchBalanceWait = 100 * time.Millisecond
+)
+
+type (
+	// buildIdDispatchBalancer splits the tasks of a versioned (compatible set) queue between the pollers of build ids
+	// that have a dispatch weight. All pollers still read from the same matcher; a poller whose build id recently
+	// received more than its share of tasks is held back while pollers of other weighted build ids are waiting.
+	buildIdDispatchBalancer struct {
+		lock sync.Mutex
+		// decayed count of dispatched tasks per build id
+		dispatched map[string]float64
+		// number of pollers currently waiting in the matcher, per build id
+		waiting map[string]int
+		// closed and replaced on every dispatch, to wake up held back pollers
+		dispatchedC chan struct{}
+	}
+)
+
+func newBuildIdDispatchBalancer() *buildIdDispatchBalancer {
+	return &buildIdDispatchBalancer{
+		dispatched:  make(map[string]float64),
+		waiting:     make(map[string]int),
+		dispatchedC: make(chan struct{}),
+	}
+}
+
+// admit blocks while the given build id is over-served relative to the other weighted build ids with waiting pollers,
+// then registers the poller as waiting. The returned function must be called once the poll is over.
+// Build ids without a positive weight are admitted immediately and are not tracked. Returns ErrNoTasks when ctx is done.
+func (b *buildIdDispatchBalancer) admit(ctx context.Context, buildId string, weights map[string]int) (func(), error) {
+	if weights[buildId] <= 0 {
+		return func() {}, nil
+	}
+	for {
+		b.lock.Lock()
+		if !b.overServedLocked(buildId, weights) {
+			b.waiting[buildId]++
+			b.lock.Unlock()
+			return func() {
+				b.lock.Lock()
+				defer b.lock.Unlock()
+				if b.waiting[buildId]--; b.waiting[buildId] <= 0 {
+					delete(b.waiting, buildId)
+				}
+			}, nil
+		}
+		dispatchedC := b.dispatchedC
+		b.lock.Unlock()
+
+		timer := time.NewTimer(maxDispatchBalanceWait)
+		select {
+		case <-dispatchedC:
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ErrNoTasks
+		}
+		timer.Stop()
+	}
+}
+
+// recordDispatch records that a task was handed to a poller of the given build id.
+func (b *buildIdDispatchBalancer) recordDispatch(buildId string, weights map[string]int) {
+	if weights[buildId] <= 0 {
+		return
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	for id, count := range b.dispatched {
+		b.dispatched[id] = count * dispatchShareDecay
+	}
+	b.dispatched[buildId]++
+	close(b.dispatchedC)
+	b.dispatchedC = make(chan struct{})
+}
+
+// overServedLocked returns true if buildId received more than its weighted share of recent dispatches, where the
+// share is computed among buildId and the weighted build ids that have pollers waiting right now.
+func (b *buildIdDispatchBalancer) overServedLocked(buildId string, weights map[string]int) bool {
+	totalWeight := weights[buildId]
+	totalDispatched := b.dispatched[buildId]
+	for id := range b.waiting {
+		if id == buildId || weights[id] <= 0 {
+			continue
+		}
+		totalWeight += weights[id]
+		totalDispatched += b.dispatched[id]
+	}
+	if totalWeight == weights[buildId] || totalDispatched == 0 {
+		// nobody else to yield to
+		return false
+	}
+	share := b.dispatched[buildId] / totalDispatched
+	return share > float64(weights[buildId])/float64(totalWeight)
+}
+
+// parseBuildIdDispatchWeights converts the dynamic config value of MatchingBuildIdDispatchWeights, ignoring
+// entries that are not positive numbers.
+func parseBuildIdDispatchWeights(value map[string]any) map[string]int {
+	weights := make(map[string]int, len(value))
+	for buildId, v := range value {
+		var weight int
+		switch v := v.(type) {
+		case float64:
+			weight = int(v)
+		case int:
+			weight = v
+		case int32:
+			weight = int(v)
+		case int64:
+			weight = int(v)
+		}
+		if weight > 0 {
+			weights[buildId] = weight
+		}
+	}
+	return weights
+}
@@ -0,0 +1,104 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildIdDispatchBalancer_UnweightedNotTracked(t *testing.T) {
+	t.Parallel()
+	b := newBuildIdDispatchBalancer()
+	release, err := b.admit(context.Background(), "v1", nil)
+	require.NoError(t, err)
+	release()
+	b.recordDispatch("v1", nil)
+	assert.Empty(t, b.waiting)
+	assert.Empty(t, b.dispatched)
+}
+
+func TestBuildIdDispatchBalancer_HoldsBackOverServedBuildId(t *testing.T) {
+	t.Parallel()
+	weights := map[string]int{"v1": 1, "v1.1": 1}
+	b := newBuildIdDispatchBalancer()
+
+	// v1 got all recent tasks and a v1.1 poller is waiting
+	b.recordDispatch("v1", weights)
+	b.recordDispatch("v1", weights)
+	release11, err := b.admit(context.Background(), "v1.1", weights)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*maxDispatchBalanceWait)
+	defer cancel()
+	_, err = b.admit(ctx, "v1", weights)
+	assert.ErrorIs(t, err, ErrNoTasks)
+
+	// once the v1.1 poller gets its share, v1 is admitted again
+	admitted := make(chan struct{})
+	go func() {
+		release1, err := b.admit(context.Background(), "v1", weights)
+		assert.NoError(t, err)
+		release1()
+		close(admitted)
+	}()
+	release11()
+	b.recordDispatch("v1.1", weights)
+	b.recordDispatch("v1.1", weights)
+	select {
+	case <-admitted:
+	case <-time.After(time.Second):
+		t.Fatal("v1 poller was not admitted")
+	}
+}
+
+func TestBuildIdDispatchBalancer_NoOtherPollers(t *testing.T) {
+	t.Parallel()
+	weights := map[string]int{"v1": 1, "v1.1": 9}
+	b := newBuildIdDispatchBalancer()
+	for i := 0; i < 10; i++ {
+		b.recordDispatch("v1", weights)
+	}
+	// over-served, but nobody else is waiting
+	release, err := b.admit(context.Background(), "v1", weights)
+	require.NoError(t, err)
+	release()
+}
+
+func TestParseBuildIdDispatchWeights(t *testing.T) {
+	t.Parallel()
+	weights := parseBuildIdDispatchWeights(map[string]any{
+		"a": 10,
+		"b": 2.0,
+		"c": 0,
+		"d": -1,
+		"e": "3",
+	})
+	assert.Equal(t, map[string]int{"a": 10, "b": 2}, weights)
+}
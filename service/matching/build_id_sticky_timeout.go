@@ -0,0 +1,57 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"time"
+
+	"go.temporal.io/server/common/primitives/timestamp"
+)
+
+// parseStickyScheduleToStartTimeout returns the sticky schedule-to-start timeout of buildId from the dynamic config
+// value of MatchingStickyScheduleToStartTimeoutPerBuildId, or 0 if none is configured. Durations are either strings,
+// e.g. "500ms", or numbers of seconds.
+func parseStickyScheduleToStartTimeout(value map[string]any, buildId string) time.Duration {
+	if buildId == "" {
+		return 0
+	}
+	var timeout time.Duration
+	switch v := value[buildId].(type) {
+	case time.Duration:
+		timeout = v
+	case string:
+		timeout, _ = timestamp.ParseDurationDefaultSeconds(v)
+	case float64:
+		timeout = time.Duration(v * float64(time.Second))
+	case int:
+		timeout = time.Duration(v) * time.Second
+	case int64:
+		timeout = time.Duration(v) * time.Second
+	}
+	if timeout < 0 {
+		return 0
+	}
+	return timeout
+}
@@ -0,0 +1,52 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseStickyScheduleToStartTimeout(t *testing.T) {
+	t.Parallel()
+	value := map[string]any{
+		"string":   "500ms",
+		"seconds":  float64(2),
+		"int":      3,
+		"duration": time.Second,
+		"negative": "-1s",
+		"invalid":  "soon",
+	}
+	assert.Equal(t, 500*time.Millisecond, parseStickyScheduleToStartTimeout(value, "string"))
+	assert.Equal(t, 2*time.Second, parseStickyScheduleToStartTimeout(value, "seconds"))
+	assert.Equal(t, 3*time.Second, parseStickyScheduleToStartTimeout(value, "int"))
+	assert.Equal(t, time.Second, parseStickyScheduleToStartTimeout(value, "duration"))
+	assert.Zero(t, parseStickyScheduleToStartTimeout(value, "negative"))
+	assert.Zero(t, parseStickyScheduleToStartTimeout(value, "invalid"))
+	assert.Zero(t, parseStickyScheduleToStartTimeout(value, "unknown"))
+	assert.Zero(t, parseStickyScheduleToStartTimeout(value, ""))
+}
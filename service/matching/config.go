@@ -29,6 +29,7 @@ import (
 
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/visibility"
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/util"
 )
@@ -48,20 +49,44 @@ type (
 
 		// taskQueueManager configuration
 
-		RangeSize                         int64
-		GetTasksBatchSize                 dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
-		UpdateAckInterval                 dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
-		MaxTaskQueueIdleTime              dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
-		NumTaskqueueWritePartitions       dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
-		NumTaskqueueReadPartitions        dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
-		ForwarderMaxOutstandingPolls      dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
-		ForwarderMaxOutstandingTasks      dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
-		ForwarderMaxRatePerSecond         dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
-		ForwarderMaxChildrenPerNode       dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
-		VersionCompatibleSetLimitPerQueue dynamicconfig.IntPropertyFn
-		VersionBuildIdLimitPerQueue       dynamicconfig.IntPropertyFn
-		TaskQueueLimitPerBuildId          dynamicconfig.IntPropertyFn
-		GetUserDataLongPollTimeout        dynamicconfig.DurationPropertyFn
+		RangeSize                            int64
+		GetTasksBatchSize                    dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		UpdateAckInterval                    dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
+		MaxTaskQueueIdleTime                 dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
+		PollerHistoryTTL                     dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
+		NumTaskqueueWritePartitions          dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		NumTaskqueueReadPartitions           dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		NumTaskqueueReadPartitionsPerBuildId dynamicconfig.MapPropertyFnWithNamespaceFilter
+		ForwarderMaxOutstandingPolls         dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		ForwarderMaxOutstandingTasks         dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		ForwarderMaxRatePerSecond            dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		ForwarderMaxChildrenPerNode          dynamicconfig.IntPropertyFnWithTaskQueueInfoFilters
+		VersionCompatibleSetLimitPerQueue    dynamicconfig.IntPropertyFn
+		VersionBuildIdLimitPerQueue          dynamicconfig.IntPropertyFn
+		VersionBuildIdLimitPerSet            dynamicconfig.IntPropertyFn
+		VersionBuildIdLabelsSizeLimit        dynamicconfig.IntPropertyFn
+		TaskQueueLimitPerBuildId             dynamicconfig.IntPropertyFn
+		UserDataSizeLimit                    dynamicconfig.IntPropertyFn
+		GetUserDataLongPollTimeout           dynamicconfig.DurationPropertyFn
+		UserDataMinPropagationInterval       dynamicconfig.DurationPropertyFn
+		RetiredBuildIdTaskTTL                dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
+		RerouteExpiredRetiredBuildIdTasks    dynamicconfig.BoolPropertyFnWithTaskQueueInfoFilters
+		UserDataConsistencyCheckInterval     dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
+		UserDataDivergenceGracePeriod        dynamicconfig.DurationPropertyFn
+		RepairDivergentUserData              dynamicconfig.BoolPropertyFnWithTaskQueueInfoFilters
+		PauseUserDataPropagation             dynamicconfig.BoolPropertyFnWithTaskQueueInfoFilters
+		BuildIdDispatchWeights               dynamicconfig.MapPropertyFnWithNamespaceFilter
+		BuildIdDispatchRatePerPoller         dynamicconfig.FloatPropertyFnWithTaskQueueInfoFilters
+		StickyTimeoutPerBuildId              dynamicconfig.MapPropertyFnWithNamespaceFilter
+		EvictOutdatedStickyPollers           dynamicconfig.BoolPropertyFnWithTaskQueueInfoFilters
+		ActivityDefaultBuildId               dynamicconfig.StringPropertyFnWithTaskQueueInfoFilters
+		ActivityVersioningIntentWins         dynamicconfig.BoolPropertyFnWithTaskQueueInfoFilters
+		VersioningTemplates                  dynamicconfig.MapPropertyFnWithNamespaceFilter
+		HLCBackwardJumpThreshold             dynamicconfig.DurationPropertyFn
+		VersioningAuditLogRetention          dynamicconfig.DurationPropertyFnWithNamespaceFilter
+		BuildIdScavengerInterval             dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
+		BuildIdScavengerRetention            dynamicconfig.DurationPropertyFnWithNamespaceFilter
+		TestDisableUserDataPropagation       dynamicconfig.BoolPropertyFn
 
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval dynamicconfig.DurationPropertyFnWithTaskQueueInfoFilters
@@ -76,6 +101,13 @@ type (
 
 		AdminNamespaceToPartitionDispatchRate          dynamicconfig.FloatPropertyFnWithNamespaceFilter
 		AdminNamespaceTaskqueueToPartitionDispatchRate dynamicconfig.FloatPropertyFnWithTaskQueueInfoFilters
+
+		// Visibility is only read by matching, to classify build id reachability
+		VisibilityPersistenceMaxReadQPS   dynamicconfig.IntPropertyFn
+		VisibilityPersistenceMaxWriteQPS  dynamicconfig.IntPropertyFn
+		EnableReadFromSecondaryVisibility dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		VisibilityDisableOrderByClause    dynamicconfig.BoolPropertyFnWithNamespaceFilter
+		VisibilityEnableManualPagination  dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	}
 
 	forwarderConfig struct {
@@ -95,12 +127,24 @@ type (
 		GetTasksBatchSize          func() int
 		UpdateAckInterval          func() time.Duration
 		MaxTaskQueueIdleTime       func() time.Duration
+		PollerHistoryTTL           func() time.Duration
 		MinTaskThrottlingBurstSize func() int
 		MaxTaskDeleteBatchSize     func() int
 
 		GetUserDataLongPollTimeout dynamicconfig.DurationPropertyFn
 		GetUserDataMinWaitTime     time.Duration
 
+		UserDataConsistencyCheckInterval func() time.Duration
+		UserDataDivergenceGracePeriod    dynamicconfig.DurationPropertyFn
+		RepairDivergentUserData          func() bool
+		PauseUserDataPropagation         func() bool
+		BuildIdScavengerInterval         func() time.Duration
+		BuildIdScavengerRetention        func() time.Duration
+		BuildIdDispatchWeights           func() map[string]int
+		BuildIdDispatchRatePerPoller     func() float64
+		EvictOutdatedStickyPollers       func() bool
+		TestDisableUserDataPropagation   dynamicconfig.BoolPropertyFn
+
 		// taskWriter configuration
 		OutstandingTaskAppendsThreshold func() int
 		MaxTaskBatchSize                func() int
@@ -115,7 +159,11 @@ type (
 )
 
 // NewConfig returns new service config with default values
-func NewConfig(dc *dynamicconfig.Collection) *Config {
+func NewConfig(
+	dc *dynamicconfig.Collection,
+	visibilityStoreConfigExist bool,
+	enableReadFromES bool,
+) *Config {
 	defaultUpdateAckInterval := []dynamicconfig.ConstrainedValue{
 		// Use a longer default interval for the per-namespace internal worker queues.
 		{
@@ -142,6 +190,7 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		GetTasksBatchSize:                     dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingGetTasksBatchSize, 1000),
 		UpdateAckInterval:                     dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingUpdateAckInterval, defaultUpdateAckInterval),
 		MaxTaskQueueIdleTime:                  dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMaxTaskQueueIdleTime, 5*time.Minute),
+		PollerHistoryTTL:                      dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingPollerHistoryTTL, 5*time.Minute),
 		LongPollExpirationInterval:            dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingLongPollExpirationInterval, time.Minute),
 		MinTaskThrottlingBurstSize:            dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMinTaskThrottlingBurstSize, 1),
 		MaxTaskDeleteBatchSize:                dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingMaxTaskDeleteBatchSize, 100),
@@ -150,6 +199,7 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		ThrottledLogRPS:                       dc.GetIntProperty(dynamicconfig.MatchingThrottledLogRPS, 20),
 		NumTaskqueueWritePartitions:           dc.GetTaskQueuePartitionsProperty(dynamicconfig.MatchingNumTaskqueueWritePartitions),
 		NumTaskqueueReadPartitions:            dc.GetTaskQueuePartitionsProperty(dynamicconfig.MatchingNumTaskqueueReadPartitions),
+		NumTaskqueueReadPartitionsPerBuildId:  dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.MatchingNumTaskqueueReadPartitionsPerBuildId, map[string]any{}),
 		ForwarderMaxOutstandingPolls:          dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingForwarderMaxOutstandingPolls, 1),
 		ForwarderMaxOutstandingTasks:          dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingForwarderMaxOutstandingTasks, 1),
 		ForwarderMaxRatePerSecond:             dc.GetIntPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingForwarderMaxRatePerSecond, 10),
@@ -157,11 +207,39 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		ShutdownDrainDuration:                 dc.GetDurationProperty(dynamicconfig.MatchingShutdownDrainDuration, 0*time.Second),
 		VersionCompatibleSetLimitPerQueue:     dc.GetIntProperty(dynamicconfig.VersionCompatibleSetLimitPerQueue, 10),
 		VersionBuildIdLimitPerQueue:           dc.GetIntProperty(dynamicconfig.VersionBuildIdLimitPerQueue, 1000),
+		VersionBuildIdLimitPerSet:             dc.GetIntProperty(dynamicconfig.VersionCompatibleBuildIdLimitPerSet, 0),
+		VersionBuildIdLabelsSizeLimit:         dc.GetIntProperty(dynamicconfig.VersionBuildIdLabelsSizeLimit, 4*1024),
 		TaskQueueLimitPerBuildId:              dc.GetIntProperty(dynamicconfig.TaskQueuesPerBuildIdLimit, 20),
+		UserDataSizeLimit:                     dc.GetIntProperty(dynamicconfig.TaskQueueUserDataSizeLimit, 1024*1024),
 		GetUserDataLongPollTimeout:            dc.GetDurationProperty(dynamicconfig.MatchingGetUserDataLongPollTimeout, 5*time.Minute),
+		UserDataMinPropagationInterval:        dc.GetDurationProperty(dynamicconfig.MatchingUserDataMinPropagationInterval, 0),
+		RetiredBuildIdTaskTTL:                 dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingRetiredBuildIdTaskTTL, 0),
+		RerouteExpiredRetiredBuildIdTasks:     dc.GetBoolPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingRerouteExpiredRetiredBuildIdTasks, false),
+		UserDataConsistencyCheckInterval:      dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingUserDataConsistencyCheckInterval, 5*time.Minute),
+		UserDataDivergenceGracePeriod:         dc.GetDurationProperty(dynamicconfig.MatchingUserDataDivergenceGracePeriod, time.Minute),
+		RepairDivergentUserData:               dc.GetBoolPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingRepairDivergentUserData, false),
+		PauseUserDataPropagation:              dc.GetBoolPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingPauseUserDataPropagation, false),
+		BuildIdDispatchWeights:                dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.MatchingBuildIdDispatchWeights, map[string]any{}),
+		BuildIdDispatchRatePerPoller:          dc.GetFloatPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingBuildIdDispatchRatePerPoller, 0),
+		StickyTimeoutPerBuildId:               dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.MatchingStickyScheduleToStartTimeoutPerBuildId, map[string]any{}),
+		EvictOutdatedStickyPollers:            dc.GetBoolPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingEvictOutdatedStickyPollers, true),
+		ActivityDefaultBuildId:                dc.GetStringPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingActivityDefaultBuildId, ""),
+		ActivityVersioningIntentWins:          dc.GetBoolPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingActivityVersioningIntentWins, true),
+		VersioningTemplates:                   dc.GetMapPropertyFnWithNamespaceFilter(dynamicconfig.MatchingVersioningTemplates, map[string]any{}),
+		HLCBackwardJumpThreshold:              dc.GetDurationProperty(dynamicconfig.MatchingHybridLogicalClockBackwardJumpThreshold, 5*time.Second),
+		VersioningAuditLogRetention:           dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.MatchingVersioningAuditLogRetention, 0),
+		BuildIdScavengerInterval:              dc.GetDurationPropertyFilteredByTaskQueueInfo(dynamicconfig.MatchingBuildIdScavengerInterval, 0),
+		BuildIdScavengerRetention:             dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.MatchingBuildIdScavengerRetention, 14*24*time.Hour),
+		TestDisableUserDataPropagation:        dc.GetBoolProperty(dynamicconfig.TestMatchingDisableUserDataPropagation, false),
 
 		AdminNamespaceToPartitionDispatchRate:          dc.GetFloatPropertyFilteredByNamespace(dynamicconfig.AdminMatchingNamespaceToPartitionDispatchRate, 10000),
 		AdminNamespaceTaskqueueToPartitionDispatchRate: dc.GetFloatPropertyFilteredByTaskQueueInfo(dynamicconfig.AdminMatchingNamespaceTaskqueueToPartitionDispatchRate, 1000),
+
+		VisibilityPersistenceMaxReadQPS:   visibility.GetVisibilityPersistenceMaxReadQPS(dc, enableReadFromES),
+		VisibilityPersistenceMaxWriteQPS:  visibility.GetVisibilityPersistenceMaxWriteQPS(dc, enableReadFromES),
+		EnableReadFromSecondaryVisibility: visibility.GetEnableReadFromSecondaryVisibilityConfig(dc, visibilityStoreConfigExist, enableReadFromES),
+		VisibilityDisableOrderByClause:    dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.VisibilityDisableOrderByClause, true),
+		VisibilityEnableManualPagination:  dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.VisibilityEnableManualPagination, true),
 	}
 }
 
@@ -180,6 +258,9 @@ func newTaskQueueConfig(id *taskQueueID, config *Config, namespace namespace.Nam
 		MaxTaskQueueIdleTime: func() time.Duration {
 			return config.MaxTaskQueueIdleTime(namespace.String(), taskQueueName, taskType)
 		},
+		PollerHistoryTTL: func() time.Duration {
+			return config.PollerHistoryTTL(namespace.String(), taskQueueName, taskType)
+		},
 		MinTaskThrottlingBurstSize: func() int {
 			return config.MinTaskThrottlingBurstSize(namespace.String(), taskQueueName, taskType)
 		},
@@ -195,6 +276,32 @@ func newTaskQueueConfig(id *taskQueueID, config *Config, namespace namespace.Nam
 		},
 		GetUserDataLongPollTimeout: config.GetUserDataLongPollTimeout,
 		GetUserDataMinWaitTime:     1 * time.Second,
+		UserDataConsistencyCheckInterval: func() time.Duration {
+			return config.UserDataConsistencyCheckInterval(namespace.String(), taskQueueName, taskType)
+		},
+		UserDataDivergenceGracePeriod: config.UserDataDivergenceGracePeriod,
+		RepairDivergentUserData: func() bool {
+			return config.RepairDivergentUserData(namespace.String(), taskQueueName, taskType)
+		},
+		PauseUserDataPropagation: func() bool {
+			return config.PauseUserDataPropagation(namespace.String(), taskQueueName, taskType)
+		},
+		BuildIdScavengerInterval: func() time.Duration {
+			return config.BuildIdScavengerInterval(namespace.String(), taskQueueName, taskType)
+		},
+		BuildIdScavengerRetention: func() time.Duration {
+			return config.BuildIdScavengerRetention(namespace.String())
+		},
+		BuildIdDispatchWeights: func() map[string]int {
+			return parseBuildIdDispatchWeights(config.BuildIdDispatchWeights(namespace.String()))
+		},
+		BuildIdDispatchRatePerPoller: func() float64 {
+			return config.BuildIdDispatchRatePerPoller(namespace.String(), taskQueueName, taskType)
+		},
+		EvictOutdatedStickyPollers: func() bool {
+			return config.EvictOutdatedStickyPollers(namespace.String(), taskQueueName, taskType)
+		},
+		TestDisableUserDataPropagation: config.TestDisableUserDataPropagation,
 		OutstandingTaskAppendsThreshold: func() int {
 			return config.OutstandingTaskAppendsThreshold(namespace.String(), taskQueueName, taskType)
 		},
@@ -44,6 +44,18 @@ var (
 		"GetTaskQueueUserData":                   0,
 		"ApplyTaskQueueUserDataReplicationEvent": 0,
 		"GetBuildIdTaskQueueMapping":             0,
+		"DescribeVersioning":                     0,
+		"CleanupUnreachableBuildIds":             0,
+		"GetDefaultBuildIdTimeline":              0,
+		"ValidateDefaultBuildIdSwitch":           0,
+		"GetClosedWorkflowBuildId":               0,
+		"GetUserDataPropagationStatus":           0,
+		"ApplyVersioningTemplate":                0,
+		"ReassignBuildId":                        0,
+		"GetTaskDispatchDecision":                0,
+		"EnableWorkerVersioning":                 0,
+		"ListWorkerBuildIdCompatibility":         0,
+		"GetBuildIdReachability":                 0,
 		"ForceUnloadTaskQueue":                   0,
 		"UpdateTaskQueueUserData":                0,
 		"ReplicateTaskQueueUserData":             0,
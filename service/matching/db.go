@@ -38,6 +38,7 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -60,6 +61,7 @@ type (
 		userDataChanged chan struct{}
 		store           persistence.TaskManager
 		logger          log.Logger
+		metricsHandler  metrics.Handler
 		matchingClient  matchingservice.MatchingServiceClient
 	}
 	taskQueueState struct {
@@ -93,6 +95,7 @@ func newTaskQueueDB(
 	taskQueue *taskQueueID,
 	kind enumspb.TaskQueueKind,
 	logger log.Logger,
+	metricsHandler metrics.Handler,
 ) *taskQueueDB {
 	return &taskQueueDB{
 		namespaceID:     namespaceID,
@@ -100,6 +103,7 @@ func newTaskQueueDB(
 		taskQueueKind:   kind,
 		store:           store,
 		logger:          logger,
+		metricsHandler:  metricsHandler,
 		userDataChanged: make(chan struct{}),
 		matchingClient:  matchingClient,
 	}
@@ -341,12 +345,45 @@ func (db *taskQueueDB) getUserDataLocked(
 			}
 			return nil, nil, err
 		}
-		db.setUserDataLocked(response.UserData)
+		db.setUserDataLocked(db.repairUserData(db.migrateUserData(response.UserData)))
 	}
 
 	return db.userData, db.userDataChanged, nil
 }
 
+// migrateUserData upgrades versioning data read from persistence that was written with an older schema version. Like
+// repairs, the migration is only kept in memory and is written back with the next user data update.
+func (db *taskQueueDB) migrateUserData(userData *persistencespb.VersionedTaskQueueUserData) *persistencespb.VersionedTaskQueueUserData {
+	versioningData, migrated := MigrateVersioningData(userData.GetData().GetVersioningData())
+	if !migrated {
+		return userData
+	}
+	db.logger.Info("Migrated versioning data loaded from persistence",
+		tag.WorkflowNamespaceID(db.namespaceID.String()),
+		tag.WorkflowTaskQueueName(db.taskQueue.FullName()))
+	db.metricsHandler.Counter(metrics.TaskQueueVersioningDataMigrated.GetMetricName()).Record(1)
+	data := *userData.GetData()
+	data.VersioningData = versioningData
+	return &persistencespb.VersionedTaskQueueUserData{Version: userData.GetVersion(), Data: &data}
+}
+
+// repairUserData fixes structurally invalid versioning data read from persistence so that a corrupt entry degrades
+// to a queue with less versioning data instead of one that fails to load. The repair is only kept in memory and is
+// written back with the next user data update.
+func (db *taskQueueDB) repairUserData(userData *persistencespb.VersionedTaskQueueUserData) *persistencespb.VersionedTaskQueueUserData {
+	versioningData, repaired := RepairVersioningData(userData.GetData().GetVersioningData())
+	if !repaired {
+		return userData
+	}
+	db.logger.Warn("Repaired invalid versioning data loaded from persistence",
+		tag.WorkflowNamespaceID(db.namespaceID.String()),
+		tag.WorkflowTaskQueueName(db.taskQueue.FullName()))
+	db.metricsHandler.Counter(metrics.TaskQueueVersioningDataRepaired.GetMetricName()).Record(1)
+	data := *userData.GetData()
+	data.VersioningData = versioningData
+	return &persistencespb.VersionedTaskQueueUserData{Version: userData.GetVersion(), Data: &data}
+}
+
 // UpdateUserData allows callers to update user data (such as worker build IDs) for this task queue. The pointer passed
 // to the update function is guaranteed to be non-nil.
 // Note that the user data's clock may be nil and should be initialized externally where there's access to the cluster
@@ -355,7 +392,7 @@ func (db *taskQueueDB) getUserDataLocked(
 // The DB write is performed remotely on an owning node for all user data updates in the namespace.
 //
 // On success returns a pointer to the updated data, which must *not* be mutated.
-func (db *taskQueueDB) UpdateUserData(ctx context.Context, updateFn func(*persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, error), taskQueueLimitPerBuildId int) (*persistencespb.VersionedTaskQueueUserData, error) {
+func (db *taskQueueDB) UpdateUserData(ctx context.Context, updateFn func(*persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, error), taskQueueLimitPerBuildId int, maxUserDataSize int, knownVersion int64) (*persistencespb.VersionedTaskQueueUserData, error) {
 	if !db.DbStoresUserData() {
 		return nil, errUserDataNoMutateNonRoot
 	}
@@ -366,6 +403,9 @@ func (db *taskQueueDB) UpdateUserData(ctx context.Context, updateFn func(*persis
 	if err != nil {
 		return nil, err
 	}
+	if knownVersion > 0 && userData.GetVersion() != knownVersion {
+		return nil, serviceerror.NewUnavailable("user data was modified concurrently, please try again")
+	}
 
 	preUpdateData := userData.GetData()
 	if preUpdateData == nil {
@@ -375,6 +415,10 @@ func (db *taskQueueDB) UpdateUserData(ctx context.Context, updateFn func(*persis
 	if err != nil {
 		return nil, err
 	}
+	updatedUserData = stampVersioningDataSchema(updatedUserData)
+	if size := updatedUserData.Size(); maxUserDataSize > 0 && size > maxUserDataSize {
+		return nil, serviceerror.NewFailedPrecondition(fmt.Sprintf("Update would grow task queue user data to %d bytes, exceeding the limit of %d bytes", size, maxUserDataSize))
+	}
 	added, removed := GetBuildIdDeltas(preUpdateData.GetVersioningData(), updatedUserData.GetVersioningData())
 	if taskQueueLimitPerBuildId > 0 && len(added) > 0 {
 		// We iterate here but in practice there should only be a single build Id added when the limit is enforced.
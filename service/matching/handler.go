@@ -40,6 +40,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
 )
 
 type (
@@ -76,6 +77,7 @@ func NewHandler(
 	namespaceRegistry namespace.Registry,
 	clusterMetadata cluster.Metadata,
 	namespaceReplicationQueue persistence.NamespaceReplicationQueue,
+	visibilityManager manager.VisibilityManager,
 ) *Handler {
 	handler := &Handler{
 		config:          config,
@@ -93,6 +95,7 @@ func NewHandler(
 			matchingServiceResolver,
 			clusterMetadata,
 			namespaceReplicationQueue,
+			visibilityManager,
 		),
 		namespaceRegistry: namespaceRegistry,
 	}
@@ -325,6 +328,115 @@ func (h *Handler) ApplyTaskQueueUserDataReplicationEvent(
 	return h.engine.ApplyTaskQueueUserDataReplicationEvent(ctx, request)
 }
 
+// DescribeVersioning describes the versioning state of a task queue, aggregated across its partitions
+func (h *Handler) DescribeVersioning(
+	ctx context.Context,
+	request *matchingservice.DescribeVersioningRequest,
+) (_ *matchingservice.DescribeVersioningResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.DescribeVersioning(ctx, request)
+}
+
+// CleanupUnreachableBuildIds removes all unreachable build ids from the versioning data of a task queue
+func (h *Handler) CleanupUnreachableBuildIds(
+	ctx context.Context,
+	request *matchingservice.CleanupUnreachableBuildIdsRequest,
+) (_ *matchingservice.CleanupUnreachableBuildIdsResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.CleanupUnreachableBuildIds(ctx, request)
+}
+
+// GetDefaultBuildIdTimeline returns the history of default build id changes of a task queue
+func (h *Handler) GetDefaultBuildIdTimeline(
+	ctx context.Context,
+	request *matchingservice.GetDefaultBuildIdTimelineRequest,
+) (_ *matchingservice.GetDefaultBuildIdTimelineResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.GetDefaultBuildIdTimeline(ctx, request)
+}
+
+// ValidateDefaultBuildIdSwitch reports the open workflows affected by making a build id the new default of a task queue
+func (h *Handler) ValidateDefaultBuildIdSwitch(
+	ctx context.Context,
+	request *matchingservice.ValidateDefaultBuildIdSwitchRequest,
+) (_ *matchingservice.ValidateDefaultBuildIdSwitchResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.ValidateDefaultBuildIdSwitch(ctx, request)
+}
+
+// GetClosedWorkflowBuildId reports the build id a closed workflow last ran on and whether it is still registered
+func (h *Handler) GetClosedWorkflowBuildId(
+	ctx context.Context,
+	request *matchingservice.GetClosedWorkflowBuildIdRequest,
+) (_ *matchingservice.GetClosedWorkflowBuildIdResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.GetClosedWorkflowBuildId(ctx, request)
+}
+
+// GetUserDataPropagationStatus reports whether the user data of a page of versioned task queues of a namespace has
+// reached all of their partitions
+func (h *Handler) GetUserDataPropagationStatus(
+	ctx context.Context,
+	request *matchingservice.GetUserDataPropagationStatusRequest,
+) (_ *matchingservice.GetUserDataPropagationStatusResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.GetUserDataPropagationStatus(ctx, request)
+}
+
+// ApplyVersioningTemplate creates the version sets of a task queue from a versioning template of its namespace
+func (h *Handler) ApplyVersioningTemplate(
+	ctx context.Context,
+	request *matchingservice.ApplyVersioningTemplateRequest,
+) (_ *matchingservice.ApplyVersioningTemplateResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.ApplyVersioningTemplate(ctx, request)
+}
+
+// ReassignBuildId repins a page of the open workflows of a task queue pinned to a build id onto a target build id
+func (h *Handler) ReassignBuildId(
+	ctx context.Context,
+	request *matchingservice.ReassignBuildIdRequest,
+) (_ *matchingservice.ReassignBuildIdResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.ReassignBuildId(ctx, request)
+}
+
+// GetTaskDispatchDecision reports the build id a hypothetical task would be dispatched to
+func (h *Handler) GetTaskDispatchDecision(
+	ctx context.Context,
+	request *matchingservice.GetTaskDispatchDecisionRequest,
+) (_ *matchingservice.GetTaskDispatchDecisionResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.GetTaskDispatchDecision(ctx, request)
+}
+
+// EnableWorkerVersioning registers the first build id of a task queue, refusing to do so if no worker polls with it
+func (h *Handler) EnableWorkerVersioning(
+	ctx context.Context,
+	request *matchingservice.EnableWorkerVersioningRequest,
+) (_ *matchingservice.EnableWorkerVersioningResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.EnableWorkerVersioning(ctx, request)
+}
+
+// ListWorkerBuildIdCompatibility lists the compatible version sets of every versioned task queue of a namespace
+func (h *Handler) ListWorkerBuildIdCompatibility(
+	ctx context.Context,
+	request *matchingservice.ListWorkerBuildIdCompatibilityRequest,
+) (_ *matchingservice.ListWorkerBuildIdCompatibilityResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.ListWorkerBuildIdCompatibility(ctx, request)
+}
+
+// GetBuildIdReachability reports whether a build id of a task queue can still receive new or existing workflows
+func (h *Handler) GetBuildIdReachability(
+	ctx context.Context,
+	request *matchingservice.GetBuildIdReachabilityRequest,
+) (_ *matchingservice.GetBuildIdReachabilityResponse, retError error) {
+	defer log.CapturePanic(h.logger, &retError)
+	return h.engine.GetBuildIdReachability(ctx, request)
+}
+
 func (h *Handler) GetBuildIdTaskQueueMapping(
 	ctx context.Context,
 	request *matchingservice.GetBuildIdTaskQueueMappingRequest,
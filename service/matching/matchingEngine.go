@@ -30,11 +30,15 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/pborman/uuid"
+	"github.com/xwb1989/sqlparser"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
@@ -48,6 +52,7 @@ import (
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	tokenspb "go.temporal.io/server/api/token/v1"
+	matchingclient "go.temporal.io/server/client/matching"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/clock"
 	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
@@ -58,8 +63,12 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/primitives/timestamp"
+	"go.temporal.io/server/common/searchattribute"
 	serviceerrors "go.temporal.io/server/common/serviceerror"
+	"go.temporal.io/server/common/singleflight"
+	"go.temporal.io/server/common/util"
 )
 
 const (
@@ -69,6 +78,18 @@ const (
 
 	recordTaskStartedDefaultTimeout   = 10 * time.Second
 	recordTaskStartedSyncMatchTimeout = 1 * time.Second
+
+	// Reasons for bouncing a task off a sticky queue back to the normal queue, recorded in StickyTaskBouncedCounter.
+	stickyBounceReasonNoRecentPoller          metrics.ReasonString = "no_recent_poller"
+	stickyBounceReasonPinnedBuildIdNotDefault metrics.ReasonString = "pinned_build_id_not_default"
+	stickyBounceReasonScheduleToStartTimeout  metrics.ReasonString = "schedule_to_start_timeout"
+
+	userDataPropagationStatusPageSize = 100
+	reassignBuildIdPageSize           = 100
+	// Default page size of DescribeVersioning, in live build ids.
+	describeVersioningPageSize = 50
+	// Default page size of ListWorkerBuildIdCompatibility, in task queues.
+	listWorkerBuildIdCompatibilityPageSize = 100
 )
 
 // Implements matching.Engine
@@ -126,6 +147,9 @@ type (
 		namespaceUpdateLockMap map[string]*namespaceUpdateLocks
 		// Serializes access to the per namespace lock map
 		namespaceUpdateLockMapLock sync.Mutex
+		visibilityManager          manager.VisibilityManager
+		// Coalesces concurrent identical GetTaskQueueUserData long polls on a partition
+		userDataLongPolls singleflight.Group[*matchingservice.GetTaskQueueUserDataResponse]
 	}
 )
 
@@ -138,6 +162,9 @@ var (
 	// ErrNoTasks is exported temporarily for integration test
 	ErrNoTasks    = errors.New("no tasks")
 	errPumpClosed = errors.New("task queue pump closed its channel")
+	// errRetiredBuildIdTaskExpired is returned when a spooled task waited for a drained or deleted build id for longer
+	// than the configured TTL and should be dropped.
+	errRetiredBuildIdTaskExpired = errors.New("task for retired build id expired")
 
 	pollerIDKey pollerIDCtxKey = "pollerID"
 	identityKey identityCtxKey = "identity"
@@ -157,6 +184,7 @@ func NewEngine(
 	resolver membership.ServiceResolver,
 	clusterMeta cluster.Metadata,
 	namespaceReplicationQueue persistence.NamespaceReplicationQueue,
+	visibilityManager manager.VisibilityManager,
 ) Engine {
 
 	return &matchingEngineImpl{
@@ -177,6 +205,7 @@ func NewEngine(
 		timeSource:                clock.NewRealTimeSource(), // No need to mock this at the moment
 		namespaceReplicationQueue: namespaceReplicationQueue,
 		namespaceUpdateLockMap:    make(map[string]*namespaceUpdateLocks),
+		visibilityManager:         visibilityManager,
 	}
 }
 
@@ -314,18 +343,32 @@ func (e *matchingEngineImpl) AddWorkflowTask(
 	}
 
 	sticky := stickyInfo.kind == enumspb.TASK_QUEUE_KIND_STICKY
+	var stickyTimeout time.Duration
+	pollerUnavailableWindow := stickyPollerUnavailableWindow
+	if sticky {
+		stickyTimeout, err = e.stickyScheduleToStartTimeout(namespaceID, addRequest.VersionDirective.GetBuildId())
+		if err != nil {
+			return false, err
+		}
+		if stickyTimeout > 0 && stickyTimeout < pollerUnavailableWindow {
+			pollerUnavailableWindow = stickyTimeout
+		}
+	}
 	// do not load sticky task queue if it is not already loaded, which means it has no poller.
 	tqm, err := e.getTaskQueueManager(ctx, taskQueue, stickyInfo, !sticky)
 	if err != nil {
 		return false, err
-	} else if sticky && (tqm == nil || !tqm.HasPollerAfter(time.Now().Add(-stickyPollerUnavailableWindow))) {
-		return false, serviceerrors.NewStickyWorkerUnavailable()
+	} else if sticky && (tqm == nil || !tqm.HasPollerAfter(time.Now().Add(-pollerUnavailableWindow))) {
+		return false, e.bounceStickyTask(stickyBounceReasonNoRecentPoller)
 	}
 
 	// This needs to move to history see - https://go.temporal.io/server/issues/181
 	var expirationTime *time.Time
 	now := timestamp.TimePtr(time.Now().UTC())
 	expirationDuration := timestamp.DurationValue(addRequest.GetScheduleToStartTimeout())
+	if stickyTimeout > 0 && (expirationDuration == 0 || stickyTimeout < expirationDuration) {
+		expirationDuration = stickyTimeout
+	}
 	if expirationDuration == 0 {
 		// noop
 	} else {
@@ -342,12 +385,18 @@ func (e *matchingEngineImpl) AddWorkflowTask(
 		VersionDirective: addRequest.VersionDirective,
 	}
 
-	return tqm.AddTask(ctx, addTaskParams{
+	syncMatch, err := tqm.AddTask(ctx, addTaskParams{
 		execution:     addRequest.Execution,
 		taskInfo:      taskInfo,
 		source:        addRequest.GetSource(),
 		forwardedFrom: addRequest.GetForwardedSource(),
+		// the task is bounced back to the normal queue rather than spooled if the sticky poller doesn't take it in time
+		syncMatchTimeout: stickyTimeout,
 	})
+	if errors.Is(err, errSyncMatchTimedOut) {
+		return false, e.bounceStickyTask(stickyBounceReasonScheduleToStartTimeout)
+	}
+	return syncMatch, err
 }
 
 // AddActivityTask either delivers task directly to waiting poller or save it into task queue persistence.
@@ -417,25 +466,118 @@ func (e *matchingEngineImpl) DispatchSpooledTask(
 	// If this came from a versioned queue, ignore the version and re-resolve, in case we're
 	// going to the default and the default changed.
 	unversionedOrigTaskQueue := newTaskQueueIDWithVersionSet(origTaskQueue, "")
+	sticky := stickyInfo.kind == enumspb.TASK_QUEUE_KIND_STICKY
+	versionSet := origTaskQueue.VersionSet()
 	// Redirect and re-resolve if we're blocked in matcher and user data changes.
 	for {
+		taskDirective := directive
+		var expiry time.Time
+		if buildId := directive.GetBuildId(); buildId != "" && !sticky {
+			var reroute bool
+			var err error
+			expiry, reroute, err = e.getRetiredBuildIdTaskExpiry(ctx, unversionedOrigTaskQueue, buildId, taskInfo)
+			if err != nil {
+				return err
+			}
+			if !expiry.IsZero() && !e.timeSource.Now().Before(expiry) {
+				if !reroute {
+					return errRetiredBuildIdTaskExpired
+				}
+				taskDirective = &taskqueuespb.TaskVersionDirective{
+					Value: &taskqueuespb.TaskVersionDirective_UseDefault{UseDefault: &types.Empty{}},
+				}
+				expiry = time.Time{}
+			}
+		}
 		taskQueue, userDataChanged, err := e.redirectToVersionedQueueForAdd(
-			ctx, unversionedOrigTaskQueue, directive, stickyInfo)
+			ctx, unversionedOrigTaskQueue, taskDirective, stickyInfo)
+		if sticky && common.IsStickyWorkerUnavailable(err) && stickyInfo.normalName != "" {
+			// The build id of this sticky queue is not the default of its set anymore and its pollers are going
+			// away. Move the task to the normal queue so the new default picks it up instead of waiting for the
+			// sticky timeout.
+			unversionedOrigTaskQueue, err = newTaskQueueID(origTaskQueue.namespaceID, stickyInfo.normalName, origTaskQueue.taskType)
+			if err != nil {
+				return err
+			}
+			stickyInfo = normalStickyInfo
+			sticky = false
+			continue
+		}
 		if err != nil {
 			return err
 		}
-		sticky := stickyInfo.kind == enumspb.TASK_QUEUE_KIND_STICKY
+		if taskQueue.VersionSet() != versionSet {
+			if versionSet != "" {
+				e.emitVersionSetRedirectStats(taskQueue, versionSet)
+			}
+			versionSet = taskQueue.VersionSet()
+		}
 		tqm, err := e.getTaskQueueManager(ctx, taskQueue, stickyInfo, !sticky)
 		if err != nil {
 			return err
 		}
+		// Re-resolve once the task expires if it's still blocked in matcher by then.
+		stopInterrupt := func() {}
+		if !expiry.IsZero() {
+			userDataChanged, stopInterrupt = interruptAfter(userDataChanged, expiry.Sub(e.timeSource.Now()))
+		}
 		err = tqm.DispatchSpooledTask(ctx, task, userDataChanged)
+		stopInterrupt()
 		if err != errInterrupted {
 			return err
 		}
 	}
 }
 
+// getRetiredBuildIdTaskExpiry returns when a spooled task pinned to buildId expires because that build id is no longer
+// active (or the zero time if it doesn't expire), and whether it should be re-routed to the default set once expired.
+func (e *matchingEngineImpl) getRetiredBuildIdTaskExpiry(
+	ctx context.Context,
+	taskQueue *taskQueueID,
+	buildId string,
+	taskInfo *persistencespb.TaskInfo,
+) (time.Time, bool, error) {
+	namespace, err := e.namespaceRegistry.GetNamespaceName(taskQueue.namespaceID)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	ttl := e.config.RetiredBuildIdTaskTTL(namespace.String(), taskQueue.BaseNameString(), taskQueue.taskType)
+	if ttl <= 0 {
+		return time.Time{}, false, nil
+	}
+	tqm, err := e.getTaskQueueManager(ctx, taskQueue, normalStickyInfo, true)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	userData, _, err := tqm.GetUserData(ctx)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	data := userData.GetData().GetVersioningData()
+	expiry := retiredBuildIdTaskExpiry(data, buildId, timestamp.TimeValue(taskInfo.GetCreateTime()), ttl)
+	reroute := e.config.RerouteExpiredRetiredBuildIdTasks(namespace.String(), taskQueue.BaseNameString(), taskQueue.taskType)
+	return expiry, reroute, nil
+}
+
+// interruptAfter returns a channel that is closed when either interruptCh is closed or d elapses, along with a
+// function to release the associated resources.
+func interruptAfter(interruptCh chan struct{}, d time.Duration) (chan struct{}, func()) {
+	merged := make(chan struct{})
+	done := make(chan struct{})
+	timer := time.NewTimer(d)
+	go func() {
+		defer timer.Stop()
+		select {
+		case <-interruptCh:
+		case <-timer.C:
+		case <-done:
+			return
+		}
+		close(merged)
+	}()
+	return merged, func() { close(done) }
+}
+
 // PollWorkflowTaskQueue tries to get the workflow task using exponential backoff.
 func (e *matchingEngineImpl) PollWorkflowTaskQueue(
 	ctx context.Context,
@@ -544,6 +686,7 @@ pollLoop:
 			continue pollLoop
 		}
 		task.finish(nil)
+		e.emitCompatibleBuildIdDispatchStats(opMetrics, task, request.WorkerVersionCapabilities)
 		return e.createPollWorkflowTaskQueueResponse(task, resp, opMetrics), nil
 	}
 }
@@ -667,7 +810,7 @@ func (e *matchingEngineImpl) QueryWorkflow(
 	if err != nil {
 		return nil, err
 	} else if sticky && (tqm == nil || !tqm.HasPollerAfter(time.Now().Add(-stickyPollerUnavailableWindow))) {
-		return nil, serviceerrors.NewStickyWorkerUnavailable()
+		return nil, e.bounceStickyTask(stickyBounceReasonNoRecentPoller)
 	}
 
 	taskID := uuid.New()
@@ -766,7 +909,31 @@ func (e *matchingEngineImpl) DescribeTaskQueue(
 		return nil, err
 	}
 
-	return tlMgr.DescribeTaskQueue(request.DescRequest.GetIncludeTaskQueueStatus()), nil
+	includeStatus := request.DescRequest.GetIncludeTaskQueueStatus()
+	response := tlMgr.DescribeTaskQueue(includeStatus)
+	if includeStatus {
+		// the manager without a version set is the unversioned queue
+		response.UnversionedBacklogCountHint = response.GetTaskQueueStatus().GetBacklogCountHint()
+	}
+	for _, versionSetId := range request.GetVersionSetIds() {
+		// Versioned queues are only loaded on demand, don't create one just to describe it.
+		versionedMgr, err := e.getTaskQueueManager(ctx, newTaskQueueIDWithVersionSet(taskQueue, versionSetId), stickyInfo, false)
+		if err != nil {
+			return nil, err
+		}
+		if versionedMgr == nil {
+			continue
+		}
+		versionedResponse := versionedMgr.DescribeTaskQueue(includeStatus)
+		response.Pollers = append(response.Pollers, versionedResponse.GetPollers()...)
+		if includeStatus {
+			if response.VersionedBacklogCountHints == nil {
+				response.VersionedBacklogCountHints = make(map[string]int64)
+			}
+			response.VersionedBacklogCountHints[versionSetId] = versionedResponse.GetTaskQueueStatus().GetBacklogCountHint()
+		}
+	}
+	return response, nil
 }
 
 func (e *matchingEngineImpl) ListTaskQueuePartitions(
@@ -820,18 +987,40 @@ func (e *matchingEngineImpl) UpdateWorkerBuildIdCompatibility(
 	req *matchingservice.UpdateWorkerBuildIdCompatibilityRequest,
 ) (*matchingservice.UpdateWorkerBuildIdCompatibilityResponse, error) {
 	namespaceID := namespace.ID(req.GetNamespaceId())
-	taskQueueName := req.GetRequest().GetTaskQueue()
+	taskQueueName := req.GetTaskQueue()
+	if taskQueueName == "" {
+		// sent by a frontend that predates the task_queue field
+		taskQueueName = req.GetRequest().GetTaskQueue()
+	}
 	taskQueue, err := newTaskQueueID(namespaceID, taskQueueName, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
 	if err != nil {
 		return nil, err
 	}
+	ns, err := e.namespaceRegistry.GetNamespaceByID(namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	nsName := ns.Name()
 	tqMgr, err := e.getTaskQueueManager(ctx, taskQueue, normalStickyInfo, true)
 	if err != nil {
 		return nil, err
 	}
+	if removeBuildId := req.GetRemoveBuildId(); removeBuildId != nil {
+		// Workflows that started after this check can only be assigned to the build id if it is a set default, which
+		// RemoveBuildId refuses unless the set has no other live build id.
+		openWorkflowCount, err := e.countOpenWorkflowsByBuildId(ctx, ns, taskQueue, removeBuildId.GetBuildId())
+		if err != nil {
+			return nil, err
+		}
+		if openWorkflowCount > 0 {
+			return nil, serviceerror.NewFailedPrecondition(fmt.Sprintf(
+				"build id %v still has %d open workflows", removeBuildId.GetBuildId(), openWorkflowCount))
+		}
+	}
 	updateOptions := UserDataUpdateOptions{
 		Replicate:                true,
 		TaskQueueLimitPerBuildId: e.config.TaskQueueLimitPerBuildId(),
+		MaxUserDataSize:          e.config.UserDataSizeLimit(),
 	}
 	err = tqMgr.UpdateUserData(ctx, updateOptions, func(data *persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, error) {
 		clock := data.GetClock()
@@ -839,17 +1028,63 @@ func (e *matchingEngineImpl) UpdateWorkerBuildIdCompatibility(
 			tmp := hlc.Zero(e.clusterMeta.GetClusterID())
 			clock = &tmp
 		}
-		updatedClock := hlc.Next(*clock, e.timeSource)
-		versioningData, err := UpdateVersionSets(
-			updatedClock,
-			data.GetVersioningData(),
-			req.GetRequest(),
-			e.config.VersionCompatibleSetLimitPerQueue(),
-			e.config.VersionBuildIdLimitPerQueue(),
-		)
+		updatedClock := e.nextClock(*clock)
+		var versioningData *persistencespb.VersioningData
+		var err error
+		switch req.GetOperation().(type) {
+		case *matchingservice.UpdateWorkerBuildIdCompatibilityRequest_Request:
+			versioningData, err = UpdateVersionSets(
+				updatedClock,
+				data.GetVersioningData(),
+				req.GetRequest(),
+				e.config.VersionCompatibleSetLimitPerQueue(),
+				e.config.VersionBuildIdLimitPerQueue(),
+				e.config.VersionBuildIdLimitPerSet(),
+			)
+		case *matchingservice.UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining_:
+			versioningData, err = MarkBuildIdDraining(
+				updatedClock,
+				data.GetVersioningData(),
+				req.GetMarkBuildIdDraining().GetBuildId(),
+			)
+		case *matchingservice.UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet_:
+			versioningData, err = SwapBuildIdsWithinSet(
+				updatedClock,
+				data.GetVersioningData(),
+				req.GetSwapBuildIdsWithinSet().GetFirstBuildId(),
+				req.GetSwapBuildIdsWithinSet().GetSecondBuildId(),
+			)
+		case *matchingservice.UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets_:
+			versioningData, err = SwapDefaultSets(
+				updatedClock,
+				data.GetVersioningData(),
+				req.GetSwapDefaultSets().GetFirstBuildId(),
+				req.GetSwapDefaultSets().GetSecondBuildId(),
+			)
+		case *matchingservice.UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels_:
+			versioningData, err = SetBuildIdLabels(
+				updatedClock,
+				data.GetVersioningData(),
+				req.GetSetBuildIdLabels().GetBuildId(),
+				req.GetSetBuildIdLabels().GetLabels(),
+				e.config.VersionBuildIdLabelsSizeLimit(),
+			)
+		case *matchingservice.UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId_:
+			versioningData, err = RemoveBuildId(
+				updatedClock,
+				data.GetVersioningData(),
+				req.GetRemoveBuildId().GetBuildId(),
+			)
+		default:
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("invalid operation: %v", req.GetOperation()))
+		}
 		if err != nil {
 			return nil, err
 		}
+		if retention := e.config.VersioningAuditLogRetention(nsName.String()); retention > 0 {
+			boundary := hlc.Clock{WallClock: updatedClock.GetWallClock() - retention.Milliseconds()}
+			versioningData = CompactAuditLog(versioningData, boundary)
+		}
 		// Avoid mutation
 		ret := *data
 		ret.Clock = &updatedClock
@@ -872,154 +1107,1292 @@ func (e *matchingEngineImpl) GetWorkerBuildIdCompatibility(
 	if err != nil {
 		return nil, err
 	}
+	var data *persistencespb.TaskQueueUserData
 	tqMgr, err := e.getTaskQueueManager(ctx, taskQueue, normalStickyInfo, true)
+	if err == nil {
+		var userData *persistencespb.VersionedTaskQueueUserData
+		userData, _, err = tqMgr.GetUserData(ctx)
+		data = userData.GetData()
+	}
 	if err != nil {
-		if _, ok := err.(*serviceerror.NotFound); ok {
+		if _, ok := err.(*serviceerror.NotFound); !ok {
+			return nil, err
+		}
+		if req.GetHypotheticalUpdate() == nil {
 			return &matchingservice.GetWorkerBuildIdCompatibilityResponse{}, nil
 		}
-		return nil, err
 	}
-	userData, _, err := tqMgr.GetUserData(ctx)
-	if err != nil {
-		if _, ok := err.(*serviceerror.NotFound); ok {
-			return &matchingservice.GetWorkerBuildIdCompatibilityResponse{}, nil
+	versioningData := data.GetVersioningData()
+	if update := req.GetHypotheticalUpdate(); update != nil {
+		// Compute the result of the update the same way UpdateWorkerBuildIdCompatibility would, without persisting it
+		clock := data.GetClock()
+		if clock == nil {
+			tmp := hlc.Zero(e.clusterMeta.GetClusterID())
+			clock = &tmp
+		}
+		versioningData, err = UpdateVersionSets(
+			e.nextClock(*clock),
+			versioningData,
+			update,
+			e.config.VersionCompatibleSetLimitPerQueue(),
+			e.config.VersionBuildIdLimitPerQueue(),
+			e.config.VersionBuildIdLimitPerSet(),
+		)
+		if err != nil {
+			return nil, err
 		}
-		return nil, err
 	}
+	response := ToBuildIdOrderingResponse(versioningData, int(req.GetRequest().GetMaxSets()))
 	return &matchingservice.GetWorkerBuildIdCompatibilityResponse{
-		Response: ToBuildIdOrderingResponse(userData.GetData().GetVersioningData(), int(req.GetRequest().GetMaxSets())),
+		Response:      response,
+		BuildIdLabels: ToBuildIdLabelsResponse(versioningData, response),
 	}, nil
 }
 
-func (e *matchingEngineImpl) GetTaskQueueUserData(
+// DescribeVersioning aggregates, for every version set and live build id in a page of a task queue's version sets,
+// whether it is the default, its state, the number of pollers currently polling with it across all partitions, the
+// number of open workflows that have run on it, and its reachability. Pages are bounded in live build ids since each
+// of them is counted with a visibility query.
+func (e *matchingEngineImpl) DescribeVersioning(
 	ctx context.Context,
-	req *matchingservice.GetTaskQueueUserDataRequest,
-) (*matchingservice.GetTaskQueueUserDataResponse, error) {
+	req *matchingservice.DescribeVersioningRequest,
+) (*matchingservice.DescribeVersioningResponse, error) {
 	namespaceID := namespace.ID(req.GetNamespaceId())
-	taskQueue, err := newTaskQueueID(namespaceID, req.GetTaskQueue(), req.GetTaskQueueType())
+	ns, err := e.namespaceRegistry.GetNamespaceByID(namespaceID)
 	if err != nil {
 		return nil, err
 	}
+	taskQueue, err := newTaskQueueID(namespaceID, req.GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+	if !taskQueue.IsRoot() {
+		return nil, serviceerror.NewInvalidArgument("versioning can only be described on the root partition")
+	}
 	tqMgr, err := e.getTaskQueueManager(ctx, taskQueue, normalStickyInfo, true)
 	if err != nil {
 		return nil, err
 	}
-	version := req.GetLastKnownUserDataVersion()
-	if version < 0 {
-		return nil, serviceerror.NewInvalidArgument("last_known_user_data_version must not be negative")
+	userData, _, err := tqMgr.GetUserData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data := userData.GetData().GetVersioningData()
+	if len(data.GetVersionSets()) == 0 {
+		return &matchingservice.DescribeVersioningResponse{}, nil
 	}
 
-	if req.WaitNewData {
-		var cancel context.CancelFunc
-		ctx, cancel = newChildContext(ctx, e.config.GetUserDataLongPollTimeout(), returnEmptyTaskTimeBudget)
-		defer cancel()
+	pageSize := int(req.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = describeVersioningPageSize
+	}
+	sets, nextPageToken, err := pageVersionSets(data, req.GetNextPageToken(), pageSize)
+	if err != nil {
+		return nil, err
 	}
 
-	for {
-		resp := &matchingservice.GetTaskQueueUserDataResponse{}
-		userData, userDataChanged, err := tqMgr.GetUserData(ctx)
+	setIds := make([]string, len(sets))
+	for i, set := range sets {
+		setIds[i] = getSetID(set)
+	}
+	pollerCounts, err := e.countPollersByBuildId(ctx, ns, taskQueue, setIds)
+	if err != nil {
+		return nil, err
+	}
+	// Draining defaults are skipped when assigning new workflows, so this is not necessarily the default set.
+	newWorkflowsSetId, err := lookupVersionSetForAdd(data, "")
+	if err != nil {
+		return nil, err
+	}
+
+	numSets := len(data.GetVersionSets())
+	versionSets, err := util.MapConcurrent(sets, func(set *persistencespb.CompatibleVersionSet) (*matchingservice.DescribeVersioningResponse_VersionSet, error) {
+		reachability, err := e.getVersionSetReachability(ctx, ns, taskQueue, set, getSetID(set) == newWorkflowsSetId)
 		if err != nil {
 			return nil, err
 		}
-		if req.WaitNewData && userData.GetVersion() == version {
-			// long-poll: wait for data to change/appear
-			select {
-			case <-ctx.Done():
-				resp.TaskQueueHasUserData = userData != nil
-				return resp, nil
-			case <-userDataChanged:
+		setDefaultIdx := len(set.GetBuildIds()) - 1
+		buildIds := make([]*matchingservice.DescribeVersioningResponse_BuildIdInfo, 0, len(set.GetBuildIds()))
+		for idx, buildId := range set.GetBuildIds() {
+			if !isBuildIdLive(buildId) {
 				continue
 			}
+			openWorkflowCount, err := e.countOpenWorkflowsByBuildId(ctx, ns, taskQueue, buildId.GetId())
+			if err != nil {
+				return nil, err
+			}
+			info := &matchingservice.DescribeVersioningResponse_BuildIdInfo{
+				BuildId:           buildId.GetId(),
+				IsSetDefault:      idx == setDefaultIdx,
+				State:             buildId.GetState(),
+				PollerCount:       pollerCounts[buildId.GetId()],
+				Reachability:      []enumspb.TaskReachability{},
+				OpenWorkflowCount: openWorkflowCount,
+			}
+			// Only the set default is dispatched to, older build ids in the set are unreachable.
+			if info.IsSetDefault {
+				info.Reachability = reachability
+			}
+			buildIds = append(buildIds, info)
 		}
-		if userData != nil {
-			resp.TaskQueueHasUserData = true
-			if userData.Version > version {
-				resp.UserData = userData
-			} else if userData.Version < version {
-				// This is highly unlikely but may happen due to an edge case in during ownership transfer.
-				// We rely on client retries in this case to let the system eventually self-heal.
-				return nil, serviceerror.NewFailedPrecondition(
-					"requested task queue user data for version greater than known version")
+		return &matchingservice.DescribeVersioningResponse_VersionSet{
+			BuildIds:  buildIds,
+			IsDefault: set == data.GetVersionSets()[numSets-1],
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.DescribeVersioningResponse{
+		VersionSets:   versionSets,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// pageVersionSets returns the version sets of a page of DescribeVersioning and the token of the next page. A page
+// starts at the set whose id is the page token, or at the oldest set, and holds whole sets until the next one would
+// exceed pageSize live build ids. Every live build id costs a visibility query, bounding them bounds a page.
+func pageVersionSets(
+	data *persistencespb.VersioningData,
+	pageToken []byte,
+	pageSize int,
+) ([]*persistencespb.CompatibleVersionSet, []byte, error) {
+	versionSets := data.GetVersionSets()
+	start := 0
+	if len(pageToken) > 0 {
+		start = -1
+		for i, set := range versionSets {
+			if getSetID(set) == string(pageToken) {
+				start = i
+				break
 			}
 		}
-		return resp, nil
+		if start < 0 {
+			return nil, nil, serviceerror.NewInvalidArgument("version sets changed since the previous page, start over")
+		}
+	}
+
+	end, buildIds := start, 0
+	for end < len(versionSets) {
+		live := 0
+		for _, buildId := range versionSets[end].GetBuildIds() {
+			if isBuildIdLive(buildId) {
+				live++
+			}
+		}
+		if end > start && buildIds+live > pageSize {
+			break
+		}
+		buildIds += live
+		end++
+	}
+	var nextPageToken []byte
+	if end < len(versionSets) {
+		nextPageToken = []byte(getSetID(versionSets[end]))
 	}
+	return versionSets[start:end], nextPageToken, nil
 }
 
-func (e *matchingEngineImpl) ApplyTaskQueueUserDataReplicationEvent(
+// CleanupUnreachableBuildIds removes, in a single user data update, every build id of a task queue that is unreachable
+// as reported by DescribeVersioning: build ids that are not their set default, and all build ids of sets that are not
+// reachable by new, open or closed workflows.
+func (e *matchingEngineImpl) CleanupUnreachableBuildIds(
 	ctx context.Context,
-	req *matchingservice.ApplyTaskQueueUserDataReplicationEventRequest,
-) (*matchingservice.ApplyTaskQueueUserDataReplicationEventResponse, error) {
+	req *matchingservice.CleanupUnreachableBuildIdsRequest,
+) (*matchingservice.CleanupUnreachableBuildIdsResponse, error) {
 	namespaceID := namespace.ID(req.GetNamespaceId())
-	taskQueueName := req.GetTaskQueue()
-	taskQueue, err := newTaskQueueID(namespaceID, taskQueueName, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	ns, err := e.namespaceRegistry.GetNamespaceByID(namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	taskQueue, err := newTaskQueueID(namespaceID, req.GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
 	if err != nil {
 		return nil, err
 	}
+	if !taskQueue.IsRoot() {
+		return nil, serviceerror.NewInvalidArgument("unreachable build ids can only be cleaned up on the root partition")
+	}
 	tqMgr, err := e.getTaskQueueManager(ctx, taskQueue, normalStickyInfo, true)
 	if err != nil {
 		return nil, err
 	}
-	updateOptions := UserDataUpdateOptions{
-		Replicate: false,
-		// Avoid setting a limit to allow the replication event to always be applied
-		TaskQueueLimitPerBuildId: 0,
+	userData, _, err := tqMgr.GetUserData(ctx)
+	if err != nil {
+		return nil, err
 	}
-	err = tqMgr.UpdateUserData(ctx, updateOptions, func(current *persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, error) {
-		mergedUserData := *current
-		mergedUserData.VersioningData = MergeVersioningData(current.GetVersioningData(), req.GetUserData().GetVersioningData())
-		return &mergedUserData, nil
+	data := userData.GetData().GetVersioningData()
+	if len(data.GetVersionSets()) == 0 {
+		return &matchingservice.CleanupUnreachableBuildIdsResponse{}, nil
+	}
+
+	newWorkflowsSetId, err := lookupVersionSetForAdd(data, "")
+	if err != nil {
+		return nil, err
+	}
+	unreachableBySet, err := util.MapConcurrent(data.GetVersionSets(), func(set *persistencespb.CompatibleVersionSet) ([]string, error) {
+		reachability, err := e.getVersionSetReachability(ctx, ns, taskQueue, set, getSetID(set) == newWorkflowsSetId)
+		if err != nil {
+			return nil, err
+		}
+		setDefaultIdx := len(set.GetBuildIds()) - 1
+		var unreachable []string
+		for idx, buildId := range set.GetBuildIds() {
+			// Only the set default is dispatched to, older build ids in the set are unreachable.
+			if isBuildIdLive(buildId) && (idx != setDefaultIdx || len(reachability) == 0) {
+				unreachable = append(unreachable, buildId.GetId())
+			}
+		}
+		return unreachable, nil
 	})
-	return &matchingservice.ApplyTaskQueueUserDataReplicationEventResponse{}, err
+	if err != nil {
+		return nil, err
+	}
+	var removed []string
+	for _, unreachable := range unreachableBySet {
+		removed = append(removed, unreachable...)
+	}
+	if len(removed) == 0 {
+		return &matchingservice.CleanupUnreachableBuildIdsResponse{}, nil
+	}
+	if err := e.removeUnreachableBuildIds(ctx, tqMgr, userData, removed); err != nil {
+		return nil, err
+	}
+	return &matchingservice.CleanupUnreachableBuildIdsResponse{RemovedBuildIds: removed}, nil
 }
 
-func (e *matchingEngineImpl) GetBuildIdTaskQueueMapping(
-	ctx context.Context,
-	req *matchingservice.GetBuildIdTaskQueueMappingRequest,
-) (*matchingservice.GetBuildIdTaskQueueMappingResponse, error) {
-	taskQueues, err := e.taskManager.GetTaskQueuesByBuildId(ctx, &persistence.GetTaskQueuesByBuildIdRequest{
-		NamespaceID: req.NamespaceId,
-		BuildID:     req.BuildId,
+// scavengeStaleBuildIds deletes the live build ids of the compatible sets of a root workflow partition that have not
+// been the task queue default for longer than the build id scavenger retention and that no workflow can reach anymore.
+// Returns the deleted build ids.
+func (e *matchingEngineImpl) scavengeStaleBuildIds(ctx context.Context, tqMgr taskQueueManager, retention time.Duration) ([]string, error) {
+	taskQueue := tqMgr.QueueID()
+	ns, err := e.namespaceRegistry.GetNamespaceByID(taskQueue.namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	userData, _, err := tqMgr.GetUserData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data := userData.GetData().GetVersioningData()
+	boundary := hlc.Clock{WallClock: e.timeSource.Now().Add(-retention).UnixMilli()}
+	staleSets := StaleVersionSets(data, boundary)
+	if len(staleSets) == 0 {
+		return nil, nil
+	}
+
+	unreachableBySet, err := util.MapConcurrent(staleSets, func(set *persistencespb.CompatibleVersionSet) ([]string, error) {
+		// Stale sets are not the default, they never receive new workflows.
+		reachability, err := e.getVersionSetReachability(ctx, ns, taskQueue, set, false)
+		if err != nil || len(reachability) > 0 {
+			return nil, err
+		}
+		var unreachable []string
+		for _, buildId := range set.GetBuildIds() {
+			if isBuildIdLive(buildId) {
+				unreachable = append(unreachable, buildId.GetId())
+			}
+		}
+		return unreachable, nil
 	})
 	if err != nil {
 		return nil, err
 	}
-	return &matchingservice.GetBuildIdTaskQueueMappingResponse{TaskQueues: taskQueues}, nil
+	var removed []string
+	for _, unreachable := range unreachableBySet {
+		removed = append(removed, unreachable...)
+	}
+	if len(removed) == 0 {
+		return nil, nil
+	}
+	if err := e.removeUnreachableBuildIds(ctx, tqMgr, userData, removed); err != nil {
+		return nil, err
+	}
+	return removed, nil
 }
 
-func (e *matchingEngineImpl) ForceUnloadTaskQueue(
+// removeUnreachableBuildIds marks the given build ids as deleted in the user data of a root partition, provided that
+// its user data is still at the version their reachability was computed from.
+func (e *matchingEngineImpl) removeUnreachableBuildIds(
 	ctx context.Context,
-	req *matchingservice.ForceUnloadTaskQueueRequest,
-) (*matchingservice.ForceUnloadTaskQueueResponse, error) {
+	tqMgr taskQueueManager,
+	userData *persistencespb.VersionedTaskQueueUserData,
+	buildIds []string,
+) error {
+	updateOptions := UserDataUpdateOptions{
+		Replicate: true,
+		// Reachability was computed for the given user data, do not apply it to anything else.
+		KnownVersion: userData.GetVersion(),
+	}
+	return tqMgr.UpdateUserData(ctx, updateOptions, func(current *persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, error) {
+		clock := current.GetClock()
+		if clock == nil {
+			tmp := hlc.Zero(e.clusterMeta.GetClusterID())
+			clock = &tmp
+		}
+		updatedClock := e.nextClock(*clock)
+		// Avoid mutation
+		ret := *current
+		ret.Clock = &updatedClock
+		ret.VersioningData = RemoveBuildIds(updatedClock, current.GetVersioningData(), buildIds)
+		return &ret, nil
+	})
+}
+
+// GetDefaultBuildIdTimeline returns the task queue default build id transitions recorded in the versioning audit log,
+// ordered by timestamp.
+func (e *matchingEngineImpl) GetDefaultBuildIdTimeline(
+	ctx context.Context,
+	req *matchingservice.GetDefaultBuildIdTimelineRequest,
+) (*matchingservice.GetDefaultBuildIdTimelineResponse, error) {
 	namespaceID := namespace.ID(req.GetNamespaceId())
-	taskQueue, err := newTaskQueueID(namespaceID, req.TaskQueue, req.TaskQueueType)
+	taskQueue, err := newTaskQueueID(namespaceID, req.GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
 	if err != nil {
 		return nil, err
 	}
-	tqm, err := e.getTaskQueueManager(ctx, taskQueue, normalStickyInfo, false)
+	if !taskQueue.IsRoot() {
+		return nil, serviceerror.NewInvalidArgument("default build id timeline can only be read from the root partition")
+	}
+	tqMgr, err := e.getTaskQueueManager(ctx, taskQueue, normalStickyInfo, true)
 	if err != nil {
 		return nil, err
 	}
-	if tqm == nil {
-		return &matchingservice.ForceUnloadTaskQueueResponse{WasLoaded: false}, nil
+	userData, _, err := tqMgr.GetUserData(ctx)
+	if err != nil {
+		return nil, err
 	}
-	e.unloadTaskQueue(tqm)
-	return &matchingservice.ForceUnloadTaskQueueResponse{WasLoaded: true}, nil
-}
-
-func (e *matchingEngineImpl) UpdateTaskQueueUserData(ctx context.Context, request *matchingservice.UpdateTaskQueueUserDataRequest) (*matchingservice.UpdateTaskQueueUserDataResponse, error) {
-	locks := e.getNamespaceUpdateLocks(request.GetNamespaceId())
-	locks.updateLock.Lock()
-	defer locks.updateLock.Unlock()
-
-	err := e.taskManager.UpdateTaskQueueUserData(ctx, &persistence.UpdateTaskQueueUserDataRequest{
-		NamespaceID:     request.GetNamespaceId(),
-		TaskQueue:       request.GetTaskQueue(),
-		UserData:        request.GetUserData(),
-		BuildIdsAdded:   request.BuildIdsAdded,
-		BuildIdsRemoved: request.BuildIdsRemoved,
+	var timeline []*persistencespb.VersioningAuditEntry
+	// the default as of the compaction boundary stands for the compacted entries
+	if checkpoint := userData.GetData().GetVersioningData().GetAuditLogCheckpoint(); checkpoint != nil {
+		timeline = append(timeline, checkpoint)
+	}
+	timeline = append(timeline, userData.GetData().GetVersioningData().GetAuditLog()...)
+	sort.SliceStable(timeline, func(i, j int) bool {
+		return hlc.Less(*timeline[i].Timestamp, *timeline[j].Timestamp)
 	})
-	return &matchingservice.UpdateTaskQueueUserDataResponse{}, err
+	return &matchingservice.GetDefaultBuildIdTimelineResponse{Timeline: timeline}, nil
+}
+
+// ValidateDefaultBuildIdSwitch reports, without modifying the task queue, how many open workflows would be affected by
+// making the given build id the new default. Workflows already processed by a build id of the task queue keep running
+// on their version set, only workflows that have not yet been processed by any worker move to the new default.
+func (e *matchingEngineImpl) ValidateDefaultBuildIdSwitch(
+	ctx context.Context,
+	req *matchingservice.ValidateDefaultBuildIdSwitchRequest,
+) (*matchingservice.ValidateDefaultBuildIdSwitchResponse, error) {
+	namespaceID := namespace.ID(req.GetNamespaceId())
+	ns, err := e.namespaceRegistry.GetNamespaceByID(namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	taskQueue, err := newTaskQueueID(namespaceID, req.GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+	if !taskQueue.IsRoot() {
+		return nil, serviceerror.NewInvalidArgument("default build id switch can only be validated on the root partition")
+	}
+	if req.GetBuildId() == "" {
+		return nil, serviceerror.NewInvalidArgument("build id must be set")
+	}
+	tqMgr, err := e.getTaskQueueManager(ctx, taskQueue, normalStickyInfo, true)
+	if err != nil {
+		return nil, err
+	}
+	userData, _, err := tqMgr.GetUserData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data := userData.GetData().GetVersioningData()
+	if setIdx, _ := findVersion(data, req.GetBuildId()); setIdx != -1 {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("version %s already exists", req.GetBuildId()))
+	}
+	var escapedBuildIds []string
+	for _, set := range data.GetVersionSets() {
+		for _, buildId := range set.GetBuildIds() {
+			escapedBuildIds = append(escapedBuildIds, sqlparser.String(sqlparser.NewStrVal([]byte(common.VersionedBuildIdSearchAttribute(buildId.GetId())))))
+		}
+	}
+
+	count := func(filter string) (int64, error) {
+		countResponse, err := e.visibilityManager.CountWorkflowExecutions(ctx, &manager.CountWorkflowExecutionsRequest{
+			NamespaceID: ns.ID(),
+			Namespace:   ns.Name(),
+			Query: fmt.Sprintf(`%s = %q AND %s = "Running" AND %s`,
+				searchattribute.TaskQueue, taskQueue.BaseNameString(), searchattribute.ExecutionStatus, filter),
+		})
+		if err != nil {
+			return 0, err
+		}
+		return countResponse.Count, nil
+	}
+	var staying int64
+	if len(escapedBuildIds) > 0 {
+		staying, err = count(fmt.Sprintf("%s IN (%s)", searchattribute.BuildIds, strings.Join(escapedBuildIds, ",")))
+		if err != nil {
+			return nil, err
+		}
+	}
+	moving, err := count(fmt.Sprintf("%s IS NULL", searchattribute.BuildIds))
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.ValidateDefaultBuildIdSwitchResponse{
+		StayingWorkflowCount: staying,
+		MovingWorkflowCount:  moving,
+	}, nil
+}
+
+// GetClosedWorkflowBuildId reports the build id a closed workflow last ran on, taken from the worker version stamp of
+// its mutable state, and whether that build id is still registered in the current versioning data of its task queue.
+func (e *matchingEngineImpl) GetClosedWorkflowBuildId(
+	ctx context.Context,
+	req *matchingservice.GetClosedWorkflowBuildIdRequest,
+) (*matchingservice.GetClosedWorkflowBuildIdResponse, error) {
+	namespaceID := namespace.ID(req.GetNamespaceId())
+	taskQueue, err := newTaskQueueID(namespaceID, req.GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+	if !taskQueue.IsRoot() {
+		return nil, serviceerror.NewInvalidArgument("closed workflow build id can only be queried on the root partition")
+	}
+	mutableState, err := e.historyClient.GetMutableState(ctx, &historyservice.GetMutableStateRequest{
+		NamespaceId: req.GetNamespaceId(),
+		Execution:   req.GetExecution(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if mutableState.GetWorkflowState() != enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED {
+		return nil, serviceerror.NewFailedPrecondition("workflow is not closed")
+	}
+	if mutableState.GetTaskQueue().GetName() != taskQueue.BaseNameString() {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("workflow did not run on task queue %s", taskQueue.BaseNameString()))
+	}
+	buildId := mutableState.GetWorkerVersionStamp().GetBuildId()
+	if buildId == "" {
+		return &matchingservice.GetClosedWorkflowBuildIdResponse{}, nil
+	}
+
+	tqMgr, err := e.getTaskQueueManager(ctx, taskQueue, normalStickyInfo, true)
+	if err != nil {
+		return nil, err
+	}
+	userData, _, err := tqMgr.GetUserData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data := userData.GetData().GetVersioningData()
+	registered := false
+	if setIdx, indexInSet := findVersion(data, buildId); setIdx != -1 {
+		registered = isBuildIdLive(data.GetVersionSets()[setIdx].GetBuildIds()[indexInSet])
+	}
+	return &matchingservice.GetClosedWorkflowBuildIdResponse{
+		BuildId:    buildId,
+		Registered: registered,
+	}, nil
+}
+
+// GetUserDataPropagationStatus reports, for every task queue with versioning data in a page of the user data entries
+// of a namespace, how many of its partitions have loaded the user data version persisted by the root partition of the
+// workflow task queue.
+func (e *matchingEngineImpl) GetUserDataPropagationStatus(
+	ctx context.Context,
+	req *matchingservice.GetUserDataPropagationStatusRequest,
+) (*matchingservice.GetUserDataPropagationStatusResponse, error) {
+	ns, err := e.namespaceRegistry.GetNamespaceByID(namespace.ID(req.GetNamespaceId()))
+	if err != nil {
+		return nil, err
+	}
+	pageSize := int(req.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = userDataPropagationStatusPageSize
+	}
+	response, err := e.taskManager.ListTaskQueueUserDataEntries(ctx, &persistence.ListTaskQueueUserDataEntriesRequest{
+		NamespaceID:   ns.ID().String(),
+		PageSize:      pageSize,
+		NextPageToken: req.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var statuses []*matchingservice.GetUserDataPropagationStatusResponse_TaskQueueStatus
+	for _, entry := range response.Entries {
+		if len(entry.Data.GetVersioningData().GetVersionSets()) == 0 {
+			continue
+		}
+		status, err := e.getUserDataPropagationStatus(ctx, ns, entry.TaskQueue)
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, status)
+	}
+	return &matchingservice.GetUserDataPropagationStatusResponse{
+		TaskQueues:    statuses,
+		NextPageToken: response.NextPageToken,
+	}, nil
+}
+
+// getUserDataPropagationStatus fans out GetTaskQueueUserData to every partition of both task queue types, without
+// loading the partitions which are not loaded, and compares the user data version they have loaded against the
+// persisted one. Partitions that fail to reply are reported on their own rather than failing the whole status.
+func (e *matchingEngineImpl) getUserDataPropagationStatus(
+	ctx context.Context,
+	ns *namespace.Namespace,
+	taskQueueName string,
+) (*matchingservice.GetUserDataPropagationStatusResponse_TaskQueueStatus, error) {
+	taskQueue, err := newTaskQueueID(ns.ID(), taskQueueName, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+	// The root partition of the workflow task queue owns the user data, its persisted version can be read without
+	// loading it.
+	persisted, err := e.taskManager.GetTaskQueueUserData(ctx, &persistence.GetTaskQueueUserDataRequest{
+		NamespaceID: ns.ID().String(),
+		TaskQueue:   taskQueueName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	version := persisted.UserData.GetVersion()
+
+	var requests []*matchingservice.GetTaskQueueUserDataRequest
+	for _, taskQueueType := range []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_WORKFLOW, enumspb.TASK_QUEUE_TYPE_ACTIVITY} {
+		n := e.numReadPartitions(ns, taskQueue, taskQueueType)
+		for i := 0; i < n; i++ {
+			requests = append(requests, &matchingservice.GetTaskQueueUserDataRequest{
+				NamespaceId:   ns.ID().String(),
+				TaskQueue:     taskQueue.WithPartition(i).FullName(),
+				TaskQueueType: taskQueueType,
+				OnlyIfLoaded:  true,
+			})
+		}
+	}
+	type partitionResult struct {
+		response *matchingservice.GetTaskQueueUserDataResponse
+		err      error
+	}
+	// The mapper never fails, errors are kept per partition.
+	results, _ := util.MapConcurrent(requests, func(request *matchingservice.GetTaskQueueUserDataRequest) (partitionResult, error) {
+		response, err := e.matchingClient.GetTaskQueueUserData(ctx, request)
+		return partitionResult{response: response, err: err}, nil
+	})
+
+	status := &matchingservice.GetUserDataPropagationStatusResponse_TaskQueueStatus{
+		TaskQueue:       taskQueueName,
+		UserDataVersion: version,
+		TotalPartitions: int32(len(requests)),
+	}
+	for i, result := range results {
+		switch {
+		case result.err != nil:
+			status.PartitionErrors = append(status.PartitionErrors, &matchingservice.GetUserDataPropagationStatusResponse_PartitionError{
+				TaskQueue:     requests[i].GetTaskQueue(),
+				TaskQueueType: requests[i].GetTaskQueueType(),
+				Error:         result.err.Error(),
+			})
+		case result.response.GetPartitionNotLoaded():
+			// The partition fetches the latest user data when it is loaded.
+			status.UnloadedPartitions++
+			status.PropagatedPartitions++
+		case result.response.GetUserData().GetVersion() == version:
+			status.PropagatedPartitions++
+		}
+	}
+	status.FullyPropagated = status.PropagatedPartitions == status.TotalPartitions
+	return status, nil
+}
+
+// numReadPartitions returns the number of read partitions of a task queue, including the extra partitions configured
+// for any build id, since tasks and pollers of such a build id may be on any of them.
+func (e *matchingEngineImpl) numReadPartitions(
+	ns *namespace.Namespace,
+	taskQueue *taskQueueID,
+	taskQueueType enumspb.TaskQueueType,
+) int {
+	n := e.config.NumTaskqueueReadPartitions(ns.Name().String(), taskQueue.BaseNameString(), taskQueueType)
+	perBuildId := e.config.NumTaskqueueReadPartitionsPerBuildId(ns.Name().String())
+	for buildId := range perBuildId {
+		if m, ok := matchingclient.BuildIdPartitions(perBuildId, buildId); ok {
+			n = util.Max(n, m)
+		}
+	}
+	return n
+}
+
+// ApplyVersioningTemplate creates the version sets of a task queue without versioning data from a named template of
+// its namespace.
+func (e *matchingEngineImpl) ApplyVersioningTemplate(
+	ctx context.Context,
+	req *matchingservice.ApplyVersioningTemplateRequest,
+) (*matchingservice.ApplyVersioningTemplateResponse, error) {
+	namespaceID := namespace.ID(req.GetNamespaceId())
+	nsName, err := e.namespaceRegistry.GetNamespaceName(namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	rawTemplate, ok := e.config.VersioningTemplates(nsName.String())[req.GetTemplateName()]
+	if !ok {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("versioning template %q not found", req.GetTemplateName()))
+	}
+	template, err := parseVersioningTemplate(rawTemplate)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("invalid versioning template %q: %v", req.GetTemplateName(), err))
+	}
+	taskQueue, err := newTaskQueueID(namespaceID, req.GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+	tqMgr, err := e.getTaskQueueManager(ctx, taskQueue, normalStickyInfo, true)
+	if err != nil {
+		return nil, err
+	}
+	updateOptions := UserDataUpdateOptions{
+		Replicate:                true,
+		TaskQueueLimitPerBuildId: e.config.TaskQueueLimitPerBuildId(),
+		MaxUserDataSize:          e.config.UserDataSizeLimit(),
+	}
+	err = tqMgr.UpdateUserData(ctx, updateOptions, func(data *persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, error) {
+		clock := data.GetClock()
+		if clock == nil {
+			tmp := hlc.Zero(e.clusterMeta.GetClusterID())
+			clock = &tmp
+		}
+		updatedClock := e.nextClock(*clock)
+		versioningData, err := ApplyVersioningTemplate(
+			updatedClock,
+			data.GetVersioningData(),
+			template,
+			e.config.VersionCompatibleSetLimitPerQueue(),
+			e.config.VersionBuildIdLimitPerQueue(),
+			e.config.VersionBuildIdLimitPerSet(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		// Avoid mutation
+		ret := *data
+		ret.Clock = &updatedClock
+		ret.VersioningData = versioningData
+		return &ret, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.ApplyVersioningTemplateResponse{}, nil
+}
+
+// ReassignBuildId moves the open workflows of a task queue off a build id by repinning each of them to the target build
+// id, which schedules its next workflow task there. Workflows with a workflow task in flight are skipped, since that
+// task was dispatched to the old build id already. One page of workflows is visited per call, the returned page token
+// resumes with the next one. Repinning is idempotent, so a failed call can be retried with the same token.
+func (e *matchingEngineImpl) ReassignBuildId(
+	ctx context.Context,
+	req *matchingservice.ReassignBuildIdRequest,
+) (*matchingservice.ReassignBuildIdResponse, error) {
+	if req.GetBuildId() == "" || req.GetTargetBuildId() == "" {
+		return nil, serviceerror.NewInvalidArgument("build id and target build id are required")
+	}
+	if req.GetBuildId() == req.GetTargetBuildId() {
+		return nil, serviceerror.NewInvalidArgument("target build id must differ from the reassigned build id")
+	}
+	namespaceID := namespace.ID(req.GetNamespaceId())
+	ns, err := e.namespaceRegistry.GetNamespaceByID(namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	taskQueue, err := newTaskQueueID(namespaceID, req.GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+	if !taskQueue.IsRoot() {
+		return nil, serviceerror.NewInvalidArgument("build ids can only be reassigned on the root partition")
+	}
+	tqMgr, err := e.getTaskQueueManager(ctx, taskQueue, normalStickyInfo, true)
+	if err != nil {
+		return nil, err
+	}
+	userData, _, err := tqMgr.GetUserData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data := userData.GetData().GetVersioningData()
+	setIdx, indexInSet := findVersion(data, req.GetTargetBuildId())
+	if setIdx == -1 || !isBuildIdLive(data.GetVersionSets()[setIdx].GetBuildIds()[indexInSet]) {
+		return nil, serviceerror.NewFailedPrecondition(fmt.Sprintf("target build id %s is not registered on task queue %s", req.GetTargetBuildId(), taskQueue.BaseNameString()))
+	}
+
+	escapedBuildId := sqlparser.String(sqlparser.NewStrVal([]byte(common.VersionedBuildIdSearchAttribute(req.GetBuildId()))))
+	query := fmt.Sprintf(`%s = %q AND %s = %s AND %s = "Running"`,
+		searchattribute.TaskQueue, taskQueue.BaseNameString(),
+		searchattribute.BuildIds, escapedBuildId,
+		searchattribute.ExecutionStatus)
+	listResponse, err := e.visibilityManager.ListWorkflowExecutions(ctx, &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID:   ns.ID(),
+		Namespace:     ns.Name(),
+		PageSize:      reassignBuildIdPageSize,
+		NextPageToken: req.GetNextPageToken(),
+		Query:         query,
+	})
+	if err != nil {
+		return nil, err
+	}
+	response := &matchingservice.ReassignBuildIdResponse{NextPageToken: listResponse.NextPageToken}
+	for _, info := range listResponse.Executions {
+		if err := e.reassignWorkflow(ctx, ns, info.GetExecution(), req, response); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+// EnableWorkerVersioning registers the first build id of a task queue without versioning data as its default. Unless
+// forced, it first checks that a worker polls with that build id, since polls of an unregistered build id are
+// redirected to the queue of the set id guessed from it, which is the id of the set registered here.
+func (e *matchingEngineImpl) EnableWorkerVersioning(
+	ctx context.Context,
+	req *matchingservice.EnableWorkerVersioningRequest,
+) (*matchingservice.EnableWorkerVersioningResponse, error) {
+	namespaceID := namespace.ID(req.GetNamespaceId())
+	ns, err := e.namespaceRegistry.GetNamespaceByID(namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetBuildId() == "" {
+		return nil, serviceerror.NewInvalidArgument("build id must be set")
+	}
+	taskQueue, err := newTaskQueueID(namespaceID, req.GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+	if !taskQueue.IsRoot() {
+		return nil, serviceerror.NewInvalidArgument("versioning can only be enabled on the root partition")
+	}
+	tqMgr, err := e.getTaskQueueManager(ctx, taskQueue, normalStickyInfo, true)
+	if err != nil {
+		return nil, err
+	}
+	pollerCounts, err := e.countPollersByBuildId(ctx, ns, taskQueue, []string{hashBuildId(req.GetBuildId())})
+	if err != nil {
+		return nil, err
+	}
+	pollerCount := pollerCounts[req.GetBuildId()]
+	if pollerCount == 0 && !req.GetForce() {
+		return nil, serviceerror.NewFailedPrecondition(fmt.Sprintf(
+			"no poller with build id %q was seen on task queue %q, start a worker with that build id before enabling versioning or force it",
+			req.GetBuildId(), req.GetTaskQueue()))
+	}
+
+	updateOptions := UserDataUpdateOptions{
+		Replicate:                true,
+		TaskQueueLimitPerBuildId: e.config.TaskQueueLimitPerBuildId(),
+		MaxUserDataSize:          e.config.UserDataSizeLimit(),
+	}
+	err = tqMgr.UpdateUserData(ctx, updateOptions, func(data *persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, error) {
+		if len(data.GetVersioningData().GetVersionSets()) > 0 {
+			return nil, serviceerror.NewFailedPrecondition("versioning is already enabled on the task queue")
+		}
+		clock := data.GetClock()
+		if clock == nil {
+			tmp := hlc.Zero(e.clusterMeta.GetClusterID())
+			clock = &tmp
+		}
+		updatedClock := e.nextClock(*clock)
+		versioningData, err := UpdateVersionSets(
+			updatedClock,
+			data.GetVersioningData(),
+			&workflowservice.UpdateWorkerBuildIdCompatibilityRequest{
+				Operation: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_AddNewBuildIdInNewDefaultSet{
+					AddNewBuildIdInNewDefaultSet: req.GetBuildId(),
+				},
+			},
+			e.config.VersionCompatibleSetLimitPerQueue(),
+			e.config.VersionBuildIdLimitPerQueue(),
+			e.config.VersionBuildIdLimitPerSet(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		// Avoid mutation
+		ret := *data
+		ret.Clock = &updatedClock
+		ret.VersioningData = versioningData
+		return &ret, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.EnableWorkerVersioningResponse{PollerCount: pollerCount}, nil
+}
+
+// ListWorkerBuildIdCompatibility lists the compatible version sets of the task queues of a namespace which have
+// versioning data, one page of persisted task queue user data at a time. Since the user data is read from persistence,
+// it is not affected by which partitions are loaded on this node.
+func (e *matchingEngineImpl) ListWorkerBuildIdCompatibility(
+	ctx context.Context,
+	req *matchingservice.ListWorkerBuildIdCompatibilityRequest,
+) (*matchingservice.ListWorkerBuildIdCompatibilityResponse, error) {
+	ns, err := e.namespaceRegistry.GetNamespaceByID(namespace.ID(req.GetNamespaceId()))
+	if err != nil {
+		return nil, err
+	}
+	pageSize := int(req.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = listWorkerBuildIdCompatibilityPageSize
+	}
+	response, err := e.taskManager.ListTaskQueueUserDataEntries(ctx, &persistence.ListTaskQueueUserDataEntriesRequest{
+		NamespaceID:   ns.ID().String(),
+		PageSize:      pageSize,
+		NextPageToken: req.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var taskQueues []*matchingservice.ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility
+	for _, entry := range response.Entries {
+		// Persisted data may predate the current schema, migrate it the same way loading it into a task queue would.
+		data, _ := MigrateVersioningData(entry.Data.GetVersioningData())
+		versionSets := ToBuildIdOrderingResponse(data, int(req.GetMaxSets())).GetMajorVersionSets()
+		if len(versionSets) == 0 {
+			continue
+		}
+		taskQueues = append(taskQueues, &matchingservice.ListWorkerBuildIdCompatibilityResponse_TaskQueueBuildIdCompatibility{
+			TaskQueue:        entry.TaskQueue,
+			MajorVersionSets: versionSets,
+		})
+	}
+	return &matchingservice.ListWorkerBuildIdCompatibilityResponse{
+		TaskQueues:    taskQueues,
+		NextPageToken: response.NextPageToken,
+	}, nil
+}
+
+// GetBuildIdReachability reports whether a build id of a task queue can still receive tasks, following the same rules as
+// DescribeVersioning: only the live default of a version set is dispatched to, and the set's reachability is derived
+// from the versioning data and from visibility.
+func (e *matchingEngineImpl) GetBuildIdReachability(
+	ctx context.Context,
+	req *matchingservice.GetBuildIdReachabilityRequest,
+) (*matchingservice.GetBuildIdReachabilityResponse, error) {
+	if req.GetBuildId() == "" {
+		return nil, serviceerror.NewInvalidArgument("build id must be set")
+	}
+	namespaceID := namespace.ID(req.GetNamespaceId())
+	ns, err := e.namespaceRegistry.GetNamespaceByID(namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	taskQueue, err := newTaskQueueID(namespaceID, req.GetTaskQueue(), enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+	if !taskQueue.IsRoot() {
+		return nil, serviceerror.NewInvalidArgument("build id reachability can only be read from the root partition")
+	}
+	tqMgr, err := e.getTaskQueueManager(ctx, taskQueue, normalStickyInfo, true)
+	if err != nil {
+		return nil, err
+	}
+	userData, _, err := tqMgr.GetUserData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data := userData.GetData().GetVersioningData()
+	setIdx, indexInSet := findVersion(data, req.GetBuildId())
+	if setIdx == -1 {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("build id %v not found", req.GetBuildId()))
+	}
+	set := data.GetVersionSets()[setIdx]
+	response := &matchingservice.GetBuildIdReachabilityResponse{
+		TaskQueueReachability: &taskqueuepb.TaskQueueReachability{
+			TaskQueue:    taskQueue.BaseNameString(),
+			Reachability: []enumspb.TaskReachability{},
+		},
+	}
+	if !isBuildIdLive(set.GetBuildIds()[indexInSet]) || indexInSet != len(set.GetBuildIds())-1 {
+		return response, nil
+	}
+
+	// Draining defaults are skipped when assigning new workflows, so this is not necessarily the default set.
+	newWorkflowsSetId, err := lookupVersionSetForAdd(data, "")
+	if err != nil {
+		return nil, err
+	}
+	response.TaskQueueReachability.Reachability, err = e.getVersionSetReachability(ctx, ns, taskQueue, set, getSetID(set) == newWorkflowsSetId)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetTaskDispatchDecision reports the build id a task with the given versioning intent would be dispatched to, going
+// through the same redirect as AddWorkflowTask and AddActivityTask, without adding it.
+func (e *matchingEngineImpl) GetTaskDispatchDecision(
+	ctx context.Context,
+	req *matchingservice.GetTaskDispatchDecisionRequest,
+) (*matchingservice.GetTaskDispatchDecisionResponse, error) {
+	namespaceID := namespace.ID(req.GetNamespaceId())
+	taskQueue, err := newTaskQueueID(namespaceID, req.GetTaskQueue(), req.GetTaskQueueType())
+	if err != nil {
+		return nil, err
+	}
+	directive := &taskqueuespb.TaskVersionDirective{Value: &taskqueuespb.TaskVersionDirective_UseDefault{UseDefault: &types.Empty{}}}
+	if req.GetUseCompatibleVersion() && req.GetWorkflowBuildId() != "" {
+		directive = &taskqueuespb.TaskVersionDirective{Value: &taskqueuespb.TaskVersionDirective_BuildId{BuildId: req.GetWorkflowBuildId()}}
+	}
+	origTaskQueue := taskQueue
+	taskQueue, _, err = e.redirectToVersionedQueueForAdd(ctx, taskQueue, directive, normalStickyInfo)
+	if err != nil {
+		return nil, err
+	}
+	setID := taskQueue.VersionSet()
+	if setID == "" {
+		return &matchingservice.GetTaskDispatchDecisionResponse{}, nil
+	}
+	tqMgr, err := e.getTaskQueueManager(ctx, origTaskQueue, normalStickyInfo, true)
+	if err != nil {
+		return nil, err
+	}
+	userData, _, err := tqMgr.GetUserData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// A set id guessed for an unknown build id is not in the versioning data, the task would then be matched by the
+	// workflow's own build id.
+	buildId := req.GetWorkflowBuildId()
+	for _, set := range userData.GetData().GetVersioningData().GetVersionSets() {
+		if getSetID(set) == setID && len(set.GetBuildIds()) > 0 {
+			buildId = set.BuildIds[len(set.BuildIds)-1].GetId()
+			break
+		}
+	}
+	return &matchingservice.GetTaskDispatchDecisionResponse{BuildId: buildId, VersionSetId: setID}, nil
+}
+
+// reassignWorkflow repins a single workflow for ReassignBuildId, and records the outcome in response.
+func (e *matchingEngineImpl) reassignWorkflow(
+	ctx context.Context,
+	ns *namespace.Namespace,
+	execution *commonpb.WorkflowExecution,
+	req *matchingservice.ReassignBuildIdRequest,
+	response *matchingservice.ReassignBuildIdResponse,
+) error {
+	repinResponse, err := e.historyClient.RepinWorkflowBuildId(ctx, &historyservice.RepinWorkflowBuildIdRequest{
+		NamespaceId:   ns.ID().String(),
+		Execution:     execution,
+		BuildId:       req.GetBuildId(),
+		TargetBuildId: req.GetTargetBuildId(),
+	})
+	var notFound *serviceerror.NotFound
+	var failedPrecondition *serviceerror.FailedPrecondition
+	if errors.As(err, &notFound) {
+		// Visibility may be stale, the workflow completed in the meantime.
+		return nil
+	} else if errors.As(err, &failedPrecondition) {
+		response.SkippedWorkflows++
+		return nil
+	} else if err != nil {
+		return err
+	}
+	// The workflow may have moved to another build id since visibility recorded it on this one.
+	if repinResponse.GetRepinned() {
+		response.ReassignedWorkflows++
+	}
+	return nil
+}
+
+// countPollersByBuildId fans out DescribeTaskQueue to every partition of both task queue types and counts the distinct
+// poller identities seen per build id, on the unversioned queues and the queues of the given version sets.
+func (e *matchingEngineImpl) countPollersByBuildId(
+	ctx context.Context,
+	ns *namespace.Namespace,
+	taskQueue *taskQueueID,
+	setIds []string,
+) (map[string]int32, error) {
+	var requests []*matchingservice.DescribeTaskQueueRequest
+	for _, taskQueueType := range []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_WORKFLOW, enumspb.TASK_QUEUE_TYPE_ACTIVITY} {
+		n := e.numReadPartitions(ns, taskQueue, taskQueueType)
+		for i := 0; i < n; i++ {
+			requests = append(requests, &matchingservice.DescribeTaskQueueRequest{
+				NamespaceId: ns.ID().String(),
+				DescRequest: &workflowservice.DescribeTaskQueueRequest{
+					Namespace:     ns.Name().String(),
+					TaskQueue:     &taskqueuepb.TaskQueue{Name: taskQueue.WithPartition(i).FullName(), Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+					TaskQueueType: taskQueueType,
+				},
+				VersionSetIds: setIds,
+			})
+		}
+	}
+	responses, err := util.MapConcurrent(requests, func(request *matchingservice.DescribeTaskQueueRequest) (*matchingservice.DescribeTaskQueueResponse, error) {
+		return e.matchingClient.DescribeTaskQueue(ctx, request)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	identities := make(map[string]map[string]struct{})
+	for _, response := range responses {
+		for _, poller := range response.GetPollers() {
+			caps := poller.GetWorkerVersionCapabilities()
+			if !caps.GetUseVersioning() || caps.GetBuildId() == "" {
+				continue
+			}
+			if _, ok := identities[caps.GetBuildId()]; !ok {
+				identities[caps.GetBuildId()] = make(map[string]struct{})
+			}
+			identities[caps.GetBuildId()][poller.GetIdentity()] = struct{}{}
+		}
+	}
+	counts := make(map[string]int32, len(identities))
+	for buildId, ids := range identities {
+		counts[buildId] = int32(len(ids))
+	}
+	return counts, nil
+}
+
+// countOpenWorkflowsByBuildId counts the open workflows of a task queue that have run on the given versioned build id.
+func (e *matchingEngineImpl) countOpenWorkflowsByBuildId(
+	ctx context.Context,
+	ns *namespace.Namespace,
+	taskQueue *taskQueueID,
+	buildId string,
+) (int64, error) {
+	escapedBuildId := sqlparser.String(sqlparser.NewStrVal([]byte(common.VersionedBuildIdSearchAttribute(buildId))))
+	countResponse, err := e.visibilityManager.CountWorkflowExecutions(ctx, &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: ns.ID(),
+		Namespace:   ns.Name(),
+		Query: fmt.Sprintf(`%s = %q AND %s = %s AND %s = "Running"`,
+			searchattribute.TaskQueue, taskQueue.BaseNameString(),
+			searchattribute.BuildIds, escapedBuildId,
+			searchattribute.ExecutionStatus),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return countResponse.Count, nil
+}
+
+// getVersionSetReachability classifies the reachability of the default build id of a version set, following the same
+// rules as the frontend GetWorkerTaskReachability API.
+func (e *matchingEngineImpl) getVersionSetReachability(
+	ctx context.Context,
+	ns *namespace.Namespace,
+	taskQueue *taskQueueID,
+	set *persistencespb.CompatibleVersionSet,
+	receivesNewWorkflows bool,
+) ([]enumspb.TaskReachability, error) {
+	reachability := []enumspb.TaskReachability{}
+	escapedBuildIds := make([]string, len(set.GetBuildIds()))
+	for i, buildId := range set.GetBuildIds() {
+		escapedBuildIds[i] = sqlparser.String(sqlparser.NewStrVal([]byte(common.VersionedBuildIdSearchAttribute(buildId.GetId()))))
+	}
+	buildIdsFilter := fmt.Sprintf("%s IN (%s)", searchattribute.BuildIds, strings.Join(escapedBuildIds, ","))
+	openBuildIdsFilter := buildIdsFilter
+	if receivesNewWorkflows {
+		reachability = append(reachability, enumspb.TASK_REACHABILITY_NEW_WORKFLOWS)
+		// Take into account started workflows that have not yet been processed by any worker.
+		openBuildIdsFilter = fmt.Sprintf("(%s IS NULL OR %s)", searchattribute.BuildIds, buildIdsFilter)
+	}
+
+	queries := []struct {
+		reachability enumspb.TaskReachability
+		filter       string
+	}{
+		{enumspb.TASK_REACHABILITY_OPEN_WORKFLOWS, fmt.Sprintf(`%s AND %s = "Running"`, openBuildIdsFilter, searchattribute.ExecutionStatus)},
+		{enumspb.TASK_REACHABILITY_CLOSED_WORKFLOWS, fmt.Sprintf(`%s AND %s != "Running"`, buildIdsFilter, searchattribute.ExecutionStatus)},
+	}
+	for _, query := range queries {
+		countResponse, err := e.visibilityManager.CountWorkflowExecutions(ctx, &manager.CountWorkflowExecutionsRequest{
+			NamespaceID: ns.ID(),
+			Namespace:   ns.Name(),
+			Query:       fmt.Sprintf("%s = %q AND %s", searchattribute.TaskQueue, taskQueue.BaseNameString(), query.filter),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if countResponse.Count > 0 {
+			reachability = append(reachability, query.reachability)
+		}
+	}
+	return reachability, nil
+}
+
+func (e *matchingEngineImpl) GetTaskQueueUserData(
+	ctx context.Context,
+	req *matchingservice.GetTaskQueueUserDataRequest,
+) (*matchingservice.GetTaskQueueUserDataResponse, error) {
+	namespaceID := namespace.ID(req.GetNamespaceId())
+	taskQueue, err := newTaskQueueID(namespaceID, req.GetTaskQueue(), req.GetTaskQueueType())
+	if err != nil {
+		return nil, err
+	}
+	tqMgr, err := e.getTaskQueueManager(ctx, taskQueue, normalStickyInfo, !req.GetOnlyIfLoaded())
+	if err != nil {
+		return nil, err
+	}
+	if tqMgr == nil {
+		return &matchingservice.GetTaskQueueUserDataResponse{PartitionNotLoaded: true}, nil
+	}
+	version := req.GetLastKnownUserDataVersion()
+	if version < 0 {
+		return nil, serviceerror.NewInvalidArgument("last_known_user_data_version must not be negative")
+	}
+
+	resp, err := e.getTaskQueueUserData(ctx, tqMgr, version, false)
+	if err != nil || !req.WaitNewData || resp.UserData != nil {
+		return resp, err
+	}
+
+	// Many pollers of a partition long poll for the same version, let a single call do the waiting for all of them.
+	pollCtx, cancel := newChildContext(ctx, e.config.GetUserDataLongPollTimeout(), returnEmptyTaskTimeBudget)
+	defer cancel()
+	key := fmt.Sprintf("%s/%s/%s/%d", namespaceID, taskQueue.FullName(), taskQueue.taskType, version)
+	sharedResp, _, err := e.userDataLongPolls.DoContext(pollCtx, key, func() (*matchingservice.GetTaskQueueUserDataResponse, error) {
+		// The shared call must not depend on the context of the caller that happened to start it.
+		sharedCtx, sharedCancel := context.WithTimeout(context.Background(), e.config.GetUserDataLongPollTimeout())
+		defer sharedCancel()
+		e.metricsHandler.Counter(metrics.TaskQueueUserDataLongPolls.GetMetricName()).Record(
+			1,
+			metrics.OperationTag(metrics.MatchingGetTaskQueueUserDataScope),
+			metrics.TaskQueueTag(taskQueue.FullName()),
+			metrics.TaskQueueTypeTag(taskQueue.taskType),
+		)
+		return e.getTaskQueueUserData(sharedCtx, tqMgr, version, true)
+	})
+	if pollCtx.Err() != nil {
+		// This caller's long poll is over before the shared one, as far as it knows nothing changed.
+		return resp, nil
+	}
+	return sharedResp, err
+}
+
+func (e *matchingEngineImpl) getTaskQueueUserData(
+	ctx context.Context,
+	tqMgr taskQueueManager,
+	version int64,
+	waitNewData bool,
+) (*matchingservice.GetTaskQueueUserDataResponse, error) {
+	for {
+		resp := &matchingservice.GetTaskQueueUserDataResponse{}
+		userData, userDataChanged, err := tqMgr.GetUserData(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if waitNewData && userData.GetVersion() == version {
+			// long-poll: wait for data to change/appear
+			select {
+			case <-ctx.Done():
+				resp.TaskQueueHasUserData = userData != nil
+				return resp, nil
+			case <-userDataChanged:
+				// Hold the answer for a bit so that a burst of updates reaches the partitions at once.
+				if interval := e.config.UserDataMinPropagationInterval(); interval > 0 {
+					select {
+					case <-ctx.Done():
+					case <-time.After(interval):
+					}
+				}
+				continue
+			}
+		}
+		if userData != nil {
+			resp.TaskQueueHasUserData = true
+			if userData.Version > version {
+				resp.UserData = userData
+			} else if userData.Version < version {
+				// This is highly unlikely but may happen due to an edge case in during ownership transfer.
+				// We rely on client retries in this case to let the system eventually self-heal.
+				return nil, serviceerror.NewFailedPrecondition(
+					"requested task queue user data for version greater than known version")
+			}
+		}
+		return resp, nil
+	}
+}
+
+func (e *matchingEngineImpl) ApplyTaskQueueUserDataReplicationEvent(
+	ctx context.Context,
+	req *matchingservice.ApplyTaskQueueUserDataReplicationEventRequest,
+) (*matchingservice.ApplyTaskQueueUserDataReplicationEventResponse, error) {
+	namespaceID := namespace.ID(req.GetNamespaceId())
+	taskQueueName := req.GetTaskQueue()
+	taskQueue, err := newTaskQueueID(namespaceID, taskQueueName, enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	if err != nil {
+		return nil, err
+	}
+	tqMgr, err := e.getTaskQueueManager(ctx, taskQueue, normalStickyInfo, true)
+	if err != nil {
+		return nil, err
+	}
+	updateOptions := UserDataUpdateOptions{
+		Replicate: false,
+		// Avoid setting limits to allow the replication event to always be applied
+		TaskQueueLimitPerBuildId: 0,
+		MaxUserDataSize:          0,
+	}
+	err = tqMgr.UpdateUserData(ctx, updateOptions, func(current *persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, error) {
+		mergedUserData := *current
+		// the remote cluster may run an older server and send data of an older schema version
+		incoming, _ := MigrateVersioningData(req.GetUserData().GetVersioningData())
+		logVersioningDataMergeDecision(
+			log.With(e.logger, tag.WorkflowNamespaceID(namespaceID.String()), tag.WorkflowTaskQueueName(taskQueueName)),
+			current.GetVersioningData(),
+			incoming,
+		)
+		mergedUserData.VersioningData = MergeVersioningData(current.GetVersioningData(), incoming)
+		return &mergedUserData, nil
+	})
+	return &matchingservice.ApplyTaskQueueUserDataReplicationEventResponse{}, err
+}
+
+func (e *matchingEngineImpl) GetBuildIdTaskQueueMapping(
+	ctx context.Context,
+	req *matchingservice.GetBuildIdTaskQueueMappingRequest,
+) (*matchingservice.GetBuildIdTaskQueueMappingResponse, error) {
+	taskQueues, err := e.taskManager.GetTaskQueuesByBuildId(ctx, &persistence.GetTaskQueuesByBuildIdRequest{
+		NamespaceID: req.NamespaceId,
+		BuildID:     req.BuildId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &matchingservice.GetBuildIdTaskQueueMappingResponse{TaskQueues: taskQueues}, nil
+}
+
+func (e *matchingEngineImpl) ForceUnloadTaskQueue(
+	ctx context.Context,
+	req *matchingservice.ForceUnloadTaskQueueRequest,
+) (*matchingservice.ForceUnloadTaskQueueResponse, error) {
+	namespaceID := namespace.ID(req.GetNamespaceId())
+	taskQueue, err := newTaskQueueID(namespaceID, req.TaskQueue, req.TaskQueueType)
+	if err != nil {
+		return nil, err
+	}
+	tqm, err := e.getTaskQueueManager(ctx, taskQueue, normalStickyInfo, false)
+	if err != nil {
+		return nil, err
+	}
+	if tqm == nil {
+		return &matchingservice.ForceUnloadTaskQueueResponse{WasLoaded: false}, nil
+	}
+	e.unloadTaskQueue(tqm)
+	return &matchingservice.ForceUnloadTaskQueueResponse{WasLoaded: true}, nil
+}
+
+func (e *matchingEngineImpl) UpdateTaskQueueUserData(ctx context.Context, request *matchingservice.UpdateTaskQueueUserDataRequest) (*matchingservice.UpdateTaskQueueUserDataResponse, error) {
+	locks := e.getNamespaceUpdateLocks(request.GetNamespaceId())
+	locks.updateLock.Lock()
+	defer locks.updateLock.Unlock()
+
+	err := e.taskManager.UpdateTaskQueueUserData(ctx, &persistence.UpdateTaskQueueUserDataRequest{
+		NamespaceID:     request.GetNamespaceId(),
+		TaskQueue:       request.GetTaskQueue(),
+		UserData:        request.GetUserData(),
+		BuildIdsAdded:   request.BuildIdsAdded,
+		BuildIdsRemoved: request.BuildIdsRemoved,
+	})
+	return &matchingservice.UpdateTaskQueueUserDataResponse{}, err
 }
 
 func (e *matchingEngineImpl) ReplicateTaskQueueUserData(ctx context.Context, request *matchingservice.ReplicateTaskQueueUserDataRequest) (*matchingservice.ReplicateTaskQueueUserDataResponse, error) {
@@ -1056,6 +2429,19 @@ func (e *matchingEngineImpl) getNamespaceUpdateLocks(namespaceId string) *namesp
 	return locks
 }
 
+// nextClock generates the clock of the next user data update after the given clock, reporting large backward jumps of
+// the physical time.
+func (e *matchingEngineImpl) nextClock(clock hlc.Clock) hlc.Clock {
+	next, backwardJump := hlc.NextWithBackwardJumpDetection(clock, e.timeSource)
+	if threshold := e.config.HLCBackwardJumpThreshold(); threshold > 0 && backwardJump > threshold {
+		e.metricsHandler.Counter(metrics.HybridLogicalClockBackwardJump.GetMetricName()).Record(1)
+		e.logger.Warn("Physical time is significantly behind hybrid logical clock wall clock",
+			tag.NewDurationTag("backward-jump", backwardJump),
+			tag.NewInt64("hlc-wall-clock", clock.GetWallClock()))
+	}
+	return next
+}
+
 func (e *matchingEngineImpl) getHostInfo(partitionKey string) (string, error) {
 	host, err := e.keyResolver.Lookup(partitionKey)
 	if err != nil {
@@ -1278,6 +2664,42 @@ func (e *matchingEngineImpl) recordActivityTaskStarted(
 	})
 }
 
+// emitCompatibleBuildIdDispatchStats counts tasks that were directed at one build id but
+// delivered to a different (compatible) build id in the same set.
+func (e *matchingEngineImpl) emitCompatibleBuildIdDispatchStats(
+	metricsHandler metrics.Handler,
+	task *internalTask,
+	caps *commonpb.WorkerVersionCapabilities,
+) {
+	if !caps.GetUseVersioning() {
+		return
+	}
+	directedBuildId := task.event.Data.GetVersionDirective().GetBuildId()
+	if directedBuildId == "" || directedBuildId == caps.GetBuildId() {
+		return
+	}
+	metricsHandler.Counter(metrics.CompatibleBuildIdDispatchCounter.GetMetricName()).Record(1, metrics.BuildIdTag(caps.GetBuildId()))
+}
+
+// emitVersionSetRedirectStats counts spooled tasks that moved from one compatible set to another, e.g. because the
+// default set changed while they were backlogged.
+func (e *matchingEngineImpl) emitVersionSetRedirectStats(taskQueue *taskQueueID, fromVersionSet string) {
+	nsName, err := e.namespaceRegistry.GetNamespaceName(taskQueue.namespaceID)
+	if err != nil {
+		return
+	}
+	metrics.GetPerTaskQueueScope(
+		e.metricsHandler.WithTags(metrics.TaskQueueTypeTag(taskQueue.taskType)),
+		nsName.String(),
+		taskQueue.BaseNameString(),
+		enumspb.TASK_QUEUE_KIND_NORMAL,
+	).Counter(metrics.VersionSetRedirectCounter.GetMetricName()).Record(
+		1,
+		metrics.FromVersionSetTag(fromVersionSet),
+		metrics.VersionSetTag(taskQueue.VersionSet()),
+	)
+}
+
 func (e *matchingEngineImpl) emitForwardedSourceStats(
 	metricsHandler metrics.Handler,
 	isTaskForwarded bool,
@@ -1320,15 +2742,20 @@ func (e *matchingEngineImpl) redirectToVersionedQueueForPoll(
 		return nil, err
 	}
 	data := userData.GetData().GetVersioningData()
+	nsName, err := e.namespaceRegistry.GetNamespaceName(taskQueue.namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	dispatchWeights := parseBuildIdDispatchWeights(e.config.BuildIdDispatchWeights(nsName.String()))
 
 	if stickyInfo.kind == enumspb.TASK_QUEUE_KIND_STICKY {
 		// In the sticky case we don't redirect, but we may kick off this worker if there's a
 		// newer one.
-		err := checkVersionForStickyPoll(data, workerVersionCapabilities)
+		err := checkVersionForStickyPoll(data, workerVersionCapabilities, dispatchWeights)
 		return taskQueue, err
 	}
 
-	versionSet, err := lookupVersionSetForPoll(data, workerVersionCapabilities)
+	versionSet, err := lookupVersionSetForPoll(data, workerVersionCapabilities, dispatchWeights)
 	if err != nil {
 		return nil, err
 	}
@@ -1367,9 +2794,22 @@ func (e *matchingEngineImpl) redirectToVersionedQueueForAdd(
 		// In the sticky case we don't redirect, but we may kick off this worker if there's a
 		// newer one.
 		err := checkVersionForStickyAdd(data, buildId)
+		if err == errPinnedBuildIdNotDefault {
+			return nil, nil, e.bounceStickyTask(stickyBounceReasonPinnedBuildIdNotDefault)
+		}
 		return taskQueue, userDataChanged, err
 	}
 
+	if taskQueue.taskType == enumspb.TASK_QUEUE_TYPE_ACTIVITY {
+		nsName, err := e.namespaceRegistry.GetNamespaceName(taskQueue.namespaceID)
+		if err != nil {
+			return nil, nil, err
+		}
+		activityDefault := e.config.ActivityDefaultBuildId(nsName.String(), taskQueue.FullName(), taskQueue.taskType)
+		intentWins := e.config.ActivityVersioningIntentWins(nsName.String(), taskQueue.FullName(), taskQueue.taskType)
+		buildId = lookupBuildIdForActivityAdd(data, buildId, activityDefault, intentWins)
+	}
+
 	versionSet, err := lookupVersionSetForAdd(data, buildId)
 	if err == errEmptyVersioningData {
 		// default was requested for an unversioned queue
@@ -1380,6 +2820,26 @@ func (e *matchingEngineImpl) redirectToVersionedQueueForAdd(
 	return newTaskQueueIDWithVersionSet(taskQueue, versionSet), userDataChanged, nil
 }
 
+// stickyScheduleToStartTimeout returns the sticky schedule-to-start timeout configured for buildId in the given
+// namespace, see MatchingStickyScheduleToStartTimeoutPerBuildId, or 0 if none is.
+func (e *matchingEngineImpl) stickyScheduleToStartTimeout(namespaceID namespace.ID, buildId string) (time.Duration, error) {
+	if buildId == "" {
+		return 0, nil
+	}
+	nsName, err := e.namespaceRegistry.GetNamespaceName(namespaceID)
+	if err != nil {
+		return 0, err
+	}
+	return parseStickyScheduleToStartTimeout(e.config.StickyTimeoutPerBuildId(nsName.String()), buildId), nil
+}
+
+// bounceStickyTask records why a task could not be dispatched to a sticky queue and returns the error that makes the
+// caller fall back to the normal queue.
+func (e *matchingEngineImpl) bounceStickyTask(reason metrics.ReasonString) error {
+	e.metricsHandler.Counter(metrics.StickyTaskBouncedCounter.GetMetricName()).Record(1, metrics.ReasonTag(reason))
+	return serviceerrors.NewStickyWorkerUnavailable()
+}
+
 func (m *lockableQueryTaskMap) put(key string, value chan *queryResult) {
 	m.Lock()
 	defer m.Unlock()
@@ -48,6 +48,18 @@ type (
 		GetWorkerBuildIdCompatibility(ctx context.Context, request *matchingservice.GetWorkerBuildIdCompatibilityRequest) (*matchingservice.GetWorkerBuildIdCompatibilityResponse, error)
 		GetTaskQueueUserData(ctx context.Context, request *matchingservice.GetTaskQueueUserDataRequest) (*matchingservice.GetTaskQueueUserDataResponse, error)
 		ApplyTaskQueueUserDataReplicationEvent(ctx context.Context, request *matchingservice.ApplyTaskQueueUserDataReplicationEventRequest) (*matchingservice.ApplyTaskQueueUserDataReplicationEventResponse, error)
+		DescribeVersioning(ctx context.Context, request *matchingservice.DescribeVersioningRequest) (*matchingservice.DescribeVersioningResponse, error)
+		CleanupUnreachableBuildIds(ctx context.Context, request *matchingservice.CleanupUnreachableBuildIdsRequest) (*matchingservice.CleanupUnreachableBuildIdsResponse, error)
+		GetDefaultBuildIdTimeline(ctx context.Context, request *matchingservice.GetDefaultBuildIdTimelineRequest) (*matchingservice.GetDefaultBuildIdTimelineResponse, error)
+		ValidateDefaultBuildIdSwitch(ctx context.Context, request *matchingservice.ValidateDefaultBuildIdSwitchRequest) (*matchingservice.ValidateDefaultBuildIdSwitchResponse, error)
+		GetClosedWorkflowBuildId(ctx context.Context, request *matchingservice.GetClosedWorkflowBuildIdRequest) (*matchingservice.GetClosedWorkflowBuildIdResponse, error)
+		GetUserDataPropagationStatus(ctx context.Context, request *matchingservice.GetUserDataPropagationStatusRequest) (*matchingservice.GetUserDataPropagationStatusResponse, error)
+		ApplyVersioningTemplate(ctx context.Context, request *matchingservice.ApplyVersioningTemplateRequest) (*matchingservice.ApplyVersioningTemplateResponse, error)
+		ReassignBuildId(ctx context.Context, request *matchingservice.ReassignBuildIdRequest) (*matchingservice.ReassignBuildIdResponse, error)
+		GetTaskDispatchDecision(ctx context.Context, request *matchingservice.GetTaskDispatchDecisionRequest) (*matchingservice.GetTaskDispatchDecisionResponse, error)
+		EnableWorkerVersioning(ctx context.Context, request *matchingservice.EnableWorkerVersioningRequest) (*matchingservice.EnableWorkerVersioningResponse, error)
+		ListWorkerBuildIdCompatibility(ctx context.Context, request *matchingservice.ListWorkerBuildIdCompatibilityRequest) (*matchingservice.ListWorkerBuildIdCompatibilityResponse, error)
+		GetBuildIdReachability(ctx context.Context, request *matchingservice.GetBuildIdReachabilityRequest) (*matchingservice.GetBuildIdReachabilityResponse, error)
 		GetBuildIdTaskQueueMapping(ctx context.Context, request *matchingservice.GetBuildIdTaskQueueMappingRequest) (*matchingservice.GetBuildIdTaskQueueMappingResponse, error)
 		ForceUnloadTaskQueue(ctx context.Context, request *matchingservice.ForceUnloadTaskQueueRequest) (*matchingservice.ForceUnloadTaskQueueResponse, error)
 		UpdateTaskQueueUserData(ctx context.Context, request *matchingservice.UpdateTaskQueueUserDataRequest) (*matchingservice.UpdateTaskQueueUserDataResponse, error)
@@ -63,6 +63,7 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
@@ -1920,11 +1921,14 @@ func (s *matchingEngineSuite) TestGetVersioningData() {
 		id := fmt.Sprintf("%d", i)
 		res, err := s.matchingEngine.UpdateWorkerBuildIdCompatibility(context.Background(), &matchingservice.UpdateWorkerBuildIdCompatibilityRequest{
 			NamespaceId: namespaceID.String(),
-			Request: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest{
-				Namespace: namespaceID.String(),
-				TaskQueue: tq,
-				Operation: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_AddNewBuildIdInNewDefaultSet{
-					AddNewBuildIdInNewDefaultSet: id,
+			TaskQueue:   tq,
+			Operation: &matchingservice.UpdateWorkerBuildIdCompatibilityRequest_Request{
+				Request: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest{
+					Namespace: namespaceID.String(),
+					TaskQueue: tq,
+					Operation: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_AddNewBuildIdInNewDefaultSet{
+						AddNewBuildIdInNewDefaultSet: id,
+					},
 				},
 			},
 		})
@@ -1940,14 +1944,17 @@ func (s *matchingEngineSuite) TestGetVersioningData() {
 		}
 		res, err := s.matchingEngine.UpdateWorkerBuildIdCompatibility(context.Background(), &matchingservice.UpdateWorkerBuildIdCompatibilityRequest{
 			NamespaceId: namespaceID.String(),
-			Request: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest{
-				Namespace: namespaceID.String(),
-				TaskQueue: tq,
-				Operation: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_AddNewCompatibleBuildId{
-					AddNewCompatibleBuildId: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_AddNewCompatibleVersion{
-						NewBuildId:                id,
-						ExistingCompatibleBuildId: prevCompat,
-						MakeSetDefault:            false,
+			TaskQueue:   tq,
+			Operation: &matchingservice.UpdateWorkerBuildIdCompatibilityRequest_Request{
+				Request: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest{
+					Namespace: namespaceID.String(),
+					TaskQueue: tq,
+					Operation: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_AddNewCompatibleBuildId{
+						AddNewCompatibleBuildId: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_AddNewCompatibleVersion{
+							NewBuildId:                id,
+							ExistingCompatibleBuildId: prevCompat,
+							MakeSetDefault:            false,
+						},
 					},
 				},
 			},
@@ -2004,6 +2011,70 @@ func (s *matchingEngineSuite) TestGetVersioningData() {
 	s.Equal("5", majorSets[0].GetBuildIds()[0])
 }
 
+func (s *matchingEngineSuite) TestUpdateWorkerBuildIdCompatibility_RequestWithoutTaskQueue() {
+	namespaceID := namespace.ID(uuid.New())
+	tq := "tupac"
+
+	// Frontends that predate the task_queue field only set the task queue of the wrapped request
+	_, err := s.matchingEngine.UpdateWorkerBuildIdCompatibility(context.Background(), &matchingservice.UpdateWorkerBuildIdCompatibilityRequest{
+		NamespaceId: namespaceID.String(),
+		Operation: &matchingservice.UpdateWorkerBuildIdCompatibilityRequest_Request{
+			Request: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest{
+				Namespace: namespaceID.String(),
+				TaskQueue: tq,
+				Operation: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_AddNewBuildIdInNewDefaultSet{
+					AddNewBuildIdInNewDefaultSet: "v1",
+				},
+			},
+		},
+	})
+	s.NoError(err)
+
+	res, err := s.matchingEngine.GetWorkerBuildIdCompatibility(context.Background(), &matchingservice.GetWorkerBuildIdCompatibilityRequest{
+		NamespaceId: namespaceID.String(),
+		Request: &workflowservice.GetWorkerBuildIdCompatibilityRequest{
+			Namespace: namespaceID.String(),
+			TaskQueue: tq,
+		},
+	})
+	s.NoError(err)
+	majorSets := res.GetResponse().GetMajorVersionSets()
+	s.Len(majorSets, 1)
+	s.Equal([]string{"v1"}, majorSets[0].GetBuildIds())
+}
+
+func (s *matchingEngineSuite) TestPageVersionSets() {
+	clock := hybrid_logical_clock.Zero(1)
+	data := mkInitialData(4, clock)
+	// the second set holds two live build ids and a deleted one
+	data.VersionSets[1].BuildIds = append(data.VersionSets[1].BuildIds,
+		&persistencespb.BuildId{Id: "1.1", State: persistencespb.STATE_ACTIVE, StateUpdateTimestamp: &clock},
+		&persistencespb.BuildId{Id: "1.2", State: persistencespb.STATE_DELETED, StateUpdateTimestamp: &clock},
+	)
+
+	var pages [][]*persistencespb.CompatibleVersionSet
+	var token []byte
+	for {
+		sets, nextPageToken, err := pageVersionSets(data, token, 2)
+		s.NoError(err)
+		pages = append(pages, sets)
+		if len(nextPageToken) == 0 {
+			break
+		}
+		token = nextPageToken
+	}
+	s.Equal([][]*persistencespb.CompatibleVersionSet{
+		// the second set does not fit in the rest of the first page, sets are never split across pages
+		{data.VersionSets[0]},
+		{data.VersionSets[1]},
+		{data.VersionSets[2], data.VersionSets[3]},
+	}, pages)
+
+	_, _, err := pageVersionSets(data, []byte("unknown"), 2)
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
 func (s *matchingEngineSuite) TestGetTaskQueueUserData_NoData() {
 	namespaceID := namespace.ID(uuid.New())
 	tq := "tupac"
@@ -2046,6 +2117,46 @@ func (s *matchingEngineSuite) TestGetTaskQueueUserData_ReturnsData() {
 	s.Equal(res.UserData, userData)
 }
 
+func (s *matchingEngineSuite) TestGetTaskQueueUserData_OnlyIfLoaded() {
+	namespaceID := namespace.ID(uuid.New())
+	tq := "tupac"
+
+	userData := &persistencespb.VersionedTaskQueueUserData{
+		Version: 1,
+		Data:    &persistencespb.TaskQueueUserData{Clock: &clockspb.HybridLogicalClock{WallClock: 123456}},
+	}
+	s.taskManager.UpdateTaskQueueUserData(context.Background(),
+		&persistence.UpdateTaskQueueUserDataRequest{
+			NamespaceID: namespaceID.String(),
+			TaskQueue:   tq,
+			UserData:    userData,
+		})
+	userData.Version++
+
+	req := &matchingservice.GetTaskQueueUserDataRequest{
+		NamespaceId:   namespaceID.String(),
+		TaskQueue:     tq,
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		OnlyIfLoaded:  true,
+	}
+	res, err := s.matchingEngine.GetTaskQueueUserData(context.Background(), req)
+	s.NoError(err)
+	s.True(res.PartitionNotLoaded)
+	s.Nil(res.UserData)
+	s.Empty(s.matchingEngine.getTaskQueues(1000))
+
+	req.OnlyIfLoaded = false
+	_, err = s.matchingEngine.GetTaskQueueUserData(context.Background(), req)
+	s.NoError(err)
+
+	req.OnlyIfLoaded = true
+	res, err = s.matchingEngine.GetTaskQueueUserData(context.Background(), req)
+	s.NoError(err)
+	s.False(res.PartitionNotLoaded)
+	s.True(res.TaskQueueHasUserData)
+	s.Equal(res.UserData, userData)
+}
+
 func (s *matchingEngineSuite) TestGetTaskQueueUserData_ReturnsEmpty() {
 	namespaceID := namespace.ID(uuid.New())
 	tq := "tupac"
@@ -2073,6 +2184,38 @@ func (s *matchingEngineSuite) TestGetTaskQueueUserData_ReturnsEmpty() {
 	s.Nil(res.UserData.GetData())
 }
 
+func (s *matchingEngineSuite) TestNextClock_ReportsLargeBackwardJump() {
+	metricsHandler := metricstest.NewCaptureHandler()
+	capture := metricsHandler.StartCapture()
+	defer metricsHandler.StopCapture(capture)
+	now := time.Now().UTC()
+	timeSource := clock.NewEventTimeSource().Update(now)
+	config := defaultTestConfig()
+	config.HLCBackwardJumpThreshold = dynamicconfig.GetDurationPropertyFn(time.Minute)
+	e := s.newMatchingEngine(config, s.taskManager)
+	e.metricsHandler = metricsHandler
+	e.timeSource = timeSource
+	t0 := hybrid_logical_clock.ZeroAt(now, 1)
+
+	// small backward jump is tolerated silently
+	timeSource.Update(now.Add(-time.Second))
+	t1 := e.nextClock(t0)
+	s.True(hybrid_logical_clock.Greater(t1, t0))
+	s.Empty(capture.Snapshot()[metrics.HybridLogicalClockBackwardJump.GetMetricName()])
+
+	// large backward jump is reported but the clock stays monotonic
+	timeSource.Update(now.Add(-time.Hour))
+	t2 := e.nextClock(t1)
+	s.True(hybrid_logical_clock.Greater(t2, t1))
+	s.Len(capture.Snapshot()[metrics.HybridLogicalClockBackwardJump.GetMetricName()], 1)
+
+	// detection is disabled with a zero threshold
+	config.HLCBackwardJumpThreshold = dynamicconfig.GetDurationPropertyFn(0)
+	t3 := e.nextClock(t2)
+	s.True(hybrid_logical_clock.Greater(t3, t2))
+	s.Len(capture.Snapshot()[metrics.HybridLogicalClockBackwardJump.GetMetricName()], 1)
+}
+
 func (s *matchingEngineSuite) TestGetTaskQueueUserData_LongPoll_Expires() {
 	namespaceID := namespace.ID(uuid.New())
 	tq := "tupac"
@@ -2120,11 +2263,14 @@ func (s *matchingEngineSuite) TestGetTaskQueueUserData_LongPoll_WakesUp_FromNoth
 
 		_, err := s.matchingEngine.UpdateWorkerBuildIdCompatibility(context.Background(), &matchingservice.UpdateWorkerBuildIdCompatibilityRequest{
 			NamespaceId: namespaceID.String(),
-			Request: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest{
-				Namespace: namespaceID.String(),
-				TaskQueue: tq,
-				Operation: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_AddNewBuildIdInNewDefaultSet{
-					AddNewBuildIdInNewDefaultSet: "v1",
+			TaskQueue:   tq,
+			Operation: &matchingservice.UpdateWorkerBuildIdCompatibilityRequest_Request{
+				Request: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest{
+					Namespace: namespaceID.String(),
+					TaskQueue: tq,
+					Operation: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_AddNewBuildIdInNewDefaultSet{
+						AddNewBuildIdInNewDefaultSet: "v1",
+					},
 				},
 			},
 		})
@@ -2167,11 +2313,14 @@ func (s *matchingEngineSuite) TestGetTaskQueueUserData_LongPoll_WakesUp_From2to3
 
 		_, err := s.matchingEngine.UpdateWorkerBuildIdCompatibility(context.Background(), &matchingservice.UpdateWorkerBuildIdCompatibilityRequest{
 			NamespaceId: namespaceID.String(),
-			Request: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest{
-				Namespace: namespaceID.String(),
-				TaskQueue: tq,
-				Operation: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_AddNewBuildIdInNewDefaultSet{
-					AddNewBuildIdInNewDefaultSet: "v1",
+			TaskQueue:   tq,
+			Operation: &matchingservice.UpdateWorkerBuildIdCompatibilityRequest_Request{
+				Request: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest{
+					Namespace: namespaceID.String(),
+					TaskQueue: tq,
+					Operation: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_AddNewBuildIdInNewDefaultSet{
+						AddNewBuildIdInNewDefaultSet: "v1",
+					},
 				},
 			},
 		})
@@ -2596,6 +2745,12 @@ func (m *testTaskManager) UpdateTaskQueueUserData(ctx context.Context, request *
 	return nil
 }
 
+// CompareAndSwapTaskQueueUserData implements persistence.TaskManager
+func (*testTaskManager) CompareAndSwapTaskQueueUserData(ctx context.Context, request *persistence.CompareAndSwapTaskQueueUserDataRequest) (*persistence.CompareAndSwapTaskQueueUserDataResponse, error) {
+	// No need to implement this for unit tests
+	panic("unimplemented")
+}
+
 // ListTaskQueueUserDataEntries implements persistence.TaskManager
 func (*testTaskManager) ListTaskQueueUserDataEntries(ctx context.Context, request *persistence.ListTaskQueueUserDataEntriesRequest) (*persistence.ListTaskQueueUserDataEntriesResponse, error) {
 	// No need to implement this for unit tests
@@ -2625,7 +2780,7 @@ func validateTimeRange(t time.Time, expectedDuration time.Duration) bool {
 }
 
 func defaultTestConfig() *Config {
-	config := NewConfig(dynamicconfig.NewNoopCollection())
+	config := NewConfig(dynamicconfig.NewNoopCollection(), false, false)
 	config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueueInfo(100 * time.Millisecond)
 	config.MaxTaskDeleteBatchSize = dynamicconfig.GetIntPropertyFilteredByTaskQueueInfo(1)
 	return config
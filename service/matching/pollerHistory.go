@@ -30,12 +30,12 @@ import (
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 
 	"go.temporal.io/server/common/cache"
+	"go.temporal.io/server/common/clock"
 )
 
 const (
 	pollerHistoryInitSize    = 0
 	pollerHistoryInitMaxSize = 1000
-	pollerHistoryTTL         = 5 * time.Minute
 )
 
 type (
@@ -52,11 +52,14 @@ type pollerHistory struct {
 	history cache.Cache
 }
 
-func newPollerHistory() *pollerHistory {
+// newPollerHistory creates a poller history that reports each poller with the time it was last seen, according to
+// the given time source, until it has not polled for ttl.
+func newPollerHistory(ttl time.Duration, timeSource clock.TimeSource) *pollerHistory {
 	opts := &cache.Options{
 		InitialCapacity: pollerHistoryInitSize,
-		TTL:             pollerHistoryTTL,
+		TTL:             ttl,
 		Pin:             false,
+		TimeSource:      timeSource,
 	}
 
 	return &pollerHistory{
@@ -45,6 +45,7 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
+	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/debug"
 	"go.temporal.io/server/common/future"
@@ -98,6 +99,9 @@ type (
 		taskInfo      *persistencespb.TaskInfo
 		source        enumsspb.TaskSource
 		forwardedFrom string
+		// syncMatchTimeout, if positive, makes AddTask wait up to that long for a local poller instead of spooling
+		// the task, and return errSyncMatchTimedOut if none picked it up.
+		syncMatchTimeout time.Duration
 	}
 
 	stickyInfo struct {
@@ -105,9 +109,19 @@ type (
 		normalName string                // if kind is sticky, name of normal queue
 	}
 
+	outstandingPoll struct {
+		cancel context.CancelFunc
+		caps   *commonpb.WorkerVersionCapabilities
+	}
+
 	UserDataUpdateOptions struct {
 		Replicate                bool
 		TaskQueueLimitPerBuildId int
+		// MaxUserDataSize is the max size in bytes of the updated user data, zero means no limit.
+		MaxUserDataSize int
+		// KnownVersion is the version of the user data the update was computed from, the update fails if the user
+		// data was modified since. Zero means no check.
+		KnownVersion int64
 	}
 	UserDataUpdateFunc func(*persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, error)
 
@@ -164,18 +178,26 @@ type (
 		metricsHandler       metrics.Handler
 		namespace            namespace.Name
 		taggedMetricsHandler metrics.Handler // namespace/taskqueue tagged metric scope
+		// namespace/taskqueue/version set tagged metric scope, tagged with the base name of the task queue so that
+		// the version sets of all partitions can be compared while a new build id rolls out
+		versionSetMetricsHandler metrics.Handler
 		// pollerHistory stores poller which poll from this taskqueue in last few minutes
 		pollerHistory *pollerHistory
 		// outstandingPollsMap is needed to keep track of all outstanding pollers for a
 		// particular taskqueue.  PollerID generated by frontend is used as the key and
-		// CancelFunc is the value.  This is used to cancel the context to unblock any
+		// CancelFunc is part of the value.  This is used to cancel the context to unblock any
 		// outstanding poller when the frontend detects client connection is closed to
-		// prevent tasks being dispatched to zombie pollers.
+		// prevent tasks being dispatched to zombie pollers, or when a sticky poller's build id
+		// is no longer the default of its compatible set.
 		outstandingPollsLock sync.Mutex
-		outstandingPollsMap  map[string]context.CancelFunc
-		clusterMeta          cluster.Metadata
-		goroGroup            goro.Group
-		initializedError     *future.FutureImpl[struct{}]
+		outstandingPollsMap  map[string]outstandingPoll
+		// dispatchBalancer splits tasks between pollers of weighted build ids in a versioned queue
+		dispatchBalancer *buildIdDispatchBalancer
+		// dispatchRateLimiter limits the dispatch rate to pollers of each build id in a versioned queue
+		dispatchRateLimiter *buildIdDispatchRateLimiter
+		clusterMeta         cluster.Metadata
+		goroGroup           goro.Group
+		initializedError    *future.FutureImpl[struct{}]
 		// userDataInitialFetch is fulfilled once versioning data is fetched from the root partition. If this TQ is
 		// the root partition, it is fulfilled as soon as it is fetched from db.
 		userDataInitialFetch *future.FutureImpl[struct{}]
@@ -187,6 +209,7 @@ var _ taskQueueManager = (*taskQueueManagerImpl)(nil)
 var (
 	errRemoteSyncMatchFailed  = serviceerror.NewCanceled("remote sync match failed")
 	errMissingNormalQueueName = errors.New("missing normal queue name")
+	errSyncMatchTimedOut      = errors.New("no poller picked up the task in time")
 
 	normalStickyInfo = stickyInfo{kind: enumspb.TASK_QUEUE_KIND_NORMAL}
 )
@@ -220,7 +243,6 @@ func newTaskQueueManager(
 
 	taskQueueConfig := newTaskQueueConfig(taskQueue, config, nsName)
 
-	db := newTaskQueueDB(e.taskManager, e.matchingClient, taskQueue.namespaceID, taskQueue, stickyInfo.kind, e.logger)
 	logger := log.With(e.logger,
 		tag.WorkflowTaskQueueName(taskQueue.FullName()),
 		tag.WorkflowTaskQueueType(taskQueue.taskType),
@@ -231,26 +253,35 @@ func newTaskQueueManager(
 		taskQueue.FullName(),
 		stickyInfo.kind,
 	)
+	versionSetMetricsHandler := metrics.GetPerTaskQueueScope(
+		e.metricsHandler.WithTags(metrics.OperationTag(metrics.MatchingTaskQueueMgrScope), metrics.TaskQueueTypeTag(taskQueue.taskType)),
+		nsName.String(),
+		taskQueue.BaseNameString(),
+		stickyInfo.kind,
+	).WithTags(metrics.VersionSetTag(taskQueue.VersionSet()))
+	db := newTaskQueueDB(e.taskManager, e.matchingClient, taskQueue.namespaceID, taskQueue, stickyInfo.kind, e.logger, taggedMetricsHandler)
 	tlMgr := &taskQueueManagerImpl{
-		status:               common.DaemonStatusInitialized,
-		engine:               e,
-		namespaceRegistry:    e.namespaceRegistry,
-		matchingClient:       e.matchingClient,
-		metricsHandler:       e.metricsHandler,
-		taskQueueID:          taskQueue,
-		stickyInfo:           stickyInfo,
-		logger:               logger,
-		db:                   db,
-		taskAckManager:       newAckManager(e.logger),
-		taskGC:               newTaskGC(db, taskQueueConfig),
-		config:               taskQueueConfig,
-		pollerHistory:        newPollerHistory(),
-		outstandingPollsMap:  make(map[string]context.CancelFunc),
-		clusterMeta:          clusterMeta,
-		namespace:            nsName,
-		taggedMetricsHandler: taggedMetricsHandler,
-		initializedError:     future.NewFuture[struct{}](),
-		userDataInitialFetch: future.NewFuture[struct{}](),
+		status:                   common.DaemonStatusInitialized,
+		engine:                   e,
+		namespaceRegistry:        e.namespaceRegistry,
+		matchingClient:           e.matchingClient,
+		metricsHandler:           e.metricsHandler,
+		taskQueueID:              taskQueue,
+		stickyInfo:               stickyInfo,
+		logger:                   logger,
+		db:                       db,
+		taskAckManager:           newAckManager(e.logger),
+		taskGC:                   newTaskGC(db, taskQueueConfig),
+		config:                   taskQueueConfig,
+		pollerHistory:            newPollerHistory(taskQueueConfig.PollerHistoryTTL(), e.timeSource),
+		outstandingPollsMap:      make(map[string]outstandingPoll),
+		dispatchBalancer:         newBuildIdDispatchBalancer(),
+		clusterMeta:              clusterMeta,
+		namespace:                nsName,
+		taggedMetricsHandler:     taggedMetricsHandler,
+		versionSetMetricsHandler: versionSetMetricsHandler,
+		initializedError:         future.NewFuture[struct{}](),
+		userDataInitialFetch:     future.NewFuture[struct{}](),
 	}
 
 	tlMgr.liveness = newLiveness(
@@ -258,6 +289,10 @@ func newTaskQueueManager(
 		taskQueueConfig.MaxTaskQueueIdleTime,
 		tlMgr.unloadFromEngine,
 	)
+	tlMgr.dispatchRateLimiter = newBuildIdDispatchRateLimiter(
+		taskQueueConfig.BuildIdDispatchRatePerPoller,
+		tlMgr.pollerCountForBuildId,
+	)
 	tlMgr.taskWriter = newTaskWriter(tlMgr)
 	tlMgr.taskReader = newTaskReader(tlMgr)
 
@@ -310,6 +345,12 @@ func (c *taskQueueManagerImpl) Start() {
 	c.taskReader.Start()
 	if c.shouldFetchUserData() {
 		c.goroGroup.Go(c.fetchUserDataLoop)
+		if c.kind != enumspb.TASK_QUEUE_KIND_STICKY && c.config.UserDataConsistencyCheckInterval() > 0 {
+			c.goroGroup.Go(c.checkUserDataConsistencyLoop)
+		}
+	}
+	if c.db.DbStoresUserData() && c.config.BuildIdScavengerInterval() > 0 {
+		c.goroGroup.Go(c.scavengeBuildIdsLoop)
 	}
 	c.logger.Info("", tag.LifeCycleStarted)
 	c.taggedMetricsHandler.Counter(metrics.TaskQueueStartedCounter.GetMetricName()).Record(1)
@@ -398,6 +439,14 @@ func (c *taskQueueManagerImpl) AddTask(
 		return false, err
 	}
 
+	if params.syncMatchTimeout > 0 {
+		syncMatch, err := c.waitSyncMatch(ctx, params)
+		if !syncMatch && err == nil {
+			err = errSyncMatchTimedOut
+		}
+		return syncMatch, err
+	}
+
 	if namespaceEntry.ActiveInCluster(c.clusterMeta.GetCurrentClusterName()) {
 		syncMatch, err := c.trySyncMatch(ctx, params)
 		if syncMatch {
@@ -441,7 +490,7 @@ func (c *taskQueueManagerImpl) GetTask(
 		// Found pollerID on context, add it to the map to allow it to be canceled in
 		// response to CancelPoller call
 		c.outstandingPollsLock.Lock()
-		c.outstandingPollsMap[pollerID] = cancel
+		c.outstandingPollsMap[pollerID] = outstandingPoll{cancel: cancel, caps: pollMetadata.workerVersionCapabilities}
 		c.outstandingPollsLock.Unlock()
 		defer func() {
 			c.outstandingPollsLock.Lock()
@@ -475,16 +524,63 @@ func (c *taskQueueManagerImpl) GetTask(
 		return c.matcher.PollForQuery(childCtx, pollMetadata)
 	}
 
+	var weights map[string]int
+	buildId := pollMetadata.workerVersionCapabilities.GetBuildId()
+	if c.isVersioned() {
+		weights = c.config.BuildIdDispatchWeights()
+		if err := c.dispatchRateLimiter.wait(childCtx, buildId); err != nil {
+			c.recordPollOutcome(buildId, err)
+			return nil, err
+		}
+	}
+	release, err := c.dispatchBalancer.admit(childCtx, buildId, weights)
+	if err != nil {
+		c.recordPollOutcome(buildId, err)
+		return nil, err
+	}
 	task, err := c.matcher.Poll(childCtx, pollMetadata)
+	release()
+	c.recordPollOutcome(buildId, err)
 	if err != nil {
 		return nil, err
 	}
+	c.dispatchBalancer.recordDispatch(buildId, weights)
+	c.recordVersionedDispatch(task, pollMetadata.workerVersionCapabilities)
 
 	task.namespace = c.namespace
 	task.backlogCountHint = c.taskAckManager.getBacklogCountHint()
 	return task, nil
 }
 
+// recordPollOutcome counts a poll that either matched a task or timed out empty, per build id of the poller, so that
+// operators can tell how many of their pollers are idle.
+func (c *taskQueueManagerImpl) recordPollOutcome(buildId string, err error) {
+	switch err {
+	case nil:
+		c.taggedMetricsHandler.Counter(metrics.PollMatchedPerBuildIdCounter.GetMetricName()).Record(1, metrics.BuildIdTag(buildId))
+	case ErrNoTasks:
+		c.taggedMetricsHandler.Counter(metrics.PollTimedOutPerBuildIdCounter.GetMetricName()).Record(1, metrics.BuildIdTag(buildId))
+	}
+}
+
+// recordVersionedDispatch counts a task handed to a local poller, by version set and by whether the poller uses
+// versioning, and for versioned pollers how many of their tasks were sync matched, per build id.
+func (c *taskQueueManagerImpl) recordVersionedDispatch(task *internalTask, caps *commonpb.WorkerVersionCapabilities) {
+	if task.isStarted() {
+		// Matched on and counted by the partition the poll was forwarded to
+		return
+	}
+	if !caps.GetUseVersioning() {
+		c.versionSetMetricsHandler.Counter(metrics.UnversionedPollerDispatchCounter.GetMetricName()).Record(1)
+		return
+	}
+	buildIdTag := metrics.BuildIdTag(caps.GetBuildId())
+	c.versionSetMetricsHandler.Counter(metrics.VersionedPollerDispatchCounter.GetMetricName()).Record(1, buildIdTag)
+	if task.isSyncMatchTask() {
+		c.versionSetMetricsHandler.Counter(metrics.SyncMatchPerBuildIdCounter.GetMetricName()).Record(1, buildIdTag)
+	}
+}
+
 // DispatchSpooledTask dispatches a task to a poller. When there are no pollers to pick
 // up the task or if rate limit is exceeded, this method will return error. Task
 // *will not* be persisted to db
@@ -517,10 +613,12 @@ func (c *taskQueueManagerImpl) GetUserData(ctx context.Context) (*persistencespb
 }
 
 func (c *taskQueueManagerImpl) UpdateUserData(ctx context.Context, options UserDataUpdateOptions, updateFn UserDataUpdateFunc) error {
-	newData, err := c.db.UpdateUserData(ctx, updateFn, options.TaskQueueLimitPerBuildId)
+	newData, err := c.db.UpdateUserData(ctx, updateFn, options.TaskQueueLimitPerBuildId, options.MaxUserDataSize, options.KnownVersion)
 	if err != nil {
 		return err
 	}
+	c.taggedMetricsHandler.Histogram(metrics.TaskQueueUserDataSize.GetMetricName(), metrics.TaskQueueUserDataSize.GetMetricUnit()).
+		Record(int64(newData.GetData().Size()))
 	c.signalIfFatal(err)
 	if !options.Replicate {
 		return nil
@@ -547,11 +645,24 @@ func (c *taskQueueManagerImpl) UpdateUserData(ctx context.Context, options UserD
 	return err
 }
 
-// GetAllPollerInfo returns all pollers that polled from this taskqueue in last few minutes
+// GetAllPollerInfo returns all pollers that polled from this taskqueue in last few minutes, see
+// dynamicconfig.MatchingPollerHistoryTTL, along with when each of them was last seen
 func (c *taskQueueManagerImpl) GetAllPollerInfo() []*taskqueuepb.PollerInfo {
 	return c.pollerHistory.getPollerInfo(time.Time{})
 }
 
+// pollerCountForBuildId returns the number of pollers of the given build id that polled within the last long poll
+// interval, i.e. that are still polling.
+func (c *taskQueueManagerImpl) pollerCountForBuildId(buildId string) int {
+	count := 0
+	for _, poller := range c.pollerHistory.getPollerInfo(c.engine.timeSource.Now().Add(-c.config.LongPollExpirationInterval())) {
+		if poller.GetWorkerVersionCapabilities().GetBuildId() == buildId {
+			count++
+		}
+	}
+	return count
+}
+
 func (c *taskQueueManagerImpl) HasPollerAfter(accessTime time.Time) bool {
 	inflightPollerCount := 0
 	c.outstandingPollsLock.Lock()
@@ -566,11 +677,11 @@ func (c *taskQueueManagerImpl) HasPollerAfter(accessTime time.Time) bool {
 
 func (c *taskQueueManagerImpl) CancelPoller(pollerID string) {
 	c.outstandingPollsLock.Lock()
-	cancel, ok := c.outstandingPollsMap[pollerID]
+	poll, ok := c.outstandingPollsMap[pollerID]
 	c.outstandingPollsLock.Unlock()
 
-	if ok && cancel != nil {
-		cancel()
+	if ok && poll.cancel != nil {
+		poll.cancel()
 	}
 }
 
@@ -697,6 +808,19 @@ func (c *taskQueueManagerImpl) trySyncMatch(ctx context.Context, params addTaskP
 	return c.matcher.Offer(childCtx, task)
 }
 
+// waitSyncMatch blocks until the task is matched with a local poller or params.syncMatchTimeout elapses.
+func (c *taskQueueManagerImpl) waitSyncMatch(ctx context.Context, params addTaskParams) (bool, error) {
+	childCtx, cancel := newChildContext(ctx, params.syncMatchTimeout, time.Second)
+	defer cancel()
+
+	fakeTaskIdWrapper := &persistencespb.AllocatedTaskInfo{
+		Data:   params.taskInfo,
+		TaskId: syncMatchTaskId,
+	}
+	task := newInternalTask(fakeTaskIdWrapper, nil, params.source, params.forwardedFrom, true)
+	return c.matcher.offerOrTimeout(childCtx, task)
+}
+
 // newChildContext creates a child context with desired timeout.
 // if tailroom is non-zero, then child context timeout will be
 // the minOf(parentCtx.Deadline()-tailroom, timeout). Use this
@@ -766,6 +890,10 @@ func (c *taskQueueManagerImpl) userDataFetchSource() (string, error) {
 	return parent.FullName(), nil
 }
 
+// fetchUserDataLoop keeps a non-owning partition's user data in sync with its parent in the partition tree.
+// Propagation is pull based: each partition long-polls its parent and only caches the result in memory, so only the
+// root partition ever writes user data to persistence and fan-out is bounded by the tree degree rather than by the
+// number of partitions.
 func (c *taskQueueManagerImpl) fetchUserDataLoop(ctx context.Context) error {
 	ctx = c.callerInfoContext(ctx)
 
@@ -779,6 +907,8 @@ func (c *taskQueueManagerImpl) fetchUserDataLoop(ctx context.Context) error {
 	}
 
 	firstCall := true
+	// pendingUserData holds user data received while propagation got paused, applied once it is resumed
+	var pendingUserData *persistencespb.VersionedTaskQueueUserData
 
 	op := func(ctx context.Context) error {
 		knownUserData, _, err := c.GetUserData(ctx)
@@ -803,8 +933,13 @@ func (c *taskQueueManagerImpl) fetchUserDataLoop(ctx context.Context) error {
 		// If it's nil because it never existed, then we'd never have any data.
 		// It can't be nil due to removing versions, as that would result in a non-nil container with
 		// nil inner fields.
-		if res.GetUserData() != nil {
-			c.db.setUserDataForNonOwningPartition(res.GetUserData())
+		if res.GetUserData() != nil && !c.config.TestDisableUserDataPropagation() {
+			// The initial fetch is always applied, a partition without any user data can't route versioned tasks.
+			if !firstCall && c.config.PauseUserDataPropagation() {
+				pendingUserData = res.GetUserData()
+			} else {
+				c.setFetchedUserData(res.GetUserData())
+			}
 		}
 		if firstCall {
 			c.userDataInitialFetch.Set(struct{}{}, err)
@@ -816,6 +951,20 @@ func (c *taskQueueManagerImpl) fetchUserDataLoop(ctx context.Context) error {
 	minWaitTime := c.config.GetUserDataMinWaitTime
 
 	for ctx.Err() == nil {
+		if !firstCall && c.config.PauseUserDataPropagation() {
+			// Don't fetch while paused. Updates keep being accepted by the root partition and are fetched
+			// once propagation is resumed.
+			select {
+			case <-ctx.Done():
+			case <-time.After(c.config.GetUserDataMinWaitTime):
+			}
+			continue
+		}
+		if pendingUserData != nil {
+			c.setFetchedUserData(pendingUserData)
+			pendingUserData = nil
+		}
+
 		start := time.Now()
 		_ = backoff.ThrottleRetryContext(ctx, op, getUserDataRetryPolicy, nil)
 		elapsed := time.Since(start)
@@ -839,3 +988,143 @@ func (c *taskQueueManagerImpl) fetchUserDataLoop(ctx context.Context) error {
 
 	return ctx.Err()
 }
+
+// setFetchedUserData caches user data fetched from the parent partition.
+func (c *taskQueueManagerImpl) setFetchedUserData(userData *persistencespb.VersionedTaskQueueUserData) {
+	c.db.setUserDataForNonOwningPartition(userData)
+	c.taggedMetricsHandler.Counter(metrics.TaskQueueUserDataPropagated.GetMetricName()).Record(1)
+	if c.kind == enumspb.TASK_QUEUE_KIND_STICKY && c.config.EvictOutdatedStickyPollers() {
+		c.evictOutdatedPollers(userData.GetData().GetVersioningData())
+	}
+}
+
+// evictOutdatedPollers cancels the outstanding polls of a sticky queue whose build id is no longer the default of its
+// compatible set. Without this, those pollers would keep the sticky queue alive until the sticky schedule-to-start
+// timeout; once cancelled, their next poll is rejected with NewerBuildExists and their workflows move to the new
+// default right away.
+func (c *taskQueueManagerImpl) evictOutdatedPollers(data *persistencespb.VersioningData) {
+	weights := c.config.BuildIdDispatchWeights()
+	var evicted []context.CancelFunc
+	c.outstandingPollsLock.Lock()
+	for _, poll := range c.outstandingPollsMap {
+		if !poll.caps.GetUseVersioning() {
+			continue
+		}
+		if err := checkVersionForStickyPoll(data, poll.caps, weights); err != nil {
+			evicted = append(evicted, poll.cancel)
+		}
+	}
+	c.outstandingPollsLock.Unlock()
+
+	for _, cancel := range evicted {
+		cancel()
+	}
+	if len(evicted) > 0 {
+		c.taggedMetricsHandler.Counter(metrics.StickyPollerEvictedCounter.GetMetricName()).Record(int64(len(evicted)))
+	}
+}
+
+// scavengeBuildIdsLoop periodically deletes the build ids of compatible sets that have not been the task queue default
+// for longer than the configured retention and that no workflow can reach anymore, so the user data of task queues
+// that keep getting new build ids does not grow without bound.
+func (c *taskQueueManagerImpl) scavengeBuildIdsLoop(ctx context.Context) error {
+	ctx = c.callerInfoContext(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(c.config.BuildIdScavengerInterval()):
+		}
+
+		removed, err := c.engine.scavengeStaleBuildIds(ctx, c, c.config.BuildIdScavengerRetention())
+		if err != nil {
+			c.logger.Warn("Failed to scavenge stale build ids", tag.Error(err))
+			continue
+		}
+		if len(removed) > 0 {
+			c.taggedMetricsHandler.Counter(metrics.VersioningBuildIdsScavenged.GetMetricName()).Record(int64(len(removed)))
+			c.logger.Info("Scavenged stale build ids", tag.NewStringsTag("build-ids", removed))
+		}
+	}
+}
+
+// checkUserDataConsistencyLoop periodically compares the user data of this partition against the root partition, which
+// owns it. Propagation should keep them in sync, so disagreement for longer than the grace period is reported as
+// divergence and, if enabled, repaired by adopting the root partition's copy.
+func (c *taskQueueManagerImpl) checkUserDataConsistencyLoop(ctx context.Context) error {
+	ctx = c.callerInfoContext(ctx)
+
+	var divergedSince time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(c.config.UserDataConsistencyCheckInterval()):
+		}
+
+		if c.config.PauseUserDataPropagation() {
+			// partitions are expected to lag behind the root while propagation is paused
+			divergedSince = time.Time{}
+			continue
+		}
+
+		rootUserData, diverged, err := c.checkUserDataConsistency(ctx)
+		if err != nil {
+			c.logger.Debug("Failed to check user data consistency", tag.Error(err))
+			continue
+		}
+		if !diverged {
+			divergedSince = time.Time{}
+			continue
+		}
+		now := time.Now()
+		if divergedSince.IsZero() {
+			divergedSince = now
+		}
+		if now.Sub(divergedSince) < c.config.UserDataDivergenceGracePeriod() {
+			continue
+		}
+
+		c.taggedMetricsHandler.Counter(metrics.VersioningPartitionDivergence.GetMetricName()).Record(1)
+		knownUserData, _, _ := c.GetUserData(ctx)
+		c.logger.Warn("Task queue partition user data diverged from root partition",
+			tag.NewInt64("local-user-data-version", knownUserData.GetVersion()),
+			tag.NewInt64("root-user-data-version", rootUserData.GetVersion()),
+			tag.NewDurationTag("diverged-for", now.Sub(divergedSince)))
+		if c.config.RepairDivergentUserData() && rootUserData != nil {
+			c.db.setUserDataForNonOwningPartition(rootUserData)
+			divergedSince = time.Time{}
+		}
+	}
+}
+
+// checkUserDataConsistency fetches the user data from the root partition and returns it along with whether it
+// disagrees with the user data known to this partition.
+func (c *taskQueueManagerImpl) checkUserDataConsistency(ctx context.Context) (*persistencespb.VersionedTaskQueueUserData, bool, error) {
+	knownUserData, _, err := c.GetUserData(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, ioTimeout)
+	defer cancel()
+
+	res, err := c.matchingClient.GetTaskQueueUserData(callCtx, &matchingservice.GetTaskQueueUserDataRequest{
+		NamespaceId:   c.taskQueueID.namespaceID.String(),
+		TaskQueue:     c.taskQueueID.Root().FullName(),
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	rootUserData := res.GetUserData()
+	if knownUserData.GetVersion() != rootUserData.GetVersion() {
+		return rootUserData, true, nil
+	}
+	knownClock, rootClock := knownUserData.GetData().GetClock(), rootUserData.GetData().GetClock()
+	if knownClock == nil || rootClock == nil {
+		return rootUserData, knownClock != rootClock, nil
+	}
+	return rootUserData, !hlc.Equal(*knownClock, *rootClock), nil
+}
@@ -46,9 +46,12 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
+	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -217,7 +220,7 @@ func TestSyncMatchLeasingUnavailable(t *testing.T) {
 }
 
 func TestForeignPartitionOwnerCausesUnload(t *testing.T) {
-	cfg := NewConfig(dynamicconfig.NewNoopCollection())
+	cfg := NewConfig(dynamicconfig.NewNoopCollection(), false, false)
 	cfg.RangeSize = 1 // TaskID block size
 	var leaseErr error = nil
 	tqm := mustCreateTestTaskQueueManager(t, gomock.NewController(t),
@@ -416,11 +419,70 @@ func TestDescribeTaskQueue(t *testing.T) {
 	require.Zero(t, taskQueueStatus.GetBacklogCountHint())
 }
 
+func TestDescribeTaskQueue_PollerLastSeen(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := mustCreateTestTaskQueueManager(t, controller)
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	tlm.pollerHistory = newPollerHistory(time.Minute, timeSource)
+
+	buildId := "v1"
+	pollMetadata := &pollMetadata{
+		workerVersionCapabilities: &commonpb.WorkerVersionCapabilities{BuildId: buildId, UseVersioning: true},
+	}
+	firstSeen := timeSource.Now()
+	tlm.pollerHistory.updatePollerInfo(pollerIdentity("test-poll"), pollMetadata)
+
+	pollers := tlm.DescribeTaskQueue(false).GetPollers()
+	require.Len(t, pollers, 1)
+	require.Equal(t, buildId, pollers[0].GetWorkerVersionCapabilities().GetBuildId())
+	require.True(t, firstSeen.Equal(*pollers[0].GetLastAccessTime()))
+
+	// polling again advances the last seen time
+	timeSource.Update(firstSeen.Add(30 * time.Second))
+	tlm.pollerHistory.updatePollerInfo(pollerIdentity("test-poll"), pollMetadata)
+	pollers = tlm.DescribeTaskQueue(false).GetPollers()
+	require.Len(t, pollers, 1)
+	require.True(t, timeSource.Now().Equal(*pollers[0].GetLastAccessTime()))
+
+	// the poller ages out once it was not seen for the ttl
+	timeSource.Update(timeSource.Now().Add(time.Minute + time.Second))
+	require.Empty(t, tlm.DescribeTaskQueue(false).GetPollers())
+}
+
+func TestRecordVersionedDispatch(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := mustCreateTestTaskQueueManager(t, controller)
+	metricsHandler := metricstest.NewCaptureHandler()
+	capture := metricsHandler.StartCapture()
+	defer metricsHandler.StopCapture(capture)
+	tlm.versionSetMetricsHandler = metricsHandler
+
+	versioned := &commonpb.WorkerVersionCapabilities{BuildId: "v1", UseVersioning: true}
+	syncMatched := newInternalTask(&persistencespb.AllocatedTaskInfo{}, nil, enumsspb.TASK_SOURCE_HISTORY, "", true)
+	spooled := newInternalTask(&persistencespb.AllocatedTaskInfo{}, nil, enumsspb.TASK_SOURCE_DB_BACKLOG, "", false)
+	tlm.recordVersionedDispatch(syncMatched, versioned)
+	tlm.recordVersionedDispatch(spooled, versioned)
+	tlm.recordVersionedDispatch(spooled, nil)
+	// tasks matched on the parent partition are counted there
+	tlm.recordVersionedDispatch(newInternalStartedTask(&startedTaskInfo{}), versioned)
+
+	snapshot := capture.Snapshot()
+	require.Len(t, snapshot[metrics.VersionedPollerDispatchCounter.GetMetricName()], 2)
+	require.Len(t, snapshot[metrics.UnversionedPollerDispatchCounter.GetMetricName()], 1)
+	syncMatches := snapshot[metrics.SyncMatchPerBuildIdCounter.GetMetricName()]
+	require.Len(t, syncMatches, 1)
+	require.Equal(t, "v1", syncMatches[0].Tags["build_id"])
+}
+
 func TestCheckIdleTaskQueue(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
 
-	cfg := NewConfig(dynamicconfig.NewNoopCollection())
+	cfg := NewConfig(dynamicconfig.NewNoopCollection(), false, false)
 	cfg.MaxTaskQueueIdleTime = dynamicconfig.GetDurationPropertyFnFilteredByTaskQueueInfo(2 * time.Second)
 	tqCfg := defaultTqmTestOpts(controller)
 	tqCfg.config = cfg
@@ -826,6 +888,68 @@ func TestTQMFetchesUserDataStickyToNormal(t *testing.T) {
 	tq.Stop()
 }
 
+func TestEvictOutdatedStickyPollers(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	tqCfg := defaultTqmTestOpts(controller)
+
+	logger := log.NewTestLogger()
+	mockNamespaceCache := namespace.NewMockRegistry(controller)
+	mockNamespaceCache.EXPECT().GetNamespaceByID(gomock.Any()).Return(&namespace.Namespace{}, nil).AnyTimes()
+	me := newMatchingEngine(tqCfg.config, newTestTaskManager(logger), nil, logger, mockNamespaceCache, tqCfg.matchingClientMock)
+	cmeta := cluster.NewMetadataForTest(cluster.NewTestClusterMetadataConfig(false, true))
+	stickyInfo := stickyInfo{
+		kind:       enumspb.TASK_QUEUE_KIND_STICKY,
+		normalName: "normal-queue",
+	}
+	tlMgr, err := newTaskQueueManager(me, tqCfg.tqId, stickyInfo, tqCfg.config, cmeta)
+	require.NoError(t, err)
+	tq := tlMgr.(*taskQueueManagerImpl)
+
+	cancelled := make(map[string]bool)
+	addPoll := func(pollerID string, caps *commonpb.WorkerVersionCapabilities) {
+		tq.outstandingPollsMap[pollerID] = outstandingPoll{
+			cancel: func() { cancelled[pollerID] = true },
+			caps:   caps,
+		}
+	}
+	addPoll("old", &commonpb.WorkerVersionCapabilities{BuildId: "v1", UseVersioning: true})
+	addPoll("new", &commonpb.WorkerVersionCapabilities{BuildId: "v1.1", UseVersioning: true})
+	addPoll("unknown", &commonpb.WorkerVersionCapabilities{BuildId: "v2", UseVersioning: true})
+	addPoll("unversioned", &commonpb.WorkerVersionCapabilities{BuildId: "v1"})
+
+	tq.setFetchedUserData(&persistencespb.VersionedTaskQueueUserData{
+		Version: 1,
+		Data: &persistencespb.TaskQueueUserData{
+			VersioningData: mkSingleSetData("v1", buildID(1, "v1"), buildID(2, "v1.1")),
+		},
+	})
+	require.Equal(t, map[string]bool{"old": true}, cancelled)
+}
+
+func TestUpdateUserDataFailsOnStaleKnownVersion(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	ctx := context.Background()
+
+	tq := mustCreateTestTaskQueueManagerWithConfig(t, controller, defaultTqmTestOpts(controller))
+	tq.db.Lock()
+	tq.db.setUserDataLocked(&persistencespb.VersionedTaskQueueUserData{
+		Version: 2,
+		Data: &persistencespb.TaskQueueUserData{
+			VersioningData: mkSingleSetData("v1", buildID(1, "v1")),
+		},
+	})
+	tq.db.Unlock()
+
+	err := tq.UpdateUserData(ctx, UserDataUpdateOptions{KnownVersion: 1}, func(data *persistencespb.TaskQueueUserData) (*persistencespb.TaskQueueUserData, error) {
+		t.Fatal("update function should not be called on a stale version")
+		return data, nil
+	})
+	var unavailable *serviceerror.Unavailable
+	require.ErrorAs(t, err, &unavailable)
+}
+
 func TestUpdateOnNonRootFails(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
@@ -135,6 +135,11 @@ dispatchLoop:
 				if err == nil {
 					break
 				}
+				if err == errRetiredBuildIdTaskExpired {
+					task.finish(nil)
+					tr.taggedMetricsHandler().Counter(metrics.ExpiredTasksPerTaskQueueCounter.GetMetricName()).Record(1)
+					break
+				}
 				if err == context.Canceled {
 					tr.tlMgr.logger.Info("Taskqueue manager context is cancelled, shutting down")
 					return err
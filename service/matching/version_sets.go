@@ -26,6 +26,8 @@ package matching
 
 import (
 	"fmt"
+	"sort"
+	"time"
 
 	"crypto/sha256"
 	"encoding/base64"
@@ -34,38 +36,66 @@ import (
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
-	serviceerrors "go.temporal.io/server/common/serviceerror"
 )
 
 var (
 	// Error used to signal that a queue has no versioning data. This shouldn't escape matching.
 	errEmptyVersioningData = serviceerror.NewInternal("versioning data is empty")
+	// Error used to signal that a task must be bounced off a versioned sticky queue because the build id the workflow
+	// is pinned to is no longer the default of its set, so the sticky worker is not the one that should run the task.
+	// This shouldn't escape matching.
+	errPinnedBuildIdNotDefault = serviceerror.NewFailedPrecondition("pinned build id is no longer the default of its set")
 )
 
 // ToBuildIdOrderingResponse transforms the internal VersioningData representation to public representation.
 // If maxSets is given, the last sets up to maxSets will be returned.
 func ToBuildIdOrderingResponse(data *persistencespb.VersioningData, maxSets int) *workflowservice.GetWorkerBuildIdCompatibilityResponse {
-	lenSets := len(data.GetVersionSets())
-	numSets := lenSets
-	if maxSets > 0 && numSets > maxSets {
-		numSets = maxSets
-	}
-	versionSets := make([]*taskqueuepb.CompatibleVersionSet, numSets)
-	for i := range versionSets {
-		set := data.GetVersionSets()[i+lenSets-numSets]
+	versionSets := make([]*taskqueuepb.CompatibleVersionSet, 0, len(data.GetVersionSets()))
+	for _, set := range data.GetVersionSets() {
 		buildIds := make([]string, 0, len(set.GetBuildIds()))
 		for _, version := range set.GetBuildIds() {
-			if version.State == persistencespb.STATE_ACTIVE {
+			if isBuildIdLive(version) {
 				buildIds = append(buildIds, version.Id)
 			}
 		}
-		versionSets[i] = &taskqueuepb.CompatibleVersionSet{BuildIds: buildIds}
+		// Sets whose build ids have all been deleted are not reported
+		if len(buildIds) == 0 {
+			continue
+		}
+		versionSets = append(versionSets, &taskqueuepb.CompatibleVersionSet{BuildIds: buildIds})
+	}
+	if maxSets > 0 && len(versionSets) > maxSets {
+		versionSets = versionSets[len(versionSets)-maxSets:]
 	}
 	return &workflowservice.GetWorkerBuildIdCompatibilityResponse{MajorVersionSets: versionSets}
 }
 
+// ToBuildIdLabelsResponse collects the labels of the build ids included in the given response, keyed by build id.
+// Build ids without labels are omitted.
+func ToBuildIdLabelsResponse(data *persistencespb.VersioningData, response *workflowservice.GetWorkerBuildIdCompatibilityResponse) map[string]*matchingservice.GetWorkerBuildIdCompatibilityResponse_BuildIdLabels {
+	var labels map[string]*matchingservice.GetWorkerBuildIdCompatibilityResponse_BuildIdLabels
+	for _, set := range response.GetMajorVersionSets() {
+		for _, buildId := range set.GetBuildIds() {
+			setIdx, indexInSet := findVersion(data, buildId)
+			if setIdx < 0 {
+				continue
+			}
+			buildIdLabels := data.VersionSets[setIdx].BuildIds[indexInSet].GetLabels()
+			if len(buildIdLabels) == 0 {
+				continue
+			}
+			if labels == nil {
+				labels = make(map[string]*matchingservice.GetWorkerBuildIdCompatibilityResponse_BuildIdLabels)
+			}
+			labels[buildId] = &matchingservice.GetWorkerBuildIdCompatibilityResponse_BuildIdLabels{Labels: buildIdLabels}
+		}
+	}
+	return labels
+}
+
 func checkLimits(g *persistencespb.VersioningData, maxSets, maxBuildIds int) error {
 	sets := g.GetVersionSets()
 	if maxSets > 0 && len(sets) > maxSets {
@@ -84,6 +114,21 @@ func checkLimits(g *persistencespb.VersioningData, maxSets, maxBuildIds int) err
 	return nil
 }
 
+func checkSetLimit(g *persistencespb.VersioningData, buildId string, maxBuildIdsPerSet int) error {
+	if maxBuildIdsPerSet == 0 {
+		return nil
+	}
+	setIdx, _ := findVersion(g, buildId)
+	if setIdx == -1 {
+		return nil
+	}
+	numBuildIds := len(g.GetVersionSets()[setIdx].GetBuildIds())
+	if numBuildIds > maxBuildIdsPerSet {
+		return serviceerror.NewFailedPrecondition(fmt.Sprintf("update would exceed number of compatible build IDs permitted in a single set in namespace dynamic config (%v/%v)", numBuildIds, maxBuildIdsPerSet))
+	}
+	return nil
+}
+
 // UpdateVersionSets updates version sets given existing versioning data and an update request. The request is expected
 // to have already been validated.
 //
@@ -108,10 +153,11 @@ func checkLimits(g *persistencespb.VersioningData, maxSets, maxBuildIds int) err
 //     default for that set.
 //  3. Target some existing version, marking it (and thus its set) as the default set.
 //
-// Deletions are performed by a background process which verifies build IDs are no longer in use and safe to delete (not yet implemented).
+// Deletions are performed separately, see RemoveBuildIds.
 //
-// Update may fail with FailedPrecondition if it would cause exceeding the supplied limits.
-func UpdateVersionSets(clock hlc.Clock, data *persistencespb.VersioningData, req *workflowservice.UpdateWorkerBuildIdCompatibilityRequest, maxSets, maxBuildIds int) (*persistencespb.VersioningData, error) {
+// Update may fail with FailedPrecondition if it would cause exceeding the supplied limits. maxBuildIdsPerSet bounds the
+// length of a chain of compatible build IDs and is only enforced when adding a new compatible build ID.
+func UpdateVersionSets(clock hlc.Clock, data *persistencespb.VersioningData, req *workflowservice.UpdateWorkerBuildIdCompatibilityRequest, maxSets, maxBuildIds, maxBuildIdsPerSet int) (*persistencespb.VersioningData, error) {
 	data, err := updateImpl(clock, data, req)
 	if err != nil {
 		return nil, err
@@ -119,14 +165,345 @@ func UpdateVersionSets(clock hlc.Clock, data *persistencespb.VersioningData, req
 	if err := checkLimits(data, maxSets, maxBuildIds); err != nil {
 		return nil, err
 	}
+	if addNew := req.GetAddNewCompatibleBuildId(); addNew != nil {
+		if err := checkSetLimit(data, addNew.GetNewBuildId(), maxBuildIdsPerSet); err != nil {
+			return nil, err
+		}
+	}
 	return data, nil
 }
 
+// MarkBuildIdDraining returns a copy of the given versioning data with buildId marked as draining. New workflows and
+// activities are not assigned to a set whose default build id is draining, but tasks already pinned to the build id
+// continue to be dispatched to it.
+func MarkBuildIdDraining(timestamp hlc.Clock, data *persistencespb.VersioningData, buildId string) (*persistencespb.VersioningData, error) {
+	setIdx, indexInSet := findVersion(data, buildId)
+	if setIdx < 0 {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("build id %v not found", buildId))
+	}
+	existing := data.VersionSets[setIdx].BuildIds[indexInSet]
+	switch existing.State {
+	case persistencespb.STATE_DRAINING:
+		// Make the request idempotent
+		return data, nil
+	case persistencespb.STATE_ACTIVE:
+	default:
+		return nil, serviceerror.NewFailedPrecondition(fmt.Sprintf("build id %v is not active", buildId))
+	}
+
+	modifiedData := persistencespb.VersioningData{
+		VersionSets:            make([]*persistencespb.CompatibleVersionSet, len(data.VersionSets)),
+		DefaultUpdateTimestamp: data.DefaultUpdateTimestamp,
+		AuditLog:               data.AuditLog,
+		AuditLogCheckpoint:     data.AuditLogCheckpoint,
+	}
+	copy(modifiedData.VersionSets, data.VersionSets)
+	// Avoid mutating the set and build id slice shared with the existing data
+	modifiedSet := *data.VersionSets[setIdx]
+	modifiedSet.BuildIds = make([]*persistencespb.BuildId, len(modifiedSet.BuildIds))
+	copy(modifiedSet.BuildIds, data.VersionSets[setIdx].BuildIds)
+	modifiedSet.BuildIds[indexInSet] = &persistencespb.BuildId{
+		Id:                    existing.Id,
+		State:                 persistencespb.STATE_DRAINING,
+		StateUpdateTimestamp:  &timestamp,
+		Labels:                existing.Labels,
+		LabelsUpdateTimestamp: existing.LabelsUpdateTimestamp,
+	}
+	modifiedData.VersionSets[setIdx] = &modifiedSet
+	return &modifiedData, nil
+}
+
+// SwapBuildIdsWithinSet returns a copy of the given versioning data with the positions of two build ids in the same
+// compatible set swapped, atomically. If either build id is the set default, the other one becomes the new default.
+func SwapBuildIdsWithinSet(timestamp hlc.Clock, data *persistencespb.VersioningData, firstBuildId, secondBuildId string) (*persistencespb.VersioningData, error) {
+	firstSetIdx, firstIdxInSet := findVersion(data, firstBuildId)
+	if firstSetIdx < 0 {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("build id %v not found", firstBuildId))
+	}
+	secondSetIdx, secondIdxInSet := findVersion(data, secondBuildId)
+	if secondSetIdx < 0 {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("build id %v not found", secondBuildId))
+	}
+	if firstSetIdx != secondSetIdx {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("build ids %v and %v are not in the same compatible set", firstBuildId, secondBuildId))
+	}
+	if firstIdxInSet == secondIdxInSet {
+		return data, nil
+	}
+
+	modifiedData := persistencespb.VersioningData{
+		VersionSets:            make([]*persistencespb.CompatibleVersionSet, len(data.VersionSets)),
+		DefaultUpdateTimestamp: data.DefaultUpdateTimestamp,
+		AuditLog:               data.AuditLog,
+		AuditLogCheckpoint:     data.AuditLogCheckpoint,
+	}
+	copy(modifiedData.VersionSets, data.VersionSets)
+	// Avoid mutating the set and build id slice shared with the existing data
+	modifiedSet := *data.VersionSets[firstSetIdx]
+	modifiedSet.BuildIds = make([]*persistencespb.BuildId, len(modifiedSet.BuildIds))
+	copy(modifiedSet.BuildIds, data.VersionSets[firstSetIdx].BuildIds)
+	modifiedSet.BuildIds[firstIdxInSet], modifiedSet.BuildIds[secondIdxInSet] = modifiedSet.BuildIds[secondIdxInSet], modifiedSet.BuildIds[firstIdxInSet]
+	defaultIdx := len(modifiedSet.BuildIds) - 1
+	if firstIdxInSet == defaultIdx || secondIdxInSet == defaultIdx {
+		modifiedSet.DefaultUpdateTimestamp = &timestamp
+	}
+	modifiedData.VersionSets[firstSetIdx] = &modifiedSet
+	recordDefaultBuildIdChange(data, &modifiedData, timestamp)
+	return &modifiedData, nil
+}
+
+// SwapDefaultSets returns a copy of the given versioning data with the positions of the compatible sets of two build ids
+// swapped, atomically. One of the build ids must be in the current default set, the set of the other one becomes the
+// new default set and the former default set takes its place.
+func SwapDefaultSets(timestamp hlc.Clock, data *persistencespb.VersioningData, firstBuildId, secondBuildId string) (*persistencespb.VersioningData, error) {
+	firstSetIdx, _ := findVersion(data, firstBuildId)
+	if firstSetIdx < 0 {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("build id %v not found", firstBuildId))
+	}
+	secondSetIdx, _ := findVersion(data, secondBuildId)
+	if secondSetIdx < 0 {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("build id %v not found", secondBuildId))
+	}
+	defaultSetIdx := len(data.VersionSets) - 1
+	if firstSetIdx != defaultSetIdx && secondSetIdx != defaultSetIdx {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("neither build id %v nor %v is in the default set", firstBuildId, secondBuildId))
+	}
+	if firstSetIdx == secondSetIdx {
+		// Make the request idempotent
+		return data, nil
+	}
+
+	modifiedData := persistencespb.VersioningData{
+		VersionSets:            make([]*persistencespb.CompatibleVersionSet, len(data.VersionSets)),
+		DefaultUpdateTimestamp: &timestamp,
+		AuditLog:               data.AuditLog,
+		AuditLogCheckpoint:     data.AuditLogCheckpoint,
+	}
+	copy(modifiedData.VersionSets, data.VersionSets)
+	modifiedData.VersionSets[firstSetIdx], modifiedData.VersionSets[secondSetIdx] = modifiedData.VersionSets[secondSetIdx], modifiedData.VersionSets[firstSetIdx]
+	recordDefaultBuildIdChange(data, &modifiedData, timestamp)
+	return &modifiedData, nil
+}
+
+// SetBuildIdLabels returns a copy of the given versioning data with the labels of buildId replaced by the given labels.
+// An empty labels map clears them. Fails with InvalidArgument if the total size of the label keys and values exceeds
+// maxLabelsSize, zero means no limit.
+func SetBuildIdLabels(timestamp hlc.Clock, data *persistencespb.VersioningData, buildId string, labels map[string]string, maxLabelsSize int) (*persistencespb.VersioningData, error) {
+	setIdx, indexInSet := findVersion(data, buildId)
+	if setIdx < 0 {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("build id %v not found", buildId))
+	}
+	existing := data.VersionSets[setIdx].BuildIds[indexInSet]
+	if !isBuildIdLive(existing) {
+		return nil, serviceerror.NewFailedPrecondition(fmt.Sprintf("build id %v is not live", buildId))
+	}
+	if maxLabelsSize > 0 {
+		size := 0
+		for key, value := range labels {
+			size += len(key) + len(value)
+		}
+		if size > maxLabelsSize {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("labels of build id %v exceed the size permitted in namespace dynamic config (%v/%v)", buildId, size, maxLabelsSize))
+		}
+	}
+	if len(labels) == 0 {
+		labels = nil
+	}
+
+	modifiedData := persistencespb.VersioningData{
+		VersionSets:            make([]*persistencespb.CompatibleVersionSet, len(data.VersionSets)),
+		DefaultUpdateTimestamp: data.DefaultUpdateTimestamp,
+		AuditLog:               data.AuditLog,
+		AuditLogCheckpoint:     data.AuditLogCheckpoint,
+	}
+	copy(modifiedData.VersionSets, data.VersionSets)
+	// Avoid mutating the set and build id slice shared with the existing data
+	modifiedSet := *data.VersionSets[setIdx]
+	modifiedSet.BuildIds = make([]*persistencespb.BuildId, len(modifiedSet.BuildIds))
+	copy(modifiedSet.BuildIds, data.VersionSets[setIdx].BuildIds)
+	modifiedBuildId := *existing
+	modifiedBuildId.Labels = labels
+	modifiedBuildId.LabelsUpdateTimestamp = &timestamp
+	modifiedSet.BuildIds[indexInSet] = &modifiedBuildId
+	modifiedData.VersionSets[setIdx] = &modifiedSet
+	return &modifiedData, nil
+}
+
+// RemoveBuildId returns a copy of the given versioning data with a single retired build id marked as deleted. The queue
+// default may not be removed, nor may the default of a set that still has other live build ids, since tasks of that set
+// would keep being dispatched to it; another build id must be promoted first. Removing a deleted build id is a no-op.
+// Callers are responsible for checking that no open workflows are still pinned to the build id.
+func RemoveBuildId(timestamp hlc.Clock, data *persistencespb.VersioningData, buildId string) (*persistencespb.VersioningData, error) {
+	setIdx, indexInSet := findVersion(data, buildId)
+	if setIdx < 0 {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("build id %v not found", buildId))
+	}
+	set := data.GetVersionSets()[setIdx]
+	if !isBuildIdLive(set.GetBuildIds()[indexInSet]) {
+		// Make the request idempotent
+		return data, nil
+	}
+	if buildId == getDefaultBuildId(data) {
+		return nil, serviceerror.NewFailedPrecondition(fmt.Sprintf("build id %v is the default of the task queue", buildId))
+	}
+	if indexInSet == len(set.GetBuildIds())-1 {
+		for _, other := range set.GetBuildIds()[:indexInSet] {
+			if isBuildIdLive(other) {
+				return nil, serviceerror.NewFailedPrecondition(fmt.Sprintf("build id %v is the default of its compatible set, promote another build id within the set first", buildId))
+			}
+		}
+	}
+	return RemoveBuildIds(timestamp, data, []string{buildId}), nil
+}
+
+// RemoveBuildIds returns a copy of the given versioning data with the given build ids marked as deleted. Build ids that
+// are not found or not live are ignored. Deleted build ids are kept as tombstones so that the removal can be merged
+// with concurrent replicated updates.
+func RemoveBuildIds(timestamp hlc.Clock, data *persistencespb.VersioningData, buildIds []string) *persistencespb.VersioningData {
+	toRemove := make(map[string]struct{}, len(buildIds))
+	for _, buildId := range buildIds {
+		toRemove[buildId] = struct{}{}
+	}
+	modifiedData := persistencespb.VersioningData{
+		VersionSets:            make([]*persistencespb.CompatibleVersionSet, len(data.GetVersionSets())),
+		DefaultUpdateTimestamp: data.GetDefaultUpdateTimestamp(),
+		AuditLog:               data.GetAuditLog(),
+		AuditLogCheckpoint:     data.GetAuditLogCheckpoint(),
+	}
+	copy(modifiedData.VersionSets, data.GetVersionSets())
+	for setIdx, set := range modifiedData.VersionSets {
+		var modifiedSet *persistencespb.CompatibleVersionSet
+		for idx, buildId := range set.GetBuildIds() {
+			if _, ok := toRemove[buildId.GetId()]; !ok || !isBuildIdLive(buildId) {
+				continue
+			}
+			if modifiedSet == nil {
+				// Avoid mutating the set and build id slice shared with the existing data
+				copied := *set
+				copied.BuildIds = make([]*persistencespb.BuildId, len(set.BuildIds))
+				copy(copied.BuildIds, set.BuildIds)
+				modifiedSet = &copied
+			}
+			modifiedSet.BuildIds[idx] = &persistencespb.BuildId{
+				Id:                   buildId.Id,
+				State:                persistencespb.STATE_DELETED,
+				StateUpdateTimestamp: &timestamp,
+			}
+		}
+		if modifiedSet != nil {
+			modifiedData.VersionSets[setIdx] = modifiedSet
+		}
+	}
+	return &modifiedData
+}
+
+// StaleVersionSets returns the compatible sets of the given versioning data that have live build ids and have not been
+// the task queue default since before boundary. A set stops being the default when another set is made the default, so
+// every set but the default one has been non-default at least since the task queue default last changed.
+func StaleVersionSets(data *persistencespb.VersioningData, boundary hlc.Clock) []*persistencespb.CompatibleVersionSet {
+	sets := data.GetVersionSets()
+	if len(sets) <= 1 || data.GetDefaultUpdateTimestamp() == nil || !hlc.Less(*data.GetDefaultUpdateTimestamp(), boundary) {
+		return nil
+	}
+	var stale []*persistencespb.CompatibleVersionSet
+	for _, set := range sets[:len(sets)-1] {
+		for _, buildId := range set.GetBuildIds() {
+			if isBuildIdLive(buildId) {
+				stale = append(stale, set)
+				break
+			}
+		}
+	}
+	return stale
+}
+
+// ApplyVersioningTemplate returns versioning data with the compatible version sets of the given template, in order,
+// with the last build id of each set as its default and the last set as the queue default. The template may only be
+// applied to a queue without version sets. Build ids are added as if by a sequence of UpdateVersionSets calls, so
+// the supplied limits are enforced the same way, and no partial result is ever returned.
+func ApplyVersioningTemplate(timestamp hlc.Clock, data *persistencespb.VersioningData, template [][]string, maxSets, maxBuildIds, maxBuildIdsPerSet int) (*persistencespb.VersioningData, error) {
+	if len(data.GetVersionSets()) > 0 {
+		return nil, serviceerror.NewFailedPrecondition("versioning templates can only be applied to task queues without versioning data")
+	}
+	if len(template) == 0 {
+		return nil, serviceerror.NewInvalidArgument("versioning template has no version sets")
+	}
+	seen := make(map[string]struct{})
+	for _, set := range template {
+		if len(set) == 0 {
+			return nil, serviceerror.NewInvalidArgument("versioning template has an empty version set")
+		}
+		for _, buildId := range set {
+			// Re-adding a build id would be accepted as an idempotent update, so reject duplicates explicitly
+			if _, ok := seen[buildId]; ok {
+				return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("build id %s appears more than once in versioning template", buildId))
+			}
+			seen[buildId] = struct{}{}
+		}
+	}
+	for _, set := range template {
+		var err error
+		for idx, buildId := range set {
+			req := &workflowservice.UpdateWorkerBuildIdCompatibilityRequest{}
+			if idx == 0 {
+				req.Operation = &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_AddNewBuildIdInNewDefaultSet{
+					AddNewBuildIdInNewDefaultSet: buildId,
+				}
+			} else {
+				req.Operation = &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_AddNewCompatibleBuildId{
+					AddNewCompatibleBuildId: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_AddNewCompatibleVersion{
+						NewBuildId:                buildId,
+						ExistingCompatibleBuildId: set[0],
+						MakeSetDefault:            true,
+					},
+				}
+			}
+			if data, err = UpdateVersionSets(timestamp, data, req, maxSets, maxBuildIds, maxBuildIdsPerSet); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return data, nil
+}
+
+// parseVersioningTemplate converts a template of the MatchingVersioningTemplates dynamic config to a list of
+// compatible sets of build ids.
+func parseVersioningTemplate(value any) ([][]string, error) {
+	sets, ok := value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("expected a list of version sets, got %T", value)
+	}
+	template := make([][]string, 0, len(sets))
+	for _, set := range sets {
+		var buildIds []string
+		switch set := set.(type) {
+		case []string:
+			buildIds = set
+		case []any:
+			for _, buildId := range set {
+				id, ok := buildId.(string)
+				if !ok {
+					return nil, fmt.Errorf("expected a build id string, got %T", buildId)
+				}
+				buildIds = append(buildIds, id)
+			}
+		default:
+			return nil, fmt.Errorf("expected a list of build ids, got %T", set)
+		}
+		template = append(template, buildIds)
+	}
+	return template, nil
+}
+
+func isBuildIdLive(buildId *persistencespb.BuildId) bool {
+	return buildId.State == persistencespb.STATE_ACTIVE || buildId.State == persistencespb.STATE_DRAINING
+}
+
 func gatherBuildIds(data *persistencespb.VersioningData) map[string]struct{} {
 	buildIds := make(map[string]struct{}, 0)
 	for _, set := range data.GetVersionSets() {
 		for _, buildId := range set.BuildIds {
-			if buildId.State == persistencespb.STATE_ACTIVE {
+			if isBuildIdLive(buildId) {
 				buildIds[buildId.Id] = struct{}{}
 			}
 		}
@@ -152,6 +529,133 @@ func GetBuildIdDeltas(prev *persistencespb.VersioningData, curr *persistencespb.
 	return added, removed
 }
 
+// CompactAuditLog returns a copy of the given versioning data whose audit log entries older than boundary are
+// summarized into the audit log checkpoint, which records the task queue default as of boundary. Compacting at or
+// before an existing checkpoint, or when no entry is older than boundary, returns the data as is.
+func CompactAuditLog(data *persistencespb.VersioningData, boundary hlc.Clock) *persistencespb.VersioningData {
+	checkpoint, auditLog := compactAuditLog(data.GetAuditLogCheckpoint(), data.GetAuditLog(), boundary)
+	if checkpoint == data.GetAuditLogCheckpoint() {
+		return data
+	}
+	modifiedData := *data
+	modifiedData.AuditLogCheckpoint = checkpoint
+	modifiedData.AuditLog = auditLog
+	return &modifiedData
+}
+
+// compactAuditLog drops the entries of auditLog older than boundary and returns a checkpoint at boundary with the
+// build id of the last dropped entry, or the given checkpoint and log unchanged if there is nothing to drop.
+func compactAuditLog(
+	checkpoint *persistencespb.VersioningAuditEntry,
+	auditLog []*persistencespb.VersioningAuditEntry,
+	boundary hlc.Clock,
+) (*persistencespb.VersioningAuditEntry, []*persistencespb.VersioningAuditEntry) {
+	if checkpoint != nil && !hlc.Less(*checkpoint.Timestamp, boundary) {
+		return checkpoint, auditLog
+	}
+	idx := auditLogIndex(auditLog, boundary)
+	if idx == 0 {
+		return checkpoint, auditLog
+	}
+	return &persistencespb.VersioningAuditEntry{
+		Timestamp:      &boundary,
+		DefaultBuildId: auditLog[idx-1].GetDefaultBuildId(),
+	}, auditLog[idx:]
+}
+
+// auditLogIndex returns the index of the first entry of auditLog that is not older than boundary.
+func auditLogIndex(auditLog []*persistencespb.VersioningAuditEntry, boundary hlc.Clock) int {
+	return sort.Search(len(auditLog), func(i int) bool {
+		return !hlc.Less(*auditLog[i].Timestamp, boundary)
+	})
+}
+
+// getDefaultBuildIdAsOf returns the task queue default build id at the given time according to the audit log, or an
+// empty string if there was no default yet. Times before the audit log checkpoint can no longer be reconstructed and
+// return an error.
+func getDefaultBuildIdAsOf(data *persistencespb.VersioningData, asOf hlc.Clock) (string, error) {
+	defaultBuildId := ""
+	if checkpoint := data.GetAuditLogCheckpoint(); checkpoint != nil {
+		if hlc.Less(asOf, *checkpoint.Timestamp) {
+			return "", serviceerror.NewFailedPrecondition("the versioning audit log was compacted past the requested time")
+		}
+		defaultBuildId = checkpoint.GetDefaultBuildId()
+	}
+	for _, entry := range data.GetAuditLog() {
+		if hlc.Less(asOf, *entry.Timestamp) {
+			break
+		}
+		defaultBuildId = entry.GetDefaultBuildId()
+	}
+	return defaultBuildId, nil
+}
+
+// RepairVersioningData returns a copy of the given versioning data with structurally invalid entries fixed, and
+// whether any repair was needed. Data written by this server is never invalid, but a bad merge or a manual edit of
+// persistence may leave behind data that would otherwise break lookups:
+//   - a build id that appears more than once is kept only at its first occurrence;
+//   - a set id claimed by more than one set is kept only by the first of them;
+//   - a set left without set ids gets a fresh id derived from its first build id, the way new sets are created;
+//   - a set left without build ids, or whose fresh set id is already taken, is quarantined by dropping it.
+//
+// The input is never mutated.
+func RepairVersioningData(data *persistencespb.VersioningData) (*persistencespb.VersioningData, bool) {
+	if data == nil {
+		return nil, false
+	}
+	repaired := false
+	seenBuildIds := make(map[string]struct{})
+	seenSetIds := make(map[string]struct{})
+	modifiedData := persistencespb.VersioningData{
+		VersionSets:            make([]*persistencespb.CompatibleVersionSet, 0, len(data.GetVersionSets())),
+		DefaultUpdateTimestamp: data.GetDefaultUpdateTimestamp(),
+		AuditLog:               data.GetAuditLog(),
+		AuditLogCheckpoint:     data.GetAuditLogCheckpoint(),
+	}
+	for _, set := range data.GetVersionSets() {
+		modifiedSet := persistencespb.CompatibleVersionSet{
+			SetIds:                 make([]string, 0, len(set.GetSetIds())),
+			BuildIds:               make([]*persistencespb.BuildId, 0, len(set.GetBuildIds())),
+			DefaultUpdateTimestamp: set.GetDefaultUpdateTimestamp(),
+		}
+		for _, buildId := range set.GetBuildIds() {
+			if _, ok := seenBuildIds[buildId.GetId()]; ok {
+				repaired = true
+				continue
+			}
+			seenBuildIds[buildId.GetId()] = struct{}{}
+			modifiedSet.BuildIds = append(modifiedSet.BuildIds, buildId)
+		}
+		if len(modifiedSet.BuildIds) == 0 {
+			repaired = true
+			continue
+		}
+		for _, setId := range set.GetSetIds() {
+			if _, ok := seenSetIds[setId]; ok {
+				repaired = true
+				continue
+			}
+			modifiedSet.SetIds = append(modifiedSet.SetIds, setId)
+		}
+		if len(modifiedSet.SetIds) == 0 {
+			repaired = true
+			setId := hashBuildId(modifiedSet.BuildIds[0].GetId())
+			if _, ok := seenSetIds[setId]; ok {
+				continue
+			}
+			modifiedSet.SetIds = append(modifiedSet.SetIds, setId)
+		}
+		for _, setId := range modifiedSet.SetIds {
+			seenSetIds[setId] = struct{}{}
+		}
+		modifiedData.VersionSets = append(modifiedData.VersionSets, &modifiedSet)
+	}
+	if !repaired {
+		return data, false
+	}
+	return &modifiedData, true
+}
+
 func hashBuildId(buildID string) string {
 	bytes := []byte(buildID)
 	summed := sha256.Sum256(bytes)
@@ -168,6 +672,8 @@ func updateImpl(timestamp hlc.Clock, existingData *persistencespb.VersioningData
 	modifiedData := persistencespb.VersioningData{
 		VersionSets:            make([]*persistencespb.CompatibleVersionSet, len(existingData.GetVersionSets())),
 		DefaultUpdateTimestamp: existingData.GetDefaultUpdateTimestamp(),
+		AuditLog:               existingData.GetAuditLog(),
+		AuditLogCheckpoint:     existingData.GetAuditLogCheckpoint(),
 	}
 	copy(modifiedData.VersionSets, existingData.GetVersionSets())
 
@@ -255,9 +761,45 @@ func updateImpl(timestamp hlc.Clock, existingData *persistencespb.VersioningData
 		makeVersionInSetDefault(&modifiedData, targetSetIdx, versionInSetIdx, &timestamp)
 	}
 
+	recordDefaultBuildIdChange(existingData, &modifiedData, timestamp)
 	return &modifiedData, nil
 }
 
+// getDefaultBuildId returns the task queue default build id, the default of the default set, or an empty string if
+// there are no version sets.
+func getDefaultBuildId(data *persistencespb.VersioningData) string {
+	sets := data.GetVersionSets()
+	if len(sets) == 0 {
+		return ""
+	}
+	buildIds := sets[len(sets)-1].GetBuildIds()
+	if len(buildIds) == 0 {
+		return ""
+	}
+	return buildIds[len(buildIds)-1].GetId()
+}
+
+// recordDefaultBuildIdChange adds an entry to the audit log of modified if its default build id differs from the one in
+// existing. The entry is inserted at its position by compareAuditEntries, which is normally the end of the log, so the
+// log stays sorted. The log slice is copied to avoid mutating the one shared with the existing data.
+func recordDefaultBuildIdChange(existing *persistencespb.VersioningData, modified *persistencespb.VersioningData, timestamp hlc.Clock) {
+	defaultBuildId := getDefaultBuildId(modified)
+	if defaultBuildId == "" || defaultBuildId == getDefaultBuildId(existing) {
+		return
+	}
+	entry := &persistencespb.VersioningAuditEntry{
+		Timestamp:      &timestamp,
+		DefaultBuildId: defaultBuildId,
+	}
+	idx := sort.Search(len(modified.AuditLog), func(i int) bool {
+		return compareAuditEntries(modified.AuditLog[i], entry) > 0
+	})
+	auditLog := make([]*persistencespb.VersioningAuditEntry, 0, len(modified.AuditLog)+1)
+	auditLog = append(auditLog, modified.AuditLog[:idx]...)
+	auditLog = append(auditLog, entry)
+	modified.AuditLog = append(auditLog, modified.AuditLog[idx:]...)
+}
+
 func extractTargetedVersion(req *workflowservice.UpdateWorkerBuildIdCompatibilityRequest) string {
 	if req.GetAddNewCompatibleBuildId() != nil {
 		return req.GetAddNewCompatibleBuildId().GetNewBuildId()
@@ -310,8 +852,13 @@ func makeVersionInSetDefault(data *persistencespb.VersioningData, setIx, version
 }
 
 // Requires: caps is not nil
-func lookupVersionSetForPoll(data *persistencespb.VersioningData, caps *commonpb.WorkerVersionCapabilities) (string, error) {
-	// For poll, only the latest version in the compatible set can get tasks.
+func lookupVersionSetForPoll(
+	data *persistencespb.VersioningData,
+	caps *commonpb.WorkerVersionCapabilities,
+	dispatchWeights map[string]int,
+) (string, error) {
+	// For poll, only the latest version in the compatible set can get tasks, unless the build ID was given a dispatch
+	// weight, in which case it shares the tasks of its set with the other weighted build IDs.
 	// Find the version set that this worker is in.
 	// Note data may be nil here, findVersion will return -1 then.
 	setIdx, indexInSet := findVersion(data, caps.BuildId)
@@ -330,15 +877,19 @@ func lookupVersionSetForPoll(data *persistencespb.VersioningData, caps *commonpb
 	}
 	set := data.VersionSets[setIdx]
 	lastIndex := len(set.BuildIds) - 1
-	if indexInSet != lastIndex {
+	if indexInSet != lastIndex && dispatchWeights[caps.BuildId] <= 0 {
 		return "", serviceerror.NewNewerBuildExists(set.BuildIds[lastIndex].Id)
 	}
 	return getSetID(set), nil
 }
 
 // Requires: caps is not nil
-func checkVersionForStickyPoll(data *persistencespb.VersioningData, caps *commonpb.WorkerVersionCapabilities) error {
-	// For poll, only the latest version in the compatible set can get tasks.
+func checkVersionForStickyPoll(
+	data *persistencespb.VersioningData,
+	caps *commonpb.WorkerVersionCapabilities,
+	dispatchWeights map[string]int,
+) error {
+	// For poll, only the latest version in the compatible set (or a weighted one) can get tasks.
 	// Find the version set that this worker is in.
 	// Note data may be nil here, findVersion will return -1 then.
 	setIdx, indexInSet := findVersion(data, caps.BuildId)
@@ -350,12 +901,29 @@ func checkVersionForStickyPoll(data *persistencespb.VersioningData, caps *common
 	}
 	set := data.VersionSets[setIdx]
 	lastIndex := len(set.BuildIds) - 1
-	if indexInSet != lastIndex {
+	if indexInSet != lastIndex && dispatchWeights[caps.BuildId] <= 0 {
 		return serviceerror.NewNewerBuildExists(set.BuildIds[lastIndex].Id)
 	}
 	return nil
 }
 
+// lookupBuildIdForActivityAdd applies the activity default build id of a task queue to an activity task, returning the
+// build id to pass to lookupVersionSetForAdd. Tasks that requested the task queue default (buildId == "") are sent to
+// the activity default. Tasks that requested a build id compatible with their workflow's keep it if intentWins is set,
+// otherwise they are also sent to the activity default. An activity default that is not in data is ignored.
+func lookupBuildIdForActivityAdd(data *persistencespb.VersioningData, buildId, activityDefault string, intentWins bool) string {
+	if activityDefault == "" {
+		return buildId
+	}
+	if setIdx, _ := findVersion(data, activityDefault); setIdx < 0 {
+		return buildId
+	}
+	if buildId != "" && intentWins {
+		return buildId
+	}
+	return activityDefault
+}
+
 // For this function, buildId == "" means "use default"
 func lookupVersionSetForAdd(data *persistencespb.VersioningData, buildId string) (string, error) {
 	var set *persistencespb.CompatibleVersionSet
@@ -368,6 +936,14 @@ func lookupVersionSetForAdd(data *persistencespb.VersioningData, buildId string)
 			return "", errEmptyVersioningData
 		}
 		set = data.VersionSets[setLen-1]
+		// Don't start new work on a draining build id. Fall back to the most recent set whose default is not
+		// draining, or stay on the default set if they all are.
+		for i := setLen - 1; i >= 0; i-- {
+			if candidate := data.VersionSets[i]; candidate != nil && !isSetDefaultDraining(candidate) {
+				set = candidate
+				break
+			}
+		}
 	} else {
 		// For add, any version in the compatible set maps to the set.
 		// Note data may be nil here, findVersion will return -1 then.
@@ -392,6 +968,9 @@ func lookupVersionSetForAdd(data *persistencespb.VersioningData, buildId string)
 	return getSetID(set), nil
 }
 
+// checkVersionForStickyAdd validates, before a task is dispatched to a versioned sticky queue, that the build id the
+// workflow is pinned to is still the default of its set, i.e. the build id sticky pollers are allowed to poll with.
+// Returns errPinnedBuildIdNotDefault if the task must be bounced back to the normal queue.
 // For this function, buildId == "" means "use default"
 func checkVersionForStickyAdd(data *persistencespb.VersioningData, buildId string) error {
 	if buildId == "" {
@@ -409,11 +988,37 @@ func checkVersionForStickyAdd(data *persistencespb.VersioningData, buildId strin
 	}
 	// If this is not the set's default anymore, we need to kick it back to the regular queue.
 	if indexInSet != len(data.VersionSets[setIdx].BuildIds)-1 {
-		return serviceerrors.NewStickyWorkerUnavailable()
+		return errPinnedBuildIdNotDefault
 	}
 	return nil
 }
 
+func isSetDefaultDraining(set *persistencespb.CompatibleVersionSet) bool {
+	buildIds := set.GetBuildIds()
+	return len(buildIds) > 0 && buildIds[len(buildIds)-1].GetState() == persistencespb.STATE_DRAINING
+}
+
+// retiredBuildIdTaskExpiry returns the time at which a task created at createTime and pinned to buildId expires
+// because the default build id of its set was drained or deleted: ttl after the later of the task creation and the
+// default being retired. Returns the zero time if the task never expires, i.e. the set default is still active or the
+// build id is unknown.
+func retiredBuildIdTaskExpiry(data *persistencespb.VersioningData, buildId string, createTime time.Time, ttl time.Duration) time.Time {
+	setIdx, _ := findVersion(data, buildId)
+	if setIdx < 0 {
+		return time.Time{}
+	}
+	buildIds := data.VersionSets[setIdx].GetBuildIds()
+	setDefault := buildIds[len(buildIds)-1]
+	if setDefault.GetState() == persistencespb.STATE_ACTIVE {
+		return time.Time{}
+	}
+	retiredAt := time.UnixMilli(setDefault.GetStateUpdateTimestamp().GetWallClock())
+	if createTime.After(retiredAt) {
+		retiredAt = createTime
+	}
+	return retiredAt.Add(ttl)
+}
+
 // getSetID returns an arbitrary but consistent member of the set.
 // We want Add and Poll requests for the same set to converge on a single id so we can match
 // them, but we don't have a single id for a set in the general case: in rare cases we may have
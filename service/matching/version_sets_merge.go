@@ -26,9 +26,12 @@ package matching
 
 import (
 	"sort"
+	"strings"
 
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 )
 
 // Merge and sort two sets of set IDs
@@ -74,6 +77,9 @@ type buildIDInfo struct {
 	stateUpdateTimestamp hlc.Clock
 	setIDs               []string
 	madeDefaultAt        hlc.Clock
+	labels               map[string]string
+	// nil if the labels were never set
+	labelsUpdateTimestamp *hlc.Clock
 }
 
 func collectBuildIdInfo(sets []*persistencespb.CompatibleVersionSet) map[string]buildIDInfo {
@@ -92,12 +98,20 @@ func collectBuildIdInfo(sets []*persistencespb.CompatibleVersionSet) map[string]
 				if setIdx == lastIdx {
 					madeDefaultAt = hlc.Max(*set.DefaultUpdateTimestamp, madeDefaultAt)
 				}
+				labels := info.labels
+				labelsUpdateTimestamp := info.labelsUpdateTimestamp
+				if buildID.LabelsUpdateTimestamp != nil && (labelsUpdateTimestamp == nil || hlc.Greater(*buildID.LabelsUpdateTimestamp, *labelsUpdateTimestamp)) {
+					labels = buildID.Labels
+					labelsUpdateTimestamp = buildID.LabelsUpdateTimestamp
+				}
 
 				buildIDToInfo[buildID.Id] = buildIDInfo{
-					state:                state,
-					stateUpdateTimestamp: stateUpdateTimestamp,
-					setIDs:               mergeSetIDs(info.setIDs, set.SetIds),
-					madeDefaultAt:        madeDefaultAt,
+					state:                 state,
+					stateUpdateTimestamp:  stateUpdateTimestamp,
+					setIDs:                mergeSetIDs(info.setIDs, set.SetIds),
+					madeDefaultAt:         madeDefaultAt,
+					labels:                labels,
+					labelsUpdateTimestamp: labelsUpdateTimestamp,
 				}
 			} else {
 				// A build ID was seen for the first time, track it
@@ -106,10 +120,12 @@ func collectBuildIdInfo(sets []*persistencespb.CompatibleVersionSet) map[string]
 					madeDefaultAt = *set.DefaultUpdateTimestamp
 				}
 				buildIDToInfo[buildID.Id] = buildIDInfo{
-					state:                buildID.State,
-					stateUpdateTimestamp: *buildID.StateUpdateTimestamp,
-					setIDs:               set.SetIds,
-					madeDefaultAt:        madeDefaultAt,
+					state:                 buildID.State,
+					stateUpdateTimestamp:  *buildID.StateUpdateTimestamp,
+					setIDs:                set.SetIds,
+					madeDefaultAt:         madeDefaultAt,
+					labels:                buildID.Labels,
+					labelsUpdateTimestamp: buildID.LabelsUpdateTimestamp,
 				}
 			}
 		}
@@ -138,6 +154,11 @@ func intoVersionSets(buildIDToInfo map[string]buildIDInfo, defaultSetIds []strin
 			State:                info.state,
 			StateUpdateTimestamp: &timestamp,
 		}
+		// Deleted build ids don't keep their labels
+		if isBuildIdLive(buildID) {
+			buildID.Labels = info.labels
+			buildID.LabelsUpdateTimestamp = info.labelsUpdateTimestamp
+		}
 		defaultTimestamp := info.madeDefaultAt
 
 		// Insert the build ID in the right order based on whether it is the default or by its update timestamp
@@ -177,7 +198,8 @@ func sortSets(sets []*persistencespb.CompatibleVersionSet, defaultSetIds []strin
 }
 
 // MergeVersioningData merges two VersioningData structs.
-// If a build ID appears in both data structures, the merged structure will include that latest status and timestamp.
+// If a build ID appears in both data structures, the merged structure will include that latest status and timestamp,
+// and the latest labels.
 // If a build ID appears in different sets in the different structures, those sets will be merged.
 // The merged data's per set default and global default will be set according to the latest timestamps in the sources.
 // if (a) is nil, (b) is returned as is, otherwise, if (b) is nil (a) is returned as is.
@@ -201,8 +223,85 @@ func MergeVersioningData(a *persistencespb.VersioningData, b *persistencespb.Ver
 	// Build the merged compatible sets using collected build ID information
 	sets := intoVersionSets(buildIDToInfo, defaultSetIds)
 
+	// The later checkpoint wins, entries the other side did not compact yet are dropped if they are older than it
+	auditLogCheckpoint := mergeAuditLogCheckpoints(a.AuditLogCheckpoint, b.AuditLogCheckpoint)
+	auditLog := mergeAuditLogs(a.AuditLog, b.AuditLog)
+	if auditLogCheckpoint != nil {
+		auditLog = auditLog[auditLogIndex(auditLog, *auditLogCheckpoint.Timestamp):]
+	}
+
 	return &persistencespb.VersioningData{
 		VersionSets:            sets,
 		DefaultUpdateTimestamp: &maxDefaultTimestamp,
+		AuditLog:               auditLog,
+		AuditLogCheckpoint:     auditLogCheckpoint,
+	}
+}
+
+// mergeAuditLogCheckpoints returns the later of two audit log checkpoints, ordered by compareAuditEntries, or nil if
+// neither log was compacted.
+func mergeAuditLogCheckpoints(a *persistencespb.VersioningAuditEntry, b *persistencespb.VersioningAuditEntry) *persistencespb.VersioningAuditEntry {
+	if a == nil {
+		return b
+	} else if b == nil {
+		return a
+	}
+	if compareAuditEntries(a, b) < 0 {
+		return b
+	}
+	return a
+}
+
+// compareAuditEntries orders audit log entries by their HLC timestamp, breaking ties by build id so that the order is
+// strict and doesn't depend on the order in which the logs were merged. Returns -1 if a comes before b, 1 if it comes
+// after and 0 if the entries are identical.
+func compareAuditEntries(a *persistencespb.VersioningAuditEntry, b *persistencespb.VersioningAuditEntry) int {
+	// Note that hlc.Compare returns 1 if its first argument is the smaller one
+	if c := hlc.Compare(*b.Timestamp, *a.Timestamp); c != 0 {
+		return c
+	}
+	return strings.Compare(a.GetDefaultBuildId(), b.GetDefaultBuildId())
+}
+
+// mergeAuditLogs returns the union of two audit logs ordered by compareAuditEntries. Entries present in both logs,
+// identified by their timestamp and build id, are kept once.
+func mergeAuditLogs(a []*persistencespb.VersioningAuditEntry, b []*persistencespb.VersioningAuditEntry) []*persistencespb.VersioningAuditEntry {
+	if len(a) == 0 && len(b) == 0 {
+		return nil
+	}
+	all := make([]*persistencespb.VersioningAuditEntry, 0, len(a)+len(b))
+	all = append(append(all, a...), b...)
+	sort.Slice(all, func(i, j int) bool {
+		return compareAuditEntries(all[i], all[j]) < 0
+	})
+	merged := all[:1]
+	for _, entry := range all[1:] {
+		if compareAuditEntries(merged[len(merged)-1], entry) != 0 {
+			merged = append(merged, entry)
+		}
+	}
+	return merged
+}
+
+// logVersioningDataMergeDecision records which side's default set wins when merging versioning data received from
+// another cluster (remote) into the local data, mirroring the tie-breaking done by MergeVersioningData.
+func logVersioningDataMergeDecision(logger log.Logger, local *persistencespb.VersioningData, remote *persistencespb.VersioningData) {
+	if local.GetDefaultUpdateTimestamp() == nil || remote.GetDefaultUpdateTimestamp() == nil {
+		// nothing to resolve, the other side is taken as is
+		return
+	}
+	localClock := *local.DefaultUpdateTimestamp
+	remoteClock := *remote.DefaultUpdateTimestamp
+	result, decidedBy := hlc.CompareVerbose(localClock, remoteClock)
+	winner, winningClock := "remote", remoteClock
+	if result < 0 {
+		winner, winningClock = "local", localClock
 	}
+	logger.Info("Resolved versioning data merge",
+		tag.NewAnyTag("local-default-update-clock", localClock),
+		tag.NewAnyTag("remote-default-update-clock", remoteClock),
+		tag.NewInt("compare-result", result),
+		tag.NewStringTag("decided-by", decidedBy),
+		tag.NewStringTag("winner", winner),
+		tag.NewInt64("winning-cluster-id", winningClock.ClusterId))
 }
@@ -27,10 +27,13 @@ package matching
 import (
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 )
 
 func fromWallClock(wallclock int64) *hlc.Clock {
@@ -150,6 +153,24 @@ func TestSetMerge_DifferentStates_UpdatesTimestampsAndState(t *testing.T) {
 	assert.Equal(t, b, MergeVersioningData(b, a))
 }
 
+func TestSetMerge_DifferentLabels_PrefersNewerLabels(t *testing.T) {
+	withLabels := func(wallclock int64, labels map[string]string) *persistencespb.VersioningData {
+		data := mkSingleSetData("0.1", buildID(1, "0.1"))
+		data.VersionSets[0].BuildIds[0].Labels = labels
+		data.VersionSets[0].BuildIds[0].LabelsUpdateTimestamp = fromWallClock(wallclock)
+		return data
+	}
+	a := withLabels(2, map[string]string{"commit": "a"})
+	b := withLabels(3, map[string]string{"commit": "b"})
+	assert.Equal(t, b, MergeVersioningData(a, b))
+	assert.Equal(t, b, MergeVersioningData(b, a))
+
+	// Labels are kept when the other side never set them
+	c := mkSingleSetData("0.1", buildID(1, "0.1"))
+	assert.Equal(t, a, MergeVersioningData(a, c))
+	assert.Equal(t, a, MergeVersioningData(c, a))
+}
+
 func TestSetMerge_MultipleMatches_MergesSets(t *testing.T) {
 	a := mkSingleSetData("0.1", buildID(1, "0.1"), buildID(3, "0.2"))
 	b := &persistencespb.VersioningData{
@@ -198,3 +219,139 @@ func TestSetMerge_SetPromoted_PreservesGlobalDefault(t *testing.T) {
 	assert.Equal(t, b, MergeVersioningData(a, b))
 	assert.Equal(t, b, MergeVersioningData(b, a))
 }
+
+func TestSetMerge_AuditLogs_UnionOrderedByTimestamp(t *testing.T) {
+	shared := &persistencespb.VersioningAuditEntry{Timestamp: fromWallClock(1), DefaultBuildId: "0.1"}
+	a := &persistencespb.VersioningData{
+		VersionSets: []*persistencespb.CompatibleVersionSet{
+			mkSet("0.1", buildID(1, "0.1")),
+			mkSet("0.3", buildID(3, "0.3")),
+		},
+		DefaultUpdateTimestamp: fromWallClock(3),
+		AuditLog: []*persistencespb.VersioningAuditEntry{
+			shared,
+			{Timestamp: fromWallClock(3), DefaultBuildId: "0.3"},
+		},
+	}
+	b := &persistencespb.VersioningData{
+		VersionSets: []*persistencespb.CompatibleVersionSet{
+			mkSet("0.1", buildID(1, "0.1")),
+			mkSet("0.2", buildID(2, "0.2")),
+		},
+		DefaultUpdateTimestamp: fromWallClock(2),
+		AuditLog: []*persistencespb.VersioningAuditEntry{
+			shared,
+			{Timestamp: fromWallClock(2), DefaultBuildId: "0.2"},
+		},
+	}
+	expected := []*persistencespb.VersioningAuditEntry{
+		shared,
+		{Timestamp: fromWallClock(2), DefaultBuildId: "0.2"},
+		{Timestamp: fromWallClock(3), DefaultBuildId: "0.3"},
+	}
+	assert.Equal(t, expected, MergeVersioningData(a, b).AuditLog)
+	assert.Equal(t, expected, MergeVersioningData(b, a).AuditLog)
+}
+
+func TestSetMerge_AuditLogs_DropsEntriesBeforeCheckpoint(t *testing.T) {
+	entries := []*persistencespb.VersioningAuditEntry{
+		{Timestamp: fromWallClock(1), DefaultBuildId: "0.1"},
+		{Timestamp: fromWallClock(2), DefaultBuildId: "0.2"},
+		{Timestamp: fromWallClock(3), DefaultBuildId: "0.3"},
+	}
+	a := &persistencespb.VersioningData{
+		VersionSets: []*persistencespb.CompatibleVersionSet{
+			mkSet("0.1", buildID(1, "0.1")),
+			mkSet("0.2", buildID(2, "0.2")),
+			mkSet("0.3", buildID(3, "0.3")),
+		},
+		DefaultUpdateTimestamp: fromWallClock(3),
+		AuditLog:               entries,
+	}
+	b := CompactAuditLog(a, *fromWallClock(3))
+
+	expectedCheckpoint := &persistencespb.VersioningAuditEntry{Timestamp: fromWallClock(3), DefaultBuildId: "0.2"}
+	assert.Equal(t, expectedCheckpoint, MergeVersioningData(a, b).AuditLogCheckpoint)
+	assert.Equal(t, entries[2:], MergeVersioningData(a, b).AuditLog)
+	assert.Equal(t, expectedCheckpoint, MergeVersioningData(b, a).AuditLogCheckpoint)
+	assert.Equal(t, entries[2:], MergeVersioningData(b, a).AuditLog)
+}
+
+func TestMergeAuditLogs_OverlappingLogs_OrderedByHLCAndDeduplicated(t *testing.T) {
+	entry := func(wallclock int64, version int32, clusterID int64, buildID string) *persistencespb.VersioningAuditEntry {
+		return &persistencespb.VersioningAuditEntry{
+			Timestamp:      &hlc.Clock{WallClock: wallclock, Version: version, ClusterId: clusterID},
+			DefaultBuildId: buildID,
+		}
+	}
+	// Cluster 1 and 2 share their history up to wallclock 2, then diverge. Entries with the same wallclock are
+	// ordered by version, then cluster id, then build id.
+	a := []*persistencespb.VersioningAuditEntry{
+		entry(1, 0, 1, "0.1"),
+		entry(2, 0, 1, "0.2"),
+		entry(3, 0, 1, "0.3"),
+		entry(3, 1, 1, "0.4"),
+	}
+	b := []*persistencespb.VersioningAuditEntry{
+		entry(1, 0, 1, "0.1"),
+		entry(2, 0, 1, "0.2"),
+		entry(3, 0, 2, "1.0"),
+		entry(3, 0, 2, "0.9"),
+	}
+	expected := []*persistencespb.VersioningAuditEntry{
+		entry(1, 0, 1, "0.1"),
+		entry(2, 0, 1, "0.2"),
+		entry(3, 0, 1, "0.3"),
+		entry(3, 0, 2, "0.9"),
+		entry(3, 0, 2, "1.0"),
+		entry(3, 1, 1, "0.4"),
+	}
+	assert.Equal(t, expected, mergeAuditLogs(a, b))
+	assert.Equal(t, expected, mergeAuditLogs(b, a))
+	// Merging is idempotent
+	assert.Equal(t, expected, mergeAuditLogs(expected, a))
+	assert.Equal(t, expected, mergeAuditLogs(expected, expected))
+	assert.Nil(t, mergeAuditLogs(nil, nil))
+}
+
+func TestLogVersioningDataMergeDecision(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := log.NewMockLogger(ctrl)
+
+	localClock := hlc.Clock{WallClock: 5, Version: 0, ClusterId: 1}
+	remoteClock := hlc.Clock{WallClock: 5, Version: 0, ClusterId: 2}
+	local := &persistencespb.VersioningData{
+		VersionSets:            []*persistencespb.CompatibleVersionSet{mkSet("0.1", buildID(2, "0.1")), mkSet("1.0", buildID(5, "1.0"))},
+		DefaultUpdateTimestamp: &localClock,
+	}
+	remote := &persistencespb.VersioningData{
+		VersionSets:            []*persistencespb.CompatibleVersionSet{mkSet("1.0", buildID(3, "1.0")), mkSet("0.1", buildID(2, "0.1"))},
+		DefaultUpdateTimestamp: &remoteClock,
+	}
+
+	// same wall clock and version, the higher cluster id wins
+	logger.EXPECT().Info("Resolved versioning data merge",
+		tag.NewAnyTag("local-default-update-clock", localClock),
+		tag.NewAnyTag("remote-default-update-clock", remoteClock),
+		tag.NewInt("compare-result", 1),
+		tag.NewStringTag("decided-by", hlc.DecidedByClusterID),
+		tag.NewStringTag("winner", "remote"),
+		tag.NewInt64("winning-cluster-id", 2))
+	logVersioningDataMergeDecision(logger, local, remote)
+	assert.Equal(t, []string{"0.1"}, MergeVersioningData(local, remote).VersionSets[1].SetIds)
+
+	// a later wall clock wins regardless of cluster id
+	localClock.WallClock = 6
+	logger.EXPECT().Info("Resolved versioning data merge",
+		tag.NewAnyTag("local-default-update-clock", localClock),
+		tag.NewAnyTag("remote-default-update-clock", remoteClock),
+		tag.NewInt("compare-result", -1),
+		tag.NewStringTag("decided-by", hlc.DecidedByWallClock),
+		tag.NewStringTag("winner", "local"),
+		tag.NewInt64("winning-cluster-id", 1))
+	logVersioningDataMergeDecision(logger, local, remote)
+	assert.Equal(t, []string{"1.0"}, MergeVersioningData(local, remote).VersionSets[1].SetIds)
+
+	// nothing to resolve without local data
+	logVersioningDataMergeDecision(logger, nil, remote)
+}
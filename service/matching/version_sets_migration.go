@@ -0,0 +1,110 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
+)
+
+// versioningDataMigrations upgrades versioning data from the schema version at its index to the next one. Steps work
+// on a copy of the data and may replace any part of it, but must not mutate what they replace.
+var versioningDataMigrations = []func(data *persistencespb.VersioningData){
+	// 0 -> 1: data written before build id states, set default timestamps and the audit log were introduced.
+	migrateVersioningDataToV1,
+}
+
+// currentVersioningDataSchemaVersion is the schema version of versioning data written by this server.
+var currentVersioningDataSchemaVersion = int32(len(versioningDataMigrations))
+
+// MigrateVersioningData returns a copy of the given versioning data upgraded to the current schema version, and
+// whether any migration was needed. Data of the current version, or of a newer one written by a more recent server,
+// is returned as is. The input is never mutated.
+func MigrateVersioningData(data *persistencespb.VersioningData) (*persistencespb.VersioningData, bool) {
+	if data == nil || data.GetSchemaVersion() >= currentVersioningDataSchemaVersion {
+		return data, false
+	}
+	modifiedData := *data
+	for version := modifiedData.SchemaVersion; version < currentVersioningDataSchemaVersion; version++ {
+		versioningDataMigrations[version](&modifiedData)
+	}
+	modifiedData.SchemaVersion = currentVersioningDataSchemaVersion
+	return &modifiedData, true
+}
+
+// stampVersioningDataSchema returns user data whose versioning data is marked with the current schema version, copying
+// it if needed. Versioning data is always migrated before being updated, so whatever is written is current.
+func stampVersioningDataSchema(userData *persistencespb.TaskQueueUserData) *persistencespb.TaskQueueUserData {
+	versioningData := userData.GetVersioningData()
+	if versioningData == nil || versioningData.GetSchemaVersion() >= currentVersioningDataSchemaVersion {
+		return userData
+	}
+	modifiedData := *userData
+	modifiedVersioningData := *versioningData
+	modifiedVersioningData.SchemaVersion = currentVersioningDataSchemaVersion
+	modifiedData.VersioningData = &modifiedVersioningData
+	return &modifiedData
+}
+
+// migrateVersioningDataToV1 fills in the fields older data was written without:
+//   - build ids without a state are active, they predate draining and deletion;
+//   - missing timestamps default to the enclosing one, i.e. a build id to its set default update timestamp and a set to
+//     the data default update timestamp, so that merges have something to compare;
+//   - an empty audit log is seeded with the current default build id as of the last default update.
+func migrateVersioningDataToV1(data *persistencespb.VersioningData) {
+	sets := make([]*persistencespb.CompatibleVersionSet, 0, len(data.GetVersionSets()))
+	for _, set := range data.GetVersionSets() {
+		modifiedSet := *set
+		if modifiedSet.DefaultUpdateTimestamp == nil {
+			modifiedSet.DefaultUpdateTimestamp = data.GetDefaultUpdateTimestamp()
+		}
+		modifiedSet.BuildIds = make([]*persistencespb.BuildId, 0, len(set.GetBuildIds()))
+		for _, buildId := range set.GetBuildIds() {
+			modifiedBuildId := *buildId
+			if modifiedBuildId.State == persistencespb.STATE_UNSPECIFIED {
+				modifiedBuildId.State = persistencespb.STATE_ACTIVE
+			}
+			if modifiedBuildId.StateUpdateTimestamp == nil {
+				modifiedBuildId.StateUpdateTimestamp = modifiedSet.DefaultUpdateTimestamp
+			}
+			modifiedSet.BuildIds = append(modifiedSet.BuildIds, &modifiedBuildId)
+		}
+		sets = append(sets, &modifiedSet)
+	}
+	data.VersionSets = sets
+
+	defaultBuildId := getDefaultBuildId(data)
+	if len(data.GetAuditLog()) > 0 || data.GetAuditLogCheckpoint() != nil || defaultBuildId == "" {
+		return
+	}
+	timestamp := data.GetDefaultUpdateTimestamp()
+	if setTimestamp := sets[len(sets)-1].GetDefaultUpdateTimestamp(); timestamp == nil || setTimestamp != nil && hlc.Less(*timestamp, *setTimestamp) {
+		timestamp = setTimestamp
+	}
+	if timestamp == nil {
+		return
+	}
+	data.AuditLog = []*persistencespb.VersioningAuditEntry{{Timestamp: timestamp, DefaultBuildId: defaultBuildId}}
+}
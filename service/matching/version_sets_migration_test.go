@@ -0,0 +1,138 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	enumspb "go.temporal.io/api/enums/v1"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
+)
+
+// mkLegacyVersioningDataBlob returns versioning data as written before schema versions were introduced: build ids have
+// neither a state nor a timestamp, only the data has a default update timestamp and there is no audit log.
+func mkLegacyVersioningDataBlob(t *testing.T) []byte {
+	clock := hlc.Zero(1)
+	legacy := &persistencespb.VersioningData{
+		VersionSets: []*persistencespb.CompatibleVersionSet{
+			{SetIds: []string{hashBuildId("1")}, BuildIds: []*persistencespb.BuildId{{Id: "1"}}},
+			{SetIds: []string{hashBuildId("2")}, BuildIds: []*persistencespb.BuildId{{Id: "2"}, {Id: "2.1"}}},
+		},
+		DefaultUpdateTimestamp: &clock,
+	}
+	blob, err := legacy.Marshal()
+	assert.NoError(t, err)
+	return blob
+}
+
+func assertMigratedLegacyVersioningData(t *testing.T, data *persistencespb.VersioningData) {
+	clock := hlc.Zero(1)
+	assert.Equal(t, currentVersioningDataSchemaVersion, data.GetSchemaVersion())
+	for _, set := range data.GetVersionSets() {
+		assert.Equal(t, &clock, set.GetDefaultUpdateTimestamp())
+		for _, buildId := range set.GetBuildIds() {
+			assert.Equal(t, persistencespb.STATE_ACTIVE, buildId.GetState())
+			assert.Equal(t, &clock, buildId.GetStateUpdateTimestamp())
+		}
+	}
+	assert.Equal(t, []*persistencespb.VersioningAuditEntry{{Timestamp: &clock, DefaultBuildId: "2.1"}}, data.GetAuditLog())
+}
+
+func TestMigrateVersioningData(t *testing.T) {
+	data := &persistencespb.VersioningData{}
+	assert.NoError(t, data.Unmarshal(mkLegacyVersioningDataBlob(t)))
+	original := &persistencespb.VersioningData{}
+	assert.NoError(t, original.Unmarshal(mkLegacyVersioningDataBlob(t)))
+
+	migrated, ok := MigrateVersioningData(data)
+	assert.True(t, ok)
+	assertMigratedLegacyVersioningData(t, migrated)
+	// input not mutated
+	assert.Equal(t, original, data)
+
+	// already current data is returned as is
+	again, ok := MigrateVersioningData(migrated)
+	assert.False(t, ok)
+	assert.Same(t, migrated, again)
+
+	// an existing audit log is kept
+	data.AuditLog = []*persistencespb.VersioningAuditEntry{{Timestamp: data.DefaultUpdateTimestamp, DefaultBuildId: "2"}}
+	migrated, ok = MigrateVersioningData(data)
+	assert.True(t, ok)
+	assert.Equal(t, data.AuditLog, migrated.AuditLog)
+}
+
+func TestMigrateVersioningData_Nil(t *testing.T) {
+	migrated, ok := MigrateVersioningData(nil)
+	assert.False(t, ok)
+	assert.Nil(t, migrated)
+}
+
+func TestTaskQueueDBMigratesUserDataOnLoad(t *testing.T) {
+	ctx := context.Background()
+	logger := log.NewTestLogger()
+	taskManager := newTestTaskManager(logger)
+	metricsHandler := metricstest.NewCaptureHandler()
+	capture := metricsHandler.StartCapture()
+	defer metricsHandler.StopCapture(capture)
+
+	legacy := &persistencespb.VersioningData{}
+	assert.NoError(t, legacy.Unmarshal(mkLegacyVersioningDataBlob(t)))
+	legacyTaskQueue := newTestTaskQueueID(defaultNamespaceId, "legacy", enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	taskManager.getTaskQueueManager(legacyTaskQueue).userData = &persistencespb.VersionedTaskQueueUserData{
+		Version: 3,
+		Data:    &persistencespb.TaskQueueUserData{VersioningData: legacy},
+	}
+	db := newTaskQueueDB(taskManager, nil, defaultNamespaceId, legacyTaskQueue, enumspb.TASK_QUEUE_KIND_NORMAL, logger, metricsHandler)
+	userData, _, err := db.GetUserData(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), userData.GetVersion())
+	assertMigratedLegacyVersioningData(t, userData.GetData().GetVersioningData())
+	assert.Len(t, capture.Snapshot()[metrics.TaskQueueVersioningDataMigrated.GetMetricName()], 1)
+
+	current := &persistencespb.VersionedTaskQueueUserData{Version: 1, Data: mkUserData(2)}
+	currentTaskQueue := newTestTaskQueueID(defaultNamespaceId, "current", enumspb.TASK_QUEUE_TYPE_WORKFLOW)
+	taskManager.getTaskQueueManager(currentTaskQueue).userData = current
+	db = newTaskQueueDB(taskManager, nil, defaultNamespaceId, currentTaskQueue, enumspb.TASK_QUEUE_KIND_NORMAL, logger, metricsHandler)
+	userData, _, err = db.GetUserData(ctx)
+	assert.NoError(t, err)
+	assert.Same(t, current, userData)
+	assert.Len(t, capture.Snapshot()[metrics.TaskQueueVersioningDataMigrated.GetMetricName()], 1)
+}
+
+func TestStampVersioningDataSchema(t *testing.T) {
+	userData := &persistencespb.TaskQueueUserData{VersioningData: mkInitialData(1, hlc.Zero(1))}
+	stamped := stampVersioningDataSchema(userData)
+	assert.Equal(t, currentVersioningDataSchemaVersion, stamped.GetVersioningData().GetSchemaVersion())
+	assert.Equal(t, int32(0), userData.GetVersioningData().GetSchemaVersion())
+	assert.Same(t, stamped, stampVersioningDataSchema(stamped))
+}
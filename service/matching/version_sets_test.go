@@ -27,6 +27,7 @@ package matching
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -34,6 +35,7 @@ import (
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	clockspb "go.temporal.io/server/api/clock/v1"
+	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	commonclock "go.temporal.io/server/common/clock"
 	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
@@ -60,9 +62,11 @@ func mkInitialData(numSets int, clock clockspb.HybridLogicalClock) *persistences
 
 func mkUserData(numSets int) *persistencespb.TaskQueueUserData {
 	clock := hlc.Zero(1)
+	data := mkInitialData(numSets, clock)
+	data.SchemaVersion = currentVersioningDataSchemaVersion
 	return &persistencespb.TaskQueueUserData{
 		Clock:          &clock,
-		VersioningData: mkInitialData(numSets, clock),
+		VersioningData: data,
 	}
 }
 
@@ -105,7 +109,7 @@ func TestNewDefaultUpdate(t *testing.T) {
 
 	req := mkNewDefReq("2")
 	nextClock := hlc.Next(clock, commonclock.NewRealTimeSource())
-	updatedData, err := UpdateVersionSets(nextClock, initialData, req, 0, 0)
+	updatedData, err := UpdateVersionSets(nextClock, initialData, req, 0, 0, 0)
 	assert.NoError(t, err)
 	assert.Equal(t, mkInitialData(2, clock), initialData)
 
@@ -128,6 +132,9 @@ func TestNewDefaultUpdate(t *testing.T) {
 				DefaultUpdateTimestamp: &nextClock,
 			},
 		},
+		AuditLog: []*persistencespb.VersioningAuditEntry{
+			{Timestamp: &nextClock, DefaultBuildId: "2"},
+		},
 	}
 	assert.Equal(t, expected, updatedData)
 
@@ -141,7 +148,7 @@ func TestNewDefaultSetUpdateOfEmptyData(t *testing.T) {
 
 	req := mkNewDefReq("1")
 	nextClock := hlc.Next(clock, commonclock.NewRealTimeSource())
-	updatedData, err := UpdateVersionSets(nextClock, initialData, req, 0, 0)
+	updatedData, err := UpdateVersionSets(nextClock, initialData, req, 0, 0, 0)
 	assert.NoError(t, err)
 	assert.Equal(t, mkInitialData(0, clock), initialData)
 
@@ -154,6 +161,9 @@ func TestNewDefaultSetUpdateOfEmptyData(t *testing.T) {
 				DefaultUpdateTimestamp: &nextClock,
 			},
 		},
+		AuditLog: []*persistencespb.VersioningAuditEntry{
+			{Timestamp: &nextClock, DefaultBuildId: "1"},
+		},
 	}
 	assert.Equal(t, expected, updatedData)
 }
@@ -164,7 +174,7 @@ func TestNewDefaultSetUpdateCompatWithCurDefault(t *testing.T) {
 
 	req := mkNewCompatReq("1.1", "1", true)
 	nextClock := hlc.Next(clock, commonclock.NewRealTimeSource())
-	updatedData, err := UpdateVersionSets(nextClock, initialData, req, 0, 0)
+	updatedData, err := UpdateVersionSets(nextClock, initialData, req, 0, 0, 0)
 	assert.NoError(t, err)
 	assert.Equal(t, mkInitialData(2, clock), initialData)
 
@@ -185,6 +195,9 @@ func TestNewDefaultSetUpdateCompatWithCurDefault(t *testing.T) {
 				DefaultUpdateTimestamp: &nextClock,
 			},
 		},
+		AuditLog: []*persistencespb.VersioningAuditEntry{
+			{Timestamp: &nextClock, DefaultBuildId: "1.1"},
+		},
 	}
 	assert.Equal(t, expected, updatedData)
 }
@@ -195,7 +208,7 @@ func TestNewDefaultSetUpdateCompatWithNonDefaultSet(t *testing.T) {
 
 	req := mkNewCompatReq("0.1", "0", true)
 	nextClock := hlc.Next(clock, commonclock.NewRealTimeSource())
-	updatedData, err := UpdateVersionSets(nextClock, initialData, req, 0, 0)
+	updatedData, err := UpdateVersionSets(nextClock, initialData, req, 0, 0, 0)
 	assert.NoError(t, err)
 	assert.Equal(t, mkInitialData(2, clock), initialData)
 
@@ -216,6 +229,9 @@ func TestNewDefaultSetUpdateCompatWithNonDefaultSet(t *testing.T) {
 				DefaultUpdateTimestamp: &nextClock,
 			},
 		},
+		AuditLog: []*persistencespb.VersioningAuditEntry{
+			{Timestamp: &nextClock, DefaultBuildId: "0.1"},
+		},
 	}
 	assert.Equal(t, expected, updatedData)
 }
@@ -226,7 +242,7 @@ func TestNewCompatibleWithVerInOlderSet(t *testing.T) {
 
 	req := mkNewCompatReq("0.1", "0", false)
 	nextClock := hlc.Next(clock, commonclock.NewRealTimeSource())
-	updatedData, err := UpdateVersionSets(nextClock, initialData, req, 0, 0)
+	updatedData, err := UpdateVersionSets(nextClock, initialData, req, 0, 0, 0)
 	assert.NoError(t, err)
 	assert.Equal(t, mkInitialData(2, clock), initialData)
 
@@ -260,12 +276,12 @@ func TestNewCompatibleWithNonDefaultSetUpdate(t *testing.T) {
 
 	req := mkNewCompatReq("0.1", "0", false)
 	clock1 := hlc.Next(clock0, commonclock.NewRealTimeSource())
-	data, err := UpdateVersionSets(clock1, data, req, 0, 0)
+	data, err := UpdateVersionSets(clock1, data, req, 0, 0, 0)
 	assert.NoError(t, err)
 
 	req = mkNewCompatReq("0.2", "0.1", false)
 	clock2 := hlc.Next(clock1, commonclock.NewRealTimeSource())
-	data, err = UpdateVersionSets(clock2, data, req, 0, 0)
+	data, err = UpdateVersionSets(clock2, data, req, 0, 0, 0)
 	assert.NoError(t, err)
 
 	expected := &persistencespb.VersioningData{
@@ -292,7 +308,7 @@ func TestNewCompatibleWithNonDefaultSetUpdate(t *testing.T) {
 	// Ensure setting a compatible version which targets a non-leaf compat version ends up without a branch
 	req = mkNewCompatReq("0.3", "0.1", false)
 	clock3 := hlc.Next(clock1, commonclock.NewRealTimeSource())
-	data, err = UpdateVersionSets(clock3, data, req, 0, 0)
+	data, err = UpdateVersionSets(clock3, data, req, 0, 0, 0)
 	assert.NoError(t, err)
 
 	expected = &persistencespb.VersioningData{
@@ -325,7 +341,7 @@ func TestCompatibleTargetsNotFound(t *testing.T) {
 
 	req := mkNewCompatReq("1.1", "1", false)
 	nextClock := hlc.Next(clock, commonclock.NewRealTimeSource())
-	_, err := UpdateVersionSets(nextClock, data, req, 0, 0)
+	_, err := UpdateVersionSets(nextClock, data, req, 0, 0, 0)
 	var notFound *serviceerror.NotFound
 	assert.ErrorAs(t, err, &notFound)
 }
@@ -336,7 +352,7 @@ func TestMakeExistingSetDefault(t *testing.T) {
 
 	req := mkExistingDefault("1")
 	clock1 := hlc.Next(clock0, commonclock.NewRealTimeSource())
-	data, err := UpdateVersionSets(clock1, data, req, 0, 0)
+	data, err := UpdateVersionSets(clock1, data, req, 0, 0, 0)
 	assert.NoError(t, err)
 
 	expected := &persistencespb.VersioningData{
@@ -360,6 +376,9 @@ func TestMakeExistingSetDefault(t *testing.T) {
 				DefaultUpdateTimestamp: &clock0,
 			},
 		},
+		AuditLog: []*persistencespb.VersioningAuditEntry{
+			{Timestamp: &clock1, DefaultBuildId: "1"},
+		},
 	}
 
 	assert.Equal(t, expected, data)
@@ -367,7 +386,7 @@ func TestMakeExistingSetDefault(t *testing.T) {
 	req = mkNewCompatReq("0.1", "0", true)
 
 	clock2 := hlc.Next(clock1, commonclock.NewRealTimeSource())
-	data, err = UpdateVersionSets(clock2, data, req, 0, 0)
+	data, err = UpdateVersionSets(clock2, data, req, 0, 0, 0)
 	assert.NoError(t, err)
 
 	expected = &persistencespb.VersioningData{
@@ -392,6 +411,10 @@ func TestMakeExistingSetDefault(t *testing.T) {
 				DefaultUpdateTimestamp: &clock2,
 			},
 		},
+		AuditLog: []*persistencespb.VersioningAuditEntry{
+			{Timestamp: &clock1, DefaultBuildId: "1"},
+			{Timestamp: &clock2, DefaultBuildId: "0.1"},
+		},
 	}
 	assert.Equal(t, expected, data)
 }
@@ -401,11 +424,11 @@ func TestSayVersionIsCompatWithDifferentSetThanItsAlreadyCompatWithNotAllowed(t
 	data := mkInitialData(3, clock)
 
 	req := mkNewCompatReq("0.1", "0", false)
-	data, err := UpdateVersionSets(clock, data, req, 0, 0)
+	data, err := UpdateVersionSets(clock, data, req, 0, 0, 0)
 	assert.NoError(t, err)
 
 	req = mkNewCompatReq("0.1", "1", false)
-	_, err = UpdateVersionSets(clock, data, req, 0, 0)
+	_, err = UpdateVersionSets(clock, data, req, 0, 0, 0)
 	var invalidArgument *serviceerror.InvalidArgument
 	assert.ErrorAs(t, err, &invalidArgument)
 }
@@ -416,7 +439,7 @@ func TestLimitsMaxSets(t *testing.T) {
 	data := mkInitialData(maxSets, clock)
 
 	req := mkNewDefReq("10")
-	_, err := UpdateVersionSets(clock, data, req, maxSets, 0)
+	_, err := UpdateVersionSets(clock, data, req, maxSets, 0, 0)
 	var failedPrecondition *serviceerror.FailedPrecondition
 	assert.ErrorAs(t, err, &failedPrecondition)
 }
@@ -427,9 +450,24 @@ func TestLimitsMaxBuildIds(t *testing.T) {
 	data := mkInitialData(maxBuildIds, clock)
 
 	req := mkNewDefReq("10")
-	_, err := UpdateVersionSets(clock, data, req, 0, maxBuildIds)
+	_, err := UpdateVersionSets(clock, data, req, 0, maxBuildIds, 0)
+	var failedPrecondition *serviceerror.FailedPrecondition
+	assert.ErrorAs(t, err, &failedPrecondition)
+}
+
+func TestLimitsMaxBuildIdsPerSet(t *testing.T) {
+	clock := hlc.Zero(1)
+	maxBuildIdsPerSet := 2
+	data := mkInitialData(2, clock)
+
+	data, err := UpdateVersionSets(clock, data, mkNewCompatReq("0.1", "0", false), 0, 0, maxBuildIdsPerSet)
+	assert.NoError(t, err)
+	_, err = UpdateVersionSets(clock, data, mkNewCompatReq("0.2", "0.1", false), 0, 0, maxBuildIdsPerSet)
 	var failedPrecondition *serviceerror.FailedPrecondition
 	assert.ErrorAs(t, err, &failedPrecondition)
+	// Other sets are unaffected
+	_, err = UpdateVersionSets(clock, data, mkNewCompatReq("1.1", "1", false), 0, 0, maxBuildIdsPerSet)
+	assert.NoError(t, err)
 }
 
 func TestPromoteWithinVersion(t *testing.T) {
@@ -438,15 +476,15 @@ func TestPromoteWithinVersion(t *testing.T) {
 
 	req := mkNewCompatReq("0.1", "0", false)
 	clock1 := hlc.Next(clock0, commonclock.NewRealTimeSource())
-	data, err := UpdateVersionSets(clock1, data, req, 0, 0)
+	data, err := UpdateVersionSets(clock1, data, req, 0, 0, 0)
 	assert.NoError(t, err)
 	req = mkNewCompatReq("0.2", "0", false)
 	clock2 := hlc.Next(clock1, commonclock.NewRealTimeSource())
-	data, err = UpdateVersionSets(clock2, data, req, 0, 0)
+	data, err = UpdateVersionSets(clock2, data, req, 0, 0, 0)
 	assert.NoError(t, err)
 	req = mkPromoteInSet("0.1")
 	clock3 := hlc.Next(clock2, commonclock.NewRealTimeSource())
-	data, err = UpdateVersionSets(clock3, data, req, 0, 0)
+	data, err = UpdateVersionSets(clock3, data, req, 0, 0, 0)
 	assert.NoError(t, err)
 
 	expected := &persistencespb.VersioningData{
@@ -476,7 +514,7 @@ func TestAddNewDefaultAlreadyExtantVersionWithNoConflictSucceeds(t *testing.T) {
 	original := mkInitialData(3, clock)
 
 	req := mkNewDefReq("2")
-	updated, err := UpdateVersionSets(clock, original, req, 0, 0)
+	updated, err := UpdateVersionSets(clock, original, req, 0, 0, 0)
 	assert.NoError(t, err)
 	assert.Equal(t, original, updated)
 }
@@ -484,9 +522,9 @@ func TestAddNewDefaultAlreadyExtantVersionWithNoConflictSucceeds(t *testing.T) {
 func TestAddToExistingSetAlreadyExtantVersionWithNoConflictSucceeds(t *testing.T) {
 	clock := hlc.Zero(1)
 	req := mkNewCompatReq("1.1", "1", false)
-	original, err := UpdateVersionSets(clock, mkInitialData(3, clock), req, 0, 0)
+	original, err := UpdateVersionSets(clock, mkInitialData(3, clock), req, 0, 0, 0)
 	assert.NoError(t, err)
-	updated, err := UpdateVersionSets(clock, original, req, 0, 0)
+	updated, err := UpdateVersionSets(clock, original, req, 0, 0, 0)
 	assert.NoError(t, err)
 	assert.Equal(t, original, updated)
 }
@@ -494,10 +532,10 @@ func TestAddToExistingSetAlreadyExtantVersionWithNoConflictSucceeds(t *testing.T
 func TestAddToExistingSetAlreadyExtantVersionErrorsIfNotDefault(t *testing.T) {
 	clock := hlc.Zero(1)
 	req := mkNewCompatReq("1.1", "1", true)
-	original, err := UpdateVersionSets(clock, mkInitialData(3, clock), req, 0, 0)
+	original, err := UpdateVersionSets(clock, mkInitialData(3, clock), req, 0, 0, 0)
 	assert.NoError(t, err)
 	req = mkNewCompatReq("1", "1.1", true)
-	_, err = UpdateVersionSets(clock, original, req, 0, 0)
+	_, err = UpdateVersionSets(clock, original, req, 0, 0, 0)
 	var invalidArgument *serviceerror.InvalidArgument
 	assert.ErrorAs(t, err, &invalidArgument)
 }
@@ -505,10 +543,10 @@ func TestAddToExistingSetAlreadyExtantVersionErrorsIfNotDefault(t *testing.T) {
 func TestAddToExistingSetAlreadyExtantVersionErrorsIfNotDefaultSet(t *testing.T) {
 	clock := hlc.Zero(1)
 	req := mkNewCompatReq("1.1", "1", false)
-	original, err := UpdateVersionSets(clock, mkInitialData(3, clock), req, 0, 0)
+	original, err := UpdateVersionSets(clock, mkInitialData(3, clock), req, 0, 0, 0)
 	assert.NoError(t, err)
 	req = mkNewCompatReq("1.1", "1", true)
-	_, err = UpdateVersionSets(clock, original, req, 0, 0)
+	_, err = UpdateVersionSets(clock, original, req, 0, 0, 0)
 	var invalidArgument *serviceerror.InvalidArgument
 	assert.ErrorAs(t, err, &invalidArgument)
 }
@@ -518,7 +556,7 @@ func TestPromoteWithinSetAlreadyPromotedIsANoop(t *testing.T) {
 	original := mkInitialData(3, clock0)
 	req := mkPromoteInSet("1")
 	clock1 := hlc.Zero(2)
-	updated, err := UpdateVersionSets(clock1, original, req, 0, 0)
+	updated, err := UpdateVersionSets(clock1, original, req, 0, 0, 0)
 	assert.NoError(t, err)
 	assert.Equal(t, original, updated)
 }
@@ -528,7 +566,7 @@ func TestPromoteSetAlreadyPromotedIsANoop(t *testing.T) {
 	original := mkInitialData(3, clock0)
 	req := mkExistingDefault("2")
 	clock1 := hlc.Zero(2)
-	updated, err := UpdateVersionSets(clock1, original, req, 0, 0)
+	updated, err := UpdateVersionSets(clock1, original, req, 0, 0, 0)
 	assert.NoError(t, err)
 	assert.Equal(t, original, updated)
 }
@@ -538,7 +576,7 @@ func TestAddAlreadyExtantVersionAsDefaultErrors(t *testing.T) {
 	data := mkInitialData(3, clock)
 
 	req := mkNewDefReq("0")
-	_, err := UpdateVersionSets(clock, data, req, 0, 0)
+	_, err := UpdateVersionSets(clock, data, req, 0, 0, 0)
 	var invalidArgument *serviceerror.InvalidArgument
 	assert.ErrorAs(t, err, &invalidArgument)
 }
@@ -548,7 +586,7 @@ func TestAddAlreadyExtantVersionToAnotherSetErrors(t *testing.T) {
 	data := mkInitialData(3, clock)
 
 	req := mkNewCompatReq("0", "1", false)
-	_, err := UpdateVersionSets(clock, data, req, 0, 0)
+	_, err := UpdateVersionSets(clock, data, req, 0, 0, 0)
 	var invalidArgument *serviceerror.InvalidArgument
 	assert.ErrorAs(t, err, &invalidArgument)
 }
@@ -558,7 +596,7 @@ func TestMakeSetDefaultTargetingNonexistentVersionErrors(t *testing.T) {
 	data := mkInitialData(3, clock)
 
 	req := mkExistingDefault("crab boi")
-	_, err := UpdateVersionSets(clock, data, req, 0, 0)
+	_, err := UpdateVersionSets(clock, data, req, 0, 0, 0)
 	var notFound *serviceerror.NotFound
 	assert.ErrorAs(t, err, &notFound)
 }
@@ -568,7 +606,7 @@ func TestPromoteWithinSetTargetingNonexistentVersionErrors(t *testing.T) {
 	data := mkInitialData(3, clock)
 
 	req := mkPromoteInSet("i'd rather be writing rust ;)")
-	_, err := UpdateVersionSets(clock, data, req, 0, 0)
+	_, err := UpdateVersionSets(clock, data, req, 0, 0, 0)
 	var notFound *serviceerror.NotFound
 	assert.ErrorAs(t, err, &notFound)
 }
@@ -601,6 +639,479 @@ func TestToBuildIdOrderingResponseOmitsDeleted(t *testing.T) {
 	assert.Equal(t, expected, actual.MajorVersionSets)
 }
 
+func TestMarkBuildIdDraining(t *testing.T) {
+	clock := hlc.Zero(1)
+	initialData := mkInitialData(2, clock)
+
+	nextClock := hlc.Next(clock, commonclock.NewRealTimeSource())
+	updatedData, err := MarkBuildIdDraining(nextClock, initialData, "1")
+	assert.NoError(t, err)
+	assert.Equal(t, mkInitialData(2, clock), initialData)
+
+	expected := mkInitialData(2, clock)
+	expected.VersionSets[1].BuildIds[0] = &persistencespb.BuildId{Id: "1", State: persistencespb.STATE_DRAINING, StateUpdateTimestamp: &nextClock}
+	assert.Equal(t, expected, updatedData)
+
+	// Draining build ids are still reported
+	actual := ToBuildIdOrderingResponse(updatedData, 0)
+	assert.Equal(t, []*taskqueuepb.CompatibleVersionSet{{BuildIds: []string{"0"}}, {BuildIds: []string{"1"}}}, actual.MajorVersionSets)
+
+	// Idempotent
+	again, err := MarkBuildIdDraining(hlc.Next(nextClock, commonclock.NewRealTimeSource()), updatedData, "1")
+	assert.NoError(t, err)
+	assert.Equal(t, updatedData, again)
+}
+
+func TestMarkBuildIdDrainingTargetingNonexistentVersionErrors(t *testing.T) {
+	clock := hlc.Zero(1)
+	_, err := MarkBuildIdDraining(clock, mkInitialData(2, clock), "nope")
+	var notFound *serviceerror.NotFound
+	assert.ErrorAs(t, err, &notFound)
+}
+
+func TestRemoveBuildIds(t *testing.T) {
+	clock := hlc.Zero(1)
+	mkData := func() *persistencespb.VersioningData {
+		data := mkInitialData(3, clock)
+		data, err := UpdateVersionSets(clock, data, mkNewCompatReq("1.1", "1", false), 0, 0, 0)
+		assert.NoError(t, err)
+		return data
+	}
+	data := mkData()
+
+	nextClock := hlc.Next(clock, commonclock.NewRealTimeSource())
+	updatedData := RemoveBuildIds(nextClock, data, []string{"0", "1", "nope"})
+	assert.Equal(t, mkData(), data)
+
+	expected := mkData()
+	expected.VersionSets[0].BuildIds[0] = &persistencespb.BuildId{Id: "0", State: persistencespb.STATE_DELETED, StateUpdateTimestamp: &nextClock}
+	expected.VersionSets[1].BuildIds[0] = &persistencespb.BuildId{Id: "1", State: persistencespb.STATE_DELETED, StateUpdateTimestamp: &nextClock}
+	assert.Equal(t, expected, updatedData)
+	// Untouched sets are shared with the original data
+	assert.Same(t, data.VersionSets[2], updatedData.VersionSets[2])
+
+	// Sets without live build ids are not reported
+	actual := ToBuildIdOrderingResponse(updatedData, 0)
+	assert.Equal(t, []*taskqueuepb.CompatibleVersionSet{{BuildIds: []string{"1.1"}}, {BuildIds: []string{"2"}}}, actual.MajorVersionSets)
+
+	// Already deleted build ids keep their original timestamp
+	again := RemoveBuildIds(hlc.Next(nextClock, commonclock.NewRealTimeSource()), updatedData, []string{"0"})
+	assert.Equal(t, updatedData, again)
+}
+
+func TestRemoveBuildId(t *testing.T) {
+	clock := hlc.Zero(1)
+	mkData := func() *persistencespb.VersioningData {
+		data := mkInitialData(3, clock)
+		data, err := UpdateVersionSets(clock, data, mkNewCompatReq("1.1", "1", false), 0, 0, 0)
+		assert.NoError(t, err)
+		return data
+	}
+	data := mkData()
+
+	nextClock := hlc.Next(clock, commonclock.NewRealTimeSource())
+	updatedData, err := RemoveBuildId(nextClock, data, "1")
+	assert.NoError(t, err)
+	assert.Equal(t, mkData(), data)
+
+	expected := mkData()
+	expected.VersionSets[1].BuildIds[0] = &persistencespb.BuildId{Id: "1", State: persistencespb.STATE_DELETED, StateUpdateTimestamp: &nextClock}
+	assert.Equal(t, expected, updatedData)
+
+	// Idempotent
+	again, err := RemoveBuildId(hlc.Next(nextClock, commonclock.NewRealTimeSource()), updatedData, "1")
+	assert.NoError(t, err)
+	assert.Equal(t, updatedData, again)
+
+	// The only build id of a set may be removed as long as it is not the queue default
+	updatedData, err = RemoveBuildId(nextClock, updatedData, "0")
+	assert.NoError(t, err)
+	actual := ToBuildIdOrderingResponse(updatedData, 0)
+	assert.Equal(t, []*taskqueuepb.CompatibleVersionSet{{BuildIds: []string{"1.1"}}, {BuildIds: []string{"2"}}}, actual.MajorVersionSets)
+}
+
+func TestRemoveBuildIdRefusesDefaults(t *testing.T) {
+	clock := hlc.Zero(1)
+	data := mkInitialData(3, clock)
+	data, err := UpdateVersionSets(clock, data, mkNewCompatReq("1.1", "1", false), 0, 0, 0)
+	assert.NoError(t, err)
+
+	var failedPrecondition *serviceerror.FailedPrecondition
+	// Queue default
+	_, err = RemoveBuildId(clock, data, "2")
+	assert.ErrorAs(t, err, &failedPrecondition)
+	// Default of a set with other live build ids
+	_, err = RemoveBuildId(clock, data, "1.1")
+	assert.ErrorAs(t, err, &failedPrecondition)
+
+	var notFound *serviceerror.NotFound
+	_, err = RemoveBuildId(clock, data, "nope")
+	assert.ErrorAs(t, err, &notFound)
+}
+
+func TestStaleVersionSets(t *testing.T) {
+	clock := hlc.Zero(1)
+	data := mkInitialData(3, clock)
+	data = RemoveBuildIds(clock, data, []string{"1"})
+
+	// The default changed too recently
+	assert.Empty(t, StaleVersionSets(data, clock))
+
+	// Sets without live build ids and the default set are never stale
+	boundary := hlc.Next(clock, commonclock.NewRealTimeSource())
+	assert.Equal(t, []*persistencespb.CompatibleVersionSet{data.VersionSets[0]}, StaleVersionSets(data, boundary))
+
+	// Making a set the default restarts the retention of all the others
+	data, err := UpdateVersionSets(boundary, data, mkExistingDefault("0"), 0, 0, 0)
+	assert.NoError(t, err)
+	assert.Empty(t, StaleVersionSets(data, boundary))
+}
+
+func TestApplyVersioningTemplate(t *testing.T) {
+	clock := hlc.Zero(1)
+	template, err := parseVersioningTemplate([]any{[]any{"1", "1.1"}, []string{"2"}, []any{"3", "3.1", "3.2"}})
+	assert.NoError(t, err)
+
+	data, err := ApplyVersioningTemplate(clock, nil, template, 0, 0, 0)
+	assert.NoError(t, err)
+	actual := ToBuildIdOrderingResponse(data, 0)
+	assert.Equal(t, []*taskqueuepb.CompatibleVersionSet{
+		{BuildIds: []string{"1", "1.1"}},
+		{BuildIds: []string{"2"}},
+		{BuildIds: []string{"3", "3.1", "3.2"}},
+	}, actual.MajorVersionSets)
+	assert.Equal(t, "3.2", getDefaultBuildId(data))
+
+	// Only applies to queues without versioning data
+	_, err = ApplyVersioningTemplate(clock, data, template, 0, 0, 0)
+	var failedPrecondition *serviceerror.FailedPrecondition
+	assert.ErrorAs(t, err, &failedPrecondition)
+
+	// Limits are enforced for the whole template
+	_, err = ApplyVersioningTemplate(clock, nil, template, 2, 0, 0)
+	assert.ErrorAs(t, err, &failedPrecondition)
+
+	// Duplicate build ids are rejected
+	_, err = ApplyVersioningTemplate(clock, nil, [][]string{{"1"}, {"1"}}, 0, 0, 0)
+	var invalidArgument *serviceerror.InvalidArgument
+	assert.ErrorAs(t, err, &invalidArgument)
+
+	_, err = parseVersioningTemplate([]any{[]any{1}})
+	assert.Error(t, err)
+}
+
+func TestSwapBuildIdsWithinSet(t *testing.T) {
+	clock := hlc.Zero(1)
+	mkData := func() *persistencespb.VersioningData {
+		data := mkInitialData(2, clock)
+		data, err := UpdateVersionSets(clock, data, mkNewCompatReq("1.1", "1", false), 0, 0, 0)
+		assert.NoError(t, err)
+		data, err = UpdateVersionSets(clock, data, mkNewCompatReq("1.2", "1.1", false), 0, 0, 0)
+		assert.NoError(t, err)
+		return data
+	}
+	data := mkData()
+
+	nextClock := hlc.Next(clock, commonclock.NewRealTimeSource())
+	updatedData, err := SwapBuildIdsWithinSet(nextClock, data, "1.2", "1")
+	assert.NoError(t, err)
+	assert.Equal(t, mkData(), data)
+	assert.Equal(t, "1", updatedData.VersionSets[1].BuildIds[2].Id)
+	assert.Equal(t, "1.1", updatedData.VersionSets[1].BuildIds[1].Id)
+	assert.Equal(t, "1.2", updatedData.VersionSets[1].BuildIds[0].Id)
+	assert.Equal(t, &nextClock, updatedData.VersionSets[1].DefaultUpdateTimestamp)
+	assert.Equal(t, data.VersionSets[0], updatedData.VersionSets[0])
+
+	// Swapping non default build ids leaves the default alone
+	swappedAgain, err := SwapBuildIdsWithinSet(hlc.Next(nextClock, commonclock.NewRealTimeSource()), updatedData, "1.2", "1.1")
+	assert.NoError(t, err)
+	assert.Equal(t, "1", swappedAgain.VersionSets[1].BuildIds[2].Id)
+	assert.Equal(t, &nextClock, swappedAgain.VersionSets[1].DefaultUpdateTimestamp)
+}
+
+func TestSwapBuildIdsWithinSetValidation(t *testing.T) {
+	clock := hlc.Zero(1)
+	data := mkInitialData(2, clock)
+
+	_, err := SwapBuildIdsWithinSet(clock, data, "0", "nope")
+	var notFound *serviceerror.NotFound
+	assert.ErrorAs(t, err, &notFound)
+
+	_, err = SwapBuildIdsWithinSet(clock, data, "0", "1")
+	var invalidArgument *serviceerror.InvalidArgument
+	assert.ErrorAs(t, err, &invalidArgument)
+}
+
+func TestSwapDefaultSets(t *testing.T) {
+	clock := hlc.Zero(1)
+	data := mkInitialData(4, clock)
+
+	nextClock := hlc.Next(clock, commonclock.NewRealTimeSource())
+	updatedData, err := SwapDefaultSets(nextClock, data, "3", "1")
+	assert.NoError(t, err)
+	assert.Equal(t, mkInitialData(4, clock), data)
+	assert.Equal(t, "0", updatedData.VersionSets[0].BuildIds[0].Id)
+	assert.Equal(t, "3", updatedData.VersionSets[1].BuildIds[0].Id)
+	assert.Equal(t, "2", updatedData.VersionSets[2].BuildIds[0].Id)
+	assert.Equal(t, "1", updatedData.VersionSets[3].BuildIds[0].Id)
+	assert.Equal(t, &nextClock, updatedData.DefaultUpdateTimestamp)
+	assert.Equal(t, "1", getDefaultBuildId(updatedData))
+	assert.Equal(t, &persistencespb.VersioningAuditEntry{Timestamp: &nextClock, DefaultBuildId: "1"}, updatedData.AuditLog[len(updatedData.AuditLog)-1])
+
+	// Swapping back restores the original order
+	swappedBack, err := SwapDefaultSets(hlc.Next(nextClock, commonclock.NewRealTimeSource()), updatedData, "3", "1")
+	assert.NoError(t, err)
+	assert.Equal(t, data.VersionSets, swappedBack.VersionSets)
+
+	// Both build ids in the default set is a noop
+	data, err = UpdateVersionSets(clock, data, mkNewCompatReq("3.1", "3", false), 0, 0, 0)
+	assert.NoError(t, err)
+	same, err := SwapDefaultSets(nextClock, data, "3", "3.1")
+	assert.NoError(t, err)
+	assert.Same(t, data, same)
+}
+
+func TestSwapDefaultSetsValidation(t *testing.T) {
+	clock := hlc.Zero(1)
+	data := mkInitialData(3, clock)
+
+	_, err := SwapDefaultSets(clock, data, "2", "nope")
+	var notFound *serviceerror.NotFound
+	assert.ErrorAs(t, err, &notFound)
+
+	_, err = SwapDefaultSets(clock, data, "0", "1")
+	var invalidArgument *serviceerror.InvalidArgument
+	assert.ErrorAs(t, err, &invalidArgument)
+}
+
+func TestSetBuildIdLabels(t *testing.T) {
+	clock := hlc.Zero(1)
+	initialData := mkInitialData(2, clock)
+	labels := map[string]string{"commit": "abc123", "owner": "team"}
+
+	nextClock := hlc.Next(clock, commonclock.NewRealTimeSource())
+	updatedData, err := SetBuildIdLabels(nextClock, initialData, "1", labels, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, mkInitialData(2, clock), initialData)
+
+	expected := mkInitialData(2, clock)
+	expected.VersionSets[1].BuildIds[0] = &persistencespb.BuildId{
+		Id:                    "1",
+		State:                 persistencespb.STATE_ACTIVE,
+		StateUpdateTimestamp:  &clock,
+		Labels:                labels,
+		LabelsUpdateTimestamp: &nextClock,
+	}
+	assert.Equal(t, expected, updatedData)
+
+	response := ToBuildIdOrderingResponse(updatedData, 0)
+	assert.Equal(t, map[string]*matchingservice.GetWorkerBuildIdCompatibilityResponse_BuildIdLabels{
+		"1": {Labels: labels},
+	}, ToBuildIdLabelsResponse(updatedData, response))
+
+	// Labels survive draining
+	drainedData, err := MarkBuildIdDraining(hlc.Next(nextClock, commonclock.NewRealTimeSource()), updatedData, "1")
+	assert.NoError(t, err)
+	assert.Equal(t, labels, drainedData.VersionSets[1].BuildIds[0].Labels)
+
+	// Empty labels clear them
+	clearedData, err := SetBuildIdLabels(hlc.Next(nextClock, commonclock.NewRealTimeSource()), updatedData, "1", map[string]string{}, 100)
+	assert.NoError(t, err)
+	assert.Nil(t, clearedData.VersionSets[1].BuildIds[0].Labels)
+	assert.Nil(t, ToBuildIdLabelsResponse(clearedData, ToBuildIdOrderingResponse(clearedData, 0)))
+}
+
+func TestSetBuildIdLabelsValidation(t *testing.T) {
+	clock := hlc.Zero(1)
+	data := mkInitialData(2, clock)
+
+	_, err := SetBuildIdLabels(clock, data, "nope", nil, 0)
+	var notFound *serviceerror.NotFound
+	assert.ErrorAs(t, err, &notFound)
+
+	_, err = SetBuildIdLabels(clock, data, "1", map[string]string{"commit": "abc123"}, 8)
+	var invalidArgument *serviceerror.InvalidArgument
+	assert.ErrorAs(t, err, &invalidArgument)
+
+	_, err = SetBuildIdLabels(clock, RemoveBuildIds(clock, data, []string{"1"}), "1", nil, 0)
+	var failedPrecondition *serviceerror.FailedPrecondition
+	assert.ErrorAs(t, err, &failedPrecondition)
+}
+
+func TestDefaultBuildIdAuditLog(t *testing.T) {
+	timeSource := commonclock.NewRealTimeSource()
+	clock0 := hlc.Zero(1)
+	clock1 := hlc.Next(clock0, timeSource)
+	data, err := UpdateVersionSets(clock1, nil, mkNewDefReq("0"), 0, 0, 0)
+	assert.NoError(t, err)
+	clock2 := hlc.Next(clock1, timeSource)
+	data, err = UpdateVersionSets(clock2, data, mkNewDefReq("1"), 0, 0, 0)
+	assert.NoError(t, err)
+	// Not a default change
+	clock3 := hlc.Next(clock2, timeSource)
+	data, err = UpdateVersionSets(clock3, data, mkNewCompatReq("0.1", "0", false), 0, 0, 0)
+	assert.NoError(t, err)
+	clock4 := hlc.Next(clock3, timeSource)
+	data, err = UpdateVersionSets(clock4, data, mkExistingDefault("0"), 0, 0, 0)
+	assert.NoError(t, err)
+	clock5 := hlc.Next(clock4, timeSource)
+	data, err = SwapBuildIdsWithinSet(clock5, data, "0", "0.1")
+	assert.NoError(t, err)
+	// Idempotent update
+	previous := data
+	data, err = UpdateVersionSets(hlc.Next(clock5, timeSource), data, mkExistingDefault("0.1"), 0, 0, 0)
+	assert.NoError(t, err)
+	assert.Same(t, previous, data)
+	// Other modifications carry the log over
+	data = RemoveBuildIds(hlc.Next(clock5, timeSource), data, []string{"1"})
+
+	assert.Equal(t, []*persistencespb.VersioningAuditEntry{
+		{Timestamp: &clock1, DefaultBuildId: "0"},
+		{Timestamp: &clock2, DefaultBuildId: "1"},
+		{Timestamp: &clock4, DefaultBuildId: "0.1"},
+		{Timestamp: &clock5, DefaultBuildId: "0"},
+	}, data.AuditLog)
+}
+
+func TestCompactAuditLog(t *testing.T) {
+	var data *persistencespb.VersioningData
+	var clocks []hlc.Clock
+	for i := 0; i < 5; i++ {
+		clock := hlc.Clock{WallClock: int64(i+1) * 100, ClusterId: 1}
+		clocks = append(clocks, clock)
+		var err error
+		data, err = UpdateVersionSets(clock, data, mkNewDefReq(fmt.Sprintf("%d", i)), 0, 0, 0)
+		assert.NoError(t, err)
+	}
+
+	boundary := hlc.Clock{WallClock: 350}
+	compacted := CompactAuditLog(data, boundary)
+	// Entries before the boundary are summarized into the checkpoint
+	assert.Equal(t, &persistencespb.VersioningAuditEntry{Timestamp: &boundary, DefaultBuildId: "2"}, compacted.AuditLogCheckpoint)
+	assert.Equal(t, []*persistencespb.VersioningAuditEntry{
+		{Timestamp: &clocks[3], DefaultBuildId: "3"},
+		{Timestamp: &clocks[4], DefaultBuildId: "4"},
+	}, compacted.AuditLog)
+	// The original data is not mutated
+	assert.Len(t, data.AuditLog, 5)
+	assert.Nil(t, data.AuditLogCheckpoint)
+
+	// As of queries at or after the boundary still reconstruct the default
+	for _, tc := range []struct {
+		asOf     hlc.Clock
+		expected string
+	}{
+		{asOf: boundary, expected: "2"},
+		{asOf: clocks[3], expected: "3"},
+		{asOf: hlc.Clock{WallClock: 450}, expected: "3"},
+		{asOf: hlc.Clock{WallClock: 1000}, expected: "4"},
+	} {
+		expected, err := getDefaultBuildIdAsOf(data, tc.asOf)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, expected)
+		actual, err := getDefaultBuildIdAsOf(compacted, tc.asOf)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, actual)
+	}
+	// Before the boundary they can't
+	_, err := getDefaultBuildIdAsOf(compacted, clocks[1])
+	var failedPrecondition *serviceerror.FailedPrecondition
+	assert.ErrorAs(t, err, &failedPrecondition)
+
+	// Compacting at or before the checkpoint is a noop
+	assert.Same(t, compacted, CompactAuditLog(compacted, boundary))
+	assert.Same(t, compacted, CompactAuditLog(compacted, hlc.Clock{WallClock: 150}))
+	// Nothing older than the boundary
+	assert.Same(t, data, CompactAuditLog(data, hlc.Clock{WallClock: 50}))
+
+	// Compacting further moves the checkpoint forward
+	boundary = hlc.Clock{WallClock: 1000}
+	compacted = CompactAuditLog(compacted, boundary)
+	assert.Equal(t, &persistencespb.VersioningAuditEntry{Timestamp: &boundary, DefaultBuildId: "4"}, compacted.AuditLogCheckpoint)
+	assert.Empty(t, compacted.AuditLog)
+	defaultBuildId, err := getDefaultBuildIdAsOf(compacted, boundary)
+	assert.NoError(t, err)
+	assert.Equal(t, "4", defaultBuildId)
+}
+
+func TestCheckVersionForStickyAdd(t *testing.T) {
+	clock := hlc.Zero(1)
+	data := mkInitialData(2, clock)
+	data, err := UpdateVersionSets(clock, data, mkNewCompatReq("1.1", "1", false), 0, 0, 0)
+	assert.NoError(t, err)
+
+	// The set default is still what the workflow is pinned to
+	assert.NoError(t, checkVersionForStickyAdd(data, "1.1"))
+	// Unknown build ids stay on the sticky queue
+	assert.NoError(t, checkVersionForStickyAdd(data, "nope"))
+	// A newer build id became the set default, the task must be bounced
+	assert.Equal(t, errPinnedBuildIdNotDefault, checkVersionForStickyAdd(data, "1"))
+}
+
+func TestDefaultBuildIdAuditLog_KeptSorted(t *testing.T) {
+	clock := hlc.Zero(1)
+	data, err := UpdateVersionSets(clock, nil, mkNewDefReq("0"), 0, 0, 0)
+	assert.NoError(t, err)
+	// An entry merged from a cluster whose clock is ahead
+	remoteClock := hlc.Clock{WallClock: 100, ClusterId: 2}
+	data.AuditLog = append(data.AuditLog, &persistencespb.VersioningAuditEntry{Timestamp: &remoteClock, DefaultBuildId: "2"})
+
+	localClock := hlc.Clock{WallClock: 50, ClusterId: 1}
+	data, err = UpdateVersionSets(localClock, data, mkNewDefReq("1"), 0, 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []*persistencespb.VersioningAuditEntry{
+		{Timestamp: &clock, DefaultBuildId: "0"},
+		{Timestamp: &localClock, DefaultBuildId: "1"},
+		{Timestamp: &remoteClock, DefaultBuildId: "2"},
+	}, data.AuditLog)
+}
+
+func TestLookupVersionSetForAddSkipsDrainingDefault(t *testing.T) {
+	clock := hlc.Zero(1)
+	data := mkInitialData(3, clock)
+
+	setID, err := lookupVersionSetForAdd(data, "")
+	assert.NoError(t, err)
+	assert.Equal(t, hashBuildId("2"), setID)
+
+	data, err = MarkBuildIdDraining(clock, data, "2")
+	assert.NoError(t, err)
+	setID, err = lookupVersionSetForAdd(data, "")
+	assert.NoError(t, err)
+	assert.Equal(t, hashBuildId("1"), setID)
+
+	// Tasks pinned to the draining build id still go to its set
+	setID, err = lookupVersionSetForAdd(data, "2")
+	assert.NoError(t, err)
+	assert.Equal(t, hashBuildId("2"), setID)
+
+	// If everything is draining, stay on the default set
+	data, err = MarkBuildIdDraining(clock, data, "1")
+	assert.NoError(t, err)
+	data, err = MarkBuildIdDraining(clock, data, "0")
+	assert.NoError(t, err)
+	setID, err = lookupVersionSetForAdd(data, "")
+	assert.NoError(t, err)
+	assert.Equal(t, hashBuildId("2"), setID)
+}
+
+func TestLookupBuildIdForActivityAdd(t *testing.T) {
+	data := mkInitialData(3, hlc.Zero(1))
+
+	// No activity default
+	assert.Equal(t, "", lookupBuildIdForActivityAdd(data, "", "", true))
+	assert.Equal(t, "0", lookupBuildIdForActivityAdd(data, "0", "", false))
+	// Unknown activity default is ignored
+	assert.Equal(t, "", lookupBuildIdForActivityAdd(data, "", "nope", true))
+	assert.Equal(t, "0", lookupBuildIdForActivityAdd(data, "0", "nope", false))
+	// Activity default replaces the task queue default
+	assert.Equal(t, "1", lookupBuildIdForActivityAdd(data, "", "1", true))
+	assert.Equal(t, "1", lookupBuildIdForActivityAdd(data, "", "1", false))
+	// Compatible intent wins only if configured to
+	assert.Equal(t, "0", lookupBuildIdForActivityAdd(data, "0", "1", true))
+	assert.Equal(t, "1", lookupBuildIdForActivityAdd(data, "0", "1", false))
+}
+
 func TestHashBuildId(t *testing.T) {
 	// This function should never change.
 	assert.Equal(t, "ftrPuUeORv2JD4Wp2wTU", hashBuildId("my-build-id"))
@@ -648,3 +1159,63 @@ func TestGetBuildIdDeltas_AcceptsNils(t *testing.T) {
 	assert.Equal(t, []string(nil), removed)
 	assert.Equal(t, []string(nil), added)
 }
+
+func TestRetiredBuildIdTaskExpiry(t *testing.T) {
+	clock := hlc.Zero(1)
+	data := mkInitialData(2, clock)
+	ttl := time.Minute
+	createTime := time.UnixMilli(1000)
+
+	// Active build ids never expire, neither do unknown ones
+	assert.True(t, retiredBuildIdTaskExpiry(data, "0", createTime, ttl).IsZero())
+	assert.True(t, retiredBuildIdTaskExpiry(data, "nope", createTime, ttl).IsZero())
+
+	drainClock := hlc.Clock{WallClock: 5000, Version: 0, ClusterId: 1}
+	data, err := MarkBuildIdDraining(drainClock, data, "0")
+	assert.NoError(t, err)
+
+	// Tasks created before the build id was retired get the full TTL after retirement
+	assert.Equal(t, time.UnixMilli(5000).Add(ttl), retiredBuildIdTaskExpiry(data, "0", createTime, ttl))
+	// Tasks created after get the full TTL after creation
+	assert.Equal(t, time.UnixMilli(9000).Add(ttl), retiredBuildIdTaskExpiry(data, "0", time.UnixMilli(9000), ttl))
+	// Other sets are unaffected
+	assert.True(t, retiredBuildIdTaskExpiry(data, "1", createTime, ttl).IsZero())
+
+	// A newer active default in the set means tasks still have somewhere to go
+	data, err = UpdateVersionSets(clock, data, &workflowservice.UpdateWorkerBuildIdCompatibilityRequest{
+		Operation: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_AddNewCompatibleBuildId{
+			AddNewCompatibleBuildId: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_AddNewCompatibleVersion{
+				NewBuildId:                "0.1",
+				ExistingCompatibleBuildId: "0",
+			},
+		},
+	}, 0, 0, 0)
+	assert.NoError(t, err)
+	assert.True(t, retiredBuildIdTaskExpiry(data, "0", createTime, ttl).IsZero())
+}
+
+func TestRepairVersioningData(t *testing.T) {
+	clock := hlc.Zero(1)
+	data := mkInitialData(3, clock)
+	repaired, ok := RepairVersioningData(data)
+	assert.False(t, ok)
+	assert.Same(t, data, repaired)
+
+	mkCorruptData := func() *persistencespb.VersioningData {
+		data := mkInitialData(4, clock)
+		// Build id "0" also appears in set 1
+		data.VersionSets[1].BuildIds = append(data.VersionSets[1].BuildIds, data.VersionSets[0].BuildIds[0])
+		// Set 2 claims the set id of set 1 and has no other
+		data.VersionSets[2].SetIds = data.VersionSets[1].SetIds
+		// Set 3 only holds a duplicate build id
+		data.VersionSets[3].BuildIds = data.VersionSets[1].BuildIds[:1]
+		return data
+	}
+	data = mkCorruptData()
+	repaired, ok = RepairVersioningData(data)
+	assert.True(t, ok)
+	assert.Equal(t, mkCorruptData(), data)
+
+	expected := mkInitialData(3, clock)
+	assert.Equal(t, expected, repaired)
+}
@@ -35,6 +35,7 @@ import (
 	"go.temporal.io/sdk/workflow"
 
 	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 )
 
 func (s *clientIntegrationSuite) TestAdminRebuildMutableState() {
@@ -110,3 +111,31 @@ func (s *clientIntegrationSuite) TestAdminRebuildMutableState() {
 	s.Equal(response1.DatabaseMutableState.ExecutionInfo.VersionHistories, response2.DatabaseMutableState.ExecutionInfo.VersionHistories)
 	s.Equal(response1.DatabaseMutableState.ExecutionState, response2.DatabaseMutableState.ExecutionState)
 }
+
+func (s *clientIntegrationSuite) TestAdminDescribeHistoryQueue() {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	resp, err := s.adminClient.DescribeHistoryQueue(ctx, &adminservice.DescribeHistoryQueueRequest{
+		ShardId:  1,
+		Category: enumsspb.TASK_CATEGORY_TRANSFER,
+	})
+	s.NoError(err)
+	s.Len(resp.GetQueues(), 1)
+	s.Equal(enumsspb.TASK_CATEGORY_TRANSFER, resp.GetQueues()[0].GetCategory())
+	s.NotNil(resp.GetQueues()[0].GetExclusiveReaderHighWatermark())
+
+	resp, err = s.adminClient.DescribeHistoryQueue(ctx, &adminservice.DescribeHistoryQueueRequest{
+		ShardId: 1,
+	})
+	s.NoError(err)
+	var categories []enumsspb.TaskCategory
+	for _, queue := range resp.GetQueues() {
+		categories = append(categories, queue.GetCategory())
+	}
+	s.Subset(categories, []enumsspb.TaskCategory{
+		enumsspb.TASK_CATEGORY_TRANSFER,
+		enumsspb.TASK_CATEGORY_TIMER,
+		enumsspb.TASK_CATEGORY_VISIBILITY,
+	})
+}
@@ -57,6 +57,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/membership"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
 	persistenceClient "go.temporal.io/server/common/persistence/client"
@@ -121,6 +122,7 @@ type (
 		mockAdminClient                  map[string]adminservice.AdminServiceClient
 		namespaceReplicationTaskExecutor namespace.ReplicationTaskExecutor
 		spanExporters                    []otelsdktrace.SpanExporter
+		captureMetricsHandler            *metricstest.CaptureHandler
 	}
 
 	// HistoryConfig contains configs for history service
@@ -191,6 +193,7 @@ func newTemporal(params *TemporalParams) *temporalImpl {
 		namespaceReplicationTaskExecutor: params.NamespaceReplicationTaskExecutor,
 		spanExporters:                    params.SpanExporters,
 		dcClient:                         testDCClient,
+		captureMetricsHandler:            metricstest.NewCaptureHandler(),
 	}
 	impl.overrideHistoryDynamicConfig(testDCClient)
 	return impl
@@ -390,7 +393,7 @@ func (c *temporalImpl) startFrontend(hosts map[primitives.ServiceName][]string,
 		fx.Provide(func() carchiver.ArchivalMetadata { return c.archiverMetadata }),
 		fx.Provide(func() provider.ArchiverProvider { return c.archiverProvider }),
 		fx.Provide(sdkClientFactoryProvider),
-		fx.Provide(func() metrics.Handler { return metrics.NoopMetricsHandler }),
+		fx.Provide(func() metrics.Handler { return c.captureMetricsHandler }),
 		fx.Provide(func() []grpc.UnaryServerInterceptor { return nil }),
 		fx.Provide(func() authorization.Authorizer { return nil }),
 		fx.Provide(func() authorization.ClaimMapper { return nil }),
@@ -471,7 +474,7 @@ func (c *temporalImpl) startHistory(
 				persistenceConfig,
 				serviceName,
 			),
-			fx.Provide(func() metrics.Handler { return metrics.NoopMetricsHandler }),
+			fx.Provide(func() metrics.Handler { return c.captureMetricsHandler }),
 			fx.Provide(func() listenHostPort { return listenHostPort(grpcPort) }),
 			fx.Provide(func() config.DCRedirectionPolicy { return config.DCRedirectionPolicy{} }),
 			fx.Provide(func() log.ThrottledLogger { return c.logger }),
@@ -559,7 +562,7 @@ func (c *temporalImpl) startMatching(hosts map[primitives.ServiceName][]string,
 			persistenceConfig,
 			serviceName,
 		),
-		fx.Provide(func() metrics.Handler { return metrics.NoopMetricsHandler }),
+		fx.Provide(func() metrics.Handler { return c.captureMetricsHandler }),
 		fx.Provide(func() listenHostPort { return listenHostPort(c.MatchingGRPCServiceAddress()) }),
 		fx.Provide(func() log.ThrottledLogger { return c.logger }),
 		fx.Provide(newRPCFactoryImpl),
@@ -570,6 +573,7 @@ func (c *temporalImpl) startMatching(hosts map[primitives.ServiceName][]string,
 		fx.Provide(func() carchiver.ArchivalMetadata { return c.archiverMetadata }),
 		fx.Provide(func() provider.ArchiverProvider { return c.archiverProvider }),
 		fx.Provide(func() client.FactoryProvider { return client.NewFactoryProvider() }),
+		fx.Provide(func() esclient.Client { return c.esClient }),
 		fx.Provide(func() searchattribute.Mapper { return nil }),
 		fx.Provide(func() resolver.ServiceResolver { return resolver.NewNoopResolver() }),
 		fx.Provide(persistenceClient.FactoryProvider),
@@ -648,7 +652,7 @@ func (c *temporalImpl) startWorker(hosts map[primitives.ServiceName][]string, st
 			persistenceConfig,
 			serviceName,
 		),
-		fx.Provide(func() metrics.Handler { return metrics.NoopMetricsHandler }),
+		fx.Provide(func() metrics.Handler { return c.captureMetricsHandler }),
 		fx.Provide(func() listenHostPort { return listenHostPort(c.WorkerGRPCServiceAddress()) }),
 		fx.Provide(func() config.DCRedirectionPolicy { return config.DCRedirectionPolicy{} }),
 		fx.Provide(func() log.ThrottledLogger { return c.logger }),
@@ -705,6 +709,10 @@ func (c *temporalImpl) GetExecutionManager() persistence.ExecutionManager {
 	return c.executionManager
 }
 
+func (c *temporalImpl) GetCaptureMetricsHandler() *metricstest.CaptureHandler {
+	return c.captureMetricsHandler
+}
+
 func (c *temporalImpl) overrideHistoryDynamicConfig(client *dcClient) {
 	client.OverrideValue(dynamicconfig.ReplicationTaskProcessorStartWait, time.Nanosecond)
 
@@ -29,16 +29,23 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/dgryski/go-farm"
+	"github.com/gogo/protobuf/types"
+	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 
+	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/activity"
 	sdkclient "go.temporal.io/sdk/client"
@@ -46,10 +53,17 @@ import (
 	"go.temporal.io/sdk/worker"
 	"go.temporal.io/sdk/workflow"
 
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
+	hlc "go.temporal.io/server/common/clock/hybrid_logical_clock"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/tqname"
 )
 
@@ -192,6 +206,118 @@ func (s *versioningIntegSuite) TestVersioningStatePersistsAcrossUnload() {
 	s.Equal(s.prefixed("foo"), getCurrentDefault(res))
 }
 
+func (s *versioningIntegSuite) TestCorruptVersioningDataRepairedOnLoad() {
+	ctx := NewContext()
+	tq := "integration-versioning-corrupt-data"
+
+	s.addNewDefaultBuildId(ctx, tq, "foo")
+	s.addNewDefaultBuildId(ctx, tq, "bar")
+
+	// Corrupt the persisted data behind matching's back: duplicate a build id across sets and add a set that
+	// references another set's id.
+	taskMgr := s.testCluster.testBase.TaskMgr
+	nsId := s.getNamespaceID(s.namespace)
+	stored, err := taskMgr.GetTaskQueueUserData(ctx, &persistence.GetTaskQueueUserDataRequest{
+		NamespaceID: nsId,
+		TaskQueue:   tq,
+	})
+	s.NoError(err)
+	data := stored.UserData.Data
+	sets := data.VersioningData.VersionSets
+	s.Len(sets, 2)
+	sets[1].BuildIds = append(sets[1].BuildIds, sets[0].BuildIds[0])
+	sets = append(sets, &persistencespb.CompatibleVersionSet{
+		SetIds:                 sets[1].SetIds,
+		BuildIds:               []*persistencespb.BuildId{{Id: s.prefixed("baz"), State: persistencespb.STATE_ACTIVE, StateUpdateTimestamp: data.Clock}},
+		DefaultUpdateTimestamp: data.Clock,
+	})
+	data.VersioningData.VersionSets = sets
+	err = taskMgr.UpdateTaskQueueUserData(ctx, &persistence.UpdateTaskQueueUserDataRequest{
+		NamespaceID: nsId,
+		TaskQueue:   tq,
+		UserData:    &persistencespb.VersionedTaskQueueUserData{Version: stored.UserData.Version, Data: data},
+	})
+	s.NoError(err)
+
+	captureHandler := s.testCluster.host.GetCaptureMetricsHandler()
+	capture := captureHandler.StartCapture()
+	defer captureHandler.StopCapture(capture)
+
+	// Unload the task queue so that the corrupt data is loaded from persistence.
+	_, err = s.testCluster.host.matchingClient.ForceUnloadTaskQueue(ctx, &matchingservice.ForceUnloadTaskQueueRequest{
+		NamespaceId:   nsId,
+		TaskQueue:     tq,
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	})
+	s.NoError(err)
+
+	res, err := s.engine.GetWorkerBuildIdCompatibility(ctx, &workflowservice.GetWorkerBuildIdCompatibilityRequest{
+		Namespace: s.namespace,
+		TaskQueue: tq,
+	})
+	s.NoError(err)
+	s.Equal([]*taskqueuepb.CompatibleVersionSet{
+		{BuildIds: []string{s.prefixed("foo")}},
+		{BuildIds: []string{s.prefixed("bar")}},
+		{BuildIds: []string{s.prefixed("baz")}},
+	}, res.MajorVersionSets)
+	s.NotEmpty(capture.Snapshot()[metrics.TaskQueueVersioningDataRepaired.GetMetricName()])
+
+	// The repaired data can be updated as usual
+	s.addNewDefaultBuildId(ctx, tq, "qux")
+}
+
+func (s *versioningIntegSuite) TestUserDataPropagationKeepsOldVersionSets() {
+	tq := s.randomizeStr(s.T().Name())
+
+	dc := s.testCluster.host.dcClient
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueReadPartitions, 4)
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueWritePartitions, 4)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueReadPartitions)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueWritePartitions)
+
+	started := make(chan struct{}, 1)
+	wf := func(ctx workflow.Context) (string, error) {
+		started <- struct{}{}
+		workflow.GetSignalChannel(ctx, "wait").Receive(ctx, nil)
+		return "done from v1!", nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+	s.waitForPropagation(ctx, tq, "v1")
+
+	w1 := worker.New(s.sdkClient, tq, worker.Options{
+		BuildID:                          s.prefixed("v1"),
+		UseBuildIDForVersioning:          true,
+		MaxConcurrentWorkflowTaskPollers: numPollers,
+	})
+	w1.RegisterWorkflowWithOptions(wf, workflow.RegisterOptions{Name: "wf"})
+	s.NoError(w1.Start())
+	defer w1.Stop()
+
+	run, err := s.sdkClient.ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{TaskQueue: tq}, "wf")
+	s.NoError(err)
+	s.waitForChan(ctx, started)
+
+	// push the set of v1 far from the default
+	for _, buildId := range []string{"v2", "v3", "v4", "v5"} {
+		s.addNewDefaultBuildId(ctx, tq, buildId)
+	}
+	s.waitForPropagation(ctx, tq, "v5")
+	// every partition still knows about the old set
+	s.waitForPropagation(ctx, tq, "v1")
+
+	// the next workflow task belongs to the set of v1 and must be dispatched to its worker whatever the partition
+	s.NoError(s.sdkClient.SignalWorkflow(ctx, run.GetID(), run.GetRunID(), "wait", nil))
+
+	var out string
+	s.NoError(run.Get(ctx, &out))
+	s.Equal("done from v1!", out)
+}
+
 func (s *versioningIntegSuite) TestVersioningChangesPropagate() {
 	ctx := NewContext()
 	tq := "integration-versioning-propagate"
@@ -241,6 +367,251 @@ func (s *versioningIntegSuite) TestMaxTaskQueuesPerBuildIdEnforced() {
 	s.Equal("Exceeded max task queues allowed to be mapped to a single build id: 3", failedPreconditionError.Message)
 }
 
+func (s *versioningIntegSuite) TestSwapBuildIdsWithinSet() {
+	ctx := NewContext()
+	tq := "integration-versioning-swap-within-set"
+
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+	s.addCompatibleBuildId(ctx, tq, "v1.1", "v1", false)
+	// make v1 the default again so that v1.1 sits behind it
+	_, err := s.engine.UpdateWorkerBuildIdCompatibility(ctx, &workflowservice.UpdateWorkerBuildIdCompatibilityRequest{
+		Namespace: s.namespace,
+		TaskQueue: tq,
+		Operation: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_PromoteBuildIdWithinSet{
+			PromoteBuildIdWithinSet: s.prefixed("v1"),
+		},
+	})
+	s.NoError(err)
+
+	_, err = s.testCluster.GetMatchingClient().UpdateWorkerBuildIdCompatibility(ctx, &matchingservice.UpdateWorkerBuildIdCompatibilityRequest{
+		NamespaceId: s.getNamespaceID(s.namespace),
+		TaskQueue:   tq,
+		Operation: &matchingservice.UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet_{
+			SwapBuildIdsWithinSet: &matchingservice.UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet{
+				FirstBuildId:  s.prefixed("v1"),
+				SecondBuildId: s.prefixed("v1.1"),
+			},
+		},
+	})
+	s.NoError(err)
+
+	res, err := s.engine.GetWorkerBuildIdCompatibility(ctx, &workflowservice.GetWorkerBuildIdCompatibilityRequest{
+		Namespace: s.namespace,
+		TaskQueue: tq,
+	})
+	s.NoError(err)
+	s.Equal(s.prefixed("v1.1"), getCurrentDefault(res))
+	s.Equal([]string{s.prefixed("v1"), s.prefixed("v1.1")}, res.GetMajorVersionSets()[0].GetBuildIds())
+
+	// both build ids must be in the same set
+	s.addNewDefaultBuildId(ctx, tq, "v2")
+	_, err = s.testCluster.GetMatchingClient().UpdateWorkerBuildIdCompatibility(ctx, &matchingservice.UpdateWorkerBuildIdCompatibilityRequest{
+		NamespaceId: s.getNamespaceID(s.namespace),
+		TaskQueue:   tq,
+		Operation: &matchingservice.UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet_{
+			SwapBuildIdsWithinSet: &matchingservice.UpdateWorkerBuildIdCompatibilityRequest_SwapBuildIdsWithinSet{
+				FirstBuildId:  s.prefixed("v1"),
+				SecondBuildId: s.prefixed("v2"),
+			},
+		},
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *versioningIntegSuite) TestSwapDefaultSets() {
+	ctx := NewContext()
+	tq := "integration-versioning-swap-default-sets"
+
+	for i := 0; i < 10; i++ {
+		s.addNewDefaultBuildId(ctx, tq, fmt.Sprintf("foo-%d", i))
+	}
+	s.addCompatibleBuildId(ctx, tq, "foo-2.1", "foo-2", false)
+
+	swap := func(first, second string) error {
+		_, err := s.testCluster.GetMatchingClient().UpdateWorkerBuildIdCompatibility(ctx, &matchingservice.UpdateWorkerBuildIdCompatibilityRequest{
+			NamespaceId: s.getNamespaceID(s.namespace),
+			TaskQueue:   tq,
+			Operation: &matchingservice.UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets_{
+				SwapDefaultSets: &matchingservice.UpdateWorkerBuildIdCompatibilityRequest_SwapDefaultSets{
+					FirstBuildId:  s.prefixed(first),
+					SecondBuildId: s.prefixed(second),
+				},
+			},
+		})
+		return err
+	}
+	s.NoError(swap("foo-9", "foo-2"))
+
+	res, err := s.engine.GetWorkerBuildIdCompatibility(ctx, &workflowservice.GetWorkerBuildIdCompatibilityRequest{
+		Namespace: s.namespace,
+		TaskQueue: tq,
+	})
+	s.NoError(err)
+	s.Equal(s.prefixed("foo-2.1"), getCurrentDefault(res))
+	s.Equal([]string{s.prefixed("foo-9")}, res.GetMajorVersionSets()[2].GetBuildIds())
+
+	// Swap back
+	s.NoError(swap("foo-2", "foo-9"))
+	res, err = s.engine.GetWorkerBuildIdCompatibility(ctx, &workflowservice.GetWorkerBuildIdCompatibilityRequest{
+		Namespace: s.namespace,
+		TaskQueue: tq,
+	})
+	s.NoError(err)
+	s.Equal(s.prefixed("foo-9"), getCurrentDefault(res))
+	s.Equal([]string{s.prefixed("foo-2"), s.prefixed("foo-2.1")}, res.GetMajorVersionSets()[2].GetBuildIds())
+
+	// One of the sets must be the default
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(swap("foo-1", "foo-2"), &invalidArgument)
+}
+
+func (s *versioningIntegSuite) TestGetTaskDispatchDecision() {
+	ctx := NewContext()
+	tq := "integration-versioning-task-dispatch-decision"
+
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+	s.addNewDefaultBuildId(ctx, tq, "v2")
+
+	// A new workflow goes to the current default
+	res, err := s.testCluster.GetMatchingClient().GetTaskDispatchDecision(ctx, &matchingservice.GetTaskDispatchDecisionRequest{
+		NamespaceId:   s.getNamespaceID(s.namespace),
+		TaskQueue:     tq,
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	})
+	s.NoError(err)
+	s.Equal(s.prefixed("v2"), res.GetBuildId())
+
+	// A compatible activity of a workflow on v1 stays on v1. Activity partitions load user data asynchronously.
+	s.Eventually(func() bool {
+		res, err := s.testCluster.GetMatchingClient().GetTaskDispatchDecision(ctx, &matchingservice.GetTaskDispatchDecisionRequest{
+			NamespaceId:          s.getNamespaceID(s.namespace),
+			TaskQueue:            tq,
+			TaskQueueType:        enumspb.TASK_QUEUE_TYPE_ACTIVITY,
+			WorkflowBuildId:      s.prefixed("v1"),
+			UseCompatibleVersion: true,
+		})
+		s.NoError(err)
+		return res.GetBuildId() == s.prefixed("v1")
+	}, 10*time.Second, 100*time.Millisecond)
+}
+
+func (s *versioningIntegSuite) TestHypotheticalCompatibleBuildId() {
+	ctx := NewContext()
+	tq := "integration-versioning-hypothetical-compatible"
+
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+	s.addNewDefaultBuildId(ctx, tq, "v2")
+
+	update := &workflowservice.UpdateWorkerBuildIdCompatibilityRequest{
+		Namespace: s.namespace,
+		TaskQueue: tq,
+		Operation: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_AddNewCompatibleBuildId{
+			AddNewCompatibleBuildId: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_AddNewCompatibleVersion{
+				NewBuildId:                s.prefixed("v1.1"),
+				ExistingCompatibleBuildId: s.prefixed("v1"),
+				MakeSetDefault:            true,
+			},
+		},
+	}
+	hypothetical, err := s.testCluster.GetMatchingClient().GetWorkerBuildIdCompatibility(ctx, &matchingservice.GetWorkerBuildIdCompatibilityRequest{
+		NamespaceId: s.getNamespaceID(s.namespace),
+		Request: &workflowservice.GetWorkerBuildIdCompatibilityRequest{
+			Namespace: s.namespace,
+			TaskQueue: tq,
+		},
+		HypotheticalUpdate: update,
+	})
+	s.NoError(err)
+	s.Equal(s.prefixed("v1.1"), getCurrentDefault(hypothetical.GetResponse()))
+
+	// nothing was persisted
+	res, err := s.engine.GetWorkerBuildIdCompatibility(ctx, &workflowservice.GetWorkerBuildIdCompatibilityRequest{
+		Namespace: s.namespace,
+		TaskQueue: tq,
+	})
+	s.NoError(err)
+	s.Equal(s.prefixed("v2"), getCurrentDefault(res))
+
+	_, err = s.engine.UpdateWorkerBuildIdCompatibility(ctx, update)
+	s.NoError(err)
+	res, err = s.engine.GetWorkerBuildIdCompatibility(ctx, &workflowservice.GetWorkerBuildIdCompatibilityRequest{
+		Namespace: s.namespace,
+		TaskQueue: tq,
+	})
+	s.NoError(err)
+	s.Equal(res, hypothetical.GetResponse())
+}
+
+func (s *versioningIntegSuite) TestMaxCompatibleBuildIdsPerSetEnforced() {
+	ctx := NewContext()
+	tq := s.randomizeStr(s.T().Name())
+	const limit = 3
+
+	dc := s.testCluster.host.dcClient
+	dc.OverrideValue(dynamicconfig.VersionCompatibleBuildIdLimitPerSet, limit)
+	defer dc.RemoveOverride(dynamicconfig.VersionCompatibleBuildIdLimitPerSet)
+
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+	prev := "v1"
+	for i := 1; i < limit; i++ {
+		buildId := prev + ".1"
+		s.addCompatibleBuildId(ctx, tq, buildId, prev, false)
+		prev = buildId
+	}
+
+	_, err := s.engine.UpdateWorkerBuildIdCompatibility(ctx, &workflowservice.UpdateWorkerBuildIdCompatibilityRequest{
+		Namespace: s.namespace,
+		TaskQueue: tq,
+		Operation: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_AddNewCompatibleBuildId{
+			AddNewCompatibleBuildId: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_AddNewCompatibleVersion{
+				NewBuildId:                s.prefixed(prev + ".1"),
+				ExistingCompatibleBuildId: s.prefixed(prev),
+			},
+		},
+	})
+	var failedPreconditionError *serviceerror.FailedPrecondition
+	s.ErrorAs(err, &failedPreconditionError)
+	s.Contains(failedPreconditionError.Message, fmt.Sprintf("(%d/%d)", limit+1, limit))
+}
+
+func (s *versioningIntegSuite) TestVersioningPartitionDivergence() {
+	ctx := NewContext()
+	tq := s.randomizeStr(s.T().Name())
+
+	dc := s.testCluster.host.dcClient
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueReadPartitions, 4)
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueWritePartitions, 4)
+	dc.OverrideValue(dynamicconfig.MatchingUserDataConsistencyCheckInterval, 200*time.Millisecond)
+	dc.OverrideValue(dynamicconfig.MatchingUserDataDivergenceGracePeriod, time.Second)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueReadPartitions)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueWritePartitions)
+	defer dc.RemoveOverride(dynamicconfig.MatchingUserDataConsistencyCheckInterval)
+	defer dc.RemoveOverride(dynamicconfig.MatchingUserDataDivergenceGracePeriod)
+
+	captureHandler := s.testCluster.host.GetCaptureMetricsHandler()
+	capture := captureHandler.StartCapture()
+	defer captureHandler.StopCapture(capture)
+
+	// load all partitions
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+	s.waitForPropagation(ctx, tq, "v1")
+
+	// leave all but the root partition stale
+	dc.OverrideValue(dynamicconfig.TestMatchingDisableUserDataPropagation, true)
+	defer dc.RemoveOverride(dynamicconfig.TestMatchingDisableUserDataPropagation)
+	s.addNewDefaultBuildId(ctx, tq, "v2")
+
+	s.Eventually(func() bool {
+		return len(capture.Snapshot()[metrics.VersioningPartitionDivergence.GetMetricName()]) > 0
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// with propagation still disabled, only a repair can bring the partitions up to date
+	dc.OverrideValue(dynamicconfig.MatchingRepairDivergentUserData, true)
+	defer dc.RemoveOverride(dynamicconfig.MatchingRepairDivergentUserData)
+	s.waitForPropagation(ctx, tq, "v2")
+}
+
 func (s *versioningIntegSuite) testWithMatchingBehavior(subtest func()) {
 	dc := s.testCluster.host.dcClient
 	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueReadPartitions)
@@ -396,6 +767,25 @@ func (s *versioningIntegSuite) TestDispatchUpgradeWait() {
 	s.testWithMatchingBehavior(func() { s.dispatchUpgrade(false) })
 }
 
+func (s *versioningIntegSuite) TestDispatchUpgradeCompatibleBuildIdMetric() {
+	s.testWithMatchingBehavior(func() {
+		captureHandler := s.testCluster.host.GetCaptureMetricsHandler()
+		capture := captureHandler.StartCapture()
+		defer captureHandler.StopCapture(capture)
+
+		s.dispatchUpgrade(true)
+
+		// the workflow task after the upgrade was directed at v1 but delivered to v11
+		var count int64
+		for _, recording := range capture.Snapshot()[metrics.CompatibleBuildIdDispatchCounter.GetMetricName()] {
+			if recording.Tags["build_id"] == s.prefixed("v11") {
+				count += recording.Value.(int64)
+			}
+		}
+		s.GreaterOrEqual(count, int64(1))
+	})
+}
+
 func (s *versioningIntegSuite) dispatchUpgrade(stopOld bool) {
 	tq := s.randomizeStr(s.T().Name())
 
@@ -468,41 +858,1833 @@ func (s *versioningIntegSuite) dispatchUpgrade(stopOld bool) {
 	s.Equal("done from 1.1!", out)
 }
 
-func (s *versioningIntegSuite) TestDispatchActivity() {
-	s.testWithMatchingBehavior(s.dispatchActivity)
+func (s *versioningIntegSuite) TestDispatchUpgradeStickyBounceReason() {
+	s.testWithMatchingBehavior(func() {
+		captureHandler := s.testCluster.host.GetCaptureMetricsHandler()
+		capture := captureHandler.StartCapture()
+		defer captureHandler.StopCapture(capture)
+
+		s.dispatchUpgrade(false)
+
+		// the workflow task after the upgrade was bounced off the v1 sticky queue because v11 became the set default,
+		// even though the v1 worker was still polling it
+		var count int64
+		for _, recording := range capture.Snapshot()[metrics.StickyTaskBouncedCounter.GetMetricName()] {
+			if recording.Tags["reason"] == "pinned_build_id_not_default" {
+				count += recording.Value.(int64)
+			}
+		}
+		s.GreaterOrEqual(count, int64(1))
+	})
+}
+
+func (s *versioningIntegSuite) TestDispatchUpgradeEvictsStickyPollers() {
+	s.testWithMatchingBehavior(func() {
+		captureHandler := s.testCluster.host.GetCaptureMetricsHandler()
+		capture := captureHandler.StartCapture()
+		defer captureHandler.StopCapture(capture)
+
+		s.dispatchUpgrade(false)
+
+		// the v1 worker's polls on its sticky queue were cancelled as soon as v11 became the set default
+		var count int64
+		for _, recording := range capture.Snapshot()[metrics.StickyPollerEvictedCounter.GetMetricName()] {
+			count += recording.Value.(int64)
+		}
+		s.GreaterOrEqual(count, int64(1))
+	})
+}
+
+func (s *versioningIntegSuite) TestDispatchDrainingBuildId() {
+	s.testWithMatchingBehavior(s.dispatchDrainingBuildId)
 }
 
-func (s *versioningIntegSuite) dispatchActivity() {
-	// This also implicitly tests that a workflow stays on a compatible version set if a new
-	// incompatible set is registered, because wf2 just panics. It further tests that
-	// stickiness on v1 is not broken by registering v2, because the channel send will panic on
-	// replay after we close the channel.
+func (s *versioningIntegSuite) dispatchDrainingBuildId() {
+	tq := s.randomizeStr(s.T().Name())
+
+	started := make(chan struct{}, 1)
+
+	wf0 := func(ctx workflow.Context) (string, error) {
+		return "done from 0!", nil
+	}
+
+	wf1 := func(ctx workflow.Context) (string, error) {
+		started <- struct{}{}
+		workflow.GetSignalChannel(ctx, "wait").Receive(ctx, nil)
+		return "done from 1!", nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	s.addNewDefaultBuildId(ctx, tq, "v0")
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+	s.waitForPropagation(ctx, tq, "v1")
+
+	w0 := worker.New(s.sdkClient, tq, worker.Options{
+		BuildID:                          s.prefixed("v0"),
+		UseBuildIDForVersioning:          true,
+		MaxConcurrentWorkflowTaskPollers: numPollers,
+	})
+	w0.RegisterWorkflowWithOptions(wf0, workflow.RegisterOptions{Name: "wf"})
+	s.NoError(w0.Start())
+	defer w0.Stop()
+
+	w1 := worker.New(s.sdkClient, tq, worker.Options{
+		BuildID:                          s.prefixed("v1"),
+		UseBuildIDForVersioning:          true,
+		MaxConcurrentWorkflowTaskPollers: numPollers,
+	})
+	w1.RegisterWorkflowWithOptions(wf1, workflow.RegisterOptions{Name: "wf"})
+	s.NoError(w1.Start())
+	defer w1.Stop()
+
+	run1, err := s.sdkClient.ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{TaskQueue: tq}, "wf")
+	s.NoError(err)
+	s.waitForChan(ctx, started)
+
+	// mark v1 draining: the running workflow should stay there but new ones should not start on it
+	_, err = s.testCluster.GetMatchingClient().UpdateWorkerBuildIdCompatibility(ctx, &matchingservice.UpdateWorkerBuildIdCompatibilityRequest{
+		NamespaceId: s.getNamespaceID(s.namespace),
+		TaskQueue:   tq,
+		Operation: &matchingservice.UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining_{
+			MarkBuildIdDraining: &matchingservice.UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining{
+				BuildId: s.prefixed("v1"),
+			},
+		},
+	})
+	s.NoError(err)
+	s.waitForDrainingPropagation(ctx, tq, "v1")
+
+	run2, err := s.sdkClient.ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{TaskQueue: tq}, "wf")
+	s.NoError(err)
+	var out string
+	s.NoError(run2.Get(ctx, &out))
+	s.Equal("done from 0!", out)
+
+	// unblock the first workflow, which should still complete on v1
+	s.NoError(s.sdkClient.SignalWorkflow(ctx, run1.GetID(), run1.GetRunID(), "wait", nil))
+	s.NoError(run1.Get(ctx, &out))
+	s.Equal("done from 1!", out)
+}
+
+func (s *versioningIntegSuite) TestDispatchRetiredBuildIdTaskTTL() {
+	dc := s.testCluster.host.dcClient
+	dc.OverrideValue(dynamicconfig.MatchingRetiredBuildIdTaskTTL, 2*time.Second)
+	dc.OverrideValue(dynamicconfig.MatchingRerouteExpiredRetiredBuildIdTasks, true)
+	defer dc.RemoveOverride(dynamicconfig.MatchingRetiredBuildIdTaskTTL)
+	defer dc.RemoveOverride(dynamicconfig.MatchingRerouteExpiredRetiredBuildIdTasks)
+	s.testWithMatchingBehavior(s.dispatchRetiredBuildIdTaskTTL)
+}
+
+func (s *versioningIntegSuite) dispatchRetiredBuildIdTaskTTL() {
+	tq := s.randomizeStr(s.T().Name())
+
+	started := make(chan struct{}, 1)
+
+	wf1 := func(ctx workflow.Context) (string, error) {
+		started <- struct{}{}
+		workflow.GetSignalChannel(ctx, "wait").Receive(ctx, nil)
+		return "done from 1!", nil
+	}
+	wf2 := func(ctx workflow.Context) (string, error) {
+		workflow.GetSignalChannel(ctx, "wait").Receive(ctx, nil)
+		return "done from 2!", nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+	s.waitForPropagation(ctx, tq, "v1")
+
+	w1 := worker.New(s.sdkClient, tq, worker.Options{
+		BuildID:                          s.prefixed("v1"),
+		UseBuildIDForVersioning:          true,
+		MaxConcurrentWorkflowTaskPollers: numPollers,
+		// Don't use a sticky queue so the next workflow task goes straight to the normal queue
+		StickyScheduleToStartTimeout: time.Millisecond,
+	})
+	w1.RegisterWorkflowWithOptions(wf1, workflow.RegisterOptions{Name: "wf"})
+	s.NoError(w1.Start())
+
+	run, err := s.sdkClient.ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{TaskQueue: tq}, "wf")
+	s.NoError(err)
+	s.waitForChan(ctx, started)
+	w1.Stop()
+
+	s.addNewDefaultBuildId(ctx, tq, "v2")
+	s.waitForPropagation(ctx, tq, "v2")
+
+	w2 := worker.New(s.sdkClient, tq, worker.Options{
+		BuildID:                          s.prefixed("v2"),
+		UseBuildIDForVersioning:          true,
+		MaxConcurrentWorkflowTaskPollers: numPollers,
+	})
+	w2.RegisterWorkflowWithOptions(wf2, workflow.RegisterOptions{Name: "wf"})
+	s.NoError(w2.Start())
+	defer w2.Stop()
+
+	_, err = s.testCluster.GetMatchingClient().UpdateWorkerBuildIdCompatibility(ctx, &matchingservice.UpdateWorkerBuildIdCompatibilityRequest{
+		NamespaceId: s.getNamespaceID(s.namespace),
+		TaskQueue:   tq,
+		Operation: &matchingservice.UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining_{
+			MarkBuildIdDraining: &matchingservice.UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining{
+				BuildId: s.prefixed("v1"),
+			},
+		},
+	})
+	s.NoError(err)
+	s.waitForDrainingPropagation(ctx, tq, "v1")
+
+	// The next workflow task is pinned to v1 which has no pollers, once the TTL passes it is re-routed to the default
+	s.NoError(s.sdkClient.SignalWorkflow(ctx, run.GetID(), run.GetRunID(), "wait", nil))
+	var out string
+	s.NoError(run.Get(ctx, &out))
+	s.Equal("done from 2!", out)
+}
+
+func (s *versioningIntegSuite) TestDispatchDrainingBuildIdStickyTimeout() {
+	dc := s.testCluster.host.dcClient
+	dc.OverrideValue(dynamicconfig.MatchingRetiredBuildIdTaskTTL, time.Millisecond)
+	dc.OverrideValue(dynamicconfig.MatchingRerouteExpiredRetiredBuildIdTasks, true)
+	defer dc.RemoveOverride(dynamicconfig.MatchingRetiredBuildIdTaskTTL)
+	defer dc.RemoveOverride(dynamicconfig.MatchingRerouteExpiredRetiredBuildIdTasks)
+	defer dc.RemoveOverride(dynamicconfig.MatchingStickyScheduleToStartTimeoutPerBuildId)
+	s.testWithMatchingBehavior(s.dispatchDrainingBuildIdStickyTimeout)
+}
+
+func (s *versioningIntegSuite) dispatchDrainingBuildIdStickyTimeout() {
+	tq := s.randomizeStr(s.T().Name())
+	// the sticky timeout the workflow would otherwise wait for before its task is moved to the normal queue
+	const defaultStickyTimeout = 5 * time.Second
+	// build ids are prefixed differently for each matching behavior
+	s.testCluster.host.dcClient.OverrideValue(dynamicconfig.MatchingStickyScheduleToStartTimeoutPerBuildId, map[string]any{
+		s.prefixed("v1"): "500ms",
+	})
+
+	started := make(chan struct{}, 1)
+
+	wf1 := func(ctx workflow.Context) (string, error) {
+		started <- struct{}{}
+		workflow.GetSignalChannel(ctx, "wait").Receive(ctx, nil)
+		return "done from 1!", nil
+	}
+	wf11 := func(ctx workflow.Context) (string, error) {
+		workflow.GetSignalChannel(ctx, "wait").Receive(ctx, nil)
+		return "done from 1.1!", nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+	s.waitForPropagation(ctx, tq, "v1")
+
+	w1 := worker.New(s.sdkClient, tq, worker.Options{
+		BuildID:                          s.prefixed("v1"),
+		UseBuildIDForVersioning:          true,
+		MaxConcurrentWorkflowTaskPollers: numPollers,
+		StickyScheduleToStartTimeout:     defaultStickyTimeout,
+	})
+	w1.RegisterWorkflowWithOptions(wf1, workflow.RegisterOptions{Name: "wf"})
+	s.NoError(w1.Start())
+
+	run, err := s.sdkClient.ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{TaskQueue: tq}, "wf")
+	s.NoError(err)
+	s.waitForChan(ctx, started)
+	w1.Stop()
+
+	s.addNewDefaultBuildId(ctx, tq, "v11")
+	s.waitForPropagation(ctx, tq, "v11")
+
+	w11 := worker.New(s.sdkClient, tq, worker.Options{
+		BuildID:                          s.prefixed("v11"),
+		UseBuildIDForVersioning:          true,
+		MaxConcurrentWorkflowTaskPollers: numPollers,
+	})
+	w11.RegisterWorkflowWithOptions(wf11, workflow.RegisterOptions{Name: "wf"})
+	s.NoError(w11.Start())
+	defer w11.Stop()
+
+	_, err = s.testCluster.GetMatchingClient().UpdateWorkerBuildIdCompatibility(ctx, &matchingservice.UpdateWorkerBuildIdCompatibilityRequest{
+		NamespaceId: s.getNamespaceID(s.namespace),
+		TaskQueue:   tq,
+		Operation: &matchingservice.UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining_{
+			MarkBuildIdDraining: &matchingservice.UpdateWorkerBuildIdCompatibilityRequest_MarkBuildIdDraining{
+				BuildId: s.prefixed("v1"),
+			},
+		},
+	})
+	s.NoError(err)
+	s.waitForDrainingPropagation(ctx, tq, "v1")
+
+	// The next workflow task goes to the sticky queue of the stopped v1 worker, it is bounced back to the normal queue
+	// once the v1 sticky timeout passes, and from there re-routed to v11 since v1 is draining
+	start := time.Now()
+	s.NoError(s.sdkClient.SignalWorkflow(ctx, run.GetID(), run.GetRunID(), "wait", nil))
+	var out string
+	s.NoError(run.Get(ctx, &out))
+	s.Equal("done from 1.1!", out)
+	s.Less(time.Since(start), defaultStickyTimeout)
+}
+
+func (s *versioningIntegSuite) TestDescribeVersioning() {
+	s.testWithMatchingBehavior(s.describeVersioning)
+}
+
+func (s *versioningIntegSuite) describeVersioning() {
+	tq := s.randomizeStr(s.T().Name())
+
+	started := make(chan struct{}, 1)
+
+	wf1 := func(ctx workflow.Context) (string, error) {
+		started <- struct{}{}
+		workflow.GetSignalChannel(ctx, "wait").Receive(ctx, nil)
+		return "done from 1!", nil
+	}
+	wf2 := func(ctx workflow.Context) (string, error) {
+		return "done from 2!", nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+	s.waitForPropagation(ctx, tq, "v1")
+
+	w1 := worker.New(s.sdkClient, tq, worker.Options{
+		BuildID:                          s.prefixed("v1"),
+		UseBuildIDForVersioning:          true,
+		MaxConcurrentWorkflowTaskPollers: numPollers,
+	})
+	w1.RegisterWorkflowWithOptions(wf1, workflow.RegisterOptions{Name: "wf"})
+	s.NoError(w1.Start())
+	defer w1.Stop()
+
+	run1, err := s.sdkClient.ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{TaskQueue: tq}, "wf")
+	s.NoError(err)
+	s.waitForChan(ctx, started)
+
+	s.addNewDefaultBuildId(ctx, tq, "v2")
+	s.waitForPropagation(ctx, tq, "v2")
+
+	w2 := worker.New(s.sdkClient, tq, worker.Options{
+		BuildID:                          s.prefixed("v2"),
+		UseBuildIDForVersioning:          true,
+		MaxConcurrentWorkflowTaskPollers: numPollers,
+	})
+	w2.RegisterWorkflowWithOptions(wf2, workflow.RegisterOptions{Name: "wf"})
+	s.NoError(w2.Start())
+	defer w2.Stop()
+
+	// visibility and poller history are updated asynchronously, wait for both to settle
+	var v1, v2 *matchingservice.DescribeVersioningResponse_BuildIdInfo
+	var v1Set, v2Set *matchingservice.DescribeVersioningResponse_VersionSet
+	s.Eventually(func() bool {
+		res, err := s.testCluster.GetMatchingClient().DescribeVersioning(ctx, &matchingservice.DescribeVersioningRequest{
+			NamespaceId: s.getNamespaceID(s.namespace),
+			TaskQueue:   tq,
+		})
+		if err != nil || len(res.GetVersionSets()) != 2 {
+			return false
+		}
+		v1Set, v2Set = res.GetVersionSets()[0], res.GetVersionSets()[1]
+		if len(v1Set.GetBuildIds()) != 1 || len(v2Set.GetBuildIds()) != 1 {
+			return false
+		}
+		v1, v2 = v1Set.GetBuildIds()[0], v2Set.GetBuildIds()[0]
+		return v1.GetPollerCount() > 0 && v2.GetPollerCount() > 0 &&
+			slices.Contains(v1.GetReachability(), enumspb.TASK_REACHABILITY_OPEN_WORKFLOWS)
+	}, 10*time.Second, 200*time.Millisecond)
+
+	s.Equal(s.prefixed("v1"), v1.GetBuildId())
+	s.False(v1Set.GetIsDefault())
+	s.True(v1.GetIsSetDefault())
+	s.Equal(persistencespb.STATE_ACTIVE, v1.GetState())
+	s.Equal([]enumspb.TaskReachability{enumspb.TASK_REACHABILITY_OPEN_WORKFLOWS}, v1.GetReachability())
+
+	s.Equal(s.prefixed("v2"), v2.GetBuildId())
+	s.True(v2Set.GetIsDefault())
+	s.True(v2.GetIsSetDefault())
+	s.Equal(persistencespb.STATE_ACTIVE, v2.GetState())
+	s.Equal([]enumspb.TaskReachability{enumspb.TASK_REACHABILITY_NEW_WORKFLOWS}, v2.GetReachability())
+
+	s.NoError(s.sdkClient.SignalWorkflow(ctx, run1.GetID(), run1.GetRunID(), "wait", nil))
+	var out string
+	s.NoError(run1.Get(ctx, &out))
+	s.Equal("done from 1!", out)
+}
+
+func (s *versioningIntegSuite) TestEnableWorkerVersioning() {
+	s.testWithMatchingBehavior(s.enableWorkerVersioning)
+}
+
+func (s *versioningIntegSuite) enableWorkerVersioning() {
+	tq := s.randomizeStr(s.T().Name())
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	enable := func() (*matchingservice.EnableWorkerVersioningResponse, error) {
+		return s.testCluster.GetMatchingClient().EnableWorkerVersioning(ctx, &matchingservice.EnableWorkerVersioningRequest{
+			NamespaceId: s.getNamespaceID(s.namespace),
+			TaskQueue:   tq,
+			BuildId:     s.prefixed("v1"),
+		})
+	}
+
+	// No worker polls with the build id yet
+	_, err := enable()
+	var failedPrecondition *serviceerror.FailedPrecondition
+	s.ErrorAs(err, &failedPrecondition)
+	res, err := s.engine.GetWorkerBuildIdCompatibility(ctx, &workflowservice.GetWorkerBuildIdCompatibilityRequest{
+		Namespace: s.namespace,
+		TaskQueue: tq,
+	})
+	s.NoError(err)
+	s.Empty(res.GetMajorVersionSets())
+
+	wf := func(ctx workflow.Context) (string, error) {
+		return "done!", nil
+	}
+	w1 := worker.New(s.sdkClient, tq, worker.Options{
+		BuildID:                          s.prefixed("v1"),
+		UseBuildIDForVersioning:          true,
+		MaxConcurrentWorkflowTaskPollers: numPollers,
+	})
+	w1.RegisterWorkflowWithOptions(wf, workflow.RegisterOptions{Name: "wf"})
+	s.NoError(w1.Start())
+	defer w1.Stop()
+
+	// poller history is updated asynchronously
+	s.Eventually(func() bool {
+		enableRes, err := enable()
+		if err != nil {
+			s.ErrorAs(err, &failedPrecondition)
+			return false
+		}
+		s.Positive(enableRes.GetPollerCount())
+		return true
+	}, 10*time.Second, 200*time.Millisecond)
+
+	res, err = s.engine.GetWorkerBuildIdCompatibility(ctx, &workflowservice.GetWorkerBuildIdCompatibilityRequest{
+		Namespace: s.namespace,
+		TaskQueue: tq,
+	})
+	s.NoError(err)
+	s.Equal(s.prefixed("v1"), getCurrentDefault(res))
+
+	// The task queue is now versioned and served by the worker
+	s.waitForPropagation(ctx, tq, "v1")
+	run, err := s.sdkClient.ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{TaskQueue: tq}, "wf")
+	s.NoError(err)
+	var out string
+	s.NoError(run.Get(ctx, &out))
+	s.Equal("done!", out)
+
+	// Versioning can only be enabled once
+	_, err = enable()
+	s.ErrorAs(err, &failedPrecondition)
+}
+
+func (s *versioningIntegSuite) TestDescribeVersioningOpenWorkflowCounts() {
+	tq := s.randomizeStr(s.T().Name())
+
+	dc := s.testCluster.host.dcClient
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueReadPartitions, 4)
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueWritePartitions, 4)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueReadPartitions)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueWritePartitions)
+
+	started := make(chan struct{}, 3)
+
+	wf := func(ctx workflow.Context) (string, error) {
+		started <- struct{}{}
+		workflow.GetSignalChannel(ctx, "wait").Receive(ctx, nil)
+		return "done!", nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+	s.waitForPropagation(ctx, tq, "v1")
+
+	w1 := worker.New(s.sdkClient, tq, worker.Options{
+		BuildID:                          s.prefixed("v1"),
+		UseBuildIDForVersioning:          true,
+		MaxConcurrentWorkflowTaskPollers: numPollers,
+	})
+	w1.RegisterWorkflowWithOptions(wf, workflow.RegisterOptions{Name: "wf"})
+	s.NoError(w1.Start())
+	defer w1.Stop()
+
+	var runs []sdkclient.WorkflowRun
+	for i := 0; i < 2; i++ {
+		run, err := s.sdkClient.ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{TaskQueue: tq}, "wf")
+		s.NoError(err)
+		s.waitForChan(ctx, started)
+		runs = append(runs, run)
+	}
+
+	s.addNewDefaultBuildId(ctx, tq, "v2")
+	s.waitForPropagation(ctx, tq, "v2")
+
+	w2 := worker.New(s.sdkClient, tq, worker.Options{
+		BuildID:                          s.prefixed("v2"),
+		UseBuildIDForVersioning:          true,
+		MaxConcurrentWorkflowTaskPollers: numPollers,
+	})
+	w2.RegisterWorkflowWithOptions(wf, workflow.RegisterOptions{Name: "wf"})
+	s.NoError(w2.Start())
+	defer w2.Stop()
+
+	run, err := s.sdkClient.ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{TaskQueue: tq}, "wf")
+	s.NoError(err)
+	s.waitForChan(ctx, started)
+	runs = append(runs, run)
+
+	openWorkflowCounts := func() map[string]int64 {
+		res, err := s.testCluster.GetMatchingClient().DescribeVersioning(ctx, &matchingservice.DescribeVersioningRequest{
+			NamespaceId: s.getNamespaceID(s.namespace),
+			TaskQueue:   tq,
+		})
+		s.NoError(err)
+		counts := make(map[string]int64)
+		for _, set := range res.GetVersionSets() {
+			for _, buildId := range set.GetBuildIds() {
+				counts[buildId.GetBuildId()] = buildId.GetOpenWorkflowCount()
+			}
+		}
+		return counts
+	}
+
+	// visibility is updated asynchronously
+	expected := map[string]int64{s.prefixed("v1"): 2, s.prefixed("v2"): 1}
+	s.Eventually(func() bool {
+		return maps.Equal(expected, openWorkflowCounts())
+	}, 10*time.Second, 200*time.Millisecond)
+
+	for _, run := range runs {
+		s.NoError(s.sdkClient.SignalWorkflow(ctx, run.GetID(), run.GetRunID(), "wait", nil))
+		var out string
+		s.NoError(run.Get(ctx, &out))
+		s.Equal("done!", out)
+	}
+
+	expected = map[string]int64{s.prefixed("v1"): 0, s.prefixed("v2"): 0}
+	s.Eventually(func() bool {
+		return maps.Equal(expected, openWorkflowCounts())
+	}, 10*time.Second, 200*time.Millisecond)
+}
+
+func (s *versioningIntegSuite) TestRemoveBuildId() {
+	tq := s.randomizeStr(s.T().Name())
+
+	dc := s.testCluster.host.dcClient
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueReadPartitions, 4)
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueWritePartitions, 4)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueReadPartitions)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueWritePartitions)
+
+	started := make(chan struct{}, 1)
+
+	wf := func(ctx workflow.Context) (string, error) {
+		started <- struct{}{}
+		workflow.GetSignalChannel(ctx, "wait").Receive(ctx, nil)
+		return "done!", nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+	s.waitForPropagation(ctx, tq, "v1")
+
+	w1 := worker.New(s.sdkClient, tq, worker.Options{
+		BuildID:                          s.prefixed("v1"),
+		UseBuildIDForVersioning:          true,
+		MaxConcurrentWorkflowTaskPollers: numPollers,
+	})
+	w1.RegisterWorkflowWithOptions(wf, workflow.RegisterOptions{Name: "wf"})
+	s.NoError(w1.Start())
+	defer w1.Stop()
+
+	run, err := s.sdkClient.ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{TaskQueue: tq}, "wf")
+	s.NoError(err)
+	s.waitForChan(ctx, started)
+
+	s.addNewDefaultBuildId(ctx, tq, "v2")
+
+	remove := func(buildId string) error {
+		_, err := s.testCluster.GetMatchingClient().UpdateWorkerBuildIdCompatibility(ctx, &matchingservice.UpdateWorkerBuildIdCompatibilityRequest{
+			NamespaceId: s.getNamespaceID(s.namespace),
+			TaskQueue:   tq,
+			Operation: &matchingservice.UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId_{
+				RemoveBuildId: &matchingservice.UpdateWorkerBuildIdCompatibilityRequest_RemoveBuildId{
+					BuildId: s.prefixed(buildId),
+				},
+			},
+		})
+		return err
+	}
+
+	// The queue default is never removed
+	var failedPrecondition *serviceerror.FailedPrecondition
+	s.ErrorAs(remove("v2"), &failedPrecondition)
+
+	// visibility is updated asynchronously, wait for the open workflow on v1 to be counted
+	s.Eventually(func() bool {
+		return errors.As(remove("v1"), &failedPrecondition)
+	}, 10*time.Second, 200*time.Millisecond)
+
+	s.NoError(s.sdkClient.SignalWorkflow(ctx, run.GetID(), run.GetRunID(), "wait", nil))
+	var out string
+	s.NoError(run.Get(ctx, &out))
+	s.Equal("done!", out)
+
+	s.Eventually(func() bool {
+		return remove("v1") == nil
+	}, 10*time.Second, 200*time.Millisecond)
+	s.waitForDeletedPropagation(ctx, tq, "v1")
+
+	compat, err := s.engine.GetWorkerBuildIdCompatibility(ctx, &workflowservice.GetWorkerBuildIdCompatibilityRequest{
+		Namespace: s.namespace,
+		TaskQueue: tq,
+	})
+	s.NoError(err)
+	s.Equal([]*taskqueuepb.CompatibleVersionSet{{BuildIds: []string{s.prefixed("v2")}}}, compat.GetMajorVersionSets())
+}
+
+func (s *versioningIntegSuite) TestGetBuildIdReachability() {
+	tq := s.randomizeStr(s.T().Name())
+
+	dc := s.testCluster.host.dcClient
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueReadPartitions, 4)
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueWritePartitions, 4)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueReadPartitions)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueWritePartitions)
+
+	started := make(chan struct{}, 1)
+
+	wf := func(ctx workflow.Context) (string, error) {
+		started <- struct{}{}
+		workflow.GetSignalChannel(ctx, "wait").Receive(ctx, nil)
+		return "done!", nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+	s.waitForPropagation(ctx, tq, "v1")
+
+	w1 := worker.New(s.sdkClient, tq, worker.Options{
+		BuildID:                          s.prefixed("v1"),
+		UseBuildIDForVersioning:          true,
+		MaxConcurrentWorkflowTaskPollers: numPollers,
+	})
+	w1.RegisterWorkflowWithOptions(wf, workflow.RegisterOptions{Name: "wf"})
+	s.NoError(w1.Start())
+	defer w1.Stop()
+
+	run, err := s.sdkClient.ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{TaskQueue: tq}, "wf")
+	s.NoError(err)
+	s.waitForChan(ctx, started)
+
+	s.addNewDefaultBuildId(ctx, tq, "v2")
+
+	reachability := func(buildId string) ([]enumspb.TaskReachability, error) {
+		res, err := s.testCluster.GetMatchingClient().GetBuildIdReachability(ctx, &matchingservice.GetBuildIdReachabilityRequest{
+			NamespaceId: s.getNamespaceID(s.namespace),
+			TaskQueue:   tq,
+			BuildId:     s.prefixed(buildId),
+		})
+		return res.GetTaskQueueReachability().GetReachability(), err
+	}
+	eventuallyReachable := func(buildId string, expected ...enumspb.TaskReachability) {
+		// visibility is updated asynchronously
+		s.Eventually(func() bool {
+			actual, err := reachability(buildId)
+			s.NoError(err)
+			return slices.Equal(expected, actual)
+		}, 10*time.Second, 200*time.Millisecond)
+	}
+
+	eventuallyReachable("v2", enumspb.TASK_REACHABILITY_NEW_WORKFLOWS)
+	eventuallyReachable("v1", enumspb.TASK_REACHABILITY_OPEN_WORKFLOWS)
+
+	s.NoError(s.sdkClient.SignalWorkflow(ctx, run.GetID(), run.GetRunID(), "wait", nil))
+	var out string
+	s.NoError(run.Get(ctx, &out))
+	s.Equal("done!", out)
+
+	// Only queries on the closed workflow may still reach v1
+	eventuallyReachable("v1", enumspb.TASK_REACHABILITY_CLOSED_WORKFLOWS)
+
+	// A superseded build id within a set is never dispatched to
+	s.addCompatibleBuildId(ctx, tq, "v1.1", "v1", false)
+	eventuallyReachable("v1")
+
+	_, err = reachability("nope")
+	var notFound *serviceerror.NotFound
+	s.ErrorAs(err, &notFound)
+}
+
+func (s *versioningIntegSuite) TestCleanupUnreachableBuildIds() {
+	tq := s.randomizeStr(s.T().Name())
+
+	dc := s.testCluster.host.dcClient
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueReadPartitions, 4)
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueWritePartitions, 4)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueReadPartitions)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueWritePartitions)
+
+	started := make(chan struct{}, 1)
+
+	wf := func(ctx workflow.Context) (string, error) {
+		started <- struct{}{}
+		workflow.GetSignalChannel(ctx, "wait").Receive(ctx, nil)
+		return "done!", nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+	s.waitForPropagation(ctx, tq, "v1")
+
+	w1 := worker.New(s.sdkClient, tq, worker.Options{
+		BuildID:                          s.prefixed("v1"),
+		UseBuildIDForVersioning:          true,
+		MaxConcurrentWorkflowTaskPollers: numPollers,
+	})
+	w1.RegisterWorkflowWithOptions(wf, workflow.RegisterOptions{Name: "wf"})
+	s.NoError(w1.Start())
+	defer w1.Stop()
+
+	run, err := s.sdkClient.ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{TaskQueue: tq}, "wf")
+	s.NoError(err)
+	s.waitForChan(ctx, started)
+
+	// v2 never ran any workflow, v3 was superseded by v3.1 within its set
+	s.addNewDefaultBuildId(ctx, tq, "v2")
+	s.addNewDefaultBuildId(ctx, tq, "v3")
+	s.addCompatibleBuildId(ctx, tq, "v3.1", "v3", true)
+
+	// visibility is updated asynchronously, wait for v1 to be reachable by its open workflow
+	s.Eventually(func() bool {
+		res, err := s.testCluster.GetMatchingClient().DescribeVersioning(ctx, &matchingservice.DescribeVersioningRequest{
+			NamespaceId: s.getNamespaceID(s.namespace),
+			TaskQueue:   tq,
+		})
+		if err != nil || len(res.GetVersionSets()) == 0 || len(res.GetVersionSets()[0].GetBuildIds()) == 0 {
+			return false
+		}
+		return slices.Contains(res.GetVersionSets()[0].GetBuildIds()[0].GetReachability(), enumspb.TASK_REACHABILITY_OPEN_WORKFLOWS)
+	}, 10*time.Second, 200*time.Millisecond)
+
+	res, err := s.testCluster.GetMatchingClient().CleanupUnreachableBuildIds(ctx, &matchingservice.CleanupUnreachableBuildIdsRequest{
+		NamespaceId: s.getNamespaceID(s.namespace),
+		TaskQueue:   tq,
+	})
+	s.NoError(err)
+	s.ElementsMatch([]string{s.prefixed("v2"), s.prefixed("v3")}, res.GetRemovedBuildIds())
+
+	compat, err := s.engine.GetWorkerBuildIdCompatibility(ctx, &workflowservice.GetWorkerBuildIdCompatibilityRequest{
+		Namespace: s.namespace,
+		TaskQueue: tq,
+	})
+	s.NoError(err)
+	s.Equal([]*taskqueuepb.CompatibleVersionSet{
+		{BuildIds: []string{s.prefixed("v1")}},
+		{BuildIds: []string{s.prefixed("v3.1")}},
+	}, compat.GetMajorVersionSets())
+
+	s.waitForVersioningDataPropagation(ctx, tq, func(data *persistencespb.VersioningData) bool {
+		return getBuildIdState(data, s.prefixed("v2")) == persistencespb.STATE_DELETED &&
+			getBuildIdState(data, s.prefixed("v3")) == persistencespb.STATE_DELETED
+	})
+
+	// nothing left to clean up
+	res, err = s.testCluster.GetMatchingClient().CleanupUnreachableBuildIds(ctx, &matchingservice.CleanupUnreachableBuildIdsRequest{
+		NamespaceId: s.getNamespaceID(s.namespace),
+		TaskQueue:   tq,
+	})
+	s.NoError(err)
+	s.Empty(res.GetRemovedBuildIds())
+
+	s.NoError(s.sdkClient.SignalWorkflow(ctx, run.GetID(), run.GetRunID(), "wait", nil))
+	var out string
+	s.NoError(run.Get(ctx, &out))
+	s.Equal("done!", out)
+}
+
+func (s *versioningIntegSuite) TestBuildIdScavenger() {
+	tq := s.randomizeStr(s.T().Name())
+
+	dc := s.testCluster.host.dcClient
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueReadPartitions, 4)
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueWritePartitions, 4)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueReadPartitions)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueWritePartitions)
+	dc.OverrideValue(dynamicconfig.MatchingBuildIdScavengerInterval, 200*time.Millisecond)
+	dc.OverrideValue(dynamicconfig.MatchingBuildIdScavengerRetention, time.Millisecond)
+	defer dc.RemoveOverride(dynamicconfig.MatchingBuildIdScavengerInterval)
+	defer dc.RemoveOverride(dynamicconfig.MatchingBuildIdScavengerRetention)
+
+	started := make(chan struct{}, 1)
+
+	wf := func(ctx workflow.Context) (string, error) {
+		started <- struct{}{}
+		workflow.GetSignalChannel(ctx, "wait").Receive(ctx, nil)
+		return "done!", nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+	s.waitForPropagation(ctx, tq, "v1")
+
+	w1 := worker.New(s.sdkClient, tq, worker.Options{
+		BuildID:                          s.prefixed("v1"),
+		UseBuildIDForVersioning:          true,
+		MaxConcurrentWorkflowTaskPollers: numPollers,
+	})
+	w1.RegisterWorkflowWithOptions(wf, workflow.RegisterOptions{Name: "wf"})
+	s.NoError(w1.Start())
+	defer w1.Stop()
+
+	run, err := s.sdkClient.ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{TaskQueue: tq}, "wf")
+	s.NoError(err)
+	s.waitForChan(ctx, started)
+
+	// visibility is updated asynchronously, wait for v1 to be reachable by its open workflow before it goes stale
+	s.Eventually(func() bool {
+		res, err := s.testCluster.GetMatchingClient().GetBuildIdReachability(ctx, &matchingservice.GetBuildIdReachabilityRequest{
+			NamespaceId: s.getNamespaceID(s.namespace),
+			TaskQueue:   tq,
+			BuildId:     s.prefixed("v1"),
+		})
+		return err == nil && slices.Contains(res.GetTaskQueueReachability().GetReachability(), enumspb.TASK_REACHABILITY_OPEN_WORKFLOWS)
+	}, 10*time.Second, 200*time.Millisecond)
+
+	// v2 never ran any workflow
+	s.addNewDefaultBuildId(ctx, tq, "v2")
+	s.addNewDefaultBuildId(ctx, tq, "v3")
+
+	s.waitForDeletedPropagation(ctx, tq, "v2")
+
+	compat, err := s.engine.GetWorkerBuildIdCompatibility(ctx, &workflowservice.GetWorkerBuildIdCompatibilityRequest{
+		Namespace: s.namespace,
+		TaskQueue: tq,
+	})
+	s.NoError(err)
+	s.Equal([]*taskqueuepb.CompatibleVersionSet{
+		{BuildIds: []string{s.prefixed("v1")}},
+		{BuildIds: []string{s.prefixed("v3")}},
+	}, compat.GetMajorVersionSets())
+
+	s.NoError(s.sdkClient.SignalWorkflow(ctx, run.GetID(), run.GetRunID(), "wait", nil))
+	var out string
+	s.NoError(run.Get(ctx, &out))
+	s.Equal("done!", out)
+}
+
+func (s *versioningIntegSuite) TestGetDefaultBuildIdTimeline() {
+	tq := s.randomizeStr(s.T().Name())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+	s.addNewDefaultBuildId(ctx, tq, "v2")
+	s.addNewDefaultBuildId(ctx, tq, "v3")
+
+	res, err := s.testCluster.GetMatchingClient().GetDefaultBuildIdTimeline(ctx, &matchingservice.GetDefaultBuildIdTimelineRequest{
+		NamespaceId: s.getNamespaceID(s.namespace),
+		TaskQueue:   tq,
+	})
+	s.NoError(err)
+	timeline := res.GetTimeline()
+	s.Len(timeline, 3)
+	for i, buildId := range []string{"v1", "v2", "v3"} {
+		s.Equal(s.prefixed(buildId), timeline[i].GetDefaultBuildId())
+		if i > 0 {
+			s.True(hlc.Greater(*timeline[i].GetTimestamp(), *timeline[i-1].GetTimestamp()))
+		}
+	}
+}
+
+func (s *versioningIntegSuite) TestValidateDefaultBuildIdSwitch() {
+	tq := s.randomizeStr(s.T().Name())
+
+	dc := s.testCluster.host.dcClient
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueReadPartitions, 4)
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueWritePartitions, 4)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueReadPartitions)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueWritePartitions)
+
+	started := make(chan struct{}, 2)
+
+	wf1 := func(ctx workflow.Context) (string, error) {
+		started <- struct{}{}
+		workflow.GetSignalChannel(ctx, "wait").Receive(ctx, nil)
+		return "done from 1!", nil
+	}
+	wf2 := func(ctx workflow.Context) (string, error) {
+		return "done from 2!", nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+	s.waitForPropagation(ctx, tq, "v1")
+
+	w1 := worker.New(s.sdkClient, tq, worker.Options{
+		BuildID:                          s.prefixed("v1"),
+		UseBuildIDForVersioning:          true,
+		MaxConcurrentWorkflowTaskPollers: numPollers,
+	})
+	w1.RegisterWorkflowWithOptions(wf1, workflow.RegisterOptions{Name: "wf"})
+	s.NoError(w1.Start())
+	defer w1.Stop()
+
+	var runs []sdkclient.WorkflowRun
+	for i := 0; i < 2; i++ {
+		run, err := s.sdkClient.ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{TaskQueue: tq}, "wf")
+		s.NoError(err)
+		s.waitForChan(ctx, started)
+		runs = append(runs, run)
+	}
+
+	// visibility is updated asynchronously
+	s.Eventually(func() bool {
+		res, err := s.testCluster.GetMatchingClient().ValidateDefaultBuildIdSwitch(ctx, &matchingservice.ValidateDefaultBuildIdSwitchRequest{
+			NamespaceId: s.getNamespaceID(s.namespace),
+			TaskQueue:   tq,
+			BuildId:     s.prefixed("v2"),
+		})
+		s.NoError(err)
+		return res.GetStayingWorkflowCount() == 2 && res.GetMovingWorkflowCount() == 0
+	}, 10*time.Second, 200*time.Millisecond)
+
+	_, err := s.testCluster.GetMatchingClient().ValidateDefaultBuildIdSwitch(ctx, &matchingservice.ValidateDefaultBuildIdSwitchRequest{
+		NamespaceId: s.getNamespaceID(s.namespace),
+		TaskQueue:   tq,
+		BuildId:     s.prefixed("v1"),
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+
+	s.addNewDefaultBuildId(ctx, tq, "v2")
+	s.waitForPropagation(ctx, tq, "v2")
+
+	w2 := worker.New(s.sdkClient, tq, worker.Options{
+		BuildID:                          s.prefixed("v2"),
+		UseBuildIDForVersioning:          true,
+		MaxConcurrentWorkflowTaskPollers: numPollers,
+	})
+	w2.RegisterWorkflowWithOptions(wf2, workflow.RegisterOptions{Name: "wf"})
+	s.NoError(w2.Start())
+	defer w2.Stop()
+
+	// the validated workflows stay on v1
+	for _, run := range runs {
+		s.NoError(s.sdkClient.SignalWorkflow(ctx, run.GetID(), run.GetRunID(), "wait", nil))
+		var out string
+		s.NoError(run.Get(ctx, &out))
+		s.Equal("done from 1!", out)
+	}
+}
+
+func (s *versioningIntegSuite) TestGetClosedWorkflowBuildId() {
+	tq := s.randomizeStr(s.T().Name())
+
+	dc := s.testCluster.host.dcClient
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueReadPartitions, 4)
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueWritePartitions, 4)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueReadPartitions)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueWritePartitions)
+
+	wf := func(ctx workflow.Context) (string, error) {
+		return "done!", nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+	s.waitForPropagation(ctx, tq, "v1")
+
+	w1 := worker.New(s.sdkClient, tq, worker.Options{
+		BuildID:                          s.prefixed("v1"),
+		UseBuildIDForVersioning:          true,
+		MaxConcurrentWorkflowTaskPollers: numPollers,
+	})
+	w1.RegisterWorkflowWithOptions(wf, workflow.RegisterOptions{Name: "wf"})
+	s.NoError(w1.Start())
+
+	run, err := s.sdkClient.ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{TaskQueue: tq}, "wf")
+	s.NoError(err)
+	var out string
+	s.NoError(run.Get(ctx, &out))
+	s.Equal("done!", out)
+	w1.Stop()
+
+	getClosedWorkflowBuildId := func() *matchingservice.GetClosedWorkflowBuildIdResponse {
+		res, err := s.testCluster.GetMatchingClient().GetClosedWorkflowBuildId(ctx, &matchingservice.GetClosedWorkflowBuildIdRequest{
+			NamespaceId: s.getNamespaceID(s.namespace),
+			TaskQueue:   tq,
+			Execution:   &commonpb.WorkflowExecution{WorkflowId: run.GetID(), RunId: run.GetRunID()},
+		})
+		s.NoError(err)
+		return res
+	}
+	res := getClosedWorkflowBuildId()
+	s.Equal(s.prefixed("v1"), res.GetBuildId())
+	s.True(res.GetRegistered())
+
+	// retire v1: it is superseded by v1.1 within its set, which makes it unreachable
+	s.addCompatibleBuildId(ctx, tq, "v1.1", "v1", true)
+	cleanup, err := s.testCluster.GetMatchingClient().CleanupUnreachableBuildIds(ctx, &matchingservice.CleanupUnreachableBuildIdsRequest{
+		NamespaceId: s.getNamespaceID(s.namespace),
+		TaskQueue:   tq,
+	})
+	s.NoError(err)
+	s.Equal([]string{s.prefixed("v1")}, cleanup.GetRemovedBuildIds())
+
+	res = getClosedWorkflowBuildId()
+	s.Equal(s.prefixed("v1"), res.GetBuildId())
+	s.False(res.GetRegistered())
+}
+
+func (s *versioningIntegSuite) TestApplyVersioningTemplate() {
+	templateName := s.randomizeStr("template")
+	template := []any{
+		[]any{s.prefixed("v1"), s.prefixed("v1.1")},
+		[]any{s.prefixed("v2")},
+		[]any{s.prefixed("v3"), s.prefixed("v3.1"), s.prefixed("v3.2")},
+	}
+	dc := s.testCluster.host.dcClient
+	dc.OverrideValue(dynamicconfig.MatchingVersioningTemplates, map[string]any{templateName: template})
+	defer dc.RemoveOverride(dynamicconfig.MatchingVersioningTemplates)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	tq := s.randomizeStr(s.T().Name())
+
+	_, err := s.testCluster.GetMatchingClient().ApplyVersioningTemplate(ctx, &matchingservice.ApplyVersioningTemplateRequest{
+		NamespaceId:  s.getNamespaceID(s.namespace),
+		TaskQueue:    tq,
+		TemplateName: templateName,
+	})
+	s.NoError(err)
+
+	res, err := s.engine.GetWorkerBuildIdCompatibility(ctx, &workflowservice.GetWorkerBuildIdCompatibilityRequest{
+		Namespace: s.namespace,
+		TaskQueue: tq,
+	})
+	s.NoError(err)
+	s.Equal([]*taskqueuepb.CompatibleVersionSet{
+		{BuildIds: []string{s.prefixed("v1"), s.prefixed("v1.1")}},
+		{BuildIds: []string{s.prefixed("v2")}},
+		{BuildIds: []string{s.prefixed("v3"), s.prefixed("v3.1"), s.prefixed("v3.2")}},
+	}, res.GetMajorVersionSets())
+
+	// The template can't be applied again once the queue has versioning data
+	_, err = s.testCluster.GetMatchingClient().ApplyVersioningTemplate(ctx, &matchingservice.ApplyVersioningTemplateRequest{
+		NamespaceId:  s.getNamespaceID(s.namespace),
+		TaskQueue:    tq,
+		TemplateName: templateName,
+	})
+	var failedPrecondition *serviceerror.FailedPrecondition
+	s.ErrorAs(err, &failedPrecondition)
+
+	_, err = s.testCluster.GetMatchingClient().ApplyVersioningTemplate(ctx, &matchingservice.ApplyVersioningTemplateRequest{
+		NamespaceId:  s.getNamespaceID(s.namespace),
+		TaskQueue:    s.randomizeStr(s.T().Name()),
+		TemplateName: s.randomizeStr("unknown"),
+	})
+	var notFound *serviceerror.NotFound
+	s.ErrorAs(err, &notFound)
+}
+
+func (s *versioningIntegSuite) TestGetUserDataPropagationStatus() {
+	dc := s.testCluster.host.dcClient
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueReadPartitions, 4)
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueWritePartitions, 4)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueReadPartitions)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueWritePartitions)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	var tqs []string
+	for i := 0; i < 3; i++ {
+		tq := s.randomizeStr(fmt.Sprintf("%s-%d", s.T().Name(), i))
+		s.addNewDefaultBuildId(ctx, tq, "v1")
+		s.addNewDefaultBuildId(ctx, tq, "v2")
+		tqs = append(tqs, tq)
+	}
+	for _, tq := range tqs {
+		s.waitForPropagation(ctx, tq, "v2")
+	}
+
+	statuses := make(map[string]*matchingservice.GetUserDataPropagationStatusResponse_TaskQueueStatus)
+	var nextPageToken []byte
+	for {
+		res, err := s.testCluster.GetMatchingClient().GetUserDataPropagationStatus(ctx, &matchingservice.GetUserDataPropagationStatusRequest{
+			NamespaceId:   s.getNamespaceID(s.namespace),
+			PageSize:      1,
+			NextPageToken: nextPageToken,
+		})
+		s.NoError(err)
+		s.LessOrEqual(len(res.GetTaskQueues()), 1)
+		for _, status := range res.GetTaskQueues() {
+			statuses[status.GetTaskQueue()] = status
+		}
+		if len(res.GetNextPageToken()) == 0 {
+			break
+		}
+		nextPageToken = res.GetNextPageToken()
+	}
+	for _, tq := range tqs {
+		status, ok := statuses[tq]
+		s.True(ok, tq)
+		s.True(status.GetFullyPropagated(), tq)
+		s.Empty(status.GetPartitionErrors(), tq)
+		s.Equal(int32(8), status.GetTotalPartitions(), tq)
+		s.Equal(status.GetTotalPartitions(), status.GetPropagatedPartitions(), tq)
+		s.Positive(status.GetUserDataVersion(), tq)
+	}
+}
+
+func (s *versioningIntegSuite) TestListWorkerBuildIdCompatibility() {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	var tqs []string
+	for i := 0; i < 3; i++ {
+		tq := s.randomizeStr(fmt.Sprintf("%s-%d", s.T().Name(), i))
+		s.addNewDefaultBuildId(ctx, tq, "v1")
+		s.addNewDefaultBuildId(ctx, tq, "v2")
+		s.addCompatibleBuildId(ctx, tq, "v1.1", "v1", false)
+		tqs = append(tqs, tq)
+	}
+
+	list := func(maxSets int32) map[string][]*taskqueuepb.CompatibleVersionSet {
+		versionSets := make(map[string][]*taskqueuepb.CompatibleVersionSet)
+		var nextPageToken []byte
+		for {
+			res, err := s.adminClient.ListWorkerBuildIdCompatibility(ctx, &adminservice.ListWorkerBuildIdCompatibilityRequest{
+				Namespace:     s.namespace,
+				PageSize:      2,
+				NextPageToken: nextPageToken,
+				MaxSets:       maxSets,
+			})
+			s.NoError(err)
+			for _, taskQueue := range res.GetTaskQueues() {
+				versionSets[taskQueue.GetTaskQueue()] = taskQueue.GetMajorVersionSets()
+			}
+			if len(res.GetNextPageToken()) == 0 {
+				return versionSets
+			}
+			nextPageToken = res.GetNextPageToken()
+		}
+	}
+
+	versionSets := list(0)
+	for _, tq := range tqs {
+		res, err := s.engine.GetWorkerBuildIdCompatibility(ctx, &workflowservice.GetWorkerBuildIdCompatibilityRequest{
+			Namespace: s.namespace,
+			TaskQueue: tq,
+		})
+		s.NoError(err)
+		s.Equal(res.GetMajorVersionSets(), versionSets[tq], tq)
+		s.Len(versionSets[tq], 2, tq)
+	}
+
+	versionSets = list(1)
+	for _, tq := range tqs {
+		s.Equal([]*taskqueuepb.CompatibleVersionSet{{BuildIds: []string{s.prefixed("v2")}}}, versionSets[tq], tq)
+	}
+}
+
+func (s *versioningIntegSuite) TestPartitionCountPerBuildId() {
+	dc := s.testCluster.host.dcClient
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueReadPartitions, 4)
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueWritePartitions, 4)
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueReadPartitionsPerBuildId, map[string]any{s.prefixed("v2"): 8})
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueReadPartitions)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueWritePartitions)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueReadPartitionsPerBuildId)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	tq := s.randomizeStr(s.T().Name())
+
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+	s.addNewDefaultBuildId(ctx, tq, "v2")
+	s.waitForPropagation(ctx, tq, "v2")
+
+	nsId := s.getNamespaceID(s.namespace)
+	res, err := s.testCluster.GetMatchingClient().GetTaskQueueUserData(ctx, &matchingservice.GetTaskQueueUserDataRequest{
+		NamespaceId:   nsId,
+		TaskQueue:     tq,
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	})
+	s.NoError(err)
+	var setIds []string
+	for _, set := range res.GetUserData().GetData().GetVersioningData().GetVersionSets() {
+		setIds = append(setIds, set.GetSetIds()[0])
+	}
+
+	// Start a bunch of versioned pollers for each build id, they'll be spread over partitions by the load balancer.
+	pollCtx, pollCancel := context.WithTimeout(ctx, 10*time.Second)
+	defer pollCancel()
+	for _, buildId := range []string{"v1", "v2"} {
+		for i := 0; i < 20; i++ {
+			go func(buildId string, i int) {
+				_, _ = s.engine.PollWorkflowTaskQueue(pollCtx, &workflowservice.PollWorkflowTaskQueueRequest{
+					Namespace: s.namespace,
+					TaskQueue: &taskqueuepb.TaskQueue{Name: tq, Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+					Identity:  fmt.Sprintf("%s-%d", buildId, i),
+					WorkerVersionCapabilities: &commonpb.WorkerVersionCapabilities{
+						BuildId:       s.prefixed(buildId),
+						UseVersioning: true,
+					},
+				})
+			}(buildId, i)
+		}
+	}
+
+	// v1 pollers only use the default partition count, v2 pollers also reach the extra partitions.
+	s.Eventually(func() bool {
+		var v1Low, v2Low, v2High bool
+		for i := 0; i < 8; i++ {
+			partName, err := tqname.FromBaseName(tq)
+			s.NoError(err)
+			res, err := s.testCluster.GetMatchingClient().DescribeTaskQueue(ctx, &matchingservice.DescribeTaskQueueRequest{
+				NamespaceId: nsId,
+				DescRequest: &workflowservice.DescribeTaskQueueRequest{
+					Namespace:     s.namespace,
+					TaskQueue:     &taskqueuepb.TaskQueue{Name: partName.WithPartition(i).FullName(), Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+					TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+				},
+				VersionSetIds: setIds,
+			})
+			s.NoError(err)
+			for _, poller := range res.GetPollers() {
+				switch poller.GetWorkerVersionCapabilities().GetBuildId() {
+				case s.prefixed("v1"):
+					s.Less(i, 4, "v1 poller on partition %d", i)
+					v1Low = true
+				case s.prefixed("v2"):
+					if i < 4 {
+						v2Low = true
+					} else {
+						v2High = true
+					}
+				}
+			}
+		}
+		return v1Low && v2Low && v2High
+	}, 10*time.Second, 200*time.Millisecond)
+}
+
+func (s *versioningIntegSuite) TestDescribePollerLastSeen() {
+	const pollerHistoryTTL = 3 * longPollTime
+	dc := s.testCluster.host.dcClient
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueReadPartitions, 1)
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueWritePartitions, 1)
+	dc.OverrideValue(dynamicconfig.MatchingPollerHistoryTTL, pollerHistoryTTL)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueReadPartitions)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueWritePartitions)
+	defer dc.RemoveOverride(dynamicconfig.MatchingPollerHistoryTTL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+	tq := s.randomizeStr(s.T().Name())
+	nsId := s.getNamespaceID(s.namespace)
+
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+	s.waitForPropagation(ctx, tq, "v1")
+
+	res, err := s.testCluster.GetMatchingClient().GetTaskQueueUserData(ctx, &matchingservice.GetTaskQueueUserDataRequest{
+		NamespaceId:   nsId,
+		TaskQueue:     tq,
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	})
+	s.NoError(err)
+	setId := res.GetUserData().GetData().GetVersioningData().GetVersionSets()[0].GetSetIds()[0]
+
+	// lastSeen returns the latest time a poller of v1 was seen, or the zero time if there is none
+	lastSeen := func() time.Time {
+		res, err := s.testCluster.GetMatchingClient().DescribeTaskQueue(ctx, &matchingservice.DescribeTaskQueueRequest{
+			NamespaceId: nsId,
+			DescRequest: &workflowservice.DescribeTaskQueueRequest{
+				Namespace:     s.namespace,
+				TaskQueue:     &taskqueuepb.TaskQueue{Name: tq, Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+				TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+			},
+			VersionSetIds: []string{setId},
+		})
+		s.NoError(err)
+		var last time.Time
+		for _, poller := range res.GetPollers() {
+			if poller.GetWorkerVersionCapabilities().GetBuildId() == s.prefixed("v1") && poller.GetLastAccessTime().After(last) {
+				last = *poller.GetLastAccessTime()
+			}
+		}
+		return last
+	}
+
+	w1 := worker.New(s.sdkClient, tq, worker.Options{
+		BuildID:                          s.prefixed("v1"),
+		UseBuildIDForVersioning:          true,
+		MaxConcurrentWorkflowTaskPollers: numPollers,
+	})
+	w1.RegisterWorkflowWithOptions(func(ctx workflow.Context) error { return nil }, workflow.RegisterOptions{Name: "wf"})
+	s.NoError(w1.Start())
+
+	// the last seen time advances while the worker is polling
+	var firstSeen time.Time
+	s.Eventually(func() bool {
+		firstSeen = lastSeen()
+		return !firstSeen.IsZero()
+	}, 10*time.Second, 200*time.Millisecond)
+	s.Eventually(func() bool {
+		return lastSeen().After(firstSeen)
+	}, 2*longPollTime, 200*time.Millisecond)
+
+	w1.Stop()
+	// the outstanding polls of the stopped worker end within a long poll interval, after that the last seen time
+	// stops advancing
+	time.Sleep(longPollTime + time.Second)
+	stoppedSeen := lastSeen()
+	s.False(stoppedSeen.IsZero())
+	time.Sleep(longPollTime)
+	s.Equal(stoppedSeen, lastSeen())
+
+	// and the poller eventually ages out
+	s.Eventually(func() bool {
+		return lastSeen().IsZero()
+	}, pollerHistoryTTL, 200*time.Millisecond)
+}
+
+func (s *versioningIntegSuite) TestDescribeUnversionedBacklog() {
+	dc := s.testCluster.host.dcClient
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueReadPartitions, 1)
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueWritePartitions, 1)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueReadPartitions)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueWritePartitions)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	tq := s.randomizeStr(s.T().Name())
+	nsId := s.getNamespaceID(s.namespace)
+
+	addTasks := func(count int, directive *taskqueuespb.TaskVersionDirective) {
+		for i := 0; i < count; i++ {
+			_, err := s.testCluster.GetMatchingClient().AddWorkflowTask(ctx, &matchingservice.AddWorkflowTaskRequest{
+				NamespaceId:      nsId,
+				Execution:        &commonpb.WorkflowExecution{WorkflowId: uuid.New(), RunId: uuid.New()},
+				TaskQueue:        &taskqueuepb.TaskQueue{Name: tq, Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+				ScheduledEventId: 2,
+				Source:           enumsspb.TASK_SOURCE_HISTORY,
+				VersionDirective: directive,
+			})
+			s.NoError(err)
+		}
+	}
+
+	// tasks added before versioning is enabled have no directive, they stay on the unversioned queue
+	addTasks(5, nil)
+
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+	s.waitForPropagation(ctx, tq, "v1")
+	addTasks(2, &taskqueuespb.TaskVersionDirective{
+		Value: &taskqueuespb.TaskVersionDirective_UseDefault{UseDefault: &types.Empty{}},
+	})
+
+	res, err := s.testCluster.GetMatchingClient().GetTaskQueueUserData(ctx, &matchingservice.GetTaskQueueUserDataRequest{
+		NamespaceId:   nsId,
+		TaskQueue:     tq,
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	})
+	s.NoError(err)
+	setId := res.GetUserData().GetData().GetVersioningData().GetVersionSets()[0].GetSetIds()[0]
+
+	// backlog counts are updated once the task readers load the tasks
+	s.Eventually(func() bool {
+		res, err := s.testCluster.GetMatchingClient().DescribeTaskQueue(ctx, &matchingservice.DescribeTaskQueueRequest{
+			NamespaceId: nsId,
+			DescRequest: &workflowservice.DescribeTaskQueueRequest{
+				Namespace:              s.namespace,
+				TaskQueue:              &taskqueuepb.TaskQueue{Name: tq, Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+				TaskQueueType:          enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+				IncludeTaskQueueStatus: true,
+			},
+			VersionSetIds: []string{setId},
+		})
+		s.NoError(err)
+		return res.GetUnversionedBacklogCountHint() == 5 &&
+			maps.Equal(map[string]int64{setId: 2}, res.GetVersionedBacklogCountHints())
+	}, 10*time.Second, 200*time.Millisecond)
+}
+
+func (s *versioningIntegSuite) TestBuildIdLabels() {
+	tq := s.randomizeStr(s.T().Name())
+
+	dc := s.testCluster.host.dcClient
+	dc.OverrideValue(dynamicconfig.VersionBuildIdLabelsSizeLimit, 64)
+	defer dc.RemoveOverride(dynamicconfig.VersionBuildIdLabelsSizeLimit)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+	s.addNewDefaultBuildId(ctx, tq, "v2")
+
+	setLabels := func(buildId string, labels map[string]string) error {
+		_, err := s.testCluster.GetMatchingClient().UpdateWorkerBuildIdCompatibility(ctx, &matchingservice.UpdateWorkerBuildIdCompatibilityRequest{
+			NamespaceId: s.getNamespaceID(s.namespace),
+			TaskQueue:   tq,
+			Operation: &matchingservice.UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels_{
+				SetBuildIdLabels: &matchingservice.UpdateWorkerBuildIdCompatibilityRequest_SetBuildIdLabels{
+					BuildId: s.prefixed(buildId),
+					Labels:  labels,
+				},
+			},
+		})
+		return err
+	}
+	labels := map[string]string{"commit": "abc123", "deployed": "2023-08-01", "owner": "team"}
+	s.NoError(setLabels("v1", labels))
+
+	res, err := s.testCluster.GetMatchingClient().GetWorkerBuildIdCompatibility(ctx, &matchingservice.GetWorkerBuildIdCompatibilityRequest{
+		NamespaceId: s.getNamespaceID(s.namespace),
+		Request: &workflowservice.GetWorkerBuildIdCompatibilityRequest{
+			Namespace: s.namespace,
+			TaskQueue: tq,
+		},
+	})
+	s.NoError(err)
+	s.Equal(map[string]*matchingservice.GetWorkerBuildIdCompatibilityResponse_BuildIdLabels{
+		s.prefixed("v1"): {Labels: labels},
+	}, res.GetBuildIdLabels())
+
+	err = setLabels("v2", map[string]string{"commit": strings.Repeat("a", 64)})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *versioningIntegSuite) TestDispatchActivity() {
+	s.testWithMatchingBehavior(s.dispatchActivity)
+}
+
+func (s *versioningIntegSuite) dispatchActivity() {
+	// This also implicitly tests that a workflow stays on a compatible version set if a new
+	// incompatible set is registered, because wf2 just panics. It further tests that
+	// stickiness on v1 is not broken by registering v2, because the channel send will panic on
+	// replay after we close the channel.
+
+	tq := s.randomizeStr(s.T().Name())
+
+	started := make(chan struct{}, 1)
+
+	act1 := func() (string, error) { return "v1", nil }
+	act2 := func() (string, error) { return "v2", nil }
+	wf1 := func(ctx workflow.Context) (string, error) {
+		started <- struct{}{}
+		// wait for signal
+		workflow.GetSignalChannel(ctx, "wait").Receive(ctx, nil)
+		// run two activities
+		fut1 := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+			ScheduleToCloseTimeout: time.Minute,
+			DisableEagerExecution:  true,
+			VersioningIntent:       temporal.VersioningIntentCompatible,
+		}), "act")
+		fut2 := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+			ScheduleToCloseTimeout: time.Minute,
+			DisableEagerExecution:  true,
+			VersioningIntent:       temporal.VersioningIntentDefault, // this one should go to default
+		}), "act")
+		var val1, val2 string
+		s.NoError(fut1.Get(ctx, &val1))
+		s.NoError(fut2.Get(ctx, &val2))
+		return val1 + val2, nil
+	}
+	wf2 := func(ctx workflow.Context) (string, error) {
+		panic("workflow should not run on v2")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+	s.waitForPropagation(ctx, tq, "v1")
+
+	w1 := worker.New(s.sdkClient, tq, worker.Options{
+		BuildID:                          s.prefixed("v1"),
+		UseBuildIDForVersioning:          true,
+		MaxConcurrentWorkflowTaskPollers: numPollers,
+	})
+	w1.RegisterWorkflowWithOptions(wf1, workflow.RegisterOptions{Name: "wf"})
+	w1.RegisterActivityWithOptions(act1, activity.RegisterOptions{Name: "act"})
+	s.NoError(w1.Start())
+	defer w1.Stop()
+
+	run, err := s.sdkClient.ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{TaskQueue: tq}, "wf")
+	s.NoError(err)
+	// wait for it to start on v1
+	s.waitForChan(ctx, started)
+	close(started) //force panic if replayed
+
+	// now register v2 as default
+	s.addNewDefaultBuildId(ctx, tq, "v2")
+	s.waitForPropagation(ctx, tq, "v2")
+	// start worker for v2
+	w2 := worker.New(s.sdkClient, tq, worker.Options{
+		BuildID:                          s.prefixed("v2"),
+		UseBuildIDForVersioning:          true,
+		MaxConcurrentWorkflowTaskPollers: numPollers,
+	})
+	w2.RegisterWorkflowWithOptions(wf2, workflow.RegisterOptions{Name: "wf"})
+	w2.RegisterActivityWithOptions(act2, activity.RegisterOptions{Name: "act"})
+	s.NoError(w2.Start())
+	defer w2.Stop()
+
+	// unblock the workflow
+	s.NoError(s.sdkClient.SignalWorkflow(ctx, run.GetID(), run.GetRunID(), "wait", nil))
+
+	var out string
+	s.NoError(run.Get(ctx, &out))
+	s.Equal("v1v2", out)
+}
+
+func (s *versioningIntegSuite) TestDispatchActivityIntentWinsOverActivityDefault() {
+	tq := s.randomizeStr(s.T().Name())
+
+	started := make(chan struct{}, 1)
+
+	act1 := func() (string, error) { return "v1", nil }
+	act2 := func() (string, error) { return "v2", nil }
+	act3 := func() (string, error) { return "v3", nil }
+	wf1 := func(ctx workflow.Context) (string, error) {
+		started <- struct{}{}
+		workflow.GetSignalChannel(ctx, "wait").Receive(ctx, nil)
+		fut1 := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+			ScheduleToCloseTimeout: time.Minute,
+			DisableEagerExecution:  true,
+			VersioningIntent:       temporal.VersioningIntentCompatible, // intent wins over the activity default
+		}), "act")
+		fut2 := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+			ScheduleToCloseTimeout: time.Minute,
+			DisableEagerExecution:  true,
+			VersioningIntent:       temporal.VersioningIntentDefault, // this one should go to the activity default
+		}), "act")
+		var val1, val2 string
+		s.NoError(fut1.Get(ctx, &val1))
+		s.NoError(fut2.Get(ctx, &val2))
+		return val1 + val2, nil
+	}
+	wfPanic := func(ctx workflow.Context) (string, error) {
+		panic("workflow should not run on v2 or v3")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	dc := s.testCluster.host.dcClient
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueReadPartitions, 4)
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueWritePartitions, 4)
+	dc.OverrideValue(dynamicconfig.MatchingActivityDefaultBuildId, s.prefixed("v2"))
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueReadPartitions)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueWritePartitions)
+	defer dc.RemoveOverride(dynamicconfig.MatchingActivityDefaultBuildId)
+
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+	s.waitForPropagation(ctx, tq, "v1")
+
+	w1 := worker.New(s.sdkClient, tq, worker.Options{
+		BuildID:                          s.prefixed("v1"),
+		UseBuildIDForVersioning:          true,
+		MaxConcurrentWorkflowTaskPollers: numPollers,
+	})
+	w1.RegisterWorkflowWithOptions(wf1, workflow.RegisterOptions{Name: "wf"})
+	w1.RegisterActivityWithOptions(act1, activity.RegisterOptions{Name: "act"})
+	s.NoError(w1.Start())
+	defer w1.Stop()
+
+	run, err := s.sdkClient.ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{TaskQueue: tq}, "wf")
+	s.NoError(err)
+	// wait for it to start on v1
+	s.waitForChan(ctx, started)
+	close(started) //force panic if replayed
+
+	// v2 is the activity default, v3 the task queue default
+	s.addNewDefaultBuildId(ctx, tq, "v2")
+	s.addNewDefaultBuildId(ctx, tq, "v3")
+	s.waitForPropagation(ctx, tq, "v3")
+	for _, v := range []struct {
+		buildId string
+		act     func() (string, error)
+	}{{"v2", act2}, {"v3", act3}} {
+		w := worker.New(s.sdkClient, tq, worker.Options{
+			BuildID:                          s.prefixed(v.buildId),
+			UseBuildIDForVersioning:          true,
+			MaxConcurrentWorkflowTaskPollers: numPollers,
+		})
+		w.RegisterWorkflowWithOptions(wfPanic, workflow.RegisterOptions{Name: "wf"})
+		w.RegisterActivityWithOptions(v.act, activity.RegisterOptions{Name: "act"})
+		s.NoError(w.Start())
+		defer w.Stop()
+	}
+
+	// unblock the workflow
+	s.NoError(s.sdkClient.SignalWorkflow(ctx, run.GetID(), run.GetRunID(), "wait", nil))
+
+	var out string
+	s.NoError(run.Get(ctx, &out))
+	s.Equal("v1v2", out)
+}
+
+func (s *versioningIntegSuite) TestUserDataSizeLimit() {
+	ctx := NewContext()
+	tq := "integration-versioning-user-data-size-limit"
+	const sizeLimit = 2048
+
+	dc := s.testCluster.host.dcClient
+	dc.OverrideValue(dynamicconfig.TaskQueueUserDataSizeLimit, sizeLimit)
+	defer dc.RemoveOverride(dynamicconfig.TaskQueueUserDataSizeLimit)
+
+	captureHandler := s.testCluster.host.GetCaptureMetricsHandler()
+	capture := captureHandler.StartCapture()
+	defer captureHandler.StopCapture(capture)
+
+	// grow a single compatible set since the number of sets is limited
+	s.addNewDefaultBuildId(ctx, tq, "v0")
+	var err error
+	for i := 1; err == nil; i++ {
+		_, err = s.engine.UpdateWorkerBuildIdCompatibility(ctx, &workflowservice.UpdateWorkerBuildIdCompatibilityRequest{
+			Namespace: s.namespace,
+			TaskQueue: tq,
+			Operation: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_AddNewCompatibleBuildId{
+				AddNewCompatibleBuildId: &workflowservice.UpdateWorkerBuildIdCompatibilityRequest_AddNewCompatibleVersion{
+					NewBuildId:                s.prefixed(fmt.Sprintf("v0.%d", i)),
+					ExistingCompatibleBuildId: s.prefixed("v0"),
+				},
+			},
+		})
+	}
+	var failedPrecondition *serviceerror.FailedPrecondition
+	s.ErrorAs(err, &failedPrecondition)
+	s.Contains(failedPrecondition.Message, "exceeding the limit of 2048 bytes")
+
+	res, err := s.testCluster.GetMatchingClient().GetTaskQueueUserData(ctx, &matchingservice.GetTaskQueueUserDataRequest{
+		NamespaceId:   s.getNamespaceID(s.namespace),
+		TaskQueue:     tq,
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	})
+	s.NoError(err)
+	size := res.GetUserData().GetData().Size()
+	s.LessOrEqual(size, sizeLimit)
+	// the last accepted update brought the user data within a build id of the limit
+	s.Greater(size, sizeLimit-100)
+
+	recordings := capture.Snapshot()[metrics.TaskQueueUserDataSize.GetMetricName()]
+	s.NotEmpty(recordings)
+	recorded := make([]int64, len(recordings))
+	for i, recording := range recordings {
+		recorded[i] = recording.Value.(int64)
+	}
+	s.Contains(recorded, int64(size))
+}
+
+func (s *versioningIntegSuite) TestGetTaskQueueUserDataCoalescesLongPolls() {
+	ctx := NewContext()
+	// no dashes, metric tag values are sanitized
+	tq := "integration_versioning_user_data_coalesce"
+	const callers = 20
+
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+
+	nsId := s.getNamespaceID(s.namespace)
+	res, err := s.testCluster.host.matchingClient.GetTaskQueueUserData(ctx, &matchingservice.GetTaskQueueUserDataRequest{
+		NamespaceId:   nsId,
+		TaskQueue:     tq,
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+	})
+	s.NoError(err)
+	version := res.GetUserData().GetVersion()
+
+	captureHandler := s.testCluster.host.GetCaptureMetricsHandler()
+	capture := captureHandler.StartCapture()
+	defer captureHandler.StopCapture(capture)
+
+	type result struct {
+		res *matchingservice.GetTaskQueueUserDataResponse
+		err error
+	}
+	results := make(chan result, callers)
+	for i := 0; i < callers; i++ {
+		go func() {
+			res, err := s.testCluster.host.matchingClient.GetTaskQueueUserData(ctx, &matchingservice.GetTaskQueueUserDataRequest{
+				NamespaceId:              nsId,
+				TaskQueue:                tq,
+				TaskQueueType:            enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+				LastKnownUserDataVersion: version,
+				WaitNewData:              true,
+			})
+			results <- result{res, err}
+		}()
+	}
+	// let all long polls arrive before the data changes
+	time.Sleep(time.Second)
+	s.addNewDefaultBuildId(ctx, tq, "v2")
+
+	for i := 0; i < callers; i++ {
+		r := <-results
+		s.NoError(r.err)
+		s.True(containsBuildId(r.res.GetUserData().GetData().GetVersioningData(), s.prefixed("v2")))
+	}
+
+	longPolls := 0
+	for _, recording := range capture.Snapshot()[metrics.TaskQueueUserDataLongPolls.GetMetricName()] {
+		if recording.Tags["taskqueue"] == tq {
+			longPolls++
+		}
+	}
+	s.Equal(1, longPolls)
+}
+
+func (s *versioningIntegSuite) TestUserDataMinPropagationInterval() {
+	// no dashes, metric tag values are sanitized
+	tq := "integration_versioning_min_propagation_interval"
+
+	dc := s.testCluster.host.dcClient
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueReadPartitions, 4)
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueWritePartitions, 4)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueReadPartitions)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueWritePartitions)
+	dc.OverrideValue(dynamicconfig.MatchingUserDataMinPropagationInterval, 2*time.Second)
+	defer dc.RemoveOverride(dynamicconfig.MatchingUserDataMinPropagationInterval)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	s.addNewDefaultBuildId(ctx, tq, "v0")
+	s.waitForPropagation(ctx, tq, "v0")
+	// let all partitions settle into their long polls
+	time.Sleep(3 * time.Second)
+
+	captureHandler := s.testCluster.host.GetCaptureMetricsHandler()
+	capture := captureHandler.StartCapture()
+	defer captureHandler.StopCapture(capture)
+
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+	s.addNewDefaultBuildId(ctx, tq, "v2")
+	s.addNewDefaultBuildId(ctx, tq, "v3")
+	s.waitForVersioningDataPropagation(ctx, tq, func(data *persistencespb.VersioningData) bool {
+		return len(data.GetVersionSets()) == 4 && data.VersionSets[3].BuildIds[0].Id == s.prefixed("v3")
+	})
+
+	writesPerPartition := make(map[string]int)
+	for _, recording := range capture.Snapshot()[metrics.TaskQueueUserDataPropagated.GetMetricName()] {
+		if strings.Contains(recording.Tags["taskqueue"], tq) {
+			writesPerPartition[recording.Tags["taskqueue"]+recording.Tags["task_type"]]++
+		}
+	}
+	s.NotEmpty(writesPerPartition)
+	for partition, writes := range writesPerPartition {
+		s.Equal(1, writes, "partition %s received more than one propagation wave", partition)
+	}
+}
+
+func (s *versioningIntegSuite) TestPauseUserDataPropagation() {
+	tq := s.randomizeStr(s.T().Name())
+	const partCount = 4
+
+	dc := s.testCluster.host.dcClient
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueReadPartitions, partCount)
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueWritePartitions, partCount)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueReadPartitions)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueWritePartitions)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// load all partitions with v1 before pausing
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+	s.waitForPropagation(ctx, tq, "v1")
+
+	dc.OverrideValue(dynamicconfig.MatchingPauseUserDataPropagation, true)
+	defer dc.RemoveOverride(dynamicconfig.MatchingPauseUserDataPropagation)
+
+	// the root accepts the update while paused
+	s.addNewDefaultBuildId(ctx, tq, "v2")
+
+	nsId := s.getNamespaceID(s.namespace)
+	anyPartitionUpdated := func() bool {
+		for i := 0; i < partCount; i++ {
+			for _, tp := range []enumspb.TaskQueueType{enumspb.TASK_QUEUE_TYPE_WORKFLOW, enumspb.TASK_QUEUE_TYPE_ACTIVITY} {
+				if i == 0 && tp == enumspb.TASK_QUEUE_TYPE_WORKFLOW {
+					// root partition owns the data
+					continue
+				}
+				partName, err := tqname.FromBaseName(tq)
+				s.NoError(err)
+				res, err := s.testCluster.host.matchingClient.GetTaskQueueUserData(ctx, &matchingservice.GetTaskQueueUserDataRequest{
+					NamespaceId:   nsId,
+					TaskQueue:     partName.WithPartition(i).FullName(),
+					TaskQueueType: tp,
+				})
+				s.NoError(err)
+				if containsBuildId(res.GetUserData().GetData().GetVersioningData(), s.prefixed("v2")) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	s.Never(anyPartitionUpdated, 3*time.Second, 200*time.Millisecond)
+
+	// partitions converge once propagation is resumed
+	dc.RemoveOverride(dynamicconfig.MatchingPauseUserDataPropagation)
+	s.waitForPropagation(ctx, tq, "v2")
+}
 
+func (s *versioningIntegSuite) TestDisableEagerActivityOnTaskQueue() {
 	tq := s.randomizeStr(s.T().Name())
 
+	dc := s.testCluster.host.dcClient
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueReadPartitions, 4)
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueWritePartitions, 4)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueReadPartitions)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueWritePartitions)
+	dc.OverrideValue(dynamicconfig.EnableActivityEagerExecution, true)
+	dc.OverrideValue(dynamicconfig.DisableActivityEagerExecution, true)
+	defer dc.RemoveOverride(dynamicconfig.EnableActivityEagerExecution)
+	defer dc.RemoveOverride(dynamicconfig.DisableActivityEagerExecution)
+
 	started := make(chan struct{}, 1)
 
 	act1 := func() (string, error) { return "v1", nil }
 	act2 := func() (string, error) { return "v2", nil }
 	wf1 := func(ctx workflow.Context) (string, error) {
 		started <- struct{}{}
-		// wait for signal
 		workflow.GetSignalChannel(ctx, "wait").Receive(ctx, nil)
-		// run two activities
-		fut1 := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
-			ScheduleToCloseTimeout: time.Minute,
-			DisableEagerExecution:  true,
-			VersioningIntent:       temporal.VersioningIntentCompatible,
-		}), "act")
-		fut2 := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		// eager execution is requested by the sdk, if granted the activity would run on v1
+		var val string
+		err := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
 			ScheduleToCloseTimeout: time.Minute,
-			DisableEagerExecution:  true,
-			VersioningIntent:       temporal.VersioningIntentDefault, // this one should go to default
-		}), "act")
-		var val1, val2 string
-		s.NoError(fut1.Get(ctx, &val1))
-		s.NoError(fut2.Get(ctx, &val2))
-		return val1 + val2, nil
+			VersioningIntent:       temporal.VersioningIntentDefault,
+		}), "act").Get(ctx, &val)
+		return val, err
 	}
 	wf2 := func(ctx workflow.Context) (string, error) {
 		panic("workflow should not run on v2")
@@ -526,14 +2708,10 @@ func (s *versioningIntegSuite) dispatchActivity() {
 
 	run, err := s.sdkClient.ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{TaskQueue: tq}, "wf")
 	s.NoError(err)
-	// wait for it to start on v1
 	s.waitForChan(ctx, started)
-	close(started) //force panic if replayed
 
-	// now register v2 as default
 	s.addNewDefaultBuildId(ctx, tq, "v2")
 	s.waitForPropagation(ctx, tq, "v2")
-	// start worker for v2
 	w2 := worker.New(s.sdkClient, tq, worker.Options{
 		BuildID:                          s.prefixed("v2"),
 		UseBuildIDForVersioning:          true,
@@ -544,12 +2722,213 @@ func (s *versioningIntegSuite) dispatchActivity() {
 	s.NoError(w2.Start())
 	defer w2.Stop()
 
-	// unblock the workflow
 	s.NoError(s.sdkClient.SignalWorkflow(ctx, run.GetID(), run.GetRunID(), "wait", nil))
 
 	var out string
 	s.NoError(run.Get(ctx, &out))
-	s.Equal("v1v2", out)
+	s.Equal("v2", out)
+}
+
+func (s *versioningIntegSuite) TestDispatchWeightedBuildIdsInSet() {
+	tq := s.randomizeStr(s.T().Name())
+	const workflows = 40
+
+	dc := s.testCluster.host.dcClient
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueReadPartitions, 4)
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueWritePartitions, 4)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueReadPartitions)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueWritePartitions)
+	dc.OverrideValue(dynamicconfig.MatchingBuildIdDispatchWeights, map[string]any{
+		s.prefixed("v1"):   50,
+		s.prefixed("v1.1"): 50,
+	})
+	defer dc.RemoveOverride(dynamicconfig.MatchingBuildIdDispatchWeights)
+
+	wf1 := func(ctx workflow.Context) (string, error) { return "v1", nil }
+	wf11 := func(ctx workflow.Context) (string, error) { return "v1.1", nil }
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+	s.addCompatibleBuildId(ctx, tq, "v1.1", "v1", false)
+	s.waitForPropagation(ctx, tq, "v1.1")
+
+	w1 := worker.New(s.sdkClient, tq, worker.Options{
+		BuildID:                          s.prefixed("v1"),
+		UseBuildIDForVersioning:          true,
+		MaxConcurrentWorkflowTaskPollers: numPollers,
+	})
+	w1.RegisterWorkflowWithOptions(wf1, workflow.RegisterOptions{Name: "wf"})
+	s.NoError(w1.Start())
+	defer w1.Stop()
+
+	w11 := worker.New(s.sdkClient, tq, worker.Options{
+		BuildID:                          s.prefixed("v1.1"),
+		UseBuildIDForVersioning:          true,
+		MaxConcurrentWorkflowTaskPollers: numPollers,
+	})
+	w11.RegisterWorkflowWithOptions(wf11, workflow.RegisterOptions{Name: "wf"})
+	s.NoError(w11.Start())
+	defer w11.Stop()
+
+	runs := make([]sdkclient.WorkflowRun, workflows)
+	for i := range runs {
+		run, err := s.sdkClient.ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{TaskQueue: tq}, "wf")
+		s.NoError(err)
+		runs[i] = run
+	}
+
+	counts := make(map[string]int)
+	for _, run := range runs {
+		var out string
+		s.NoError(run.Get(ctx, &out))
+		counts[out]++
+	}
+	// both build ids must get a fair part of the tasks, the exact split depends on poll timing
+	s.GreaterOrEqual(counts["v1"], workflows/4)
+	s.GreaterOrEqual(counts["v1.1"], workflows/4)
+}
+
+func (s *versioningIntegSuite) TestDispatchRateScalesWithBuildIdPollers() {
+	tq := s.randomizeStr(s.T().Name())
+	const (
+		workflows     = 60
+		ratePerPoller = 2
+		pollerCount   = 4
+	)
+
+	dc := s.testCluster.host.dcClient
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueReadPartitions, 1)
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueWritePartitions, 1)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueReadPartitions)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueWritePartitions)
+	dc.OverrideValue(dynamicconfig.MatchingBuildIdDispatchRatePerPoller, ratePerPoller)
+	defer dc.RemoveOverride(dynamicconfig.MatchingBuildIdDispatchRatePerPoller)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 90*time.Second)
+	defer cancel()
+
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+	s.waitForPropagation(ctx, tq, "v1")
+
+	// build up a backlog that outlasts the test, tasks are never completed so they also come back after timing out
+	for i := 0; i < workflows; i++ {
+		_, err := s.sdkClient.ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{TaskQueue: tq}, "wf")
+		s.NoError(err)
+	}
+
+	var dispatched atomic.Int64
+	startPoller := func(i int) context.CancelFunc {
+		pollCtx, pollCancel := context.WithCancel(ctx)
+		go func() {
+			for pollCtx.Err() == nil {
+				res, err := s.engine.PollWorkflowTaskQueue(pollCtx, &workflowservice.PollWorkflowTaskQueueRequest{
+					Namespace: s.namespace,
+					TaskQueue: &taskqueuepb.TaskQueue{Name: tq, Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+					Identity:  fmt.Sprintf("poller-%d", i),
+					WorkerVersionCapabilities: &commonpb.WorkerVersionCapabilities{
+						BuildId:       s.prefixed("v1"),
+						UseVersioning: true,
+					},
+				})
+				if err == nil && len(res.GetTaskToken()) > 0 {
+					dispatched.Add(1)
+				}
+			}
+		}()
+		return pollCancel
+	}
+	measureRate := func() float64 {
+		const window = 3 * time.Second
+		before := dispatched.Load()
+		time.Sleep(window)
+		return float64(dispatched.Load()-before) / window.Seconds()
+	}
+
+	cancels := make([]context.CancelFunc, pollerCount)
+	for i := range cancels {
+		cancels[i] = startPoller(i)
+	}
+	// let the rate catch up with the poller count
+	time.Sleep(2 * time.Second)
+	fullRate := measureRate()
+
+	// the stopped pollers still count until they have not polled for a long poll interval
+	for _, pollCancel := range cancels[1:] {
+		pollCancel()
+	}
+	time.Sleep(longPollTime + 2*time.Second)
+	reducedRate := measureRate()
+
+	for i := 1; i < pollerCount; i++ {
+		cancels[i] = startPoller(i)
+	}
+	time.Sleep(2 * time.Second)
+	recoveredRate := measureRate()
+	for _, pollCancel := range cancels {
+		pollCancel()
+	}
+
+	s.Less(reducedRate, float64(2*ratePerPoller), "rates: full %v, reduced %v, recovered %v", fullRate, reducedRate, recoveredRate)
+	s.Greater(fullRate, 2*reducedRate, "rates: full %v, reduced %v, recovered %v", fullRate, reducedRate, recoveredRate)
+	s.Greater(recoveredRate, 2*reducedRate, "rates: full %v, reduced %v, recovered %v", fullRate, reducedRate, recoveredRate)
+}
+
+func (s *versioningIntegSuite) TestPollOutcomeMetricsPerBuildId() {
+	tq := s.randomizeStr(s.T().Name())
+
+	dc := s.testCluster.host.dcClient
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueReadPartitions, 1)
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueWritePartitions, 1)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueReadPartitions)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueWritePartitions)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+	s.waitForPropagation(ctx, tq, "v1")
+
+	captureHandler := s.testCluster.host.GetCaptureMetricsHandler()
+	capture := captureHandler.StartCapture()
+	defer captureHandler.StopCapture(capture)
+
+	poll := func() *workflowservice.PollWorkflowTaskQueueResponse {
+		res, err := s.engine.PollWorkflowTaskQueue(ctx, &workflowservice.PollWorkflowTaskQueueRequest{
+			Namespace: s.namespace,
+			TaskQueue: &taskqueuepb.TaskQueue{Name: tq, Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+			Identity:  "poller",
+			WorkerVersionCapabilities: &commonpb.WorkerVersionCapabilities{
+				BuildId:       s.prefixed("v1"),
+				UseVersioning: true,
+			},
+		})
+		s.NoError(err)
+		return res
+	}
+	countForBuildId := func(metricName string) int {
+		count := 0
+		for _, recording := range capture.Snapshot()[metricName] {
+			if recording.Tags["build_id"] == s.prefixed("v1") {
+				count++
+			}
+		}
+		return count
+	}
+
+	// no tasks, the poll times out after longPollTime
+	start := time.Now()
+	s.Empty(poll().GetTaskToken())
+	s.GreaterOrEqual(time.Since(start), longPollTime-time.Second)
+	s.Equal(1, countForBuildId(metrics.PollTimedOutPerBuildIdCounter.GetMetricName()))
+	s.Equal(0, countForBuildId(metrics.PollMatchedPerBuildIdCounter.GetMetricName()))
+
+	_, err := s.sdkClient.ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{TaskQueue: tq}, "wf")
+	s.NoError(err)
+	s.NotEmpty(poll().GetTaskToken())
+	s.Equal(1, countForBuildId(metrics.PollTimedOutPerBuildIdCounter.GetMetricName()))
+	s.Equal(1, countForBuildId(metrics.PollMatchedPerBuildIdCounter.GetMetricName()))
 }
 
 func (s *versioningIntegSuite) TestDispatchChildWorkflow() {
@@ -933,6 +3312,176 @@ func (s *versioningIntegSuite) dispatchCron() {
 	s.GreaterOrEqual(runs2.Load(), int32(3))
 }
 
+func (s *versioningIntegSuite) TestDispatchResetPreserveBuildId() {
+	s.testWithMatchingBehavior(s.dispatchResetPreserveBuildId)
+}
+
+func (s *versioningIntegSuite) dispatchResetPreserveBuildId() {
+	tq := s.randomizeStr(s.T().Name())
+
+	started := make(chan struct{}, 10)
+
+	wf1 := func(ctx workflow.Context) (string, error) {
+		started <- struct{}{}
+		workflow.GetSignalChannel(ctx, "wait").Receive(ctx, nil)
+		return "done from 1!", nil
+	}
+	wf2 := func(ctx workflow.Context) (string, error) {
+		workflow.GetSignalChannel(ctx, "wait").Receive(ctx, nil)
+		return "done from 2!", nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+	s.waitForPropagation(ctx, tq, "v1")
+
+	w1 := worker.New(s.sdkClient, tq, worker.Options{
+		BuildID:                          s.prefixed("v1"),
+		UseBuildIDForVersioning:          true,
+		MaxConcurrentWorkflowTaskPollers: numPollers,
+	})
+	w1.RegisterWorkflowWithOptions(wf1, workflow.RegisterOptions{Name: "wf"})
+	s.NoError(w1.Start())
+	defer w1.Stop()
+
+	run, err := s.sdkClient.ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{TaskQueue: tq}, "wf")
+	s.NoError(err)
+	s.waitForChan(ctx, started)
+
+	// promote v2, a reset without preserve_build_id would start running there
+	s.addNewDefaultBuildId(ctx, tq, "v2")
+	s.waitForPropagation(ctx, tq, "v2")
+
+	w2 := worker.New(s.sdkClient, tq, worker.Options{
+		BuildID:                          s.prefixed("v2"),
+		UseBuildIDForVersioning:          true,
+		MaxConcurrentWorkflowTaskPollers: numPollers,
+	})
+	w2.RegisterWorkflowWithOptions(wf2, workflow.RegisterOptions{Name: "wf"})
+	s.NoError(w2.Start())
+	defer w2.Stop()
+
+	// reset to the first workflow task completion
+	var resetEventId int64
+	iter := s.sdkClient.GetWorkflowHistory(ctx, run.GetID(), run.GetRunID(), false, enumspb.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT)
+	for iter.HasNext() && resetEventId == 0 {
+		event, err := iter.Next()
+		s.NoError(err)
+		if event.GetEventType() == enumspb.EVENT_TYPE_WORKFLOW_TASK_COMPLETED {
+			resetEventId = event.GetEventId()
+		}
+	}
+	s.NotZero(resetEventId)
+
+	resetRes, err := s.testCluster.GetHistoryClient().ResetWorkflowExecution(ctx, &historyservice.ResetWorkflowExecutionRequest{
+		NamespaceId: s.getNamespaceID(s.namespace),
+		ResetRequest: &workflowservice.ResetWorkflowExecutionRequest{
+			Namespace: s.namespace,
+			WorkflowExecution: &commonpb.WorkflowExecution{
+				WorkflowId: run.GetID(),
+				RunId:      run.GetRunID(),
+			},
+			Reason:                    "test",
+			WorkflowTaskFinishEventId: resetEventId,
+			RequestId:                 uuid.New(),
+			ResetReapplyType:          enumspb.RESET_REAPPLY_TYPE_SIGNAL,
+		},
+		PreserveBuildId: true,
+	})
+	s.NoError(err)
+
+	// wait for the reset run to start on v1 before unblocking it
+	s.waitForChan(ctx, started)
+	s.NoError(s.sdkClient.SignalWorkflow(ctx, run.GetID(), resetRes.GetRunId(), "wait", nil))
+
+	var out string
+	s.NoError(s.sdkClient.GetWorkflow(ctx, run.GetID(), resetRes.GetRunId()).Get(ctx, &out))
+	s.Equal("done from 1!", out)
+}
+
+func (s *versioningIntegSuite) TestReassignBuildId() {
+	dc := s.testCluster.host.dcClient
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueReadPartitions, 4)
+	dc.OverrideValue(dynamicconfig.MatchingNumTaskqueueWritePartitions, 4)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueReadPartitions)
+	defer dc.RemoveOverride(dynamicconfig.MatchingNumTaskqueueWritePartitions)
+
+	tq := s.randomizeStr(s.T().Name())
+
+	started := make(chan struct{}, 10)
+
+	wf1 := func(ctx workflow.Context) (string, error) {
+		started <- struct{}{}
+		workflow.GetSignalChannel(ctx, "wait").Receive(ctx, nil)
+		return "done from 1!", nil
+	}
+	wf2 := func(ctx workflow.Context) (string, error) {
+		workflow.GetSignalChannel(ctx, "wait").Receive(ctx, nil)
+		return "done from 2!", nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	s.addNewDefaultBuildId(ctx, tq, "v1")
+	s.waitForPropagation(ctx, tq, "v1")
+
+	w1 := worker.New(s.sdkClient, tq, worker.Options{
+		BuildID:                          s.prefixed("v1"),
+		UseBuildIDForVersioning:          true,
+		MaxConcurrentWorkflowTaskPollers: numPollers,
+	})
+	w1.RegisterWorkflowWithOptions(wf1, workflow.RegisterOptions{Name: "wf"})
+	s.NoError(w1.Start())
+	defer w1.Stop()
+
+	run, err := s.sdkClient.ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{TaskQueue: tq}, "wf")
+	s.NoError(err)
+	s.waitForChan(ctx, started)
+
+	// v2 is the new default, but the workflow stays on v1 until it's reassigned
+	s.addNewDefaultBuildId(ctx, tq, "v2")
+	s.waitForPropagation(ctx, tq, "v2")
+
+	w2 := worker.New(s.sdkClient, tq, worker.Options{
+		BuildID:                          s.prefixed("v2"),
+		UseBuildIDForVersioning:          true,
+		MaxConcurrentWorkflowTaskPollers: numPollers,
+	})
+	w2.RegisterWorkflowWithOptions(wf2, workflow.RegisterOptions{Name: "wf"})
+	s.NoError(w2.Start())
+	defer w2.Stop()
+
+	// wait for visibility to record that the workflow ran on v1, and for its first workflow task to complete
+	s.Eventually(func() bool {
+		res, err := s.testCluster.GetMatchingClient().ReassignBuildId(ctx, &matchingservice.ReassignBuildIdRequest{
+			NamespaceId:   s.getNamespaceID(s.namespace),
+			TaskQueue:     tq,
+			BuildId:       s.prefixed("v1"),
+			TargetBuildId: s.prefixed("v2"),
+		})
+		s.NoError(err)
+		return res.GetReassignedWorkflows() == 1
+	}, 10*time.Second, 200*time.Millisecond)
+
+	// the workflow is repinned rather than reset, so the next workflow task of the same run goes to v2
+	s.NoError(s.sdkClient.SignalWorkflow(ctx, run.GetID(), run.GetRunID(), "wait", nil))
+	var out string
+	s.NoError(s.sdkClient.GetWorkflow(ctx, run.GetID(), run.GetRunID()).Get(ctx, &out))
+	s.Equal("done from 2!", out)
+
+	_, err = s.testCluster.GetMatchingClient().ReassignBuildId(ctx, &matchingservice.ReassignBuildIdRequest{
+		NamespaceId:   s.getNamespaceID(s.namespace),
+		TaskQueue:     tq,
+		BuildId:       s.prefixed("v1"),
+		TargetBuildId: s.prefixed("v3"),
+	})
+	var failedPrecondition *serviceerror.FailedPrecondition
+	s.ErrorAs(err, &failedPrecondition)
+}
+
 // Add a per test prefix to avoid hitting the namespace limit of mapped task queue per build id
 func (s *versioningIntegSuite) prefixed(buildId string) string {
 	return fmt.Sprintf("t%x:%s", 0xffff&farm.Hash32([]byte(s.T().Name())), buildId)
@@ -970,6 +3519,30 @@ func (s *versioningIntegSuite) addCompatibleBuildId(ctx context.Context, tq, new
 
 // waitForPropagation waits for all partitions of tq to mention newBuildId in their versioning data (in any position).
 func (s *versioningIntegSuite) waitForPropagation(ctx context.Context, tq, newBuildId string) {
+	s.waitForVersioningDataPropagation(ctx, tq, func(data *persistencespb.VersioningData) bool {
+		return containsBuildId(data, s.prefixed(newBuildId))
+	})
+}
+
+// waitForDrainingPropagation waits until all partitions see buildId as draining.
+func (s *versioningIntegSuite) waitForDrainingPropagation(ctx context.Context, tq, buildId string) {
+	s.waitForVersioningDataPropagation(ctx, tq, func(data *persistencespb.VersioningData) bool {
+		return getBuildIdState(data, s.prefixed(buildId)) == persistencespb.STATE_DRAINING
+	})
+}
+
+// waitForDeletedPropagation waits until all partitions see buildId as deleted.
+func (s *versioningIntegSuite) waitForDeletedPropagation(ctx context.Context, tq, buildId string) {
+	s.waitForVersioningDataPropagation(ctx, tq, func(data *persistencespb.VersioningData) bool {
+		return getBuildIdState(data, s.prefixed(buildId)) == persistencespb.STATE_DELETED
+	})
+}
+
+func (s *versioningIntegSuite) waitForVersioningDataPropagation(
+	ctx context.Context,
+	tq string,
+	condition func(*persistencespb.VersioningData) bool,
+) {
 	v, ok := s.testCluster.host.dcClient.getRawValue(dynamicconfig.MatchingNumTaskqueueReadPartitions)
 	s.True(ok, "versioning tests require setting explicit number of partitions")
 	partCount, ok := v.(int)
@@ -1000,7 +3573,7 @@ func (s *versioningIntegSuite) waitForPropagation(ctx context.Context, tq, newBu
 					TaskQueueType: pt.tp,
 				})
 			s.NoError(err)
-			if containsBuildId(res.GetUserData().GetData().GetVersioningData(), s.prefixed(newBuildId)) {
+			if condition(res.GetUserData().GetData().GetVersioningData()) {
 				delete(remaining, pt)
 			}
 		}
@@ -1028,6 +3601,17 @@ func containsBuildId(data *persistencespb.VersioningData, buildId string) bool {
 	return false
 }
 
+func getBuildIdState(data *persistencespb.VersioningData, buildId string) persistencespb.BuildId_State {
+	for _, set := range data.GetVersionSets() {
+		for _, id := range set.BuildIds {
+			if id.Id == buildId {
+				return id.State
+			}
+		}
+	}
+	return persistencespb.STATE_UNSPECIFIED
+}
+
 func getCurrentDefault(res *workflowservice.GetWorkerBuildIdCompatibilityResponse) string {
 	if res == nil {
 		return ""
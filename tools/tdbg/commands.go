@@ -378,6 +378,37 @@ func AdminDescribeShard(c *cli.Context) error {
 	return nil
 }
 
+// AdminDescribeHistoryQueue describes the history task queues of a shard
+func AdminDescribeHistoryQueue(c *cli.Context) error {
+	sid := int32(c.Int(FlagShardID))
+	category := enumsspb.TASK_CATEGORY_UNSPECIFIED
+	if categoryStr := c.String(FlagTaskType); categoryStr != "" {
+		categoryValue, err := stringToEnum(categoryStr, enumsspb.TaskCategory_value)
+		if err != nil {
+			categoryInt, err := strconv.Atoi(categoryStr)
+			if err != nil {
+				return fmt.Errorf("unable to parse Task type: %s", err)
+			}
+			categoryValue = int32(categoryInt)
+		}
+		category = enumsspb.TaskCategory(categoryValue)
+	}
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.DescribeHistoryQueue(ctx, &adminservice.DescribeHistoryQueueRequest{
+		ShardId:  sid,
+		Category: category,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to describe history queue: %s", err)
+	}
+
+	prettyPrintJSONObject(response)
+	return nil
+}
+
 // AdminShardManagement describes history host
 func AdminShardManagement(c *cli.Context) error {
 	adminClient := cFactory.AdminClient(c)
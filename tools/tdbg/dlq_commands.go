@@ -28,11 +28,13 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/urfave/cli/v2"
 	"go.uber.org/multierr"
 
 	"go.temporal.io/server/api/adminservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	"go.temporal.io/server/common"
@@ -91,6 +93,9 @@ func AdminGetDLQMessages(c *cli.Context) (err error) {
 		for _, item := range resp.GetReplicationTasks() {
 			paginateItems = append(paginateItems, item)
 		}
+		for _, item := range resp.GetHistoryTasks() {
+			paginateItems = append(paginateItems, item)
+		}
 		return paginateItems, resp.GetNextPageToken(), err
 	}
 
@@ -102,14 +107,18 @@ func AdminGetDLQMessages(c *cli.Context) (err error) {
 			return fmt.Errorf("unable to read dlq message. Last read message id: %v", lastReadMessageID)
 		}
 
-		task := item.(*replicationspb.ReplicationTask)
 		encoder := codec.NewJSONPBIndentEncoder(" ")
-		taskStr, err := encoder.Encode(task)
+		taskStr, err := encoder.Encode(item.(proto.Message))
 		if err != nil {
 			return fmt.Errorf("unable to encode dlq message. Last read message id: %v", lastReadMessageID)
 		}
 
-		lastReadMessageID = int(task.SourceTaskId)
+		switch task := item.(type) {
+		case *replicationspb.ReplicationTask:
+			lastReadMessageID = int(task.SourceTaskId)
+		case *persistencespb.HistoryTaskDLQMessage:
+			lastReadMessageID = int(task.MessageId)
+		}
 		remainingMessageCount--
 		_, err = outputFile.WriteString(fmt.Sprintf("%v\n", string(taskStr)))
 		if err != nil {
@@ -203,6 +212,8 @@ func toQueueType(dlqType string) (enumsspb.DeadLetterQueueType, error) {
 		return enumsspb.DEAD_LETTER_QUEUE_TYPE_NAMESPACE, nil
 	case "history":
 		return enumsspb.DEAD_LETTER_QUEUE_TYPE_REPLICATION, nil
+	case "history-task":
+		return enumsspb.DEAD_LETTER_QUEUE_TYPE_HISTORY_TASK, nil
 	default:
 		return enumsspb.DEAD_LETTER_QUEUE_TYPE_UNSPECIFIED, fmt.Errorf("unsupported Tueue type %v", dlqType)
 	}
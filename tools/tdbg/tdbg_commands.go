@@ -211,6 +211,24 @@ func newAdminShardManagementCommands() []*cli.Command {
 				return AdminDescribeShard(c)
 			},
 		},
+		{
+			Name:  "describe-queue",
+			Usage: "Describe the reader watermarks and backlog of the history task queues of a shard",
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:     FlagShardID,
+					Usage:    "The ID of the shard",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskType,
+					Usage: "Task type: transfer, timer, visibility, archival. All task types are described if not set",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminDescribeHistoryQueue(c)
+			},
+		},
 		{
 			Name:  "list-tasks",
 			Usage: "List tasks for given shard ID and task type",
@@ -456,7 +474,7 @@ func newAdminDLQCommands() []*cli.Command {
 			Flags: []cli.Flag{
 				&cli.StringFlag{
 					Name:  FlagDLQType,
-					Usage: "Type of DLQ to manage. (Options: namespace, history)",
+					Usage: "Type of DLQ to manage. (Options: namespace, history, history-task)",
 				},
 				&cli.StringFlag{
 					Name:  FlagCluster,
@@ -490,7 +508,7 @@ func newAdminDLQCommands() []*cli.Command {
 			Flags: []cli.Flag{
 				&cli.StringFlag{
 					Name:  FlagDLQType,
-					Usage: "Type of DLQ to manage. (Options: namespace, history)",
+					Usage: "Type of DLQ to manage. (Options: namespace, history, history-task)",
 				},
 				&cli.StringFlag{
 					Name:  FlagCluster,
@@ -516,7 +534,7 @@ func newAdminDLQCommands() []*cli.Command {
 			Flags: []cli.Flag{
 				&cli.StringFlag{
 					Name:  FlagDLQType,
-					Usage: "Type of DLQ to manage. (Options: namespace, history)",
+					Usage: "Type of DLQ to manage. (Options: namespace, history, history-task)",
 				},
 				&cli.StringFlag{
 					Name:  FlagCluster,